@@ -22,22 +22,31 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/applicationserver"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/mqtt"
 )
 
 // DefaultApplicationServerConfig is the default configuration for the Application Server.
 var DefaultApplicationServerConfig = applicationserver.Config{
 	LinkMode: "all",
 	MQTT: config.MQTT{
-		Listen:           ":1883",
-		ListenTLS:        ":8883",
-		PublicAddress:    fmt.Sprintf("%s:1883", shared.DefaultPublicHost),
-		PublicTLSAddress: fmt.Sprintf("%s:8883", shared.DefaultPublicHost),
+		Listen:            ":1883",
+		ListenTLS:         ":8883",
+		PublicAddress:     fmt.Sprintf("%s:1883", shared.DefaultPublicHost),
+		PublicTLSAddress:  fmt.Sprintf("%s:8883", shared.DefaultPublicHost),
+		MaximumPacketSize: mqtt.DefaultMaximumPacketSize,
 	},
 	Webhooks: applicationserver.WebhooksConfig{
 		Target:    "direct",
 		Timeout:   5 * time.Second,
 		QueueSize: 16,
 		Workers:   16,
+		Retry: web.RetryConfig{
+			Attempts:       5,
+			InitialBackoff: 10 * time.Second,
+			MaxBackoff:     10 * time.Minute,
+			KeepFailed:     100,
+			PendingTimeout: time.Minute,
+		},
 		Downlinks: web.DownlinksConfig{PublicAddress: shared.DefaultPublicURL + "/api/v3"},
 	},
 }
@@ -81,6 +81,15 @@ var DefaultRedisConfig = config.Redis{
 	Namespace: []string{"ttn", "v3"},
 }
 
+// DefaultStorageConfig is the default config for the storage of registries.
+var DefaultStorageConfig = config.Storage{
+	Backend: "redis",
+	Bolt: config.Bolt{
+		Path:    "./data/ttn-lw-stack.db",
+		Timeout: 10 * time.Second,
+	},
+}
+
 // DefaultEventsConfig is the default config for Events.
 var DefaultEventsConfig = config.Events{
 	Backend: "internal",
@@ -117,6 +126,7 @@ var DefaultServiceBase = config.ServiceBase{
 	Base:             DefaultBaseConfig,
 	Cluster:          DefaultClusterConfig,
 	Redis:            DefaultRedisConfig,
+	Storage:          DefaultStorageConfig,
 	Events:           DefaultEventsConfig,
 	GRPC:             DefaultGRPCConfig,
 	HTTP:             DefaultHTTPConfig,
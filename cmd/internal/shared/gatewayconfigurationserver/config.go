@@ -15,6 +15,8 @@
 package shared
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/cmd/internal/shared"
 	gs "go.thethings.network/lorawan-stack/cmd/internal/shared/gatewayserver"
 	"go.thethings.network/lorawan-stack/pkg/gatewayconfigurationserver"
@@ -30,4 +32,5 @@ func init() {
 	DefaultGatewayConfigurationServerConfig.TheThingsGateway.Default.MQTTServer = "mqtts://" + gs.DefaultGatewayServerConfig.MQTTV2.PublicTLSAddress
 	DefaultGatewayConfigurationServerConfig.TheThingsGateway.Default.FirmwareURL = "https://thethingsproducts.blob.core.windows.net/the-things-gateway/v1"
 	DefaultGatewayConfigurationServerConfig.BasicStation.Default.LNSURI = "wss://" + shared.DefaultPublicHost + gs.DefaultGatewayServerConfig.BasicStation.ListenTLS
+	DefaultGatewayConfigurationServerConfig.BasicStation.CredentialsRotation.RollbackTimeout = 24 * time.Hour
 }
@@ -22,6 +22,8 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
+	upstreamudp "go.thethings.network/lorawan-stack/pkg/gatewayserver/upstream/udp"
+	"go.thethings.network/lorawan-stack/pkg/mqtt"
 )
 
 // DefaultGatewayServerConfig is the default configuration for the GatewayServer.
@@ -30,6 +32,9 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 	Forward: map[string][]string{
 		"": {"00000000/0"},
 	},
+	UpstreamUDP: gatewayserver.UpstreamUDPConfig{
+		Config: upstreamudp.DefaultConfig,
+	},
 	UDP: gatewayserver.UDPConfig{
 		Config: udp.DefaultConfig,
 		Listeners: map[string]string{
@@ -37,20 +42,42 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 		},
 	},
 	MQTTV2: config.MQTT{
-		Listen:           ":1881",
-		ListenTLS:        ":8881",
-		PublicAddress:    fmt.Sprintf("%s:1881", shared.DefaultPublicHost),
-		PublicTLSAddress: fmt.Sprintf("%s:8881", shared.DefaultPublicHost),
+		Listen:            ":1881",
+		ListenTLS:         ":8881",
+		PublicAddress:     fmt.Sprintf("%s:1881", shared.DefaultPublicHost),
+		PublicTLSAddress:  fmt.Sprintf("%s:8881", shared.DefaultPublicHost),
+		MaximumPacketSize: mqtt.DefaultMaximumPacketSize,
 	},
 	MQTT: config.MQTT{
-		Listen:           ":1882",
-		ListenTLS:        ":8882",
-		PublicAddress:    fmt.Sprintf("%s:1882", shared.DefaultPublicHost),
-		PublicTLSAddress: fmt.Sprintf("%s:8882", shared.DefaultPublicHost),
+		Listen:            ":1882",
+		ListenTLS:         ":8882",
+		PublicAddress:     fmt.Sprintf("%s:1882", shared.DefaultPublicHost),
+		PublicTLSAddress:  fmt.Sprintf("%s:8882", shared.DefaultPublicHost),
+		MaximumPacketSize: mqtt.DefaultMaximumPacketSize,
 	},
 	BasicStation: gatewayserver.BasicStationConfig{
 		Listen:         ":1887",
 		ListenTLS:      ":8887",
 		WSPingInterval: 30 * time.Second,
 	},
+	UpdateConnectionStatsInterval:     time.Minute,
+	UpdateGatewayLocationDebounceTime: time.Hour,
+	UpdateGatewayLocationThreshold:    10,
+	ReserveBeaconWindows:              true,
+	RateLimiting: gatewayserver.RateLimitingConfig{
+		Gateway: gatewayserver.RateLimitingProfile{
+			Uplink: gatewayserver.RateLimitConfig{
+				Rate:  50,
+				Burst: 100,
+			},
+			Status: gatewayserver.RateLimitConfig{
+				Rate:  1,
+				Burst: 10,
+			},
+			Connection: gatewayserver.RateLimitConfig{
+				Rate:  0.1,
+				Burst: 10,
+			},
+		},
+	},
 }
@@ -49,6 +49,10 @@ var DefaultIdentityServerConfig = identityserver.Config{
 
 func init() {
 	DefaultIdentityServerConfig.AuthCache.MembershipTTL = 10 * time.Minute
+	DefaultIdentityServerConfig.MFA.OrganizationsTTL = time.Minute
+	DefaultIdentityServerConfig.APIKeys.TrustedProxies = []string{"127.0.0.0/8", "::1/128"}
+	DefaultIdentityServerConfig.APIKeys.ExpiryNotification.Before = 7 * 24 * time.Hour
+	DefaultIdentityServerConfig.APIKeys.ExpiryNotification.Interval = time.Hour
 	DefaultIdentityServerConfig.UserRegistration.Invitation.TokenTTL = 7 * 24 * time.Hour
 	DefaultIdentityServerConfig.UserRegistration.PasswordRequirements.MinLength = 8
 	DefaultIdentityServerConfig.UserRegistration.PasswordRequirements.MaxLength = 1000
@@ -62,4 +66,5 @@ func init() {
 	DefaultIdentityServerConfig.ProfilePicture.UseGravatar = true
 	DefaultIdentityServerConfig.EndDevicePicture.Bucket = "end_device_pictures"
 	DefaultIdentityServerConfig.EndDevicePicture.BucketURL = path.Join(shared.DefaultAssetsBaseURL, "blob", "end_device_pictures")
+	DefaultIdentityServerConfig.OAuth.OIDC.OrganizationRights = []string{"RIGHT_ORGANIZATION_INFO", "RIGHT_APPLICATION_ALL", "RIGHT_GATEWAY_ALL"}
 }
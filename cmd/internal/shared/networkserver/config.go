@@ -23,8 +23,9 @@ import (
 
 // DefaultNetworkServerConfig is the default configuration for the NetworkServer
 var DefaultNetworkServerConfig = networkserver.Config{
-	DeduplicationWindow: 200 * time.Millisecond,
-	CooldownWindow:      time.Second,
+	DeduplicationWindow:  200 * time.Millisecond,
+	CooldownWindow:       time.Second,
+	DeduplicationBackend: "memory",
 	DownlinkPriorities: networkserver.DownlinkPriorityConfig{
 		JoinAccept:             "highest",
 		MACCommands:            "highest",
@@ -19,6 +19,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -203,8 +204,22 @@ var (
 			if len(rights) == 0 {
 				return errNoAPIKeyRights
 			}
+			expiresAt, allowedCIDRs, _, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
-			res, err := createApplicationAPIKey(ctx, *appID, name, rights...)
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationAccessClient(is).CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+				ApplicationIdentifiers: *appID,
+				Name:                   name,
+				Rights:                 rights,
+				ExpiresAt:              expiresAt,
+				AllowedCIDRs:           allowedCIDRs,
+			})
 			if err != nil {
 				return err
 			}
@@ -233,8 +248,18 @@ var (
 			name, _ := cmd.Flags().GetString("name")
 
 			rights := getRights(cmd.Flags())
-			if len(rights) == 0 {
-				return errNoAPIKeyRights
+			expiresAt, allowedCIDRs, paths, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("name") {
+				paths = append(paths, "name")
+			}
+			if len(rights) > 0 {
+				paths = append(paths, "rights")
+			}
+			if len(paths) == 0 {
+				return errNoAPIKeyUpdate
 			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
@@ -244,10 +269,13 @@ var (
 			_, err = ttnpb.NewApplicationAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
 				ApplicationIdentifiers: *appID,
 				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
+					ID:           id,
+					Name:         name,
+					Rights:       rights,
+					ExpiresAt:    expiresAt,
+					AllowedCIDRs: allowedCIDRs,
 				},
+				FieldMask: types.FieldMask{Paths: paths},
 			})
 			if err != nil {
 				return err
@@ -312,10 +340,12 @@ func init() {
 	applicationAPIKeys.AddCommand(applicationAPIKeysList)
 	applicationAPIKeysCreate.Flags().String("name", "", "")
 	applicationAPIKeysCreate.Flags().AddFlagSet(applicationRightsFlags)
+	applicationAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	applicationAPIKeys.AddCommand(applicationAPIKeysCreate)
 	applicationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	applicationAPIKeysUpdate.Flags().String("name", "", "")
 	applicationAPIKeysUpdate.Flags().AddFlagSet(applicationRightsFlags)
+	applicationAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	applicationAPIKeys.AddCommand(applicationAPIKeysUpdate)
 	applicationAPIKeysDelete.Flags().String("api-key-id", "", "")
 	applicationAPIKeys.AddCommand(applicationAPIKeysDelete)
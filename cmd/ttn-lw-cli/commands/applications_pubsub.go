@@ -30,10 +30,12 @@ import (
 )
 
 var (
-	selectApplicationPubSubFlags       = util.FieldMaskFlags(&ttnpb.ApplicationPubSub{})
-	setApplicationPubSubFlags          = util.FieldFlags(&ttnpb.ApplicationPubSub{})
-	natsProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_NATSProvider{}, "nats")
-	mqttProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_MQTTProvider{}, "mqtt")
+	selectApplicationPubSubFlags        = util.FieldMaskFlags(&ttnpb.ApplicationPubSub{})
+	setApplicationPubSubFlags           = util.FieldFlags(&ttnpb.ApplicationPubSub{})
+	natsProviderApplicationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_NATSProvider{}, "nats")
+	mqttProviderApplicationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_MQTTProvider{}, "mqtt")
+	kafkaProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_KafkaProvider{}, "kafka")
+	amqpProviderApplicationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_AMQPProvider{}, "amqp")
 )
 
 func applicationPubSubIDFlags() *pflag.FlagSet {
@@ -52,6 +54,16 @@ func applicationPubSubProviderFlags() *pflag.FlagSet {
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-ca", ""))
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-client-cert", ""))
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-client-key", ""))
+	flagSet.Bool("kafka", false, "use the Kafka provider")
+	flagSet.AddFlagSet(kafkaProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(dataFlags("kafka.tls-ca", ""))
+	flagSet.AddFlagSet(dataFlags("kafka.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("kafka.tls-client-key", ""))
+	flagSet.Bool("amqp", false, "use the AMQP provider")
+	flagSet.AddFlagSet(amqpProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(dataFlags("amqp.tls-ca", ""))
+	flagSet.AddFlagSet(dataFlags("amqp.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("amqp.tls-client-key", ""))
 	addDeprecatedProviderFlags(flagSet)
 	return flagSet
 }
@@ -64,6 +76,23 @@ func forwardDeprecatedProviderFlags(flagSet *pflag.FlagSet) {
 	util.ForwardFlag(flagSet, "nats_server_url", "nats.server_url")
 }
 
+// setLocalFileFlags sets the flags with the given names to the hex encoded contents of their local files, if provided.
+func setLocalFileFlags(flagSet *pflag.FlagSet, names ...string) error {
+	for _, name := range names {
+		if filename, _ := flagSet.GetString(name + "-local-file"); filename == "" {
+			continue
+		}
+		data, err := getDataBytes(name, flagSet)
+		if err != nil {
+			return err
+		}
+		if err = flagSet.Set(name, hex.EncodeToString(data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 var errNoPubSubID = errors.DefineInvalidArgument("no_pub_sub_id", "no pubsub ID set")
 
 func getApplicationPubSubID(flagSet *pflag.FlagSet, args []string) (*ttnpb.ApplicationPubSubIdentifiers, error) {
@@ -262,6 +291,52 @@ var (
 				}
 			}
 
+			if kafka, _ := cmd.Flags().GetBool("kafka"); kafka {
+				if err = setLocalFileFlags(cmd.Flags(),
+					"kafka.tls-ca",
+					"kafka.tls-client-cert",
+					"kafka.tls-client-key",
+				); err != nil {
+					return err
+				}
+				if pubsub.GetKafka() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_Kafka{
+						Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), kafkaProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if err = util.SetFields(pubsub.GetKafka(), kafkaProviderApplicationPubSubFlags, "kafka"); err != nil {
+					return err
+				}
+			}
+
+			if amqp, _ := cmd.Flags().GetBool("amqp"); amqp {
+				if err = setLocalFileFlags(cmd.Flags(),
+					"amqp.tls-ca",
+					"amqp.tls-client-cert",
+					"amqp.tls-client-key",
+				); err != nil {
+					return err
+				}
+				if pubsub.GetAMQP() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_AMQP{
+						AMQP: &ttnpb.ApplicationPubSub_AMQPProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), amqpProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if err = util.SetFields(pubsub.GetAMQP(), amqpProviderApplicationPubSubFlags, "amqp"); err != nil {
+					return err
+				}
+			}
+
 			res, err := ttnpb.NewApplicationPubSubRegistryClient(as).Set(ctx, &ttnpb.SetApplicationPubSubRequest{
 				ApplicationPubSub: *pubsub,
 				FieldMask:         types.FieldMask{Paths: paths},
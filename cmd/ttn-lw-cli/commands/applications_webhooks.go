@@ -75,6 +75,38 @@ func headersFlags() *pflag.FlagSet {
 	return flagSet
 }
 
+func clientCertificateFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.AddFlagSet(dataFlags("client-certificate", "PEM encoded client certificate"))
+	flagSet.AddFlagSet(dataFlags("client-key", "PEM encoded private key of the client certificate"))
+	return flagSet
+}
+
+var errNoClientKey = errors.DefineInvalidArgument("no_client_key", "no private key of the client certificate set")
+
+// getClientCertificate returns the client certificate loaded from the client certificate flags.
+// If the client certificate flag is not set, this function returns nil.
+func getClientCertificate(flagSet *pflag.FlagSet) (*ttnpb.ApplicationWebhook_ClientCertificate, error) {
+	if certFile, _ := flagSet.GetString("client-certificate-local-file"); certFile == "" {
+		return nil, nil
+	}
+	cert, err := getDataBytes("client-certificate", flagSet)
+	if err != nil {
+		return nil, err
+	}
+	key, err := getDataBytes("client-key", flagSet)
+	if err != nil {
+		if errors.IsInvalidArgument(err) {
+			return nil, errNoClientKey
+		}
+		return nil, err
+	}
+	return &ttnpb.ApplicationWebhook_ClientCertificate{
+		Certificate: cert,
+		Key:         key,
+	}, nil
+}
+
 var (
 	applicationsWebhooksCommand = &cobra.Command{
 		Use:     "webhooks",
@@ -180,6 +212,14 @@ var (
 			headers, _ := cmd.Flags().GetStringSlice("headers")
 			webhook.Headers = mergeKV(webhook.Headers, headers)
 			webhook.ApplicationWebhookIdentifiers = *webhookID
+			clientCertificate, err := getClientCertificate(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if clientCertificate != nil {
+				webhook.ClientCertificate = clientCertificate
+				paths = append(paths, "client_certificate")
+			}
 
 			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
 			if err != nil {
@@ -230,6 +270,7 @@ func init() {
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(setApplicationWebhookFlags)
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(headersFlags())
+	applicationsWebhooksSetCommand.Flags().AddFlagSet(clientCertificateFlags())
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksSetCommand)
 	applicationsWebhooksDeleteCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksDeleteCommand)
@@ -19,6 +19,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 	"go.thethings.network/lorawan-stack/pkg/errors"
@@ -112,10 +113,39 @@ func getRights(flagSet *pflag.FlagSet) (rights []ttnpb.Right) {
 }
 
 var (
-	errNoAPIKeyID     = errors.DefineInvalidArgument("no_api_key_id", "no API key ID set")
-	errNoAPIKeyRights = errors.DefineInvalidArgument("no_api_key_rights", "no API key rights set")
+	errNoAPIKeyID          = errors.DefineInvalidArgument("no_api_key_id", "no API key ID set")
+	errNoAPIKeyRights      = errors.DefineInvalidArgument("no_api_key_rights", "no API key rights set")
+	errNoAPIKeyUpdate      = errors.DefineInvalidArgument("no_api_key_update", "no API key fields to update set")
+	errInvalidAPIKeyExpiry = errors.DefineInvalidArgument("invalid_api_key_expiry", "invalid API key expiry `{expires_at}`")
 )
 
+func apiKeyRestrictionFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("expires-at", "", "time after which the API key can no longer be used (RFC3339)")
+	flagSet.StringSlice("allowed-cidrs", nil, "source addresses from which the API key can be used (CIDR notation)")
+	return flagSet
+}
+
+// getAPIKeyRestrictions returns the expiry and allowed CIDRs of the API key,
+// and the field mask paths of the restrictions that are set in the flags.
+func getAPIKeyRestrictions(flagSet *pflag.FlagSet) (expiresAt *time.Time, allowedCIDRs []string, paths []string, err error) {
+	if flagSet.Changed("expires-at") {
+		paths = append(paths, "expires_at")
+		if value, _ := flagSet.GetString("expires-at"); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, nil, nil, errInvalidAPIKeyExpiry.WithCause(err).WithAttributes("expires_at", value)
+			}
+			expiresAt = &t
+		}
+	}
+	if flagSet.Changed("allowed-cidrs") {
+		paths = append(paths, "allowed_cidrs")
+		allowedCIDRs, _ = flagSet.GetStringSlice("allowed-cidrs")
+	}
+	return expiresAt, allowedCIDRs, paths, nil
+}
+
 func getAPIKeyID(flagSet *pflag.FlagSet, args []string, i int) string {
 	var apiKeyID string
 	if len(args) > 0+i {
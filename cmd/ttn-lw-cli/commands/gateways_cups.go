@@ -0,0 +1,330 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	selectGatewayFirmwareTargetFlags = util.FieldMaskFlags(&ttnpb.GatewayFirmwareTarget{})
+	setGatewayFirmwareTargetFlags    = util.FieldFlags(&ttnpb.GatewayFirmwareTarget{})
+)
+
+func gatewayFirmwareTargetIDFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("gateway-id", "", "")
+	flagSet.String("model", "", "station model")
+	return flagSet
+}
+
+func gatewayCredentialsRotationFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Duration("interval", 0, "interval between credentials rotations (0 to disable)")
+	flagSet.Duration("rollback-timeout", 0, "time after which a pending rotation is rolled back (0 to disable)")
+	return flagSet
+}
+
+var errNoFirmwareTargetID = errors.DefineInvalidArgument("no_firmware_target_id", "no gateway ID or model set")
+
+func getGatewayFirmwareTargetID(flagSet *pflag.FlagSet, args []string) (*ttnpb.GatewayFirmwareTargetIdentifiers, error) {
+	gatewayID, _ := flagSet.GetString("gateway-id")
+	model, _ := flagSet.GetString("model")
+	switch len(args) {
+	case 0:
+	case 1:
+		gatewayID = args[0]
+	default:
+		logger.Warn("Multiple IDs found in arguments, considering the first")
+		gatewayID = args[0]
+	}
+	switch {
+	case gatewayID != "" && model != "", gatewayID == "" && model == "":
+		return nil, errNoFirmwareTargetID
+	case gatewayID != "":
+		return &ttnpb.GatewayFirmwareTargetIdentifiers{
+			GatewayIDs: &ttnpb.GatewayIdentifiers{GatewayID: gatewayID},
+		}, nil
+	default:
+		return &ttnpb.GatewayFirmwareTargetIdentifiers{Model: model}, nil
+	}
+}
+
+var (
+	gatewaysCUPSCommand = &cobra.Command{
+		Use:   "cups",
+		Short: "Gateway CUPS commands",
+	}
+	gatewaysCUPSFirmwareTargetsCommand = &cobra.Command{
+		Use:     "firmware-targets",
+		Aliases: []string{"firmware-target", "firmware"},
+		Short:   "Gateway firmware targets commands",
+	}
+	gatewaysCUPSFirmwareTargetsListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the firmware targets of all gateways and station models",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			paths := util.SelectFieldMask(cmd.Flags(), selectGatewayFirmwareTargetFlags)
+			if len(paths) == 0 {
+				logger.Warn("No fields selected, will select everything")
+				selectGatewayFirmwareTargetFlags.VisitAll(func(flag *pflag.Flag) {
+					paths = append(paths, strings.Replace(flag.Name, "-", "_", -1))
+				})
+			}
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayFirmwareRegistryClient(gcs).List(ctx, &ttnpb.ListGatewayFirmwareTargetsRequest{
+				FieldMask: types.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCUPSFirmwareTargetsGetCommand = &cobra.Command{
+		Use:     "get [gateway-id]",
+		Aliases: []string{"info"},
+		Short:   "Get the firmware target of a gateway or station model",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getGatewayFirmwareTargetID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			paths := util.SelectFieldMask(cmd.Flags(), selectGatewayFirmwareTargetFlags)
+			if len(paths) == 0 {
+				logger.Warn("No fields selected, will select everything")
+				selectGatewayFirmwareTargetFlags.VisitAll(func(flag *pflag.Flag) {
+					paths = append(paths, strings.Replace(flag.Name, "-", "_", -1))
+				})
+			}
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayFirmwareRegistryClient(gcs).Get(ctx, &ttnpb.GetGatewayFirmwareTargetRequest{
+				GatewayFirmwareTargetIdentifiers: *ids,
+				FieldMask:                        types.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCUPSFirmwareTargetsSetCommand = &cobra.Command{
+		Use:     "set [gateway-id]",
+		Aliases: []string{"update"},
+		Short:   "Set the firmware target of a gateway or station model",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getGatewayFirmwareTargetID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			paths := util.UpdateFieldMask(cmd.Flags(), setGatewayFirmwareTargetFlags)
+
+			var target ttnpb.GatewayFirmwareTarget
+			if err = util.SetFields(&target, setGatewayFirmwareTargetFlags); err != nil {
+				return err
+			}
+			target.GatewayFirmwareTargetIdentifiers = *ids
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayFirmwareRegistryClient(gcs).Set(ctx, &ttnpb.SetGatewayFirmwareTargetRequest{
+				GatewayFirmwareTarget: target,
+				FieldMask:             types.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCUPSFirmwareTargetsDeleteCommand = &cobra.Command{
+		Use:   "delete [gateway-id]",
+		Short: "Delete the firmware target of a gateway or station model",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getGatewayFirmwareTargetID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGatewayFirmwareRegistryClient(gcs).Delete(ctx, ids)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	gatewaysCUPSCredentialsRotationCommand = &cobra.Command{
+		Use:     "credentials-rotation",
+		Aliases: []string{"rotation"},
+		Short:   "Gateway credentials rotation commands",
+	}
+	gatewaysCUPSCredentialsRotationGetCommand = &cobra.Command{
+		Use:     "get [gateway-id]",
+		Aliases: []string{"info"},
+		Short:   "Get the credentials rotation of a gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayCredentialsRotatorClient(gcs).Get(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCUPSCredentialsRotationSetCommand = &cobra.Command{
+		Use:     "set [gateway-id]",
+		Aliases: []string{"update"},
+		Short:   "Set the credentials rotation policy of a gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			rotation := ttnpb.GatewayCredentialsRotation{
+				GatewayIdentifiers: *gtwID,
+			}
+			var paths []string
+			if cmd.Flags().Changed("interval") {
+				rotation.Interval, _ = cmd.Flags().GetDuration("interval")
+				paths = append(paths, "interval")
+			}
+			if cmd.Flags().Changed("rollback-timeout") {
+				rotation.RollbackTimeout, _ = cmd.Flags().GetDuration("rollback-timeout")
+				paths = append(paths, "rollback_timeout")
+			}
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayCredentialsRotatorClient(gcs).Set(ctx, &ttnpb.SetGatewayCredentialsRotationRequest{
+				GatewayCredentialsRotation: rotation,
+				FieldMask:                  types.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCUPSCredentialsRotationRotateCommand = &cobra.Command{
+		Use:   "rotate [gateway-id]",
+		Short: "Rotate the credentials of a gateway the next time it connects to CUPS",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayCredentialsRotatorClient(gcs).Rotate(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCUPSCredentialsRotationRollbackCommand = &cobra.Command{
+		Use:   "rollback [gateway-id]",
+		Short: "Roll back the pending credentials rotation of a gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			gcs, err := api.Dial(ctx, config.GatewayConfigurationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGatewayCredentialsRotatorClient(gcs).Rollback(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	gatewaysCUPSFirmwareTargetsListCommand.Flags().AddFlagSet(selectGatewayFirmwareTargetFlags)
+	gatewaysCUPSFirmwareTargetsCommand.AddCommand(gatewaysCUPSFirmwareTargetsListCommand)
+	gatewaysCUPSFirmwareTargetsGetCommand.Flags().AddFlagSet(gatewayFirmwareTargetIDFlags())
+	gatewaysCUPSFirmwareTargetsGetCommand.Flags().AddFlagSet(selectGatewayFirmwareTargetFlags)
+	gatewaysCUPSFirmwareTargetsCommand.AddCommand(gatewaysCUPSFirmwareTargetsGetCommand)
+	gatewaysCUPSFirmwareTargetsSetCommand.Flags().AddFlagSet(gatewayFirmwareTargetIDFlags())
+	gatewaysCUPSFirmwareTargetsSetCommand.Flags().AddFlagSet(setGatewayFirmwareTargetFlags)
+	gatewaysCUPSFirmwareTargetsCommand.AddCommand(gatewaysCUPSFirmwareTargetsSetCommand)
+	gatewaysCUPSFirmwareTargetsDeleteCommand.Flags().AddFlagSet(gatewayFirmwareTargetIDFlags())
+	gatewaysCUPSFirmwareTargetsCommand.AddCommand(gatewaysCUPSFirmwareTargetsDeleteCommand)
+	gatewaysCUPSCommand.AddCommand(gatewaysCUPSFirmwareTargetsCommand)
+
+	gatewaysCUPSCredentialsRotationGetCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCUPSCredentialsRotationCommand.AddCommand(gatewaysCUPSCredentialsRotationGetCommand)
+	gatewaysCUPSCredentialsRotationSetCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCUPSCredentialsRotationSetCommand.Flags().AddFlagSet(gatewayCredentialsRotationFlags())
+	gatewaysCUPSCredentialsRotationCommand.AddCommand(gatewaysCUPSCredentialsRotationSetCommand)
+	gatewaysCUPSCredentialsRotationRotateCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCUPSCredentialsRotationCommand.AddCommand(gatewaysCUPSCredentialsRotationRotateCommand)
+	gatewaysCUPSCredentialsRotationRollbackCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCUPSCredentialsRotationCommand.AddCommand(gatewaysCUPSCredentialsRotationRollbackCommand)
+	gatewaysCUPSCommand.AddCommand(gatewaysCUPSCredentialsRotationCommand)
+
+	gatewaysCommand.AddCommand(gatewaysCUPSCommand)
+}
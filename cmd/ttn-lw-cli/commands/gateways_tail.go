@@ -0,0 +1,99 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"os"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	gatewaysTailCommand = &cobra.Command{
+		Use:   "tail [gateway-id]",
+		Short: "Stream the raw traffic of a gateway",
+		Long: `Stream the raw traffic of a gateway
+
+The uplink messages, status messages, scheduled downlink messages and Tx
+acknowledgments of the gateway are streamed as they are handled by the Gateway
+Server. Messages that are dropped by the Gateway Server contain the drop
+reason. The gateway must be connected to the configured Gateway Server.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			gateway, err := ttnpb.NewGatewayRegistryClient(is).Get(ctx, &ttnpb.GetGatewayRequest{
+				GatewayIdentifiers: *gtwID,
+				FieldMask:          types.FieldMask{Paths: []string{"gateway_server_address"}},
+			})
+			if err != nil {
+				return err
+			}
+			if gsMismatch := compareServerAddressGateway(gateway, config); gsMismatch {
+				return errAddressMismatchGateway
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := ttnpb.NewGsClient(gs).TailGateway(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+
+			var streamErr error
+			go func() {
+				defer cancel()
+				for {
+					msg, err := stream.Recv()
+					if err != nil {
+						streamErr = err
+						return
+					}
+					if err = io.Write(os.Stdout, config.OutputFormat, msg); err != nil {
+						streamErr = err
+						return
+					}
+				}
+			}()
+
+			<-ctx.Done()
+
+			if streamErr != nil {
+				return streamErr
+			}
+			return ctx.Err()
+		},
+	}
+)
+
+func init() {
+	gatewaysTailCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysTailCommand)
+}
@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -190,6 +191,10 @@ var (
 			if len(rights) == 0 {
 				return errNoAPIKeyRights
 			}
+			expiresAt, allowedCIDRs, _, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -199,6 +204,8 @@ var (
 				OrganizationIdentifiers: *orgID,
 				Name:                    name,
 				Rights:                  rights,
+				ExpiresAt:               expiresAt,
+				AllowedCIDRs:            allowedCIDRs,
 			})
 			if err != nil {
 				return err
@@ -228,8 +235,18 @@ var (
 			name, _ := cmd.Flags().GetString("name")
 
 			rights := getRights(cmd.Flags())
-			if len(rights) == 0 {
-				return errNoAPIKeyRights
+			expiresAt, allowedCIDRs, paths, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("name") {
+				paths = append(paths, "name")
+			}
+			if len(rights) > 0 {
+				paths = append(paths, "rights")
+			}
+			if len(paths) == 0 {
+				return errNoAPIKeyUpdate
 			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
@@ -239,10 +256,13 @@ var (
 			_, err = ttnpb.NewOrganizationAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateOrganizationAPIKeyRequest{
 				OrganizationIdentifiers: *orgID,
 				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
+					ID:           id,
+					Name:         name,
+					Rights:       rights,
+					ExpiresAt:    expiresAt,
+					AllowedCIDRs: allowedCIDRs,
 				},
+				FieldMask: types.FieldMask{Paths: paths},
 			})
 			if err != nil {
 				return err
@@ -312,10 +332,12 @@ func init() {
 	organizationAPIKeys.AddCommand(organizationAPIKeysList)
 	organizationAPIKeysCreate.Flags().String("name", "", "")
 	organizationAPIKeysCreate.Flags().AddFlagSet(organizationRightsFlags)
+	organizationAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	organizationAPIKeys.AddCommand(organizationAPIKeysCreate)
 	organizationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	organizationAPIKeysUpdate.Flags().String("name", "", "")
 	organizationAPIKeysUpdate.Flags().AddFlagSet(organizationRightsFlags)
+	organizationAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	organizationAPIKeys.AddCommand(organizationAPIKeysUpdate)
 	organizationAPIKeysDelete.Flags().String("api-key-id", "", "")
 	organizationAPIKeys.AddCommand(organizationAPIKeysDelete)
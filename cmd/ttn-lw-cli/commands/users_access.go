@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -92,6 +93,10 @@ var (
 			if len(rights) == 0 {
 				return errNoAPIKeyRights
 			}
+			expiresAt, allowedCIDRs, _, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -101,6 +106,8 @@ var (
 				UserIdentifiers: *usrID,
 				Name:            name,
 				Rights:          rights,
+				ExpiresAt:       expiresAt,
+				AllowedCIDRs:    allowedCIDRs,
 			})
 			if err != nil {
 				return err
@@ -130,8 +137,18 @@ var (
 			name, _ := cmd.Flags().GetString("name")
 
 			rights := getRights(cmd.Flags())
-			if len(rights) == 0 {
-				return errNoAPIKeyRights
+			expiresAt, allowedCIDRs, paths, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("name") {
+				paths = append(paths, "name")
+			}
+			if len(rights) > 0 {
+				paths = append(paths, "rights")
+			}
+			if len(paths) == 0 {
+				return errNoAPIKeyUpdate
 			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
@@ -141,10 +158,13 @@ var (
 			_, err = ttnpb.NewUserAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
 				UserIdentifiers: *usrID,
 				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
+					ID:           id,
+					Name:         name,
+					Rights:       rights,
+					ExpiresAt:    expiresAt,
+					AllowedCIDRs: allowedCIDRs,
 				},
+				FieldMask: types.FieldMask{Paths: paths},
 			})
 			if err != nil {
 				return err
@@ -204,10 +224,12 @@ func init() {
 	userAPIKeys.AddCommand(userAPIKeysList)
 	userAPIKeysCreate.Flags().String("name", "", "")
 	userAPIKeysCreate.Flags().AddFlagSet(userRightsFlags)
+	userAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	userAPIKeys.AddCommand(userAPIKeysCreate)
 	userAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	userAPIKeysUpdate.Flags().String("name", "", "")
 	userAPIKeysUpdate.Flags().AddFlagSet(userRightsFlags)
+	userAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	userAPIKeys.AddCommand(userAPIKeysUpdate)
 	userAPIKeysDelete.Flags().String("api-key-id", "", "")
 	userAPIKeys.AddCommand(userAPIKeysDelete)
@@ -0,0 +1,358 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-redis/redis"
+	"github.com/spf13/cobra"
+	asredis "go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	jsredis "go.thethings.network/lorawan-stack/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	nsredis "go.thethings.network/lorawan-stack/pkg/networkserver/redis"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	errRewrapStorageBackend = errors.DefineFailedPrecondition("rewrap_storage_backend", "re-wrapping keys is not supported with storage backend `{backend}`")
+	errInvalidRegistryKey   = errors.DefineCorruption("invalid_registry_key", "invalid registry key `{key}`")
+	errInvalidRewrapCursor  = errors.DefineCorruption("invalid_rewrap_cursor", "invalid cursor `{cursor}` of registry `{registry}`")
+	errRewrap               = errors.Define("rewrap", "failed to re-wrap keys of `{key}`")
+)
+
+// rewrapDone is stored as cursor of registries in which all keys are re-wrapped.
+const rewrapDone = "done"
+
+// rewrapEndDeviceGets are the paths of the end device fields that contain key envelopes.
+var rewrapEndDeviceGets = []string{
+	"pending_mac_state.queued_join_accept.keys",
+	"pending_session.keys",
+	"root_keys",
+	"session.keys",
+}
+
+// rewrapSessionKeysGets are the paths of the session keys fields that contain key envelopes.
+var rewrapSessionKeysGets = []string{
+	"app_s_key",
+	"f_nwk_s_int_key",
+	"nwk_s_enc_key",
+	"s_nwk_s_int_key",
+}
+
+// rewrapEndDevice wraps the keys of the end device with the current version of the given KEK label, and returns the
+// paths of the keys that changed.
+func rewrapEndDevice(ctx context.Context, dev *ttnpb.EndDevice, kekLabel string, kv crypto.KeyVault) ([]string, error) {
+	var paths []string
+	rewrapSessionKeys := func(sk *ttnpb.SessionKeys, prefix string) error {
+		changed, err := cryptoutil.RewrapSessionKeys(ctx, sk, prefix, kekLabel, kv)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, changed...)
+		return nil
+	}
+	rewrapKey := func(env *ttnpb.KeyEnvelope, path string) error {
+		changed, err := cryptoutil.RewrapAES128Key(ctx, env, kekLabel, kv)
+		if err != nil {
+			return err
+		}
+		if changed {
+			paths = append(paths, path)
+		}
+		return nil
+	}
+	if dev.Session != nil {
+		if err := rewrapSessionKeys(&dev.Session.SessionKeys, "session.keys"); err != nil {
+			return nil, err
+		}
+	}
+	if dev.PendingSession != nil {
+		if err := rewrapSessionKeys(&dev.PendingSession.SessionKeys, "pending_session.keys"); err != nil {
+			return nil, err
+		}
+	}
+	if dev.PendingMACState != nil && dev.PendingMACState.QueuedJoinAccept != nil {
+		if err := rewrapSessionKeys(&dev.PendingMACState.QueuedJoinAccept.Keys, "pending_mac_state.queued_join_accept.keys"); err != nil {
+			return nil, err
+		}
+	}
+	if dev.RootKeys != nil {
+		if err := rewrapKey(dev.RootKeys.AppKey, "root_keys.app_key"); err != nil {
+			return nil, err
+		}
+		if err := rewrapKey(dev.RootKeys.NwkKey, "root_keys.nwk_key"); err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
+
+// rewrapRegistry is a Redis registry of which the keys are re-wrapped.
+type rewrapRegistry struct {
+	name   string
+	redis  *ttnredis.Client
+	match  string
+	rewrap func(ctx context.Context, key string) (bool, error)
+}
+
+// newRewrapDeviceRegistry returns a rewrapRegistry for the end devices that are stored by UID with the given client.
+// The set function sets the end device with the given identifiers in the registry.
+func newRewrapDeviceRegistry(name string, cl *ttnredis.Client, set func(context.Context, ttnpb.EndDeviceIdentifiers, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) error, kekLabel string, kv crypto.KeyVault) rewrapRegistry {
+	prefix := cl.Key("uid", "")
+	return rewrapRegistry{
+		name:  name,
+		redis: cl,
+		match: cl.Key("uid", "*"),
+		rewrap: func(ctx context.Context, key string) (changed bool, err error) {
+			ids, err := unique.ToDeviceID(strings.TrimPrefix(key, prefix))
+			if err != nil {
+				return false, errInvalidRegistryKey.WithCause(err).WithAttributes("key", key)
+			}
+			err = set(ctx, ids, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				if dev == nil {
+					return nil, nil, nil
+				}
+				paths, err := rewrapEndDevice(ctx, dev, kekLabel, kv)
+				if err != nil {
+					return nil, nil, err
+				}
+				changed = len(paths) > 0
+				return dev, paths, nil
+			})
+			return changed, err
+		},
+	}
+}
+
+// newRewrapKeyRegistry returns a rewrapRegistry for the session keys of the Join Server that are stored with the given
+// client.
+// Session keys are wrapped for the Network Server and Application Server, so these keep their KEK label.
+func newRewrapKeyRegistry(cl *ttnredis.Client, kv crypto.KeyVault) rewrapRegistry {
+	registry := &jsredis.KeyRegistry{Redis: cl}
+	prefix := cl.Key("id", "")
+	return rewrapRegistry{
+		name:  "js:keys",
+		redis: cl,
+		match: cl.Key("id", "*"),
+		rewrap: func(ctx context.Context, key string) (changed bool, err error) {
+			parts := strings.Split(strings.TrimPrefix(key, prefix), ":")
+			if len(parts) != 3 {
+				return false, errInvalidRegistryKey.WithAttributes("key", key)
+			}
+			var joinEUI, devEUI types.EUI64
+			if err := joinEUI.UnmarshalText([]byte(parts[0])); err != nil {
+				return false, errInvalidRegistryKey.WithCause(err).WithAttributes("key", key)
+			}
+			if err := devEUI.UnmarshalText([]byte(parts[1])); err != nil {
+				return false, errInvalidRegistryKey.WithCause(err).WithAttributes("key", key)
+			}
+			id, err := base64.RawStdEncoding.DecodeString(parts[2])
+			if err != nil {
+				return false, errInvalidRegistryKey.WithCause(err).WithAttributes("key", key)
+			}
+			_, err = registry.SetByID(ctx, joinEUI, devEUI, id, rewrapSessionKeysGets, func(sk *ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error) {
+				if sk == nil {
+					return nil, nil, nil
+				}
+				paths, err := cryptoutil.RewrapSessionKeys(ctx, sk, "", "", kv)
+				if err != nil {
+					return nil, nil, err
+				}
+				changed = len(paths) > 0
+				return sk, paths, nil
+			})
+			return changed, err
+		},
+	}
+}
+
+// rewrapKeys walks the keys of the registry and re-wraps them. The cursor of the registry is stored in the given
+// progress hash, so that re-wrapping can be resumed.
+func rewrapKeys(ctx context.Context, progress *ttnredis.Client, progressKey string, registry rewrapRegistry, resume bool, batchSize int64) error {
+	logger := logger.WithField("registry", registry.name)
+	var cursor uint64
+	if resume {
+		s, err := progress.HGet(progressKey, registry.name).Result()
+		switch {
+		case err == redis.Nil:
+		case err != nil:
+			return ttnredis.ConvertError(err)
+		case s == rewrapDone:
+			logger.Info("Keys already re-wrapped, skip registry")
+			return nil
+		default:
+			cursor, err = strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return errInvalidRewrapCursor.WithCause(err).WithAttributes("cursor", s, "registry", registry.name)
+			}
+			logger.WithField("cursor", cursor).Info("Resume re-wrapping keys")
+		}
+	}
+
+	var scanned, rewrapped int
+	for {
+		keys, next, err := registry.redis.Scan(cursor, registry.match, batchSize).Result()
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		for _, key := range keys {
+			changed, err := registry.rewrap(ctx, key)
+			if err != nil {
+				return errRewrap.WithCause(err).WithAttributes("key", key)
+			}
+			scanned++
+			if changed {
+				rewrapped++
+			}
+		}
+		cursor = next
+		value := strconv.FormatUint(cursor, 10)
+		if cursor == 0 {
+			value = rewrapDone
+		}
+		if err := progress.HSet(progressKey, registry.name, value).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		if cursor == 0 {
+			break
+		}
+		logger.WithFields(log.Fields(
+			"scanned", scanned,
+			"rewrapped", rewrapped,
+		)).Info("Re-wrapping keys...")
+	}
+	logger.WithFields(log.Fields(
+		"scanned", scanned,
+		"rewrapped", rewrapped,
+	)).Info("Re-wrapped keys")
+	return nil
+}
+
+var (
+	keyVaultCommand = &cobra.Command{
+		Use:   "key-vault",
+		Short: "Manage keys that are wrapped with the key vault",
+	}
+	keyVaultRewrapCommand = &cobra.Command{
+		Use:   "rewrap [ns|as|js]...",
+		Short: "Re-wrap the keys in the registries with the current version of the KEK labels",
+		Long: `Re-wrap the keys in the registries with the current version of the KEK labels.
+
+The end device keys of the Network Server, Application Server and Join Server
+are wrapped with the current version of their device KEK label. The session
+keys of the Join Server are wrapped with the current version of their KEK
+label. Re-wrapping is done for all components if none are specified.
+
+Progress is stored in Redis; use --resume to continue where a previous run
+stopped.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.Storage.Backend != "redis" {
+				return errRewrapStorageBackend.WithAttributes("backend", config.Storage.Backend)
+			}
+			resume, err := cmd.Flags().GetBool("resume")
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt64("batch-size")
+			if err != nil {
+				return err
+			}
+
+			kv, err := config.KeyVault.KeyVault()
+			if err != nil {
+				return err
+			}
+			if closer, ok := kv.(io.Closer); ok {
+				defer closer.Close()
+			}
+
+			components := map[string]bool{"ns": len(args) == 0, "as": len(args) == 0, "js": len(args) == 0}
+			for _, arg := range args {
+				if _, ok := components[arg]; !ok {
+					return errUnknownComponent.WithAttributes("component", arg)
+				}
+				components[arg] = true
+			}
+
+			newClient := func(namespace ...string) *ttnredis.Client {
+				return ttnredis.New(&ttnredis.Config{
+					Redis:     config.Redis,
+					Namespace: namespace,
+				})
+			}
+			var registries []rewrapRegistry
+			if components["ns"] {
+				cl := newClient("ns", "devices")
+				registry := &nsredis.DeviceRegistry{Redis: cl}
+				registries = append(registries, newRewrapDeviceRegistry("ns:devices", cl, func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) error {
+					_, _, err := registry.SetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, rewrapEndDeviceGets, func(_ context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+						return f(dev)
+					})
+					return err
+				}, config.NS.DeviceKEKLabel, kv))
+			}
+			if components["as"] {
+				cl := newClient("as", "devices")
+				registry := &asredis.DeviceRegistry{Redis: cl}
+				registries = append(registries, newRewrapDeviceRegistry("as:devices", cl, func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) error {
+					_, err := registry.Set(ctx, ids, rewrapEndDeviceGets, f)
+					return err
+				}, config.AS.DeviceKEKLabel, kv))
+			}
+			if components["js"] {
+				cl := newClient("js", "devices")
+				registry := &jsredis.DeviceRegistry{Redis: cl}
+				registries = append(registries, newRewrapDeviceRegistry("js:devices", cl, func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) error {
+					_, err := registry.SetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, rewrapEndDeviceGets, f)
+					return err
+				}, config.JS.DeviceKEKLabel, kv))
+				registries = append(registries, newRewrapKeyRegistry(newClient("js", "keys"), kv))
+			}
+
+			progress := newClient("key-vault")
+			progressKey := progress.Key("rewrap")
+			if !resume {
+				if err := progress.Del(progressKey).Err(); err != nil {
+					return ttnredis.ConvertError(err)
+				}
+			}
+			for _, registry := range registries {
+				if err := rewrapKeys(ctx, progress, progressKey, registry, resume, batchSize); err != nil {
+					return err
+				}
+			}
+			logger.Info("Successfully re-wrapped keys")
+			return nil
+		},
+	}
+)
+
+func init() {
+	keyVaultRewrapCommand.Flags().Bool("resume", false, "Resume re-wrapping where the previous run stopped")
+	keyVaultRewrapCommand.Flags().Int64("batch-size", 100, "Number of registry keys to scan at once")
+	keyVaultCommand.AddCommand(keyVaultRewrapCommand)
+	Root.AddCommand(keyVaultCommand)
+}
@@ -0,0 +1,148 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRewrapKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	var err error
+	logger, err = log.NewLogger(log.WithHandler(log.NoopHandler))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	cl, flush := test.NewRedis(t, "commands_test")
+	defer flush()
+	defer cl.Close()
+
+	const numKeys = 200
+	for i := 0; i < numKeys; i++ {
+		if err := cl.Set(cl.Key("uid", fmt.Sprintf("key-%d", i)), "value", 0).Err(); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	progressKey := cl.Key("progress")
+	ctx := test.Context()
+
+	// newRegistry returns a registry that records the rewrapped keys and the number of batches, and fails on the
+	// first key after the given number of batches if failAfter is positive.
+	newRegistry := func(rewrapped map[string]int, batches *int, failAfter int) rewrapRegistry {
+		cursors := make(map[string]bool)
+		failed := false
+		return rewrapRegistry{
+			name:  "test",
+			redis: cl,
+			match: cl.Key("uid", "*"),
+			rewrap: func(ctx context.Context, key string) (bool, error) {
+				cursor, err := cl.HGet(progressKey, "test").Result()
+				if err != nil && err != redis.Nil {
+					return false, err
+				}
+				if !cursors[cursor] {
+					cursors[cursor] = true
+					*batches++
+				}
+				if failAfter > 0 && *batches > failAfter && !failed {
+					failed = true
+					return false, errors.New("test failure")
+				}
+				rewrapped[key]++
+				return true, nil
+			},
+		}
+	}
+
+	// Batch size is passed to SCAN.
+	{
+		var smallBatches, largeBatches int
+		smallRewrapped, largeRewrapped := make(map[string]int), make(map[string]int)
+		if err := rewrapKeys(ctx, cl, progressKey, newRegistry(smallRewrapped, &smallBatches, 0), false, 10); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		if err := cl.Del(progressKey).Err(); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		if err := rewrapKeys(ctx, cl, progressKey, newRegistry(largeRewrapped, &largeBatches, 0), false, 10000); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(smallRewrapped, should.HaveLength, numKeys)
+		a.So(largeRewrapped, should.HaveLength, numKeys)
+		a.So(smallBatches, should.BeGreaterThan, 1)
+		a.So(smallBatches, should.BeGreaterThan, largeBatches)
+		a.So(cl.HGet(progressKey, "test").Val(), should.Equal, rewrapDone)
+	}
+
+	// Re-wrapping resumes from the stored cursor.
+	{
+		if err := cl.Del(progressKey).Err(); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		var batches int
+		rewrapped := make(map[string]int)
+		err := rewrapKeys(ctx, cl, progressKey, newRegistry(rewrapped, &batches, 2), false, 10)
+		if !a.So(errors.Resemble(err, errRewrap), should.BeTrue) {
+			t.FailNow()
+		}
+		cursor := cl.HGet(progressKey, "test").Val()
+		a.So(cursor, should.NotBeEmpty)
+		a.So(cursor, should.NotEqual, rewrapDone)
+		firstRun := len(rewrapped)
+		a.So(firstRun, should.BeLessThan, numKeys)
+
+		resumed := make(map[string]int)
+		batches = 0
+		if err := rewrapKeys(ctx, cl, progressKey, newRegistry(resumed, &batches, 0), true, 10); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(len(resumed), should.BeLessThan, numKeys)
+		for key := range resumed {
+			rewrapped[key]++
+		}
+		a.So(rewrapped, should.HaveLength, numKeys)
+		a.So(cl.HGet(progressKey, "test").Val(), should.Equal, rewrapDone)
+
+		// Registries that are done are skipped when resuming.
+		skipped := make(map[string]int)
+		batches = 0
+		if err := rewrapKeys(ctx, cl, progressKey, newRegistry(skipped, &batches, 0), true, 10); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(skipped, should.BeEmpty)
+	}
+
+	// An invalid stored cursor is an error.
+	{
+		if err := cl.HSet(progressKey, "test", "invalid").Err(); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		err := rewrapKeys(ctx, cl, progressKey, newRegistry(make(map[string]int), new(int), 0), true, 10)
+		a.So(errors.Resemble(err, errInvalidRewrapCursor), should.BeTrue)
+	}
+}
@@ -21,34 +21,50 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"go.etcd.io/bbolt"
 	"go.thethings.network/lorawan-stack/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver"
+	asbolt "go.thethings.network/lorawan-stack/pkg/applicationserver/bolt"
+	asioapbolt "go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/bolt"
 	asioapredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/redis"
+	asiopsbolt "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/bolt"
 	asiopsredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/redis"
+	asiowebbolt "go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/bolt"
 	asiowebredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/redis"
 	asredis "go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
+	ttnbolt "go.thethings.network/lorawan-stack/pkg/bolt"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/console"
+	"go.thethings.network/lorawan-stack/pkg/deviceclaimingserver"
+	dcsredis "go.thethings.network/lorawan-stack/pkg/deviceclaimingserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	events_grpc "go.thethings.network/lorawan-stack/pkg/events/grpc"
 	"go.thethings.network/lorawan-stack/pkg/gatewayconfigurationserver"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	gsredis "go.thethings.network/lorawan-stack/pkg/gatewayserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/pkg/joinserver"
+	jsbolt "go.thethings.network/lorawan-stack/pkg/joinserver/bolt"
 	jsredis "go.thethings.network/lorawan-stack/pkg/joinserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/networkserver"
+	nsbolt "go.thethings.network/lorawan-stack/pkg/networkserver/bolt"
 	nsredis "go.thethings.network/lorawan-stack/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/packetbrokeragent"
 	"go.thethings.network/lorawan-stack/pkg/qrcodegenerator"
 	"go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/web"
 )
 
-var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
+var (
+	errUnknownComponent            = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
+	errUnknownStorageBackend       = errors.DefineInvalidArgument("unknown_storage_backend", "unknown storage backend `{backend}`")
+	errUnknownDeduplicationBackend = errors.DefineInvalidArgument("unknown_deduplication_backend", "unknown deduplication backend `{backend}`")
+)
 
 var startCommand = &cobra.Command{
-	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|all]... [flags]",
+	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|dcs|pba|all]... [flags]",
 	Short: "Start The Things Stack",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var start struct {
@@ -61,6 +77,8 @@ var startCommand = &cobra.Command{
 			GatewayConfigurationServer bool
 			DeviceTemplateConverter    bool
 			QRCodeGenerator            bool
+			DeviceClaimingServer       bool
+			PacketBrokerAgent          bool
 		}
 		startDefault := len(args) == 0
 		for _, arg := range args {
@@ -91,6 +109,11 @@ var startCommand = &cobra.Command{
 				start.DeviceTemplateConverter = true
 			case "qrg":
 				start.QRCodeGenerator = true
+			case "dcs":
+				start.DeviceClaimingServer = true
+			case "pba":
+				// The Packet Broker Agent requires a Packet Broker Router and is not started by default.
+				start.PacketBrokerAgent = true
 			case "all":
 				start.IdentityServer = true
 				start.GatewayServer = true
@@ -101,6 +124,7 @@ var startCommand = &cobra.Command{
 				start.GatewayConfigurationServer = true
 				start.DeviceTemplateConverter = true
 				start.QRCodeGenerator = true
+				start.DeviceClaimingServer = true
 			default:
 				return errUnknownComponent.WithAttributes("component", arg)
 			}
@@ -126,6 +150,26 @@ var startCommand = &cobra.Command{
 		}
 		redisConsumerID := redis.Key(host, strconv.Itoa(os.Getpid()))
 
+		var boltDB *bbolt.DB
+		switch config.Storage.Backend {
+		case "redis":
+		case "bolt":
+			logger.WithField("path", config.Storage.Bolt.Path).Info("Opening embedded database")
+			boltDB, err = ttnbolt.Open(config.Storage.Bolt)
+			if err != nil {
+				return err
+			}
+			defer boltDB.Close()
+		default:
+			return errUnknownStorageBackend.WithAttributes("backend", config.Storage.Backend)
+		}
+		newBoltClient := func(namespace ...string) (*ttnbolt.Client, error) {
+			return ttnbolt.New(&ttnbolt.Config{
+				DB:        boltDB,
+				Namespace: namespace,
+			})
+		}
+
 		if start.IdentityServer || startDefault {
 			logger.Info("Setting up Identity Server")
 			is, err := identityserver.New(c, &config.IS)
@@ -145,6 +189,12 @@ var startCommand = &cobra.Command{
 
 		if start.GatewayServer || startDefault {
 			logger.Info("Setting up Gateway Server")
+			if boltDB == nil {
+				config.GS.Stats = &gsredis.GatewayConnectionStatsRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"gs", "connection-stats"},
+				})}
+			}
 			gs, err := gatewayserver.New(c, &config.GS)
 			if err != nil {
 				return shared.ErrInitializeGatewayServer.WithCause(err)
@@ -156,70 +206,158 @@ var startCommand = &cobra.Command{
 			redisConsumerGroup := "ns"
 
 			logger.Info("Setting up Network Server")
-			config.NS.ApplicationUplinks = nsredis.NewApplicationUplinkQueue(redis.New(&redis.Config{
-				Redis:     config.Redis,
-				Namespace: []string{"ns", "application-uplinks"},
-			}), 100, redisConsumerGroup, redisConsumerID)
-			config.NS.Devices = &nsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
-				Redis:     config.Redis,
-				Namespace: []string{"ns", "devices"},
-			})}
-			nsDownlinkTasks := nsredis.NewDownlinkTaskQueue(redis.New(&redis.Config{
-				Redis:     config.Redis,
-				Namespace: []string{"ns", "tasks"},
-			}), 100000, redisConsumerGroup, redisConsumerID)
-			if err := nsDownlinkTasks.Init(); err != nil {
-				return shared.ErrInitializeNetworkServer.WithCause(err)
+			var nsDownlinkTasks *nsredis.DownlinkTaskQueue
+			if boltDB != nil {
+				applicationUplinks, err := newBoltClient("ns", "application-uplinks")
+				if err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				devices, err := newBoltClient("ns", "devices")
+				if err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				tasks, err := newBoltClient("ns", "tasks")
+				if err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				config.NS.ApplicationUplinks = nsbolt.NewApplicationUplinkQueue(applicationUplinks)
+				config.NS.Devices = &nsbolt.DeviceRegistry{Bolt: devices}
+				config.NS.DownlinkTasks = nsbolt.NewDownlinkTaskQueue(tasks)
+			} else {
+				config.NS.ApplicationUplinks = nsredis.NewApplicationUplinkQueue(redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"ns", "application-uplinks"},
+				}), 100, redisConsumerGroup, redisConsumerID)
+				config.NS.Devices = &nsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"ns", "devices"},
+				})}
+				nsDownlinkTasks = nsredis.NewDownlinkTaskQueue(redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"ns", "tasks"},
+				}), 100000, redisConsumerGroup, redisConsumerID)
+				if err := nsDownlinkTasks.Init(); err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				config.NS.DownlinkTasks = nsDownlinkTasks
+			}
+			switch config.NS.DeduplicationBackend {
+			case "memory":
+			case "redis":
+				config.NS.UplinkDeduplicator = &nsredis.UplinkDeduplicator{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"ns", "uplink-deduplication"},
+				})}
+			default:
+				return errUnknownDeduplicationBackend.WithAttributes("backend", config.NS.DeduplicationBackend)
 			}
-			config.NS.DownlinkTasks = nsDownlinkTasks
 			ns, err := networkserver.New(c, &config.NS)
 			if err != nil {
 				return shared.ErrInitializeNetworkServer.WithCause(err)
 			}
-			ns.Component.RegisterTask(ns.Context(), "queue_downlink", nsDownlinkTasks.Run, component.TaskRestartOnFailure)
+			if nsDownlinkTasks != nil {
+				ns.Component.RegisterTask(ns.Context(), "queue_downlink", nsDownlinkTasks.Run, component.TaskRestartOnFailure)
+			}
 		}
 
 		if start.ApplicationServer || startDefault {
+			redisConsumerGroup := "as"
+
 			logger.Info("Setting up Application Server")
-			config.AS.Links = &asredis.LinkRegistry{Redis: redis.New(&redis.Config{
-				Redis:     config.Redis,
-				Namespace: []string{"as", "links"},
-			})}
-			config.AS.Devices = &asredis.DeviceRegistry{Redis: redis.New(&redis.Config{
-				Redis:     config.Redis,
-				Namespace: []string{"as", "devices"},
-			})}
-			config.AS.PubSub.Registry = &asiopsredis.PubSubRegistry{Redis: redis.New(&redis.Config{
-				Redis:     config.Redis,
-				Namespace: []string{"as", "io", "pubsub"},
-			})}
-			config.AS.ApplicationPackages.Registry = &asioapredis.ApplicationPackagesRegistry{Redis: redis.New(&redis.Config{
-				Redis:     config.Redis,
-				Namespace: []string{"as", "io", "applicationpackages"},
-			})}
-			if config.AS.Webhooks.Target != "" {
-				config.AS.Webhooks.Registry = &asiowebredis.WebhookRegistry{Redis: redis.New(&redis.Config{
+			var asWebhookDeliveries *asiowebredis.DeliveryQueue
+			if boltDB != nil {
+				links, err := newBoltClient("as", "links")
+				if err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				devices, err := newBoltClient("as", "devices")
+				if err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				pubsubs, err := newBoltClient("as", "io", "pubsub")
+				if err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				packages, err := newBoltClient("as", "io", "applicationpackages")
+				if err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				webhooks, err := newBoltClient("as", "io", "webhooks")
+				if err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.Links = &asbolt.LinkRegistry{Bolt: links}
+				config.AS.Devices = &asbolt.DeviceRegistry{Bolt: devices}
+				config.AS.PubSub.Registry = &asiopsbolt.PubSubRegistry{Bolt: pubsubs}
+				config.AS.ApplicationPackages.Registry = &asioapbolt.ApplicationPackagesRegistry{Bolt: packages}
+				if config.AS.Webhooks.Target != "" {
+					config.AS.Webhooks.Registry = &asiowebbolt.WebhookRegistry{Bolt: webhooks}
+				}
+			} else {
+				config.AS.Links = &asredis.LinkRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"as", "links"},
+				})}
+				config.AS.Devices = &asredis.DeviceRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"as", "devices"},
+				})}
+				config.AS.PubSub.Registry = &asiopsredis.PubSubRegistry{Redis: redis.New(&redis.Config{
 					Redis:     config.Redis,
-					Namespace: []string{"as", "io", "webhooks"},
+					Namespace: []string{"as", "io", "pubsub"},
 				})}
+				config.AS.ApplicationPackages.Registry = &asioapredis.ApplicationPackagesRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"as", "io", "applicationpackages"},
+				})}
+				if config.AS.Webhooks.Target != "" {
+					config.AS.Webhooks.Registry = &asiowebredis.WebhookRegistry{Redis: redis.New(&redis.Config{
+						Redis:     config.Redis,
+						Namespace: []string{"as", "io", "webhooks"},
+					})}
+					asWebhookDeliveries = asiowebredis.NewDeliveryQueue(redis.New(&redis.Config{
+						Redis:     config.Redis,
+						Namespace: []string{"as", "io", "webhooks", "tasks"},
+					}), 100000, redisConsumerGroup, redisConsumerID, int64(config.AS.Webhooks.Retry.KeepFailed))
+					asWebhookDeliveries.PendingTimeout = config.AS.Webhooks.Retry.PendingTimeout
+					if err := asWebhookDeliveries.Init(); err != nil {
+						return shared.ErrInitializeApplicationServer.WithCause(err)
+					}
+					config.AS.Webhooks.Deliveries = asWebhookDeliveries
+				}
 			}
 			as, err := applicationserver.New(c, &config.AS)
 			if err != nil {
 				return shared.ErrInitializeApplicationServer.WithCause(err)
 			}
-			_ = as
+			if asWebhookDeliveries != nil {
+				as.Component.RegisterTask(as.Context(), "queue_webhook_deliveries", asWebhookDeliveries.Run, component.TaskRestartOnFailure)
+			}
 		}
 
 		if start.JoinServer || startDefault {
 			logger.Info("Setting up Join Server")
-			config.JS.Devices = &jsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
-				Redis:     config.Redis,
-				Namespace: []string{"js", "devices"},
-			})}
-			config.JS.Keys = &jsredis.KeyRegistry{Redis: redis.New(&redis.Config{
-				Redis:     config.Redis,
-				Namespace: []string{"js", "keys"},
-			})}
+			if boltDB != nil {
+				devices, err := newBoltClient("js", "devices")
+				if err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				keys, err := newBoltClient("js", "keys")
+				if err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.Devices = &jsbolt.DeviceRegistry{Bolt: devices}
+				config.JS.Keys = &jsbolt.KeyRegistry{Bolt: keys}
+			} else {
+				config.JS.Devices = &jsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"js", "devices"},
+				})}
+				config.JS.Keys = &jsredis.KeyRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"js", "keys"},
+				})}
+			}
 			js, err := joinserver.New(c, &config.JS)
 			if err != nil {
 				return shared.ErrInitializeJoinServer.WithCause(err)
@@ -266,6 +404,28 @@ var startCommand = &cobra.Command{
 			_ = qrg
 		}
 
+		if start.DeviceClaimingServer || startDefault {
+			logger.Info("Setting up Device Claiming Server")
+			config.DCS.AuthorizedApplications = &dcsredis.AuthorizedApplicationRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"dcs", "applications"},
+			})}
+			dcs, err := deviceclaimingserver.New(c, &config.DCS)
+			if err != nil {
+				return shared.ErrInitializeDeviceClaimingServer.WithCause(err)
+			}
+			_ = dcs
+		}
+
+		if start.PacketBrokerAgent {
+			logger.Info("Setting up Packet Broker Agent")
+			pba, err := packetbrokeragent.New(c, &config.PBA)
+			if err != nil {
+				return shared.ErrInitializePacketBrokerAgent.WithCause(err)
+			}
+			_ = pba
+		}
+
 		if rootRedirect != nil {
 			c.RegisterWeb(rootRedirect)
 		}
@@ -40,6 +40,7 @@ require (
 	github.com/Azure/go-autorest/autorest/to v0.3.0 // indirect
 	github.com/Azure/go-autorest/autorest/validation v0.2.0 // indirect
 	github.com/PuerkitoBio/purell v1.1.1
+	github.com/Shopify/sarama v1.25.0
 	github.com/TheThingsIndustries/magepkg v0.0.0-20190214092847-6c0299b7c3ed
 	github.com/TheThingsIndustries/mystique v0.0.0-20190516134627-66efd81c68ea
 	github.com/TheThingsNetwork/go-cayenne-lib v1.0.0
@@ -96,6 +97,7 @@ require (
 	github.com/mattn/goveralls v0.0.4
 	github.com/mdempsky/unconvert v0.0.0-20190921185256-3ecd357795af
 	github.com/mgechev/revive v1.0.1
+	github.com/miekg/pkcs11 v1.0.3
 	github.com/mitchellh/mapstructure v1.1.2
 	github.com/mohae/deepcopy v0.0.0-20170929034955-c48cc78d4826
 	github.com/muesli/smartcrop v0.3.0 // indirect
@@ -121,8 +123,12 @@ require (
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.6.1
 	github.com/ssor/bom v0.0.0-20170718123548-6386211fdfcf // indirect
+	github.com/streadway/amqp v0.0.0-20200108173154-1c71cc93ed71
 	github.com/valyala/fasttemplate v1.1.0 // indirect
+	github.com/xdg/scram v0.0.0-20180814205039-7eeb5667e42c
+	github.com/xdg/stringprep v1.0.0 // indirect
 	github.com/yuin/goldmark v1.1.20 // indirect
+	go.etcd.io/bbolt v1.3.3
 	go.opencensus.io v0.22.2
 	go.thethings.network/lorawan-stack-legacy v0.0.0-20190118141410-68812c833a78
 	gocloud.dev v0.18.0
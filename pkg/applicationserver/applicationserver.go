@@ -30,10 +30,15 @@ import (
 	iogrpc "go.thethings.network/lorawan-stack/pkg/applicationserver/io/grpc"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages"
-	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/loradms/v1" // The LoRa Cloud Device Management v1 package implementation
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/alcsync/v1"        // The LoRaWAN Application Layer Clock Synchronization v1 package implementation
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/fragmentation/v1"  // The LoRaWAN Fragmented Data Block Transport v1 package implementation
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/loradms/v1"        // The LoRa Cloud Device Management v1 package implementation
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/multicastsetup/v1" // The LoRaWAN Remote Multicast Setup v1 package implementation
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub"
-	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/mqtt" // The MQTT integration provider
-	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/nats" // The NATS integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/amqp"  // The AMQP integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/kafka" // The Kafka integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/mqtt"  // The MQTT integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/nats"  // The NATS integration provider
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/component"
@@ -196,17 +201,26 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 						)
 					}
 					defer lis.Close()
-					return mqtt.Serve(ctx, retryIO, lis, version.Format, endpoint.Protocol())
+					return mqtt.Serve(ctx, retryIO, lis, version.Format, endpoint.Protocol(), mqtt.WithMaximumPacketSize(version.Config.MaximumPacketSize))
 				}, component.TaskRestartOnFailure)
 		}
 	}
 
-	if webhooks, err := conf.Webhooks.NewWebhooks(ctx, as); err != nil {
+	if webhooks, err := conf.Webhooks.NewWebhooks(ctx, as, as.KeyVault); err != nil {
 		return nil, err
 	} else if webhooks != nil {
 		as.webhooks = webhooks
 		as.defaultSubscribers = append(as.defaultSubscribers, webhooks.NewSubscription())
 		c.RegisterWeb(webhooks)
+		if webhooks.Deliveries() != nil {
+			workers := conf.Webhooks.Workers
+			if workers < 1 {
+				workers = 1
+			}
+			for i := 0; i < workers; i++ {
+				c.RegisterTask(as.Context(), fmt.Sprintf("deliver_webhooks/%d", i), webhooks.Deliver, component.TaskRestartOnFailure)
+			}
+		}
 	}
 
 	if as.webhookTemplates, err = conf.Webhooks.Templates.NewTemplateStore(); err != nil {
@@ -237,7 +251,10 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsEndDeviceRegistryServer(s, as.grpc.asDevices)
 	ttnpb.RegisterAppAsServer(s, as.grpc.appAs)
 	if as.webhooks != nil {
-		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhookTemplates))
+		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhookTemplates, as.webhooks.Deliveries(),
+			web.WithClientCertificateKEK(as.KeyVault, as.config.Webhooks.KEKLabel),
+			web.WithEvict(as.webhooks.Evict),
+		))
 	}
 	if as.pubsub != nil {
 		ttnpb.RegisterApplicationPubSubRegistryServer(s, as.pubsub)
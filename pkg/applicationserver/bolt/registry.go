@@ -0,0 +1,367 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides bbolt implementations of interfaces used by applicationserver.
+package bolt
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"go.etcd.io/bbolt"
+	ttnbolt "go.thethings.network/lorawan-stack/pkg/bolt"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	errInvalidFieldmask     = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers   = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errDuplicateIdentifiers = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
+	errReadOnlyField        = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+)
+
+// DeviceRegistry is a bbolt device registry.
+type DeviceRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+func (r *DeviceRegistry) uidKey(uid string) []byte {
+	return r.Bolt.Key("uid", uid)
+}
+
+func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) []byte {
+	return r.Bolt.Key("eui", joinEUI.String(), devEUI.String())
+}
+
+// Get returns the end device by its identifiers.
+func (r *DeviceRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device").End()
+
+	pb := &ttnpb.EndDevice{}
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.GetProto(b, r.uidKey(unique.ID(ctx, ids)), pb)
+	}); err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(pb, paths...)
+}
+
+func equalEUI64(x, y *types.EUI64) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Equal(*y)
+}
+
+// Set creates, updates or deletes the end device by its identifiers.
+func (r *DeviceRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, ids)
+	uk := r.uidKey(uid)
+
+	defer trace.StartRegion(ctx, "set end device").End()
+
+	var pb *ttnpb.EndDevice
+	err := r.Bolt.Watch(func(tx *ttnbolt.WatchTx) (func(*bbolt.Bucket) error, error) {
+		v, err := tx.Get(uk)
+		if err != nil {
+			return nil, err
+		}
+		var stored *ttnpb.EndDevice
+		if v != nil {
+			stored = &ttnpb.EndDevice{}
+			if err := ttnbolt.UnmarshalProto(v, stored); err != nil {
+				return nil, err
+			}
+			pb = &ttnpb.EndDevice{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return nil, err
+			}
+			pb, err = ttnpb.FilterGetEndDevice(pb, gets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return nil, err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+
+		if stored == nil && pb == nil {
+			return nil, nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			return nil, err
+		}
+
+		if pb == nil && len(sets) == 0 {
+			return func(b *bbolt.Bucket) error {
+				if err := b.Delete(uk); err != nil {
+					return err
+				}
+				if stored.JoinEUI != nil && stored.DevEUI != nil {
+					return b.Delete(r.euiKey(*stored.JoinEUI, *stored.DevEUI))
+				}
+				return nil
+			}, nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.EndDevice{}
+		}
+
+		if pb.ApplicationIdentifiers != ids.ApplicationIdentifiers || pb.DeviceID != ids.DeviceID {
+			return nil, errInvalidIdentifiers
+		}
+
+		pb.UpdatedAt = time.Now().UTC()
+		sets = append(append(sets[:0:0], sets...),
+			"updated_at",
+		)
+
+		updated := &ttnpb.EndDevice{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.device_id",
+			); err != nil {
+				return nil, errInvalidFieldmask.WithCause(err)
+			}
+
+			pb.CreatedAt = pb.UpdatedAt
+			sets = append(sets, "created_at")
+
+			updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+			if updated.ApplicationIdentifiers != ids.ApplicationIdentifiers || updated.DeviceID != ids.DeviceID {
+				return nil, errInvalidIdentifiers
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.device_id") && pb.DeviceID != stored.DeviceID {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.device_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.join_eui") && !equalEUI64(pb.JoinEUI, stored.JoinEUI) {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.join_eui")
+			}
+			if ttnpb.HasAnyField(sets, "ids.dev_eui") && !equalEUI64(pb.DevEUI, stored.DevEUI) {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+			}
+			if err := ttnbolt.UnmarshalProto(v, updated); err != nil {
+				return nil, err
+			}
+			updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := updated.ValidateFields(sets...); err != nil {
+			return nil, err
+		}
+		pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(b *bbolt.Bucket) error {
+			if stored == nil && updated.JoinEUI != nil && updated.DevEUI != nil {
+				ek := r.euiKey(*updated.JoinEUI, *updated.DevEUI)
+				if b.Get(ek) != nil {
+					return errDuplicateIdentifiers
+				}
+				if err := b.Put(ek, []byte(uid)); err != nil {
+					return err
+				}
+			}
+			return ttnbolt.SetProto(b, uk, updated)
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+func applyLinkFieldMask(dst, src *ttnpb.ApplicationLink, paths ...string) (*ttnpb.ApplicationLink, error) {
+	if dst == nil {
+		dst = &ttnpb.ApplicationLink{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// LinkRegistry is a store for application links.
+type LinkRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+func (r *LinkRegistry) appPrefix() []byte {
+	return r.Bolt.Key("uid", "")
+}
+
+func (r *LinkRegistry) appKey(uid string) []byte {
+	return r.Bolt.Key("uid", uid)
+}
+
+// Get returns the link by the application identifiers.
+func (r *LinkRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) (*ttnpb.ApplicationLink, error) {
+	defer trace.StartRegion(ctx, "get link").End()
+
+	pb := &ttnpb.ApplicationLink{}
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.GetProto(b, r.appKey(unique.ID(ctx, ids)), pb)
+	}); err != nil {
+		return nil, err
+	}
+	return applyLinkFieldMask(nil, pb, paths...)
+}
+
+var errApplicationUID = errors.DefineCorruption("application_uid", "invalid application UID `{application_uid}`")
+
+// Range ranges the links and calls the callback function, until false is returned.
+func (r *LinkRegistry) Range(ctx context.Context, paths []string, f func(context.Context, ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationLink) bool) error {
+	defer trace.StartRegion(ctx, "range links").End()
+
+	var uids []string
+	var pbs []*ttnpb.ApplicationLink
+	prefix := r.appPrefix()
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.ForEachPrefix(b, prefix, func(k, v []byte) (bool, error) {
+			pb := &ttnpb.ApplicationLink{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return false, err
+			}
+			uids = append(uids, string(k[len(prefix):]))
+			pbs = append(pbs, pb)
+			return true, nil
+		})
+	}); err != nil {
+		return err
+	}
+	for i, uid := range uids {
+		ctx, err := unique.WithContext(ctx, uid)
+		if err != nil {
+			return errApplicationUID.WithCause(err).WithAttributes("application_uid", uid)
+		}
+		ids, err := unique.ToApplicationID(uid)
+		if err != nil {
+			return errApplicationUID.WithCause(err).WithAttributes("application_uid", uid)
+		}
+		pb, err := applyLinkFieldMask(nil, pbs[i], paths...)
+		if err != nil {
+			return err
+		}
+		if !f(ctx, ids, pb) {
+			break
+		}
+	}
+	return nil
+}
+
+// Set creates, updates or deletes the link by the application identifiers.
+func (r *LinkRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, gets []string, f func(*ttnpb.ApplicationLink) (*ttnpb.ApplicationLink, []string, error)) (*ttnpb.ApplicationLink, error) {
+	defer trace.StartRegion(ctx, "set link").End()
+
+	uk := r.appKey(unique.ID(ctx, ids))
+
+	var pb *ttnpb.ApplicationLink
+	err := r.Bolt.Watch(func(tx *ttnbolt.WatchTx) (func(*bbolt.Bucket) error, error) {
+		v, err := tx.Get(uk)
+		if err != nil {
+			return nil, err
+		}
+		var stored *ttnpb.ApplicationLink
+		if v != nil {
+			stored = &ttnpb.ApplicationLink{}
+			if err := ttnbolt.UnmarshalProto(v, stored); err != nil {
+				return nil, err
+			}
+			pb, err = applyLinkFieldMask(nil, stored, gets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return nil, err
+		}
+		if stored == nil && pb == nil {
+			return nil, nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyLinkFieldMask(nil, stored, gets...)
+			return nil, err
+		}
+
+		if pb == nil && len(sets) == 0 {
+			return func(b *bbolt.Bucket) error {
+				return b.Delete(uk)
+			}, nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.ApplicationLink{}
+		}
+
+		updated := &ttnpb.ApplicationLink{}
+		if stored != nil {
+			if err := ttnbolt.UnmarshalProto(v, updated); err != nil {
+				return nil, err
+			}
+		}
+		updated, err = applyLinkFieldMask(updated, pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+		if err := updated.ValidateFields(sets...); err != nil {
+			return nil, err
+		}
+		pb, err = applyLinkFieldMask(nil, updated, gets...)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(b *bbolt.Bucket) error {
+			return ttnbolt.SetProto(b, uk, updated)
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -85,13 +86,16 @@ var (
 
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
-	Registry  web.WebhookRegistry `name:"-"`
-	Target    string              `name:"target" description:"Target of the integration (direct)"`
-	Timeout   time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
-	QueueSize int                 `name:"queue-size" description:"Number of requests to queue"`
-	Workers   int                 `name:"workers" description:"Number of workers to process requests"`
-	Templates web.TemplatesConfig `name:"templates" description:"The store of the webhook templates"`
-	Downlinks web.DownlinksConfig `name:"downlink" description:"The downlink queue operations configuration"`
+	Registry   web.WebhookRegistry `name:"-"`
+	Deliveries web.DeliveryQueue   `name:"-"`
+	Target     string              `name:"target" description:"Target of the integration (direct)"`
+	Timeout    time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
+	QueueSize  int                 `name:"queue-size" description:"Number of requests to queue"`
+	Workers    int                 `name:"workers" description:"Number of workers to process requests"`
+	Retry      web.RetryConfig     `name:"retry" description:"Retry configuration of queued deliveries"`
+	KEKLabel   string              `name:"kek-label" description:"Label of KEK used to encrypt private keys of webhook client certificates at rest"`
+	Templates  web.TemplatesConfig `name:"templates" description:"The store of the webhook templates"`
+	Downlinks  web.DownlinksConfig `name:"downlink" description:"The downlink queue operations configuration"`
 }
 
 // PubSubConfig contains go-cloud PubSub configuration of the Application Server.
@@ -106,7 +110,9 @@ type ApplicationPackagesConfig struct {
 
 // NewWebhooks returns a new web.Webhooks based on the configuration.
 // If Target is empty, this method returns nil.
-func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.Webhooks, error) {
+// If Deliveries is set, messages are queued for delivery and the deliveries are processed by web.Webhooks.Deliver.
+// The key vault is used to decrypt the private keys of webhook client certificates.
+func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server, keyVault crypto.KeyVault) (web.Webhooks, error) {
 	var target web.Sink
 	switch c.Target {
 	case "":
@@ -123,6 +129,12 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 	if c.Registry == nil {
 		return nil, errWebhooksRegistry
 	}
+	if c.Deliveries != nil {
+		return web.NewWebhooks(ctx, server, c.Registry, target, c.Downlinks,
+			web.WithDeliveryQueue(c.Deliveries, c.Retry),
+			web.WithKeyVault(keyVault),
+		), nil
+	}
 	if c.QueueSize > 0 || c.Workers > 0 {
 		target = &web.QueuedSink{
 			Target:  target,
@@ -137,7 +149,7 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 			}
 		}()
 	}
-	return web.NewWebhooks(ctx, server, c.Registry, target, c.Downlinks), nil
+	return web.NewWebhooks(ctx, server, c.Registry, target, c.Downlinks, web.WithKeyVault(keyVault)), nil
 }
 
 // NewPubSub returns a new pubsub.PubSub based on the configuration.
@@ -33,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"google.golang.org/grpc/metadata"
@@ -41,21 +42,43 @@ import (
 const qosUpstream byte = 0
 
 type srv struct {
-	ctx    context.Context
-	server io.Server
-	format Format
-	lis    mqttnet.Listener
+	ctx           context.Context
+	server        io.Server
+	format        Format
+	lis           mqttnet.Listener
+	maxPacketSize uint32
+}
+
+// Option configures the MQTT frontend.
+type Option func(*srv)
+
+// WithMaximumPacketSize sets the maximum size of MQTT 5 packets that clients may send.
+// If size is zero, mqtt.DefaultMaximumPacketSize is used.
+func WithMaximumPacketSize(size uint32) Option {
+	return func(s *srv) {
+		s.maxPacketSize = size
+	}
 }
 
 // Serve serves the MQTT frontend.
-func Serve(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string) error {
+func Serve(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string, opts ...Option) error {
 	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/mqtt")
 	ctx = mqttlog.NewContext(ctx, mqtt.Logger(log.FromContext(ctx)))
-	s := &srv{ctx, server, format, mqttnet.NewListener(listener, protocol)}
+	v3, v5 := mqtt.SplitListener(ctx, listener)
+	s := &srv{
+		ctx:    ctx,
+		server: server,
+		format: format,
+		lis:    mqttnet.NewListener(v3, protocol),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	go func() {
 		<-ctx.Done()
 		s.lis.Close()
 	}()
+	go s.acceptV5(v5)
 	return s.accept()
 }
 
@@ -71,6 +94,7 @@ func (s *srv) accept() error {
 
 		go func() {
 			ctx := log.NewContextWithFields(s.ctx, log.Fields("remote_addr", mqttConn.RemoteAddr().String()))
+			ctx = rpcmetadata.NewContextWithRemoteAddress(ctx, mqttConn.RemoteAddr().String())
 			conn := &connection{server: s.server, mqtt: mqttConn, format: s.format}
 			if err := conn.setup(ctx); err != nil {
 				log.FromContext(ctx).WithError(err).Warn("Failed to setup connection")
@@ -133,25 +157,7 @@ func (c *connection) setup(ctx context.Context) error {
 				return
 			case up := <-c.io.Up():
 				logger := logger.WithField("device_uid", unique.ID(up.Context, up.EndDeviceIdentifiers))
-				var topicParts []string
-				switch up.Up.(type) {
-				case *ttnpb.ApplicationUp_UplinkMessage:
-					topicParts = c.format.UplinkTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_JoinAccept:
-					topicParts = c.format.JoinAcceptTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkAck:
-					topicParts = c.format.DownlinkAckTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkNack:
-					topicParts = c.format.DownlinkNackTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkSent:
-					topicParts = c.format.DownlinkSentTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkFailed:
-					topicParts = c.format.DownlinkFailedTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkQueued:
-					topicParts = c.format.DownlinkQueuedTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_LocationSolved:
-					topicParts = c.format.LocationSolvedTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				}
+				topicParts := c.upstreamTopic(up)
 				if topicParts == nil {
 					continue
 				}
@@ -216,23 +222,77 @@ func (c *connection) setup(ctx context.Context) error {
 	return nil
 }
 
+// upstreamTopic returns the topic of the upstream message, or nil if the message is not published.
+func (c *connection) upstreamTopic(up *io.ContextualApplicationUp) []string {
+	appUID := unique.ID(up.Context, c.io.ApplicationIDs())
+	switch up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return c.format.UplinkTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return c.format.JoinAcceptTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return c.format.DownlinkAckTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return c.format.DownlinkNackTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return c.format.DownlinkSentTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return c.format.DownlinkFailedTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return c.format.DownlinkQueuedTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return c.format.LocationSolvedTopic(appUID, up.DeviceID)
+	default:
+		return nil
+	}
+}
+
 type topicAccess struct {
 	appUID string
 	reads  [][]string
 	writes [][]string
 }
 
+func (a topicAccess) canRead(topicParts []string) bool {
+	for _, reads := range a.reads {
+		if topic.MatchPath(topicParts, reads) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a topicAccess) canWrite(topicParts []string) bool {
+	for _, writes := range a.writes {
+		if topic.MatchPath(topicParts, writes) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Context, error) {
+	ctx, access, err := c.connect(ctx, info.Username, string(info.Password))
+	if err != nil {
+		return nil, err
+	}
+	info.Metadata = access
+	info.Interface = c
+	return ctx, nil
+}
+
+// connect authenticates the client and subscribes to the application traffic.
+func (c *connection) connect(ctx context.Context, username, password string) (context.Context, topicAccess, error) {
 	ids := ttnpb.ApplicationIdentifiers{
-		ApplicationID: info.Username,
+		ApplicationID: username,
 	}
 	if err := ids.ValidateContext(ctx); err != nil {
-		return nil, err
+		return nil, topicAccess{}, err
 	}
 
 	md := metadata.New(map[string]string{
 		"id":            ids.ApplicationID,
-		"authorization": fmt.Sprintf("Bearer %s", info.Password),
+		"authorization": fmt.Sprintf("Bearer %s", password),
 	})
 	if ctxMd, ok := metadata.FromIncomingContext(ctx); ok {
 		md = metadata.Join(ctxMd, md)
@@ -246,7 +306,7 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 	var err error
 	c.io, err = c.server.Subscribe(ctx, "mqtt", ids)
 	if err != nil {
-		return nil, err
+		return nil, topicAccess{}, err
 	}
 	ctx = c.io.Context()
 	access := topicAccess{
@@ -270,15 +330,16 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 			c.format.DownlinkReplaceTopic(uid, topic.PartWildcard),
 		)
 	}
-	info.Metadata = access
-	info.Interface = c
-	return ctx, nil
+	return ctx, access, nil
 }
 
 var errNotAuthorized = errors.DefinePermissionDenied("not_authorized", "not authorized")
 
 func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
-	access := info.Metadata.(topicAccess)
+	return c.subscribe(info.Metadata.(topicAccess), requestedTopic, requestedQoS)
+}
+
+func (c *connection) subscribe(access topicAccess, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
 	accepted, ok := c.format.AcceptedTopic(access.appUID, topic.Split(requestedTopic))
 	if !ok {
 		return "", 0, errNotAuthorized
@@ -289,45 +350,42 @@ func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requested
 }
 
 func (c *connection) CanRead(info *auth.Info, topicParts ...string) bool {
-	access := info.Metadata.(topicAccess)
-	for _, reads := range access.reads {
-		if topic.MatchPath(topicParts, reads) {
-			return true
-		}
-	}
-	return false
+	return info.Metadata.(topicAccess).canRead(topicParts)
 }
 
 func (c *connection) CanWrite(info *auth.Info, topicParts ...string) bool {
-	access := info.Metadata.(topicAccess)
-	for _, writes := range access.writes {
-		if topic.MatchPath(topicParts, writes) {
-			return true
-		}
-	}
-	return false
+	return info.Metadata.(topicAccess).canWrite(topicParts)
 }
 
 func (c *connection) deliver(pkt *packet.PublishPacket) {
-	logger := log.FromContext(c.io.Context()).WithField("topic", pkt.TopicName)
+	c.handleDownlinks(pkt.TopicParts, pkt.Message, nil)
+}
+
+// handleDownlinks handles the downlink messages published by the client.
+// The correlation IDs are added to the downlink messages.
+func (c *connection) handleDownlinks(topicParts []string, payload []byte, correlationIDs []string) {
+	logger := log.FromContext(c.io.Context()).WithField("topic", topic.Join(topicParts))
 	var deviceID string
 	var op func(io.Server, context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error
 	switch {
-	case c.format.IsDownlinkPushTopic(pkt.TopicParts):
-		deviceID = c.format.ParseDownlinkPushTopic(pkt.TopicParts)
+	case c.format.IsDownlinkPushTopic(topicParts):
+		deviceID = c.format.ParseDownlinkPushTopic(topicParts)
 		op = io.Server.DownlinkQueuePush
-	case c.format.IsDownlinkReplaceTopic(pkt.TopicParts):
-		deviceID = c.format.ParseDownlinkReplaceTopic(pkt.TopicParts)
+	case c.format.IsDownlinkReplaceTopic(topicParts):
+		deviceID = c.format.ParseDownlinkReplaceTopic(topicParts)
 		op = io.Server.DownlinkQueueReplace
 	default:
 		logger.Error("Invalid topic path")
 		return
 	}
-	items, err := c.format.ToDownlinks(pkt.Message)
+	items, err := c.format.ToDownlinks(payload)
 	if err != nil {
 		logger.WithError(err).Warn("Failed to decode downlink messages")
 		return
 	}
+	for _, item := range items.Downlinks {
+		item.CorrelationIDs = append(item.CorrelationIDs, correlationIDs...)
+	}
 	ids := ttnpb.EndDeviceIdentifiers{
 		ApplicationIdentifiers: *c.io.ApplicationIDs(),
 		DeviceID:               deviceID,
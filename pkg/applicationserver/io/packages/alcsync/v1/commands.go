@@ -0,0 +1,135 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+const (
+	// packageIdentifier is the identifier of the Application Layer Clock Synchronization package.
+	packageIdentifier = 1
+	// packageVersion is the version of the Application Layer Clock Synchronization package.
+	packageVersion = 1
+
+	packageVersionCID           = 0x00
+	appTimeCID                  = 0x01
+	deviceAppTimePeriodicityCID = 0x02
+	forceDeviceResyncCID        = 0x03
+)
+
+var (
+	errUnknownCommand = errors.DefineInvalidArgument("unknown_command", "unknown command with CID `{cid}`")
+	errCommandLength  = errors.DefineInvalidArgument("command_length", "command with CID `{cid}` needs {expected} bytes, got {actual}")
+)
+
+// appTimeReq is the AppTimeReq uplink command.
+type appTimeReq struct {
+	// DeviceTime is the device time in seconds since GPS epoch, modulo 2^32.
+	DeviceTime  uint32
+	TokenReq    uint8
+	AnsRequired bool
+}
+
+// appTimeAns is the AppTimeAns downlink command.
+type appTimeAns struct {
+	// TimeCorrection is the correction in seconds the device should apply to its clock.
+	TimeCorrection int32
+	TokenAns       uint8
+}
+
+// deviceAppTimePeriodicityAns is the DeviceAppTimePeriodicityAns uplink command.
+type deviceAppTimePeriodicityAns struct {
+	NotSupported bool
+	// DeviceTime is the device time in seconds since GPS epoch, modulo 2^32.
+	DeviceTime uint32
+}
+
+// command is a decoded uplink command. Exactly one of the fields is set.
+type command struct {
+	PackageVersionReq           bool
+	AppTimeReq                  *appTimeReq
+	DeviceAppTimePeriodicityAns *deviceAppTimePeriodicityAns
+}
+
+func checkLength(cid byte, b []byte, n int) error {
+	if len(b) < n {
+		return errCommandLength.WithAttributes(
+			"cid", cid,
+			"expected", n,
+			"actual", len(b),
+		)
+	}
+	return nil
+}
+
+// decodeCommands decodes the uplink commands in b.
+func decodeCommands(b []byte) ([]command, error) {
+	var cmds []command
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		switch cid {
+		case packageVersionCID:
+			cmds = append(cmds, command{PackageVersionReq: true})
+
+		case appTimeCID:
+			if err := checkLength(cid, b, 5); err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, command{AppTimeReq: &appTimeReq{
+				DeviceTime:  binary.LittleEndian.Uint32(b[0:4]),
+				TokenReq:    b[4] & 0xf,
+				AnsRequired: b[4]&0x10 != 0,
+			}})
+			b = b[5:]
+
+		case deviceAppTimePeriodicityCID:
+			if err := checkLength(cid, b, 5); err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, command{DeviceAppTimePeriodicityAns: &deviceAppTimePeriodicityAns{
+				NotSupported: b[0]&0x1 != 0,
+				DeviceTime:   binary.LittleEndian.Uint32(b[1:5]),
+			}})
+			b = b[5:]
+
+		default:
+			return nil, errUnknownCommand.WithAttributes("cid", cid)
+		}
+	}
+	return cmds, nil
+}
+
+// appendPackageVersionAns appends the PackageVersionAns downlink command to b.
+func appendPackageVersionAns(b []byte) []byte {
+	return append(b, packageVersionCID, packageIdentifier, packageVersion)
+}
+
+// appendAppTimeAns appends the AppTimeAns downlink command to b.
+func appendAppTimeAns(b []byte, ans appTimeAns) []byte {
+	var correction [4]byte
+	binary.LittleEndian.PutUint32(correction[:], uint32(ans.TimeCorrection))
+	b = append(b, appTimeCID)
+	b = append(b, correction[:]...)
+	return append(b, ans.TokenAns&0xf)
+}
+
+// appendForceDeviceResyncReq appends the ForceDeviceResyncReq downlink command to b.
+func appendForceDeviceResyncReq(b []byte, nbTransmissions uint8) []byte {
+	return append(b, forceDeviceResyncCID, nbTransmissions&0x7)
+}
@@ -0,0 +1,141 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// defaultThreshold is the default minimum time correction for which AppTimeReq is answered if no answer is required.
+const defaultThreshold = 4 * time.Second
+
+// packageData is the data of a package association.
+// The threshold and the number of resync transmissions are configured by the user,
+// while the remaining fields track the clock drift state of the end device.
+type packageData struct {
+	threshold                time.Duration
+	forceResyncTransmissions uint8
+
+	lastSynchronizedAt time.Time
+	lastTimeCorrection time.Duration
+	// clockDrift is the drift of the end device clock relative to the server clock, in parts per million.
+	clockDrift float64
+}
+
+const (
+	thresholdField                = "threshold"
+	forceResyncTransmissionsField = "force_resync_transmissions"
+	lastSynchronizedAtField       = "last_synchronized_at"
+	lastTimeCorrectionField       = "last_time_correction"
+	clockDriftField               = "clock_drift"
+)
+
+func numberValue(v float64) *types.Value {
+	return &types.Value{
+		Kind: &types.Value_NumberValue{
+			NumberValue: v,
+		},
+	}
+}
+
+func (d packageData) toStruct() *types.Struct {
+	var st types.Struct
+	st.Fields = make(map[string]*types.Value)
+	st.Fields[thresholdField] = numberValue(d.threshold.Seconds())
+	st.Fields[forceResyncTransmissionsField] = numberValue(float64(d.forceResyncTransmissions))
+	if !d.lastSynchronizedAt.IsZero() {
+		st.Fields[lastSynchronizedAtField] = &types.Value{
+			Kind: &types.Value_StringValue{
+				StringValue: d.lastSynchronizedAt.UTC().Format(time.RFC3339Nano),
+			},
+		}
+		st.Fields[lastTimeCorrectionField] = numberValue(d.lastTimeCorrection.Seconds())
+		st.Fields[clockDriftField] = numberValue(d.clockDrift)
+	}
+	return &st
+}
+
+var (
+	errInvalidFieldType  = errors.DefineCorruption("invalid_field_type", "field `{field}` has the wrong type `{type}`")
+	errInvalidFieldValue = errors.DefineInvalidArgument("invalid_field_value", "field `{field}` has the invalid value `{value}`")
+)
+
+func numberField(fields map[string]*types.Value, name string) (float64, bool, error) {
+	value, ok := fields[name]
+	if !ok {
+		return 0, false, nil
+	}
+	numberValue, ok := value.GetKind().(*types.Value_NumberValue)
+	if !ok {
+		return 0, false, errInvalidFieldType.WithAttributes(
+			"field", name,
+			"type", fmt.Sprintf("%T", value.GetKind()),
+		)
+	}
+	return numberValue.NumberValue, true, nil
+}
+
+func (d *packageData) fromStruct(st *types.Struct) error {
+	fields := st.GetFields()
+
+	*d = packageData{
+		threshold: defaultThreshold,
+	}
+	if v, ok, err := numberField(fields, thresholdField); err != nil {
+		return err
+	} else if ok {
+		if v < 0 {
+			return errInvalidFieldValue.WithAttributes("field", thresholdField, "value", v)
+		}
+		d.threshold = time.Duration(v * float64(time.Second))
+	}
+	if v, ok, err := numberField(fields, forceResyncTransmissionsField); err != nil {
+		return err
+	} else if ok {
+		if v < 0 || v > 7 {
+			return errInvalidFieldValue.WithAttributes("field", forceResyncTransmissionsField, "value", v)
+		}
+		d.forceResyncTransmissions = uint8(v)
+	}
+	if value, ok := fields[lastSynchronizedAtField]; ok {
+		stringValue, ok := value.GetKind().(*types.Value_StringValue)
+		if !ok {
+			return errInvalidFieldType.WithAttributes(
+				"field", lastSynchronizedAtField,
+				"type", fmt.Sprintf("%T", value.GetKind()),
+			)
+		}
+		t, err := time.Parse(time.RFC3339Nano, stringValue.StringValue)
+		if err != nil {
+			return errInvalidFieldValue.WithCause(err).WithAttributes("field", lastSynchronizedAtField, "value", stringValue.StringValue)
+		}
+		d.lastSynchronizedAt = t
+	}
+	if v, ok, err := numberField(fields, lastTimeCorrectionField); err != nil {
+		return err
+	} else if ok {
+		d.lastTimeCorrection = time.Duration(v * float64(time.Second))
+	}
+	if v, ok, err := numberField(fields, clockDriftField); err != nil {
+		return err
+	} else if ok {
+		d.clockDrift = v
+	}
+	return nil
+}
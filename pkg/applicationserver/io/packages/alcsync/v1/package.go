@@ -0,0 +1,182 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package alcsyncv1 implements the LoRaWAN Application Layer Clock Synchronization v1.0.0 (TS003) application package.
+package alcsyncv1
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName is the name of the Application Layer Clock Synchronization package.
+const PackageName = "alcsync-v1"
+
+// ClockSyncPackage is the Application Layer Clock Synchronization package.
+type ClockSyncPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) RegisterServices(s *grpc.Server) {}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+// timeCorrection returns the correction the end device has to apply to deviceTime to be synchronized with receivedAt.
+// deviceTime is the end device time in seconds since GPS epoch, modulo 2^32.
+func timeCorrection(receivedAt time.Time, deviceTime uint32) int32 {
+	serverTime := uint32(gpstime.ToGPS(receivedAt) / time.Second)
+	return int32(serverTime - deviceTime)
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) HandleUp(ctx context.Context, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) error {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/alcsync/v1")
+	logger := log.FromContext(ctx)
+
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	var data packageData
+	if err := data.fromStruct(assoc.Data); err != nil {
+		logger.WithError(err).Debug("Failed to parse package data")
+		return err
+	}
+	cmds, err := decodeCommands(msg.FRMPayload)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to decode commands")
+		return err
+	}
+
+	var (
+		payload      []byte
+		synchronized bool
+		resynced     bool
+	)
+	for _, cmd := range cmds {
+		switch {
+		case cmd.PackageVersionReq:
+			payload = appendPackageVersionAns(payload)
+
+		case cmd.AppTimeReq != nil:
+			req := cmd.AppTimeReq
+			correction := timeCorrection(msg.ReceivedAt, req.DeviceTime)
+			d := time.Duration(correction) * time.Second
+			logger := logger.WithFields(log.Fields(
+				"time_correction", d,
+				"token_req", req.TokenReq,
+				"ans_required", req.AnsRequired,
+			))
+			if !data.lastSynchronizedAt.IsZero() && msg.ReceivedAt.After(data.lastSynchronizedAt) {
+				data.clockDrift = -d.Seconds() / msg.ReceivedAt.Sub(data.lastSynchronizedAt).Seconds() * 1e6
+			}
+			data.lastTimeCorrection = d
+			synchronized = true
+			if !req.AnsRequired && abs(d) < data.threshold {
+				logger.Debug("End device clock is synchronized")
+				continue
+			}
+			payload = appendAppTimeAns(payload, appTimeAns{
+				TimeCorrection: correction,
+				TokenAns:       req.TokenReq,
+			})
+			data.lastSynchronizedAt = msg.ReceivedAt
+			logger.Debug("Answer time request")
+
+		case cmd.DeviceAppTimePeriodicityAns != nil:
+			ans := cmd.DeviceAppTimePeriodicityAns
+			logger.WithFields(log.Fields(
+				"not_supported", ans.NotSupported,
+				"time_correction", time.Duration(timeCorrection(msg.ReceivedAt, ans.DeviceTime))*time.Second,
+			)).Debug("Received periodicity answer")
+		}
+	}
+	if data.forceResyncTransmissions > 0 {
+		payload = appendForceDeviceResyncReq(payload, data.forceResyncTransmissions)
+		resynced = true
+		logger.WithField("nb_transmissions", data.forceResyncTransmissions).Debug("Force end device resynchronization")
+	}
+
+	if len(payload) > 0 {
+		if err := p.server.DownlinkQueuePush(ctx, assoc.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink{{
+			FPort:      assoc.FPort,
+			FRMPayload: payload,
+		}}); err != nil {
+			logger.WithError(err).Debug("Failed to push downlink to device")
+			return err
+		}
+	}
+	if !synchronized && !resynced {
+		return nil
+	}
+
+	_, err = p.registry.Set(ctx, assoc.ApplicationPackageAssociationIdentifiers, []string{"data"},
+		func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			if assoc == nil {
+				return nil, nil, nil
+			}
+			// Keep the configuration of the stored data, which may have changed in the meantime.
+			var stored packageData
+			if err := stored.fromStruct(assoc.Data); err != nil {
+				return nil, nil, err
+			}
+			if synchronized {
+				stored.lastSynchronizedAt = data.lastSynchronizedAt
+				stored.lastTimeCorrection = data.lastTimeCorrection
+				stored.clockDrift = data.clockDrift
+			}
+			if resynced {
+				stored.forceResyncTransmissions = 0
+			}
+			assoc.Data = stored.toStruct()
+			return assoc, []string{"data"}, nil
+		},
+	)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to update package data")
+		return err
+	}
+	return nil
+}
+
+func init() {
+	p := ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 202,
+	}
+	packages.RegisterPackage(p, packages.CreateApplicationPackage(
+		func(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+			return &ClockSyncPackage{server, registry}
+		},
+	))
+}
@@ -0,0 +1,211 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mock"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/bolt"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDecodeCommands(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Payload        []byte
+		Commands       []command
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:     "Empty",
+			Payload:  []byte{},
+			Commands: nil,
+		},
+		{
+			Name:    "PackageVersionReq/AppTimeReq",
+			Payload: []byte{0x00, 0x01, 0x04, 0x03, 0x02, 0x01, 0x1a},
+			Commands: []command{
+				{PackageVersionReq: true},
+				{AppTimeReq: &appTimeReq{
+					DeviceTime:  0x01020304,
+					TokenReq:    0xa,
+					AnsRequired: true,
+				}},
+			},
+		},
+		{
+			Name:    "DeviceAppTimePeriodicityAns",
+			Payload: []byte{0x02, 0x01, 0x04, 0x03, 0x02, 0x01},
+			Commands: []command{
+				{DeviceAppTimePeriodicityAns: &deviceAppTimePeriodicityAns{
+					NotSupported: true,
+					DeviceTime:   0x01020304,
+				}},
+			},
+		},
+		{
+			Name:           "AppTimeReq/Short",
+			Payload:        []byte{0x01, 0x04, 0x03},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "Unknown",
+			Payload:        []byte{0x42},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			cmds, err := decodeCommands(tc.Payload)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(cmds, should.Resemble, tc.Commands)
+		})
+	}
+}
+
+func TestHandleUp(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	server := mock.NewServer(c)
+	cl, closeFn := test.NewBolt(t, "alcsync")
+	defer closeFn()
+	registry := &bolt.ApplicationPackagesRegistry{Bolt: cl}
+	p := &ClockSyncPackage{server, registry}
+
+	ids := ttnpb.ApplicationPackageAssociationIdentifiers{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+		},
+		FPort: 202,
+	}
+	_, err := registry.Set(ctx, ids, nil, func(*ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+		return &ttnpb.ApplicationPackageAssociation{
+			ApplicationPackageAssociationIdentifiers: ids,
+			PackageName:                              PackageName,
+			Data: packageData{
+				threshold:                4 * time.Second,
+				forceResyncTransmissions: 2,
+			}.toStruct(),
+		}, []string{
+			"data",
+			"ids",
+			"package_name",
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	handleUp := func(receivedAt time.Time, payload []byte) {
+		assoc, err := registry.Get(ctx, ids, []string{"data"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		err = p.HandleUp(ctx, assoc, &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids.EndDeviceIdentifiers,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      202,
+					FRMPayload: payload,
+					ReceivedAt: receivedAt,
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	appTimeReq := func(deviceTime uint32, param byte) []byte {
+		b := []byte{appTimeCID, 0, 0, 0, 0, param}
+		binary.LittleEndian.PutUint32(b[1:5], deviceTime)
+		return b
+	}
+	assertData := func(expected packageData) {
+		assoc, err := registry.Get(ctx, ids, []string{"data"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		var data packageData
+		a.So(data.fromStruct(assoc.Data), should.BeNil)
+		a.So(data.threshold, should.Equal, expected.threshold)
+		a.So(data.forceResyncTransmissions, should.Equal, expected.forceResyncTransmissions)
+		a.So(data.lastSynchronizedAt, should.Equal, expected.lastSynchronizedAt)
+		a.So(data.lastTimeCorrection, should.Equal, expected.lastTimeCorrection)
+		a.So(data.clockDrift, should.AlmostEqual, expected.clockDrift, 1e-6)
+	}
+
+	// Answer the version request and time request, and force resynchronization.
+	start := time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC)
+	serverTime := uint32(gpstime.ToGPS(start) / time.Second)
+	handleUp(start, append([]byte{packageVersionCID}, appTimeReq(serverTime-10, 0x03)...))
+
+	queue, err := server.DownlinkQueueList(ctx, ids.EndDeviceIdentifiers)
+	a.So(err, should.BeNil)
+	if a.So(queue, should.HaveLength, 1) {
+		a.So(queue[0].FPort, should.Equal, 202)
+		a.So(queue[0].FRMPayload, should.Resemble, []byte{
+			packageVersionCID, packageIdentifier, packageVersion,
+			appTimeCID, 0x0a, 0x00, 0x00, 0x00, 0x03,
+			forceDeviceResyncCID, 0x02,
+		})
+	}
+	assertData(packageData{
+		threshold:          4 * time.Second,
+		lastSynchronizedAt: start,
+		lastTimeCorrection: 10 * time.Second,
+	})
+
+	// Do not answer if the correction is below the threshold, but track the clock drift.
+	next := start.Add(1000 * time.Second)
+	handleUp(next, appTimeReq(serverTime+1000+1, 0x04))
+
+	queue, err = server.DownlinkQueueList(ctx, ids.EndDeviceIdentifiers)
+	a.So(err, should.BeNil)
+	a.So(queue, should.HaveLength, 1)
+	assertData(packageData{
+		threshold:          4 * time.Second,
+		lastSynchronizedAt: start,
+		lastTimeCorrection: -time.Second,
+		clockDrift:         1000,
+	})
+
+	// Answer if required, even if the correction is below the threshold.
+	handleUp(next, appTimeReq(serverTime+1000+1, 0x15))
+
+	queue, err = server.DownlinkQueueList(ctx, ids.EndDeviceIdentifiers)
+	a.So(err, should.BeNil)
+	if a.So(queue, should.HaveLength, 2) {
+		a.So(queue[1].FRMPayload, should.Resemble, []byte{
+			appTimeCID, 0xff, 0xff, 0xff, 0xff, 0x05,
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides a bbolt implementation of the application packages registry.
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"runtime/trace"
+	"time"
+
+	"go.etcd.io/bbolt"
+	ttnbolt "go.thethings.network/lorawan-stack/pkg/bolt"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+)
+
+// appendImplicitAssociationGetPaths appends implicit ttnpb.ApplicationPackageAssociation get paths to paths.
+func appendImplicitAssociationGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 4+len(paths)),
+		"created_at",
+		"ids",
+		"package_name",
+		"updated_at",
+	), paths...)
+}
+
+func applyAssociationFieldMask(dst, src *ttnpb.ApplicationPackageAssociation, paths ...string) (*ttnpb.ApplicationPackageAssociation, error) {
+	if dst == nil {
+		dst = &ttnpb.ApplicationPackageAssociation{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// ApplicationPackagesRegistry is a bbolt application packages registry.
+type ApplicationPackagesRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+func (r *ApplicationPackagesRegistry) devPrefix(devUID string) []byte {
+	return r.Bolt.Key("uid", devUID, "")
+}
+
+// fPortStr returns the zero-padded string representation of fPort, such that associations are stored in FPort order.
+func (r *ApplicationPackagesRegistry) fPortStr(fPort uint32) string {
+	if fPort > 255 {
+		panic("FPort cannot be higher than 255")
+	}
+	return fmt.Sprintf("%03d", fPort)
+}
+
+func (r *ApplicationPackagesRegistry) associationKey(devUID string, fPort string) []byte {
+	return r.Bolt.Key("uid", devUID, fPort)
+}
+
+// Get implements applicationpackages.AssociationRegistry.
+func (r ApplicationPackagesRegistry) Get(ctx context.Context, ids ttnpb.ApplicationPackageAssociationIdentifiers, paths []string) (*ttnpb.ApplicationPackageAssociation, error) {
+	pb := &ttnpb.ApplicationPackageAssociation{}
+	defer trace.StartRegion(ctx, "get application package association by id").End()
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.GetProto(b, r.associationKey(unique.ID(ctx, ids.EndDeviceIdentifiers), r.fPortStr(ids.FPort)), pb)
+	}); err != nil {
+		return nil, err
+	}
+	return applyAssociationFieldMask(nil, pb, appendImplicitAssociationGetPaths(paths...)...)
+}
+
+// List implements applicationpackages.AssociationRegistry.
+func (r ApplicationPackagesRegistry) List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) ([]*ttnpb.ApplicationPackageAssociation, error) {
+	var pbs []*ttnpb.ApplicationPackageAssociation
+	dp := r.devPrefix(unique.ID(ctx, ids))
+
+	defer trace.StartRegion(ctx, "list application package associations by device id").End()
+
+	err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		limit, offset := ttnbolt.PaginationLimitAndOffsetFromContext(ctx)
+		if limit != 0 {
+			ttnbolt.SetPaginationTotal(ctx, ttnbolt.CountPrefix(b, dp))
+		}
+
+		var i int64
+		return ttnbolt.ForEachPrefix(b, dp, func(_, v []byte) (bool, error) {
+			i++
+			if i <= offset {
+				return true, nil
+			}
+			pb := &ttnpb.ApplicationPackageAssociation{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return false, err
+			}
+			pb, err := applyAssociationFieldMask(nil, pb, appendImplicitAssociationGetPaths(paths...)...)
+			if err != nil {
+				return false, err
+			}
+			pbs = append(pbs, pb)
+			return limit == 0 || int64(len(pbs)) < limit, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbs, nil
+}
+
+// Set implements applicationpackages.AssociationRegistry.
+func (r ApplicationPackagesRegistry) Set(ctx context.Context, ids ttnpb.ApplicationPackageAssociationIdentifiers, gets []string, f func(*ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error)) (*ttnpb.ApplicationPackageAssociation, error) {
+	devUID := unique.ID(ctx, ids.EndDeviceIdentifiers)
+	ak := r.associationKey(devUID, r.fPortStr(ids.FPort))
+
+	defer trace.StartRegion(ctx, "set application package association by id").End()
+
+	var pb *ttnpb.ApplicationPackageAssociation
+	err := r.Bolt.Watch(func(tx *ttnbolt.WatchTx) (func(*bbolt.Bucket) error, error) {
+		v, err := tx.Get(ak)
+		if err != nil {
+			return nil, err
+		}
+		var stored *ttnpb.ApplicationPackageAssociation
+		if v != nil {
+			stored = &ttnpb.ApplicationPackageAssociation{}
+			if err := ttnbolt.UnmarshalProto(v, stored); err != nil {
+				return nil, err
+			}
+		}
+
+		gets = appendImplicitAssociationGetPaths(gets...)
+
+		if stored != nil {
+			pb = &ttnpb.ApplicationPackageAssociation{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return nil, err
+			}
+			pb, err = applyAssociationFieldMask(nil, pb, gets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return nil, err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+		if stored == nil && pb == nil {
+			return nil, nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyAssociationFieldMask(nil, stored, gets...)
+			return nil, err
+		}
+
+		if pb == nil && len(sets) == 0 {
+			return func(b *bbolt.Bucket) error {
+				return b.Delete(ak)
+			}, nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.ApplicationPackageAssociation{}
+		}
+
+		pb.UpdatedAt = time.Now().UTC()
+		sets = append(append(sets[:0:0], sets...),
+			"updated_at",
+		)
+
+		updated := &ttnpb.ApplicationPackageAssociation{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"ids.end_device_ids.application_ids",
+				"ids.end_device_ids.device_id",
+				"ids.f_port",
+			); err != nil {
+				return nil, errInvalidFieldmask.WithCause(err)
+			}
+
+			pb.CreatedAt = pb.UpdatedAt
+			sets = append(sets, "created_at")
+
+			updated, err = applyAssociationFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+			if updated.ApplicationID != ids.ApplicationID || updated.DeviceID != ids.DeviceID || updated.FPort != ids.FPort {
+				return nil, errInvalidIdentifiers
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.end_device_ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.end_device_ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.end_device_ids.device_id") && pb.DeviceID != stored.DeviceID {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.end_device_ids.device_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.f_port") && pb.FPort != stored.FPort {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.f_port")
+			}
+			if err := ttnbolt.UnmarshalProto(v, updated); err != nil {
+				return nil, err
+			}
+			updated, err = applyAssociationFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := updated.ValidateFields(sets...); err != nil {
+			return nil, err
+		}
+
+		pb, err = applyAssociationFieldMask(nil, updated, gets...)
+		if err != nil {
+			return nil, err
+		}
+		return func(b *bbolt.Bucket) error {
+			return ttnbolt.SetProto(b, ak, updated)
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// WithPagination implements applicationpackages.AssociationRegistry.
+func (r ApplicationPackagesRegistry) WithPagination(ctx context.Context, limit, page uint32, total *int64) context.Context {
+	return ttnbolt.NewContextWithPagination(ctx, int64(limit), int64(page), total)
+}
@@ -0,0 +1,197 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+const (
+	// packageIdentifier is the identifier of the Fragmented Data Block Transport package.
+	packageIdentifier = 3
+	// packageVersion is the version of the Fragmented Data Block Transport package.
+	packageVersion = 1
+
+	packageVersionCID    = 0x00
+	fragSessionStatusCID = 0x01
+	fragSessionSetupCID  = 0x02
+	fragSessionDeleteCID = 0x03
+	dataFragmentCID      = 0x08
+)
+
+var (
+	errUnknownCommand = errors.DefineInvalidArgument("unknown_command", "unknown command with CID `{cid}`")
+	errCommandLength  = errors.DefineInvalidArgument("command_length", "command with CID `{cid}` needs {expected} bytes, got {actual}")
+)
+
+// packageVersionAns is the PackageVersionAns uplink command.
+type packageVersionAns struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// fragSessionStatusAns is the FragSessionStatusAns uplink command.
+type fragSessionStatusAns struct {
+	FragIndex             uint8
+	NbFragReceived        uint16
+	MissingFrag           uint8
+	NotEnoughMatrixMemory bool
+}
+
+// fragSessionSetupAns is the FragSessionSetupAns uplink command.
+type fragSessionSetupAns struct {
+	FragIndex                    uint8
+	EncodingUnsupported          bool
+	NotEnoughMemory              bool
+	FragSessionIndexNotSupported bool
+	WrongDescriptor              bool
+}
+
+// OK returns whether the end device accepted the fragmentation session.
+func (ans fragSessionSetupAns) OK() bool {
+	return !ans.EncodingUnsupported && !ans.NotEnoughMemory && !ans.FragSessionIndexNotSupported && !ans.WrongDescriptor
+}
+
+// fragSessionDeleteAns is the FragSessionDeleteAns uplink command.
+type fragSessionDeleteAns struct {
+	FragIndex           uint8
+	SessionDoesNotExist bool
+}
+
+// command is a decoded uplink command. Exactly one of the fields is set.
+type command struct {
+	PackageVersionAns    *packageVersionAns
+	FragSessionStatusAns *fragSessionStatusAns
+	FragSessionSetupAns  *fragSessionSetupAns
+	FragSessionDeleteAns *fragSessionDeleteAns
+}
+
+func checkLength(cid byte, b []byte, n int) error {
+	if len(b) < n {
+		return errCommandLength.WithAttributes(
+			"cid", cid,
+			"expected", n,
+			"actual", len(b),
+		)
+	}
+	return nil
+}
+
+// decodeCommands decodes the uplink commands in b.
+func decodeCommands(b []byte) ([]command, error) {
+	var cmds []command
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		switch cid {
+		case packageVersionCID:
+			if err := checkLength(cid, b, 2); err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, command{PackageVersionAns: &packageVersionAns{
+				PackageIdentifier: b[0],
+				PackageVersion:    b[1],
+			}})
+			b = b[2:]
+
+		case fragSessionStatusCID:
+			if err := checkLength(cid, b, 4); err != nil {
+				return nil, err
+			}
+			receivedAndIndex := binary.LittleEndian.Uint16(b[0:2])
+			cmds = append(cmds, command{FragSessionStatusAns: &fragSessionStatusAns{
+				FragIndex:             uint8(receivedAndIndex >> 14),
+				NbFragReceived:        receivedAndIndex & 0x3fff,
+				MissingFrag:           b[2],
+				NotEnoughMatrixMemory: b[3]&0x1 != 0,
+			}})
+			b = b[4:]
+
+		case fragSessionSetupCID:
+			if err := checkLength(cid, b, 1); err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, command{FragSessionSetupAns: &fragSessionSetupAns{
+				FragIndex:                    b[0] >> 6,
+				EncodingUnsupported:          b[0]&0x1 != 0,
+				NotEnoughMemory:              b[0]&0x2 != 0,
+				FragSessionIndexNotSupported: b[0]&0x4 != 0,
+				WrongDescriptor:              b[0]&0x8 != 0,
+			}})
+			b = b[1:]
+
+		case fragSessionDeleteCID:
+			if err := checkLength(cid, b, 1); err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, command{FragSessionDeleteAns: &fragSessionDeleteAns{
+				FragIndex:           b[0] & 0x3,
+				SessionDoesNotExist: b[0]&0x4 != 0,
+			}})
+			b = b[1:]
+
+		default:
+			return nil, errUnknownCommand.WithAttributes("cid", cid)
+		}
+	}
+	return cmds, nil
+}
+
+// fragSessionSetupReq is the FragSessionSetupReq downlink command.
+type fragSessionSetupReq struct {
+	FragIndex      uint8
+	McGroupBitMask uint8
+	NbFrag         uint16
+	FragSize       uint8
+	FragAlgo       uint8
+	BlockAckDelay  uint8
+	Padding        uint8
+	Descriptor     uint32
+}
+
+// appendFragSessionStatusReq appends the FragSessionStatusReq downlink command to b.
+// If participants is set, all end devices answer, otherwise only the end devices which miss fragments.
+func appendFragSessionStatusReq(b []byte, fragIndex uint8, participants bool) []byte {
+	param := (fragIndex & 0x3) << 1
+	if participants {
+		param |= 0x1
+	}
+	return append(b, fragSessionStatusCID, param)
+}
+
+// appendFragSessionSetupReq appends the FragSessionSetupReq downlink command to b.
+func appendFragSessionSetupReq(b []byte, req fragSessionSetupReq) []byte {
+	b = append(b,
+		fragSessionSetupCID,
+		(req.FragIndex&0x3)<<4|req.McGroupBitMask&0xf,
+		byte(req.NbFrag), byte(req.NbFrag>>8),
+		req.FragSize,
+		(req.FragAlgo&0x7)<<3|req.BlockAckDelay&0x7,
+		req.Padding,
+	)
+	var descriptor [4]byte
+	binary.LittleEndian.PutUint32(descriptor[:], req.Descriptor)
+	return append(b, descriptor[:]...)
+}
+
+// appendDataFragment appends the DataFragment downlink command to b.
+// n is the 1-based index of the fragment.
+func appendDataFragment(b []byte, fragIndex uint8, n uint16, payload []byte) []byte {
+	indexAndN := uint16(fragIndex&0x3)<<14 | n&0x3fff
+	b = append(b, dataFragmentCID, byte(indexAndN), byte(indexAndN>>8))
+	return append(b, payload...)
+}
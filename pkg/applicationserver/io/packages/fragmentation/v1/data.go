@@ -0,0 +1,146 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"encoding/json"
+
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+)
+
+// sessionState is the state of a fragmentation session.
+type sessionState string
+
+const (
+	// stateSetup means that the FragSessionSetupReq has been queued.
+	stateSetup sessionState = "setup"
+	// stateTransmitting means that the end device accepted the session and the fragments have been queued.
+	stateTransmitting sessionState = "transmitting"
+	// stateCompleted means that the end device reconstructed the data block.
+	stateCompleted sessionState = "completed"
+	// stateFailed means that the end device rejected the session.
+	stateFailed sessionState = "failed"
+	// stateDeleted means that the end device deleted the session.
+	stateDeleted sessionState = "deleted"
+)
+
+const (
+	defaultFragSize = 50
+	// defaultRedundancyPercentage is the default number of coded fragments, as percentage of the number of data fragments.
+	defaultRedundancyPercentage = 20
+)
+
+// packageData is the data of a package association.
+// The data block and the session parameters are configured by the user,
+// while the remaining fields track the state of the fragmentation session.
+type packageData struct {
+	Data              []byte  `json:"data,omitempty"`
+	FragIndex         uint8   `json:"frag_index,omitempty"`
+	FragSize          uint8   `json:"frag_size,omitempty"`
+	Redundancy        *uint16 `json:"redundancy,omitempty"`
+	McGroupBitMask    uint8   `json:"mc_group_bit_mask,omitempty"`
+	MulticastDeviceID string  `json:"multicast_device_id,omitempty"`
+	BlockAckDelay     uint8   `json:"block_ack_delay,omitempty"`
+	Descriptor        uint32  `json:"descriptor,omitempty"`
+
+	State                 sessionState `json:"state,omitempty"`
+	NbFrag                uint16       `json:"nb_frag,omitempty"`
+	NbFragReceived        uint16       `json:"nb_frag_received,omitempty"`
+	MissingFrag           uint8        `json:"missing_frag,omitempty"`
+	NotEnoughMatrixMemory bool         `json:"not_enough_matrix_memory,omitempty"`
+	Error                 string       `json:"error,omitempty"`
+}
+
+// fragSize returns the configured fragment size, or the default fragment size.
+func (d packageData) fragSize() int {
+	if d.FragSize == 0 {
+		return defaultFragSize
+	}
+	return int(d.FragSize)
+}
+
+// nbFrag returns the number of data fragments of the data block.
+func (d packageData) nbFrag() int {
+	return (len(d.Data) + d.fragSize() - 1) / d.fragSize()
+}
+
+// redundancy returns the configured number of coded fragments, or the default number of coded fragments.
+func (d packageData) redundancy() int {
+	if d.Redundancy == nil {
+		return (d.nbFrag()*defaultRedundancyPercentage + 99) / 100
+	}
+	return int(*d.Redundancy)
+}
+
+// setState copies the session state of src to d.
+func (d *packageData) setState(src packageData) {
+	d.State = src.State
+	d.NbFrag = src.NbFrag
+	d.NbFragReceived = src.NbFragReceived
+	d.MissingFrag = src.MissingFrag
+	d.NotEnoughMatrixMemory = src.NotEnoughMatrixMemory
+	d.Error = src.Error
+}
+
+var (
+	errInvalidData          = errors.DefineInvalidArgument("invalid_data", "invalid package data")
+	errInvalidFragIndex     = errors.DefineInvalidArgument("invalid_frag_index", "invalid fragmentation session index `{frag_index}`")
+	errDataBlockTooLarge    = errors.DefineInvalidArgument("data_block_too_large", "data block of {nb_frag} fragments exceeds the maximum of {max} fragments")
+	errInvalidBlockAckDelay = errors.DefineInvalidArgument("invalid_block_ack_delay", "invalid block acknowledgment delay `{block_ack_delay}`")
+)
+
+// validate validates the configuration of the package data.
+func (d packageData) validate() error {
+	if d.FragIndex > 3 {
+		return errInvalidFragIndex.WithAttributes("frag_index", d.FragIndex)
+	}
+	if d.BlockAckDelay > 7 {
+		return errInvalidBlockAckDelay.WithAttributes("block_ack_delay", d.BlockAckDelay)
+	}
+	if n := d.nbFrag() + d.redundancy(); n > 0x3fff {
+		return errDataBlockTooLarge.WithAttributes("nb_frag", n, "max", 0x3fff)
+	}
+	return nil
+}
+
+func (d packageData) toStruct() (*types.Struct, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return gogoproto.Struct(m)
+}
+
+func (d *packageData) fromStruct(st *types.Struct) error {
+	m, err := gogoproto.Map(st)
+	if err != nil {
+		return errInvalidData.WithCause(err)
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return errInvalidData.WithCause(err)
+	}
+	*d = packageData{}
+	if err := json.Unmarshal(b, d); err != nil {
+		return errInvalidData.WithCause(err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+// fragAlgoParity is the identifier of the parity check based forward error correction algorithm of TS004.
+const fragAlgoParity = 0
+
+// prbs23 returns the next value of the 23-bit pseudo-random binary sequence seeded with x.
+func prbs23(x uint32) uint32 {
+	b0 := x & 0x1
+	b1 := (x & 0x20) >> 5
+	return x>>1 + (b0^b1)<<22
+}
+
+// parityMatrixRow returns the row of the parity check matrix for the n-th coded fragment of a data block of m fragments.
+// n is 1-based. The returned row contains m elements, where the data fragments to combine are set.
+func parityMatrixRow(n, m int) []bool {
+	row := make([]bool, m)
+	mTemp := 0
+	if m&(m-1) == 0 {
+		mTemp = 1
+	}
+	x := uint32(1 + 1001*n)
+	for nbCoeff := 0; nbCoeff < m/2; nbCoeff++ {
+		r := 1 << 16
+		for r >= m {
+			x = prbs23(x)
+			r = int(x % uint32(m+mTemp))
+		}
+		row[r] = true
+	}
+	return row
+}
+
+// fragment splits data in fragments of fragSize bytes, and appends redundancy coded fragments.
+// The last data fragment is padded with zeroes. fragment returns the fragments and the number of padding bytes.
+func fragment(data []byte, fragSize, redundancy int) ([][]byte, int) {
+	m := (len(data) + fragSize - 1) / fragSize
+	padding := m*fragSize - len(data)
+	padded := make([]byte, m*fragSize)
+	copy(padded, data)
+
+	frags := make([][]byte, 0, m+redundancy)
+	for i := 0; i < m; i++ {
+		frags = append(frags, padded[i*fragSize:(i+1)*fragSize])
+	}
+	for n := 1; n <= redundancy; n++ {
+		coded := make([]byte, fragSize)
+		for i, set := range parityMatrixRow(n, m) {
+			if !set {
+				continue
+			}
+			for j := range coded {
+				coded[j] ^= frags[i][j]
+			}
+		}
+		frags = append(frags, coded)
+	}
+	return frags, padding
+}
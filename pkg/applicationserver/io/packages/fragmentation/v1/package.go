@@ -0,0 +1,308 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fragmentationv1 implements the LoRaWAN Fragmented Data Block Transport v1.0.0 (TS004) application package.
+package fragmentationv1
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName is the name of the Fragmented Data Block Transport package.
+const PackageName = "fragmentation-v1"
+
+// FragmentationPackage is the Fragmented Data Block Transport package.
+type FragmentationPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *FragmentationPackage) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterApplicationFragmentationPackageServer(s, p)
+}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *FragmentationPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterApplicationFragmentationPackageHandler(context.Background(), s, conn)
+}
+
+var errAssociationNotFound = errors.DefineNotFound("association_not_found", "association with package `{package}` not found")
+
+// GetStatus implements ttnpb.ApplicationFragmentationPackageServer.
+func (p *FragmentationPackage) GetStatus(ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers) (*pbtypes.Struct, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_PACKAGES); err != nil {
+		return nil, err
+	}
+	return p.getStatus(ctx, *ids)
+}
+
+// getStatus returns the progress of the fragmentation session of the association identified by ids.
+func (p *FragmentationPackage) getStatus(ctx context.Context, ids ttnpb.ApplicationPackageAssociationIdentifiers) (*pbtypes.Struct, error) {
+	assoc, err := p.registry.Get(ctx, ids, []string{
+		"data",
+		"package_name",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if assoc.PackageName != PackageName {
+		return nil, errAssociationNotFound.WithAttributes("package", PackageName)
+	}
+	var data packageData
+	if err := data.fromStruct(assoc.Data); err != nil {
+		return nil, err
+	}
+	var progress float64
+	if data.State == stateCompleted {
+		progress = 100
+	} else if data.NbFrag > 0 {
+		progress = float64(data.NbFragReceived) / float64(data.NbFrag) * 100
+		if progress > 100 {
+			progress = 100
+		}
+	}
+	return gogoproto.Struct(map[string]interface{}{
+		"state":                    string(data.State),
+		"nb_frag":                  data.NbFrag,
+		"nb_frag_received":         data.NbFragReceived,
+		"missing_frag":             data.MissingFrag,
+		"not_enough_matrix_memory": data.NotEnoughMatrixMemory,
+		"error":                    data.Error,
+		"progress":                 progress,
+	})
+}
+
+// updateState updates the session state stored in the association identified by ids to the state of data.
+// The configuration of the stored association is preserved.
+func (p *FragmentationPackage) updateState(ctx context.Context, ids ttnpb.ApplicationPackageAssociationIdentifiers, data packageData) error {
+	_, err := p.registry.Set(ctx, ids, []string{"data"},
+		func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			if assoc == nil {
+				return nil, nil, nil
+			}
+			var stored packageData
+			if err := stored.fromStruct(assoc.Data); err != nil {
+				return nil, nil, err
+			}
+			stored.setState(data)
+			st, err := stored.toStruct()
+			if err != nil {
+				return nil, nil, err
+			}
+			assoc.Data = st
+			return assoc, []string{"data"}, nil
+		},
+	)
+	return err
+}
+
+// HandleAssociation implements packages.AssociationHandler.
+// If the association has a data block and no session state, a new fragmentation session is set up with the end device.
+func (p *FragmentationPackage) HandleAssociation(ctx context.Context, assoc *ttnpb.ApplicationPackageAssociation) error {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/fragmentation/v1")
+	logger := log.FromContext(ctx)
+
+	var data packageData
+	if err := data.fromStruct(assoc.Data); err != nil {
+		return err
+	}
+	if len(data.Data) == 0 || data.State != "" {
+		return nil
+	}
+	if err := data.validate(); err != nil {
+		return err
+	}
+
+	nbFrag := data.nbFrag()
+	frags, padding := fragment(data.Data, data.fragSize(), data.redundancy())
+	if err := p.server.DownlinkQueuePush(ctx, assoc.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink{{
+		FPort: assoc.FPort,
+		FRMPayload: appendFragSessionSetupReq(nil, fragSessionSetupReq{
+			FragIndex:      data.FragIndex,
+			McGroupBitMask: data.McGroupBitMask,
+			NbFrag:         uint16(nbFrag),
+			FragSize:       uint8(data.fragSize()),
+			FragAlgo:       fragAlgoParity,
+			BlockAckDelay:  data.BlockAckDelay,
+			Padding:        uint8(padding),
+			Descriptor:     data.Descriptor,
+		}),
+	}}); err != nil {
+		logger.WithError(err).Debug("Failed to push fragmentation session setup request")
+		return err
+	}
+	logger.WithFields(log.Fields(
+		"frag_index", data.FragIndex,
+		"nb_frag", nbFrag,
+		"nb_coded_frag", len(frags)-nbFrag,
+	)).Debug("Fragmentation session setup requested")
+
+	data.State = stateSetup
+	data.NbFrag = uint16(nbFrag)
+	st, err := data.toStruct()
+	if err != nil {
+		return err
+	}
+	assoc.Data = st
+	return nil
+}
+
+// transmit queues the fragments of the data block and a status request.
+// The fragments are queued for the multicast end device, if configured, and for the end device itself otherwise.
+func (p *FragmentationPackage) transmit(ctx context.Context, assoc *ttnpb.ApplicationPackageAssociation, data packageData) error {
+	frags, _ := fragment(data.Data, data.fragSize(), data.redundancy())
+	downs := make([]*ttnpb.ApplicationDownlink, 0, len(frags))
+	for i, frag := range frags {
+		downs = append(downs, &ttnpb.ApplicationDownlink{
+			FPort:      assoc.FPort,
+			FRMPayload: appendDataFragment(nil, data.FragIndex, uint16(i+1), frag),
+		})
+	}
+	ids := assoc.EndDeviceIdentifiers
+	if data.MulticastDeviceID != "" {
+		ids = ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: assoc.ApplicationIdentifiers,
+			DeviceID:               data.MulticastDeviceID,
+		}
+	}
+	if err := p.server.DownlinkQueuePush(ctx, ids, downs); err != nil {
+		return err
+	}
+	return p.server.DownlinkQueuePush(ctx, assoc.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink{{
+		FPort:      assoc.FPort,
+		FRMPayload: appendFragSessionStatusReq(nil, data.FragIndex, true),
+	}})
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *FragmentationPackage) HandleUp(ctx context.Context, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) error {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/fragmentation/v1")
+	logger := log.FromContext(ctx)
+
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	var data packageData
+	if err := data.fromStruct(assoc.Data); err != nil {
+		logger.WithError(err).Debug("Failed to parse package data")
+		return err
+	}
+	cmds, err := decodeCommands(msg.FRMPayload)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to decode commands")
+		return err
+	}
+
+	state, changed := data, false
+	for _, cmd := range cmds {
+		switch {
+		case cmd.PackageVersionAns != nil:
+			logger.WithFields(log.Fields(
+				"package_identifier", cmd.PackageVersionAns.PackageIdentifier,
+				"package_version", cmd.PackageVersionAns.PackageVersion,
+			)).Debug("Received package version")
+
+		case cmd.FragSessionSetupAns != nil:
+			ans := cmd.FragSessionSetupAns
+			if ans.FragIndex != data.FragIndex || state.State != stateSetup {
+				logger.WithField("frag_index", ans.FragIndex).Debug("Ignore fragmentation session setup answer")
+				continue
+			}
+			if !ans.OK() {
+				state.State = stateFailed
+				switch {
+				case ans.EncodingUnsupported:
+					state.Error = "encoding unsupported"
+				case ans.NotEnoughMemory:
+					state.Error = "not enough memory"
+				case ans.FragSessionIndexNotSupported:
+					state.Error = "fragmentation session index not supported"
+				case ans.WrongDescriptor:
+					state.Error = "wrong descriptor"
+				}
+				changed = true
+				logger.WithField("error", state.Error).Warn("End device rejected fragmentation session")
+				continue
+			}
+			if err := p.transmit(ctx, assoc, data); err != nil {
+				logger.WithError(err).Debug("Failed to push fragments")
+				return err
+			}
+			state.State = stateTransmitting
+			changed = true
+			logger.Debug("Fragments queued")
+
+		case cmd.FragSessionStatusAns != nil:
+			ans := cmd.FragSessionStatusAns
+			if ans.FragIndex != data.FragIndex {
+				continue
+			}
+			state.NbFragReceived = ans.NbFragReceived
+			state.MissingFrag = ans.MissingFrag
+			state.NotEnoughMatrixMemory = ans.NotEnoughMatrixMemory
+			changed = true
+			if ans.MissingFrag == 0 && ans.NbFragReceived > 0 && !ans.NotEnoughMatrixMemory {
+				state.State = stateCompleted
+			}
+			logger.WithFields(log.Fields(
+				"nb_frag_received", ans.NbFragReceived,
+				"missing_frag", ans.MissingFrag,
+				"not_enough_matrix_memory", ans.NotEnoughMatrixMemory,
+			)).Debug("Received fragmentation session status")
+
+		case cmd.FragSessionDeleteAns != nil:
+			ans := cmd.FragSessionDeleteAns
+			if ans.FragIndex != data.FragIndex {
+				continue
+			}
+			state.State = stateDeleted
+			changed = true
+			logger.WithField("session_does_not_exist", ans.SessionDoesNotExist).Debug("Fragmentation session deleted")
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := p.updateState(ctx, assoc.ApplicationPackageAssociationIdentifiers, state); err != nil {
+		logger.WithError(err).Debug("Failed to update package data")
+		return err
+	}
+	return nil
+}
+
+func init() {
+	p := ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 201,
+	}
+	packages.RegisterPackage(p, packages.CreateApplicationPackage(
+		func(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+			return &FragmentationPackage{server, registry}
+		},
+	))
+}
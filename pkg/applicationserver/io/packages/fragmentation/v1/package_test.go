@@ -0,0 +1,338 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mock"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/bolt"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestParityMatrixRow(t *testing.T) {
+	a := assertions.New(t)
+	a.So(parityMatrixRow(1, 4), should.Resemble, []bool{true, false, true, false})
+	a.So(parityMatrixRow(2, 4), should.Resemble, []bool{true, false, true, false})
+	a.So(parityMatrixRow(3, 4), should.Resemble, []bool{false, true, false, true})
+}
+
+func TestFragment(t *testing.T) {
+	a := assertions.New(t)
+
+	frags, padding := fragment([]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}, 2, 3)
+	a.So(padding, should.Equal, 1)
+	a.So(frags, should.Resemble, [][]byte{
+		{0x01, 0x02},
+		{0x03, 0x04},
+		{0x05, 0x06},
+		{0x07, 0x00},
+		{0x04, 0x04},
+		{0x04, 0x04},
+		{0x04, 0x04},
+	})
+
+	frags, padding = fragment([]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}, 2, 3)
+	a.So(padding, should.Equal, 0)
+	a.So(frags[4:], should.Resemble, [][]byte{
+		{0x04, 0x04},
+		{0x04, 0x04},
+		{0x04, 0x0c},
+	})
+}
+
+func TestDecodeCommands(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Payload        []byte
+		Commands       []command
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:     "Empty",
+			Payload:  []byte{},
+			Commands: nil,
+		},
+		{
+			Name:    "PackageVersionAns/FragSessionSetupAns",
+			Payload: []byte{0x00, 0x03, 0x01, 0x02, 0x42},
+			Commands: []command{
+				{PackageVersionAns: &packageVersionAns{
+					PackageIdentifier: packageIdentifier,
+					PackageVersion:    packageVersion,
+				}},
+				{FragSessionSetupAns: &fragSessionSetupAns{
+					FragIndex:       1,
+					NotEnoughMemory: true,
+				}},
+			},
+		},
+		{
+			Name:    "FragSessionStatusAns/FragSessionDeleteAns",
+			Payload: []byte{0x01, 0x0a, 0x80, 0x02, 0x01, 0x03, 0x06},
+			Commands: []command{
+				{FragSessionStatusAns: &fragSessionStatusAns{
+					FragIndex:             2,
+					NbFragReceived:        10,
+					MissingFrag:           2,
+					NotEnoughMatrixMemory: true,
+				}},
+				{FragSessionDeleteAns: &fragSessionDeleteAns{
+					FragIndex:           2,
+					SessionDoesNotExist: true,
+				}},
+			},
+		},
+		{
+			Name:           "FragSessionStatusAns/Short",
+			Payload:        []byte{0x01, 0x0a, 0x80},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "Unknown",
+			Payload:        []byte{0x42},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			cmds, err := decodeCommands(tc.Payload)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(cmds, should.Resemble, tc.Commands)
+		})
+	}
+}
+
+func TestSession(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	server := mock.NewServer(c)
+	cl, closeFn := test.NewBolt(t, "fragmentation")
+	defer closeFn()
+	registry := &bolt.ApplicationPackagesRegistry{Bolt: cl}
+	p := &FragmentationPackage{server, registry}
+
+	ids := ttnpb.ApplicationPackageAssociationIdentifiers{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+		},
+		FPort: 201,
+	}
+	mcIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		DeviceID:               "test-mc",
+	}
+	redundancy := uint16(1)
+	st, err := packageData{
+		Data:              []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+		FragIndex:         1,
+		FragSize:          4,
+		Redundancy:        &redundancy,
+		McGroupBitMask:    0x1,
+		MulticastDeviceID: mcIDs.DeviceID,
+	}.toStruct()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = registry.Set(ctx, ids, nil, func(*ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+		return &ttnpb.ApplicationPackageAssociation{
+			ApplicationPackageAssociationIdentifiers: ids,
+			PackageName:                              PackageName,
+			Data:                                     st,
+		}, []string{
+			"data",
+			"ids",
+			"package_name",
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	getAssociation := func() *ttnpb.ApplicationPackageAssociation {
+		assoc, err := registry.Get(ctx, ids, []string{
+			"data",
+			"ids",
+			"package_name",
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return assoc
+	}
+	handleAssociation := func() error {
+		_, err := registry.Set(ctx, ids, []string{
+			"data",
+			"ids",
+			"package_name",
+		}, func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			if err := p.HandleAssociation(ctx, assoc); err != nil {
+				return nil, nil, err
+			}
+			return assoc, []string{"data"}, nil
+		})
+		return err
+	}
+	handleUp := func(payload []byte) {
+		err := p.HandleUp(ctx, getAssociation(), &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids.EndDeviceIdentifiers,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      201,
+					FRMPayload: payload,
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	assertStatus := func(state sessionState, progress float64) {
+		st, err := p.getStatus(ctx, ids)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(st.Fields["state"].GetStringValue(), should.Equal, string(state))
+		a.So(st.Fields["progress"].GetNumberValue(), should.AlmostEqual, progress)
+	}
+
+	// Set up the session.
+	if !a.So(handleAssociation(), should.BeNil) {
+		t.FailNow()
+	}
+	queue, err := server.DownlinkQueueList(ctx, ids.EndDeviceIdentifiers)
+	a.So(err, should.BeNil)
+	if a.So(queue, should.HaveLength, 1) {
+		a.So(queue[0].FPort, should.Equal, 201)
+		a.So(queue[0].FRMPayload, should.Resemble, []byte{
+			fragSessionSetupCID, 0x11, 0x02, 0x00, 0x04, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00,
+		})
+	}
+	assertStatus(stateSetup, 0)
+
+	// Setting the association again does not restart the session.
+	a.So(handleAssociation(), should.BeNil)
+	queue, err = server.DownlinkQueueList(ctx, ids.EndDeviceIdentifiers)
+	a.So(err, should.BeNil)
+	a.So(queue, should.HaveLength, 1)
+
+	// The end device accepts the session; the fragments are queued for the multicast end device.
+	handleUp([]byte{fragSessionSetupCID, 0x40})
+	queue, err = server.DownlinkQueueList(ctx, mcIDs)
+	a.So(err, should.BeNil)
+	if a.So(queue, should.HaveLength, 3) {
+		a.So(queue[0].FRMPayload, should.Resemble, []byte{dataFragmentCID, 0x01, 0x40, 0x01, 0x02, 0x03, 0x04})
+		a.So(queue[1].FRMPayload, should.Resemble, []byte{dataFragmentCID, 0x02, 0x40, 0x05, 0x06, 0x07, 0x00})
+		a.So(queue[2].FRMPayload, should.Resemble, []byte{dataFragmentCID, 0x03, 0x40, 0x05, 0x06, 0x07, 0x00})
+	}
+	queue, err = server.DownlinkQueueList(ctx, ids.EndDeviceIdentifiers)
+	a.So(err, should.BeNil)
+	if a.So(queue, should.HaveLength, 2) {
+		a.So(queue[1].FRMPayload, should.Resemble, []byte{fragSessionStatusCID, 0x03})
+	}
+	assertStatus(stateTransmitting, 0)
+
+	// The end device reports progress.
+	handleUp([]byte{fragSessionStatusCID, 0x01, 0x40, 0x01, 0x00})
+	assertStatus(stateTransmitting, 50)
+
+	// The end device reconstructed the data block.
+	handleUp([]byte{fragSessionStatusCID, 0x02, 0x40, 0x00, 0x00})
+	assertStatus(stateCompleted, 100)
+
+	// The configuration is preserved.
+	var data packageData
+	a.So(data.fromStruct(getAssociation().Data), should.BeNil)
+	a.So(data.Data, should.Resemble, []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07})
+	a.So(data.MulticastDeviceID, should.Equal, mcIDs.DeviceID)
+}
+
+func TestSessionRejected(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	server := mock.NewServer(c)
+	cl, closeFn := test.NewBolt(t, "fragmentation")
+	defer closeFn()
+	registry := &bolt.ApplicationPackagesRegistry{Bolt: cl}
+	p := &FragmentationPackage{server, registry}
+
+	ids := ttnpb.ApplicationPackageAssociationIdentifiers{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+		},
+		FPort: 201,
+	}
+	st, err := packageData{
+		Data:  []byte{0x01, 0x02, 0x03},
+		State: stateSetup,
+	}.toStruct()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	assoc, err := registry.Set(ctx, ids, []string{
+		"data",
+		"ids",
+		"package_name",
+	}, func(*ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+		return &ttnpb.ApplicationPackageAssociation{
+			ApplicationPackageAssociationIdentifiers: ids,
+			PackageName:                              PackageName,
+			Data:                                     st,
+		}, []string{
+			"data",
+			"ids",
+			"package_name",
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	err = p.HandleUp(ctx, assoc, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ids.EndDeviceIdentifiers,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      201,
+				FRMPayload: []byte{fragSessionSetupCID, 0x02},
+			},
+		},
+	})
+	a.So(err, should.BeNil)
+
+	queue, err := server.DownlinkQueueList(ctx, ids.EndDeviceIdentifiers)
+	a.So(err, should.BeNil)
+	a.So(queue, should.BeEmpty)
+
+	status, err := p.getStatus(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(status.Fields["state"].GetStringValue(), should.Equal, string(stateFailed))
+	a.So(status.Fields["error"].GetStringValue(), should.Equal, "not enough memory")
+}
@@ -78,17 +78,37 @@ func (s *server) SetAssociation(ctx context.Context, req *ttnpb.SetApplicationPa
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_PACKAGES); err != nil {
 		return nil, err
 	}
-	return s.registry.Set(ctx, req.ApplicationPackageAssociationIdentifiers, appendImplicitAssociationsGetPaths(req.FieldMask.Paths...),
-		func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
-			if assoc != nil {
-				return &req.ApplicationPackageAssociation, req.FieldMask.Paths, nil
+	assoc, err := s.registry.Set(ctx, req.ApplicationPackageAssociationIdentifiers, append(appendImplicitAssociationsGetPaths(req.FieldMask.Paths...), "data"),
+		func(stored *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			assoc, sets := &req.ApplicationPackageAssociation, req.FieldMask.Paths
+			if stored != nil {
+				if err := stored.SetFields(assoc, sets...); err != nil {
+					return nil, nil, err
+				}
+				assoc = stored
+			} else {
+				sets = append(sets,
+					"ids.end_device_ids",
+					"ids.f_port",
+				)
+			}
+			handled, err := s.handleAssociation(ctx, assoc)
+			if err != nil {
+				return nil, nil, err
 			}
-			return &req.ApplicationPackageAssociation, append(req.FieldMask.Paths,
-				"ids.end_device_ids",
-				"ids.f_port",
-			), nil
+			if handled && !ttnpb.HasAnyField(sets, "data") {
+				sets = append(sets, "data")
+			}
+			return assoc, sets, nil
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	if !ttnpb.HasAnyField(req.FieldMask.Paths, "data") {
+		assoc.Data = nil
+	}
+	return assoc, nil
 }
 
 // DeleteAssociation implements ttnpb.ApplicationPackageRegistryServer.
@@ -0,0 +1,266 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+const (
+	// packageIdentifier is the identifier of the Remote Multicast Setup package.
+	packageIdentifier = 2
+	// packageVersion is the version of the Remote Multicast Setup package.
+	packageVersion = 1
+
+	packageVersionCID     = 0x00
+	mcGroupStatusCID      = 0x01
+	mcGroupSetupCID       = 0x02
+	mcGroupDeleteCID      = 0x03
+	mcClassCSessionCID    = 0x04
+	mcClassBSessionCID    = 0x05
+	mcGroupStatusItemSize = 5
+)
+
+var (
+	errUnknownCommand = errors.DefineInvalidArgument("unknown_command", "unknown command with CID `{cid}`")
+	errCommandLength  = errors.DefineInvalidArgument("command_length", "command with CID `{cid}` needs {expected} bytes, got {actual}")
+)
+
+// packageVersionAns is the PackageVersionAns uplink command.
+type packageVersionAns struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// mcGroupStatusAns is the McGroupStatusAns uplink command.
+type mcGroupStatusAns struct {
+	NbTotalGroups uint8
+	// McAddrs are the multicast addresses of the active multicast groups, indexed by multicast group ID.
+	McAddrs map[uint8]types.DevAddr
+}
+
+// mcGroupSetupAns is the McGroupSetupAns uplink command.
+type mcGroupSetupAns struct {
+	McGroupID uint8
+	IDError   bool
+}
+
+// mcGroupDeleteAns is the McGroupDeleteAns uplink command.
+type mcGroupDeleteAns struct {
+	McGroupID        uint8
+	McGroupUndefined bool
+}
+
+// mcSessionAns is the McClassCSessionAns or McClassBSessionAns uplink command.
+type mcSessionAns struct {
+	McGroupID        uint8
+	DRError          bool
+	FreqError        bool
+	McGroupUndefined bool
+	// TimeToStart is the number of seconds until the session starts. It is only set if there is no error.
+	TimeToStart uint32
+}
+
+// OK returns whether the end device accepted the session.
+func (ans mcSessionAns) OK() bool {
+	return !ans.DRError && !ans.FreqError && !ans.McGroupUndefined
+}
+
+// command is a decoded uplink command. Exactly one of the fields is set.
+type command struct {
+	PackageVersionAns  *packageVersionAns
+	McGroupStatusAns   *mcGroupStatusAns
+	McGroupSetupAns    *mcGroupSetupAns
+	McGroupDeleteAns   *mcGroupDeleteAns
+	McClassCSessionAns *mcSessionAns
+	McClassBSessionAns *mcSessionAns
+}
+
+func checkLength(cid byte, b []byte, n int) error {
+	if len(b) < n {
+		return errCommandLength.WithAttributes(
+			"cid", cid,
+			"expected", n,
+			"actual", len(b),
+		)
+	}
+	return nil
+}
+
+func decodeSessionAns(cid byte, b []byte) (*mcSessionAns, []byte, error) {
+	if err := checkLength(cid, b, 1); err != nil {
+		return nil, nil, err
+	}
+	ans := &mcSessionAns{
+		McGroupID:        b[0] & 0x3,
+		DRError:          b[0]&0x4 != 0,
+		FreqError:        b[0]&0x8 != 0,
+		McGroupUndefined: b[0]&0x10 != 0,
+	}
+	b = b[1:]
+	if !ans.OK() {
+		return ans, b, nil
+	}
+	if err := checkLength(cid, b, 3); err != nil {
+		return nil, nil, err
+	}
+	ans.TimeToStart = uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+	return ans, b[3:], nil
+}
+
+// decodeCommands decodes the uplink commands in b.
+func decodeCommands(b []byte) ([]command, error) {
+	var cmds []command
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		switch cid {
+		case packageVersionCID:
+			if err := checkLength(cid, b, 2); err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, command{PackageVersionAns: &packageVersionAns{
+				PackageIdentifier: b[0],
+				PackageVersion:    b[1],
+			}})
+			b = b[2:]
+
+		case mcGroupStatusCID:
+			if err := checkLength(cid, b, 1); err != nil {
+				return nil, err
+			}
+			ans := &mcGroupStatusAns{
+				NbTotalGroups: (b[0] >> 4) & 0x7,
+				McAddrs:       make(map[uint8]types.DevAddr),
+			}
+			mask := b[0] & 0xf
+			b = b[1:]
+			for i := uint8(0); i < 4; i++ {
+				if mask&(1<<i) == 0 {
+					continue
+				}
+				if err := checkLength(cid, b, mcGroupStatusItemSize); err != nil {
+					return nil, err
+				}
+				var addr types.DevAddr
+				binary.BigEndian.PutUint32(addr[:], binary.LittleEndian.Uint32(b[1:5]))
+				ans.McAddrs[b[0]&0x3] = addr
+				b = b[mcGroupStatusItemSize:]
+			}
+			cmds = append(cmds, command{McGroupStatusAns: ans})
+
+		case mcGroupSetupCID:
+			if err := checkLength(cid, b, 1); err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, command{McGroupSetupAns: &mcGroupSetupAns{
+				McGroupID: b[0] & 0x3,
+				IDError:   b[0]&0x4 != 0,
+			}})
+			b = b[1:]
+
+		case mcGroupDeleteCID:
+			if err := checkLength(cid, b, 1); err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, command{McGroupDeleteAns: &mcGroupDeleteAns{
+				McGroupID:        b[0] & 0x3,
+				McGroupUndefined: b[0]&0x4 != 0,
+			}})
+			b = b[1:]
+
+		case mcClassCSessionCID:
+			ans, rest, err := decodeSessionAns(cid, b)
+			if err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, command{McClassCSessionAns: ans})
+			b = rest
+
+		case mcClassBSessionCID:
+			ans, rest, err := decodeSessionAns(cid, b)
+			if err != nil {
+				return nil, err
+			}
+			cmds = append(cmds, command{McClassBSessionAns: ans})
+			b = rest
+
+		default:
+			return nil, errUnknownCommand.WithAttributes("cid", cid)
+		}
+	}
+	return cmds, nil
+}
+
+// mcGroupSetupReq is the McGroupSetupReq downlink command.
+type mcGroupSetupReq struct {
+	McGroupID      uint8
+	McAddr         types.DevAddr
+	McKeyEncrypted types.AES128Key
+	MinMcFCount    uint32
+	MaxMcFCount    uint32
+}
+
+// mcSessionReq is the McClassCSessionReq or McClassBSessionReq downlink command.
+type mcSessionReq struct {
+	McGroupID uint8
+	// SessionTime is the start of the session in GPS seconds, modulo 2^32.
+	SessionTime    uint32
+	SessionTimeout uint8
+	// Periodicity is the ping slot periodicity. It is only used for class B sessions.
+	Periodicity uint8
+	// Frequency is the downlink frequency in Hz.
+	Frequency     uint64
+	DataRateIndex uint8
+}
+
+// appendMcGroupSetupReq appends the McGroupSetupReq downlink command to b.
+func appendMcGroupSetupReq(b []byte, req mcGroupSetupReq) []byte {
+	b = append(b, mcGroupSetupCID, req.McGroupID&0x3)
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], binary.BigEndian.Uint32(req.McAddr[:]))
+	b = append(b, buf[:]...)
+	b = append(b, req.McKeyEncrypted[:]...)
+	binary.LittleEndian.PutUint32(buf[:], req.MinMcFCount)
+	b = append(b, buf[:]...)
+	binary.LittleEndian.PutUint32(buf[:], req.MaxMcFCount)
+	return append(b, buf[:]...)
+}
+
+func appendSessionReq(b []byte, cid byte, req mcSessionReq, timeout byte) []byte {
+	b = append(b, cid, req.McGroupID&0x3)
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], req.SessionTime)
+	b = append(b, buf[:]...)
+	freq := req.Frequency / 100
+	return append(b,
+		timeout,
+		byte(freq), byte(freq>>8), byte(freq>>16),
+		req.DataRateIndex,
+	)
+}
+
+// appendMcClassCSessionReq appends the McClassCSessionReq downlink command to b.
+func appendMcClassCSessionReq(b []byte, req mcSessionReq) []byte {
+	return appendSessionReq(b, mcClassCSessionCID, req, req.SessionTimeout&0xf)
+}
+
+// appendMcClassBSessionReq appends the McClassBSessionReq downlink command to b.
+func appendMcClassBSessionReq(b []byte, req mcSessionReq) []byte {
+	return appendSessionReq(b, mcClassBSessionCID, req, (req.Periodicity&0x7)<<4|req.SessionTimeout&0xf)
+}
@@ -0,0 +1,167 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"encoding/json"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// sessionState is the state of a multicast session.
+type sessionState string
+
+const (
+	// stateGroupSetup means that the McGroupSetupReq has been queued.
+	stateGroupSetup sessionState = "group_setup"
+	// stateSessionSetup means that the end device accepted the multicast group and the session request has been queued.
+	stateSessionSetup sessionState = "session_setup"
+	// stateSessionScheduled means that the end device accepted the session.
+	stateSessionScheduled sessionState = "session_scheduled"
+	// stateFailed means that the end device rejected the multicast group or the session.
+	stateFailed sessionState = "failed"
+	// stateDeleted means that the end device deleted the multicast group.
+	stateDeleted sessionState = "deleted"
+)
+
+const (
+	sessionClassB = "B"
+	sessionClassC = "C"
+
+	// defaultSessionDelay is the default time between the multicast group setup answer and the start of the session.
+	defaultSessionDelay = 5 * time.Minute
+)
+
+// packageData is the data of a package association.
+// The multicast group and session parameters are configured by the user,
+// while the remaining fields track the state of the multicast session.
+type packageData struct {
+	McKEKey        *types.AES128Key `json:"mc_ke_key,omitempty"`
+	McGroupID      uint8            `json:"mc_group_id,omitempty"`
+	McAddr         types.DevAddr    `json:"mc_addr"`
+	McKey          *types.AES128Key `json:"mc_key,omitempty"`
+	MinMcFCount    uint32           `json:"min_mc_fcnt,omitempty"`
+	MaxMcFCount    uint32           `json:"max_mc_fcnt,omitempty"`
+	SessionClass   string           `json:"session_class,omitempty"`
+	SessionTime    *time.Time       `json:"session_time,omitempty"`
+	SessionTimeout uint8            `json:"session_timeout,omitempty"`
+	Periodicity    uint8            `json:"periodicity,omitempty"`
+	Frequency      uint64           `json:"frequency,omitempty"`
+	DataRateIndex  uint8            `json:"data_rate_index,omitempty"`
+
+	State           sessionState     `json:"state,omitempty"`
+	McAppSKey       *types.AES128Key `json:"mc_app_s_key,omitempty"`
+	McNwkSKey       *types.AES128Key `json:"mc_nwk_s_key,omitempty"`
+	SessionStartsAt *time.Time       `json:"session_starts_at,omitempty"`
+	Error           string           `json:"error,omitempty"`
+}
+
+// sessionClass returns the configured session class, or class C.
+func (d packageData) sessionClass() string {
+	if d.SessionClass == "" {
+		return sessionClassC
+	}
+	return d.SessionClass
+}
+
+// maxMcFCount returns the configured maximum multicast frame counter, or the maximum value.
+func (d packageData) maxMcFCount() uint32 {
+	if d.MaxMcFCount == 0 {
+		return 0xffffffff
+	}
+	return d.MaxMcFCount
+}
+
+// setState copies the session state of src to d.
+func (d *packageData) setState(src packageData) {
+	d.State = src.State
+	d.McAppSKey = src.McAppSKey
+	d.McNwkSKey = src.McNwkSKey
+	d.SessionStartsAt = src.SessionStartsAt
+	d.Error = src.Error
+}
+
+var (
+	errInvalidData         = errors.DefineInvalidArgument("invalid_data", "invalid package data")
+	errMissingField        = errors.DefineInvalidArgument("missing_field", "missing field `{field}`")
+	errInvalidFieldValue   = errors.DefineInvalidArgument("invalid_field_value", "field `{field}` has the invalid value `{value}`")
+	errInvalidSessionClass = errors.DefineInvalidArgument("invalid_session_class", "invalid session class `{class}`")
+)
+
+// validate validates the configuration of the package data.
+func (d packageData) validate() error {
+	if d.McKEKey == nil {
+		return errMissingField.WithAttributes("field", "mc_ke_key")
+	}
+	if d.McKey == nil {
+		return errMissingField.WithAttributes("field", "mc_key")
+	}
+	if d.Frequency == 0 {
+		return errMissingField.WithAttributes("field", "frequency")
+	}
+	if d.McGroupID > 3 {
+		return errInvalidFieldValue.WithAttributes("field", "mc_group_id", "value", d.McGroupID)
+	}
+	if d.SessionTimeout > 15 {
+		return errInvalidFieldValue.WithAttributes("field", "session_timeout", "value", d.SessionTimeout)
+	}
+	if d.Periodicity > 7 {
+		return errInvalidFieldValue.WithAttributes("field", "periodicity", "value", d.Periodicity)
+	}
+	if d.Frequency/100 > 0xffffff {
+		return errInvalidFieldValue.WithAttributes("field", "frequency", "value", d.Frequency)
+	}
+	if d.maxMcFCount() < d.MinMcFCount {
+		return errInvalidFieldValue.WithAttributes("field", "max_mc_fcnt", "value", d.MaxMcFCount)
+	}
+	switch d.sessionClass() {
+	case sessionClassB, sessionClassC:
+	default:
+		return errInvalidSessionClass.WithAttributes("class", d.SessionClass)
+	}
+	return nil
+}
+
+func (d packageData) toStruct() (*pbtypes.Struct, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return gogoproto.Struct(m)
+}
+
+func (d *packageData) fromStruct(st *pbtypes.Struct) error {
+	m, err := gogoproto.Map(st)
+	if err != nil {
+		return errInvalidData.WithCause(err)
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return errInvalidData.WithCause(err)
+	}
+	*d = packageData{}
+	if err := json.Unmarshal(b, d); err != nil {
+		return errInvalidData.WithCause(err)
+	}
+	return nil
+}
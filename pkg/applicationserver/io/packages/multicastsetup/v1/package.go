@@ -0,0 +1,317 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multicastsetupv1 implements the LoRaWAN Remote Multicast Setup v1.0.0 (TS005) application package.
+package multicastsetupv1
+
+import (
+	"context"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName is the name of the Remote Multicast Setup package.
+const PackageName = "multicastsetup-v1"
+
+// MulticastSetupPackage is the Remote Multicast Setup package.
+type MulticastSetupPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *MulticastSetupPackage) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterApplicationMulticastSetupPackageServer(s, p)
+}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *MulticastSetupPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterApplicationMulticastSetupPackageHandler(context.Background(), s, conn)
+}
+
+var errAssociationNotFound = errors.DefineNotFound("association_not_found", "association with package `{package}` not found")
+
+// GetStatus implements ttnpb.ApplicationMulticastSetupPackageServer.
+func (p *MulticastSetupPackage) GetStatus(ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers) (*pbtypes.Struct, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_PACKAGES); err != nil {
+		return nil, err
+	}
+	return p.getStatus(ctx, *ids)
+}
+
+// getStatus returns the state of the multicast session of the association identified by ids.
+// The status contains the multicast session keys, which are needed to create the multicast end device.
+func (p *MulticastSetupPackage) getStatus(ctx context.Context, ids ttnpb.ApplicationPackageAssociationIdentifiers) (*pbtypes.Struct, error) {
+	assoc, err := p.registry.Get(ctx, ids, []string{
+		"data",
+		"package_name",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if assoc.PackageName != PackageName {
+		return nil, errAssociationNotFound.WithAttributes("package", PackageName)
+	}
+	var data packageData
+	if err := data.fromStruct(assoc.Data); err != nil {
+		return nil, err
+	}
+	status := map[string]interface{}{
+		"state":         string(data.State),
+		"mc_group_id":   data.McGroupID,
+		"mc_addr":       data.McAddr.String(),
+		"session_class": data.sessionClass(),
+		"error":         data.Error,
+	}
+	if data.McAppSKey != nil {
+		status["mc_app_s_key"] = data.McAppSKey.String()
+	}
+	if data.McNwkSKey != nil {
+		status["mc_nwk_s_key"] = data.McNwkSKey.String()
+	}
+	if data.SessionStartsAt != nil {
+		status["session_starts_at"] = data.SessionStartsAt.UTC().Format(time.RFC3339)
+	}
+	return gogoproto.Struct(status)
+}
+
+// updateState updates the session state stored in the association identified by ids to the state of data.
+// The configuration of the stored association is preserved.
+func (p *MulticastSetupPackage) updateState(ctx context.Context, ids ttnpb.ApplicationPackageAssociationIdentifiers, data packageData) error {
+	_, err := p.registry.Set(ctx, ids, []string{"data"},
+		func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			if assoc == nil {
+				return nil, nil, nil
+			}
+			var stored packageData
+			if err := stored.fromStruct(assoc.Data); err != nil {
+				return nil, nil, err
+			}
+			stored.setState(data)
+			st, err := stored.toStruct()
+			if err != nil {
+				return nil, nil, err
+			}
+			assoc.Data = st
+			return assoc, []string{"data"}, nil
+		},
+	)
+	return err
+}
+
+// HandleAssociation implements packages.AssociationHandler.
+// If the association has a multicast key and no session state, the multicast group is set up with the end device.
+func (p *MulticastSetupPackage) HandleAssociation(ctx context.Context, assoc *ttnpb.ApplicationPackageAssociation) error {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/multicastsetup/v1")
+	logger := log.FromContext(ctx)
+
+	var data packageData
+	if err := data.fromStruct(assoc.Data); err != nil {
+		return err
+	}
+	if data.McKey == nil || data.State != "" {
+		return nil
+	}
+	if err := data.validate(); err != nil {
+		return err
+	}
+
+	if err := p.server.DownlinkQueuePush(ctx, assoc.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink{{
+		FPort: assoc.FPort,
+		FRMPayload: appendMcGroupSetupReq(nil, mcGroupSetupReq{
+			McGroupID:      data.McGroupID,
+			McAddr:         data.McAddr,
+			McKeyEncrypted: crypto.EncryptMcKey(*data.McKEKey, *data.McKey),
+			MinMcFCount:    data.MinMcFCount,
+			MaxMcFCount:    data.maxMcFCount(),
+		}),
+	}}); err != nil {
+		logger.WithError(err).Debug("Failed to push multicast group setup request")
+		return err
+	}
+	logger.WithFields(log.Fields(
+		"mc_group_id", data.McGroupID,
+		"mc_addr", data.McAddr,
+	)).Debug("Multicast group setup requested")
+
+	mcAppSKey := crypto.DeriveMcAppSKey(*data.McKey, data.McAddr)
+	mcNwkSKey := crypto.DeriveMcNwkSKey(*data.McKey, data.McAddr)
+	data.State = stateGroupSetup
+	data.McAppSKey = &mcAppSKey
+	data.McNwkSKey = &mcNwkSKey
+	st, err := data.toStruct()
+	if err != nil {
+		return err
+	}
+	assoc.Data = st
+	return nil
+}
+
+// sessionReq returns the session request of the configured session class.
+// If no session time is configured, the session starts defaultSessionDelay after now.
+func sessionReq(data packageData, now time.Time) []byte {
+	sessionTime := now.Add(defaultSessionDelay)
+	if data.SessionTime != nil {
+		sessionTime = *data.SessionTime
+	}
+	req := mcSessionReq{
+		McGroupID:      data.McGroupID,
+		SessionTime:    uint32(gpstime.ToGPS(sessionTime) / time.Second),
+		SessionTimeout: data.SessionTimeout,
+		Periodicity:    data.Periodicity,
+		Frequency:      data.Frequency,
+		DataRateIndex:  data.DataRateIndex,
+	}
+	if data.sessionClass() == sessionClassB {
+		return appendMcClassBSessionReq(nil, req)
+	}
+	return appendMcClassCSessionReq(nil, req)
+}
+
+// handleSessionAns updates state with the session answer ans received at receivedAt.
+func handleSessionAns(ctx context.Context, state *packageData, ans *mcSessionAns, receivedAt time.Time) {
+	logger := log.FromContext(ctx)
+	if !ans.OK() {
+		state.State = stateFailed
+		switch {
+		case ans.McGroupUndefined:
+			state.Error = "multicast group undefined"
+		case ans.FreqError:
+			state.Error = "frequency not supported"
+		case ans.DRError:
+			state.Error = "data rate not supported"
+		}
+		logger.WithField("error", state.Error).Warn("End device rejected multicast session")
+		return
+	}
+	startsAt := receivedAt.Add(time.Duration(ans.TimeToStart) * time.Second)
+	state.State = stateSessionScheduled
+	state.SessionStartsAt = &startsAt
+	logger.WithField("session_starts_at", startsAt).Debug("Multicast session scheduled")
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *MulticastSetupPackage) HandleUp(ctx context.Context, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) error {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/multicastsetup/v1")
+	logger := log.FromContext(ctx)
+
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	var data packageData
+	if err := data.fromStruct(assoc.Data); err != nil {
+		logger.WithError(err).Debug("Failed to parse package data")
+		return err
+	}
+	cmds, err := decodeCommands(msg.FRMPayload)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to decode commands")
+		return err
+	}
+
+	state, changed := data, false
+	for _, cmd := range cmds {
+		switch {
+		case cmd.PackageVersionAns != nil:
+			logger.WithFields(log.Fields(
+				"package_identifier", cmd.PackageVersionAns.PackageIdentifier,
+				"package_version", cmd.PackageVersionAns.PackageVersion,
+			)).Debug("Received package version")
+
+		case cmd.McGroupStatusAns != nil:
+			logger.WithFields(log.Fields(
+				"nb_total_groups", cmd.McGroupStatusAns.NbTotalGroups,
+				"nb_active_groups", len(cmd.McGroupStatusAns.McAddrs),
+			)).Debug("Received multicast group status")
+
+		case cmd.McGroupSetupAns != nil:
+			ans := cmd.McGroupSetupAns
+			if ans.McGroupID != data.McGroupID || state.State != stateGroupSetup {
+				logger.WithField("mc_group_id", ans.McGroupID).Debug("Ignore multicast group setup answer")
+				continue
+			}
+			changed = true
+			if ans.IDError {
+				state.State = stateFailed
+				state.Error = "multicast group ID not supported"
+				logger.WithField("error", state.Error).Warn("End device rejected multicast group")
+				continue
+			}
+			if err := p.server.DownlinkQueuePush(ctx, assoc.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink{{
+				FPort:      assoc.FPort,
+				FRMPayload: sessionReq(data, msg.ReceivedAt),
+			}}); err != nil {
+				logger.WithError(err).Debug("Failed to push multicast session request")
+				return err
+			}
+			state.State = stateSessionSetup
+			logger.WithField("session_class", data.sessionClass()).Debug("Multicast session requested")
+
+		case cmd.McClassCSessionAns != nil, cmd.McClassBSessionAns != nil:
+			ans := cmd.McClassCSessionAns
+			if ans == nil {
+				ans = cmd.McClassBSessionAns
+			}
+			if ans.McGroupID != data.McGroupID || state.State != stateSessionSetup {
+				logger.WithField("mc_group_id", ans.McGroupID).Debug("Ignore multicast session answer")
+				continue
+			}
+			handleSessionAns(ctx, &state, ans, msg.ReceivedAt)
+			changed = true
+
+		case cmd.McGroupDeleteAns != nil:
+			ans := cmd.McGroupDeleteAns
+			if ans.McGroupID != data.McGroupID {
+				continue
+			}
+			state.State = stateDeleted
+			changed = true
+			logger.WithField("mc_group_undefined", ans.McGroupUndefined).Debug("Multicast group deleted")
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := p.updateState(ctx, assoc.ApplicationPackageAssociationIdentifiers, state); err != nil {
+		logger.WithError(err).Debug("Failed to update package data")
+		return err
+	}
+	return nil
+}
+
+func init() {
+	p := ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 200,
+	}
+	packages.RegisterPackage(p, packages.CreateApplicationPackage(
+		func(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+			return &MulticastSetupPackage{server, registry}
+		},
+	))
+}
@@ -0,0 +1,246 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mock"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/bolt"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDecodeCommands(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Payload        []byte
+		Commands       []command
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:     "Empty",
+			Payload:  []byte{},
+			Commands: nil,
+		},
+		{
+			Name:    "PackageVersionAns/McGroupSetupAns",
+			Payload: []byte{0x00, 0x02, 0x01, 0x02, 0x05},
+			Commands: []command{
+				{PackageVersionAns: &packageVersionAns{
+					PackageIdentifier: packageIdentifier,
+					PackageVersion:    packageVersion,
+				}},
+				{McGroupSetupAns: &mcGroupSetupAns{
+					McGroupID: 1,
+					IDError:   true,
+				}},
+			},
+		},
+		{
+			Name:    "McGroupStatusAns",
+			Payload: []byte{0x01, 0x12, 0x01, 0x04, 0x03, 0x02, 0x01},
+			Commands: []command{
+				{McGroupStatusAns: &mcGroupStatusAns{
+					NbTotalGroups: 1,
+					McAddrs: map[uint8]types.DevAddr{
+						1: {0x01, 0x02, 0x03, 0x04},
+					},
+				}},
+			},
+		},
+		{
+			Name:    "McClassCSessionAns/McClassBSessionAns",
+			Payload: []byte{0x04, 0x01, 0x3c, 0x00, 0x00, 0x05, 0x0a},
+			Commands: []command{
+				{McClassCSessionAns: &mcSessionAns{
+					McGroupID:   1,
+					TimeToStart: 60,
+				}},
+				{McClassBSessionAns: &mcSessionAns{
+					McGroupID: 2,
+					FreqError: true,
+				}},
+			},
+		},
+		{
+			Name:           "McClassCSessionAns/Short",
+			Payload:        []byte{0x04, 0x01, 0x3c},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "Unknown",
+			Payload:        []byte{0x42},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			cmds, err := decodeCommands(tc.Payload)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(cmds, should.Resemble, tc.Commands)
+		})
+	}
+}
+
+func TestSession(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	server := mock.NewServer(c)
+	cl, closeFn := test.NewBolt(t, "multicastsetup")
+	defer closeFn()
+	registry := &bolt.ApplicationPackagesRegistry{Bolt: cl}
+	p := &MulticastSetupPackage{server, registry}
+
+	ids := ttnpb.ApplicationPackageAssociationIdentifiers{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+		},
+		FPort: 200,
+	}
+	mcKEKey := types.AES128Key{0x56, 0xF2, 0xC0, 0x4B, 0x44, 0x2E, 0x65, 0x11, 0x91, 0xE1, 0xB1, 0xC1, 0x3D, 0x8D, 0x9A, 0x2B}
+	mcKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}
+	mcAddr := types.DevAddr{0x01, 0x02, 0x03, 0x04}
+	sessionTime := time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC)
+	st, err := packageData{
+		McKEKey:        &mcKEKey,
+		McGroupID:      1,
+		McAddr:         mcAddr,
+		McKey:          &mcKey,
+		MaxMcFCount:    1000,
+		SessionTime:    &sessionTime,
+		SessionTimeout: 8,
+		Frequency:      869525000,
+		DataRateIndex:  3,
+	}.toStruct()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = registry.Set(ctx, ids, nil, func(*ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+		return &ttnpb.ApplicationPackageAssociation{
+			ApplicationPackageAssociationIdentifiers: ids,
+			PackageName:                              PackageName,
+			Data:                                     st,
+		}, []string{
+			"data",
+			"ids",
+			"package_name",
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	getAssociation := func() *ttnpb.ApplicationPackageAssociation {
+		assoc, err := registry.Get(ctx, ids, []string{
+			"data",
+			"ids",
+			"package_name",
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return assoc
+	}
+	handleAssociation := func() error {
+		_, err := registry.Set(ctx, ids, []string{
+			"data",
+			"ids",
+			"package_name",
+		}, func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			if err := p.HandleAssociation(ctx, assoc); err != nil {
+				return nil, nil, err
+			}
+			return assoc, []string{"data"}, nil
+		})
+		return err
+	}
+	handleUp := func(receivedAt time.Time, payload []byte) {
+		err := p.HandleUp(ctx, getAssociation(), &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids.EndDeviceIdentifiers,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      200,
+					FRMPayload: payload,
+					ReceivedAt: receivedAt,
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	// Set up the multicast group.
+	if !a.So(handleAssociation(), should.BeNil) {
+		t.FailNow()
+	}
+	queue, err := server.DownlinkQueueList(ctx, ids.EndDeviceIdentifiers)
+	a.So(err, should.BeNil)
+	if a.So(queue, should.HaveLength, 1) {
+		encryptedMcKey := crypto.EncryptMcKey(mcKEKey, mcKey)
+		expected := append([]byte{mcGroupSetupCID, 0x01, 0x04, 0x03, 0x02, 0x01}, encryptedMcKey[:]...)
+		expected = append(expected, 0x00, 0x00, 0x00, 0x00, 0xe8, 0x03, 0x00, 0x00)
+		a.So(queue[0].FPort, should.Equal, 200)
+		a.So(queue[0].FRMPayload, should.Resemble, expected)
+	}
+
+	status, err := p.getStatus(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(status.Fields["state"].GetStringValue(), should.Equal, string(stateGroupSetup))
+	a.So(status.Fields["mc_app_s_key"].GetStringValue(), should.Equal, crypto.DeriveMcAppSKey(mcKey, mcAddr).String())
+	a.So(status.Fields["mc_nwk_s_key"].GetStringValue(), should.Equal, crypto.DeriveMcNwkSKey(mcKey, mcAddr).String())
+
+	// The end device accepts the multicast group; the class C session is requested.
+	receivedAt := sessionTime.Add(-time.Hour)
+	handleUp(receivedAt, []byte{mcGroupSetupCID, 0x01})
+	queue, err = server.DownlinkQueueList(ctx, ids.EndDeviceIdentifiers)
+	a.So(err, should.BeNil)
+	if a.So(queue, should.HaveLength, 2) {
+		expected := []byte{mcClassCSessionCID, 0x01, 0x00, 0x00, 0x00, 0x00, 0x08, 0xd2, 0xad, 0x84, 0x03}
+		binary.LittleEndian.PutUint32(expected[2:6], uint32(gpstime.ToGPS(sessionTime)/time.Second))
+		a.So(queue[1].FRMPayload, should.Resemble, expected)
+	}
+
+	// The end device accepts the session.
+	handleUp(receivedAt, []byte{mcClassCSessionCID, 0x01, 0x10, 0x0e, 0x00})
+	status, err = p.getStatus(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(status.Fields["state"].GetStringValue(), should.Equal, string(stateSessionScheduled))
+	a.So(status.Fields["session_starts_at"].GetStringValue(), should.Equal, sessionTime.Format(time.RFC3339))
+
+	// The end device deletes the multicast group.
+	handleUp(receivedAt, []byte{mcGroupDeleteCID, 0x01})
+	status, err = p.getStatus(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(status.Fields["state"].GetStringValue(), should.Equal, string(stateDeleted))
+}
@@ -84,6 +84,23 @@ func (s *server) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error {
 	return nil
 }
 
+// handleAssociation calls the association handler of the package of the association, if any.
+// The association handler may update the data of the association. handleAssociation returns whether it is called.
+func (s *server) handleAssociation(ctx context.Context, assoc *ttnpb.ApplicationPackageAssociation) (bool, error) {
+	handler, ok := s.handlers[assoc.PackageName].(AssociationHandler)
+	if !ok {
+		return false, nil
+	}
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"device_uid", unique.ID(ctx, assoc.EndDeviceIdentifiers),
+		"package", assoc.PackageName,
+	))
+	if err := handler.HandleAssociation(ctx, assoc); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // Roles implements the rpcserver.Registerer interface.
 func (s *server) Roles() []ttnpb.ClusterRole {
 	return nil
@@ -31,6 +31,13 @@ type ApplicationPackageHandler interface {
 	HandleUp(context.Context, *ttnpb.ApplicationPackageAssociation, *ttnpb.ApplicationUp) error
 }
 
+// AssociationHandler is an optional interface of ApplicationPackageHandler.
+// HandleAssociation is called when an association with the package is created or updated, before it is stored.
+// HandleAssociation may update the data of the association, which is stored with the association.
+type AssociationHandler interface {
+	HandleAssociation(context.Context, *ttnpb.ApplicationPackageAssociation) error
+}
+
 // CreateApplicationPackage is a function that creates a traffic handler for a given package.
 type CreateApplicationPackage func(io.Server, Registry) ApplicationPackageHandler
 
@@ -0,0 +1,262 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides a bbolt implementation of the PubSub registry.
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"go.etcd.io/bbolt"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub"
+	ttnbolt "go.thethings.network/lorawan-stack/pkg/bolt"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+)
+
+// appendImplicitPubSubGetPaths appends implicit ttnpb.ApplicationPubSub get paths to paths.
+func appendImplicitPubSubGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 4+len(paths)),
+		"created_at",
+		"ids",
+		"provider",
+		"updated_at",
+	), paths...)
+}
+
+func applyPubSubFieldMask(dst, src *ttnpb.ApplicationPubSub, paths ...string) (*ttnpb.ApplicationPubSub, error) {
+	if dst == nil {
+		dst = &ttnpb.ApplicationPubSub{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// PubSubRegistry is a bbolt PubSub registry.
+type PubSubRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+func (r *PubSubRegistry) allPrefix() []byte {
+	return r.Bolt.Key("uid", "")
+}
+
+func (r *PubSubRegistry) appPrefix(uid string) []byte {
+	return r.Bolt.Key("uid", uid, "")
+}
+
+func (r *PubSubRegistry) uidKey(appUID, id string) []byte {
+	return r.Bolt.Key("uid", pubsub.PubSubUID(appUID, id))
+}
+
+// Get implements pubsub.Registry.
+func (r PubSubRegistry) Get(ctx context.Context, ids ttnpb.ApplicationPubSubIdentifiers, paths []string) (*ttnpb.ApplicationPubSub, error) {
+	pb := &ttnpb.ApplicationPubSub{}
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.GetProto(b, r.uidKey(unique.ID(ctx, ids.ApplicationIdentifiers), ids.PubSubID), pb)
+	}); err != nil {
+		return nil, err
+	}
+	return applyPubSubFieldMask(nil, pb, appendImplicitPubSubGetPaths(paths...)...)
+}
+
+var errApplicationUID = errors.DefineCorruption("application_uid", "invalid application UID `{application_uid}`")
+
+// Range implements pubsub.Registry.
+func (r PubSubRegistry) Range(ctx context.Context, paths []string, f func(context.Context, ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationPubSub) bool) error {
+	prefix := r.allPrefix()
+	var uids []string
+	var pbs []*ttnpb.ApplicationPubSub
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.ForEachPrefix(b, prefix, func(k, v []byte) (bool, error) {
+			pb := &ttnpb.ApplicationPubSub{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return false, err
+			}
+			uids = append(uids, string(bytes.TrimPrefix(k, prefix)))
+			pbs = append(pbs, pb)
+			return true, nil
+		})
+	}); err != nil {
+		return err
+	}
+	for i, uid := range uids {
+		appUID, psID := pubsub.SplitPubSubUID(uid)
+		ctx, err := unique.WithContext(ctx, appUID)
+		if err != nil {
+			return errApplicationUID.WithCause(err).WithAttributes("application_uid", appUID, "pub_sub_id", psID)
+		}
+		ids, err := unique.ToApplicationID(appUID)
+		if err != nil {
+			return errApplicationUID.WithCause(err).WithAttributes("application_uid", appUID, "pub_sub_id", psID)
+		}
+		pb, err := applyPubSubFieldMask(nil, pbs[i], paths...)
+		if err != nil {
+			return err
+		}
+		if !f(ctx, ids, pb) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// List implements pubsub.Registry.
+func (r PubSubRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationPubSub, error) {
+	var pbs []*ttnpb.ApplicationPubSub
+	err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.ForEachPrefix(b, r.appPrefix(unique.ID(ctx, ids)), func(_, v []byte) (bool, error) {
+			pb := &ttnpb.ApplicationPubSub{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return false, err
+			}
+			pb, err := applyPubSubFieldMask(nil, pb, appendImplicitPubSubGetPaths(paths...)...)
+			if err != nil {
+				return false, err
+			}
+			pbs = append(pbs, pb)
+			return true, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbs, nil
+}
+
+// Set implements pubsub.Registry.
+func (r PubSubRegistry) Set(ctx context.Context, ids ttnpb.ApplicationPubSubIdentifiers, gets []string, f func(*ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error)) (*ttnpb.ApplicationPubSub, error) {
+	appUID := unique.ID(ctx, ids.ApplicationIdentifiers)
+	ik := r.uidKey(appUID, ids.PubSubID)
+
+	var pb *ttnpb.ApplicationPubSub
+	err := r.Bolt.Watch(func(tx *ttnbolt.WatchTx) (func(*bbolt.Bucket) error, error) {
+		v, err := tx.Get(ik)
+		if err != nil {
+			return nil, err
+		}
+		var stored *ttnpb.ApplicationPubSub
+		if v != nil {
+			stored = &ttnpb.ApplicationPubSub{}
+			if err := ttnbolt.UnmarshalProto(v, stored); err != nil {
+				return nil, err
+			}
+		}
+
+		gets = appendImplicitPubSubGetPaths(gets...)
+
+		if stored != nil {
+			pb = &ttnpb.ApplicationPubSub{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return nil, err
+			}
+			pb, err = applyPubSubFieldMask(nil, pb, gets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return nil, err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+		if stored == nil && pb == nil {
+			return nil, nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyPubSubFieldMask(nil, stored, gets...)
+			return nil, err
+		}
+
+		if pb == nil && len(sets) == 0 {
+			return func(b *bbolt.Bucket) error {
+				return b.Delete(ik)
+			}, nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.ApplicationPubSub{}
+		}
+
+		pb.UpdatedAt = time.Now().UTC()
+		sets = append(append(sets[:0:0], sets...),
+			"updated_at",
+		)
+
+		updated := &ttnpb.ApplicationPubSub{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.pub_sub_id",
+			); err != nil {
+				return nil, errInvalidFieldmask.WithCause(err)
+			}
+
+			pb.CreatedAt = pb.UpdatedAt
+			sets = append(sets, "created_at")
+
+			updated, err = applyPubSubFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+			if updated.ApplicationID != ids.ApplicationID || updated.PubSubID != ids.PubSubID {
+				return nil, errInvalidIdentifiers
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.pub_sub_id") && pb.PubSubID != stored.PubSubID {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.pub_sub_id")
+			}
+			if err := ttnbolt.UnmarshalProto(v, updated); err != nil {
+				return nil, err
+			}
+			updated, err = applyPubSubFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := updated.ValidateFields(sets...); err != nil {
+			return nil, err
+		}
+
+		pb, err = applyPubSubFieldMask(nil, updated, gets...)
+		if err != nil {
+			return nil, err
+		}
+		return func(b *bbolt.Bucket) error {
+			return ttnbolt.SetProto(b, ik, updated)
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
@@ -0,0 +1,320 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"context"
+	"time"
+
+	amqp "github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+type topic struct {
+	channel    channel
+	exchange   string
+	routingKey string
+}
+
+var errNilChannel = errors.DefineInvalidArgument("nil_channel", "channel is nil")
+
+// OpenTopic returns a *pubsub.Topic that publishes to the given exchange with the given routing key.
+func OpenTopic(ch channel, exchange, routingKey string) (*pubsub.Topic, error) {
+	dt, err := openDriverTopic(ch, exchange, routingKey)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewTopic(dt, nil), nil
+}
+
+func openDriverTopic(ch channel, exchange, routingKey string) (driver.Topic, error) {
+	if ch == nil {
+		return nil, errNilChannel
+	}
+	return &topic{
+		channel:    ch,
+		exchange:   exchange,
+		routingKey: routingKey,
+	}, nil
+}
+
+var errPublishFailed = errors.Define("publish_failed", "publish to AMQP exchange failed")
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	if t == nil || t.channel == nil {
+		return errNilChannel
+	}
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		pub := amqp.Publishing{
+			Headers:      encodeHeaders(msg.Metadata),
+			DeliveryMode: amqp.Persistent,
+			Timestamp:    time.Now(),
+			Body:         msg.Body,
+		}
+		if msg.BeforeSend != nil {
+			asFunc := func(i interface{}) bool {
+				p, ok := i.(**amqp.Publishing)
+				if !ok {
+					return false
+				}
+				*p = &pub
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+		}
+		if err := t.channel.Publish(t.exchange, t.routingKey, false, false, pub); err != nil {
+			return errPublishFailed.WithCause(err)
+		}
+	}
+	return nil
+}
+
+func encodeHeaders(metadata map[string]string) amqp.Table {
+	if len(metadata) == 0 {
+		return nil
+	}
+	headers := make(amqp.Table, len(metadata))
+	for k, v := range metadata {
+		headers[k] = v
+	}
+	return headers
+}
+
+func decodeMessage(delivery amqp.Delivery) *driver.Message {
+	var metadata map[string]string
+	if len(delivery.Headers) > 0 {
+		metadata = make(map[string]string, len(delivery.Headers))
+		for k, v := range delivery.Headers {
+			if s, ok := v.(string); ok {
+				metadata[k] = s
+			}
+		}
+	}
+	return &driver.Message{
+		Body:     delivery.Body,
+		Metadata: metadata,
+		AckID:    delivery.DeliveryTag,
+		AsFunc: func(i interface{}) bool {
+			p, ok := i.(*amqp.Delivery)
+			if !ok {
+				return false
+			}
+			*p = delivery
+			return true
+		},
+	}
+}
+
+// IsRetryable implements driver.Topic.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (*topic) As(interface{}) bool { return false }
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(err error, i interface{}) bool {
+	return errorAs(err, i)
+}
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+func (*topic) Close() error { return nil }
+
+type subscription struct {
+	channel    channel
+	queue      string
+	deliveries <-chan amqp.Delivery
+}
+
+// subscriptionQueueSize is the maximum number of unacknowledged messages of a subscription.
+const subscriptionQueueSize = 16
+
+// OpenSubscription returns a *pubsub.Subscription that consumes the messages published to the given exchange with
+// the given routing key. The messages are consumed from the durable queue with the given name.
+// The channel is closed when the subscription is shut down.
+func OpenSubscription(ch channel, exchange, routingKey, queueName string) (*pubsub.Subscription, error) {
+	ds, err := openDriverSubscription(ch, exchange, routingKey, queueName)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewSubscription(ds, nil, nil), nil
+}
+
+var (
+	errDeclareFailed   = errors.Define("declare_failed", "declare AMQP exchange or queue failed")
+	errSubscribeFailed = errors.Define("subscribe_failed", "subscribe to AMQP queue failed")
+)
+
+func openDriverSubscription(ch channel, exchange, routingKey, queueName string) (driver.Subscription, error) {
+	if ch == nil {
+		return nil, errNilChannel
+	}
+	queue, err := ch.QueueDeclare(queueName, true, false, false, false, nil)
+	if err != nil {
+		return nil, errDeclareFailed.WithCause(err)
+	}
+	if err := ch.QueueBind(queue.Name, routingKey, exchange, false, nil); err != nil {
+		return nil, errDeclareFailed.WithCause(err)
+	}
+	if err := ch.Qos(subscriptionQueueSize, 0, false); err != nil {
+		return nil, errSubscribeFailed.WithCause(err)
+	}
+	deliveries, err := ch.Consume(queue.Name, "", false, false, false, false, nil)
+	if err != nil {
+		return nil, errSubscribeFailed.WithCause(err)
+	}
+	return &subscription{
+		channel:    ch,
+		queue:      queue.Name,
+		deliveries: deliveries,
+	}, nil
+}
+
+// ReceiveBatch implements driver.Subscription.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	if s == nil || s.channel == nil {
+		return nil, errNilChannel
+	}
+	var messages []*driver.Message
+outer:
+	for i := 0; i < maxMessages; i++ {
+		select {
+		case <-ctx.Done():
+			break outer
+		case delivery, ok := <-s.deliveries:
+			if !ok {
+				break outer
+			}
+			messages = append(messages, decodeMessage(delivery))
+		// We cannot delay the messages for too long for the sake of
+		// having bigger batches. Avoid busy waiting, but don't wait
+		// for too long.
+		case <-time.After(1 * time.Millisecond):
+			break outer
+		}
+	}
+	return messages, ctx.Err()
+}
+
+// SendAcks implements driver.Subscription.
+func (s *subscription) SendAcks(_ context.Context, ackIDs []driver.AckID) error {
+	for _, ackID := range ackIDs {
+		if err := s.channel.Ack(ackID.(uint64), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CanNack implements driver.Subscription.
+func (*subscription) CanNack() bool { return true }
+
+// SendNacks implements driver.Subscription.
+// Negatively acknowledged messages are requeued.
+func (s *subscription) SendNacks(_ context.Context, ackIDs []driver.AckID) error {
+	for _, ackID := range ackIDs {
+		if err := s.channel.Nack(ackID.(uint64), false, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (*subscription) As(interface{}) bool { return false }
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(err error, i interface{}) bool {
+	return errorAs(err, i)
+}
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+// Closing the channel requeues the unacknowledged messages.
+func (s *subscription) Close() error {
+	if s == nil || s.channel == nil {
+		return nil
+	}
+	if err := s.channel.Close(); err != nil && err != amqp.ErrClosed {
+		return err
+	}
+	return nil
+}
+
+func errorAs(err error, i interface{}) bool {
+	if ttnErr, ok := errors.From(err); ok {
+		err = ttnErr.Cause()
+	}
+	amqpErr, ok := err.(*amqp.Error)
+	if !ok {
+		return false
+	}
+	p, ok := i.(**amqp.Error)
+	if !ok {
+		return false
+	}
+	*p = amqpErr
+	return true
+}
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	if d, ok := err.(errors.Definition); ok && d.FullName() == errNilChannel.FullName() {
+		return gcerrors.NotFound
+	}
+	switch err {
+	case nil:
+		return gcerrors.OK
+	case context.Canceled:
+		return gcerrors.Canceled
+	case context.DeadlineExceeded:
+		return gcerrors.DeadlineExceeded
+	}
+	var amqpErr *amqp.Error
+	if !errorAs(err, &amqpErr) {
+		return gcerrors.Unknown
+	}
+	switch amqpErr.Code {
+	case amqp.NotFound:
+		return gcerrors.NotFound
+	case amqp.AccessRefused:
+		return gcerrors.PermissionDenied
+	case amqp.PreconditionFailed:
+		return gcerrors.FailedPrecondition
+	case amqp.ResourceLocked:
+		return gcerrors.ResourceExhausted
+	default:
+		return gcerrors.Unknown
+	}
+}
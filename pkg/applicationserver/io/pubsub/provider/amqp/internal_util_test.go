@@ -0,0 +1,199 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"strings"
+	"sync"
+
+	amqp "github.com/streadway/amqp"
+)
+
+// memoryBroker is an in-process stand-in for an AMQP 0.9.1 broker with topic exchanges that route on exact routing keys.
+type memoryBroker struct {
+	mu        sync.Mutex
+	config    amqp.Config
+	exchanges map[string]bool
+	bindings  map[string]map[string][]string
+	queues    map[string]chan amqp.Delivery
+	acked     map[uint64]bool
+	nacked    map[uint64]bool
+	tag       uint64
+}
+
+func newMemoryBroker() *memoryBroker {
+	return &memoryBroker{
+		exchanges: map[string]bool{
+			defaultExchange: true,
+		},
+		bindings: make(map[string]map[string][]string),
+		queues:   make(map[string]chan amqp.Delivery),
+		acked:    make(map[uint64]bool),
+		nacked:   make(map[uint64]bool),
+	}
+}
+
+// Dial has the same signature as dial.
+func (b *memoryBroker) Dial(url string, config amqp.Config) (conn, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.config = config
+	return &memoryConn{broker: b}, nil
+}
+
+// Config returns the configuration used to dial the broker.
+func (b *memoryBroker) Config() amqp.Config {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.config
+}
+
+// Bind declares a queue bound to the given exchange with the given routing key.
+func (b *memoryBroker) Bind(queue, key, exchange string) (<-chan amqp.Delivery, error) {
+	ch := &memoryChannel{broker: b}
+	if _, err := ch.QueueDeclare(queue, false, true, false, false, nil); err != nil {
+		return nil, err
+	}
+	if err := ch.QueueBind(queue, key, exchange, false, nil); err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.queues[queue], nil
+}
+
+// Publish publishes a message to the given exchange with the given routing key.
+func (b *memoryBroker) Publish(exchange, key string, msg amqp.Publishing) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.exchanges[exchange] {
+		return &amqp.Error{Code: amqp.NotFound, Reason: "NOT_FOUND - no exchange"}
+	}
+	for _, name := range b.bindings[exchange][key] {
+		b.tag++
+		b.queues[name] <- amqp.Delivery{
+			Headers:      msg.Headers,
+			DeliveryMode: msg.DeliveryMode,
+			Timestamp:    msg.Timestamp,
+			DeliveryTag:  b.tag,
+			Exchange:     exchange,
+			RoutingKey:   key,
+			Body:         msg.Body,
+		}
+	}
+	return nil
+}
+
+// Acked returns whether the delivery with the given tag has been acknowledged.
+func (b *memoryBroker) Acked(tag uint64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.acked[tag]
+}
+
+type memoryConn struct {
+	broker *memoryBroker
+}
+
+// Channel implements conn.
+func (c *memoryConn) Channel() (channel, error) {
+	return &memoryChannel{broker: c.broker}, nil
+}
+
+// Close implements conn.
+func (c *memoryConn) Close() error { return nil }
+
+type memoryChannel struct {
+	broker *memoryBroker
+}
+
+// ExchangeDeclare implements channel.
+func (c *memoryChannel) ExchangeDeclare(name, kind string, _, _, _, _ bool, _ amqp.Table) error {
+	if strings.HasPrefix(name, "amq.") {
+		return &amqp.Error{Code: amqp.AccessRefused, Reason: "ACCESS_REFUSED - reserved exchange name"}
+	}
+	if kind != amqp.ExchangeTopic {
+		return &amqp.Error{Code: amqp.NotImplemented, Reason: "NOT_IMPLEMENTED - only topic exchanges are supported"}
+	}
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	c.broker.exchanges[name] = true
+	return nil
+}
+
+// QueueDeclare implements channel.
+func (c *memoryChannel) QueueDeclare(name string, _, _, _, _ bool, _ amqp.Table) (amqp.Queue, error) {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	if _, ok := c.broker.queues[name]; !ok {
+		c.broker.queues[name] = make(chan amqp.Delivery, 64)
+	}
+	return amqp.Queue{Name: name}, nil
+}
+
+// QueueBind implements channel.
+func (c *memoryChannel) QueueBind(name, key, exchange string, _ bool, _ amqp.Table) error {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	if !c.broker.exchanges[exchange] {
+		return &amqp.Error{Code: amqp.NotFound, Reason: "NOT_FOUND - no exchange"}
+	}
+	if _, ok := c.broker.queues[name]; !ok {
+		return &amqp.Error{Code: amqp.NotFound, Reason: "NOT_FOUND - no queue"}
+	}
+	if c.broker.bindings[exchange] == nil {
+		c.broker.bindings[exchange] = make(map[string][]string)
+	}
+	c.broker.bindings[exchange][key] = append(c.broker.bindings[exchange][key], name)
+	return nil
+}
+
+// Qos implements channel.
+func (c *memoryChannel) Qos(int, int, bool) error { return nil }
+
+// Consume implements channel.
+func (c *memoryChannel) Consume(queue, _ string, _, _, _, _ bool, _ amqp.Table) (<-chan amqp.Delivery, error) {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	ch, ok := c.broker.queues[queue]
+	if !ok {
+		return nil, &amqp.Error{Code: amqp.NotFound, Reason: "NOT_FOUND - no queue"}
+	}
+	return ch, nil
+}
+
+// Publish implements channel.
+func (c *memoryChannel) Publish(exchange, key string, _, _ bool, msg amqp.Publishing) error {
+	return c.broker.Publish(exchange, key, msg)
+}
+
+// Ack implements channel.
+func (c *memoryChannel) Ack(tag uint64, _ bool) error {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	c.broker.acked[tag] = true
+	return nil
+}
+
+// Nack implements channel.
+func (c *memoryChannel) Nack(tag uint64, _, _ bool) error {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	c.broker.nacked[tag] = true
+	return nil
+}
+
+// Close implements channel.
+func (c *memoryChannel) Close() error { return nil }
@@ -0,0 +1,268 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package amqp implements the AMQP 0.9.1 provider using the amqp client.
+package amqp
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	amqp "github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+var timeout = (1 << 3) * time.Second
+
+// defaultExchange is the topic exchange that is predeclared by AMQP 0.9.1 brokers.
+const defaultExchange = "amq.topic"
+
+// channel is the subset of *amqp.Channel used by the provider.
+type channel interface {
+	ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp.Table) error
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	Qos(prefetchCount, prefetchSize int, global bool) error
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error)
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	Ack(tag uint64, multiple bool) error
+	Nack(tag uint64, multiple, requeue bool) error
+	Close() error
+}
+
+// conn is the subset of *amqp.Connection used by the provider.
+type conn interface {
+	Channel() (channel, error)
+	Close() error
+}
+
+type amqpConn struct {
+	*amqp.Connection
+}
+
+// Channel implements conn.
+func (c amqpConn) Channel() (channel, error) {
+	ch, err := c.Connection.Channel()
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+var dial = func(url string, config amqp.Config) (conn, error) {
+	c, err := amqp.DialConfig(url, config)
+	if err != nil {
+		return nil, err
+	}
+	return amqpConn{Connection: c}, nil
+}
+
+type impl struct {
+}
+
+type connection struct {
+	conn
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connection) Shutdown(_ context.Context) error {
+	if err := c.Close(); err != nil && err != amqp.ErrClosed {
+		return err
+	}
+	return nil
+}
+
+var errConnectFailed = errors.Define("connect_failed", "connection to AMQP server failed")
+
+func newConfig(settings *ttnpb.ApplicationPubSub_AMQPProvider) (amqp.Config, error) {
+	config := amqp.Config{
+		Heartbeat: 10 * time.Second,
+		Locale:    "en_US",
+		Dial: func(network, addr string) (net.Conn, error) {
+			return net.DialTimeout(network, addr, timeout)
+		},
+	}
+	if settings.Username != "" {
+		config.SASL = []amqp.Authentication{
+			&amqp.PlainAuth{
+				Username: settings.Username,
+				Password: settings.Password,
+			},
+		}
+	}
+	// The TLS configuration is only used if the server URL has the amqps scheme.
+	tlsConfig, err := provider.CreateTLSConfig(settings.TLSCA, settings.TLSClientCert, settings.TLSClientKey)
+	if err != nil {
+		return amqp.Config{}, err
+	}
+	config.TLSClientConfig = tlsConfig
+	return config, nil
+}
+
+// OpenConnection implements provider.Provider using the amqp client.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_AMQP)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	config, err := newConfig(settings.AMQP)
+	if err != nil {
+		return nil, err
+	}
+	c, err := dial(settings.AMQP.ServerURL, config)
+	if err != nil {
+		return nil, errConnectFailed.WithCause(err)
+	}
+	pc = &provider.Connection{
+		ProviderConnection: &connection{
+			conn: c,
+		},
+	}
+	defer func(pc *provider.Connection) {
+		if err != nil {
+			pc.Shutdown(ctx)
+		}
+	}(pc)
+	exchange := settings.AMQP.Exchange
+	if exchange == "" {
+		exchange = defaultExchange
+	}
+	ch, err := c.Channel()
+	if err != nil {
+		return nil, errConnectFailed.WithCause(err)
+	}
+	if exchange != defaultExchange {
+		if err = ch.ExchangeDeclare(exchange, amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
+			return nil, errDeclareFailed.WithCause(err)
+		}
+	}
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &pc.Topics.UplinkMessage,
+			message: target.GetUplinkMessage(),
+		},
+		{
+			topic:   &pc.Topics.JoinAccept,
+			message: target.GetJoinAccept(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkAck,
+			message: target.GetDownlinkAck(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkNack,
+			message: target.GetDownlinkNack(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkSent,
+			message: target.GetDownlinkSent(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkFailed,
+			message: target.GetDownlinkFailed(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueued,
+			message: target.GetDownlinkQueued(),
+		},
+		{
+			topic:   &pc.Topics.LocationSolved,
+			message: target.GetLocationSolved(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		if *t.topic, err = OpenTopic(
+			ch,
+			exchange,
+			combineRoutingKeys(target.GetBaseTopic(), t.message.GetTopic()),
+		); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &pc.Subscriptions.Push,
+			message:      target.GetDownlinkPush(),
+		},
+		{
+			subscription: &pc.Subscriptions.Replace,
+			message:      target.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		// Each subscription uses a dedicated channel, so that closing the subscription does not affect the others.
+		subCh, err := c.Channel()
+		if err != nil {
+			return nil, errConnectFailed.WithCause(err)
+		}
+		routingKey := combineRoutingKeys(target.GetBaseTopic(), s.message.GetTopic())
+		if *s.subscription, err = OpenSubscription(
+			subCh,
+			exchange,
+			routingKey,
+			queueName(target, routingKey),
+		); err != nil {
+			subCh.Close()
+			return nil, err
+		}
+	}
+	return pc, nil
+}
+
+// maxQueueNameLength is the maximum length of AMQP queue names.
+const maxQueueNameLength = 255
+
+// queueName returns the name of the durable queue of the subscription to the given routing key.
+// The name includes the application and pub/sub IDs, so that pub/subs that use the same routing key do not share a
+// queue. If the name is too long, the routing key is replaced by its SHA-256 hash.
+func queueName(target provider.Target, routingKey string) string {
+	name := fmt.Sprintf("%s.%s.%s", target.GetApplicationID(), target.GetPubSubID(), routingKey)
+	if len(name) <= maxQueueNameLength {
+		return name
+	}
+	return fmt.Sprintf("%s.%s.%x", target.GetApplicationID(), target.GetPubSubID(), sha256.Sum256([]byte(routingKey)))
+}
+
+func combineRoutingKeys(k1, k2 string) string {
+	k1 = strings.Trim(k1, ".")
+	k2 = strings.Trim(k2, ".")
+	if k1 == "" {
+		return k2
+	}
+	if k2 == "" {
+		return k1
+	}
+	return fmt.Sprintf("%s.%s", k1, k2)
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_AMQP{}, impl{})
+}
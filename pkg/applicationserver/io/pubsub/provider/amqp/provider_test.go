@@ -0,0 +1,376 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	amqp "github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub"
+)
+
+func TestNewConfig(t *testing.T) {
+	a := assertions.New(t)
+
+	config, err := newConfig(&ttnpb.ApplicationPubSub_AMQPProvider{
+		ServerURL: "amqp://localhost:5672",
+	})
+	a.So(err, should.BeNil)
+	a.So(config.SASL, should.BeEmpty)
+	a.So(config.TLSClientConfig, should.NotBeNil)
+
+	config, err = newConfig(&ttnpb.ApplicationPubSub_AMQPProvider{
+		ServerURL: "amqps://localhost:5671",
+		Username:  "user",
+		Password:  "secret",
+	})
+	a.So(err, should.BeNil)
+	if a.So(config.SASL, should.HaveLength, 1) {
+		a.So(config.SASL[0].Mechanism(), should.Equal, "PLAIN")
+		a.So(config.SASL[0].Response(), should.Equal, "\x00user\x00secret")
+	}
+
+	_, err = newConfig(&ttnpb.ApplicationPubSub_AMQPProvider{
+		ServerURL: "amqps://localhost:5671",
+		TLSCA:     []byte("foo"),
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestQueueName(t *testing.T) {
+	a := assertions.New(t)
+
+	pb := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app1",
+			},
+			PubSubID: "ps1",
+		},
+	}
+	a.So(queueName(pb, "downlink.push"), should.Equal, "app1.ps1.downlink.push")
+
+	// Pub/subs of other applications that use the same routing key do not share the queue.
+	other := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app2",
+			},
+			PubSubID: "ps1",
+		},
+	}
+	a.So(queueName(other, "downlink.push"), should.NotEqual, queueName(pb, "downlink.push"))
+
+	// Long routing keys are hashed.
+	routingKey := strings.Repeat("a", 100) + "." + strings.Repeat("b", 100)
+	longPB := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: strings.Repeat("c", 36),
+			},
+			PubSubID: strings.Repeat("d", 36),
+		},
+	}
+	name := queueName(longPB, routingKey)
+	a.So(len(name), should.BeLessThanOrEqualTo, maxQueueNameLength)
+	a.So(name, should.StartWith, strings.Repeat("c", 36)+"."+strings.Repeat("d", 36)+".")
+	a.So(name, should.NotEqual, queueName(longPB, routingKey+"c"))
+}
+
+func TestOpenConnection(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	pb := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app1",
+			},
+			PubSubID: "ps1",
+		},
+		Provider: &ttnpb.ApplicationPubSub_AMQP{
+			AMQP: &ttnpb.ApplicationPubSub_AMQPProvider{},
+		},
+		BaseTopic: "app1.ps1",
+		DownlinkPush: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.push",
+		},
+		DownlinkReplace: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.replace",
+		},
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "uplink.message",
+		},
+		JoinAccept: &ttnpb.ApplicationPubSub_Message{
+			Topic: "join.accept",
+		},
+		DownlinkAck: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.ack",
+		},
+		DownlinkNack: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.nack",
+		},
+		DownlinkSent: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.sent",
+		},
+		DownlinkFailed: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.failed",
+		},
+		DownlinkQueued: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.queued",
+		},
+		LocationSolved: &ttnpb.ApplicationPubSub_Message{
+			Topic: "location.solved",
+		},
+	}
+
+	impl, err := provider.GetProvider(&ttnpb.ApplicationPubSub{
+		Provider: &ttnpb.ApplicationPubSub_AMQP{},
+	})
+	a.So(impl, should.NotBeNil)
+	a.So(err, should.BeNil)
+
+	// Invalid attributes - no server provided.
+	{
+		conn, err := impl.OpenConnection(ctx, pb)
+		a.So(conn, should.BeNil)
+		a.So(err, should.NotBeNil)
+	}
+
+	broker := newMemoryBroker()
+	oldDial := dial
+	dial = broker.Dial
+	defer func() {
+		dial = oldDial
+	}()
+
+	// Invalid attributes - reserved exchange name.
+	{
+		pb.Provider = &ttnpb.ApplicationPubSub_AMQP{
+			AMQP: &ttnpb.ApplicationPubSub_AMQPProvider{
+				ServerURL: "amqp://localhost:5672",
+				Exchange:  "amq.foo",
+			},
+		}
+		conn, err := impl.OpenConnection(ctx, pb)
+		a.So(conn, should.BeNil)
+		a.So(err, should.NotBeNil)
+	}
+
+	for _, utc := range []struct {
+		name     string
+		exchange string
+		provider *ttnpb.ApplicationPubSub_AMQPProvider
+	}{
+		{
+			name:     "DefaultExchange",
+			exchange: "amq.topic",
+			provider: &ttnpb.ApplicationPubSub_AMQPProvider{
+				ServerURL: "amqp://localhost:5672",
+			},
+		},
+		{
+			name:     "CustomExchange",
+			exchange: "ttn",
+			provider: &ttnpb.ApplicationPubSub_AMQPProvider{
+				ServerURL: "amqp://localhost:5672",
+				Username:  "user",
+				Password:  "secret",
+				Exchange:  "ttn",
+			},
+		},
+	} {
+		t.Run(utc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			pb.Provider = &ttnpb.ApplicationPubSub_AMQP{
+				AMQP: utc.provider,
+			}
+
+			conn, err := impl.OpenConnection(ctx, pb)
+			a.So(conn, should.NotBeNil)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			defer conn.Shutdown(ctx)
+
+			if utc.provider.Username != "" {
+				config := broker.Config()
+				if a.So(config.SASL, should.HaveLength, 1) {
+					a.So(config.SASL[0].Response(), should.Equal, "\x00user\x00secret")
+				}
+			}
+
+			t.Run("Downstream", func(t *testing.T) {
+				for _, tc := range []struct {
+					name          string
+					routingKey    string
+					subscription  *pubsub.Subscription
+					expectMessage bool
+				}{
+					{
+						name:          "ValidPush",
+						routingKey:    "app1.ps1.downlink.push",
+						subscription:  conn.Subscriptions.Push,
+						expectMessage: true,
+					},
+					{
+						name:          "ValidReplace",
+						routingKey:    "app1.ps1.downlink.replace",
+						subscription:  conn.Subscriptions.Replace,
+						expectMessage: true,
+					},
+					{
+						name:          "InvalidPush",
+						routingKey:    "foo.bar",
+						subscription:  conn.Subscriptions.Push,
+						expectMessage: false,
+					},
+					{
+						name:          "InvalidReplace",
+						routingKey:    "bar.foo",
+						subscription:  conn.Subscriptions.Replace,
+						expectMessage: false,
+					},
+				} {
+					t.Run(tc.name, func(t *testing.T) {
+						a := assertions.New(t)
+						ctx, cancel := context.WithTimeout(ctx, timeout)
+						defer cancel()
+
+						err := broker.Publish(utc.exchange, tc.routingKey, amqp.Publishing{
+							Headers: amqp.Table{
+								"foo": "bar",
+							},
+							Body: []byte("foobar"),
+						})
+						a.So(err, should.BeNil)
+
+						msg, err := tc.subscription.Receive(ctx)
+						if tc.expectMessage {
+							a.So(err, should.BeNil)
+							if !a.So(msg, should.NotBeNil) {
+								t.FailNow()
+							}
+							a.So(msg.Body, should.Resemble, []byte("foobar"))
+							a.So(msg.Metadata, should.Resemble, map[string]string{"foo": "bar"})
+						} else if err == nil {
+							t.Fatal("Unexpected message received")
+						}
+						if msg == nil {
+							return
+						}
+						var delivery amqp.Delivery
+						if !a.So(msg.As(&delivery), should.BeTrue) {
+							t.FailNow()
+						}
+						msg.Ack()
+						for start := time.Now(); !broker.Acked(delivery.DeliveryTag); time.Sleep(test.Delay) {
+							if time.Since(start) > timeout {
+								t.Fatal("Message not acknowledged")
+							}
+						}
+					})
+				}
+			})
+			t.Run("Upstream", func(t *testing.T) {
+				for _, tc := range []struct {
+					name       string
+					routingKey string
+					topic      *pubsub.Topic
+				}{
+					{
+						name:       "ValidUplink",
+						routingKey: "app1.ps1.uplink.message",
+						topic:      conn.Topics.UplinkMessage,
+					},
+					{
+						name:       "ValidJoinAccept",
+						routingKey: "app1.ps1.join.accept",
+						topic:      conn.Topics.JoinAccept,
+					},
+					{
+						name:       "ValidDownlinkAck",
+						routingKey: "app1.ps1.downlink.ack",
+						topic:      conn.Topics.DownlinkAck,
+					},
+					{
+						name:       "ValidDownlinkNack",
+						routingKey: "app1.ps1.downlink.nack",
+						topic:      conn.Topics.DownlinkNack,
+					},
+					{
+						name:       "ValidDownlinkSent",
+						routingKey: "app1.ps1.downlink.sent",
+						topic:      conn.Topics.DownlinkSent,
+					},
+					{
+						name:       "ValidDownlinkFailed",
+						routingKey: "app1.ps1.downlink.failed",
+						topic:      conn.Topics.DownlinkFailed,
+					},
+					{
+						name:       "ValidDownlinkQueued",
+						routingKey: "app1.ps1.downlink.queued",
+						topic:      conn.Topics.DownlinkQueued,
+					},
+					{
+						name:       "ValidLocationSolved",
+						routingKey: "app1.ps1.location.solved",
+						topic:      conn.Topics.LocationSolved,
+					},
+				} {
+					t.Run(tc.name, func(t *testing.T) {
+						a := assertions.New(t)
+
+						upCh, err := broker.Bind(utc.name+"."+tc.name, tc.routingKey, utc.exchange)
+						if !a.So(err, should.BeNil) {
+							t.FailNow()
+						}
+
+						err = tc.topic.Send(ctx, &pubsub.Message{
+							Body: []byte("foobar"),
+							Metadata: map[string]string{
+								"foo": "bar",
+							},
+						})
+						a.So(err, should.BeNil)
+
+						select {
+						case <-time.After(timeout):
+							t.Fatal("Expected message never arrived")
+						case delivery := <-upCh:
+							a.So(delivery.Body, should.Resemble, []byte("foobar"))
+							a.So(delivery.Headers, should.Resemble, amqp.Table{"foo": "bar"})
+							a.So(delivery.DeliveryMode, should.Equal, amqp.Persistent)
+						}
+					})
+				}
+			})
+		})
+	}
+}
+
+func init() {
+	timeout = (1 << 8) * test.Delay
+}
@@ -0,0 +1,338 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+type topic struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+var errNilClient = errors.DefineInvalidArgument("nil_client", "client is nil")
+
+// OpenTopic returns a *pubsub.Topic that publishes to the given Kafka topic with the given producer.
+func OpenTopic(producer sarama.SyncProducer, topicName string) (*pubsub.Topic, error) {
+	dt, err := openDriverTopic(producer, topicName)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewTopic(dt, nil), nil
+}
+
+func openDriverTopic(producer sarama.SyncProducer, topicName string) (driver.Topic, error) {
+	if producer == nil {
+		return nil, errNilClient
+	}
+	return &topic{
+		producer: producer,
+		topic:    topicName,
+	}, nil
+}
+
+var errPublishFailed = errors.Define("publish_failed", "publish to Kafka topic failed")
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	if t == nil || t.producer == nil {
+		return errNilClient
+	}
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		pm := &sarama.ProducerMessage{
+			Topic:   t.topic,
+			Value:   sarama.ByteEncoder(msg.Body),
+			Headers: encodeHeaders(msg.Metadata),
+		}
+		if msg.BeforeSend != nil {
+			asFunc := func(i interface{}) bool {
+				p, ok := i.(**sarama.ProducerMessage)
+				if !ok {
+					return false
+				}
+				*p = pm
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+		}
+		if _, _, err := t.producer.SendMessage(pm); err != nil {
+			return errPublishFailed.WithCause(err)
+		}
+	}
+	return nil
+}
+
+func encodeHeaders(metadata map[string]string) []sarama.RecordHeader {
+	if len(metadata) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	headers := make([]sarama.RecordHeader, 0, len(keys))
+	for _, k := range keys {
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(k),
+			Value: []byte(metadata[k]),
+		})
+	}
+	return headers
+}
+
+func decodeMessage(msg *sarama.ConsumerMessage, ackID driver.AckID) *driver.Message {
+	var metadata map[string]string
+	if len(msg.Headers) > 0 {
+		metadata = make(map[string]string, len(msg.Headers))
+		for _, h := range msg.Headers {
+			if h == nil {
+				continue
+			}
+			metadata[string(h.Key)] = string(h.Value)
+		}
+	}
+	return &driver.Message{
+		Body:     msg.Value,
+		Metadata: metadata,
+		AckID:    ackID,
+		AsFunc: func(i interface{}) bool {
+			p, ok := i.(**sarama.ConsumerMessage)
+			if !ok {
+				return false
+			}
+			*p = msg
+			return true
+		},
+	}
+}
+
+// IsRetryable implements driver.Topic.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topic) As(i interface{}) bool {
+	p, ok := i.(*sarama.SyncProducer)
+	if !ok {
+		return false
+	}
+	*p = t.producer
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+func (*topic) Close() error { return nil }
+
+// claimedMessage is a message that is consumed as part of a consumer group session.
+// The session is used to mark the message as consumed when it is acknowledged.
+type claimedMessage struct {
+	session sarama.ConsumerGroupSession
+	message *sarama.ConsumerMessage
+}
+
+type subscription struct {
+	group  sarama.ConsumerGroup
+	topic  string
+	subCh  chan claimedMessage
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// subscriptionQueueSize is the size of the subscription channel buffer.
+const subscriptionQueueSize = 16
+
+// OpenSubscription returns a *pubsub.Subscription that consumes the given Kafka topic as part of the given consumer group.
+// The consumer group is closed when the subscription is shut down.
+func OpenSubscription(ctx context.Context, group sarama.ConsumerGroup, topicName string) (*pubsub.Subscription, error) {
+	ds, err := openDriverSubscription(ctx, group, topicName)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewSubscription(ds, nil, nil), nil
+}
+
+func openDriverSubscription(ctx context.Context, group sarama.ConsumerGroup, topicName string) (driver.Subscription, error) {
+	if group == nil {
+		return nil, errNilClient
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	ds := &subscription{
+		group:  group,
+		topic:  topicName,
+		subCh:  make(chan claimedMessage, subscriptionQueueSize),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go ds.consume(ctx)
+	return ds, nil
+}
+
+// consumeBackoff is the time to wait before rejoining the consumer group after a failure.
+var consumeBackoff = time.Second
+
+func (s *subscription) consume(ctx context.Context) {
+	defer close(s.done)
+	logger := log.FromContext(ctx).WithField("topic", s.topic)
+	for {
+		// Consume returns when the consumer group is rebalanced, or when the context is done.
+		if err := s.group.Consume(ctx, []string{s.topic}, s); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.WithError(err).Warn("Failed to consume topic")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(consumeBackoff):
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// Setup implements sarama.ConsumerGroupHandler.
+func (*subscription) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+// Cleanup implements sarama.ConsumerGroupHandler.
+func (*subscription) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim implements sarama.ConsumerGroupHandler.
+func (s *subscription) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case s.subCh <- claimedMessage{
+			session: session,
+			message: msg,
+		}:
+		}
+	}
+	return nil
+}
+
+// ReceiveBatch implements driver.Subscription.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	if s == nil || s.group == nil {
+		return nil, errNilClient
+	}
+	var messages []*driver.Message
+outer:
+	for i := 0; i < maxMessages; i++ {
+		select {
+		case <-ctx.Done():
+			break outer
+		case msg := <-s.subCh:
+			messages = append(messages, decodeMessage(msg.message, msg))
+		// We cannot delay the messages for too long for the sake of
+		// having bigger batches. Avoid busy waiting, but don't wait
+		// for too long.
+		case <-time.After(1 * time.Millisecond):
+			break outer
+		}
+	}
+	return messages, ctx.Err()
+}
+
+// SendAcks implements driver.Subscription.
+func (*subscription) SendAcks(_ context.Context, ackIDs []driver.AckID) error {
+	for _, ackID := range ackIDs {
+		msg := ackID.(claimedMessage)
+		msg.session.MarkMessage(msg.message, "")
+	}
+	return nil
+}
+
+// CanNack implements driver.Subscription.
+func (*subscription) CanNack() bool { return false }
+
+// SendNacks implements driver.Subscription.
+func (*subscription) SendNacks(context.Context, []driver.AckID) error { panic("unreachable") }
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (s *subscription) As(i interface{}) bool {
+	p, ok := i.(*sarama.ConsumerGroup)
+	if !ok {
+		return false
+	}
+	*p = s.group
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+func (s *subscription) Close() error {
+	if s == nil || s.group == nil {
+		return nil
+	}
+	s.cancel()
+	<-s.done
+	return s.group.Close()
+}
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	if d, ok := err.(errors.Definition); ok && d.FullName() == errNilClient.FullName() {
+		return gcerrors.NotFound
+	}
+	switch err {
+	case nil:
+		return gcerrors.OK
+	case context.Canceled:
+		return gcerrors.Canceled
+	case context.DeadlineExceeded:
+		return gcerrors.DeadlineExceeded
+	case sarama.ErrUnknownTopicOrPartition:
+		return gcerrors.NotFound
+	case sarama.ErrMessageSizeTooLarge, sarama.ErrInvalidTopic:
+		return gcerrors.InvalidArgument
+	default:
+		return gcerrors.Unknown
+	}
+}
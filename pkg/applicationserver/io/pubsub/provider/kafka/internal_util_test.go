@@ -0,0 +1,252 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+// memoryBroker is an in-process stand-in for a Kafka cluster with single partition topics.
+type memoryBroker struct {
+	mu      sync.Mutex
+	topics  map[string][]*sarama.ConsumerMessage
+	offsets map[string]int64
+	notify  chan struct{}
+}
+
+func newMemoryBroker() *memoryBroker {
+	return &memoryBroker{
+		topics:  make(map[string][]*sarama.ConsumerMessage),
+		offsets: make(map[string]int64),
+		notify:  make(chan struct{}),
+	}
+}
+
+// Produce appends a message to the given topic and returns its offset.
+func (b *memoryBroker) Produce(topic string, value []byte, headers ...*sarama.RecordHeader) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	offset := int64(len(b.topics[topic]))
+	b.topics[topic] = append(b.topics[topic], &sarama.ConsumerMessage{
+		Topic:     topic,
+		Offset:    offset,
+		Value:     value,
+		Headers:   headers,
+		Timestamp: time.Now(),
+	})
+	close(b.notify)
+	b.notify = make(chan struct{})
+	return offset
+}
+
+// Messages returns the messages of the given topic.
+func (b *memoryBroker) Messages(topic string) []*sarama.ConsumerMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*sarama.ConsumerMessage(nil), b.topics[topic]...)
+}
+
+// Offset returns the committed offset of the given consumer group on the given topic.
+func (b *memoryBroker) Offset(groupID, topic string) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.offsets[groupID+"/"+topic]
+}
+
+func (b *memoryBroker) commit(groupID, topic string, offset int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if key := groupID + "/" + topic; offset > b.offsets[key] {
+		b.offsets[key] = offset
+	}
+}
+
+func (b *memoryBroker) fetch(topic string, offset int64) (*sarama.ConsumerMessage, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if msgs := b.topics[topic]; offset < int64(len(msgs)) {
+		return msgs[offset], nil
+	}
+	return nil, b.notify
+}
+
+// NewSyncProducer has the same signature as sarama.NewSyncProducer.
+func (b *memoryBroker) NewSyncProducer(_ []string, _ *sarama.Config) (sarama.SyncProducer, error) {
+	return &memoryProducer{broker: b}, nil
+}
+
+// NewConsumerGroup has the same signature as sarama.NewConsumerGroup.
+func (b *memoryBroker) NewConsumerGroup(_ []string, groupID string, _ *sarama.Config) (sarama.ConsumerGroup, error) {
+	return &memoryConsumerGroup{
+		broker:  b,
+		groupID: groupID,
+		errCh:   make(chan error),
+	}, nil
+}
+
+type memoryProducer struct {
+	broker *memoryBroker
+}
+
+// SendMessage implements sarama.SyncProducer.
+func (p *memoryProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	value, err := msg.Value.Encode()
+	if err != nil {
+		return 0, 0, err
+	}
+	headers := make([]*sarama.RecordHeader, 0, len(msg.Headers))
+	for i := range msg.Headers {
+		headers = append(headers, &msg.Headers[i])
+	}
+	return 0, p.broker.Produce(msg.Topic, value, headers...), nil
+}
+
+// SendMessages implements sarama.SyncProducer.
+func (p *memoryProducer) SendMessages(msgs []*sarama.ProducerMessage) error {
+	for _, msg := range msgs {
+		if _, _, err := p.SendMessage(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close implements sarama.SyncProducer.
+func (p *memoryProducer) Close() error { return nil }
+
+type memoryConsumerGroup struct {
+	broker  *memoryBroker
+	groupID string
+	errCh   chan error
+}
+
+// Consume implements sarama.ConsumerGroup.
+// The session ends when the context is done.
+func (g *memoryConsumerGroup) Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	session := &memoryConsumerGroupSession{
+		ctx:    ctx,
+		group:  g,
+		claims: make(map[string][]int32),
+	}
+	for _, topic := range topics {
+		session.claims[topic] = []int32{0}
+	}
+	if err := handler.Setup(session); err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	for _, topic := range topics {
+		claim := &memoryConsumerGroupClaim{
+			topic:  topic,
+			offset: g.broker.Offset(g.groupID, topic),
+			msgCh:  make(chan *sarama.ConsumerMessage),
+		}
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer close(claim.msgCh)
+			offset := claim.offset
+			for {
+				msg, notify := g.broker.fetch(claim.topic, offset)
+				if msg == nil {
+					select {
+					case <-ctx.Done():
+						return
+					case <-notify:
+						continue
+					}
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case claim.msgCh <- msg:
+					offset++
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			handler.ConsumeClaim(session, claim)
+		}()
+	}
+	wg.Wait()
+	return handler.Cleanup(session)
+}
+
+// Errors implements sarama.ConsumerGroup.
+func (g *memoryConsumerGroup) Errors() <-chan error { return g.errCh }
+
+// Close implements sarama.ConsumerGroup.
+func (g *memoryConsumerGroup) Close() error { return nil }
+
+type memoryConsumerGroupSession struct {
+	ctx    context.Context
+	group  *memoryConsumerGroup
+	claims map[string][]int32
+}
+
+// Claims implements sarama.ConsumerGroupSession.
+func (s *memoryConsumerGroupSession) Claims() map[string][]int32 { return s.claims }
+
+// MemberID implements sarama.ConsumerGroupSession.
+func (s *memoryConsumerGroupSession) MemberID() string { return "test" }
+
+// GenerationID implements sarama.ConsumerGroupSession.
+func (s *memoryConsumerGroupSession) GenerationID() int32 { return 1 }
+
+// MarkOffset implements sarama.ConsumerGroupSession.
+func (s *memoryConsumerGroupSession) MarkOffset(topic string, _ int32, offset int64, _ string) {
+	s.group.broker.commit(s.group.groupID, topic, offset)
+}
+
+// ResetOffset implements sarama.ConsumerGroupSession.
+func (s *memoryConsumerGroupSession) ResetOffset(topic string, _ int32, offset int64, _ string) {
+	s.group.broker.commit(s.group.groupID, topic, offset)
+}
+
+// MarkMessage implements sarama.ConsumerGroupSession.
+func (s *memoryConsumerGroupSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, metadata)
+}
+
+// Context implements sarama.ConsumerGroupSession.
+func (s *memoryConsumerGroupSession) Context() context.Context { return s.ctx }
+
+type memoryConsumerGroupClaim struct {
+	topic  string
+	offset int64
+	msgCh  chan *sarama.ConsumerMessage
+}
+
+// Topic implements sarama.ConsumerGroupClaim.
+func (c *memoryConsumerGroupClaim) Topic() string { return c.topic }
+
+// Partition implements sarama.ConsumerGroupClaim.
+func (c *memoryConsumerGroupClaim) Partition() int32 { return 0 }
+
+// InitialOffset implements sarama.ConsumerGroupClaim.
+func (c *memoryConsumerGroupClaim) InitialOffset() int64 { return c.offset }
+
+// HighWaterMarkOffset implements sarama.ConsumerGroupClaim.
+func (c *memoryConsumerGroupClaim) HighWaterMarkOffset() int64 { return c.offset }
+
+// Messages implements sarama.ConsumerGroupClaim.
+func (c *memoryConsumerGroupClaim) Messages() <-chan *sarama.ConsumerMessage { return c.msgCh }
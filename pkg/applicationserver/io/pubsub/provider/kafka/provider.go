@@ -0,0 +1,223 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka implements the Kafka provider using the sarama client.
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/xdg/scram"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+var timeout = (1 << 3) * time.Second
+
+var (
+	newSyncProducer  = sarama.NewSyncProducer
+	newConsumerGroup = sarama.NewConsumerGroup
+)
+
+type impl struct {
+}
+
+type connection struct {
+	sarama.SyncProducer
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connection) Shutdown(_ context.Context) error {
+	return c.Close()
+}
+
+var (
+	errInvalidSASLMechanism = errors.DefineInvalidArgument("sasl_mechanism", "invalid SASL mechanism `{mechanism}`")
+	errInvalidConfig        = errors.DefineInvalidArgument("config", "invalid Kafka configuration")
+	errConnectFailed        = errors.Define("connect_failed", "connection to Kafka brokers failed")
+)
+
+func newConfig(settings *ttnpb.ApplicationPubSub_KafkaProvider) (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	config.Version = sarama.V1_0_0_0
+	if settings.ClientID != "" {
+		config.ClientID = settings.ClientID
+	}
+	config.Net.DialTimeout = timeout
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Timeout = timeout
+	if settings.UseTLS {
+		tlsConfig, err := provider.CreateTLSConfig(settings.TLSCA, settings.TLSClientCert, settings.TLSClientKey)
+		if err != nil {
+			return nil, err
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+	if sasl := settings.SASL; sasl != nil {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.Handshake = true
+		config.Net.SASL.User = sasl.Username
+		config.Net.SASL.Password = sasl.Password
+		switch sasl.Mechanism {
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_PLAIN:
+			config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+			config.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMClientGenerator(scram.SHA256)
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+			config.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMClientGenerator(scramSHA512)
+		default:
+			return nil, errInvalidSASLMechanism.WithAttributes("mechanism", sasl.Mechanism.String())
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return nil, errInvalidConfig.WithCause(err)
+	}
+	return config, nil
+}
+
+// OpenConnection implements provider.Provider using the sarama client.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Kafka)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	config, err := newConfig(settings.Kafka)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := newSyncProducer(settings.Kafka.Brokers, config)
+	if err != nil {
+		return nil, errConnectFailed.WithCause(err)
+	}
+	pc = &provider.Connection{
+		ProviderConnection: &connection{
+			SyncProducer: producer,
+		},
+	}
+	defer func(pc *provider.Connection) {
+		if err != nil {
+			pc.Shutdown(ctx)
+		}
+	}(pc)
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &pc.Topics.UplinkMessage,
+			message: target.GetUplinkMessage(),
+		},
+		{
+			topic:   &pc.Topics.JoinAccept,
+			message: target.GetJoinAccept(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkAck,
+			message: target.GetDownlinkAck(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkNack,
+			message: target.GetDownlinkNack(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkSent,
+			message: target.GetDownlinkSent(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkFailed,
+			message: target.GetDownlinkFailed(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueued,
+			message: target.GetDownlinkQueued(),
+		},
+		{
+			topic:   &pc.Topics.LocationSolved,
+			message: target.GetLocationSolved(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		if *t.topic, err = OpenTopic(
+			producer,
+			combineTopics(target.GetBaseTopic(), t.message.GetTopic()),
+		); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &pc.Subscriptions.Push,
+			message:      target.GetDownlinkPush(),
+		},
+		{
+			subscription: &pc.Subscriptions.Replace,
+			message:      target.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		topicName := combineTopics(target.GetBaseTopic(), s.message.GetTopic())
+		group, err := newConsumerGroup(settings.Kafka.Brokers, consumerGroupID(target, settings.Kafka.ConsumerGroup, topicName), config)
+		if err != nil {
+			return nil, errConnectFailed.WithCause(err)
+		}
+		if *s.subscription, err = OpenSubscription(ctx, group, topicName); err != nil {
+			group.Close()
+			return nil, err
+		}
+	}
+	return pc, nil
+}
+
+// consumerGroupID returns the ID of the consumer group of the subscription to the given topic.
+// If no consumer group is configured, the consumer group is derived from the application and pub/sub IDs and the
+// topic, so that pub/subs that use the same topic do not share a consumer group.
+func consumerGroupID(target provider.Target, groupID, topicName string) string {
+	if groupID != "" {
+		return groupID
+	}
+	return fmt.Sprintf("%s.%s.%s", target.GetApplicationID(), target.GetPubSubID(), topicName)
+}
+
+func combineTopics(t1, t2 string) string {
+	t1 = strings.Trim(t1, ".")
+	t2 = strings.Trim(t2, ".")
+	if t1 == "" {
+		return t2
+	}
+	if t2 == "" {
+		return t1
+	}
+	return fmt.Sprintf("%s.%s", t1, t2)
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Kafka{}, impl{})
+}
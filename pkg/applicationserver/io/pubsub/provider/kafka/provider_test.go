@@ -0,0 +1,408 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub"
+)
+
+func TestNewConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		settings       *ttnpb.ApplicationPubSub_KafkaProvider
+		assertion      func(*assertions.Assertion, *sarama.Config)
+		errorAssertion func(error) bool
+	}{
+		{
+			name: "Plain",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers:  []string{"localhost:9092"},
+				ClientID: "foo",
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.ClientID, should.Equal, "foo")
+				a.So(config.Net.TLS.Enable, should.BeFalse)
+				a.So(config.Net.SASL.Enable, should.BeFalse)
+				a.So(config.Producer.Return.Successes, should.BeTrue)
+			},
+		},
+		{
+			name: "TLS",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9093"},
+				UseTLS:  true,
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.TLS.Enable, should.BeTrue)
+				a.So(config.Net.TLS.Config, should.NotBeNil)
+				a.So(config.Net.TLS.Config.RootCAs, should.BeNil)
+				a.So(config.Net.TLS.Config.Certificates, should.BeEmpty)
+			},
+		},
+		{
+			name: "TLS/InvalidCA",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9093"},
+				UseTLS:  true,
+				TLSCA:   []byte("foo"),
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			name: "SASL/Plain",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+				SASL: &ttnpb.ApplicationPubSub_KafkaProvider_SASL{
+					Mechanism: ttnpb.ApplicationPubSub_KafkaProvider_SASL_PLAIN,
+					Username:  "user",
+					Password:  "secret",
+				},
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.SASL.Enable, should.BeTrue)
+				a.So(config.Net.SASL.Mechanism, should.Equal, sarama.SASLTypePlaintext)
+				a.So(config.Net.SASL.User, should.Equal, "user")
+				a.So(config.Net.SASL.Password, should.Equal, "secret")
+			},
+		},
+		{
+			name: "SASL/SCRAM-SHA-256",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+				SASL: &ttnpb.ApplicationPubSub_KafkaProvider_SASL{
+					Mechanism: ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256,
+					Username:  "user",
+					Password:  "secret",
+				},
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.SASL.Enable, should.BeTrue)
+				a.So(config.Net.SASL.Mechanism, should.Equal, sarama.SASLTypeSCRAMSHA256)
+				if !a.So(config.Net.SASL.SCRAMClientGeneratorFunc, should.NotBeNil) {
+					return
+				}
+				client := config.Net.SASL.SCRAMClientGeneratorFunc()
+				a.So(client.Begin("user", "secret", ""), should.BeNil)
+				first, err := client.Step("")
+				a.So(err, should.BeNil)
+				a.So(strings.HasPrefix(first, "n,,n=user,r="), should.BeTrue)
+				a.So(client.Done(), should.BeFalse)
+			},
+		},
+		{
+			name: "SASL/SCRAM-SHA-512",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+				SASL: &ttnpb.ApplicationPubSub_KafkaProvider_SASL{
+					Mechanism: ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512,
+					Username:  "user",
+					Password:  "secret",
+				},
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.SASL.Enable, should.BeTrue)
+				a.So(config.Net.SASL.Mechanism, should.Equal, sarama.SASLTypeSCRAMSHA512)
+				a.So(config.Net.SASL.SCRAMClientGeneratorFunc, should.NotBeNil)
+			},
+		},
+		{
+			name: "SASL/InvalidMechanism",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+				SASL: &ttnpb.ApplicationPubSub_KafkaProvider_SASL{
+					Mechanism: ttnpb.ApplicationPubSub_KafkaProvider_SASL_Mechanism(42),
+				},
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			config, err := newConfig(tc.settings)
+			if tc.errorAssertion != nil {
+				a.So(tc.errorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			tc.assertion(a, config)
+		})
+	}
+}
+
+func TestConsumerGroupID(t *testing.T) {
+	a := assertions.New(t)
+
+	pb := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app1",
+			},
+			PubSubID: "ps1",
+		},
+	}
+	a.So(consumerGroupID(pb, "", "downlink.push"), should.Equal, "app1.ps1.downlink.push")
+	a.So(consumerGroupID(pb, "as", "downlink.push"), should.Equal, "as")
+
+	// Pub/subs of other applications that use the same topic do not share the consumer group.
+	other := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app2",
+			},
+			PubSubID: "ps1",
+		},
+	}
+	a.So(consumerGroupID(other, "", "downlink.push"), should.NotEqual, consumerGroupID(pb, "", "downlink.push"))
+}
+
+func TestOpenConnection(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	pb := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app1",
+			},
+			PubSubID: "ps1",
+		},
+		Provider: &ttnpb.ApplicationPubSub_Kafka{
+			Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{},
+		},
+		BaseTopic: "app1.ps1",
+		DownlinkPush: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.push",
+		},
+		DownlinkReplace: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.replace",
+		},
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "uplink.message",
+		},
+		JoinAccept: &ttnpb.ApplicationPubSub_Message{
+			Topic: "join.accept",
+		},
+		DownlinkAck: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.ack",
+		},
+		DownlinkNack: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.nack",
+		},
+		DownlinkSent: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.sent",
+		},
+		DownlinkFailed: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.failed",
+		},
+		DownlinkQueued: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.queued",
+		},
+		LocationSolved: &ttnpb.ApplicationPubSub_Message{
+			Topic: "location.solved",
+		},
+	}
+
+	impl, err := provider.GetProvider(&ttnpb.ApplicationPubSub{
+		Provider: &ttnpb.ApplicationPubSub_Kafka{},
+	})
+	a.So(impl, should.NotBeNil)
+	a.So(err, should.BeNil)
+
+	// Invalid attributes - no brokers provided.
+	{
+		conn, err := impl.OpenConnection(ctx, pb)
+		a.So(conn, should.BeNil)
+		a.So(err, should.NotBeNil)
+	}
+
+	broker := newMemoryBroker()
+	oldNewSyncProducer, oldNewConsumerGroup := newSyncProducer, newConsumerGroup
+	newSyncProducer, newConsumerGroup = broker.NewSyncProducer, broker.NewConsumerGroup
+	defer func() {
+		newSyncProducer, newConsumerGroup = oldNewSyncProducer, oldNewConsumerGroup
+	}()
+
+	pb.Provider = &ttnpb.ApplicationPubSub_Kafka{
+		Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{
+			Brokers:       []string{"localhost:9092"},
+			ConsumerGroup: "as",
+		},
+	}
+
+	// Valid attributes - connection established.
+	{
+		conn, err := impl.OpenConnection(ctx, pb)
+		a.So(conn, should.NotBeNil)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		defer conn.Shutdown(ctx)
+
+		t.Run("Downstream", func(t *testing.T) {
+			for _, tc := range []struct {
+				name          string
+				topicName     string
+				subscription  *pubsub.Subscription
+				expectMessage bool
+			}{
+				{
+					name:          "ValidPush",
+					topicName:     "app1.ps1.downlink.push",
+					subscription:  conn.Subscriptions.Push,
+					expectMessage: true,
+				},
+				{
+					name:          "ValidReplace",
+					topicName:     "app1.ps1.downlink.replace",
+					subscription:  conn.Subscriptions.Replace,
+					expectMessage: true,
+				},
+				{
+					name:          "InvalidPush",
+					topicName:     "foo.bar",
+					subscription:  conn.Subscriptions.Push,
+					expectMessage: false,
+				},
+				{
+					name:          "InvalidReplace",
+					topicName:     "bar.foo",
+					subscription:  conn.Subscriptions.Replace,
+					expectMessage: false,
+				},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					a := assertions.New(t)
+					ctx, cancel := context.WithTimeout(ctx, timeout)
+					defer cancel()
+
+					offset := broker.Produce(tc.topicName, []byte("foobar"), &sarama.RecordHeader{
+						Key:   []byte("foo"),
+						Value: []byte("bar"),
+					})
+
+					msg, err := tc.subscription.Receive(ctx)
+					if tc.expectMessage {
+						a.So(err, should.BeNil)
+						if !a.So(msg, should.NotBeNil) {
+							t.FailNow()
+						}
+						a.So(msg.Body, should.Resemble, []byte("foobar"))
+						a.So(msg.Metadata, should.Resemble, map[string]string{"foo": "bar"})
+					} else if err == nil {
+						t.Fatal("Unexpected message received")
+					}
+					if msg == nil {
+						return
+					}
+					msg.Ack()
+					// Acknowledged messages are marked as consumed by the consumer group.
+					for start := time.Now(); broker.Offset("as", tc.topicName) != offset+1; time.Sleep(test.Delay) {
+						if time.Since(start) > timeout {
+							t.Fatal("Message not marked as consumed")
+						}
+					}
+				})
+			}
+		})
+		t.Run("Upstream", func(t *testing.T) {
+			for _, tc := range []struct {
+				name      string
+				topicName string
+				topic     *pubsub.Topic
+			}{
+				{
+					name:      "ValidUplink",
+					topicName: "app1.ps1.uplink.message",
+					topic:     conn.Topics.UplinkMessage,
+				},
+				{
+					name:      "ValidJoinAccept",
+					topicName: "app1.ps1.join.accept",
+					topic:     conn.Topics.JoinAccept,
+				},
+				{
+					name:      "ValidDownlinkAck",
+					topicName: "app1.ps1.downlink.ack",
+					topic:     conn.Topics.DownlinkAck,
+				},
+				{
+					name:      "ValidDownlinkNack",
+					topicName: "app1.ps1.downlink.nack",
+					topic:     conn.Topics.DownlinkNack,
+				},
+				{
+					name:      "ValidDownlinkSent",
+					topicName: "app1.ps1.downlink.sent",
+					topic:     conn.Topics.DownlinkSent,
+				},
+				{
+					name:      "ValidDownlinkFailed",
+					topicName: "app1.ps1.downlink.failed",
+					topic:     conn.Topics.DownlinkFailed,
+				},
+				{
+					name:      "ValidDownlinkQueued",
+					topicName: "app1.ps1.downlink.queued",
+					topic:     conn.Topics.DownlinkQueued,
+				},
+				{
+					name:      "ValidLocationSolved",
+					topicName: "app1.ps1.location.solved",
+					topic:     conn.Topics.LocationSolved,
+				},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					a := assertions.New(t)
+
+					err := tc.topic.Send(ctx, &pubsub.Message{
+						Body: []byte("foobar"),
+						Metadata: map[string]string{
+							"foo": "bar",
+						},
+					})
+					a.So(err, should.BeNil)
+
+					msgs := broker.Messages(tc.topicName)
+					if !a.So(msgs, should.HaveLength, 1) {
+						t.FailNow()
+					}
+					a.So(msgs[0].Value, should.Resemble, []byte("foobar"))
+					if a.So(msgs[0].Headers, should.HaveLength, 1) {
+						a.So(string(msgs[0].Headers[0].Key), should.Equal, "foo")
+						a.So(string(msgs[0].Headers[0].Value), should.Equal, "bar")
+					}
+				})
+			}
+		})
+	}
+}
+
+func init() {
+	timeout = (1 << 8) * test.Delay
+}
@@ -168,6 +168,8 @@ func init() {
 	for _, p := range []ttnpb.ApplicationPubSub_Provider{
 		&ttnpb.ApplicationPubSub_NATS{},
 		&ttnpb.ApplicationPubSub_MQTT{},
+		&ttnpb.ApplicationPubSub_Kafka{},
+		&ttnpb.ApplicationPubSub_AMQP{},
 	} {
 		provider.RegisterProvider(p, impl)
 	}
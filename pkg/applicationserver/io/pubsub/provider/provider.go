@@ -27,6 +27,9 @@ import (
 type Target interface {
 	GetProvider() ttnpb.ApplicationPubSub_Provider
 
+	GetApplicationID() string
+	GetPubSubID() string
+
 	GetBaseTopic() string
 	GetDownlinkPush() *ttnpb.ApplicationPubSub_Message
 	GetDownlinkReplace() *ttnpb.ApplicationPubSub_Message
@@ -0,0 +1,48 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+var (
+	errInvalidCAPEMData = errors.DefineInvalidArgument("ca_pem_data", "CA PEM data is invalid")
+	errClientKeyPair    = errors.DefineInvalidArgument("client_key_pair", "client certificate and key are invalid")
+)
+
+// CreateTLSConfig returns a TLS configuration using the given PEM formatted CA, client certificate and client key.
+// If no CA is provided, the system-wide CA pool is used. If no client certificate is provided, the client does not
+// authenticate using a certificate.
+func CreateTLSConfig(caPEM, certPEM, keyPEM []byte) (*tls.Config, error) {
+	config := &tls.Config{}
+	if len(caPEM) != 0 {
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, errInvalidCAPEMData
+		}
+	}
+	if len(certPEM) != 0 || len(keyPEM) != 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, errClientKeyPair.WithCause(err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
@@ -0,0 +1,208 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides a bbolt implementation of the webhook registry.
+package bolt
+
+import (
+	"context"
+	"time"
+
+	"go.etcd.io/bbolt"
+	ttnbolt "go.thethings.network/lorawan-stack/pkg/bolt"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+)
+
+// appendImplicitWebhookGetPaths appends implicit ttnpb.ApplicationWebhook get paths to paths.
+func appendImplicitWebhookGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 3+len(paths)),
+		"created_at",
+		"ids",
+		"updated_at",
+	), paths...)
+}
+
+func applyWebhookFieldMask(dst, src *ttnpb.ApplicationWebhook, paths ...string) (*ttnpb.ApplicationWebhook, error) {
+	if dst == nil {
+		dst = &ttnpb.ApplicationWebhook{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// WebhookRegistry is a bbolt webhook registry.
+type WebhookRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+func (r *WebhookRegistry) appPrefix(uid string) []byte {
+	return r.Bolt.Key("uid", uid, "")
+}
+
+func (r *WebhookRegistry) idKey(appUID, id string) []byte {
+	return r.Bolt.Key("uid", appUID, id)
+}
+
+// Get implements WebhookRegistry.
+func (r WebhookRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string) (*ttnpb.ApplicationWebhook, error) {
+	pb := &ttnpb.ApplicationWebhook{}
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.GetProto(b, r.idKey(unique.ID(ctx, ids.ApplicationIdentifiers), ids.WebhookID), pb)
+	}); err != nil {
+		return nil, err
+	}
+	return applyWebhookFieldMask(nil, pb, appendImplicitWebhookGetPaths(paths...)...)
+}
+
+// List implements WebhookRegistry.
+func (r WebhookRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationWebhook, error) {
+	var pbs []*ttnpb.ApplicationWebhook
+	err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.ForEachPrefix(b, r.appPrefix(unique.ID(ctx, ids)), func(_, v []byte) (bool, error) {
+			pb := &ttnpb.ApplicationWebhook{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return false, err
+			}
+			pb, err := applyWebhookFieldMask(nil, pb, appendImplicitWebhookGetPaths(paths...)...)
+			if err != nil {
+				return false, err
+			}
+			pbs = append(pbs, pb)
+			return true, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbs, nil
+}
+
+// Set implements WebhookRegistry.
+func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, gets []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
+	appUID := unique.ID(ctx, ids.ApplicationIdentifiers)
+	ik := r.idKey(appUID, ids.WebhookID)
+
+	var pb *ttnpb.ApplicationWebhook
+	err := r.Bolt.Watch(func(tx *ttnbolt.WatchTx) (func(*bbolt.Bucket) error, error) {
+		v, err := tx.Get(ik)
+		if err != nil {
+			return nil, err
+		}
+		var stored *ttnpb.ApplicationWebhook
+		if v != nil {
+			stored = &ttnpb.ApplicationWebhook{}
+			if err := ttnbolt.UnmarshalProto(v, stored); err != nil {
+				return nil, err
+			}
+		}
+
+		gets = appendImplicitWebhookGetPaths(gets...)
+
+		if stored != nil {
+			pb = &ttnpb.ApplicationWebhook{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return nil, err
+			}
+			pb, err = applyWebhookFieldMask(nil, pb, gets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return nil, err
+		}
+		if stored == nil && pb == nil {
+			return nil, nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyWebhookFieldMask(nil, stored, gets...)
+			return nil, err
+		}
+
+		if pb == nil && len(sets) == 0 {
+			return func(b *bbolt.Bucket) error {
+				return b.Delete(ik)
+			}, nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.ApplicationWebhook{}
+		}
+
+		pb.UpdatedAt = time.Now().UTC()
+		sets = append(append(sets[:0:0], sets...),
+			"updated_at",
+		)
+
+		updated := &ttnpb.ApplicationWebhook{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.webhook_id",
+			); err != nil {
+				return nil, errInvalidFieldmask.WithCause(err)
+			}
+
+			pb.CreatedAt = pb.UpdatedAt
+			sets = append(sets, "created_at")
+
+			updated, err = applyWebhookFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+			if updated.ApplicationID != ids.ApplicationID || updated.WebhookID != ids.WebhookID {
+				return nil, errInvalidIdentifiers
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.webhook_id") && pb.WebhookID != stored.WebhookID {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.webhook_id")
+			}
+			if err := ttnbolt.UnmarshalProto(v, updated); err != nil {
+				return nil, err
+			}
+			updated, err = applyWebhookFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := updated.ValidateFields(sets...); err != nil {
+			return nil, err
+		}
+
+		pb, err = applyWebhookFieldMask(nil, updated, gets...)
+		if err != nil {
+			return nil, err
+		}
+		return func(b *bbolt.Bucket) error {
+			return ttnbolt.SetProto(b, ik, updated)
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
@@ -0,0 +1,183 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	errClientCertificate          = errors.DefineInvalidArgument("client_certificate", "invalid client certificate")
+	errNoClientCertificateKey     = errors.DefineInvalidArgument("no_client_certificate_key", "no client certificate and private key set")
+	errClientCertificateTransport = errors.DefineFailedPrecondition("client_certificate_transport", "client certificates are not supported by the HTTP transport")
+)
+
+type clientCertificateKeyType struct{}
+
+var clientCertificateKey clientCertificateKeyType
+
+// withClientCertificate returns a shallow copy of the request that is performed with the given client certificate.
+func withClientCertificate(req *http.Request, cert *tls.Certificate) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), clientCertificateKey, cert))
+}
+
+func clientCertificateFromContext(ctx context.Context) (*tls.Certificate, bool) {
+	cert, ok := ctx.Value(clientCertificateKey).(*tls.Certificate)
+	return cert, ok
+}
+
+// encryptClientCertificate validates the client certificate and its private key, and returns the client certificate
+// with the private key encrypted using the key vault and KEK label.
+func encryptClientCertificate(ctx context.Context, cc *ttnpb.ApplicationWebhook_ClientCertificate, kekLabel string, keyVault crypto.KeyVault) (*ttnpb.ApplicationWebhook_ClientCertificate, error) {
+	if len(cc.Certificate) == 0 || len(cc.Key) == 0 {
+		return nil, errNoClientCertificateKey
+	}
+	if _, err := tls.X509KeyPair(cc.Certificate, cc.Key); err != nil {
+		return nil, errClientCertificate.WithCause(err)
+	}
+	encryptedKey, err := cryptoutil.WrapBytes(ctx, cc.Key, kekLabel, keyVault)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationWebhook_ClientCertificate{
+		Certificate:  cc.Certificate,
+		EncryptedKey: encryptedKey,
+		KEKLabel:     kekLabel,
+	}, nil
+}
+
+// cachedClientCertificate is a decrypted client certificate of a webhook.
+type cachedClientCertificate struct {
+	hash string
+	cert *tls.Certificate
+}
+
+// clientCertificate returns the TLS certificate of the client certificate of the webhook, decrypting the private key
+// if necessary. Decrypted certificates are cached per webhook, so that the same certificate results in the same
+// *tls.Certificate. When the client certificate of the webhook changes, the HTTP client of the previous certificate is
+// evicted from the sink.
+func (w *webhooks) clientCertificate(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, cc *ttnpb.ApplicationWebhook_ClientCertificate) (*tls.Certificate, error) {
+	h := sha256.New()
+	h.Write(cc.Certificate)
+	h.Write(cc.EncryptedKey)
+	h.Write([]byte(cc.KEKLabel))
+	hash := string(h.Sum(nil))
+	uid := unique.ID(ctx, ids)
+	if cached, ok := w.certificates.Load(uid); ok && cached.(*cachedClientCertificate).hash == hash {
+		return cached.(*cachedClientCertificate).cert, nil
+	}
+	key, err := cryptoutil.UnwrapBytes(ctx, cc.EncryptedKey, cc.KEKLabel, w.keyVault)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair(cc.Certificate, key)
+	if err != nil {
+		return nil, errClientCertificate.WithCause(err)
+	}
+	w.evictClientCertificate(uid)
+	w.certificates.Store(uid, &cachedClientCertificate{
+		hash: hash,
+		cert: &cert,
+	})
+	return &cert, nil
+}
+
+// evictClientCertificate evicts the cached client certificate of the webhook with the given unique ID and the HTTP
+// client that presents it.
+func (w *webhooks) evictClientCertificate(uid string) {
+	cached, ok := w.certificates.Load(uid)
+	if !ok {
+		return
+	}
+	w.certificates.Delete(uid)
+	if sink, ok := w.target.(clientEvicter); ok {
+		sink.evictClient(cached.(*cachedClientCertificate).cert)
+	}
+}
+
+// Evict implements Webhooks.
+func (w *webhooks) Evict(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) {
+	w.evictClientCertificate(unique.ID(ctx, ids))
+}
+
+// clientEvicter is a Sink that keeps an HTTP client per client certificate.
+type clientEvicter interface {
+	evictClient(cert *tls.Certificate)
+}
+
+// clientFor returns the HTTP client that presents the given client certificate.
+// The client shares the configuration of the sink's HTTP client, but uses a separate transport.
+func (s *HTTPClientSink) clientFor(cert *tls.Certificate) (*http.Client, error) {
+	if client, ok := s.clients.Load(cert); ok {
+		return client.(*http.Client), nil
+	}
+	var transport *http.Transport
+	switch rt := s.Client.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = rt.Clone()
+	default:
+		return nil, errClientCertificateTransport
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.Certificates = []tls.Certificate{*cert}
+	client := &http.Client{
+		Transport:     transport,
+		CheckRedirect: s.Client.CheckRedirect,
+		Jar:           s.Client.Jar,
+		Timeout:       s.Client.Timeout,
+	}
+	actual, _ := s.clients.LoadOrStore(cert, client)
+	return actual.(*http.Client), nil
+}
+
+// evictClient evicts the HTTP client that presents the given client certificate and closes its idle connections.
+func (s *HTTPClientSink) evictClient(cert *tls.Certificate) {
+	client, ok := s.clients.Load(cert)
+	if !ok {
+		return
+	}
+	s.clients.Delete(cert)
+	client.(*http.Client).CloseIdleConnections()
+}
+
+// evictClient evicts the HTTP client that presents the given client certificate from the target sink.
+func (s *QueuedSink) evictClient(cert *tls.Certificate) {
+	if target, ok := s.Target.(clientEvicter); ok {
+		target.evictClient(cert)
+	}
+}
+
+// stripClientCertificateKey removes the private key material of the client certificate of the webhook.
+func stripClientCertificateKey(hook *ttnpb.ApplicationWebhook) *ttnpb.ApplicationWebhook {
+	if hook == nil || hook.ClientCertificate == nil {
+		return hook
+	}
+	hook.ClientCertificate.Key = nil
+	hook.ClientCertificate.EncryptedKey = nil
+	return hook
+}
@@ -0,0 +1,216 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/redis"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func generateClientCertificate(t *testing.T) (certPEM, keyPEM, der []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err = x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %s", err)
+	}
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, der
+}
+
+func TestWebhookClientCertificate(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	certPEM, keyPEM, der := generateClientCertificate(t)
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+	})
+	encryptedKey, err := cryptoutil.WrapBytes(ctx, keyPEM, "test", keyVault)
+	if err != nil {
+		t.Fatalf("Failed to wrap key: %s", err)
+	}
+
+	peerCh := make(chan []*x509.Certificate, 1)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		peerCh <- r.TLS.PeerCertificates
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAnyClientCert,
+	}
+	closedCh := make(chan struct{}, 1)
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state != http.StateClosed {
+			return
+		}
+		select {
+		case closedCh <- struct{}{}:
+		default:
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	redisClient, flush := test.NewRedis(t, "web_test")
+	defer flush()
+	defer redisClient.Close()
+	registry := &redis.WebhookRegistry{
+		Redis: redisClient,
+	}
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	setClientCertificate := func(certPEM, keyPEM []byte) {
+		encryptedKey, err := cryptoutil.WrapBytes(ctx, keyPEM, "test", keyVault)
+		if err != nil {
+			t.Fatalf("Failed to wrap key: %s", err)
+		}
+		_, err = registry.Set(ctx, ids, nil, func(_ *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			return &ttnpb.ApplicationWebhook{
+					ClientCertificate: &ttnpb.ApplicationWebhook_ClientCertificate{
+						Certificate:  certPEM,
+						EncryptedKey: encryptedKey,
+						KEKLabel:     "test",
+					},
+				},
+				[]string{
+					"client_certificate",
+				}, nil
+		})
+		if err != nil {
+			t.Fatalf("Failed to set client certificate in registry: %s", err)
+		}
+	}
+	_, err = registry.Set(ctx, ids, nil, func(_ *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		return &ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: ids,
+				BaseURL:                       server.URL,
+				Format:                        "json",
+				ClientCertificate: &ttnpb.ApplicationWebhook_ClientCertificate{
+					Certificate:  certPEM,
+					EncryptedKey: encryptedKey,
+					KEKLabel:     "test",
+				},
+				UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+					Path: "up",
+				},
+			},
+			[]string{
+				"base_url",
+				"client_certificate",
+				"format",
+				"ids",
+				"uplink_message",
+			}, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to set webhook in registry: %s", err)
+	}
+
+	sink := &web.HTTPClientSink{Client: server.Client()}
+	w := web.NewWebhooks(ctx, nil, registry, sink, web.DownlinksConfig{}, web.WithKeyVault(keyVault))
+	sub := w.NewSubscription()
+
+	sendUp := func(fCnt uint32) {
+		err := sub.SendUp(ctx, &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					SessionKeyID: []byte{0x11},
+					FPort:        42,
+					FCnt:         fCnt,
+					FRMPayload:   []byte{0x1, 0x2, 0x3},
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	assertClientCertificate := func(der []byte) {
+		select {
+		case certs := <-peerCh:
+			if a.So(certs, should.HaveLength, 1) {
+				a.So(certs[0].Raw, should.Resemble, der)
+			}
+		case <-time.After(timeout):
+			t.Fatal("Expected request with client certificate but nothing received")
+		}
+	}
+	assertConnectionClosed := func() {
+		select {
+		case <-closedCh:
+		case <-time.After(timeout):
+			t.Fatal("Expected connection to be closed")
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		sendUp(uint32(i))
+		assertClientCertificate(der)
+	}
+
+	// Changing the client certificate closes the connections of the previous certificate.
+	certPEM, keyPEM, der = generateClientCertificate(t)
+	setClientCertificate(certPEM, keyPEM)
+	sendUp(2)
+	assertClientCertificate(der)
+	assertConnectionClosed()
+
+	// Evicting the webhook closes the connections of the client certificate.
+	w.Evict(ctx, ids)
+	assertConnectionClosed()
+	sendUp(3)
+	assertClientCertificate(der)
+}
@@ -0,0 +1,196 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"crypto/rand"
+	"net/http"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// DeliveryQueue is a persistent queue of webhook deliveries.
+type DeliveryQueue interface {
+	// Add adds the delivery to the queue, to be attempted at startAt.
+	// If startAt is zero, the delivery is attempted as soon as possible.
+	Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, startAt time.Time) error
+	// Pop calls f on the earliest delivery in the queue, for which the timestamp is in range [0, time.Now()],
+	// if such is available, otherwise it blocks until it is.
+	// Context passed to f must be derived from ctx.
+	Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) error) error
+	// AddFailed stores the permanently failed delivery.
+	AddFailed(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error
+	// ListFailed returns the most recent permanently failed deliveries of the webhook.
+	// If limit is 0, all stored failed deliveries are returned.
+	ListFailed(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit int) ([]*ttnpb.ApplicationWebhookDelivery, error)
+	// ReplayFailed moves the permanently failed deliveries of the webhook back to the queue.
+	// If deliveryIDs is empty, all failed deliveries of the webhook are replayed.
+	ReplayFailed(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) error
+	// ClearFailed removes all permanently failed deliveries of the webhook.
+	ClearFailed(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) error
+}
+
+// RetryConfig defines the configuration of the webhook delivery retries.
+type RetryConfig struct {
+	Attempts       int           `name:"attempts" description:"Maximum number of delivery attempts of a message"`
+	InitialBackoff time.Duration `name:"initial-backoff" description:"Time to wait before retrying a failed delivery"`
+	MaxBackoff     time.Duration `name:"max-backoff" description:"Maximum time to wait before retrying a failed delivery"`
+	KeepFailed     int           `name:"keep-failed" description:"Number of permanently failed deliveries to keep per webhook"`
+	PendingTimeout time.Duration `name:"pending-timeout" description:"Time after which deliveries that are not processed are queued again"`
+}
+
+// Backoff returns the time to wait before the next delivery attempt, after the given number of failed attempts.
+// The backoff doubles with every attempt, starting from InitialBackoff, and is capped at MaxBackoff.
+func (c RetryConfig) Backoff(attempts uint32) time.Duration {
+	backoff := c.InitialBackoff
+	for i := uint32(1); i < attempts; i++ {
+		if c.MaxBackoff > 0 && backoff >= c.MaxBackoff {
+			break
+		}
+		backoff *= 2
+	}
+	if c.MaxBackoff > 0 && backoff > c.MaxBackoff {
+		backoff = c.MaxBackoff
+	}
+	return backoff
+}
+
+// getDeliveryPaths are the ttnpb.ApplicationWebhook paths used to create the request of a delivery.
+var getDeliveryPaths = []string{
+	"base_url",
+	"client_certificate",
+	"downlink_api_key",
+	"downlink_ack",
+	"downlink_failed",
+	"downlink_nack",
+	"downlink_queued",
+	"downlink_sent",
+	"format",
+	"headers",
+	"join_accept",
+	"location_solved",
+	"signing_secret",
+	"uplink_message",
+}
+
+func newDeliveryID() string {
+	return ulid.MustNew(ulid.Now(), rand.Reader).String()
+}
+
+func (w *webhooks) enqueue(ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook) error {
+	return w.deliveries.Add(ctx, &ttnpb.ApplicationWebhookDelivery{
+		ApplicationWebhookIdentifiers: hook.ApplicationWebhookIdentifiers,
+		DeliveryID:                    newDeliveryID(),
+		Up:                            msg,
+		CreatedAt:                     time.Now().UTC(),
+	}, time.Time{})
+}
+
+var (
+	errNoDeliveryQueue = errors.DefineFailedPrecondition("no_delivery_queue", "webhook deliveries are not queued")
+	errDeliveryAttempt = errors.DefineUnavailable("delivery_attempt", "delivery attempt failed: {message}")
+)
+
+func deliveryErrorDetails(err error) *ttnpb.ErrorDetails {
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	return ttnpb.ErrorDetailsToProto(errDeliveryAttempt.WithAttributes("message", err.Error()))
+}
+
+// retryable returns whether the delivery that failed with err may succeed when attempted again.
+func retryable(err error) bool {
+	return !errors.Resemble(err, errRequestRejected) &&
+		!errors.Resemble(err, errFormatNotFound) &&
+		!errors.Resemble(err, errClientCertificate)
+}
+
+// Deliver processes deliveries from the delivery queue until ctx is done.
+func (w *webhooks) Deliver(ctx context.Context) error {
+	if w.deliveries == nil {
+		return errNoDeliveryQueue
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if err := w.deliveries.Pop(ctx, w.deliver); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *webhooks) deliver(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"application_id", delivery.ApplicationID,
+		"webhook_id", delivery.WebhookID,
+		"delivery_id", delivery.DeliveryID,
+		"attempts", delivery.Attempts,
+	))
+	ctx = log.NewContext(ctx, logger)
+
+	hook, err := w.registry.Get(ctx, delivery.ApplicationWebhookIdentifiers, getDeliveryPaths)
+	if errors.IsNotFound(err) || err == nil && hook == nil {
+		logger.Debug("Webhook not found, drop delivery")
+		return nil
+	}
+	// Errors retrieving the webhook are retried like failed delivery attempts, so that the delivery is not lost.
+	var req *http.Request
+	if err == nil {
+		req, err = w.newRequest(ctx, delivery.Up, hook)
+		if err == nil && req == nil {
+			logger.Debug("Message type not enabled for webhook, drop delivery")
+			return nil
+		}
+	}
+	if err == nil {
+		logger.WithField("url", req.URL).Debug("Process delivery")
+		err = w.target.Process(req)
+	}
+	if err == nil {
+		registerDeliverySuccess(ctx, delivery)
+		return nil
+	}
+
+	delivery.Attempts++
+	delivery.LastAttemptedAt = timePtr(time.Now().UTC())
+	delivery.LastError = deliveryErrorDetails(err)
+	if retryable(err) && int(delivery.Attempts) < w.retry.Attempts {
+		backoff := w.retry.Backoff(delivery.Attempts)
+		logger.WithError(err).WithField("backoff", backoff).Debug("Delivery failed, retry")
+		if err := w.deliveries.Add(ctx, delivery, time.Now().Add(backoff)); err != nil {
+			return err
+		}
+		registerDeliveryRetry(ctx, delivery, err)
+		return nil
+	}
+	logger.WithError(err).Warn("Delivery failed permanently")
+	if err := w.deliveries.AddFailed(ctx, delivery); err != nil {
+		return err
+	}
+	registerDeliveryFail(ctx, delivery, err)
+	return nil
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
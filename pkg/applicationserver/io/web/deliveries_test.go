@@ -0,0 +1,250 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRetryConfigBackoff(t *testing.T) {
+	for _, tc := range []struct {
+		Config   web.RetryConfig
+		Attempts uint32
+		Expected time.Duration
+	}{
+		{
+			Config:   web.RetryConfig{InitialBackoff: time.Second, MaxBackoff: time.Minute},
+			Attempts: 1,
+			Expected: time.Second,
+		},
+		{
+			Config:   web.RetryConfig{InitialBackoff: time.Second, MaxBackoff: time.Minute},
+			Attempts: 3,
+			Expected: 4 * time.Second,
+		},
+		{
+			Config:   web.RetryConfig{InitialBackoff: time.Second, MaxBackoff: time.Minute},
+			Attempts: 10,
+			Expected: time.Minute,
+		},
+		{
+			Config:   web.RetryConfig{InitialBackoff: time.Second},
+			Attempts: 5,
+			Expected: 16 * time.Second,
+		},
+	} {
+		t.Run(fmt.Sprintf("%v/%v/%d", tc.Config.InitialBackoff, tc.Config.MaxBackoff, tc.Attempts), func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(tc.Config.Backoff(tc.Attempts), should.Equal, tc.Expected)
+		})
+	}
+}
+
+// flakyWebhookRegistry is a web.WebhookRegistry that fails to get webhooks with the errors sent on getErrCh.
+type flakyWebhookRegistry struct {
+	web.WebhookRegistry
+	getErrCh chan error
+}
+
+func (r *flakyWebhookRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string) (*ttnpb.ApplicationWebhook, error) {
+	select {
+	case err := <-r.getErrCh:
+		return nil, err
+	default:
+	}
+	return r.WebhookRegistry.Get(ctx, ids, paths)
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	redisClient, flush := test.NewRedis(t, "web_test")
+	defer flush()
+	defer redisClient.Close()
+
+	statusCh := make(chan int, 1)
+	reqCh := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case reqCh <- struct{}{}:
+		default:
+		}
+		w.WriteHeader(<-statusCh)
+	}))
+	defer server.Close()
+
+	registry := &redis.WebhookRegistry{
+		Redis: redisClient,
+	}
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	_, err := registry.Set(ctx, ids, nil, func(_ *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		return &ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: ids,
+				BaseURL:                       server.URL,
+				Format:                        "json",
+				UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+					Path: "up",
+				},
+			},
+			[]string{
+				"base_url",
+				"format",
+				"ids",
+				"uplink_message",
+			}, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to set webhook in registry: %s", err)
+	}
+
+	queue := redis.NewDeliveryQueue(redisClient, 100, "test", "test", 10)
+	if err := queue.Init(); err != nil {
+		t.Fatalf("Failed to initialize delivery queue: %s", err)
+	}
+	go queue.Run(ctx)
+
+	flakyRegistry := &flakyWebhookRegistry{
+		WebhookRegistry: registry,
+		getErrCh:        make(chan error, 1),
+	}
+	w := web.NewWebhooks(ctx, nil, flakyRegistry, &web.HTTPClientSink{Client: http.DefaultClient}, web.DownlinksConfig{},
+		web.WithDeliveryQueue(queue, web.RetryConfig{
+			Attempts:       3,
+			InitialBackoff: test.Delay,
+			MaxBackoff:     test.Delay << 2,
+		}),
+	)
+	go w.Deliver(ctx)
+	sub := w.NewSubscription()
+
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+
+	respond := func(t *testing.T, status int) {
+		select {
+		case <-reqCh:
+		case <-time.After(timeout):
+			t.Fatal("Expected delivery attempt")
+		}
+		statusCh <- status
+	}
+	expectNoAttempt := func(t *testing.T) {
+		select {
+		case <-reqCh:
+			t.Fatal("Unexpected delivery attempt")
+		case <-time.After(timeout):
+		}
+	}
+	listFailed := func(t *testing.T) []*ttnpb.ApplicationWebhookDelivery {
+		deliveries, err := queue.ListFailed(ctx, ids, 0)
+		if err != nil {
+			t.Fatalf("Failed to list failed deliveries: %s", err)
+		}
+		return deliveries
+	}
+
+	t.Run("RetrySucceeds", func(t *testing.T) {
+		a := assertions.New(t)
+		if err := sub.SendUp(ctx, up); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		respond(t, http.StatusServiceUnavailable)
+		respond(t, http.StatusOK)
+		expectNoAttempt(t)
+		a.So(listFailed(t), should.BeEmpty)
+	})
+
+	t.Run("RegistryFailure", func(t *testing.T) {
+		a := assertions.New(t)
+		flakyRegistry.getErrCh <- errors.New("test")
+		if err := sub.SendUp(ctx, up); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		respond(t, http.StatusOK)
+		expectNoAttempt(t)
+		a.So(listFailed(t), should.BeEmpty)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		a := assertions.New(t)
+		if err := sub.SendUp(ctx, up); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		respond(t, http.StatusBadRequest)
+		expectNoAttempt(t)
+		failed := listFailed(t)
+		if !a.So(failed, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		a.So(failed[0].Attempts, should.Equal, uint32(1))
+		a.So(failed[0].LastAttemptedAt, should.NotBeNil)
+		a.So(failed[0].LastError, should.NotBeNil)
+
+		if err := queue.ReplayFailed(ctx, ids, failed[0].DeliveryID); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		respond(t, http.StatusOK)
+		expectNoAttempt(t)
+		a.So(listFailed(t), should.BeEmpty)
+	})
+
+	t.Run("AttemptsExhausted", func(t *testing.T) {
+		a := assertions.New(t)
+		if err := sub.SendUp(ctx, up); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		for i := 0; i < 3; i++ {
+			respond(t, http.StatusServiceUnavailable)
+		}
+		expectNoAttempt(t)
+		failed := listFailed(t)
+		if a.So(failed, should.HaveLength, 1) {
+			a.So(failed[0].Attempts, should.Equal, uint32(3))
+		}
+
+		if err := queue.ClearFailed(ctx, ids); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(listFailed(t), should.BeEmpty)
+	})
+}
@@ -20,6 +20,8 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -38,16 +40,50 @@ func appendImplicitWebhookGetPaths(paths ...string) []string {
 }
 
 type webhookRegistryRPC struct {
-	webhooks  WebhookRegistry
-	templates *TemplateStore
+	webhooks   WebhookRegistry
+	templates  *TemplateStore
+	deliveries DeliveryQueue
+
+	keyVault crypto.KeyVault
+	kekLabel string
+
+	evict func(context.Context, ttnpb.ApplicationWebhookIdentifiers)
+}
+
+// RegistryRPCOption configures the webhook registry gRPC server.
+type RegistryRPCOption func(*webhookRegistryRPC)
+
+// WithClientCertificateKEK configures the webhook registry gRPC server to encrypt the private keys of client
+// certificates using the given key vault and KEK label.
+// If the KEK label is empty, the private keys are stored in the clear.
+func WithClientCertificateKEK(keyVault crypto.KeyVault, kekLabel string) RegistryRPCOption {
+	return func(s *webhookRegistryRPC) {
+		s.keyVault = keyVault
+		s.kekLabel = kekLabel
+	}
+}
+
+// WithEvict configures the webhook registry gRPC server to evict the cached state of webhooks when they are set or
+// deleted, typically using Webhooks.Evict.
+func WithEvict(evict func(context.Context, ttnpb.ApplicationWebhookIdentifiers)) RegistryRPCOption {
+	return func(s *webhookRegistryRPC) {
+		s.evict = evict
+	}
 }
 
 // NewWebhookRegistryRPC returns a new webhook registry gRPC server.
-func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates *TemplateStore) ttnpb.ApplicationWebhookRegistryServer {
-	return &webhookRegistryRPC{
-		webhooks:  webhooks,
-		templates: templates,
+// If deliveries is nil, the failed deliveries cannot be listed nor replayed.
+func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates *TemplateStore, deliveries DeliveryQueue, opts ...RegistryRPCOption) ttnpb.ApplicationWebhookRegistryServer {
+	s := &webhookRegistryRPC{
+		webhooks:   webhooks,
+		templates:  templates,
+		deliveries: deliveries,
+		keyVault:   cryptoutil.EmptyKeyVault,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 func (s webhookRegistryRPC) GetFormats(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.ApplicationWebhookFormats, error) {
@@ -72,7 +108,11 @@ func (s webhookRegistryRPC) Get(ctx context.Context, req *ttnpb.GetApplicationWe
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Get(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...))
+	hook, err := s.webhooks.Get(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...))
+	if err != nil {
+		return nil, err
+	}
+	return stripClientCertificateKey(hook), nil
 }
 
 func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplicationWebhooksRequest) (*ttnpb.ApplicationWebhooks, error) {
@@ -88,6 +128,9 @@ func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplication
 			setTotalHeader(ctx, uint64(len(webhooks)))
 		}
 	}()
+	for _, hook := range webhooks {
+		stripClientCertificateKey(hook)
+	}
 	return &ttnpb.ApplicationWebhooks{
 		Webhooks: webhooks,
 	}, nil
@@ -101,17 +144,32 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 	); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Set(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...),
+	paths := ttnpb.FlattenPaths(req.FieldMask.Paths, []string{"client_certificate"})
+	if ttnpb.HasAnyField(paths, "client_certificate") && req.ClientCertificate != nil {
+		cc, err := encryptClientCertificate(ctx, req.ClientCertificate, s.kekLabel, s.keyVault)
+		if err != nil {
+			return nil, err
+		}
+		req.ClientCertificate = cc
+	}
+	hook, err := s.webhooks.Set(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(paths...),
 		func(webhook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
 			if webhook != nil {
-				return &req.ApplicationWebhook, req.FieldMask.Paths, nil
+				return &req.ApplicationWebhook, paths, nil
 			}
-			return &req.ApplicationWebhook, append(req.FieldMask.Paths,
+			return &req.ApplicationWebhook, append(paths,
 				"ids.application_ids",
 				"ids.webhook_id",
 			), nil
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	if s.evict != nil {
+		s.evict(ctx, req.ApplicationWebhookIdentifiers)
+	}
+	return stripClientCertificateKey(hook), nil
 }
 
 func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWebhookIdentifiers) (*pbtypes.Empty, error) {
@@ -130,5 +188,50 @@ func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWe
 	if err != nil {
 		return nil, err
 	}
+	if s.evict != nil {
+		s.evict(ctx, *req)
+	}
+	if s.deliveries != nil {
+		if err := s.deliveries.ClearFailed(ctx, *req); err != nil {
+			return nil, err
+		}
+	}
+	return ttnpb.Empty, nil
+}
+
+func (s webhookRegistryRPC) ListFailedDeliveries(ctx context.Context, req *ttnpb.ListApplicationWebhookFailedDeliveriesRequest) (*ttnpb.ApplicationWebhookDeliveries, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.deliveries == nil {
+		return nil, errNoDeliveryQueue
+	}
+	deliveries, err := s.deliveries.ListFailed(ctx, req.ApplicationWebhookIdentifiers, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, uint64(len(deliveries)))
+		}
+	}()
+	return &ttnpb.ApplicationWebhookDeliveries{
+		Deliveries: deliveries,
+	}, nil
+}
+
+func (s webhookRegistryRPC) ReplayFailedDeliveries(ctx context.Context, req *ttnpb.ReplayApplicationWebhookFailedDeliveriesRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	); err != nil {
+		return nil, err
+	}
+	if s.deliveries == nil {
+		return nil, errNoDeliveryQueue
+	}
+	if err := s.deliveries.ReplayFailed(ctx, req.ApplicationWebhookIdentifiers, req.DeliveryIDs...); err != nil {
+		return nil, err
+	}
 	return ttnpb.Empty, nil
 }
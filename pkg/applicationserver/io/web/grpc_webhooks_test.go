@@ -17,6 +17,7 @@ package web_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	pbtypes "github.com/gogo/protobuf/types"
@@ -26,6 +27,8 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -55,7 +58,20 @@ func TestWebhookRegistryRPC(t *testing.T) {
 	defer flush()
 	defer redisClient.Close()
 	webhookReg := &redis.WebhookRegistry{Redis: redisClient}
-	srv := web.NewWebhookRegistryRPC(webhookReg, nil)
+	deliveries := redis.NewDeliveryQueue(redisClient, 100, "test", "test", 10)
+	if err := deliveries.Init(); err != nil {
+		t.Fatalf("Failed to initialize delivery queue: %s", err)
+	}
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+	})
+	evictCh := make(chan ttnpb.ApplicationWebhookIdentifiers, 1)
+	srv := web.NewWebhookRegistryRPC(webhookReg, nil, deliveries,
+		web.WithClientCertificateKEK(keyVault, "test"),
+		web.WithEvict(func(_ context.Context, ids ttnpb.ApplicationWebhookIdentifiers) {
+			evictCh <- ids
+		}),
+	)
 	c.RegisterGRPC(&mockRegisterer{ctx, srv})
 	componenttest.StartComponent(t, c)
 	defer c.Close()
@@ -68,6 +84,14 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		AuthValue:     registeredApplicationKey,
 		AllowInsecure: true,
 	})
+	assertEvicted := func(ids ttnpb.ApplicationWebhookIdentifiers) {
+		select {
+		case evicted := <-evictCh:
+			a.So(evicted, should.Resemble, ids)
+		case <-time.After(timeout):
+			t.Fatal("Expected webhook to be evicted")
+		}
+	}
 
 	// Formats.
 	{
@@ -106,6 +130,10 @@ func TestWebhookRegistryRPC(t *testing.T) {
 			},
 		}, creds)
 		a.So(err, should.BeNil)
+		assertEvicted(ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: registeredApplicationID,
+			WebhookID:              registeredWebhookID,
+		})
 	}
 
 	// List; assert one.
@@ -136,6 +164,115 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		a.So(res.BaseURL, should.Equal, "http://localhost/test")
 	}
 
+	// Set client certificate.
+	{
+		ids := ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: registeredApplicationID,
+			WebhookID:              registeredWebhookID,
+		}
+		certPEM, keyPEM, _ := generateClientCertificate(t)
+
+		_, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			ApplicationWebhook: ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: ids,
+				ClientCertificate: &ttnpb.ApplicationWebhook_ClientCertificate{
+					Certificate: certPEM,
+				},
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"client_certificate"},
+			},
+		}, creds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+		res, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			ApplicationWebhook: ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: ids,
+				SigningSecret:                 "signing-secret",
+				ClientCertificate: &ttnpb.ApplicationWebhook_ClientCertificate{
+					Certificate: certPEM,
+					Key:         keyPEM,
+				},
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"client_certificate.certificate", "client_certificate.key", "signing_secret"},
+			},
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(res.ClientCertificate, should.NotBeNil) {
+			a.So(res.SigningSecret, should.Equal, "signing-secret")
+			a.So(res.ClientCertificate.Certificate, should.Resemble, certPEM)
+			a.So(res.ClientCertificate.Key, should.BeEmpty)
+			a.So(res.ClientCertificate.EncryptedKey, should.BeEmpty)
+			a.So(res.ClientCertificate.KEKLabel, should.Equal, "test")
+		}
+		assertEvicted(ids)
+
+		stored, err := webhookReg.Get(ctx, ids, []string{"client_certificate"})
+		if a.So(err, should.BeNil) && a.So(stored.ClientCertificate, should.NotBeNil) {
+			a.So(stored.ClientCertificate.Key, should.BeEmpty)
+			a.So(stored.ClientCertificate.EncryptedKey, should.NotBeEmpty)
+			a.So(stored.ClientCertificate.EncryptedKey, should.NotResemble, keyPEM)
+			key, err := cryptoutil.UnwrapBytes(ctx, stored.ClientCertificate.EncryptedKey, "test", keyVault)
+			a.So(err, should.BeNil)
+			a.So(key, should.Resemble, keyPEM)
+		}
+
+		get, err := client.Get(ctx, &ttnpb.GetApplicationWebhookRequest{
+			ApplicationWebhookIdentifiers: ids,
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"client_certificate"},
+			},
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(get.ClientCertificate, should.NotBeNil) {
+			a.So(get.ClientCertificate.Certificate, should.Resemble, certPEM)
+			a.So(get.ClientCertificate.EncryptedKey, should.BeEmpty)
+		}
+	}
+
+	// Failed deliveries.
+	{
+		ids := ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: registeredApplicationID,
+			WebhookID:              registeredWebhookID,
+		}
+		err := deliveries.AddFailed(ctx, &ttnpb.ApplicationWebhookDelivery{
+			ApplicationWebhookIdentifiers: ids,
+			DeliveryID:                    "test-delivery",
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: registeredDeviceID,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort:      42,
+						FRMPayload: []byte{0x1, 0x2, 0x3},
+					},
+				},
+			},
+			Attempts: 5,
+		})
+		a.So(err, should.BeNil)
+
+		res, err := client.ListFailedDeliveries(ctx, &ttnpb.ListApplicationWebhookFailedDeliveriesRequest{
+			ApplicationWebhookIdentifiers: ids,
+		}, creds)
+		a.So(err, should.BeNil)
+		if a.So(res.Deliveries, should.HaveLength, 1) {
+			a.So(res.Deliveries[0].DeliveryID, should.Equal, "test-delivery")
+			a.So(res.Deliveries[0].Attempts, should.Equal, uint32(5))
+		}
+
+		_, err = client.ReplayFailedDeliveries(ctx, &ttnpb.ReplayApplicationWebhookFailedDeliveriesRequest{
+			ApplicationWebhookIdentifiers: ids,
+			DeliveryIDs:                   []string{"test-delivery"},
+		}, creds)
+		a.So(err, should.BeNil)
+
+		res, err = client.ListFailedDeliveries(ctx, &ttnpb.ListApplicationWebhookFailedDeliveriesRequest{
+			ApplicationWebhookIdentifiers: ids,
+		}, creds)
+		a.So(err, should.BeNil)
+		a.So(res.Deliveries, should.BeEmpty)
+	}
+
 	// Delete.
 	{
 		_, err := client.Delete(ctx, &ttnpb.ApplicationWebhookIdentifiers{
@@ -143,6 +280,10 @@ func TestWebhookRegistryRPC(t *testing.T) {
 			WebhookID:              registeredWebhookID,
 		}, creds)
 		a.So(err, should.BeNil)
+		assertEvicted(ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: registeredApplicationID,
+			WebhookID:              registeredWebhookID,
+		})
 	}
 
 	// Check empty.
@@ -234,7 +375,7 @@ description: Bar`),
 			a.So(err, should.BeNil)
 
 			c := componenttest.NewComponent(t, &component.Config{})
-			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store)})
+			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store, nil)})
 			componenttest.StartComponent(t, c)
 			defer c.Close()
 
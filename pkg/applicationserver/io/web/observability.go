@@ -0,0 +1,116 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/metrics"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtWebhookDeliveryRetry = events.Define(
+		"as.webhook.delivery.retry", "retry webhook delivery",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+	evtWebhookDeliveryFail = events.Define(
+		"as.webhook.delivery.fail", "fail webhook delivery",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+)
+
+const (
+	subsystem     = "as_webhook"
+	applicationID = "application_id"
+	webhookID     = "webhook_id"
+)
+
+var webhookMetrics = &deliveryMetrics{
+	deliveriesSucceeded: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "deliveries_succeeded_total",
+			Help:      "Number of webhook deliveries that succeeded",
+		},
+		[]string{applicationID, webhookID},
+	),
+	deliveriesRetried: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "deliveries_retried_total",
+			Help:      "Number of webhook deliveries that failed and are retried",
+		},
+		[]string{applicationID, webhookID},
+	),
+	deliveriesFailed: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "deliveries_failed_total",
+			Help:      "Number of webhook deliveries that failed permanently",
+		},
+		[]string{applicationID, webhookID},
+	),
+}
+
+func init() {
+	metrics.MustRegister(webhookMetrics)
+}
+
+type deliveryMetrics struct {
+	deliveriesSucceeded *metrics.ContextualCounterVec
+	deliveriesRetried   *metrics.ContextualCounterVec
+	deliveriesFailed    *metrics.ContextualCounterVec
+}
+
+func (m deliveryMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.deliveriesSucceeded.Describe(ch)
+	m.deliveriesRetried.Describe(ch)
+	m.deliveriesFailed.Describe(ch)
+}
+
+func (m deliveryMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.deliveriesSucceeded.Collect(ch)
+	m.deliveriesRetried.Collect(ch)
+	m.deliveriesFailed.Collect(ch)
+}
+
+var errDeliveryFailed = errors.DefineAborted("delivery_failed", "delivery `{delivery_id}` to webhook `{webhook_id}` failed")
+
+func deliveryError(delivery *ttnpb.ApplicationWebhookDelivery, err error) error {
+	return errDeliveryFailed.
+		WithAttributes(
+			"delivery_id", delivery.DeliveryID,
+			"webhook_id", delivery.WebhookID,
+			"attempts", delivery.Attempts).
+		WithCause(err)
+}
+
+func registerDeliverySuccess(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) {
+	webhookMetrics.deliveriesSucceeded.WithLabelValues(ctx, delivery.ApplicationID, delivery.WebhookID).Inc()
+}
+
+func registerDeliveryRetry(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, err error) {
+	events.Publish(evtWebhookDeliveryRetry(ctx, delivery.Up.EndDeviceIdentifiers, deliveryError(delivery, err)))
+	webhookMetrics.deliveriesRetried.WithLabelValues(ctx, delivery.ApplicationID, delivery.WebhookID).Inc()
+}
+
+func registerDeliveryFail(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, err error) {
+	events.Publish(evtWebhookDeliveryFail(ctx, delivery.Up.EndDeviceIdentifiers, deliveryError(delivery, err)))
+	webhookMetrics.deliveriesFailed.WithLabelValues(ctx, delivery.ApplicationID, delivery.WebhookID).Inc()
+}
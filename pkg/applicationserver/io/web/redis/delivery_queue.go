@@ -0,0 +1,216 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// DeliveryQueue is an implementation of web.DeliveryQueue.
+type DeliveryQueue struct {
+	*ttnredis.TaskQueue
+	// MaxFailed is the maximum number of permanently failed deliveries kept per webhook.
+	// If MaxFailed is 0, failed deliveries are not kept.
+	MaxFailed int64
+}
+
+const (
+	deliveriesKey = "deliveries"
+	failedKey     = "failed"
+)
+
+// NewDeliveryQueue returns a new webhook delivery queue.
+func NewDeliveryQueue(cl *ttnredis.Client, maxLen int64, group, id string, maxFailed int64) *DeliveryQueue {
+	return &DeliveryQueue{
+		TaskQueue: &ttnredis.TaskQueue{
+			Redis:  cl,
+			MaxLen: maxLen,
+			Group:  group,
+			ID:     id,
+			Key:    cl.Key(deliveriesKey),
+		},
+		MaxFailed: maxFailed,
+	}
+}
+
+func (q *DeliveryQueue) failedKey(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) string {
+	return ttnredis.Key(q.Key, failedKey, unique.ID(ctx, ids.ApplicationIdentifiers), ids.WebhookID)
+}
+
+func (q *DeliveryQueue) failedIDsKey(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) string {
+	return ttnredis.Key(q.failedKey(ctx, ids), "ids")
+}
+
+func (q *DeliveryQueue) add(r redis.Cmdable, delivery *ttnpb.ApplicationWebhookDelivery, startAt time.Time) error {
+	s, err := ttnredis.MarshalProto(delivery)
+	if err != nil {
+		return err
+	}
+	// The marshaled delivery is used as task payload. Payloads are unique, since each delivery has a unique ID
+	// and the number of attempts changes on every retry.
+	return ttnredis.AddTask(r, q.Key, q.MaxLen, s, startAt, false)
+}
+
+// Add implements web.DeliveryQueue.
+func (q *DeliveryQueue) Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, startAt time.Time) error {
+	return q.add(q.Redis, delivery, startAt)
+}
+
+// Pop implements web.DeliveryQueue.
+func (q *DeliveryQueue) Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) error) error {
+	return q.TaskQueue.Pop(ctx, func(s string, _ time.Time) error {
+		delivery := &ttnpb.ApplicationWebhookDelivery{}
+		if err := ttnredis.UnmarshalProto(s, delivery); err != nil {
+			// Invalid deliveries can never be processed, so they are dropped instead of being queued again.
+			log.FromContext(ctx).WithError(err).Warn("Failed to unmarshal delivery, drop delivery")
+			return nil
+		}
+		ctx, err := unique.WithContext(ctx, unique.ID(ctx, delivery.ApplicationIdentifiers))
+		if err != nil {
+			return err
+		}
+		return f(ctx, delivery)
+	})
+}
+
+// AddFailed implements web.DeliveryQueue.
+// Only the MaxFailed most recent failed deliveries are kept per webhook.
+func (q *DeliveryQueue) AddFailed(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+	if q.MaxFailed <= 0 {
+		return nil
+	}
+	s, err := ttnredis.MarshalProto(delivery)
+	if err != nil {
+		return err
+	}
+	fk := q.failedKey(ctx, delivery.ApplicationWebhookIdentifiers)
+	ik := q.failedIDsKey(ctx, delivery.ApplicationWebhookIdentifiers)
+	err = q.Redis.Watch(func(tx *redis.Tx) error {
+		evicted, err := tx.ZRange(ik, 0, -q.MaxFailed).Result()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Pipelined(func(p redis.Pipeliner) error {
+			if len(evicted) > 0 {
+				p.HDel(fk, evicted...)
+				members := make([]interface{}, 0, len(evicted))
+				for _, id := range evicted {
+					members = append(members, id)
+				}
+				p.ZRem(ik, members...)
+			}
+			p.HSet(fk, delivery.DeliveryID, s)
+			p.ZAdd(ik, redis.Z{
+				Score:  float64(time.Now().UnixNano()),
+				Member: delivery.DeliveryID,
+			})
+			return nil
+		})
+		return err
+	}, ik)
+	return ttnredis.ConvertError(err)
+}
+
+// ListFailed implements web.DeliveryQueue.
+func (q *DeliveryQueue) ListFailed(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit int) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	deliveryIDs, err := q.Redis.ZRevRange(q.failedIDsKey(ctx, ids), 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(deliveryIDs) == 0 {
+		return nil, nil
+	}
+	vs, err := q.Redis.HMGet(q.failedKey(ctx, ids), deliveryIDs...).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	deliveries := make([]*ttnpb.ApplicationWebhookDelivery, 0, len(vs))
+	for _, v := range vs {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		delivery := &ttnpb.ApplicationWebhookDelivery{}
+		if err := ttnredis.UnmarshalProto(s, delivery); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+// ReplayFailed implements web.DeliveryQueue.
+// The number of attempts of the replayed deliveries is reset.
+func (q *DeliveryQueue) ReplayFailed(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) error {
+	fk := q.failedKey(ctx, ids)
+	ik := q.failedIDsKey(ctx, ids)
+	err := q.Redis.Watch(func(tx *redis.Tx) error {
+		var vs map[string]string
+		if len(deliveryIDs) == 0 {
+			var err error
+			vs, err = tx.HGetAll(fk).Result()
+			if err != nil {
+				return err
+			}
+		} else {
+			res, err := tx.HMGet(fk, deliveryIDs...).Result()
+			if err != nil {
+				return err
+			}
+			vs = make(map[string]string, len(res))
+			for i, v := range res {
+				if s, ok := v.(string); ok {
+					vs[deliveryIDs[i]] = s
+				}
+			}
+		}
+		if len(vs) == 0 {
+			return nil
+		}
+		_, err := tx.Pipelined(func(p redis.Pipeliner) error {
+			fields := make([]string, 0, len(vs))
+			members := make([]interface{}, 0, len(vs))
+			for id, s := range vs {
+				delivery := &ttnpb.ApplicationWebhookDelivery{}
+				if err := ttnredis.UnmarshalProto(s, delivery); err != nil {
+					return err
+				}
+				delivery.Attempts = 0
+				if err := q.add(p, delivery, time.Time{}); err != nil {
+					return err
+				}
+				fields = append(fields, id)
+				members = append(members, id)
+			}
+			p.HDel(fk, fields...)
+			p.ZRem(ik, members...)
+			return nil
+		})
+		return err
+	}, fk, ik)
+	return ttnredis.ConvertError(err)
+}
+
+// ClearFailed implements web.DeliveryQueue.
+func (q *DeliveryQueue) ClearFailed(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) error {
+	return ttnredis.ConvertError(q.Redis.Del(q.failedKey(ctx, ids), q.failedIDsKey(ctx, ids)).Err())
+}
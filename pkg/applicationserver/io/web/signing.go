@@ -0,0 +1,64 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	signatureTimestampHeader = "X-Webhook-Timestamp"
+	signatureHeader          = "X-Webhook-Signature"
+	signaturePrefix          = "sha256="
+)
+
+// computeSignature returns the HMAC-SHA256 signature of the body at the given timestamp using the secret.
+// The signed content is the timestamp in seconds since the Unix epoch, a dot and the body.
+func computeSignature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// signRequest sets the signature headers of the request with the given body.
+func signRequest(req *http.Request, secret string, body []byte, now time.Time) {
+	timestamp := now.Unix()
+	req.Header.Set(signatureTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(signatureHeader, computeSignature(secret, timestamp, body))
+}
+
+// VerifySignature verifies the signature headers of a webhook request with the given body.
+// The timestamp of the signature may not deviate more than maxAge from now, which protects against replay attacks.
+// This function is provided for webhook receivers implemented in Go.
+func VerifySignature(header http.Header, body []byte, secret string, maxAge time.Duration, now time.Time) bool {
+	timestamp, err := strconv.ParseInt(header.Get(signatureTimestampHeader), 10, 64)
+	if err != nil {
+		return false
+	}
+	if maxAge > 0 {
+		if d := now.Sub(time.Unix(timestamp, 0)); d > maxAge || d < -maxAge {
+			return false
+		}
+	}
+	expected := computeSignature(secret, timestamp, body)
+	return hmac.Equal([]byte(header.Get(signatureHeader)), []byte(expected))
+}
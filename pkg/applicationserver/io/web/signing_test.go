@@ -0,0 +1,114 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/redis"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestWebhookSigning(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	redisClient, flush := test.NewRedis(t, "web_test")
+	defer flush()
+	defer redisClient.Close()
+	registry := &redis.WebhookRegistry{
+		Redis: redisClient,
+	}
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	_, err := registry.Set(ctx, ids, nil, func(_ *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		return &ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: ids,
+				BaseURL:                       "https://myapp.com/api/ttn/v3",
+				Format:                        "json",
+				SigningSecret:                 "signing-secret",
+				UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+					Path: "up",
+				},
+			},
+			[]string{
+				"base_url",
+				"format",
+				"ids",
+				"signing_secret",
+				"uplink_message",
+			}, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to set webhook in registry: %s", err)
+	}
+
+	testSink := &mockSink{
+		ch: make(chan *http.Request, 1),
+	}
+	w := web.NewWebhooks(ctx, nil, registry, testSink, web.DownlinksConfig{})
+	sub := w.NewSubscription()
+
+	err = sub.SendUp(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	var req *http.Request
+	select {
+	case req = <-testSink.ch:
+	case <-time.After(timeout):
+		t.Fatal("Expected message but nothing received")
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(req.Header.Get("X-Webhook-Timestamp"), should.NotBeEmpty)
+	a.So(req.Header.Get("X-Webhook-Signature"), should.StartWith, "sha256=")
+
+	now := time.Now()
+	a.So(web.VerifySignature(req.Header, body, "signing-secret", time.Minute, now), should.BeTrue)
+	a.So(web.VerifySignature(req.Header, body, "other-secret", time.Minute, now), should.BeFalse)
+	a.So(web.VerifySignature(req.Header, append(body, ' '), "signing-secret", time.Minute, now), should.BeFalse)
+	a.So(web.VerifySignature(req.Header, body, "signing-secret", time.Minute, now.Add(time.Hour)), should.BeFalse)
+
+	replayed := req.Header.Clone()
+	replayed.Set("X-Webhook-Timestamp", "0")
+	a.So(web.VerifySignature(replayed, body, "signing-secret", 0, now), should.BeFalse)
+}
@@ -25,10 +25,13 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"time"
 
 	echo "github.com/labstack/echo/v4"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	web_errors "go.thethings.network/lorawan-stack/pkg/errors/web"
 	"go.thethings.network/lorawan-stack/pkg/log"
@@ -53,15 +56,29 @@ type ControllableSink interface {
 }
 
 // HTTPClientSink contains an HTTP client to make outgoing requests.
+// Requests with a client certificate are performed with a derived HTTP client that presents the certificate.
 type HTTPClientSink struct {
 	*http.Client
+
+	clients sync.Map
 }
 
-var errRequest = errors.DefineUnavailable("request", "request failed with status `{code}`")
+var (
+	errRequest         = errors.DefineUnavailable("request", "request failed with status `{code}`")
+	errRequestRejected = errors.DefineFailedPrecondition("request_rejected", "request rejected with status `{code}`")
+)
 
 // Process uses the HTTP client to perform the request.
+// Server errors, timeouts and rate limiting result in a retryable error; other client errors are permanent.
 func (s *HTTPClientSink) Process(req *http.Request) error {
-	res, err := s.Do(req)
+	client := s.Client
+	if cert, ok := clientCertificateFromContext(req.Context()); ok {
+		var err error
+		if client, err = s.clientFor(cert); err != nil {
+			return err
+		}
+	}
+	res, err := client.Do(req)
 	if err != nil {
 		return err
 	}
@@ -69,10 +86,16 @@ func (s *HTTPClientSink) Process(req *http.Request) error {
 		stdio.Copy(ioutil.Discard, res.Body)
 		res.Body.Close()
 	}()
-	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode <= 299:
 		return nil
+	case res.StatusCode >= 500,
+		res.StatusCode == http.StatusRequestTimeout,
+		res.StatusCode == http.StatusTooManyRequests:
+		return errRequest.WithAttributes("code", res.StatusCode)
+	default:
+		return errRequestRejected.WithAttributes("code", res.StatusCode)
 	}
-	return errRequest.WithAttributes("code", res.StatusCode)
 }
 
 // QueuedSink is a ControllableSink with queue.
@@ -138,8 +161,15 @@ func (s *QueuedSink) Process(req *http.Request) error {
 type Webhooks interface {
 	ttnweb.Registerer
 	Registry() WebhookRegistry
+	// Deliveries returns the delivery queue, or nil if messages are not queued for delivery.
+	Deliveries() DeliveryQueue
 	// NewSubscription returns a new webhooks integration subscription.
 	NewSubscription() *io.Subscription
+	// Deliver processes deliveries from the delivery queue until the context is done.
+	Deliver(ctx context.Context) error
+	// Evict evicts the cached client certificate of the webhook and closes the idle connections of the HTTP client
+	// that presents it.
+	Evict(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers)
 }
 
 // DownlinksConfig defines the configuration for the webhook downlink queue operations.
@@ -155,22 +185,55 @@ type webhooks struct {
 	registry  WebhookRegistry
 	target    Sink
 	downlinks DownlinksConfig
+
+	deliveries DeliveryQueue
+	retry      RetryConfig
+
+	keyVault     crypto.KeyVault
+	certificates sync.Map
+}
+
+// Option configures Webhooks.
+type Option func(*webhooks)
+
+// WithDeliveryQueue configures Webhooks to queue messages for delivery in the given queue.
+// Failed deliveries are retried according to the retry configuration.
+// The target of queued deliveries must process requests synchronously.
+func WithDeliveryQueue(deliveries DeliveryQueue, retry RetryConfig) Option {
+	return func(w *webhooks) {
+		w.deliveries = deliveries
+		w.retry = retry
+	}
+}
+
+// WithKeyVault configures Webhooks to decrypt the private keys of client certificates using the given key vault.
+func WithKeyVault(keyVault crypto.KeyVault) Option {
+	return func(w *webhooks) {
+		w.keyVault = keyVault
+	}
 }
 
 // NewWebhooks returns a new Webhooks.
-func NewWebhooks(ctx context.Context, server io.Server, registry WebhookRegistry, target Sink, downlinks DownlinksConfig) Webhooks {
+func NewWebhooks(ctx context.Context, server io.Server, registry WebhookRegistry, target Sink, downlinks DownlinksConfig, opts ...Option) Webhooks {
 	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/web")
-	return &webhooks{
+	w := &webhooks{
 		ctx:       ctx,
 		server:    server,
 		registry:  registry,
 		target:    target,
 		downlinks: downlinks,
+		keyVault:  cryptoutil.EmptyKeyVault,
+	}
+	for _, opt := range opts {
+		opt(w)
 	}
+	return w
 }
 
 func (w *webhooks) Registry() WebhookRegistry { return w.registry }
 
+func (w *webhooks) Deliveries() DeliveryQueue { return w.deliveries }
+
 // RegisterRoutes registers the webhooks to the web server to handle downlink requests.
 func (w *webhooks) RegisterRoutes(server *ttnweb.Server) {
 	middleware := []echo.MiddlewareFunc{
@@ -310,22 +373,7 @@ func (w *webhooks) NewSubscription() *io.Subscription {
 }
 
 func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error {
-	hooks, err := w.registry.List(ctx, msg.ApplicationIdentifiers,
-		[]string{
-			"base_url",
-			"downlink_api_key",
-			"downlink_ack",
-			"downlink_failed",
-			"downlink_nack",
-			"downlink_queued",
-			"downlink_sent",
-			"format",
-			"headers",
-			"join_accept",
-			"location_solved",
-			"uplink_message",
-		},
-	)
+	hooks, err := w.registry.List(ctx, msg.ApplicationIdentifiers, getDeliveryPaths)
 	if err != nil {
 		return err
 	}
@@ -344,6 +392,13 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			if req == nil {
 				return
 			}
+			if w.deliveries != nil {
+				logger.WithField("url", req.URL).Debug("Queue message")
+				if err := w.enqueue(ctx, msg, hook); err != nil {
+					logger.WithError(err).Warn("Failed to queue message")
+				}
+				return
+			}
 			logger.WithField("url", req.URL).Debug("Process message")
 			if err := w.target.Process(req); err != nil {
 				logger.WithError(err).Warn("Failed to process message")
@@ -408,6 +463,16 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 	}
 	req.Header.Set("Content-Type", format.ContentType)
 	req.Header.Set("User-Agent", userAgent)
+	if hook.SigningSecret != "" {
+		signRequest(req, hook.SigningSecret, buf, time.Now())
+	}
+	if hook.ClientCertificate != nil {
+		cert, err := w.clientCertificate(ctx, hook.ApplicationWebhookIdentifiers, hook.ClientCertificate)
+		if err != nil {
+			return nil, err
+		}
+		req = withClientCertificate(req, cert)
+	}
 	return req, nil
 }
 
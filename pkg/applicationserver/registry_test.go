@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/bolt"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -123,6 +124,18 @@ func TestLinkRegistry(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "Bolt",
+			New: func(t testing.TB) (LinkRegistry, func() error) {
+				cl, closeFn := test.NewBolt(t, namespace[:]...)
+				reg := &bolt.LinkRegistry{Bolt: cl}
+				return reg, func() error {
+					closeFn()
+					return nil
+				}
+			},
+			N: 8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
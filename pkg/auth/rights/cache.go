@@ -29,13 +29,19 @@ var now = time.Now // override this in unit tests
 
 func newReq(ctx context.Context, id ttnpb.Identifiers) cachedReq {
 	md := rpcmetadata.FromIncomingContext(ctx)
-	return cachedReq{UniqueID: unique.ID(ctx, id), AuthType: md.AuthType, AuthValue: md.AuthValue}
+	req := cachedReq{UniqueID: unique.ID(ctx, id), AuthType: md.AuthType, AuthValue: md.AuthValue}
+	// The rights of API keys depend on the address that they are used from.
+	if addr, ok := rpcmetadata.RemoteAddress(ctx); ok {
+		req.RemoteAddress = addr.String()
+	}
+	return req
 }
 
 type cachedReq struct {
-	UniqueID  string
-	AuthType  string
-	AuthValue string
+	UniqueID      string
+	AuthType      string
+	AuthValue     string
+	RemoteAddress string
 }
 
 func newRes() *cachedRes {
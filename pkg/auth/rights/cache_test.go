@@ -16,6 +16,7 @@ package rights
 
 import (
 	"context"
+	"net"
 	"testing"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc/peer"
 )
 
 var currentTime time.Time
@@ -112,3 +114,30 @@ func TestCache(t *testing.T) {
 	a.So(c.gatewayRights, should.BeEmpty)
 	a.So(c.organizationRights, should.BeEmpty)
 }
+
+func TestCacheRemoteAddress(t *testing.T) {
+	now = func() time.Time {
+		return currentTime
+	}
+
+	a := assertions.New(t)
+
+	mockFetcher := &mockFetcher{}
+
+	c := NewInMemoryCache(mockFetcher, 5*time.Minute, time.Minute).(*inMemoryCache)
+
+	ctxA := peer.NewContext(test.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}})
+	fetchRights(ctxA, "foo", c)
+
+	a.So(mockFetcher.applicationCtx, should.Equal, ctxA)
+
+	ctxB := peer.NewContext(test.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 5678}})
+	fetchRights(ctxB, "foo", c)
+
+	a.So(mockFetcher.applicationCtx, should.Equal, ctxA) // Same address, so cached.
+
+	ctxC := peer.NewContext(test.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.1"), Port: 1234}})
+	fetchRights(ctxC, "foo", c)
+
+	a.So(mockFetcher.applicationCtx, should.Equal, ctxC) // Different address, so not cached.
+}
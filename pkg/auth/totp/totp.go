@@ -0,0 +1,169 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package totp implements time-based one-time passwords (RFC 6238) for two-factor authentication,
+// and the recovery codes that can be used instead of them.
+package totp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/random"
+)
+
+const (
+	// Period is the time step of the codes.
+	Period = 30 * time.Second
+	// Digits is the number of digits of the codes.
+	Digits = 6
+	// Skew is the number of periods before and after the current period in which codes are still accepted.
+	Skew = 1
+	// MaxFailedAttempts is the number of consecutive incorrect codes after which validation is locked.
+	MaxFailedAttempts = 5
+	// LockoutDuration is the duration for which validation is locked after MaxFailedAttempts incorrect codes.
+	LockoutDuration = 5 * time.Minute
+
+	secretLength       = 20
+	modulo             = 1000000
+	recoveryCodeLength = 5
+)
+
+var enc = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret generates a new secret, encoded in base32.
+func GenerateSecret() string {
+	return enc.EncodeToString(random.Bytes(secretLength))
+}
+
+var errInvalidSecret = errors.DefineInvalidArgument("secret", "invalid TOTP secret")
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := enc.DecodeString(strings.TrimRight(strings.ToUpper(secret), "="))
+	if err != nil {
+		return nil, errInvalidSecret.WithCause(err)
+	}
+	return key, nil
+}
+
+func counterCode(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%modulo)
+}
+
+// TimeStep returns the time step (the counter) of the codes at time t.
+func TimeStep(t time.Time) uint64 {
+	return uint64(t.Unix()) / uint64(Period/time.Second)
+}
+
+// Code returns the code of the secret at time t.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return counterCode(key, TimeStep(t)), nil
+}
+
+// Validate returns whether the code is valid for the secret at time t, and the time step of the code.
+// Codes of time steps up to and including lastTimeStep are not valid, so that a code can only be used once.
+func Validate(secret, code string, t time.Time, lastTimeStep uint64) (timeStep uint64, ok bool, err error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(code) != Digits {
+		return 0, false, nil
+	}
+	c := TimeStep(t)
+	for i := c - Skew; i <= c+Skew; i++ {
+		if i <= lastTimeStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(counterCode(key, i)), []byte(code)) == 1 {
+			return i, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// Failed returns the number of consecutive failed attempts after an incorrect code at time t.
+// If the number of failed attempts reaches MaxFailedAttempts, Failed returns the time until which validation is
+// locked, and the number of failed attempts starts over.
+func Failed(failedAttempts uint32, t time.Time) (uint32, *time.Time) {
+	failedAttempts++
+	if failedAttempts < MaxFailedAttempts {
+		return failedAttempts, nil
+	}
+	lockedUntil := t.Add(LockoutDuration)
+	return 0, &lockedUntil
+}
+
+// Locked returns whether validation is locked at time t.
+func Locked(lockedUntil *time.Time, t time.Time) bool {
+	return lockedUntil != nil && t.Before(*lockedUntil)
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
+
+// GenerateRecoveryCodes generates n recovery codes.
+// It returns the codes that are shown to the user, and their hashes that are stored.
+func GenerateRecoveryCodes(ctx context.Context, n int) (codes, hashes []string, err error) {
+	codes, hashes = make([]string, n), make([]string, n)
+	for i := range codes {
+		code := strings.ToLower(enc.EncodeToString(random.Bytes(recoveryCodeLength)))
+		codes[i] = code[:len(code)/2] + "-" + code[len(code)/2:]
+		hashes[i], err = auth.Hash(ctx, code)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return codes, hashes, nil
+}
+
+// ValidateRecoveryCode returns the index of the hash that matches the recovery code.
+// If the recovery code does not match any of the hashes, ValidateRecoveryCode returns -1.
+func ValidateRecoveryCode(hashes []string, code string) (int, error) {
+	code = normalizeRecoveryCode(code)
+	if code == "" {
+		return -1, nil
+	}
+	for i, hashed := range hashes {
+		ok, err := auth.Validate(hashed, code)
+		if err != nil {
+			return -1, err
+		}
+		if ok {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
@@ -0,0 +1,144 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/pbkdf2"
+	. "go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// rfcSecret is the SHA1 secret of the test vectors in RFC 6238, encoded in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	for _, tc := range []struct {
+		Time int64
+		Code string
+	}{
+		{Time: 59, Code: "287082"},
+		{Time: 1111111109, Code: "081804"},
+		{Time: 1111111111, Code: "050471"},
+		{Time: 1234567890, Code: "005924"},
+		{Time: 2000000000, Code: "279037"},
+	} {
+		t.Run(tc.Code, func(t *testing.T) {
+			a := assertions.New(t)
+			code, err := Code(rfcSecret, time.Unix(tc.Time, 0))
+			a.So(err, should.BeNil)
+			a.So(code, should.Equal, tc.Code)
+		})
+	}
+
+	_, err := Code("not base32!", time.Now())
+	assertions.New(t).So(err, should.NotBeNil)
+}
+
+func TestValidate(t *testing.T) {
+	a := assertions.New(t)
+
+	secret := GenerateSecret()
+	now := time.Unix(1234567890, 0)
+
+	code, err := Code(secret, now)
+	a.So(err, should.BeNil)
+
+	for _, tc := range []struct {
+		Name         string
+		Code         string
+		Time         time.Time
+		LastTimeStep uint64
+		Valid        bool
+	}{
+		{Name: "Current", Code: code, Time: now, Valid: true},
+		{Name: "PreviousPeriod", Code: code, Time: now.Add(Period), Valid: true},
+		{Name: "NextPeriod", Code: code, Time: now.Add(-Period), Valid: true},
+		{Name: "Expired", Code: code, Time: now.Add(3 * Period), Valid: false},
+		{Name: "Empty", Code: "", Time: now, Valid: false},
+		{Name: "Short", Code: code[:Digits-1], Time: now, Valid: false},
+		{Name: "EarlierUsed", Code: code, Time: now, LastTimeStep: TimeStep(now) - 1, Valid: true},
+		{Name: "Reused", Code: code, Time: now, LastTimeStep: TimeStep(now), Valid: false},
+		{Name: "LaterUsed", Code: code, Time: now.Add(Period), LastTimeStep: TimeStep(now) + 1, Valid: false},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			timeStep, valid, err := Validate(secret, tc.Code, tc.Time, tc.LastTimeStep)
+			a.So(err, should.BeNil)
+			a.So(valid, should.Equal, tc.Valid)
+			if tc.Valid {
+				a.So(timeStep, should.Equal, TimeStep(now))
+			}
+		})
+	}
+}
+
+func TestFailed(t *testing.T) {
+	a := assertions.New(t)
+	now := time.Unix(1234567890, 0)
+
+	var failedAttempts uint32
+	for i := 1; i < MaxFailedAttempts; i++ {
+		var lockedUntil *time.Time
+		failedAttempts, lockedUntil = Failed(failedAttempts, now)
+		a.So(failedAttempts, should.Equal, i)
+		a.So(lockedUntil, should.BeNil)
+	}
+	failedAttempts, lockedUntil := Failed(failedAttempts, now)
+	a.So(failedAttempts, should.Equal, 0)
+	if a.So(lockedUntil, should.NotBeNil) {
+		a.So(*lockedUntil, should.Equal, now.Add(LockoutDuration))
+	}
+
+	a.So(Locked(nil, now), should.BeFalse)
+	a.So(Locked(lockedUntil, now), should.BeTrue)
+	a.So(Locked(lockedUntil, now.Add(LockoutDuration)), should.BeFalse)
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	a := assertions.New(t)
+	ctx := auth.NewContextWithHashValidator(test.Context(), pbkdf2.Default())
+
+	codes, hashes, err := GenerateRecoveryCodes(ctx, 3)
+	a.So(err, should.BeNil)
+	a.So(codes, should.HaveLength, 3)
+	a.So(hashes, should.HaveLength, 3)
+
+	for i, code := range codes {
+		a.So(hashes[i], should.NotEqual, code)
+
+		idx, err := ValidateRecoveryCode(hashes, code)
+		a.So(err, should.BeNil)
+		a.So(idx, should.Equal, i)
+	}
+
+	idx, err := ValidateRecoveryCode(hashes, strings.ToUpper(strings.Replace(codes[1], "-", "", -1)))
+	a.So(err, should.BeNil)
+	a.So(idx, should.Equal, 1)
+
+	idx, err = ValidateRecoveryCode(hashes, "aaaa-bbbb")
+	a.So(err, should.BeNil)
+	a.So(idx, should.Equal, -1)
+
+	idx, err = ValidateRecoveryCode(hashes, "")
+	a.So(err, should.BeNil)
+	a.So(idx, should.Equal, -1)
+}
@@ -15,6 +15,8 @@
 package band
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -52,6 +54,11 @@ func init() {
 			},
 		},
 
+		ListenBeforeTalk: &ListenBeforeTalk{
+			RSSITarget: -80,
+			ScanTime:   5 * time.Millisecond,
+		},
+
 		DataRates: map[ttnpb.DataRateIndex]DataRate{
 			0: makeLoRaDataRate(12, 125000, makeDwellTimeMaxMACPayloadSizeFunc(59, 0)),
 			1: makeLoRaDataRate(11, 125000, makeDwellTimeMaxMACPayloadSizeFunc(59, 0)),
@@ -147,6 +147,9 @@ type Band struct {
 	// SubBands define the sub-bands, their duty-cycle limit and Tx power. The frequency ranges may not overlap.
 	SubBands []SubBandParameters
 
+	// ListenBeforeTalk contains the listen-before-talk requirements of the band. If nil, listen-before-talk is not required.
+	ListenBeforeTalk *ListenBeforeTalk
+
 	DataRates map[ttnpb.DataRateIndex]DataRate
 
 	FreqMultiplier   uint64
@@ -227,6 +230,14 @@ type SubBandParameters struct {
 	MaxEIRP      float32
 }
 
+// ListenBeforeTalk contains the listen-before-talk (LBT) requirements of a band.
+type ListenBeforeTalk struct {
+	// RSSITarget is the signal strength (dBm) above which the channel is considered busy.
+	RSSITarget float32
+	// ScanTime is the minimum time that the channel is sensed before transmission.
+	ScanTime time.Duration
+}
+
 // Comprises returns whether the duty cycle applies to the given frequency.
 func (d SubBandParameters) Comprises(frequency uint64) bool {
 	return frequency >= d.MinFrequency && frequency <= d.MaxFrequency
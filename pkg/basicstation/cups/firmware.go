@@ -0,0 +1,220 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"context"
+	"hash/crc32"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+const (
+	firmwareTargetsPrefix        = "targets/"
+	firmwareGatewayTargetsPrefix = firmwareTargetsPrefix + "gateways/"
+	firmwareModelTargetsPrefix   = firmwareTargetsPrefix + "models/"
+)
+
+var (
+	errFirmwareTargetIdentifiers = errors.DefineInvalidArgument("firmware_target_identifiers", "either gateway identifiers or model must be set")
+	errFirmwareTargetNotFound    = errors.DefineNotFound("firmware_target_not_found", "firmware target not found")
+	errUpdateDataNotFound        = errors.DefineNotFound("update_data_not_found", "update data `{key}` not found")
+	errFirmwareStorage           = errors.DefineUnavailable("firmware_storage", "firmware storage unavailable")
+)
+
+// firmwareStore stores firmware targets in a blob bucket. Firmware targets of gateways are stored by gateway UID,
+// firmware targets of station models are stored by model. The update data is uploaded to the bucket by operators
+// and referred to by key.
+type firmwareStore struct {
+	bucket *blob.Bucket
+}
+
+func validateFirmwareTargetIdentifiers(ids ttnpb.GatewayFirmwareTargetIdentifiers) error {
+	if (ids.GatewayIDs == nil) == (ids.Model == "") {
+		return errFirmwareTargetIdentifiers
+	}
+	return nil
+}
+
+func firmwareTargetKey(ctx context.Context, ids ttnpb.GatewayFirmwareTargetIdentifiers) string {
+	if ids.GatewayIDs != nil {
+		return firmwareGatewayTargetsPrefix + unique.ID(ctx, ids.GatewayIDs)
+	}
+	return firmwareModelTargetsPrefix + url.PathEscape(ids.Model)
+}
+
+func (st *firmwareStore) readTarget(ctx context.Context, key string) (*ttnpb.GatewayFirmwareTarget, error) {
+	b, err := st.bucket.ReadAll(ctx, key)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, errFirmwareTargetNotFound
+		}
+		return nil, errFirmwareStorage.WithCause(err)
+	}
+	target := &ttnpb.GatewayFirmwareTarget{}
+	if err := target.Unmarshal(b); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// Get returns the firmware target with the given identifiers.
+func (st *firmwareStore) Get(ctx context.Context, ids ttnpb.GatewayFirmwareTargetIdentifiers) (*ttnpb.GatewayFirmwareTarget, error) {
+	return st.readTarget(ctx, firmwareTargetKey(ctx, ids))
+}
+
+// List returns all firmware targets.
+func (st *firmwareStore) List(ctx context.Context) ([]*ttnpb.GatewayFirmwareTarget, error) {
+	var targets []*ttnpb.GatewayFirmwareTarget
+	iter := st.bucket.List(&blob.ListOptions{Prefix: firmwareTargetsPrefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return targets, nil
+			}
+			return nil, errFirmwareStorage.WithCause(err)
+		}
+		if obj.IsDir {
+			continue
+		}
+		target, err := st.readTarget(ctx, obj.Key)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+}
+
+// Set stores the firmware target.
+func (st *firmwareStore) Set(ctx context.Context, target *ttnpb.GatewayFirmwareTarget) error {
+	b, err := target.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := st.bucket.WriteAll(ctx, firmwareTargetKey(ctx, target.GatewayFirmwareTargetIdentifiers), b, nil); err != nil {
+		return errFirmwareStorage.WithCause(err)
+	}
+	return nil
+}
+
+// Delete deletes the firmware target with the given identifiers.
+func (st *firmwareStore) Delete(ctx context.Context, ids ttnpb.GatewayFirmwareTargetIdentifiers) error {
+	if err := st.bucket.Delete(ctx, firmwareTargetKey(ctx, ids)); err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return errFirmwareTargetNotFound
+		}
+		return errFirmwareStorage.WithCause(err)
+	}
+	return nil
+}
+
+// UpdateData returns the update data with the given key.
+func (st *firmwareStore) UpdateData(ctx context.Context, key string) ([]byte, error) {
+	b, err := st.bucket.ReadAll(ctx, key)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, errUpdateDataNotFound.WithAttributes("key", key)
+		}
+		return nil, errFirmwareStorage.WithCause(err)
+	}
+	return b, nil
+}
+
+// Target returns the firmware target that applies to the gateway.
+// The firmware target of the gateway takes precedence over the firmware target of the station model.
+// If no firmware target applies, this method returns nil.
+func (st *firmwareStore) Target(ctx context.Context, ids ttnpb.GatewayIdentifiers, model string) (*ttnpb.GatewayFirmwareTarget, error) {
+	target, err := st.Get(ctx, ttnpb.GatewayFirmwareTargetIdentifiers{GatewayIDs: &ids})
+	if err == nil || !errors.IsNotFound(err) {
+		return target, err
+	}
+	if model = strings.TrimSpace(model); model == "" {
+		return nil, nil
+	}
+	target, err = st.Get(ctx, ttnpb.GatewayFirmwareTargetIdentifiers{Model: model})
+	if err != nil && errors.IsNotFound(err) {
+		return nil, nil
+	}
+	return target, err
+}
+
+// rolloutPercentage returns the percentage of gateways that the firmware target is rolled out to at the given time.
+// The rollout starts with one batch, and another batch is added every batch interval.
+func rolloutPercentage(target *ttnpb.GatewayFirmwareTarget, now time.Time) uint32 {
+	start := target.CreatedAt
+	if target.RolloutStartsAt != nil {
+		start = *target.RolloutStartsAt
+	}
+	if target.Paused || now.Before(start) {
+		return 0
+	}
+	if target.BatchPercentage == 0 || target.BatchPercentage >= 100 {
+		return 100
+	}
+	batches := uint64(1)
+	if target.BatchInterval > 0 {
+		batches += uint64(now.Sub(start) / target.BatchInterval)
+	}
+	if pct := batches * uint64(target.BatchPercentage); pct < 100 {
+		return uint32(pct)
+	}
+	return 100
+}
+
+// inRollout returns whether the gateway with the given EUI is part of a rollout to the given percentage of gateways.
+// Gateways are assigned to batches by their EUI, so that a gateway stays in the rollout as the rollout progresses.
+func inRollout(eui types.EUI64, percentage uint32) bool {
+	return crc32.ChecksumIEEE(eui[:])%100 < percentage
+}
+
+// getUpdateData returns the update data for the gateway if the firmware target that applies to the gateway is rolled
+// out to it, and the gateway does not run the firmware package yet.
+func (s *Server) getUpdateData(ctx context.Context, gtw *ttnpb.Gateway, req UpdateInfoRequest, now time.Time) []byte {
+	logger := log.FromContext(ctx)
+	target, err := s.firmware.Target(ctx, gtw.GatewayIdentifiers, req.Model)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get firmware target")
+		return nil
+	}
+	if target == nil || target.Package == req.Package {
+		return nil
+	}
+	logger = logger.WithField("package", target.Package)
+	if !inRollout(req.Router.EUI64, rolloutPercentage(target, now)) {
+		logger.Debug("Gateway not in firmware rollout")
+		return nil
+	}
+	updateData, err := s.firmware.UpdateData(ctx, target.UpdateDataKey)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get update data")
+		return nil
+	}
+	logger.Info("Update gateway firmware")
+	return updateData
+}
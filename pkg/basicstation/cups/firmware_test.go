@@ -0,0 +1,248 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/basicstation"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"gocloud.dev/blob/memblob"
+)
+
+func TestRolloutPercentage(t *testing.T) {
+	start := time.Date(2019, time.November, 1, 12, 0, 0, 0, time.UTC)
+	later := start.Add(time.Hour)
+
+	for _, tt := range []struct {
+		Name     string
+		Target   ttnpb.GatewayFirmwareTarget
+		Now      time.Time
+		Expected uint32
+	}{
+		{
+			Name:     "No Batches",
+			Target:   ttnpb.GatewayFirmwareTarget{CreatedAt: start},
+			Now:      start,
+			Expected: 100,
+		},
+		{
+			Name:     "Paused",
+			Target:   ttnpb.GatewayFirmwareTarget{CreatedAt: start, Paused: true},
+			Now:      start,
+			Expected: 0,
+		},
+		{
+			Name:     "Not Started",
+			Target:   ttnpb.GatewayFirmwareTarget{CreatedAt: start, RolloutStartsAt: &later},
+			Now:      start,
+			Expected: 0,
+		},
+		{
+			Name: "First Batch",
+			Target: ttnpb.GatewayFirmwareTarget{
+				CreatedAt:       start,
+				BatchPercentage: 10,
+				BatchInterval:   time.Hour,
+			},
+			Now:      start.Add(59 * time.Minute),
+			Expected: 10,
+		},
+		{
+			Name: "Third Batch",
+			Target: ttnpb.GatewayFirmwareTarget{
+				CreatedAt:       start,
+				BatchPercentage: 10,
+				BatchInterval:   time.Hour,
+			},
+			Now:      start.Add(2 * time.Hour),
+			Expected: 30,
+		},
+		{
+			Name: "Third Batch From Rollout Start",
+			Target: ttnpb.GatewayFirmwareTarget{
+				CreatedAt:       start,
+				RolloutStartsAt: &later,
+				BatchPercentage: 10,
+				BatchInterval:   time.Hour,
+			},
+			Now:      later.Add(2 * time.Hour),
+			Expected: 30,
+		},
+		{
+			Name: "Completed",
+			Target: ttnpb.GatewayFirmwareTarget{
+				CreatedAt:       start,
+				BatchPercentage: 30,
+				BatchInterval:   time.Hour,
+			},
+			Now:      start.Add(24 * time.Hour),
+			Expected: 100,
+		},
+		{
+			Name: "No Interval",
+			Target: ttnpb.GatewayFirmwareTarget{
+				CreatedAt:       start,
+				BatchPercentage: 30,
+			},
+			Now:      start.Add(24 * time.Hour),
+			Expected: 30,
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(rolloutPercentage(&tt.Target, tt.Now), should.Equal, tt.Expected)
+		})
+	}
+}
+
+func TestInRollout(t *testing.T) {
+	a := assertions.New(t)
+
+	var included int
+	for i := 0; i < 1000; i++ {
+		eui := types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, byte(i >> 8), byte(i)}
+		a.So(inRollout(eui, 0), should.BeFalse)
+		a.So(inRollout(eui, 100), should.BeTrue)
+		if inRollout(eui, 50) {
+			included++
+			// Gateways stay in the rollout as it progresses.
+			a.So(inRollout(eui, 60), should.BeTrue)
+		}
+	}
+	a.So(included, should.BeBetween, 400, 600)
+}
+
+func TestFirmwareStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	st := &firmwareStore{bucket: memblob.OpenBucket(nil)}
+
+	gtwIDs := ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"}
+	gtwTarget := &ttnpb.GatewayFirmwareTarget{
+		GatewayFirmwareTargetIdentifiers: ttnpb.GatewayFirmwareTargetIdentifiers{
+			GatewayIDs: &gtwIDs,
+		},
+		Package:       "2.0.1",
+		UpdateDataKey: "minihub/2.0.1.bin",
+	}
+	modelTarget := &ttnpb.GatewayFirmwareTarget{
+		GatewayFirmwareTargetIdentifiers: ttnpb.GatewayFirmwareTargetIdentifiers{
+			Model: "minihub",
+		},
+		Package:       "2.0.2",
+		UpdateDataKey: "minihub/2.0.2.bin",
+	}
+
+	_, err := st.Get(ctx, gtwTarget.GatewayFirmwareTargetIdentifiers)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	target, err := st.Target(ctx, gtwIDs, "minihub")
+	a.So(err, should.BeNil)
+	a.So(target, should.BeNil)
+
+	a.So(st.Set(ctx, modelTarget), should.BeNil)
+	target, err = st.Target(ctx, gtwIDs, "minihub")
+	a.So(err, should.BeNil)
+	a.So(target, should.Resemble, modelTarget)
+
+	a.So(st.Set(ctx, gtwTarget), should.BeNil)
+	target, err = st.Target(ctx, gtwIDs, "minihub")
+	a.So(err, should.BeNil)
+	a.So(target, should.Resemble, gtwTarget)
+
+	targets, err := st.List(ctx)
+	a.So(err, should.BeNil)
+	a.So(targets, should.HaveLength, 2)
+
+	a.So(st.Delete(ctx, gtwTarget.GatewayFirmwareTargetIdentifiers), should.BeNil)
+	target, err = st.Target(ctx, gtwIDs, "minihub")
+	a.So(err, should.BeNil)
+	a.So(target, should.Resemble, modelTarget)
+
+	err = st.Delete(ctx, gtwTarget.GatewayFirmwareTargetIdentifiers)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = st.UpdateData(ctx, modelTarget.UpdateDataKey)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(st.bucket.WriteAll(ctx, modelTarget.UpdateDataKey, []byte{0x1, 0x2, 0x3}, nil), should.BeNil)
+	updateData, err := st.UpdateData(ctx, modelTarget.UpdateDataKey)
+	a.So(err, should.BeNil)
+	a.So(updateData, should.Resemble, []byte{0x1, 0x2, 0x3})
+}
+
+func TestGetUpdateData(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	bucket := memblob.OpenBucket(nil)
+	s := NewServer(nil, WithFirmwareBucket(bucket))
+
+	gtw := &ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+			GatewayID: "test-gateway",
+			EUI:       &mockGatewayEUI,
+		},
+	}
+	req := UpdateInfoRequest{
+		Router:  basicstation.EUI{EUI64: mockGatewayEUI},
+		Model:   "minihub",
+		Package: "2.0.0",
+	}
+	now := time.Now()
+
+	// No firmware target.
+	a.So(s.getUpdateData(ctx, gtw, req, now), should.BeNil)
+
+	a.So(s.firmware.Set(ctx, &ttnpb.GatewayFirmwareTarget{
+		GatewayFirmwareTargetIdentifiers: ttnpb.GatewayFirmwareTargetIdentifiers{
+			Model: "minihub",
+		},
+		CreatedAt:     now,
+		Package:       "2.0.1",
+		UpdateDataKey: "minihub/2.0.1.bin",
+	}), should.BeNil)
+
+	// No update data.
+	a.So(s.getUpdateData(ctx, gtw, req, now), should.BeNil)
+
+	a.So(bucket.WriteAll(ctx, "minihub/2.0.1.bin", []byte{0x1, 0x2, 0x3}, nil), should.BeNil)
+	a.So(s.getUpdateData(ctx, gtw, req, now), should.Resemble, []byte{0x1, 0x2, 0x3})
+
+	// Gateway runs the firmware package already.
+	req.Package = "2.0.1"
+	a.So(s.getUpdateData(ctx, gtw, req, now), should.BeNil)
+
+	// Firmware rollout paused.
+	req.Package = "2.0.0"
+	a.So(s.firmware.Set(ctx, &ttnpb.GatewayFirmwareTarget{
+		GatewayFirmwareTargetIdentifiers: ttnpb.GatewayFirmwareTargetIdentifiers{
+			GatewayIDs: &gtw.GatewayIdentifiers,
+		},
+		CreatedAt:     now,
+		Package:       "2.0.1",
+		UpdateDataKey: "minihub/2.0.1.bin",
+		Paused:        true,
+	}), should.BeNil)
+	a.So(s.getUpdateData(ctx, gtw, req, now), should.BeNil)
+}
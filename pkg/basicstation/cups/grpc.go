@@ -0,0 +1,296 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"context"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+var (
+	errFirmwareNotConfigured = errors.DefineFailedPrecondition("firmware_not_configured", "firmware updates are not configured")
+	errRotationNotConfigured = errors.DefineFailedPrecondition("rotation_not_configured", "credentials rotation is not configured")
+	errNoRotationPending     = errors.DefineFailedPrecondition("no_rotation_pending", "no credentials rotation pending for gateway `{gateway_uid}`")
+	errAdminRequired         = errors.DefinePermissionDenied("admin_required", "admin rights required")
+)
+
+// RegisterServices registers the CUPS management services at s.
+func (s *Server) RegisterServices(gs *grpc.Server) {
+	ttnpb.RegisterGatewayFirmwareRegistryServer(gs, &firmwareRegistryRPC{Server: s})
+	ttnpb.RegisterGatewayCredentialsRotatorServer(gs, &credentialsRotatorRPC{Server: s})
+}
+
+// RegisterHandlers registers the gRPC gateway handlers of the CUPS management services.
+func (s *Server) RegisterHandlers(mux *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterGatewayFirmwareRegistryHandler(s.component.Context(), mux, conn)
+	ttnpb.RegisterGatewayCredentialsRotatorHandler(s.component.Context(), mux, conn)
+}
+
+// requireAdmin returns an error if the caller is not an admin.
+func (s *Server) requireAdmin(ctx context.Context) error {
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, s.component.AllowInsecureForCredentials())
+	if err != nil {
+		return err
+	}
+	access, err := s.getEntityAccess(ctx)
+	if err != nil {
+		return err
+	}
+	authInfo, err := access.AuthInfo(ctx, ttnpb.Empty, callOpt)
+	if err != nil {
+		return err
+	}
+	if !authInfo.IsAdmin {
+		return errAdminRequired
+	}
+	return nil
+}
+
+type firmwareRegistryRPC struct {
+	*Server
+}
+
+// requireFirmwareTargetRights returns an error if the caller is not allowed to access the firmware target.
+// Firmware targets of gateways require the given gateway rights, firmware targets of station models require admin rights.
+func (s *firmwareRegistryRPC) requireFirmwareTargetRights(ctx context.Context, ids ttnpb.GatewayFirmwareTargetIdentifiers, required ...ttnpb.Right) error {
+	if s.firmware == nil {
+		return errFirmwareNotConfigured
+	}
+	if err := validateFirmwareTargetIdentifiers(ids); err != nil {
+		return err
+	}
+	if ids.GatewayIDs != nil {
+		return rights.RequireGateway(ctx, *ids.GatewayIDs, required...)
+	}
+	return s.requireAdmin(ctx)
+}
+
+func applyFirmwareTargetFieldMask(target *ttnpb.GatewayFirmwareTarget, paths []string) (*ttnpb.GatewayFirmwareTarget, error) {
+	if len(paths) == 0 {
+		return target, nil
+	}
+	res := &ttnpb.GatewayFirmwareTarget{
+		GatewayFirmwareTargetIdentifiers: target.GatewayFirmwareTargetIdentifiers,
+	}
+	if err := res.SetFields(target, paths...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *firmwareRegistryRPC) List(ctx context.Context, req *ttnpb.ListGatewayFirmwareTargetsRequest) (*ttnpb.GatewayFirmwareTargets, error) {
+	if s.firmware == nil {
+		return nil, errFirmwareNotConfigured
+	}
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	targets, err := s.firmware.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, target := range targets {
+		if targets[i], err = applyFirmwareTargetFieldMask(target, req.FieldMask.Paths); err != nil {
+			return nil, err
+		}
+	}
+	return &ttnpb.GatewayFirmwareTargets{
+		Targets: targets,
+	}, nil
+}
+
+func (s *firmwareRegistryRPC) Get(ctx context.Context, req *ttnpb.GetGatewayFirmwareTargetRequest) (*ttnpb.GatewayFirmwareTarget, error) {
+	if err := s.requireFirmwareTargetRights(ctx, req.GatewayFirmwareTargetIdentifiers, ttnpb.RIGHT_GATEWAY_INFO); err != nil {
+		return nil, err
+	}
+	target, err := s.firmware.Get(ctx, req.GatewayFirmwareTargetIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	return applyFirmwareTargetFieldMask(target, req.FieldMask.Paths)
+}
+
+func (s *firmwareRegistryRPC) Set(ctx context.Context, req *ttnpb.SetGatewayFirmwareTargetRequest) (*ttnpb.GatewayFirmwareTarget, error) {
+	if err := s.requireFirmwareTargetRights(ctx, req.GatewayFirmwareTargetIdentifiers, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	target, err := s.firmware.Get(ctx, req.GatewayFirmwareTargetIdentifiers)
+	if errors.IsNotFound(err) {
+		target = &ttnpb.GatewayFirmwareTarget{
+			GatewayFirmwareTargetIdentifiers: req.GatewayFirmwareTargetIdentifiers,
+			CreatedAt:                        now,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	if err := target.SetFields(&req.GatewayFirmwareTarget, req.FieldMask.Paths...); err != nil {
+		return nil, err
+	}
+	target.GatewayFirmwareTargetIdentifiers = req.GatewayFirmwareTargetIdentifiers
+	target.UpdatedAt = now
+	if err := target.ValidateFields(); err != nil {
+		return nil, err
+	}
+	if err := s.firmware.Set(ctx, target); err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithField("package", target.Package).Info("Set firmware target")
+	return applyFirmwareTargetFieldMask(target, req.FieldMask.Paths)
+}
+
+func (s *firmwareRegistryRPC) Delete(ctx context.Context, ids *ttnpb.GatewayFirmwareTargetIdentifiers) (*pbtypes.Empty, error) {
+	if err := s.requireFirmwareTargetRights(ctx, *ids, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	if err := s.firmware.Delete(ctx, *ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+type credentialsRotatorRPC struct {
+	*Server
+}
+
+var getRotationMask = pbtypes.FieldMask{Paths: []string{
+	"attributes",
+}}
+
+// getGateway returns the gateway with its attributes.
+func (s *credentialsRotatorRPC) getGateway(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*ttnpb.Gateway, error) {
+	registry, err := s.getRegistry(ctx, &ids)
+	if err != nil {
+		return nil, err
+	}
+	gtw, err := registry.Get(ctx, &ttnpb.GetGatewayRequest{
+		GatewayIdentifiers: ids,
+		FieldMask:          getRotationMask,
+	}, s.getServerAuth(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if gtw.Attributes == nil {
+		gtw.Attributes = make(map[string]string)
+	}
+	return gtw, nil
+}
+
+// updateAttributes updates the attributes of the gateway with the credentials of the caller.
+func (s *credentialsRotatorRPC) updateAttributes(ctx context.Context, gtw *ttnpb.Gateway, auth grpc.CallOption) (*ttnpb.Gateway, error) {
+	registry, err := s.getRegistry(ctx, &gtw.GatewayIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	return registry.Update(ctx, &ttnpb.UpdateGatewayRequest{
+		Gateway:   *gtw,
+		FieldMask: getRotationMask,
+	}, auth)
+}
+
+// requireRotationRights returns the forwarded auth of the caller if the caller is allowed to manage the credentials
+// of the gateway.
+func (s *credentialsRotatorRPC) requireRotationRights(ctx context.Context, ids ttnpb.GatewayIdentifiers) (grpc.CallOption, error) {
+	if err := rights.RequireGateway(ctx, ids,
+		ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC,
+		ttnpb.RIGHT_GATEWAY_SETTINGS_API_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	return rpcmetadata.WithForwardedAuth(ctx, s.component.AllowInsecureForCredentials())
+}
+
+func (s *credentialsRotatorRPC) Get(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.GatewayCredentialsRotation, error) {
+	if err := rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_INFO); err != nil {
+		return nil, err
+	}
+	gtw, err := s.getGateway(ctx, *ids)
+	if err != nil {
+		return nil, err
+	}
+	return s.credentialsRotation(gtw), nil
+}
+
+func (s *credentialsRotatorRPC) Set(ctx context.Context, req *ttnpb.SetGatewayCredentialsRotationRequest) (*ttnpb.GatewayCredentialsRotation, error) {
+	auth, err := s.requireRotationRights(ctx, req.GatewayIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	gtw, err := s.getGateway(ctx, req.GatewayIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "interval") {
+		gtw.Attributes[cupsRotationIntervalAttribute] = req.Interval.String()
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "rollback_timeout") {
+		gtw.Attributes[cupsRotationRollbackTimeoutAttribute] = req.RollbackTimeout.String()
+	}
+	if gtw, err = s.updateAttributes(ctx, gtw, auth); err != nil {
+		return nil, err
+	}
+	return s.credentialsRotation(gtw), nil
+}
+
+func (s *credentialsRotatorRPC) Rotate(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.GatewayCredentialsRotation, error) {
+	if s.rotationAuth == nil {
+		return nil, errRotationNotConfigured
+	}
+	auth, err := s.requireRotationRights(ctx, *ids)
+	if err != nil {
+		return nil, err
+	}
+	gtw, err := s.getGateway(ctx, *ids)
+	if err != nil {
+		return nil, err
+	}
+	gtw.Attributes[cupsRotationRequestedAttribute] = "true"
+	if gtw, err = s.updateAttributes(ctx, gtw, auth); err != nil {
+		return nil, err
+	}
+	return s.credentialsRotation(gtw), nil
+}
+
+func (s *credentialsRotatorRPC) Rollback(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.GatewayCredentialsRotation, error) {
+	auth, err := s.requireRotationRights(ctx, *ids)
+	if err != nil {
+		return nil, err
+	}
+	gtw, err := s.getGateway(ctx, *ids)
+	if err != nil {
+		return nil, err
+	}
+	if gtw.Attributes[cupsRotationPendingSinceAttribute] == "" {
+		return nil, errNoRotationPending.WithAttributes("gateway_uid", unique.ID(ctx, ids))
+	}
+	ctx = log.NewContextWithField(ctx, "gateway_uid", unique.ID(ctx, ids))
+	if err := s.rollbackRotation(ctx, gtw, auth); err != nil {
+		return nil, err
+	}
+	if gtw, err = s.updateAttributes(ctx, gtw, auth); err != nil {
+		return nil, err
+	}
+	return s.credentialsRotation(gtw), nil
+}
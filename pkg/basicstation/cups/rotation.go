@@ -0,0 +1,238 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+const (
+	cupsRotationIntervalAttribute        = "cups-rotation-interval"
+	cupsRotationRollbackTimeoutAttribute = "cups-rotation-rollback-timeout"
+	cupsRotatedAtAttribute               = "cups-rotated-at"
+	cupsRotationPendingSinceAttribute    = "cups-rotation-pending-since"
+	cupsRotationRequestedAttribute       = "cups-rotation-requested"
+	cupsPreviousCredentialsIDAttribute   = "cups-previous-credentials-id"
+	cupsPreviousCredentialsAttribute     = "cups-previous-credentials"
+	lnsPreviousCredentialsIDAttribute    = "lns-previous-credentials-id"
+	lnsPreviousCredentialsAttribute      = "lns-previous-credentials"
+)
+
+// gatewayCredentials describes the gateway attributes of the credentials that CUPS manages.
+type gatewayCredentials struct {
+	name                string
+	idAttribute         string
+	attribute           string
+	previousIDAttribute string
+	previousAttribute   string
+	rights              []ttnpb.Right
+}
+
+var (
+	cupsCredentials = gatewayCredentials{
+		name:                "CUPS",
+		idAttribute:         cupsCredentialsIDAttribute,
+		attribute:           cupsCredentialsAttribute,
+		previousIDAttribute: cupsPreviousCredentialsIDAttribute,
+		previousAttribute:   cupsPreviousCredentialsAttribute,
+		rights: []ttnpb.Right{
+			ttnpb.RIGHT_GATEWAY_INFO,
+			ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC,
+		},
+	}
+	lnsCredentials = gatewayCredentials{
+		name:                "LNS",
+		idAttribute:         lnsCredentialsIDAttribute,
+		attribute:           lnsCredentialsAttribute,
+		previousIDAttribute: lnsPreviousCredentialsIDAttribute,
+		previousAttribute:   lnsPreviousCredentialsAttribute,
+		rights: []ttnpb.Right{
+			ttnpb.RIGHT_GATEWAY_INFO,
+			ttnpb.RIGHT_GATEWAY_LINK,
+		},
+	}
+	rotatedCredentials = []gatewayCredentials{cupsCredentials, lnsCredentials}
+)
+
+// createAPIKey creates a gateway API key for the given credentials.
+func (s *Server) createAPIKey(ctx context.Context, ids ttnpb.GatewayIdentifiers, creds gatewayCredentials, auth grpc.CallOption) (*ttnpb.APIKey, error) {
+	access, err := s.getAccess(ctx, &ids)
+	if err != nil {
+		return nil, err
+	}
+	key, err := access.CreateAPIKey(ctx, &ttnpb.CreateGatewayAPIKeyRequest{
+		GatewayIdentifiers: ids,
+		Name:               fmt.Sprintf("%s Key, generated %s", creds.name, time.Now().UTC().Format(time.RFC3339)),
+		Rights:             creds.rights,
+	}, auth)
+	if err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithField("api_key_id", key.ID).Infof("Created gateway API key for %s", creds.name)
+	return key, nil
+}
+
+// deleteAPIKey deletes the gateway API key with the given ID.
+// API keys that no longer exist are ignored.
+func (s *Server) deleteAPIKey(ctx context.Context, ids ttnpb.GatewayIdentifiers, id string, auth grpc.CallOption) error {
+	if id == "" {
+		return nil
+	}
+	access, err := s.getAccess(ctx, &ids)
+	if err != nil {
+		return err
+	}
+	_, err = access.UpdateAPIKey(ctx, &ttnpb.UpdateGatewayAPIKeyRequest{
+		GatewayIdentifiers: ids,
+		APIKey:             ttnpb.APIKey{ID: id},
+	}, auth)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	log.FromContext(ctx).WithField("api_key_id", id).Info("Deleted gateway API key")
+	return nil
+}
+
+func parseTimeAttribute(attributes map[string]string, key string) *time.Time {
+	t, err := time.Parse(time.RFC3339, attributes[key])
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func parseDurationAttribute(attributes map[string]string, key string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(attributes[key])
+	if err != nil {
+		return fallback
+	}
+	return d
+}
+
+// credentialsRotation returns the credentials rotation of the gateway from its attributes.
+func (s *Server) credentialsRotation(gtw *ttnpb.Gateway) *ttnpb.GatewayCredentialsRotation {
+	requested, _ := strconv.ParseBool(gtw.Attributes[cupsRotationRequestedAttribute])
+	return &ttnpb.GatewayCredentialsRotation{
+		GatewayIdentifiers: gtw.GatewayIdentifiers,
+		Interval:           parseDurationAttribute(gtw.Attributes, cupsRotationIntervalAttribute, s.rotationInterval),
+		RollbackTimeout:    parseDurationAttribute(gtw.Attributes, cupsRotationRollbackTimeoutAttribute, s.rotationRollbackTimeout),
+		RotatedAt:          parseTimeAttribute(gtw.Attributes, cupsRotatedAtAttribute),
+		PendingSince:       parseTimeAttribute(gtw.Attributes, cupsRotationPendingSinceAttribute),
+		Requested:          requested,
+	}
+}
+
+// rotationDue returns whether the credentials of the gateway should be rotated at the given time.
+func (s *Server) rotationDue(gtw *ttnpb.Gateway, now time.Time) bool {
+	if gtw.Attributes[cupsCredentialsIDAttribute] == "" && gtw.Attributes[lnsCredentialsIDAttribute] == "" {
+		return false
+	}
+	rotation := s.credentialsRotation(gtw)
+	switch {
+	case rotation.PendingSince != nil:
+		return false
+	case rotation.Requested:
+		return true
+	case rotation.Interval <= 0:
+		return false
+	case rotation.RotatedAt == nil:
+		// The credentials were not rotated before; the interval starts now.
+		gtw.Attributes[cupsRotatedAtAttribute] = now.UTC().Format(time.RFC3339)
+		return false
+	default:
+		return now.Sub(*rotation.RotatedAt) >= rotation.Interval
+	}
+}
+
+// startRotation creates new credentials for the gateway. The current credentials are kept as previous credentials
+// until the gateway connects with the new credentials.
+func (s *Server) startRotation(ctx context.Context, gtw *ttnpb.Gateway, now time.Time, auth grpc.CallOption) error {
+	for _, creds := range rotatedCredentials {
+		if gtw.Attributes[creds.idAttribute] == "" {
+			continue
+		}
+		key, err := s.createAPIKey(ctx, gtw.GatewayIdentifiers, creds, auth)
+		if err != nil {
+			return err
+		}
+		gtw.Attributes[creds.previousIDAttribute] = gtw.Attributes[creds.idAttribute]
+		gtw.Attributes[creds.previousAttribute] = gtw.Attributes[creds.attribute]
+		gtw.Attributes[creds.idAttribute] = key.ID
+		gtw.Attributes[creds.attribute] = fmt.Sprintf("Bearer %s", key.Key)
+	}
+	gtw.Attributes[cupsRotationPendingSinceAttribute] = now.UTC().Format(time.RFC3339)
+	delete(gtw.Attributes, cupsRotationRequestedAttribute)
+	log.FromContext(ctx).Info("Started credentials rotation")
+	return nil
+}
+
+// confirmRotation deletes the previous credentials of the gateway.
+func (s *Server) confirmRotation(ctx context.Context, gtw *ttnpb.Gateway, now time.Time, auth grpc.CallOption) error {
+	for _, creds := range rotatedCredentials {
+		if err := s.deleteAPIKey(ctx, gtw.GatewayIdentifiers, gtw.Attributes[creds.previousIDAttribute], auth); err != nil {
+			return err
+		}
+		delete(gtw.Attributes, creds.previousIDAttribute)
+		delete(gtw.Attributes, creds.previousAttribute)
+	}
+	delete(gtw.Attributes, cupsRotationPendingSinceAttribute)
+	gtw.Attributes[cupsRotatedAtAttribute] = now.UTC().Format(time.RFC3339)
+	log.FromContext(ctx).Info("Confirmed credentials rotation")
+	return nil
+}
+
+// rollbackRotation deletes the new credentials of the gateway and restores the previous credentials.
+func (s *Server) rollbackRotation(ctx context.Context, gtw *ttnpb.Gateway, auth grpc.CallOption) error {
+	for _, creds := range rotatedCredentials {
+		if gtw.Attributes[creds.previousIDAttribute] == "" {
+			continue
+		}
+		if err := s.deleteAPIKey(ctx, gtw.GatewayIdentifiers, gtw.Attributes[creds.idAttribute], auth); err != nil {
+			return err
+		}
+		gtw.Attributes[creds.idAttribute] = gtw.Attributes[creds.previousIDAttribute]
+		gtw.Attributes[creds.attribute] = gtw.Attributes[creds.previousAttribute]
+		delete(gtw.Attributes, creds.previousIDAttribute)
+		delete(gtw.Attributes, creds.previousAttribute)
+	}
+	delete(gtw.Attributes, cupsRotationPendingSinceAttribute)
+	log.FromContext(ctx).Warn("Rolled back credentials rotation")
+	return nil
+}
+
+// rotateCredentials starts a rotation of the credentials of the gateway if it is due, or rolls back the pending
+// rotation if the gateway did not connect with the new credentials within the rollback timeout.
+// This method returns whether the credentials of the gateway changed.
+func (s *Server) rotateCredentials(ctx context.Context, gtw *ttnpb.Gateway, now time.Time) (bool, error) {
+	rotation := s.credentialsRotation(gtw)
+	if rotation.PendingSince != nil {
+		if rotation.RollbackTimeout <= 0 || now.Sub(*rotation.PendingSince) < rotation.RollbackTimeout {
+			return false, nil
+		}
+		return true, s.rollbackRotation(ctx, gtw, s.rotationAuth(ctx))
+	}
+	if !s.rotationDue(gtw, now) {
+		return false, nil
+	}
+	return true, s.startRotation(ctx, gtw, now, s.rotationAuth(ctx))
+}
@@ -0,0 +1,140 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestCredentialsRotation(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	start := time.Date(2019, time.November, 1, 12, 0, 0, 0, time.UTC)
+
+	mockGateway := func() *ttnpb.Gateway {
+		return &ttnpb.Gateway{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: "test-gateway",
+				EUI:       &mockGatewayEUI,
+			},
+			Attributes: map[string]string{
+				cupsCredentialsIDAttribute: "CUPSKEYID",
+				cupsCredentialsAttribute:   "Bearer CUPSKEYCONTENTS",
+				lnsCredentialsIDAttribute:  "LNSKEYID",
+				lnsCredentialsAttribute:    "Bearer LNSKEYCONTENTS",
+			},
+		}
+	}
+
+	t.Run("Interval", func(t *testing.T) {
+		a := assertions.New(t)
+		store := &mockGatewayClient{}
+		store.res.CreateAPIKey = &ttnpb.APIKey{ID: "NEWKEYID", Key: "NEWKEYCONTENTS"}
+		s := NewServer(nil, WithRegistries(store, store), WithCredentialsRotation(time.Hour, 0, mockAuthFunc))
+
+		gtw := mockGateway()
+
+		// The interval starts when the gateway is first seen.
+		rotated, err := s.rotateCredentials(ctx, gtw, start)
+		a.So(err, should.BeNil)
+		a.So(rotated, should.BeFalse)
+		a.So(gtw.Attributes[cupsRotatedAtAttribute], should.Equal, start.Format(time.RFC3339))
+
+		rotated, err = s.rotateCredentials(ctx, gtw, start.Add(59*time.Minute))
+		a.So(err, should.BeNil)
+		a.So(rotated, should.BeFalse)
+		a.So(store.req.CreateAPIKey, should.BeNil)
+
+		rotated, err = s.rotateCredentials(ctx, gtw, start.Add(time.Hour))
+		a.So(err, should.BeNil)
+		a.So(rotated, should.BeTrue)
+		if a.So(store.req.CreateAPIKey, should.NotBeNil) {
+			a.So(store.req.CreateAPIKey.GatewayIdentifiers, should.Resemble, gtw.GatewayIdentifiers)
+		}
+		a.So(gtw.Attributes[cupsCredentialsIDAttribute], should.Equal, "NEWKEYID")
+		a.So(gtw.Attributes[cupsCredentialsAttribute], should.Equal, "Bearer NEWKEYCONTENTS")
+		a.So(gtw.Attributes[cupsPreviousCredentialsIDAttribute], should.Equal, "CUPSKEYID")
+		a.So(gtw.Attributes[cupsPreviousCredentialsAttribute], should.Equal, "Bearer CUPSKEYCONTENTS")
+		a.So(gtw.Attributes[lnsCredentialsIDAttribute], should.Equal, "NEWKEYID")
+		a.So(gtw.Attributes[lnsPreviousCredentialsIDAttribute], should.Equal, "LNSKEYID")
+		a.So(gtw.Attributes[cupsRotationPendingSinceAttribute], should.Equal, start.Add(time.Hour).Format(time.RFC3339))
+
+		// No rollback timeout, so the rotation stays pending.
+		rotated, err = s.rotateCredentials(ctx, gtw, start.Add(48*time.Hour))
+		a.So(err, should.BeNil)
+		a.So(rotated, should.BeFalse)
+
+		a.So(s.confirmRotation(ctx, gtw, start.Add(49*time.Hour), mockAuthFunc(ctx)), should.BeNil)
+		if a.So(store.req.UpdateAPIKey, should.NotBeNil) {
+			a.So(store.req.UpdateAPIKey.APIKey.ID, should.Equal, "LNSKEYID")
+			a.So(store.req.UpdateAPIKey.APIKey.Rights, should.BeEmpty)
+		}
+		a.So(gtw.Attributes, should.NotContainKey, cupsPreviousCredentialsIDAttribute)
+		a.So(gtw.Attributes, should.NotContainKey, lnsPreviousCredentialsIDAttribute)
+		a.So(gtw.Attributes, should.NotContainKey, cupsRotationPendingSinceAttribute)
+		a.So(gtw.Attributes[cupsRotatedAtAttribute], should.Equal, start.Add(49*time.Hour).Format(time.RFC3339))
+	})
+
+	t.Run("Requested", func(t *testing.T) {
+		a := assertions.New(t)
+		store := &mockGatewayClient{}
+		store.res.CreateAPIKey = &ttnpb.APIKey{ID: "NEWKEYID", Key: "NEWKEYCONTENTS"}
+		s := NewServer(nil, WithRegistries(store, store), WithCredentialsRotation(0, 0, mockAuthFunc))
+
+		gtw := mockGateway()
+		rotated, err := s.rotateCredentials(ctx, gtw, start)
+		a.So(err, should.BeNil)
+		a.So(rotated, should.BeFalse)
+
+		gtw.Attributes[cupsRotationRequestedAttribute] = "true"
+		rotated, err = s.rotateCredentials(ctx, gtw, start)
+		a.So(err, should.BeNil)
+		a.So(rotated, should.BeTrue)
+		a.So(gtw.Attributes, should.NotContainKey, cupsRotationRequestedAttribute)
+		a.So(gtw.Attributes[cupsCredentialsIDAttribute], should.Equal, "NEWKEYID")
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		a := assertions.New(t)
+		store := &mockGatewayClient{}
+		store.res.CreateAPIKey = &ttnpb.APIKey{ID: "NEWKEYID", Key: "NEWKEYCONTENTS"}
+		s := NewServer(nil, WithRegistries(store, store), WithCredentialsRotation(0, time.Hour, mockAuthFunc))
+
+		gtw := mockGateway()
+		gtw.Attributes[cupsRotationRequestedAttribute] = "true"
+		rotated, err := s.rotateCredentials(ctx, gtw, start)
+		a.So(err, should.BeNil)
+		a.So(rotated, should.BeTrue)
+
+		rotated, err = s.rotateCredentials(ctx, gtw, start.Add(59*time.Minute))
+		a.So(err, should.BeNil)
+		a.So(rotated, should.BeFalse)
+		a.So(store.req.UpdateAPIKey, should.BeNil)
+
+		rotated, err = s.rotateCredentials(ctx, gtw, start.Add(time.Hour))
+		a.So(err, should.BeNil)
+		a.So(rotated, should.BeTrue)
+		if a.So(store.req.UpdateAPIKey, should.NotBeNil) {
+			a.So(store.req.UpdateAPIKey.APIKey.ID, should.Equal, "NEWKEYID")
+		}
+		a.So(gtw.Attributes, should.Resemble, mockGateway().Attributes)
+	})
+}
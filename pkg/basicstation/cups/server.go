@@ -17,8 +17,12 @@ package cups
 import (
 	"context"
 	"crypto"
+	"crypto/elliptic"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/pem"
+	"hash/crc32"
+	"io/ioutil"
 	"net"
 	"net/url"
 	"strings"
@@ -31,6 +35,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/web"
+	"gocloud.dev/blob"
 	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -48,15 +53,70 @@ type ServerConfig struct {
 		LNSURI string `name:"lns-uri" description:"The default LNS URI that the gateways should use"`
 	} `name:"default" description:"Default gateway settings"`
 	AllowCUPSURIUpdate bool `name:"allow-cups-uri-update" description:"Allow CUPS URI updates"`
+	Firmware           struct {
+		Bucket          string   `name:"bucket" description:"Blob bucket that contains the firmware targets and update data"`
+		SigningKeyFiles []string `name:"signing-key-files" description:"Paths of the PEM encoded ECDSA private keys to sign update data with"`
+	} `name:"firmware" description:"Firmware update settings"`
+	CredentialsRotation struct {
+		APIKey          string        `name:"api-key" description:"API Key to use for creating and deleting gateway API keys"`
+		Interval        time.Duration `name:"interval" description:"Default interval at which gateway credentials are rotated (0 to only rotate on request)"`
+		RollbackTimeout time.Duration `name:"rollback-timeout" description:"Default time after which a credentials rotation is rolled back if the gateway does not use the new credentials"`
+	} `name:"credentials-rotation" description:"Gateway credentials rotation settings"`
+}
+
+var errSigningKey = errors.DefineInvalidArgument("signing_key", "invalid signing key `{file}`")
+
+// loadSigner loads the ECDSA private key from the PEM encoded file and returns the key CRC and signer.
+// The key CRC is the CRC32 of the uncompressed public key without the point format prefix, as used by Basic Station.
+func loadSigner(file string) (uint32, crypto.Signer, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return 0, nil, errSigningKey.WithCause(err).WithAttributes("file", file)
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return 0, nil, errSigningKey.WithAttributes("file", file)
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return 0, nil, errSigningKey.WithCause(err).WithAttributes("file", file)
+	}
+	pub := elliptic.Marshal(key.Curve, key.X, key.Y)
+	return crc32.ChecksumIEEE(pub[1:]), key, nil
 }
 
 // NewServer returns a new CUPS server from this config on top of the component.
-func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) *Server {
+func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) (*Server, error) {
 	opts := []Option{
 		WithExplicitEnable(conf.ExplicitEnable),
 		WithAllowCUPSURIUpdate(conf.AllowCUPSURIUpdate),
 		WithDefaultLNSURI(conf.Default.LNSURI),
 	}
+	if conf.Firmware.Bucket != "" {
+		bucket, err := c.GetBaseConfig(c.Context()).Blob.Bucket(c.Context(), conf.Firmware.Bucket)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithFirmwareBucket(bucket))
+	}
+	for _, file := range conf.Firmware.SigningKeyFiles {
+		keyCRC, signer, err := loadSigner(file)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithSigner(keyCRC, signer))
+	}
+	var rotationAuth func(context.Context) grpc.CallOption
+	if conf.CredentialsRotation.APIKey != "" {
+		rotationAuth = func(ctx context.Context) grpc.CallOption {
+			return grpc.PerRPCCredentials(rpcmetadata.MD{
+				AuthType:      "bearer",
+				AuthValue:     conf.CredentialsRotation.APIKey,
+				AllowInsecure: c.AllowInsecureForCredentials(),
+			})
+		}
+	}
+	opts = append(opts, WithCredentialsRotation(conf.CredentialsRotation.Interval, conf.CredentialsRotation.RollbackTimeout, rotationAuth))
 	var registerUnknownTo *ttnpb.OrganizationOrUserIdentifiers
 	switch conf.RegisterUnknown.Type {
 	case "user":
@@ -80,7 +140,7 @@ func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option)
 	}
 	s := NewServer(c, append(opts, customOpts...)...)
 	c.RegisterWeb(s)
-	return s
+	return s, nil
 }
 
 // Server implements the Basic Station Configuration and Update Server.
@@ -89,9 +149,10 @@ type Server struct {
 
 	// registry and access can be used to override the default behavior of getting
 	// clients from the appropriate cluster peer.
-	registry ttnpb.GatewayRegistryClient
-	access   ttnpb.GatewayAccessClient
-	auth     func(context.Context) grpc.CallOption
+	registry     ttnpb.GatewayRegistryClient
+	access       ttnpb.GatewayAccessClient
+	entityAccess ttnpb.EntityAccessClient
+	auth         func(context.Context) grpc.CallOption
 
 	requireExplicitEnable bool
 	registerUnknown       bool
@@ -108,7 +169,12 @@ type Server struct {
 	trustCacheMu sync.RWMutex
 	trustCache   map[string]*x509.Certificate
 
-	signers map[uint32]crypto.Signer
+	signers  map[uint32]crypto.Signer
+	firmware *firmwareStore
+
+	rotationInterval        time.Duration
+	rotationRollbackTimeout time.Duration
+	rotationAuth            func(context.Context) grpc.CallOption
 }
 
 func (s *Server) getServerAuth(ctx context.Context) grpc.CallOption {
@@ -140,6 +206,17 @@ func (s *Server) getAccess(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (
 	return ttnpb.NewGatewayAccessClient(cc), nil
 }
 
+func (s *Server) getEntityAccess(ctx context.Context) (ttnpb.EntityAccessClient, error) {
+	if s.entityAccess != nil {
+		return s.entityAccess, nil
+	}
+	cc, err := s.component.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.NewEntityAccessClient(cc), nil
+}
+
 // Option configures the CUPSServer.
 type Option func(s *Server)
 
@@ -204,6 +281,29 @@ func WithSigner(keyCRC uint32, signer crypto.Signer) Option {
 	}
 }
 
+// WithFirmwareBucket configures the CUPS server with a blob bucket that contains
+// the firmware targets and update data. Gateways that have auto update enabled
+// are updated to the firmware target that applies to them.
+func WithFirmwareBucket(bucket *blob.Bucket) Option {
+	return func(s *Server) {
+		if bucket != nil {
+			s.firmware = &firmwareStore{bucket: bucket}
+		} else {
+			s.firmware = nil
+		}
+	}
+}
+
+// WithCredentialsRotation configures the CUPS server to rotate the CUPS and LNS
+// credentials of gateways. The interval and rollback timeout are used for gateways
+// that do not have a rotation policy. The auth func is used to create and delete
+// gateway API keys; if it is nil, credentials are not rotated.
+func WithCredentialsRotation(interval, rollbackTimeout time.Duration, auth func(context.Context) grpc.CallOption) Option {
+	return func(s *Server) {
+		s.rotationInterval, s.rotationRollbackTimeout, s.rotationAuth = interval, rollbackTimeout, auth
+	}
+}
+
 // WithRegistries overrides the CUPS server's gateway registries.
 func WithRegistries(registry ttnpb.GatewayRegistryClient, access ttnpb.GatewayAccessClient) Option {
 	return func(s *Server) {
@@ -211,6 +311,13 @@ func WithRegistries(registry ttnpb.GatewayRegistryClient, access ttnpb.GatewayAc
 	}
 }
 
+// WithEntityAccess overrides the CUPS server's entity access client.
+func WithEntityAccess(access ttnpb.EntityAccessClient) Option {
+	return func(s *Server) {
+		s.entityAccess = access
+	}
+}
+
 // WithAuth overrides the CUPS server's server auth func.
 func WithAuth(auth func(ctx context.Context) grpc.CallOption) Option {
 	return func(s *Server) {
@@ -238,7 +345,7 @@ func (s *Server) RegisterRoutes(web *web.Server) {
 }
 
 func getContext(c echo.Context) context.Context {
-	ctx := c.Request().Context()
+	ctx := rpcmetadata.NewContextWithRemoteAddress(c.Request().Context(), c.Request().RemoteAddr)
 	md := metadata.New(map[string]string{
 		"authorization": c.Request().Header.Get(echo.HeaderAuthorization),
 	})
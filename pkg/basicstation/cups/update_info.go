@@ -92,34 +92,15 @@ func (s *Server) registerGateway(ctx context.Context, req UpdateInfoRequest) (*t
 		return nil, err
 	}
 	logger.Info("Created new gateway")
-	accessRegistry, err := s.getAccess(ctx, &gtw.GatewayIdentifiers)
-	if err != nil {
-		return nil, err
-	}
-	cupsKey, err := accessRegistry.CreateAPIKey(ctx, &ttnpb.CreateGatewayAPIKeyRequest{
-		GatewayIdentifiers: gtw.GatewayIdentifiers,
-		Name:               fmt.Sprintf("CUPS Key, generated %s", time.Now().UTC().Format(time.RFC3339)),
-		Rights: []ttnpb.Right{
-			ttnpb.RIGHT_GATEWAY_INFO,
-			ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC,
-		},
-	}, auth)
+	ctx = log.NewContext(ctx, logger)
+	cupsKey, err := s.createAPIKey(ctx, gtw.GatewayIdentifiers, cupsCredentials, auth)
 	if err != nil {
 		return nil, err
 	}
-	logger.WithField("api_key_id", cupsKey.ID).Info("Created gateway API key for CUPS")
-	lnsKey, err := accessRegistry.CreateAPIKey(ctx, &ttnpb.CreateGatewayAPIKeyRequest{
-		GatewayIdentifiers: gtw.GatewayIdentifiers,
-		Name:               fmt.Sprintf("LNS Key, generated %s", time.Now().UTC().Format(time.RFC3339)),
-		Rights: []ttnpb.Right{
-			ttnpb.RIGHT_GATEWAY_INFO,
-			ttnpb.RIGHT_GATEWAY_LINK,
-		},
-	}, auth)
+	lnsKey, err := s.createAPIKey(ctx, gtw.GatewayIdentifiers, lnsCredentials, auth)
 	if err != nil {
 		return nil, err
 	}
-	logger.WithField("api_key_id", lnsKey.ID).Info("Created gateway API key for LNS")
 	gtw.Attributes = map[string]string{
 		cupsAttribute:              "true",
 		cupsAuthHeaderAttribute:    getAuthHeader(ctx),
@@ -182,6 +163,7 @@ func (s *Server) UpdateInfo(c echo.Context) error {
 
 	uid := unique.ID(ctx, gtw.GatewayIdentifiers)
 	logger = logger.WithField("gateway_uid", uid)
+	ctx = log.NewContext(ctx, logger)
 
 	var gatewayAuth grpc.CallOption
 	if rights.RequireGateway(ctx, gtw.GatewayIdentifiers,
@@ -217,6 +199,15 @@ func (s *Server) UpdateInfo(c echo.Context) error {
 	}
 
 	res := UpdateInfoResponse{}
+	now := time.Now()
+
+	var rotated bool
+	if s.rotationAuth != nil {
+		rotated, err = s.rotateCredentials(ctx, gtw, now)
+		if err != nil {
+			return err
+		}
+	}
 
 	if gtw.Attributes[cupsURIAttribute] == "" {
 		gtw.Attributes[cupsURIAttribute] = req.CUPSURI
@@ -272,10 +263,17 @@ func (s *Server) UpdateInfo(c echo.Context) error {
 		}
 	}
 
-	if gtw.AutoUpdate {
-		// TODO: Compare the Station, Model, Package, version_ids and update_channel in order to check if any updates are required
-		// (https://github.com/TheThingsNetwork/lorawan-stack/issues/365)
-		var updateData []byte
+	if !rotated && s.rotationAuth != nil && gtw.Attributes[cupsRotationPendingSinceAttribute] != "" &&
+		res.CUPSCredentials == nil && res.LNSCredentials == nil {
+		// The gateway connected with the new credentials, so the previous credentials are no longer needed.
+		if err := s.confirmRotation(ctx, gtw, now, s.rotationAuth(ctx)); err != nil {
+			return err
+		}
+		rotated = true
+	}
+
+	if gtw.AutoUpdate && s.firmware != nil {
+		updateData := s.getUpdateData(ctx, gtw, req, now)
 		if updateData != nil {
 			var (
 				keyCRC uint32
@@ -296,11 +294,13 @@ func (s *Server) UpdateInfo(c echo.Context) error {
 				res.SignatureKeyCRC = keyCRC
 				res.Signature = sig
 				res.UpdateData = updateData
+			} else {
+				logger.Warn("No signing key known by gateway, skip firmware update")
 			}
 		}
 	}
 
-	gtw.Attributes[cupsLastSeenAttribute] = time.Now().UTC().Format(time.RFC3339)
+	gtw.Attributes[cupsLastSeenAttribute] = now.UTC().Format(time.RFC3339)
 	gtw.Attributes[cupsStationAttribute] = req.Station
 	gtw.Attributes[cupsModelAttribute] = req.Model
 	gtw.Attributes[cupsPackageAttribute] = req.Package
@@ -309,6 +309,10 @@ func (s *Server) UpdateInfo(c echo.Context) error {
 	if err != nil {
 		return err
 	}
+	if rotated {
+		// The credentials that the gateway authenticated with may have been deleted.
+		gatewayAuth = s.rotationAuth(ctx)
+	}
 	gtw, err = registry.Update(ctx, &ttnpb.UpdateGatewayRequest{
 		Gateway: *gtw,
 		FieldMask: pbtypes.FieldMask{Paths: []string{
@@ -0,0 +1,224 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt provides a general client and utilities for the embedded bbolt key-value store.
+package bolt
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"go.etcd.io/bbolt"
+	"go.thethings.network/lorawan-stack/pkg/config"
+)
+
+const (
+	// separator is character used to separate the keys.
+	separator = ':'
+)
+
+// Key constructs the full key for entity identified by ks by joining ks using the default separator.
+func Key(ks ...string) string {
+	return strings.Join(ks, string(separator))
+}
+
+// Open opens the bbolt database at the path configured in conf.
+// The directory of the database file is created if it does not exist.
+func Open(conf config.Bolt) (*bbolt.DB, error) {
+	if dir := filepath.Dir(conf.Path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, errOpen.WithAttributes("path", conf.Path).WithCause(err)
+		}
+	}
+	db, err := bbolt.Open(conf.Path, 0600, &bbolt.Options{
+		Timeout: conf.Timeout,
+	})
+	if err != nil {
+		return nil, errOpen.WithAttributes("path", conf.Path).WithCause(err)
+	}
+	return db, nil
+}
+
+// Config represents bbolt client configuration.
+type Config struct {
+	DB        *bbolt.DB
+	Namespace []string
+}
+
+// Client represents a bbolt store client.
+// All values of the client are stored in a single bucket, identified by the namespace.
+type Client struct {
+	DB     *bbolt.DB
+	bucket []byte
+}
+
+// New returns a new initialized bbolt store client.
+func New(conf *Config) (*Client, error) {
+	bucket := []byte(Key(conf.Namespace...))
+	if err := conf.DB.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucket)
+		return err
+	}); err != nil {
+		return nil, ConvertError(err)
+	}
+	return &Client{
+		DB:     conf.DB,
+		bucket: bucket,
+	}, nil
+}
+
+// Key constructs the full key for entity identified by ks by joining ks using the default separator.
+func (cl *Client) Key(ks ...string) []byte {
+	return []byte(Key(ks...))
+}
+
+// View calls f with the bucket of cl in a read-only transaction.
+// Values returned by the bucket are only valid during the transaction.
+func (cl *Client) View(f func(*bbolt.Bucket) error) error {
+	return ConvertError(cl.DB.View(func(tx *bbolt.Tx) error {
+		return f(tx.Bucket(cl.bucket))
+	}))
+}
+
+// Update calls f with the bucket of cl in a read-write transaction.
+// If f returns an error, the transaction is rolled back.
+func (cl *Client) Update(f func(*bbolt.Bucket) error) error {
+	return ConvertError(cl.DB.Update(func(tx *bbolt.Tx) error {
+		return f(tx.Bucket(cl.bucket))
+	}))
+}
+
+// GetProto unmarshals the value stored at k in b into pb.
+func GetProto(b *bbolt.Bucket, k []byte, pb proto.Message) error {
+	v := b.Get(k)
+	if v == nil {
+		return errNotFound
+	}
+	return UnmarshalProto(v, pb)
+}
+
+// FindProto unmarshals the value stored at keyFunc(id) in b into pb, where id is the value stored at k in b.
+func FindProto(b *bbolt.Bucket, k []byte, keyFunc func(string) []byte, pb proto.Message) error {
+	id := b.Get(k)
+	if id == nil {
+		return errNotFound
+	}
+	return GetProto(b, keyFunc(string(id)), pb)
+}
+
+// SetProto marshals pb and stores it at k in b.
+func SetProto(b *bbolt.Bucket, k []byte, pb proto.Message) error {
+	v, err := proto.Marshal(pb)
+	if err != nil {
+		return err
+	}
+	return b.Put(k, v)
+}
+
+// UnmarshalProto unmarshals v into pb.
+func UnmarshalProto(v []byte, pb proto.Message) error {
+	if err := proto.Unmarshal(v, pb); err != nil {
+		return errDecode.WithCause(err)
+	}
+	return nil
+}
+
+// ForEachPrefix calls f for each key-value pair in b, for which the key starts with prefix, in key order.
+// The iteration stops if f returns false or an error.
+func ForEachPrefix(b *bbolt.Bucket, prefix []byte, f func(k, v []byte) (bool, error)) error {
+	c := b.Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		ok, err := f(k, v)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+	return nil
+}
+
+// CountPrefix returns the number of keys in b, which start with prefix.
+func CountPrefix(b *bbolt.Bucket, prefix []byte) int64 {
+	var n int64
+	c := b.Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		n++
+	}
+	return n
+}
+
+// WatchTx records the values read during a watched transaction.
+type WatchTx struct {
+	cl   *Client
+	keys [][]byte
+	vals [][]byte
+}
+
+// Get returns a copy of the value stored at k, or nil if no value is stored at k, and watches k for modifications.
+func (tx *WatchTx) Get(k []byte) ([]byte, error) {
+	var v []byte
+	if err := tx.cl.View(func(b *bbolt.Bucket) error {
+		if bv := b.Get(k); bv != nil {
+			v = append(make([]byte, 0, len(bv)), bv...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	tx.keys = append(tx.keys, k)
+	tx.vals = append(tx.vals, v)
+	return v, nil
+}
+
+// GetProto unmarshals the value stored at k into pb and watches k for modifications.
+func (tx *WatchTx) GetProto(k []byte, pb proto.Message) error {
+	v, err := tx.Get(k)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return errNotFound
+	}
+	return UnmarshalProto(v, pb)
+}
+
+// Watch calls f with a WatchTx, which records all values read by f.
+// f is called outside of a database transaction and may therefore block.
+// The function returned by f, if not nil, is called in a read-write transaction,
+// which is only committed if none of the values read by f were modified in the meantime.
+// If a watched value was modified, Watch returns an error and the transaction is aborted.
+func (cl *Client) Watch(f func(*WatchTx) (func(*bbolt.Bucket) error, error)) error {
+	tx := &WatchTx{cl: cl}
+	update, err := f(tx)
+	if err != nil {
+		return err
+	}
+	if update == nil {
+		return nil
+	}
+	return cl.Update(func(b *bbolt.Bucket) error {
+		for i, k := range tx.keys {
+			v := b.Get(k)
+			if (v == nil) != (tx.vals[i] == nil) || !bytes.Equal(v, tx.vals[i]) {
+				return errConflict.WithAttributes("key", string(k))
+			}
+		}
+		return update(b)
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.etcd.io/bbolt"
+	. "go.thethings.network/lorawan-stack/pkg/bolt"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestProto(t *testing.T) {
+	a := assertions.New(t)
+
+	cl, closeFn := test.NewBolt(t, "bolt_test")
+	defer closeFn()
+
+	k := cl.Key("uid", "test")
+	err := cl.View(func(b *bbolt.Bucket) error {
+		return GetProto(b, k, &ttnpb.ApplicationIdentifiers{})
+	})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	err = cl.Update(func(b *bbolt.Bucket) error {
+		return SetProto(b, k, &ttnpb.ApplicationIdentifiers{ApplicationID: "test"})
+	})
+	a.So(err, should.BeNil)
+
+	pb := &ttnpb.ApplicationIdentifiers{}
+	err = cl.View(func(b *bbolt.Bucket) error {
+		return GetProto(b, k, pb)
+	})
+	a.So(err, should.BeNil)
+	a.So(pb, should.Resemble, &ttnpb.ApplicationIdentifiers{ApplicationID: "test"})
+}
+
+func TestWatch(t *testing.T) {
+	a := assertions.New(t)
+
+	cl, closeFn := test.NewBolt(t, "bolt_test")
+	defer closeFn()
+
+	k := cl.Key("test")
+	err := cl.Watch(func(tx *WatchTx) (func(*bbolt.Bucket) error, error) {
+		v, err := tx.Get(k)
+		a.So(err, should.BeNil)
+		a.So(v, should.BeNil)
+		return func(b *bbolt.Bucket) error {
+			return b.Put(k, []byte("foo"))
+		}, nil
+	})
+	a.So(err, should.BeNil)
+
+	err = cl.Watch(func(tx *WatchTx) (func(*bbolt.Bucket) error, error) {
+		v, err := tx.Get(k)
+		a.So(err, should.BeNil)
+		a.So(v, should.Resemble, []byte("foo"))
+
+		if err := cl.Update(func(b *bbolt.Bucket) error {
+			return b.Put(k, []byte("bar"))
+		}); err != nil {
+			return nil, err
+		}
+		return func(b *bbolt.Bucket) error {
+			return b.Put(k, []byte("baz"))
+		}, nil
+	})
+	a.So(errors.IsAborted(err), should.BeTrue)
+
+	err = cl.View(func(b *bbolt.Bucket) error {
+		a.So(b.Get(k), should.Resemble, []byte("bar"))
+		return nil
+	})
+	a.So(err, should.BeNil)
+}
+
+func TestTaskQueue(t *testing.T) {
+	a := assertions.New(t)
+
+	cl, closeFn := test.NewBolt(t, "bolt_test")
+	defer closeFn()
+
+	q := &TaskQueue{
+		Bolt: cl,
+		Key:  "tasks",
+	}
+
+	ctx, cancel := context.WithTimeout(test.Context(), test.Delay)
+	err := q.Pop(ctx, func(string, time.Time) error {
+		t.Error("f must not be called on empty queue")
+		return nil
+	})
+	cancel()
+	a.So(err, should.BeNil)
+
+	a.So(q.Add("a", time.Unix(0, 42), false), should.BeNil)
+	a.So(q.Add("b", time.Now().Add(time.Hour), false), should.BeNil)
+	a.So(q.Add("b", time.Unix(13, 0), false), should.BeNil)
+	a.So(q.Add("c", time.Now().Add(time.Hour), false), should.BeNil)
+	a.So(q.Add("c", time.Unix(42, 0), true), should.BeNil)
+
+	type task struct {
+		payload string
+		startAt time.Time
+	}
+	var popped []task
+	for i := 0; i < 2; i++ {
+		err := q.Pop(test.Context(), func(s string, startAt time.Time) error {
+			popped = append(popped, task{s, startAt})
+			return nil
+		})
+		a.So(err, should.BeNil)
+	}
+	a.So(popped, should.Resemble, []task{
+		{"a", time.Unix(0, 42)},
+		{"c", time.Unix(42, 0)},
+	})
+
+	popCh := make(chan task, 1)
+	go func() {
+		q.Pop(test.Context(), func(s string, startAt time.Time) error {
+			popCh <- task{s, startAt}
+			return nil
+		})
+	}()
+	time.Sleep(test.Delay)
+	a.So(q.Add("d", time.Unix(1, 0), false), should.BeNil)
+	select {
+	case tsk := <-popCh:
+		a.So(tsk, should.Resemble, task{"d", time.Unix(1, 0)})
+	case <-time.After(test.Delay * 10):
+		t.Fatal("Timed out waiting for Pop to call f")
+	}
+}
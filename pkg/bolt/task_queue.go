@@ -0,0 +1,176 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+// TaskQueue is a task queue.
+// Each task is identified by its payload, hence there is at most one task with the same payload in the queue.
+type TaskQueue struct {
+	Bolt *Client
+	Key  string
+
+	mu   sync.Mutex
+	wake chan struct{}
+}
+
+func (q *TaskQueue) payloadKey(s string) []byte {
+	return q.Bolt.Key(q.Key, "payload", s)
+}
+
+func (q *TaskQueue) timePrefix() []byte {
+	return q.Bolt.Key(q.Key, "time", "")
+}
+
+func (q *TaskQueue) timeKey(ts []byte, s string) []byte {
+	prefix := q.timePrefix()
+	k := make([]byte, 0, len(prefix)+len(ts)+1+len(s))
+	k = append(k, prefix...)
+	k = append(k, ts...)
+	k = append(k, separator)
+	return append(k, s...)
+}
+
+func encodeStartAt(startAt time.Time) []byte {
+	var ns int64
+	if !startAt.IsZero() {
+		ns = startAt.UnixNano()
+	}
+	if ns < 0 {
+		ns = 0
+	}
+	ts := make([]byte, 8)
+	binary.BigEndian.PutUint64(ts, uint64(ns))
+	return ts
+}
+
+func decodeStartAt(ts []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(ts)))
+}
+
+// wakeCh returns the channel, which is closed when a task is added to the queue.
+func (q *TaskQueue) wakeCh() <-chan struct{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.wake == nil {
+		q.wake = make(chan struct{})
+	}
+	return q.wake
+}
+
+func (q *TaskQueue) notify() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.wake != nil {
+		close(q.wake)
+		q.wake = nil
+	}
+}
+
+// Add adds a task s to the queue with a timestamp startAt.
+// If a task s is already present in the queue, it is only updated if replace is true.
+func (q *TaskQueue) Add(s string, startAt time.Time, replace bool) error {
+	pk := q.payloadKey(s)
+	if err := q.Bolt.Update(func(b *bbolt.Bucket) error {
+		stored := b.Get(pk)
+		if stored != nil {
+			if !replace {
+				return nil
+			}
+			if err := b.Delete(q.timeKey(stored, s)); err != nil {
+				return err
+			}
+		}
+		ts := encodeStartAt(startAt)
+		if err := b.Put(pk, ts); err != nil {
+			return err
+		}
+		return b.Put(q.timeKey(ts, s), []byte{})
+	}); err != nil {
+		return err
+	}
+	q.notify()
+	return nil
+}
+
+// pop removes the earliest task from the queue, if its timestamp is at or before now.
+// If there is no such task, pop returns the timestamp of the earliest task in the queue, if any.
+func (q *TaskQueue) pop(now time.Time) (s string, startAt time.Time, ok bool, next time.Time, err error) {
+	prefix := q.timePrefix()
+	err = q.Bolt.Update(func(b *bbolt.Bucket) error {
+		return ForEachPrefix(b, prefix, func(k, _ []byte) (bool, error) {
+			ts := k[len(prefix) : len(prefix)+8]
+			t := decodeStartAt(ts)
+			if t.After(now) {
+				next = t
+				return false, nil
+			}
+			s = string(k[len(prefix)+9:])
+			startAt = t
+			ok = true
+			if err := b.Delete(q.payloadKey(s)); err != nil {
+				return false, err
+			}
+			return false, b.Delete(append(k[:0:0], k...))
+		})
+	})
+	return
+}
+
+// Pop calls f on the earliest task in the queue, for which timestamp is in range [0, time.Now()],
+// if such is available, otherwise it blocks until it is.
+// The task is removed from the queue before f is called.
+// If ctx.Deadline() is present, Pop will return at or shortly after it.
+func (q *TaskQueue) Pop(ctx context.Context, f func(string, time.Time) error) error {
+	for {
+		wake := q.wakeCh()
+		s, startAt, ok, next, err := q.pop(time.Now())
+		if err != nil {
+			return err
+		}
+		if ok {
+			return f(s, startAt)
+		}
+
+		var timer *time.Timer
+		var timerCh <-chan time.Time
+		if !next.IsZero() {
+			timer = time.NewTimer(time.Until(next))
+			timerCh = timer.C
+		}
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil
+			}
+			return ctx.Err()
+		case <-wake:
+		case <-timerCh:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/tls"
 	"io/ioutil"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -25,6 +26,7 @@ import (
 	ttnblob "go.thethings.network/lorawan-stack/pkg/blob"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/crypto/pkcs11"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/fetch"
 	"go.thethings.network/lorawan-stack/pkg/log"
@@ -58,6 +60,7 @@ type Cluster struct {
 	ApplicationServer string   `name:"application-server" description:"Address for the Application Server"`
 	JoinServer        string   `name:"join-server" description:"Address for the Join Server"`
 	CryptoServer      string   `name:"crypto-server" description:"Address for the Crypto Server"`
+	PacketBrokerAgent string   `name:"packet-broker-agent" description:"Address for the Packet Broker Agent"`
 	TLS               bool     `name:"tls" description:"Do cluster gRPC over TLS"`
 	Keys              []string `name:"keys" description:"Keys used to communicate between components of the cluster. The first one will be used by the cluster to identify itself"`
 }
@@ -140,6 +143,18 @@ func (r Redis) IsZero() bool {
 			!r.Failover.Enable && r.Address == "")
 }
 
+// Bolt represents configuration of the embedded bbolt database.
+type Bolt struct {
+	Path    string        `name:"path" description:"Path of the database file"`
+	Timeout time.Duration `name:"timeout" description:"Time to wait for the lock on the database file"`
+}
+
+// Storage represents configuration of the storage backend of the registries.
+type Storage struct {
+	Backend string `name:"backend" description:"Backend to use for registries (redis, bolt)"`
+	Bolt    Bolt   `name:"bolt"`
+}
+
 // CloudEvents represents configuration for the cloud events backend.
 type CloudEvents struct {
 	PublishURL   string `name:"publish-url" description:"URL for the topic to send events"`
@@ -166,20 +181,53 @@ type Rights struct {
 	TTL time.Duration `name:"ttl" description:"Validity of Identity Server responses"`
 }
 
+// PKCS11KeyVault represents configuration for a PKCS#11 key vault.
+type PKCS11KeyVault struct {
+	Library    string `name:"library" description:"Path to the PKCS#11 library"`
+	TokenLabel string `name:"token-label" description:"Label of the PKCS#11 token"`
+	PIN        string `name:"pin" description:"User PIN of the PKCS#11 token"`
+	Sessions   int    `name:"sessions" description:"Number of concurrent sessions with the PKCS#11 token"`
+}
+
 // KeyVault represents configuration for key vaults.
 type KeyVault struct {
-	Provider string            `name:"provider" description:"Provider (static)"`
-	Static   map[string][]byte `name:"static"`
+	Provider    string            `name:"provider" description:"Provider (static, pkcs11)"`
+	KEKVersions map[string]string `name:"kek-versions" description:"Current version of KEK labels (label=version)"`
+	Static      map[string][]byte `name:"static"`
+	PKCS11      PKCS11KeyVault    `name:"pkcs11"`
 }
 
+var errInvalidKEKVersion = errors.DefineInvalidArgument("invalid_kek_version", "invalid version `{version}` of KEK label `{label}`")
+
 // KeyVault returns an initialized crypto.KeyVault based on the configuration.
 func (v KeyVault) KeyVault() (crypto.KeyVault, error) {
+	kekLabeler := cryptoutil.ComponentPrefixKEKLabeler{
+		Separator:     ":",
+		ReplaceOldNew: []string{":", "_"},
+	}
+	if len(v.KEKVersions) > 0 {
+		kekLabeler.Versions = make(map[string]int, len(v.KEKVersions))
+		for label, s := range v.KEKVersions {
+			version, err := strconv.Atoi(s)
+			if err != nil || version < 0 {
+				return nil, errInvalidKEKVersion.WithAttributes("label", label, "version", s)
+			}
+			kekLabeler.Versions[label] = version
+		}
+	}
 	switch v.Provider {
 	case "static":
 		kv := cryptoutil.NewMemKeyVault(v.Static)
-		kv.Separator = ":"
-		kv.ReplaceOldNew = []string{":", "_"}
+		kv.ComponentPrefixKEKLabeler = kekLabeler
 		return kv, nil
+	case "pkcs11":
+		return pkcs11.NewKeyVault(pkcs11.Config{
+			Library:    v.PKCS11.Library,
+			TokenLabel: v.PKCS11.TokenLabel,
+			PIN:        v.PKCS11.PIN,
+			Sessions:   v.PKCS11.Sessions,
+			KEKLabeler: kekLabeler,
+		})
 	default:
 		return cryptoutil.EmptyKeyVault, nil
 	}
@@ -444,6 +492,7 @@ type ServiceBase struct {
 	Cluster          Cluster                `name:"cluster"`
 	Cache            Cache                  `name:"cache"`
 	Redis            Redis                  `name:"redis"`
+	Storage          Storage                `name:"storage"`
 	Events           Events                 `name:"events"`
 	GRPC             GRPC                   `name:"grpc"`
 	HTTP             HTTP                   `name:"http"`
@@ -471,10 +520,11 @@ func (c ServiceBase) DeviceRepositoryFetcher(ctx context.Context) (fetch.Interfa
 
 // MQTT contains the listen and public addresses of an MQTT frontend.
 type MQTT struct {
-	Listen           string `name:"listen" description:"Address for the MQTT frontend to listen on"`
-	ListenTLS        string `name:"listen-tls" description:"Address for the MQTTS frontend to listen on"`
-	PublicAddress    string `name:"public-address" description:"Public address of the MQTT frontend"`
-	PublicTLSAddress string `name:"public-tls-address" description:"Public address of the MQTTs frontend"`
+	Listen            string `name:"listen" description:"Address for the MQTT frontend to listen on"`
+	ListenTLS         string `name:"listen-tls" description:"Address for the MQTTS frontend to listen on"`
+	PublicAddress     string `name:"public-address" description:"Public address of the MQTT frontend"`
+	PublicTLSAddress  string `name:"public-tls-address" description:"Public address of the MQTTs frontend"`
+	MaximumPacketSize uint32 `name:"maximum-packet-size" description:"Maximum size of MQTT 5 packets that clients may send"`
 }
 
 // MQTTConfigProvider provides contextual access to MQTT configuration.
@@ -53,3 +53,11 @@ type NetworkApplication interface {
 	Network
 	Application
 }
+
+// KeyVault performs network and application layer cryptographic operations with wrapped root keys inside the key
+// vault, so that the root keys are never exposed.
+type KeyVault interface {
+	// NetworkApplication returns a network and application service that uses the given wrapped root keys.
+	// Either root key may be nil.
+	NetworkApplication(ctx context.Context, nwkKey, appKey *ttnpb.KeyEnvelope) (NetworkApplication, error)
+}
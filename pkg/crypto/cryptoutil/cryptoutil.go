@@ -16,6 +16,7 @@ package cryptoutil
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 
 	"go.thethings.network/lorawan-stack/pkg/crypto"
@@ -31,12 +32,14 @@ var (
 
 // WrapAES128Key performs the RFC 3394 Wrap algorithm on the given key using the given key vault and KEK label.
 // If the KEK label is empty, the key will be returned in the clear.
+// The key is wrapped with the current version of the KEK label.
 func WrapAES128Key(ctx context.Context, key types.AES128Key, kekLabel string, v crypto.KeyVault) (ttnpb.KeyEnvelope, error) {
 	if kekLabel == "" {
 		return ttnpb.KeyEnvelope{
 			EncryptedKey: key[:],
 		}, nil
 	}
+	kekLabel = v.KEKLabel(ctx, kekLabel)
 	wrapped, err := v.Wrap(ctx, key[:], kekLabel)
 	if err != nil {
 		return ttnpb.KeyEnvelope{}, err
@@ -73,6 +76,121 @@ func UnwrapAES128Key(ctx context.Context, wrapped ttnpb.KeyEnvelope, v crypto.Ke
 	return key, nil
 }
 
+// RewrapAES128Key wraps the key in the given key envelope with the current version of the given KEK label.
+// If the KEK label is empty, the key is wrapped with the current version of the KEK label of the envelope.
+// Keys that are stored in the clear are only wrapped if the given KEK label is not empty.
+// RewrapAES128Key returns whether the key envelope changed.
+func RewrapAES128Key(ctx context.Context, env *ttnpb.KeyEnvelope, kekLabel string, v crypto.KeyVault) (bool, error) {
+	if env == nil {
+		return false, nil
+	}
+	if kekLabel == "" {
+		kekLabel = env.KEKLabel
+	}
+	if kekLabel == "" || env.KEKLabel == v.KEKLabel(ctx, kekLabel) && env.Key == nil {
+		return false, nil
+	}
+	key, err := UnwrapAES128Key(ctx, *env, v)
+	if err != nil {
+		return false, err
+	}
+	rewrapped, err := WrapAES128Key(ctx, key, kekLabel, v)
+	if err != nil {
+		return false, err
+	}
+	*env = rewrapped
+	return true, nil
+}
+
+// RewrapSessionKeys wraps the keys in the given session keys with the current version of the given KEK label, using
+// RewrapAES128Key. RewrapSessionKeys returns the paths of the keys that changed, prefixed with the given prefix.
+func RewrapSessionKeys(ctx context.Context, sk *ttnpb.SessionKeys, prefix string, kekLabel string, v crypto.KeyVault) ([]string, error) {
+	if sk == nil {
+		return nil, nil
+	}
+	var paths []string
+	for _, key := range []struct {
+		path string
+		env  *ttnpb.KeyEnvelope
+	}{
+		{path: "app_s_key", env: sk.AppSKey},
+		{path: "f_nwk_s_int_key", env: sk.FNwkSIntKey},
+		{path: "nwk_s_enc_key", env: sk.NwkSEncKey},
+		{path: "s_nwk_s_int_key", env: sk.SNwkSIntKey},
+	} {
+		changed, err := RewrapAES128Key(ctx, key.env, kekLabel, v)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			paths = append(paths, pathWithPrefix(prefix, key.path))
+		}
+	}
+	return paths, nil
+}
+
+// WrapBytes performs the RFC 3394 Wrap algorithm on the given plaintext of arbitrary length using the given key vault
+// and KEK label. The plaintext is prefixed with its length and padded with zeros to a multiple of 8 bytes.
+// If the KEK label is empty, the plaintext will be returned in the clear.
+// The plaintext is wrapped with the current version of the KEK label, and can be unwrapped with the KEK label at any
+// version.
+func WrapBytes(ctx context.Context, plaintext []byte, kekLabel string, v crypto.KeyVault) ([]byte, error) {
+	if kekLabel == "" {
+		return plaintext, nil
+	}
+	n := 4 + len(plaintext)
+	if n < 16 {
+		n = 16
+	}
+	if r := n % 8; r != 0 {
+		n += 8 - r
+	}
+	padded := make([]byte, n)
+	binary.BigEndian.PutUint32(padded, uint32(len(plaintext)))
+	copy(padded[4:], plaintext)
+	return v.Wrap(ctx, padded, v.KEKLabel(ctx, kekLabel))
+}
+
+// UnwrapBytes performs the RFC 3394 Unwrap algorithm on the given ciphertext, that is wrapped with WrapBytes, using the
+// given key vault and KEK label.
+// If the KEK label is empty, the ciphertext is assumed to be the plaintext.
+func UnwrapBytes(ctx context.Context, ciphertext []byte, kekLabel string, v crypto.KeyVault) ([]byte, error) {
+	if kekLabel == "" {
+		return ciphertext, nil
+	}
+	padded, err := v.Unwrap(ctx, ciphertext, kekLabel)
+	if err != nil {
+		return nil, err
+	}
+	if len(padded) < 4 {
+		return nil, errInvalidLength
+	}
+	n := binary.BigEndian.Uint32(padded)
+	if uint64(n) > uint64(len(padded)-4) {
+		return nil, errInvalidLength
+	}
+	return padded[4 : 4+n], nil
+}
+
+// UnwrapKEKVersions calls unwrap with the given KEK label, and then with the other versions of the KEK label, from the
+// current version to the first version, until unwrap succeeds. If unwrap fails for all versions, the error of the given
+// KEK label is returned.
+func UnwrapKEKVersions(ctx context.Context, l crypto.ComponentKEKLabeler, kekLabel string, unwrap func(kekLabel string) error) error {
+	err := unwrap(kekLabel)
+	if err == nil {
+		return nil
+	}
+	for _, version := range l.KEKLabelVersions(ctx, kekLabel) {
+		if version == kekLabel {
+			continue
+		}
+		if unwrap(version) == nil {
+			return nil
+		}
+	}
+	return err
+}
+
 func pathWithPrefix(prefix, path string) string {
 	if prefix == "" {
 		return path
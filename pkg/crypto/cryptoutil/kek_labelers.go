@@ -18,6 +18,7 @@ import (
 	"context"
 	"net"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -29,6 +30,33 @@ type ComponentPrefixKEKLabeler struct {
 	Separator string
 	// ReplaceOldNew is a set of old and new string pairs to replace in parts.
 	ReplaceOldNew []string
+	// Versions is the current version by KEK label. KEK labels that are not in Versions are at version 0.
+	Versions map[string]int
+}
+
+// KEKLabelVersionSeparator separates the KEK label and the version in versioned KEK labels.
+// Version 0 of a KEK label is the KEK label without version.
+const KEKLabelVersionSeparator = "@v"
+
+// VersionedKEKLabel returns the given version of the KEK label.
+func VersionedKEKLabel(label string, version int) string {
+	if version <= 0 {
+		return label
+	}
+	return label + KEKLabelVersionSeparator + strconv.Itoa(version)
+}
+
+// SplitKEKLabelVersion splits the given versioned KEK label in the KEK label and the version.
+func SplitKEKLabelVersion(versioned string) (label string, version int) {
+	i := strings.LastIndex(versioned, KEKLabelVersionSeparator)
+	if i < 0 {
+		return versioned, 0
+	}
+	version, err := strconv.Atoi(versioned[i+len(KEKLabelVersionSeparator):])
+	if err != nil || version <= 0 {
+		return versioned, 0
+	}
+	return versioned[:i], version
 }
 
 // hostFromAddress returns the cluster host from the given address.
@@ -59,6 +87,7 @@ func (c ComponentPrefixKEKLabeler) join(parts ...string) string {
 }
 
 // NsKEKLabel returns a KEK label in the form `ns:netID:host` from the given NetID and address, where `:` is the default separator. Empty parts are omitted.
+// The KEK label is returned at its current version.
 func (c ComponentPrefixKEKLabeler) NsKEKLabel(ctx context.Context, netID *types.NetID, addr string) string {
 	parts := make([]string, 0, 3)
 	parts = append(parts, "ns")
@@ -68,15 +97,37 @@ func (c ComponentPrefixKEKLabeler) NsKEKLabel(ctx context.Context, netID *types.
 	if addr != "" {
 		parts = append(parts, hostFromAddress(ctx, addr))
 	}
-	return c.join(parts...)
+	return c.KEKLabel(ctx, c.join(parts...))
 }
 
 // AsKEKLabel returns a KEK label in the form `as:host` from the given address, where `:` is the default separator. Empty parts are omitted.
+// The KEK label is returned at its current version.
 func (c ComponentPrefixKEKLabeler) AsKEKLabel(ctx context.Context, addr string) string {
 	parts := make([]string, 0, 2)
 	parts = append(parts, "as")
 	if addr != "" {
 		parts = append(parts, hostFromAddress(ctx, addr))
 	}
-	return c.join(parts...)
+	return c.KEKLabel(ctx, c.join(parts...))
+}
+
+// KEKLabel returns the current version of the given KEK label.
+// If the given KEK label is versioned, the current version of the unversioned KEK label is returned.
+func (c ComponentPrefixKEKLabeler) KEKLabel(ctx context.Context, label string) string {
+	label, _ = SplitKEKLabelVersion(label)
+	return VersionedKEKLabel(label, c.Versions[label])
+}
+
+// KEKLabelVersions returns the versions of the given KEK label, from the current version to the unversioned KEK label.
+func (c ComponentPrefixKEKLabeler) KEKLabelVersions(ctx context.Context, label string) []string {
+	label, _ = SplitKEKLabelVersion(label)
+	current := c.Versions[label]
+	if current < 0 {
+		current = 0
+	}
+	versions := make([]string, 0, current+1)
+	for version := current; version >= 0; version-- {
+		versions = append(versions, VersionedKEKLabel(label, version))
+	}
+	return versions
 }
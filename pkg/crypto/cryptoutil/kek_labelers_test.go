@@ -118,3 +118,40 @@ func TestComponentPrefixKEKLabeler(t *testing.T) {
 		})
 	}
 }
+
+func TestComponentPrefixKEKLabelerVersions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	labeler := ComponentPrefixKEKLabeler{
+		Versions: map[string]int{
+			"kek":       2,
+			"ns/000042": 1,
+		},
+	}
+
+	a.So(labeler.KEKLabel(ctx, "other"), should.Equal, "other")
+	a.So(labeler.KEKLabel(ctx, "kek"), should.Equal, "kek@v2")
+	a.So(labeler.KEKLabel(ctx, "kek@v1"), should.Equal, "kek@v2")
+	a.So(labeler.KEKLabelVersions(ctx, "other"), should.Resemble, []string{"other"})
+	a.So(labeler.KEKLabelVersions(ctx, "kek@v1"), should.Resemble, []string{"kek@v2", "kek@v1", "kek"})
+	a.So(labeler.NsKEKLabel(ctx, &types.NetID{0x00, 0x00, 0x42}, ""), should.Equal, "ns/000042@v1")
+	a.So(labeler.AsKEKLabel(ctx, "localhost"), should.Equal, "as/localhost")
+
+	for _, tc := range []struct {
+		Versioned string
+		Label     string
+		Version   int
+	}{
+		{Versioned: "kek", Label: "kek"},
+		{Versioned: "kek@v1", Label: "kek", Version: 1},
+		{Versioned: "kek@v12", Label: "kek", Version: 12},
+		{Versioned: "kek@v", Label: "kek@v"},
+		{Versioned: "kek@v0", Label: "kek@v0"},
+		{Versioned: "kek@vx", Label: "kek@vx"},
+	} {
+		label, version := SplitKEKLabelVersion(tc.Versioned)
+		a.So(label, should.Equal, tc.Label)
+		a.So(version, should.Equal, tc.Version)
+	}
+}
@@ -50,12 +50,20 @@ func (v MemKeyVault) Wrap(ctx context.Context, plaintext []byte, kekLabel string
 }
 
 // Unwrap implements KeyVault.
-func (v MemKeyVault) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) ([]byte, error) {
-	kek, ok := v.m[kekLabel]
-	if !ok {
-		return nil, errKEKNotFound.WithAttributes("label", kekLabel)
-	}
-	return crypto.UnwrapKey(ciphertext, kek)
+func (v MemKeyVault) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) (plaintext []byte, err error) {
+	err = UnwrapKEKVersions(ctx, v, kekLabel, func(kekLabel string) error {
+		kek, ok := v.m[kekLabel]
+		if !ok {
+			return errKEKNotFound.WithAttributes("label", kekLabel)
+		}
+		res, err := crypto.UnwrapKey(ciphertext, kek)
+		if err != nil {
+			return err
+		}
+		plaintext = res
+		return nil
+	})
+	return
 }
 
 // GetCertificate implements KeyVault.
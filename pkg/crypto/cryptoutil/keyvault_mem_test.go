@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -104,3 +105,46 @@ TPERi9uMQjERns1qXG/9DJLe/Qxi0r84hA==
 		a.So(errors.IsNotFound(err), should.BeTrue)
 	}
 }
+
+func TestMemKeyVaultVersions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	plaintext, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	kek, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	kekV1, _ := hex.DecodeString("0F0E0D0C0B0A09080706050403020100")
+
+	v := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"kek":    kek,
+		"kek@v1": kekV1,
+	})
+	v.Versions = map[string]int{"kek": 1}
+
+	ciphertext, err := crypto.WrapKey(plaintext, kek)
+	a.So(err, should.BeNil)
+	ciphertextV1, err := crypto.WrapKey(plaintext, kekV1)
+	a.So(err, should.BeNil)
+
+	for _, tc := range []struct {
+		Ciphertext []byte
+		KEKLabel   string
+	}{
+		{Ciphertext: ciphertext, KEKLabel: "kek"},
+		{Ciphertext: ciphertext, KEKLabel: "kek@v1"},
+		{Ciphertext: ciphertextV1, KEKLabel: "kek"},
+		{Ciphertext: ciphertextV1, KEKLabel: "kek@v1"},
+	} {
+		actual, err := v.Unwrap(ctx, tc.Ciphertext, tc.KEKLabel)
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, plaintext)
+	}
+
+	// Unknown KEK.
+	{
+		other, _ := hex.DecodeString("00000000000000000000000000000000")
+		otherCiphertext, err := crypto.WrapKey(plaintext, other)
+		a.So(err, should.BeNil)
+		_, err = v.Unwrap(ctx, otherCiphertext, "kek@v1")
+		a.So(errors.IsDataLoss(err), should.BeTrue)
+	}
+}
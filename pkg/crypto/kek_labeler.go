@@ -24,4 +24,8 @@ import (
 type ComponentKEKLabeler interface {
 	NsKEKLabel(ctx context.Context, netID *types.NetID, addr string) string
 	AsKEKLabel(ctx context.Context, addr string) string
+	// KEKLabel returns the current version of the given KEK label.
+	KEKLabel(ctx context.Context, label string) string
+	// KEKLabelVersions returns the versions of the given KEK label, from the current version to the first version.
+	KEKLabelVersions(ctx context.Context, label string) []string
 }
@@ -98,3 +98,52 @@ func DeriveJSIntKey(key types.AES128Key, devEUI types.EUI64) types.AES128Key {
 func DeriveJSEncKey(key types.AES128Key, devEUI types.EUI64) types.AES128Key {
 	return deriveDeviceKey(key, 0x05, devEUI)
 }
+
+// deriveMulticastKey derives a multicast key
+func deriveMulticastKey(key types.AES128Key, t byte, addr types.DevAddr) (derived types.AES128Key) {
+	buf := make([]byte, 16)
+	buf[0] = t
+	copy(buf[1:5], reverse(addr[:]))
+	block, _ := aes.NewCipher(key[:])
+	block.Encrypt(derived[:], buf)
+	return
+}
+
+// DeriveMcRootKey derives the Remote Multicast Setup Root Key
+// - If a LoRaWAN 1.0 device is used, the GenAppKey is used as "key"
+// - If a LoRaWAN 1.1 device is used, the AppKey is used as "key"
+func DeriveMcRootKey(key types.AES128Key, legacy bool) (derived types.AES128Key) {
+	buf := make([]byte, 16)
+	if !legacy {
+		buf[0] = 0x20
+	}
+	block, _ := aes.NewCipher(key[:])
+	block.Encrypt(derived[:], buf)
+	return
+}
+
+// DeriveMcKEKey derives the Remote Multicast Setup Key Encryption Key
+func DeriveMcKEKey(mcRootKey types.AES128Key) (derived types.AES128Key) {
+	buf := make([]byte, 16)
+	block, _ := aes.NewCipher(mcRootKey[:])
+	block.Encrypt(derived[:], buf)
+	return
+}
+
+// EncryptMcKey encrypts the McKey of a multicast group with the McKEKey of an end device
+// - The McKey is encrypted with AES decryption, so that the end device recovers it with AES encryption
+func EncryptMcKey(mcKEKey, mcKey types.AES128Key) (encrypted types.AES128Key) {
+	block, _ := aes.NewCipher(mcKEKey[:])
+	block.Decrypt(encrypted[:], mcKey[:])
+	return
+}
+
+// DeriveMcAppSKey derives the Multicast Application Session Key
+func DeriveMcAppSKey(mcKey types.AES128Key, mcAddr types.DevAddr) types.AES128Key {
+	return deriveMulticastKey(mcKey, 0x01, mcAddr)
+}
+
+// DeriveMcNwkSKey derives the Multicast Network Session Key
+func DeriveMcNwkSKey(mcKey types.AES128Key, mcAddr types.DevAddr) types.AES128Key {
+	return deriveMulticastKey(mcKey, 0x02, mcAddr)
+}
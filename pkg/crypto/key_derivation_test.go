@@ -56,4 +56,23 @@ func TestKeyDerivation(t *testing.T) {
 
 	jsEncKey := DeriveJSEncKey(key, devEUI)
 	a.So(jsEncKey, should.Equal, types.AES128Key{0xBB, 0x71, 0x1E, 0xEF, 0xB9, 0x82, 0x9B, 0x4A, 0x75, 0x86, 0x6F, 0x86, 0x16, 0xBA, 0xCD, 0x6D})
+
+	mcRootKey := DeriveMcRootKey(key, false)
+	a.So(mcRootKey, should.Equal, types.AES128Key{0x68, 0xDA, 0x7B, 0x67, 0x98, 0x4F, 0x39, 0x4D, 0x0F, 0x53, 0xBE, 0xDE, 0xD1, 0x48, 0x40, 0x53})
+
+	mcRootKey = DeriveMcRootKey(key, true)
+	a.So(mcRootKey, should.Equal, types.AES128Key{0xC3, 0x0C, 0xCE, 0x7A, 0x52, 0x52, 0x6E, 0xEE, 0x58, 0x23, 0x79, 0xC2, 0x23, 0x08, 0xC6, 0x31})
+
+	mcKEKey := DeriveMcKEKey(mcRootKey)
+	a.So(mcKEKey, should.Equal, types.AES128Key{0x56, 0xF2, 0xC0, 0x4B, 0x44, 0x2E, 0x65, 0x11, 0x91, 0xE1, 0xB1, 0xC1, 0x3D, 0x8D, 0x9A, 0x2B})
+
+	mcKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}
+	a.So(EncryptMcKey(mcKEKey, mcKey), should.Equal, types.AES128Key{0x21, 0xC0, 0x9A, 0x8D, 0xC0, 0xE8, 0x53, 0xDE, 0x42, 0x13, 0x44, 0x8F, 0x29, 0x4F, 0xB8, 0xEA})
+
+	mcAddr := types.DevAddr{0x01, 0x02, 0x03, 0x04}
+	mcAppSKey := DeriveMcAppSKey(key, mcAddr)
+	a.So(mcAppSKey, should.Equal, types.AES128Key{0x07, 0xA6, 0x5B, 0x96, 0xA4, 0x81, 0xD0, 0x2F, 0x56, 0x93, 0x01, 0x01, 0x64, 0xEA, 0x97, 0x87})
+
+	mcNwkSKey := DeriveMcNwkSKey(key, mcAddr)
+	a.So(mcNwkSKey, should.Equal, types.AES128Key{0x7A, 0x92, 0x7A, 0x23, 0x14, 0x86, 0x55, 0xB0, 0xD5, 0x04, 0x64, 0x55, 0x18, 0xF4, 0x86, 0xA3})
 }
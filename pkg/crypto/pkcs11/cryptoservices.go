@@ -0,0 +1,273 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build cgo
+
+package pkcs11
+
+import (
+	"context"
+
+	"github.com/miekg/pkcs11"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var (
+	errNoNwkKey  = errors.DefineCorruption("no_nwk_key", "no NwkKey specified")
+	errNoAppKey  = errors.DefineCorruption("no_app_key", "no AppKey specified")
+	errNoDevEUI  = errors.DefineCorruption("no_dev_eui", "no DevEUI specified")
+	errNoJoinEUI = errors.DefineCorruption("no_join_eui", "no JoinEUI specified")
+
+	errInvalidPayloadSize = errors.DefineInvalidArgument("invalid_payload_size", "invalid payload size `{size}`")
+)
+
+func reverse(in []byte) []byte {
+	out := make([]byte, len(in))
+	for i, b := range in {
+		out[len(in)-1-i] = b
+	}
+	return out
+}
+
+// sessionKeyBlock returns the block that is encrypted with the root key to derive a LoRaWAN 1.1 session key.
+func sessionKeyBlock(t byte, jn types.JoinNonce, joinEUI types.EUI64, dn types.DevNonce) []byte {
+	buf := make([]byte, 16)
+	buf[0] = t
+	copy(buf[1:4], reverse(jn[:]))
+	copy(buf[4:12], reverse(joinEUI[:]))
+	copy(buf[12:14], reverse(dn[:]))
+	return buf
+}
+
+// legacySessionKeyBlock returns the block that is encrypted with the root key to derive a LoRaWAN 1.0 session key.
+func legacySessionKeyBlock(t byte, jn types.JoinNonce, nid types.NetID, dn types.DevNonce) []byte {
+	buf := make([]byte, 16)
+	buf[0] = t
+	copy(buf[1:4], reverse(jn[:]))
+	copy(buf[4:7], reverse(nid[:]))
+	copy(buf[7:9], reverse(dn[:]))
+	return buf
+}
+
+// deviceKeyBlock returns the block that is encrypted with the NwkKey to derive the JSIntKey and JSEncKey.
+func deviceKeyBlock(t byte, devEUI types.EUI64) []byte {
+	buf := make([]byte, 16)
+	buf[0] = t
+	copy(buf[1:9], reverse(devEUI[:]))
+	return buf
+}
+
+// rootKeys is a cryptoservices.NetworkApplication that uses wrapped root keys on the token.
+// The session keys and the JSIntKey and JSEncKey are derived on the token; the root keys never leave the token.
+type rootKeys struct {
+	hsm *hsm
+	nwkKey,
+	appKey *ttnpb.KeyEnvelope
+}
+
+func (k *rootKeys) getNwkKey(version ttnpb.MACVersion) (*ttnpb.KeyEnvelope, error) {
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		if k.nwkKey == nil {
+			return nil, errNoNwkKey
+		}
+		return k.nwkKey, nil
+	default:
+		if k.appKey == nil {
+			return nil, errNoAppKey
+		}
+		return k.appKey, nil
+	}
+}
+
+// deriveDeviceKey derives the JSIntKey or JSEncKey from the NwkKey on the token.
+func (k *rootKeys) deriveDeviceKey(ctx context.Context, t byte, devEUI types.EUI64) (key types.AES128Key, err error) {
+	if k.nwkKey == nil {
+		return types.AES128Key{}, errNoNwkKey
+	}
+	err = k.hsm.withRootKey(ctx, k.nwkKey, func(sh pkcs11.SessionHandle, nwkKey pkcs11.ObjectHandle) error {
+		derived, err := k.hsm.encrypt(sh, nwkKey, deviceKeyBlock(t, devEUI))
+		if err != nil {
+			return err
+		}
+		copy(key[:], derived)
+		return nil
+	})
+	return
+}
+
+// JoinRequestMIC implements cryptoservices.Network.
+func (k *rootKeys) JoinRequestMIC(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) (mic [4]byte, err error) {
+	if len(payload) != 19 {
+		return [4]byte{}, errInvalidPayloadSize.WithAttributes("size", len(payload))
+	}
+	env, err := k.getNwkKey(version)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	err = k.hsm.withRootKey(ctx, env, func(sh pkcs11.SessionHandle, key pkcs11.ObjectHandle) (err error) {
+		mic, err = k.hsm.mic(sh, key, payload)
+		return err
+	})
+	return
+}
+
+// JoinAcceptMIC implements cryptoservices.Network.
+func (k *rootKeys) JoinAcceptMIC(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, joinReqType byte, dn types.DevNonce, payload []byte) (mic [4]byte, err error) {
+	if dev.JoinEUI == nil {
+		return [4]byte{}, errNoJoinEUI
+	}
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return [4]byte{}, errNoDevEUI
+	}
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		jsIntKey, err := k.deriveDeviceKey(ctx, 0x06, *dev.DevEUI)
+		if err != nil {
+			return [4]byte{}, err
+		}
+		return crypto.ComputeJoinAcceptMIC(jsIntKey, joinReqType, *dev.JoinEUI, dn, payload)
+	default:
+		if n := len(payload); n != 13 && n != 29 {
+			return [4]byte{}, errInvalidPayloadSize.WithAttributes("size", len(payload))
+		}
+		env, err := k.getNwkKey(version)
+		if err != nil {
+			return [4]byte{}, err
+		}
+		err = k.hsm.withRootKey(ctx, env, func(sh pkcs11.SessionHandle, key pkcs11.ObjectHandle) (err error) {
+			mic, err = k.hsm.mic(sh, key, payload)
+			return err
+		})
+		return mic, err
+	}
+}
+
+// EncryptJoinAccept implements cryptoservices.Network.
+// The join-accept message is encrypted with AES decryption, so that the end device decrypts it with AES encryption.
+func (k *rootKeys) EncryptJoinAccept(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) (res []byte, err error) {
+	if n := len(payload); n != 16 && n != 32 {
+		return nil, errInvalidPayloadSize.WithAttributes("size", len(payload))
+	}
+	env, err := k.getNwkKey(version)
+	if err != nil {
+		return nil, err
+	}
+	err = k.hsm.withRootKey(ctx, env, func(sh pkcs11.SessionHandle, key pkcs11.ObjectHandle) (err error) {
+		res, err = k.hsm.decrypt(sh, key, payload)
+		return err
+	})
+	return
+}
+
+// EncryptRejoinAccept implements cryptoservices.Network.
+func (k *rootKeys) EncryptRejoinAccept(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) ([]byte, error) {
+	if version.Compare(ttnpb.MAC_V1_1) < 0 {
+		panic("This statement is unreachable. Please version check.")
+	}
+	if dev.JoinEUI == nil {
+		return nil, errNoJoinEUI
+	}
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return nil, errNoDevEUI
+	}
+	jsEncKey, err := k.deriveDeviceKey(ctx, 0x05, *dev.DevEUI)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.EncryptJoinAccept(jsEncKey, payload)
+}
+
+// DeriveNwkSKeys implements cryptoservices.Network.
+func (k *rootKeys) DeriveNwkSKeys(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, jn types.JoinNonce, dn types.DevNonce, nid types.NetID) (keys cryptoservices.NwkSKeys, err error) {
+	if dev.JoinEUI == nil {
+		return cryptoservices.NwkSKeys{}, errNoJoinEUI
+	}
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return cryptoservices.NwkSKeys{}, errNoDevEUI
+	}
+	env, err := k.getNwkKey(version)
+	if err != nil {
+		return cryptoservices.NwkSKeys{}, err
+	}
+	err = k.hsm.withRootKey(ctx, env, func(sh pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		switch {
+		case version.Compare(ttnpb.MAC_V1_1) >= 0:
+			blocks := make([]byte, 0, 48)
+			blocks = append(blocks, sessionKeyBlock(0x01, jn, *dev.JoinEUI, dn)...)
+			blocks = append(blocks, sessionKeyBlock(0x03, jn, *dev.JoinEUI, dn)...)
+			blocks = append(blocks, sessionKeyBlock(0x04, jn, *dev.JoinEUI, dn)...)
+			derived, err := k.hsm.encrypt(sh, key, blocks)
+			if err != nil {
+				return err
+			}
+			copy(keys.FNwkSIntKey[:], derived[0:16])
+			copy(keys.SNwkSIntKey[:], derived[16:32])
+			copy(keys.NwkSEncKey[:], derived[32:48])
+		default:
+			derived, err := k.hsm.encrypt(sh, key, legacySessionKeyBlock(0x01, jn, nid, dn))
+			if err != nil {
+				return err
+			}
+			copy(keys.FNwkSIntKey[:], derived)
+		}
+		return nil
+	})
+	return
+}
+
+// GetNwkKey implements cryptoservices.Network.
+// The NwkKey is not exposed, so this method returns nil, nil.
+func (k *rootKeys) GetNwkKey(ctx context.Context, dev *ttnpb.EndDevice) (*types.AES128Key, error) {
+	return nil, nil
+}
+
+// DeriveAppSKey implements cryptoservices.Application.
+func (k *rootKeys) DeriveAppSKey(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, jn types.JoinNonce, dn types.DevNonce, nid types.NetID) (appSKey types.AES128Key, err error) {
+	if dev.JoinEUI == nil {
+		return types.AES128Key{}, errNoJoinEUI
+	}
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return types.AES128Key{}, errNoDevEUI
+	}
+	if k.appKey == nil {
+		return types.AES128Key{}, errNoAppKey
+	}
+	var block []byte
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		block = sessionKeyBlock(0x02, jn, *dev.JoinEUI, dn)
+	default:
+		block = legacySessionKeyBlock(0x02, jn, nid, dn)
+	}
+	err = k.hsm.withRootKey(ctx, k.appKey, func(sh pkcs11.SessionHandle, key pkcs11.ObjectHandle) error {
+		derived, err := k.hsm.encrypt(sh, key, block)
+		if err != nil {
+			return err
+		}
+		copy(appSKey[:], derived)
+		return nil
+	})
+	return
+}
+
+// GetAppKey implements cryptoservices.Application.
+// The AppKey is not exposed, so this method returns nil, nil.
+func (k *rootKeys) GetAppKey(ctx context.Context, dev *ttnpb.EndDevice) (*types.AES128Key, error) {
+	return nil, nil
+}
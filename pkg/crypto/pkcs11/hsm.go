@@ -0,0 +1,336 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build cgo
+
+package pkcs11
+
+import (
+	"context"
+	"strings"
+
+	"github.com/miekg/pkcs11"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// operationError returns the error of a failed PKCS#11 operation.
+func operationError(operation string, err error) error {
+	return errOperation.WithCause(err).WithAttributes("operation", operation)
+}
+
+// hsm is a PKCS#11 token with a pool of logged in sessions.
+type hsm struct {
+	ctx      *pkcs11.Ctx
+	slot     uint
+	pin      string
+	sessions *sessionPool
+	labeler  cryptoutil.ComponentPrefixKEKLabeler
+	objects  objectCache
+}
+
+// errorCode returns the PKCS#11 return value of the error, if any.
+func errorCode(err error) (uint, bool) {
+	pErr, ok := errors.RootCause(err).(pkcs11.Error)
+	return uint(pErr), ok
+}
+
+// sessionInvalid returns whether the error indicates that the session is no longer valid.
+func sessionInvalid(err error) bool {
+	code, ok := errorCode(err)
+	return ok && (code == pkcs11.CKR_SESSION_HANDLE_INVALID || code == pkcs11.CKR_SESSION_CLOSED)
+}
+
+// objectHandleInvalid returns whether the error indicates that an object handle is no longer valid.
+func objectHandleInvalid(err error) bool {
+	code, ok := errorCode(err)
+	return ok && code == pkcs11.CKR_OBJECT_HANDLE_INVALID
+}
+
+// findSlot returns the slot of the token with the given label.
+func findSlot(p *pkcs11.Ctx, label string) (uint, error) {
+	slots, err := p.GetSlotList(true)
+	if err != nil {
+		return 0, operationError("C_GetSlotList", err)
+	}
+	for _, slot := range slots {
+		info, err := p.GetTokenInfo(slot)
+		if err != nil {
+			continue
+		}
+		if strings.TrimSpace(info.Label) == label {
+			return slot, nil
+		}
+	}
+	return 0, errTokenNotFound.WithAttributes("label", label)
+}
+
+// openHSM loads the PKCS#11 library, and opens and logs in a session with the configured token.
+// The other sessions of the pool are opened when they are first used.
+func openHSM(conf Config) (*hsm, error) {
+	if conf.Library == "" {
+		return nil, errNoLibrary
+	}
+	if conf.TokenLabel == "" {
+		return nil, errNoTokenLabel
+	}
+	p := pkcs11.New(conf.Library)
+	if p == nil {
+		return nil, errLoadLibrary.WithAttributes("library", conf.Library)
+	}
+	if err := p.Initialize(); err != nil {
+		p.Destroy()
+		return nil, operationError("C_Initialize", err)
+	}
+	slot, err := findSlot(p, conf.TokenLabel)
+	if err != nil {
+		p.Finalize()
+		p.Destroy()
+		return nil, err
+	}
+	n := conf.Sessions
+	if n <= 0 {
+		n = defaultSessions
+	}
+	h := &hsm{
+		ctx:     p,
+		slot:    slot,
+		pin:     conf.PIN,
+		labeler: conf.KEKLabeler,
+	}
+	h.sessions = newSessionPool(n, h.openSession, h.closeSession, sessionInvalid)
+	// Open a session to check that the token is available and that the PIN is correct.
+	if err := h.sessions.withSession(context.Background(), func(uint) error { return nil }); err != nil {
+		h.Close()
+		return nil, err
+	}
+	return h, nil
+}
+
+// openSession opens a session with the token and logs in.
+func (h *hsm) openSession() (uint, error) {
+	sh, err := h.ctx.OpenSession(h.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return 0, operationError("C_OpenSession", err)
+	}
+	// The login state is shared by all sessions with the token.
+	if err := h.ctx.Login(sh, pkcs11.CKU_USER, h.pin); err != nil {
+		if code, ok := errorCode(err); !ok || code != pkcs11.CKR_USER_ALREADY_LOGGED_IN {
+			h.ctx.CloseSession(sh)
+			return 0, operationError("C_Login", err)
+		}
+	}
+	return uint(sh), nil
+}
+
+// closeSession closes a session with the token.
+func (h *hsm) closeSession(sh uint) {
+	h.ctx.CloseSession(pkcs11.SessionHandle(sh))
+}
+
+// Close closes the sessions with the token and unloads the PKCS#11 library.
+func (h *hsm) Close() error {
+	err := h.ctx.CloseAllSessions(h.slot)
+	h.ctx.Finalize()
+	h.ctx.Destroy()
+	if err != nil {
+		return operationError("C_CloseAllSessions", err)
+	}
+	return nil
+}
+
+// withSession calls f with a session from the pool. Operations in one session are not concurrent.
+// If the operation fails because the session or an object handle is no longer valid, the cached object handles are
+// cleared and the operation is retried once with another session.
+func (h *hsm) withSession(ctx context.Context, f func(pkcs11.SessionHandle) error) (err error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		err = h.sessions.withSession(ctx, func(sh uint) error {
+			return f(pkcs11.SessionHandle(sh))
+		})
+		if err == nil || !sessionInvalid(err) && !objectHandleInvalid(err) {
+			return err
+		}
+		h.objects.clear()
+	}
+	return err
+}
+
+// findObject returns the handle of the token object with the given class and label.
+// This method returns false if the object is not found.
+func (h *hsm) findObject(sh pkcs11.SessionHandle, class uint, label string) (pkcs11.ObjectHandle, bool, error) {
+	if oh, ok := h.objects.get(class, label); ok {
+		return pkcs11.ObjectHandle(oh), true, nil
+	}
+	if err := h.ctx.FindObjectsInit(sh, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}); err != nil {
+		return 0, false, operationError("C_FindObjectsInit", err)
+	}
+	ohs, _, err := h.ctx.FindObjects(sh, 1)
+	if finalErr := h.ctx.FindObjectsFinal(sh); err == nil && finalErr != nil {
+		return 0, false, operationError("C_FindObjectsFinal", finalErr)
+	}
+	if err != nil {
+		return 0, false, operationError("C_FindObjects", err)
+	}
+	if len(ohs) == 0 {
+		return 0, false, nil
+	}
+	h.objects.set(class, label, uint(ohs[0]))
+	return ohs[0], true, nil
+}
+
+// getValue returns the CKA_VALUE attribute of the given object.
+func (h *hsm) getValue(sh pkcs11.SessionHandle, oh pkcs11.ObjectHandle) ([]byte, error) {
+	attrs, err := h.ctx.GetAttributeValue(sh, oh, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return nil, operationError("C_GetAttributeValue", err)
+	}
+	return attrs[0].Value, nil
+}
+
+// destroy destroys the given session object.
+func (h *hsm) destroy(sh pkcs11.SessionHandle, oh pkcs11.ObjectHandle) {
+	h.ctx.DestroyObject(sh, oh)
+}
+
+// sessionKeyTemplate returns the template of a secret session object.
+// Extractable keys can be read in the clear; keys that are not extractable can only be used on the token.
+func sessionKeyTemplate(keyType uint, extractable bool) []*pkcs11.Attribute {
+	return []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, !extractable),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, extractable),
+		pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, !extractable),
+		pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, !extractable),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, !extractable),
+	}
+}
+
+var keyWrapMechanism = []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_WRAP, nil)}
+
+// findKEK returns the handle of the KEK with the given label.
+func (h *hsm) findKEK(sh pkcs11.SessionHandle, kekLabel string) (pkcs11.ObjectHandle, error) {
+	kek, ok, err := h.findObject(sh, pkcs11.CKO_SECRET_KEY, kekLabel)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errKEKNotFound.WithAttributes("label", kekLabel)
+	}
+	return kek, nil
+}
+
+// unwrapSessionKey unwraps the ciphertext with the KEK with the given label into a session object.
+// If unwrapping fails, the other versions of the KEK label are tried.
+// The caller must destroy the returned object.
+func (h *hsm) unwrapSessionKey(ctx context.Context, sh pkcs11.SessionHandle, ciphertext []byte, kekLabel string, keyType uint, extractable bool) (oh pkcs11.ObjectHandle, err error) {
+	err = cryptoutil.UnwrapKEKVersions(ctx, h.labeler, kekLabel, func(kekLabel string) error {
+		kek, err := h.findKEK(sh, kekLabel)
+		if err != nil {
+			return err
+		}
+		res, err := h.ctx.UnwrapKey(sh, keyWrapMechanism, kek, ciphertext, sessionKeyTemplate(keyType, extractable))
+		if err != nil {
+			return operationError("C_UnwrapKey", err)
+		}
+		oh = res
+		return nil
+	})
+	return
+}
+
+// importSessionKey creates a session object with the given key value.
+// The caller must destroy the returned object.
+func (h *hsm) importSessionKey(sh pkcs11.SessionHandle, key []byte, keyType uint, extractable bool) (pkcs11.ObjectHandle, error) {
+	template := append(sessionKeyTemplate(keyType, extractable), pkcs11.NewAttribute(pkcs11.CKA_VALUE, key))
+	oh, err := h.ctx.CreateObject(sh, template)
+	if err != nil {
+		return 0, operationError("C_CreateObject", err)
+	}
+	return oh, nil
+}
+
+// withRootKey calls f with a session object of the AES root key in the given envelope.
+// The root key is unwrapped on the token and can not be extracted; the session object is destroyed when f returns.
+func (h *hsm) withRootKey(ctx context.Context, env *ttnpb.KeyEnvelope, f func(pkcs11.SessionHandle, pkcs11.ObjectHandle) error) error {
+	return h.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		var oh pkcs11.ObjectHandle
+		var err error
+		switch {
+		case env.Key != nil:
+			oh, err = h.importSessionKey(sh, env.Key[:], pkcs11.CKK_AES, false)
+		case env.KEKLabel == "":
+			if len(env.EncryptedKey) != 16 {
+				return errInvalidKeyLength.WithAttributes("length", len(env.EncryptedKey))
+			}
+			oh, err = h.importSessionKey(sh, env.EncryptedKey, pkcs11.CKK_AES, false)
+		default:
+			oh, err = h.unwrapSessionKey(ctx, sh, env.EncryptedKey, env.KEKLabel, pkcs11.CKK_AES, false)
+		}
+		if err != nil {
+			return err
+		}
+		defer h.destroy(sh, oh)
+		return f(sh, oh)
+	})
+}
+
+var aesECBMechanism = []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_ECB, nil)}
+
+// encrypt encrypts the blocks with AES in ECB mode with the given key.
+func (h *hsm) encrypt(sh pkcs11.SessionHandle, key pkcs11.ObjectHandle, blocks []byte) ([]byte, error) {
+	if err := h.ctx.EncryptInit(sh, aesECBMechanism, key); err != nil {
+		return nil, operationError("C_EncryptInit", err)
+	}
+	res, err := h.ctx.Encrypt(sh, blocks)
+	if err != nil {
+		return nil, operationError("C_Encrypt", err)
+	}
+	return res, nil
+}
+
+// decrypt decrypts the blocks with AES in ECB mode with the given key.
+func (h *hsm) decrypt(sh pkcs11.SessionHandle, key pkcs11.ObjectHandle, blocks []byte) ([]byte, error) {
+	if err := h.ctx.DecryptInit(sh, aesECBMechanism, key); err != nil {
+		return nil, operationError("C_DecryptInit", err)
+	}
+	res, err := h.ctx.Decrypt(sh, blocks)
+	if err != nil {
+		return nil, operationError("C_Decrypt", err)
+	}
+	return res, nil
+}
+
+var aesCMACMechanism = []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_CMAC, nil)}
+
+// mic computes the AES-CMAC of the data with the given key, and returns the first 4 bytes.
+func (h *hsm) mic(sh pkcs11.SessionHandle, key pkcs11.ObjectHandle, data []byte) ([4]byte, error) {
+	if err := h.ctx.SignInit(sh, aesCMACMechanism, key); err != nil {
+		return [4]byte{}, operationError("C_SignInit", err)
+	}
+	mac, err := h.ctx.Sign(sh, data)
+	if err != nil {
+		return [4]byte{}, operationError("C_Sign", err)
+	}
+	var mic [4]byte
+	copy(mic[:], mac)
+	return mic, nil
+}
@@ -0,0 +1,157 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build cgo
+
+package pkcs11
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/miekg/pkcs11"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// KeyVault is a crypto.KeyVault that uses a PKCS#11 token.
+// Keys are wrapped and unwrapped with the RFC 3394 AES key wrap algorithm on the token, so the KEKs never leave the
+// token. KeyVault also implements cryptoservices.KeyVault, so that the root keys of end devices never leave the
+// token either.
+type KeyVault struct {
+	cryptoutil.ComponentPrefixKEKLabeler
+	hsm *hsm
+}
+
+// NewKeyVault returns a new KeyVault that uses the configured PKCS#11 token.
+func NewKeyVault(conf Config) (crypto.KeyVault, error) {
+	h, err := openHSM(conf)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyVault{
+		ComponentPrefixKEKLabeler: conf.KEKLabeler,
+		hsm:                       h,
+	}, nil
+}
+
+// Close closes the sessions with the token.
+func (v *KeyVault) Close() error {
+	return v.hsm.Close()
+}
+
+// Wrap implements crypto.KeyVault.
+func (v *KeyVault) Wrap(ctx context.Context, plaintext []byte, kekLabel string) (res []byte, err error) {
+	err = v.hsm.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		kek, err := v.hsm.findKEK(sh, kekLabel)
+		if err != nil {
+			return err
+		}
+		key, err := v.hsm.importSessionKey(sh, plaintext, pkcs11.CKK_GENERIC_SECRET, true)
+		if err != nil {
+			return err
+		}
+		defer v.hsm.destroy(sh, key)
+		res, err = v.hsm.ctx.WrapKey(sh, keyWrapMechanism, kek, key)
+		if err != nil {
+			return operationError("C_WrapKey", err)
+		}
+		return nil
+	})
+	return
+}
+
+// Unwrap implements crypto.KeyVault.
+func (v *KeyVault) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) (res []byte, err error) {
+	err = v.hsm.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		key, err := v.hsm.unwrapSessionKey(ctx, sh, ciphertext, kekLabel, pkcs11.CKK_GENERIC_SECRET, true)
+		if err != nil {
+			return err
+		}
+		defer v.hsm.destroy(sh, key)
+		res, err = v.hsm.getValue(sh, key)
+		return err
+	})
+	return
+}
+
+// getCertificate returns the X.509 certificate object with the given label.
+func (v *KeyVault) getCertificate(sh pkcs11.SessionHandle, id string) (*x509.Certificate, error) {
+	oh, ok, err := v.hsm.findObject(sh, pkcs11.CKO_CERTIFICATE, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errCertificateNotFound.WithAttributes("id", id)
+	}
+	der, err := v.hsm.getValue(sh, oh)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+// GetCertificate implements crypto.KeyVault.
+func (v *KeyVault) GetCertificate(ctx context.Context, id string) (cert *x509.Certificate, err error) {
+	err = v.hsm.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		cert, err = v.getCertificate(sh, id)
+		return err
+	})
+	return
+}
+
+// ExportCertificate implements crypto.KeyVault.
+// The private key of the certificate does not leave the token; the returned private key signs on the token.
+func (v *KeyVault) ExportCertificate(ctx context.Context, id string) (*tls.Certificate, error) {
+	var cert *x509.Certificate
+	err := v.hsm.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		var err error
+		cert, err = v.getCertificate(sh, id)
+		if err != nil {
+			return err
+		}
+		_, ok, err := v.hsm.findObject(sh, pkcs11.CKO_PRIVATE_KEY, id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errPrivateKeyNotFound.WithAttributes("id", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	signer, err := newSigner(v.hsm, cert.PublicKey, id)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Certificate{
+		Certificate: [][]byte{cert.Raw},
+		PrivateKey:  signer,
+		Leaf:        cert,
+	}, nil
+}
+
+// NetworkApplication implements cryptoservices.KeyVault.
+func (v *KeyVault) NetworkApplication(ctx context.Context, nwkKey, appKey *ttnpb.KeyEnvelope) (cryptoservices.NetworkApplication, error) {
+	return &rootKeys{
+		hsm:    v.hsm,
+		nwkKey: nwkKey,
+		appKey: appKey,
+	}, nil
+}
@@ -0,0 +1,199 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build cgo
+
+package pkcs11
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/miekg/pkcs11"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// newTestKeyVault returns a KeyVault with the token configured in the environment, for example a SoftHSM token:
+//
+//   softhsm2-util --init-token --free --label test --pin 1234 --so-pin 1234
+//   PKCS11_LIBRARY=/usr/lib/softhsm/libsofthsm2.so PKCS11_TOKEN_LABEL=test PKCS11_PIN=1234 go test ./pkg/crypto/pkcs11
+func newTestKeyVault(t *testing.T) *KeyVault {
+	conf := Config{
+		Library:    os.Getenv("PKCS11_LIBRARY"),
+		TokenLabel: os.Getenv("PKCS11_TOKEN_LABEL"),
+		PIN:        os.Getenv("PKCS11_PIN"),
+		Sessions:   2,
+	}
+	if conf.Library == "" || conf.TokenLabel == "" {
+		t.Skip("Missing PKCS#11 token")
+	}
+	kv, err := NewKeyVault(conf)
+	if err != nil {
+		t.Fatalf("Failed to open PKCS#11 token: %v", err)
+	}
+	return kv.(*KeyVault)
+}
+
+// createKEK creates an AES KEK session object with the given label and value.
+func createKEK(t *testing.T, kv *KeyVault, label string, value []byte) {
+	err := kv.hsm.withSession(context.Background(), func(sh pkcs11.SessionHandle) error {
+		_, err := kv.hsm.ctx.CreateObject(sh, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			pkcs11.NewAttribute(pkcs11.CKA_VALUE, value),
+			pkcs11.NewAttribute(pkcs11.CKA_WRAP, true),
+			pkcs11.NewAttribute(pkcs11.CKA_UNWRAP, true),
+		})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Failed to create KEK: %v", err)
+	}
+}
+
+func TestKeyVault(t *testing.T) {
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	kv := newTestKeyVault(t)
+	defer kv.Close()
+
+	kek := bytes.Repeat([]byte{0x42}, 16)
+	createKEK(t, kv, "test-kek", kek)
+
+	plaintext := []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+	expected, err := crypto.WrapKey(plaintext, kek)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ciphertext, err := kv.Wrap(ctx, plaintext, "test-kek")
+	a.So(err, should.BeNil)
+	a.So(ciphertext, should.Resemble, expected)
+
+	res, err := kv.Unwrap(ctx, ciphertext, "test-kek")
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, plaintext)
+
+	// Recreate the KEK, so that the cached object handle is no longer valid.
+	err = kv.hsm.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		oh, _, err := kv.hsm.findObject(sh, pkcs11.CKO_SECRET_KEY, "test-kek")
+		if err != nil {
+			return err
+		}
+		return kv.hsm.ctx.DestroyObject(sh, oh)
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	createKEK(t, kv, "test-kek", kek)
+
+	res, err = kv.Unwrap(ctx, ciphertext, "test-kek")
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, plaintext)
+
+	_, err = kv.Wrap(ctx, plaintext, "unknown-kek")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = kv.GetCertificate(ctx, "unknown-certificate")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestNetworkApplication(t *testing.T) {
+	ctx := context.Background()
+
+	kv := newTestKeyVault(t)
+	defer kv.Close()
+
+	kek := bytes.Repeat([]byte{0x42}, 16)
+	createKEK(t, kv, "test-kek", kek)
+
+	nwkKey := types.AES128Key{0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1}
+	appKey := types.AES128Key{0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2}
+	wrappedNwkKey, err := crypto.WrapKey(nwkKey[:], kek)
+	if err != nil {
+		t.Fatalf("Failed to wrap NwkKey: %v", err)
+	}
+	wrappedAppKey, err := crypto.WrapKey(appKey[:], kek)
+	if err != nil {
+		t.Fatalf("Failed to wrap AppKey: %v", err)
+	}
+
+	mem := cryptoservices.NewMemory(&nwkKey, &appKey)
+	svc, err := kv.NetworkApplication(ctx,
+		&ttnpb.KeyEnvelope{EncryptedKey: wrappedNwkKey, KEKLabel: "test-kek"},
+		&ttnpb.KeyEnvelope{EncryptedKey: wrappedAppKey, KEKLabel: "test-kek"},
+	)
+	if err != nil {
+		t.Fatalf("Failed to create crypto service: %v", err)
+	}
+
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		},
+	}
+	jn := types.JoinNonce{0x1, 0x2, 0x3}
+	dn := types.DevNonce{0x1, 0x2}
+	nid := types.NetID{0x1, 0x2, 0x3}
+
+	for _, version := range []ttnpb.MACVersion{
+		ttnpb.MAC_V1_0,
+		ttnpb.MAC_V1_0_2,
+		ttnpb.MAC_V1_1,
+	} {
+		t.Run(version.String(), func(t *testing.T) {
+			a := assertions.New(t)
+
+			joinRequest := bytes.Repeat([]byte{0x1}, 19)
+			expectedMIC, _ := mem.JoinRequestMIC(ctx, dev, version, joinRequest)
+			mic, err := svc.JoinRequestMIC(ctx, dev, version, joinRequest)
+			a.So(err, should.BeNil)
+			a.So(mic, should.Equal, expectedMIC)
+
+			joinAccept := bytes.Repeat([]byte{0x1}, 13)
+			expectedMIC, _ = mem.JoinAcceptMIC(ctx, dev, version, 0xff, dn, joinAccept)
+			mic, err = svc.JoinAcceptMIC(ctx, dev, version, 0xff, dn, joinAccept)
+			a.So(err, should.BeNil)
+			a.So(mic, should.Equal, expectedMIC)
+
+			joinAccept = bytes.Repeat([]byte{0x1}, 16)
+			expectedPayload, _ := mem.EncryptJoinAccept(ctx, dev, version, joinAccept)
+			payload, err := svc.EncryptJoinAccept(ctx, dev, version, joinAccept)
+			a.So(err, should.BeNil)
+			a.So(payload, should.Resemble, expectedPayload)
+
+			expectedNwkSKeys, _ := mem.DeriveNwkSKeys(ctx, dev, version, jn, dn, nid)
+			nwkSKeys, err := svc.DeriveNwkSKeys(ctx, dev, version, jn, dn, nid)
+			a.So(err, should.BeNil)
+			a.So(nwkSKeys, should.Resemble, expectedNwkSKeys)
+
+			expectedAppSKey, _ := mem.DeriveAppSKey(ctx, dev, version, jn, dn, nid)
+			appSKey, err := svc.DeriveAppSKey(ctx, dev, version, jn, dn, nid)
+			a.So(err, should.BeNil)
+			a.So(appSKey, should.Resemble, expectedAppSKey)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pkcs11 implements a crypto.KeyVault backed by a PKCS#11 token, such as a hardware security module (HSM).
+//
+// KEKs are AES keys on the token that are looked up by their label and that allow wrapping and unwrapping.
+// Certificates are looked up by their label, and the private keys of certificates by the same label. Private keys do
+// not leave the token; signatures are created on the token.
+//
+// This package requires cgo. Without cgo, creating a key vault returns an error.
+package pkcs11
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// Config is the configuration of a PKCS#11 key vault.
+type Config struct {
+	// Library is the path to the PKCS#11 library of the token vendor.
+	Library string
+	// TokenLabel is the label of the token to use.
+	TokenLabel string
+	// PIN is the user PIN of the token.
+	PIN string
+	// Sessions is the number of concurrent sessions with the token.
+	Sessions int
+	// KEKLabeler determines the KEK labels of components and the versions of KEK labels.
+	KEKLabeler cryptoutil.ComponentPrefixKEKLabeler
+}
+
+// defaultSessions is used when no number of concurrent sessions is configured.
+const defaultSessions = 8
+
+var (
+	errNoLibrary     = errors.DefineInvalidArgument("no_library", "no PKCS#11 library specified")
+	errNoTokenLabel  = errors.DefineInvalidArgument("no_token_label", "no PKCS#11 token label specified")
+	errNotAvailable  = errors.DefineUnimplemented("not_available", "PKCS#11 is not available in this build")
+	errLoadLibrary   = errors.DefineFailedPrecondition("load_library", "failed to load PKCS#11 library `{library}`")
+	errTokenNotFound = errors.DefineNotFound("token_not_found", "PKCS#11 token with label `{label}` not found")
+	errOperation     = errors.Define("operation", "PKCS#11 operation `{operation}` failed")
+
+	errKEKNotFound         = errors.DefineNotFound("kek_not_found", "KEK with label `{label}` not found")
+	errCertificateNotFound = errors.DefineNotFound("certificate_not_found", "certificate with ID `{id}` not found")
+	errPrivateKeyNotFound  = errors.DefineNotFound("private_key_not_found", "private key with ID `{id}` not found")
+	errInvalidKeyLength    = errors.DefineInvalidArgument("invalid_key_length", "invalid key length `{length}`")
+)
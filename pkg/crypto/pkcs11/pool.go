@@ -0,0 +1,114 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkcs11
+
+import (
+	"context"
+	"sync"
+)
+
+// session is a slot in the session pool. Slots without an open session are opened when they are used.
+type session struct {
+	handle uint
+	open   bool
+}
+
+// sessionPool is a pool of sessions with a token.
+// Sessions are opened when they are first used. Sessions that become invalid are closed, and are opened again when
+// they are used next.
+type sessionPool struct {
+	slots          chan session
+	openSession    func() (uint, error)
+	closeSession   func(uint)
+	sessionInvalid func(error) bool
+}
+
+// newSessionPool returns a pool of n sessions. A session is closed when an operation in the session fails with an
+// error for which sessionInvalid returns true.
+func newSessionPool(n int, openSession func() (uint, error), closeSession func(uint), sessionInvalid func(error) bool) *sessionPool {
+	p := &sessionPool{
+		slots:          make(chan session, n),
+		openSession:    openSession,
+		closeSession:   closeSession,
+		sessionInvalid: sessionInvalid,
+	}
+	for i := 0; i < n; i++ {
+		p.slots <- session{}
+	}
+	return p
+}
+
+// withSession calls f with a session from the pool. Operations in one session are not concurrent.
+func (p *sessionPool) withSession(ctx context.Context, f func(uint) error) error {
+	var s session
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case s = <-p.slots:
+	}
+	defer func() { p.slots <- s }()
+	if !s.open {
+		handle, err := p.openSession()
+		if err != nil {
+			return err
+		}
+		s = session{handle: handle, open: true}
+	}
+	err := f(s.handle)
+	if err != nil && p.sessionInvalid(err) {
+		p.closeSession(s.handle)
+		s = session{}
+	}
+	return err
+}
+
+// objectKey identifies a token object by its class and label.
+// Objects of different classes can have the same label, such as a certificate and its private key.
+type objectKey struct {
+	class uint
+	label string
+}
+
+// objectCache caches the handles of token objects.
+type objectCache struct {
+	mu      sync.RWMutex
+	handles map[objectKey]uint
+}
+
+// get returns the cached handle of the object with the given class and label.
+func (c *objectCache) get(class uint, label string) (uint, bool) {
+	c.mu.RLock()
+	handle, ok := c.handles[objectKey{class: class, label: label}]
+	c.mu.RUnlock()
+	return handle, ok
+}
+
+// set caches the handle of the object with the given class and label.
+func (c *objectCache) set(class uint, label string, handle uint) {
+	c.mu.Lock()
+	if c.handles == nil {
+		c.handles = make(map[objectKey]uint)
+	}
+	c.handles[objectKey{class: class, label: label}] = handle
+	c.mu.Unlock()
+}
+
+// clear removes all cached handles. Handles are cleared when the token reports an invalid handle, for example when
+// objects are recreated or the token is reset.
+func (c *objectCache) clear() {
+	c.mu.Lock()
+	c.handles = nil
+	c.mu.Unlock()
+}
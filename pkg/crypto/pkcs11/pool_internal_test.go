@@ -0,0 +1,167 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkcs11
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var (
+	errTestSessionInvalid = errors.New("session invalid")
+	errTestOperation      = errors.New("operation failed")
+	errTestOpen           = errors.New("open failed")
+)
+
+func TestSessionPool(t *testing.T) {
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	var next uint
+	var openErr error
+	var closed []uint
+	pool := newSessionPool(2,
+		func() (uint, error) {
+			if openErr != nil {
+				return 0, openErr
+			}
+			next++
+			return next, nil
+		},
+		func(sh uint) { closed = append(closed, sh) },
+		func(err error) bool { return err == errTestSessionInvalid },
+	)
+
+	// Sessions are opened when they are first used.
+	a.So(next, should.Equal, 0)
+
+	var used []uint
+	use := func(err error) error {
+		return pool.withSession(ctx, func(sh uint) error {
+			used = append(used, sh)
+			return err
+		})
+	}
+
+	a.So(use(nil), should.BeNil)
+	a.So(use(nil), should.BeNil)
+	a.So(use(nil), should.BeNil)
+	a.So(used, should.Resemble, []uint{1, 2, 1})
+	a.So(next, should.Equal, 2)
+
+	// Other errors keep the session open.
+	a.So(use(errTestOperation), should.Equal, errTestOperation)
+	a.So(closed, should.BeEmpty)
+
+	// Invalid sessions are closed, and a new session is opened when the slot is used next.
+	used = nil
+	a.So(use(errTestSessionInvalid), should.Equal, errTestSessionInvalid)
+	a.So(closed, should.Resemble, []uint{1})
+	a.So(use(nil), should.BeNil)
+	a.So(use(nil), should.BeNil)
+	a.So(used, should.Resemble, []uint{1, 2, 3})
+
+	// When opening a session fails, the slot stays in the pool and opening is tried again.
+	a.So(use(errTestSessionInvalid), should.Equal, errTestSessionInvalid)
+	a.So(closed, should.Resemble, []uint{1, 2})
+	openErr = errTestOpen
+	a.So(use(nil), should.BeNil)
+	a.So(use(nil), should.Equal, errTestOpen)
+	a.So(use(nil), should.BeNil)
+	a.So(use(nil), should.Equal, errTestOpen)
+	openErr = nil
+	used = nil
+	a.So(use(nil), should.BeNil)
+	a.So(use(nil), should.BeNil)
+	a.So(used, should.Resemble, []uint{3, 4})
+}
+
+func TestSessionPoolContext(t *testing.T) {
+	a := assertions.New(t)
+
+	pool := newSessionPool(1,
+		func() (uint, error) { return 1, nil },
+		func(uint) {},
+		func(error) bool { return false },
+	)
+
+	inUse, release := make(chan struct{}), make(chan struct{})
+	go pool.withSession(context.Background(), func(uint) error {
+		close(inUse)
+		<-release
+		return nil
+	})
+	<-inUse
+
+	// All sessions are in use.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := pool.withSession(ctx, func(uint) error {
+		t.Fatal("Session must not be used")
+		return nil
+	})
+	a.So(err, should.Resemble, context.DeadlineExceeded)
+
+	close(release)
+	a.So(pool.withSession(context.Background(), func(uint) error { return nil }), should.BeNil)
+}
+
+func TestObjectCache(t *testing.T) {
+	a := assertions.New(t)
+
+	const (
+		classCertificate uint = 1
+		classPrivateKey  uint = 3
+	)
+
+	var c objectCache
+	_, ok := c.get(classCertificate, "foo")
+	a.So(ok, should.BeFalse)
+
+	// Objects of different classes with the same label have different handles.
+	c.set(classCertificate, "foo", 42)
+	c.set(classPrivateKey, "foo", 43)
+	c.set(classCertificate, "bar", 44)
+
+	for _, tc := range []struct {
+		class  uint
+		label  string
+		handle uint
+	}{
+		{classCertificate, "foo", 42},
+		{classPrivateKey, "foo", 43},
+		{classCertificate, "bar", 44},
+	} {
+		handle, ok := c.get(tc.class, tc.label)
+		a.So(ok, should.BeTrue)
+		a.So(handle, should.Equal, tc.handle)
+	}
+	_, ok = c.get(classPrivateKey, "bar")
+	a.So(ok, should.BeFalse)
+
+	c.clear()
+	_, ok = c.get(classCertificate, "foo")
+	a.So(ok, should.BeFalse)
+
+	c.set(classCertificate, "foo", 45)
+	handle, ok := c.get(classCertificate, "foo")
+	a.So(ok, should.BeTrue)
+	a.So(handle, should.Equal, 45)
+}
@@ -0,0 +1,139 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build cgo
+
+package pkcs11
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/miekg/pkcs11"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+var (
+	errUnsupportedKeyType = errors.DefineInvalidArgument("unsupported_key_type", "unsupported key type `{type}`")
+	errUnsupportedHash    = errors.DefineInvalidArgument("unsupported_hash", "unsupported hash function `{hash}`")
+)
+
+// signer is a crypto.Signer that signs with a private key on the token.
+// The private key is looked up by its label, so that the signer keeps working when the object handle changes.
+type signer struct {
+	hsm    *hsm
+	public crypto.PublicKey
+	label  string
+}
+
+func newSigner(h *hsm, public crypto.PublicKey, label string) (*signer, error) {
+	switch public.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+	default:
+		return nil, errUnsupportedKeyType.WithAttributes("type", fmt.Sprintf("%T", public))
+	}
+	return &signer{
+		hsm:    h,
+		public: public,
+		label:  label,
+	}, nil
+}
+
+// Public implements crypto.Signer.
+func (s *signer) Public() crypto.PublicKey {
+	return s.public
+}
+
+// pkcs1Prefixes are the ASN.1 DER prefixes of the DigestInfo structures of PKCS #1 v1.5 signatures.
+var pkcs1Prefixes = map[crypto.Hash][]byte{
+	crypto.SHA1:   {0x30, 0x21, 0x30, 0x09, 0x06, 0x05, 0x2b, 0x0e, 0x03, 0x02, 0x1a, 0x05, 0x00, 0x04, 0x14},
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+// pssHashes are the PKCS#11 hash mechanisms and mask generation functions of RSA-PSS signatures.
+var pssHashes = map[crypto.Hash][2]uint{
+	crypto.SHA1:   {pkcs11.CKM_SHA_1, pkcs11.CKG_MGF1_SHA1},
+	crypto.SHA256: {pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256},
+	crypto.SHA384: {pkcs11.CKM_SHA384, pkcs11.CKG_MGF1_SHA384},
+	crypto.SHA512: {pkcs11.CKM_SHA512, pkcs11.CKG_MGF1_SHA512},
+}
+
+// Sign implements crypto.Signer.
+func (s *signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) (signature []byte, err error) {
+	var mechanism *pkcs11.Mechanism
+	data := digest
+	switch s.public.(type) {
+	case *ecdsa.PublicKey:
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	case *rsa.PublicKey:
+		hash := opts.HashFunc()
+		if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+			params, ok := pssHashes[hash]
+			if !ok {
+				return nil, errUnsupportedHash.WithAttributes("hash", hash.String())
+			}
+			saltLength := pssOpts.SaltLength
+			if saltLength == rsa.PSSSaltLengthAuto || saltLength == rsa.PSSSaltLengthEqualsHash {
+				saltLength = hash.Size()
+			}
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_PSS, pkcs11.NewPSSParams(params[0], params[1], uint(saltLength)))
+		} else {
+			prefix, ok := pkcs1Prefixes[hash]
+			if !ok {
+				return nil, errUnsupportedHash.WithAttributes("hash", hash.String())
+			}
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)
+			data = append(append(make([]byte, 0, len(prefix)+len(digest)), prefix...), digest...)
+		}
+	}
+	err = s.hsm.withSession(context.Background(), func(sh pkcs11.SessionHandle) error {
+		key, ok, err := s.hsm.findObject(sh, pkcs11.CKO_PRIVATE_KEY, s.label)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errPrivateKeyNotFound.WithAttributes("id", s.label)
+		}
+		if err := s.hsm.ctx.SignInit(sh, []*pkcs11.Mechanism{mechanism}, key); err != nil {
+			return operationError("C_SignInit", err)
+		}
+		signature, err = s.hsm.ctx.Sign(sh, data)
+		if err != nil {
+			return operationError("C_Sign", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.public.(*ecdsa.PublicKey); ok {
+		// PKCS#11 returns the concatenation of r and s, while Go expects the ASN.1 encoding.
+		n := len(signature) / 2
+		return asn1.Marshal(struct {
+			R, S *big.Int
+		}{
+			R: new(big.Int).SetBytes(signature[:n]),
+			S: new(big.Int).SetBytes(signature[n:]),
+		})
+	}
+	return signature, nil
+}
@@ -0,0 +1,508 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/qrcode"
+	"go.thethings.network/lorawan-stack/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/discover"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	evtClaimEndDeviceSuccess = events.Define(
+		"dcs.end_device.claim.success", "claim end device successful",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+	evtClaimEndDeviceAbort = events.Define(
+		"dcs.end_device.claim.abort", "abort claiming end device",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+)
+
+var (
+	errParseQRCode                = errors.Define("parse_qr_code", "parse QR code failed")
+	errQRCodeData                 = errors.DefineInvalidArgument("qr_code_data", "invalid QR code data")
+	errApplicationNotAuthorized   = errors.DefinePermissionDenied("application_not_authorized", "application `{application_uid}` not authorized for claiming")
+	errAuthenticationCode         = errors.DefinePermissionDenied("authentication_code", "invalid claim authentication code")
+	errAuthenticationCodeValidity = errors.DefinePermissionDenied("authentication_code_validity", "claim authentication code not valid at this time")
+	errNoJoinServer               = errors.DefineFailedPrecondition("no_join_server", "end device is not registered on a Join Server")
+	errClaimSameApplication       = errors.DefineFailedPrecondition("claim_same_application", "end device is already in application `{application_uid}`")
+)
+
+// rollbackTimeout is the timeout for rolling back a failed claim.
+const rollbackTimeout = 10 * time.Second
+
+var (
+	// isTransferPaths are the Identity Server end device paths that are transferred to the target end device.
+	isTransferPaths = []string{
+		"attributes",
+		"description",
+		"locations",
+		"name",
+		"picture",
+		"service_profile_id",
+		"version_ids",
+	}
+	// jsTransferPaths are the Join Server end device paths that are transferred to the target end device.
+	jsTransferPaths = []string{
+		"application_server_id",
+		"application_server_kek_label",
+		"claim_authentication_code",
+		"last_dev_nonce",
+		"last_join_nonce",
+		"last_rj_count_0",
+		"last_rj_count_1",
+		"net_id",
+		"network_server_kek_label",
+		"provisioner_id",
+		"provisioning_data",
+		"resets_join_nonces",
+		"root_keys.app_key.key",
+		"root_keys.nwk_key.key",
+		"root_keys.root_key_id",
+		"used_dev_nonces",
+	}
+	// nsTransferPaths are the Network Server end device paths that are transferred to the target end device.
+	// The session and MAC state are not transferred; the end device has to rejoin.
+	nsTransferPaths = []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"mac_settings",
+		"max_frequency",
+		"min_frequency",
+		"supports_class_b",
+		"supports_class_c",
+		"supports_join",
+	}
+	// asTransferPaths are the Application Server end device paths that are transferred to the target end device.
+	// The session is not transferred; the end device has to rejoin.
+	asTransferPaths = []string{
+		"formatters",
+		"skip_payload_crypto",
+	}
+	// endDeviceIdentifiersPaths are the end device identifier paths that are set on creation.
+	endDeviceIdentifiersPaths = []string{
+		"ids.application_ids",
+		"ids.dev_eui",
+		"ids.device_id",
+		"ids.join_eui",
+	}
+)
+
+func (dcs *DeviceClaimingServer) withAPIKey(key string) grpc.CallOption {
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     key,
+		AllowInsecure: dcs.AllowInsecureForCredentials(),
+	})
+}
+
+// dialTarget dials the target server at the given address.
+func (dcs *DeviceClaimingServer) dialTarget(ctx context.Context, address string) (*grpc.ClientConn, error) {
+	tlsConfig, err := dcs.GetTLSClientConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = discover.WithTLSFallback(ctx, dcs.ClusterTLS())
+	return discover.DialContext(ctx, address, credentials.NewTLS(tlsConfig), rpcclient.DefaultDialOptions(ctx)...)
+}
+
+// sourceDevice returns the authenticated identifiers of the source end device in the claim request.
+func sourceDevice(req *ttnpb.ClaimEndDeviceRequest) (joinEUI, devEUI types.EUI64, authCode string, err error) {
+	switch source := req.SourceDevice.(type) {
+	case *ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_:
+		ids := source.AuthenticatedIdentifiers
+		return ids.JoinEUI, ids.DevEUI, ids.AuthenticationCode, nil
+	case *ttnpb.ClaimEndDeviceRequest_QRCode:
+		data, err := qrcode.Parse(source.QRCode)
+		if err != nil {
+			return types.EUI64{}, types.EUI64{}, "", errParseQRCode.WithCause(err)
+		}
+		authIDs, ok := data.(qrcode.AuthenticatedEndDeviceIdentifiers)
+		if !ok {
+			return types.EUI64{}, types.EUI64{}, "", errQRCodeData
+		}
+		joinEUI, devEUI, authCode = authIDs.AuthenticatedEndDeviceIdentifiers()
+		return joinEUI, devEUI, authCode, nil
+	default:
+		panic("unreachable")
+	}
+}
+
+// validateAuthenticationCode validates the given value against the claim authentication code at the given time.
+func validateAuthenticationCode(code *ttnpb.EndDeviceAuthenticationCode, value string, at time.Time) error {
+	if code == nil || code.Value == "" || subtle.ConstantTimeCompare([]byte(code.Value), []byte(value)) != 1 {
+		return errAuthenticationCode
+	}
+	if code.ValidFrom != nil && at.Before(*code.ValidFrom) ||
+		code.ValidTo != nil && at.After(*code.ValidTo) {
+		return errAuthenticationCodeValidity
+	}
+	return nil
+}
+
+func (dcs *DeviceClaimingServer) claim(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest) (_ *ttnpb.EndDeviceIdentifiers, err error) {
+	joinEUI, devEUI, authCode, err := sourceDevice(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := rights.RequireApplication(ctx, req.TargetApplicationIDs,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	targetAuth, err := rpcmetadata.WithForwardedAuth(ctx, dcs.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
+		"target_application_uid", unique.ID(ctx, req.TargetApplicationIDs),
+	))
+	ctx = log.NewContext(ctx, logger)
+
+	isConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	isClient := ttnpb.NewEndDeviceRegistryClient(isConn)
+	sourceIDs, err := isClient.GetIdentifiersForEUIs(ctx, &ttnpb.GetEndDeviceIdentifiersForEUIsRequest{
+		JoinEUI: joinEUI,
+		DevEUI:  devEUI,
+	}, dcs.WithClusterAuth())
+	if err != nil {
+		return nil, err
+	}
+	if sourceIDs.ApplicationID == req.TargetApplicationIDs.ApplicationID {
+		return nil, errClaimSameApplication.WithAttributes("application_uid", unique.ID(ctx, req.TargetApplicationIDs))
+	}
+	logger = logger.WithFields(log.Fields(
+		"source_application_uid", unique.ID(ctx, sourceIDs.ApplicationIdentifiers),
+		"source_device_id", sourceIDs.DeviceID,
+	))
+	ctx = log.NewContext(ctx, logger)
+
+	authorization, err := dcs.authorizedApplications.Get(ctx, sourceIDs.ApplicationIdentifiers)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errApplicationNotAuthorized.WithAttributes("application_uid", unique.ID(ctx, sourceIDs.ApplicationIdentifiers))
+		}
+		return nil, err
+	}
+	sourceAuth := dcs.withAPIKey(authorization.APIKey)
+
+	isDev, err := isClient.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: *sourceIDs,
+		FieldMask: pbtypes.FieldMask{
+			Paths: append([]string{
+				"application_server_address",
+				"join_server_address",
+				"network_server_address",
+			}, isTransferPaths...),
+		},
+	}, sourceAuth)
+	if err != nil {
+		return nil, err
+	}
+	if isDev.JoinServerAddress == "" {
+		return nil, errNoJoinServer
+	}
+
+	jsConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, *sourceIDs)
+	if err != nil {
+		return nil, err
+	}
+	jsClient := ttnpb.NewJsEndDeviceRegistryClient(jsConn)
+	jsDev, err := jsClient.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: *sourceIDs,
+		FieldMask: pbtypes.FieldMask{
+			Paths: append([]string{
+				"application_server_address",
+				"network_server_address",
+			}, jsTransferPaths...),
+		},
+	}, sourceAuth)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAuthenticationCode(jsDev.ClaimAuthenticationCode, authCode, time.Now()); err != nil {
+		return nil, err
+	}
+
+	var nsClient ttnpb.NsEndDeviceRegistryClient
+	var nsDev *ttnpb.EndDevice
+	if isDev.NetworkServerAddress != "" {
+		nsConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, *sourceIDs)
+		if err != nil {
+			return nil, err
+		}
+		nsClient = ttnpb.NewNsEndDeviceRegistryClient(nsConn)
+		nsDev, err = nsClient.Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: *sourceIDs,
+			FieldMask: pbtypes.FieldMask{
+				Paths: nsTransferPaths,
+			},
+		}, sourceAuth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var asClient ttnpb.AsEndDeviceRegistryClient
+	var asDev *ttnpb.EndDevice
+	if isDev.ApplicationServerAddress != "" {
+		asConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, *sourceIDs)
+		if err != nil {
+			return nil, err
+		}
+		asClient = ttnpb.NewAsEndDeviceRegistryClient(asConn)
+		asDev, err = asClient.Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: *sourceIDs,
+			FieldMask: pbtypes.FieldMask{
+				Paths: asTransferPaths,
+			},
+		}, sourceAuth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The end device is created on the target Network Server and Application Server only when their addresses are given.
+	var targetNSClient ttnpb.NsEndDeviceRegistryClient
+	if nsDev != nil && req.TargetNetworkServerAddress != "" {
+		conn, err := dcs.dialTarget(ctx, req.TargetNetworkServerAddress)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		targetNSClient = ttnpb.NewNsEndDeviceRegistryClient(conn)
+	}
+	var targetASClient ttnpb.AsEndDeviceRegistryClient
+	if asDev != nil && req.TargetApplicationServerAddress != "" {
+		conn, err := dcs.dialTarget(ctx, req.TargetApplicationServerAddress)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+		targetASClient = ttnpb.NewAsEndDeviceRegistryClient(conn)
+	}
+
+	targetIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: req.TargetApplicationIDs,
+		DeviceID:               req.TargetDeviceID,
+		JoinEUI:                &joinEUI,
+		DevEUI:                 &devEUI,
+	}
+	if targetIDs.DeviceID == "" {
+		targetIDs.DeviceID = sourceIDs.DeviceID
+	}
+
+	// The end device is deleted from the source application and created in the target application in steps.
+	// When a step fails, the completed steps are rolled back in reverse order.
+	var rollbacks []func(context.Context) error
+	defer func() {
+		if err == nil {
+			return
+		}
+		logger.WithError(err).Warn("Claim failed, roll back")
+		events.Publish(evtClaimEndDeviceAbort(ctx, sourceIDs, err))
+		ctx, cancel := context.WithTimeout(log.NewContext(dcs.Context(), logger), rollbackTimeout)
+		defer cancel()
+		for i := len(rollbacks) - 1; i >= 0; i-- {
+			if err := rollbacks[i](ctx); err != nil {
+				logger.WithError(err).Error("Failed to roll back claim")
+			}
+		}
+	}()
+
+	if asDev != nil {
+		if _, err := asClient.Delete(ctx, sourceIDs, sourceAuth); err != nil {
+			return nil, err
+		}
+		rollbacks = append(rollbacks, func(ctx context.Context) error {
+			_, err := asClient.Set(ctx, &ttnpb.SetEndDeviceRequest{
+				EndDevice: *asDev,
+				FieldMask: pbtypes.FieldMask{
+					Paths: asTransferPaths,
+				},
+			}, sourceAuth)
+			return err
+		})
+	}
+	if nsDev != nil {
+		if _, err := nsClient.Delete(ctx, sourceIDs, sourceAuth); err != nil {
+			return nil, err
+		}
+		rollbacks = append(rollbacks, func(ctx context.Context) error {
+			_, err := nsClient.Set(ctx, &ttnpb.SetEndDeviceRequest{
+				EndDevice: *nsDev,
+				FieldMask: pbtypes.FieldMask{
+					Paths: nsTransferPaths,
+				},
+			}, sourceAuth)
+			return err
+		})
+	}
+	if _, err := jsClient.Delete(ctx, sourceIDs, sourceAuth); err != nil {
+		return nil, err
+	}
+	rollbacks = append(rollbacks, func(ctx context.Context) error {
+		_, err := jsClient.Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: *jsDev,
+			FieldMask: pbtypes.FieldMask{
+				Paths: append(append([]string{
+					"application_server_address",
+					"network_server_address",
+				}, endDeviceIdentifiersPaths...), jsTransferPaths...),
+			},
+		}, sourceAuth)
+		return err
+	})
+	if _, err := isClient.Delete(ctx, sourceIDs, sourceAuth); err != nil {
+		return nil, err
+	}
+	rollbacks = append(rollbacks, func(ctx context.Context) error {
+		_, err := isClient.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+			EndDevice: *isDev,
+		}, sourceAuth)
+		return err
+	})
+
+	targetISDev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: targetIDs,
+		JoinServerAddress:    isDev.JoinServerAddress,
+	}
+	if err := targetISDev.SetFields(isDev, isTransferPaths...); err != nil {
+		return nil, err
+	}
+	if targetNSClient != nil {
+		targetISDev.NetworkServerAddress = req.TargetNetworkServerAddress
+	}
+	if targetASClient != nil {
+		targetISDev.ApplicationServerAddress = req.TargetApplicationServerAddress
+	}
+	if _, err := isClient.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: *targetISDev,
+	}, targetAuth); err != nil {
+		return nil, err
+	}
+	rollbacks = append(rollbacks, func(ctx context.Context) error {
+		_, err := isClient.Delete(ctx, &targetIDs, targetAuth)
+		return err
+	})
+
+	targetJSDev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers:      targetIDs,
+		NetworkServerAddress:      targetISDev.NetworkServerAddress,
+		ApplicationServerAddress:  targetISDev.ApplicationServerAddress,
+		NetworkServerKEKLabel:     req.TargetNetworkServerKEKLabel,
+		ApplicationServerKEKLabel: req.TargetApplicationServerKEKLabel,
+		ApplicationServerID:       req.TargetApplicationServerID,
+		NetID:                     req.TargetNetID,
+	}
+	jsSets := ttnpb.ExcludeFields(jsTransferPaths,
+		"application_server_id",
+		"application_server_kek_label",
+		"net_id",
+		"network_server_kek_label",
+	)
+	if req.InvalidateAuthenticationCode {
+		jsSets = ttnpb.ExcludeFields(jsSets, "claim_authentication_code")
+	}
+	if err := targetJSDev.SetFields(jsDev, jsSets...); err != nil {
+		return nil, err
+	}
+	if _, err := jsClient.Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: *targetJSDev,
+		FieldMask: pbtypes.FieldMask{
+			Paths: append(append([]string{
+				"application_server_address",
+				"application_server_id",
+				"application_server_kek_label",
+				"net_id",
+				"network_server_address",
+				"network_server_kek_label",
+			}, endDeviceIdentifiersPaths...), jsSets...),
+		},
+	}, targetAuth); err != nil {
+		return nil, err
+	}
+	rollbacks = append(rollbacks, func(ctx context.Context) error {
+		_, err := jsClient.Delete(ctx, &targetIDs, targetAuth)
+		return err
+	})
+
+	if targetNSClient != nil {
+		targetNSDev := &ttnpb.EndDevice{
+			EndDeviceIdentifiers: targetIDs,
+		}
+		if err := targetNSDev.SetFields(nsDev, nsTransferPaths...); err != nil {
+			return nil, err
+		}
+		if _, err := targetNSClient.Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: *targetNSDev,
+			FieldMask: pbtypes.FieldMask{
+				Paths: nsTransferPaths,
+			},
+		}, targetAuth); err != nil {
+			return nil, err
+		}
+		rollbacks = append(rollbacks, func(ctx context.Context) error {
+			_, err := targetNSClient.Delete(ctx, &targetIDs, targetAuth)
+			return err
+		})
+	}
+
+	if targetASClient != nil {
+		targetASDev := &ttnpb.EndDevice{
+			EndDeviceIdentifiers: targetIDs,
+		}
+		if err := targetASDev.SetFields(asDev, asTransferPaths...); err != nil {
+			return nil, err
+		}
+		if _, err := targetASClient.Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: *targetASDev,
+			FieldMask: pbtypes.FieldMask{
+				Paths: asTransferPaths,
+			},
+		}, targetAuth); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Info("Claimed end device")
+	events.Publish(evtClaimEndDeviceSuccess(ctx, ttnpb.CombineIdentifiers(sourceIDs, targetIDs), nil))
+	return &targetIDs, nil
+}
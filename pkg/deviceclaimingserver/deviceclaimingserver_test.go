@@ -0,0 +1,398 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	. "go.thethings.network/lorawan-stack/pkg/deviceclaimingserver"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var (
+	sourceAppIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "source-app"}
+	sourceAppKey = "source-key"
+	targetAppIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "target-app"}
+	targetAppKey = "target-key"
+
+	joinEUI  = types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}
+	devEUI   = types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	authCode = "BEEF1234"
+
+	sourceDevIDs = ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: sourceAppIDs,
+		DeviceID:               "source-dev",
+		JoinEUI:                &joinEUI,
+		DevEUI:                 &devEUI,
+	}
+	targetDevIDs = ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: targetAppIDs,
+		DeviceID:               "target-dev",
+		JoinEUI:                &joinEUI,
+		DevEUI:                 &devEUI,
+	}
+
+	allDeviceRights = ttnpb.RightsFrom(
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	)
+)
+
+var errUnavailable = errors.DefineUnavailable("unavailable", "unavailable")
+
+func newContextWithRightsFetcher(ctx context.Context) context.Context {
+	return rights.NewContextWithFetcher(
+		ctx,
+		rights.FetcherFunc(func(ctx context.Context, ids ttnpb.Identifiers) (set *ttnpb.Rights, err error) {
+			md := rpcmetadata.FromIncomingContext(ctx)
+			if md.AuthType != "Bearer" {
+				return
+			}
+			switch {
+			case unique.ID(ctx, ids) == unique.ID(ctx, sourceAppIDs) && md.AuthValue == sourceAppKey,
+				unique.ID(ctx, ids) == unique.ID(ctx, targetAppIDs) && md.AuthValue == targetAppKey:
+				set = allDeviceRights
+			}
+			return
+		}),
+	)
+}
+
+type testEnvironment struct {
+	IS, JS, NS, AS     *mockEndDeviceRegistry
+	TargetNS, TargetAS *mockEndDeviceRegistry
+	Cluster            config.Cluster
+	// TargetNetworkServerAddress and TargetApplicationServerAddress are the addresses of the Network Server and
+	// Application Server outside the cluster to which end devices are claimed.
+	TargetNetworkServerAddress, TargetApplicationServerAddress string
+}
+
+func newTestEnvironment(ctx context.Context) *testEnvironment {
+	env := &testEnvironment{
+		IS: newMockEndDeviceRegistry(&ttnpb.EndDevice{
+			EndDeviceIdentifiers:     sourceDevIDs,
+			Name:                     "Source Device",
+			JoinServerAddress:        "localhost",
+			NetworkServerAddress:     "localhost",
+			ApplicationServerAddress: "localhost",
+		}),
+		JS: newMockEndDeviceRegistry(&ttnpb.EndDevice{
+			EndDeviceIdentifiers:     sourceDevIDs,
+			NetworkServerAddress:     "localhost",
+			ApplicationServerAddress: "localhost",
+			RootKeys: &ttnpb.RootKeys{
+				AppKey: &ttnpb.KeyEnvelope{
+					Key: &types.AES128Key{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8},
+				},
+			},
+			ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{
+				Value: authCode,
+			},
+		}),
+		NS: newMockEndDeviceRegistry(&ttnpb.EndDevice{
+			EndDeviceIdentifiers: sourceDevIDs,
+			FrequencyPlanID:      test.EUFrequencyPlanID,
+			LoRaWANVersion:       ttnpb.MAC_V1_0_3,
+			LoRaWANPHYVersion:    ttnpb.PHY_V1_0_3_REV_A,
+			SupportsJoin:         true,
+		}),
+		AS: newMockEndDeviceRegistry(&ttnpb.EndDevice{
+			EndDeviceIdentifiers: sourceDevIDs,
+			Formatters: &ttnpb.MessagePayloadFormatters{
+				UpFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			},
+		}),
+		TargetNS: newMockEndDeviceRegistry(),
+		TargetAS: newMockEndDeviceRegistry(),
+	}
+	access := &mockApplicationAccess{
+		keys: map[string]map[string]*ttnpb.Rights{
+			unique.ID(ctx, sourceAppIDs): {sourceAppKey: allDeviceRights},
+			unique.ID(ctx, targetAppIDs): {targetAppKey: allDeviceRights},
+		},
+	}
+	env.Cluster = config.Cluster{
+		IdentityServer: startMockServer(ctx, func(srv *rpcserver.Server) {
+			ttnpb.RegisterEndDeviceRegistryServer(srv.Server, env.IS)
+			ttnpb.RegisterApplicationAccessServer(srv.Server, access)
+		}),
+		JoinServer: startMockServer(ctx, func(srv *rpcserver.Server) {
+			ttnpb.RegisterJsEndDeviceRegistryServer(srv.Server, env.JS)
+		}),
+		NetworkServer: startMockServer(ctx, func(srv *rpcserver.Server) {
+			ttnpb.RegisterNsEndDeviceRegistryServer(srv.Server, env.NS)
+		}),
+		ApplicationServer: startMockServer(ctx, func(srv *rpcserver.Server) {
+			ttnpb.RegisterAsEndDeviceRegistryServer(srv.Server, env.AS)
+		}),
+	}
+	env.TargetNetworkServerAddress = startMockServer(ctx, func(srv *rpcserver.Server) {
+		ttnpb.RegisterNsEndDeviceRegistryServer(srv.Server, env.TargetNS)
+	})
+	env.TargetApplicationServerAddress = startMockServer(ctx, func(srv *rpcserver.Server) {
+		ttnpb.RegisterAsEndDeviceRegistryServer(srv.Server, env.TargetAS)
+	})
+	return env
+}
+
+func startDeviceClaimingServer(t *testing.T, env *testEnvironment) (*component.Component, *DeviceClaimingServer) {
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: env.Cluster,
+		},
+	})
+	c.AddContextFiller(newContextWithRightsFetcher)
+	dcs := test.Must(New(c, &Config{
+		AuthorizedApplications: &mockAuthorizedApplicationRegistry{},
+	})).(*DeviceClaimingServer)
+	componenttest.StartComponent(t, c)
+	return c, dcs
+}
+
+func withAPIKey(key string) grpc.CallOption {
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     key,
+		AllowInsecure: true,
+	})
+}
+
+func TestDeviceClaimingServer(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	test.Must(New(c, &Config{
+		AuthorizedApplications: &mockAuthorizedApplicationRegistry{},
+	}))
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_DEVICE_CLAIMING_SERVER)
+}
+
+func TestAuthorizeApplication(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	env := newTestEnvironment(ctx)
+	c, _ := startDeviceClaimingServer(t, env)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ACCESS)
+
+	client := ttnpb.NewEndDeviceClaimingServerClient(c.LoopbackConn())
+
+	// The caller does not have rights on the application.
+	_, err := client.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+		ApplicationIdentifiers: sourceAppIDs,
+		APIKey:                 sourceAppKey,
+	}, withAPIKey(targetAppKey))
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	// The API key does not have rights on the application.
+	_, err = client.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+		ApplicationIdentifiers: sourceAppIDs,
+		APIKey:                 targetAppKey,
+	}, withAPIKey(sourceAppKey))
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	_, err = client.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+		ApplicationIdentifiers: sourceAppIDs,
+		APIKey:                 sourceAppKey,
+	}, withAPIKey(sourceAppKey))
+	a.So(err, should.BeNil)
+
+	_, err = client.UnauthorizeApplication(ctx, &sourceAppIDs, withAPIKey(sourceAppKey))
+	a.So(err, should.BeNil)
+}
+
+func TestClaim(t *testing.T) {
+	claimRequest := func(code string, targetServers bool) func(*testEnvironment) *ttnpb.ClaimEndDeviceRequest {
+		return func(env *testEnvironment) *ttnpb.ClaimEndDeviceRequest {
+			req := &ttnpb.ClaimEndDeviceRequest{
+				SourceDevice: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_{
+					AuthenticatedIdentifiers: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers{
+						JoinEUI:            joinEUI,
+						DevEUI:             devEUI,
+						AuthenticationCode: code,
+					},
+				},
+				TargetApplicationIDs:         targetAppIDs,
+				TargetDeviceID:               targetDevIDs.DeviceID,
+				InvalidateAuthenticationCode: true,
+			}
+			if targetServers {
+				req.TargetNetworkServerAddress = env.TargetNetworkServerAddress
+				req.TargetApplicationServerAddress = env.TargetApplicationServerAddress
+			}
+			return req
+		}
+	}
+
+	for _, tc := range []struct {
+		Name                string
+		Authorize           bool
+		Request             func(*testEnvironment) *ttnpb.ClaimEndDeviceRequest
+		Prepare             func(*testEnvironment)
+		ErrorAssertion      func(error) bool
+		ExpectTransferred   bool
+		ExpectTargetServers bool
+	}{
+		{
+			Name:           "NotAuthorized",
+			Request:        claimRequest(authCode, true),
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:           "InvalidAuthenticationCode",
+			Authorize:      true,
+			Request:        claimRequest("BEEF0000", true),
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:      "ExpiredAuthenticationCode",
+			Authorize: true,
+			Request:   claimRequest(authCode, true),
+			Prepare: func(env *testEnvironment) {
+				validTo := time.Now().Add(-time.Hour)
+				dev, _ := env.JS.get(test.Context(), sourceDevIDs)
+				dev.ClaimAuthenticationCode.ValidTo = &validTo
+			},
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:      "RollBack",
+			Authorize: true,
+			Request:   claimRequest(authCode, true),
+			Prepare: func(env *testEnvironment) {
+				env.TargetAS.setErr = func(ids ttnpb.EndDeviceIdentifiers) error {
+					return errUnavailable
+				}
+			},
+			ErrorAssertion: errors.IsUnavailable,
+		},
+		{
+			Name:                "Success",
+			Authorize:           true,
+			Request:             claimRequest(authCode, true),
+			ExpectTransferred:   true,
+			ExpectTargetServers: true,
+		},
+		{
+			Name:              "SuccessWithoutTargetServers",
+			Authorize:         true,
+			Request:           claimRequest(authCode, false),
+			ExpectTransferred: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+			env := newTestEnvironment(ctx)
+			c, _ := startDeviceClaimingServer(t, env)
+			defer c.Close()
+			mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+			client := ttnpb.NewEndDeviceClaimingServerClient(c.LoopbackConn())
+
+			if tc.Authorize {
+				_, err := client.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+					ApplicationIdentifiers: sourceAppIDs,
+					APIKey:                 sourceAppKey,
+				}, withAPIKey(sourceAppKey))
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+			}
+			if tc.Prepare != nil {
+				tc.Prepare(env)
+			}
+
+			ids, err := client.Claim(ctx, tc.Request(env), withAPIKey(targetAppKey))
+			if tc.ErrorAssertion != nil {
+				if !a.So(tc.ErrorAssertion(err), should.BeTrue) {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			} else if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			// The source end device is always deleted from the source cluster, and the target end device is created on
+			// the Network Server and Application Server only when their addresses are given.
+			for _, expect := range []struct {
+				Name           string
+				Registry       *mockEndDeviceRegistry
+				Source, Target bool
+			}{
+				{"IS", env.IS, !tc.ExpectTransferred, tc.ExpectTransferred},
+				{"JS", env.JS, !tc.ExpectTransferred, tc.ExpectTransferred},
+				{"NS", env.NS, !tc.ExpectTransferred, false},
+				{"AS", env.AS, !tc.ExpectTransferred, false},
+				{"target NS", env.TargetNS, false, tc.ExpectTargetServers},
+				{"target AS", env.TargetAS, false, tc.ExpectTargetServers},
+			} {
+				_, hasSource := expect.Registry.get(ctx, sourceDevIDs)
+				_, hasTarget := expect.Registry.get(ctx, targetDevIDs)
+				if !a.So(hasSource, should.Equal, expect.Source) || !a.So(hasTarget, should.Equal, expect.Target) {
+					t.Errorf("Unexpected end devices in %s", expect.Name)
+				}
+			}
+			if !tc.ExpectTransferred {
+				return
+			}
+
+			a.So(*ids, should.Resemble, targetDevIDs)
+			isDev, _ := env.IS.get(ctx, targetDevIDs)
+			a.So(isDev.Name, should.Equal, "Source Device")
+			jsDev, _ := env.JS.get(ctx, targetDevIDs)
+			a.So(jsDev.RootKeys, should.NotBeNil)
+			a.So(jsDev.ClaimAuthenticationCode, should.BeNil)
+			if !tc.ExpectTargetServers {
+				a.So(isDev.NetworkServerAddress, should.BeEmpty)
+				a.So(isDev.ApplicationServerAddress, should.BeEmpty)
+				a.So(jsDev.NetworkServerAddress, should.BeEmpty)
+				a.So(jsDev.ApplicationServerAddress, should.BeEmpty)
+				return
+			}
+			a.So(isDev.NetworkServerAddress, should.Equal, env.TargetNetworkServerAddress)
+			a.So(isDev.ApplicationServerAddress, should.Equal, env.TargetApplicationServerAddress)
+			nsDev, _ := env.TargetNS.get(ctx, targetDevIDs)
+			a.So(nsDev.FrequencyPlanID, should.Equal, test.EUFrequencyPlanID)
+			asDev, _ := env.TargetAS.get(ctx, targetDevIDs)
+			a.So(asDev.Formatters, should.NotBeNil)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	evtAuthorizeApplication = events.Define(
+		"dcs.application.authorize", "authorize application for claiming",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+	evtUnauthorizeApplication = events.Define(
+		"dcs.application.unauthorize", "unauthorize application for claiming",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+)
+
+// authorizedApplicationRights are the rights that the API key of an authorized application must have.
+var authorizedApplicationRights = []ttnpb.Right{
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+}
+
+var errAPIKeyRights = errors.DefinePermissionDenied("api_key_rights", "API key of application `{application_uid}` does not have sufficient rights for claiming")
+
+type endDeviceClaimingServer struct {
+	DCS *DeviceClaimingServer
+}
+
+// Claim implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) Claim(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	return s.DCS.claim(ctx, req)
+}
+
+// AuthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) AuthorizeApplication(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, authorizedApplicationRights...); err != nil {
+		return nil, err
+	}
+	cc, err := s.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return nil, err
+	}
+	keyRights, err := ttnpb.NewApplicationAccessClient(cc).ListRights(ctx, &req.ApplicationIdentifiers, s.DCS.withAPIKey(req.APIKey))
+	if err != nil {
+		return nil, err
+	}
+	if !keyRights.Implied().IncludesAll(authorizedApplicationRights...) {
+		return nil, errAPIKeyRights.WithAttributes("application_uid", unique.ID(ctx, req.ApplicationIdentifiers))
+	}
+	if err := s.DCS.authorizedApplications.Set(ctx, req); err != nil {
+		return nil, err
+	}
+	events.Publish(evtAuthorizeApplication(ctx, req.ApplicationIdentifiers, nil))
+	return ttnpb.Empty, nil
+}
+
+// UnauthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) UnauthorizeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, *ids, authorizedApplicationRights...); err != nil {
+		return nil, err
+	}
+	if err := s.DCS.authorizedApplications.Delete(ctx, *ids); err != nil {
+		return nil, err
+	}
+	events.Publish(evtUnauthorizeApplication(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,61 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// AuthorizedApplicationRegistry is a Redis authorized application registry.
+type AuthorizedApplicationRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *AuthorizedApplicationRegistry) uidKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get implements deviceclaimingserver.AuthorizedApplicationRegistry.
+func (r *AuthorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.AuthorizeApplicationRequest, error) {
+	pb := &ttnpb.AuthorizeApplicationRequest{}
+	if err := ttnredis.GetProto(r.Redis, r.uidKey(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Set implements deviceclaimingserver.AuthorizedApplicationRegistry.
+func (r *AuthorizedApplicationRegistry) Set(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) error {
+	cmd, err := ttnredis.SetProto(r.Redis, r.uidKey(unique.ID(ctx, req.ApplicationIdentifiers)), req, 0)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Delete implements deviceclaimingserver.AuthorizedApplicationRegistry.
+func (r *AuthorizedApplicationRegistry) Delete(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+	if err := r.Redis.Del(r.uidKey(unique.ID(ctx, ids))).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
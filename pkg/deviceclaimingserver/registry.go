@@ -0,0 +1,32 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// AuthorizedApplicationRegistry is a registry of applications that are authorized for claiming.
+type AuthorizedApplicationRegistry interface {
+	// Get returns the authorization of the application by its identifiers.
+	// If the application is not authorized, a NotFound error is returned.
+	Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.AuthorizeApplicationRequest, error)
+	// Set stores the authorization of the application.
+	Set(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) error
+	// Delete deletes the authorization of the application by its identifiers.
+	Delete(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error
+}
@@ -0,0 +1,209 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+func mustHavePeer(ctx context.Context, c *component.Component, role ttnpb.ClusterRole) {
+	for i := 0; i < 20; i++ {
+		time.Sleep(20 * time.Millisecond)
+		if _, err := c.GetPeer(ctx, role, nil); err == nil {
+			return
+		}
+	}
+	panic("could not connect to peer")
+}
+
+var errNotFound = errors.DefineNotFound("not_found", "not found")
+
+type mockAuthorizedApplicationRegistry struct {
+	mu    sync.Mutex
+	items map[string]*ttnpb.AuthorizeApplicationRequest
+}
+
+func (r *mockAuthorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.AuthorizeApplicationRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req, ok := r.items[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound
+	}
+	return req, nil
+}
+
+func (r *mockAuthorizedApplicationRegistry) Set(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.items == nil {
+		r.items = make(map[string]*ttnpb.AuthorizeApplicationRequest)
+	}
+	r.items[unique.ID(ctx, req.ApplicationIdentifiers)] = req
+	return nil
+}
+
+func (r *mockAuthorizedApplicationRegistry) Delete(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.items, unique.ID(ctx, ids))
+	return nil
+}
+
+// mockEndDeviceRegistry is an in-memory end device registry that implements the Identity Server, Join Server,
+// Network Server and Application Server end device registry services.
+type mockEndDeviceRegistry struct {
+	mu      sync.Mutex
+	devices map[string]*ttnpb.EndDevice
+	// setErr returns the error to return when creating or setting the end device.
+	setErr func(ttnpb.EndDeviceIdentifiers) error
+}
+
+func newMockEndDeviceRegistry(devs ...*ttnpb.EndDevice) *mockEndDeviceRegistry {
+	r := &mockEndDeviceRegistry{
+		devices: make(map[string]*ttnpb.EndDevice),
+	}
+	for _, dev := range devs {
+		r.devices[unique.ID(context.Background(), dev.EndDeviceIdentifiers)] = dev
+	}
+	return r
+}
+
+func (r *mockEndDeviceRegistry) get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDevice, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	dev, ok := r.devices[unique.ID(ctx, ids)]
+	return dev, ok
+}
+
+func (r *mockEndDeviceRegistry) Create(ctx context.Context, req *ttnpb.CreateEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.setErr != nil {
+		if err := r.setErr(req.EndDevice.EndDeviceIdentifiers); err != nil {
+			return nil, err
+		}
+	}
+	dev := req.EndDevice
+	r.devices[unique.ID(ctx, dev.EndDeviceIdentifiers)] = &dev
+	return &dev, nil
+}
+
+func (r *mockEndDeviceRegistry) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	dev, ok := r.get(ctx, req.EndDeviceIdentifiers)
+	if !ok {
+		return nil, errNotFound
+	}
+	res := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+	}
+	if err := res.SetFields(dev, req.FieldMask.Paths...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *mockEndDeviceRegistry) GetIdentifiersForEUIs(ctx context.Context, req *ttnpb.GetEndDeviceIdentifiersForEUIsRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, dev := range r.devices {
+		if dev.JoinEUI.Equal(req.JoinEUI) && dev.DevEUI.Equal(req.DevEUI) {
+			ids := dev.EndDeviceIdentifiers
+			return &ids, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (r *mockEndDeviceRegistry) List(ctx context.Context, req *ttnpb.ListEndDevicesRequest) (*ttnpb.EndDevices, error) {
+	panic("List should not be called")
+}
+
+func (r *mockEndDeviceRegistry) Update(ctx context.Context, req *ttnpb.UpdateEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	panic("Update should not be called")
+}
+
+func (r *mockEndDeviceRegistry) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.setErr != nil {
+		if err := r.setErr(req.EndDevice.EndDeviceIdentifiers); err != nil {
+			return nil, err
+		}
+	}
+	uid := unique.ID(ctx, req.EndDevice.EndDeviceIdentifiers)
+	dev, ok := r.devices[uid]
+	if !ok {
+		dev = &ttnpb.EndDevice{
+			EndDeviceIdentifiers: req.EndDevice.EndDeviceIdentifiers,
+		}
+	}
+	if err := dev.SetFields(&req.EndDevice, req.FieldMask.Paths...); err != nil {
+		return nil, err
+	}
+	r.devices[uid] = dev
+	return dev, nil
+}
+
+func (r *mockEndDeviceRegistry) Provision(*ttnpb.ProvisionEndDevicesRequest, ttnpb.JsEndDeviceRegistry_ProvisionServer) error {
+	panic("Provision should not be called")
+}
+
+func (r *mockEndDeviceRegistry) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	if _, ok := r.devices[uid]; !ok {
+		return nil, errNotFound
+	}
+	delete(r.devices, uid)
+	return ttnpb.Empty, nil
+}
+
+// mockApplicationAccess returns the rights of API keys.
+type mockApplicationAccess struct {
+	ttnpb.ApplicationAccessServer
+	keys map[string]map[string]*ttnpb.Rights
+}
+
+func (a *mockApplicationAccess) ListRights(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error) {
+	md := rpcmetadata.FromIncomingContext(ctx)
+	if rights, ok := a.keys[unique.ID(ctx, ids)][md.AuthValue]; ok {
+		return rights, nil
+	}
+	return &ttnpb.Rights{}, nil
+}
+
+func startMockServer(ctx context.Context, register func(*rpcserver.Server)) string {
+	srv := rpcserver.New(ctx)
+	register(srv)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	go srv.Serve(lis)
+	return lis.Addr().String()
+}
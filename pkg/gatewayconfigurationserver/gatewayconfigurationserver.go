@@ -27,6 +27,9 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/gatewayconfigurationserver/gcsv2"
 	"go.thethings.network/lorawan-stack/pkg/pfconfig/cpf"
 	"go.thethings.network/lorawan-stack/pkg/pfconfig/semtechudp"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/web"
 	"google.golang.org/grpc"
@@ -47,6 +50,7 @@ type Config struct {
 type GatewayConfigurationServer struct {
 	*component.Component
 	config *Config
+	bsCUPS *bscups.Server
 }
 
 func (gcs *GatewayConfigurationServer) makeHandler(f func(context.Context, echo.Context, *ttnpb.Gateway) error) func(echo.Context) error {
@@ -105,10 +109,14 @@ func (gcs *GatewayConfigurationServer) Roles() []ttnpb.ClusterRole {
 }
 
 // RegisterServices registers services provided by gcs at s.
-func (gcs *GatewayConfigurationServer) RegisterServices(_ *grpc.Server) {}
+func (gcs *GatewayConfigurationServer) RegisterServices(s *grpc.Server) {
+	gcs.bsCUPS.RegisterServices(s)
+}
 
 // RegisterHandlers registers gRPC handlers.
-func (gcs *GatewayConfigurationServer) RegisterHandlers(_ *runtime.ServeMux, _ *grpc.ClientConn) {}
+func (gcs *GatewayConfigurationServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	gcs.bsCUPS.RegisterHandlers(s, conn)
+}
 
 // RegisterRoutes registers the web frontend routes.
 func (gcs *GatewayConfigurationServer) RegisterRoutes(server *web.Server) {
@@ -137,12 +145,18 @@ func New(c *component.Component, conf *Config) (*GatewayConfigurationServer, err
 		config:    conf,
 	}
 
-	bsCUPS := conf.BasicStation.NewServer(c)
-	_ = bsCUPS
+	bsCUPS, err := conf.BasicStation.NewServer(c)
+	if err != nil {
+		return nil, err
+	}
+	gcs.bsCUPS = bsCUPS
 
 	v2GCS := gcsv2.New(c, gcsv2.WithTheThingsGatewayConfig(conf.TheThingsGateway))
 	_ = v2GCS
 
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GatewayFirmwareRegistry", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("gatewayconfigurationserver"))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GatewayCredentialsRotator", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("gatewayconfigurationserver"))
+
 	c.RegisterGRPC(gcs)
 	c.RegisterWeb(gcs)
 	return gcs, nil
@@ -150,6 +164,7 @@ func New(c *component.Component, conf *Config) (*GatewayConfigurationServer, err
 
 func (gcs *GatewayConfigurationServer) getContext(c echo.Context) context.Context {
 	ctx := gcs.FillContext(c.Request().Context())
+	ctx = rpcmetadata.NewContextWithRemoteAddress(ctx, c.Request().RemoteAddr)
 	md := metadata.New(map[string]string{
 		"authorization": c.Request().Header.Get(echo.HeaderAuthorization),
 	})
@@ -20,12 +20,13 @@ import (
 
 	echo "github.com/labstack/echo/v4"
 	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"google.golang.org/grpc/metadata"
 )
 
 func (s *Server) normalizeAuthorization(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		ctx := c.Request().Context()
+		ctx := rpcmetadata.NewContextWithRemoteAddress(c.Request().Context(), c.Request().RemoteAddr)
 
 		authorization := c.Request().Header.Get(echo.HeaderAuthorization)
 		if authorization == "" {
@@ -19,6 +19,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
+	upstreamudp "go.thethings.network/lorawan-stack/pkg/gatewayserver/upstream/udp"
 	"go.thethings.network/lorawan-stack/pkg/types"
 )
 
@@ -37,16 +38,84 @@ type BasicStationConfig struct {
 	WSPingInterval          time.Duration `name:"ws-ping-interval" description:"Interval to send WS ping messages"`
 }
 
+// UpstreamUDPConfig defines the Semtech UDP upstream configuration of the Gateway Server.
+type UpstreamUDPConfig struct {
+	upstreamudp.Config `name:",squash"`
+	Hosts              map[string]string   `name:"hosts" description:"Addresses of the Semtech UDP upstream hosts by name"`
+	JoinEUIs           map[string][]string `name:"join-euis" description:"Forward join-requests with the JoinEUI prefixes to the specified Semtech UDP upstream hosts"`
+}
+
+// JoinEUIPrefixes parses the configured JoinEUI prefixes map.
+func (c UpstreamUDPConfig) JoinEUIPrefixes() (map[string][]types.EUI64Prefix, error) {
+	res := make(map[string][]types.EUI64Prefix, len(c.JoinEUIs))
+	for host, prefixes := range c.JoinEUIs {
+		res[host] = make([]types.EUI64Prefix, 0, len(prefixes))
+		for _, val := range prefixes {
+			var prefix types.EUI64Prefix
+			if err := prefix.UnmarshalText([]byte(val)); err != nil {
+				return nil, err
+			}
+			res[host] = append(res[host], prefix)
+		}
+	}
+	return res, nil
+}
+
+// RateLimitConfig defines a token bucket rate limit.
+type RateLimitConfig struct {
+	Rate  float64 `name:"rate" description:"Number of messages per second (0 is unlimited)"`
+	Burst uint    `name:"burst" description:"Maximum number of messages in a burst"`
+}
+
+// RateLimitingProfile defines the rate limits of uplinks, status messages and connection attempts.
+type RateLimitingProfile struct {
+	Uplink     RateLimitConfig `name:"uplink"`
+	Status     RateLimitConfig `name:"status"`
+	Connection RateLimitConfig `name:"connection"`
+}
+
+// RateLimitingConfig defines the rate limiting configuration of the Gateway Server.
+// The gateway profile applies to each gateway individually, while the frontend profiles apply to all gateways that
+// are connected with the frontend protocol together.
+type RateLimitingConfig struct {
+	Gateway      RateLimitingProfile `name:"gateway"`
+	UDP          RateLimitingProfile `name:"udp"`
+	MQTT         RateLimitingProfile `name:"mqtt"`
+	BasicStation RateLimitingProfile `name:"basic-station"`
+	GRPC         RateLimitingProfile `name:"grpc"`
+}
+
+// Protocols returns the rate limiting profiles by frontend protocol.
+func (c RateLimitingConfig) Protocols() map[string]RateLimitingProfile {
+	return map[string]RateLimitingProfile{
+		"udp":          c.UDP,
+		"mqtt":         c.MQTT,
+		"basicstation": c.BasicStation,
+		"grpc":         c.GRPC,
+	}
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
 
-	Forward map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
+	Forward     map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
+	UpstreamUDP UpstreamUDPConfig   `name:"upstream-udp"`
 
 	MQTT         config.MQTT        `name:"mqtt"`
 	MQTTV2       config.MQTT        `name:"mqtt-v2"`
 	UDP          UDPConfig          `name:"udp"`
 	BasicStation BasicStationConfig `name:"basic-station"`
+
+	RateLimiting RateLimitingConfig `name:"rate-limiting"`
+
+	ReserveBeaconWindows bool `name:"reserve-beacon-windows" description:"Reserve the guard and reserved windows of Class B beacons when scheduling downlinks on gateways with GPS time"`
+
+	Stats                         GatewayConnectionStatsRegistry `name:"-"`
+	UpdateConnectionStatsInterval time.Duration                  `name:"update-connection-stats-interval" description:"Time between updates of the stored connection stats of connected gateways"`
+
+	UpdateGatewayLocationDebounceTime time.Duration `name:"update-gateway-location-debounce-time" description:"Minimum time between updates of the gateway location from status messages"`
+	UpdateGatewayLocationThreshold    float64       `name:"update-gateway-location-threshold" description:"Minimum distance in meters that the gateway location must move before it is updated from status messages"`
 }
 
 // ForwardDevAddrPrefixes parses the configured forward map.
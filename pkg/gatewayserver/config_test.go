@@ -53,4 +53,34 @@ func TestConfig(t *testing.T) {
 		_, err := conf.ForwardDevAddrPrefixes()
 		a.So(err, should.NotBeNil)
 	}
+
+	{
+		conf := gatewayserver.Config{
+			UpstreamUDP: gatewayserver.UpstreamUDPConfig{
+				JoinEUIs: map[string][]string{
+					"legacy": {"70B3D57ED0000000/40", "0000000000000000/0"},
+				},
+			},
+		}
+		joinEUIs, err := conf.UpstreamUDP.JoinEUIPrefixes()
+		a.So(err, should.BeNil)
+		a.So(joinEUIs, should.HaveEmptyDiff, map[string][]types.EUI64Prefix{
+			"legacy": {
+				{EUI64: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 40},
+				{},
+			},
+		})
+	}
+
+	{
+		conf := gatewayserver.Config{
+			UpstreamUDP: gatewayserver.UpstreamUDPConfig{
+				JoinEUIs: map[string][]string{
+					"legacy": {"invalid"},
+				},
+			},
+		}
+		_, err := conf.UpstreamUDP.JoinEUIPrefixes()
+		a.So(err, should.NotBeNil)
+	}
 }
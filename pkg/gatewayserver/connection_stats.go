@@ -0,0 +1,126 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errConnectionClosed = errors.DefineAborted("connection_closed", "connection closed")
+
+// defaultUpdateConnectionStatsInterval is used when no interval to update the connection stats is configured.
+const defaultUpdateConnectionStatsInterval = time.Minute
+
+// connectionStats returns the statistics of the given gateway connection.
+func connectionStats(conn connectionEntry) *ttnpb.GatewayConnectionStats {
+	stats := &ttnpb.GatewayConnectionStats{}
+	ct := conn.ConnectTime()
+	stats.ConnectedAt = &ct
+	stats.Protocol = conn.Frontend().Protocol()
+	if s, t, ok := conn.StatusStats(); ok {
+		stats.LastStatusReceivedAt = &t
+		stats.LastStatus = s
+	}
+	if c, t, ok := conn.UpStats(); ok {
+		stats.LastUplinkReceivedAt = &t
+		stats.UplinkCount = c
+	}
+	if c, t, ok := conn.DownStats(); ok {
+		stats.LastDownlinkReceivedAt = &t
+		stats.DownlinkCount = c
+	}
+	if min, max, median, count := conn.RTTStats(); count > 0 {
+		stats.RoundTripTimes = &ttnpb.GatewayConnectionStats_RoundTripTimes{
+			Min:    min,
+			Max:    max,
+			Median: median,
+			Count:  uint32(count),
+		}
+	}
+	return stats
+}
+
+// disconnectReason returns the details of the error that closed the gateway connection.
+func disconnectReason(err error) *ttnpb.ErrorDetails {
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	return ttnpb.ErrorDetailsToProto(errConnectionClosed)
+}
+
+// replaceConnectionStats returns a function that replaces the stored connection stats with the given stats.
+func replaceConnectionStats(stats *ttnpb.GatewayConnectionStats) func(*ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, error) {
+	return func(*ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, error) {
+		return stats, nil
+	}
+}
+
+// updateConnectionStats stores the statistics of the gateway connection in the registry when the gateway connects,
+// periodically when the statistics changed, and when the gateway disconnects.
+// This function blocks until the connection is closed.
+func (gs *GatewayServer) updateConnectionStats(conn connectionEntry) {
+	if gs.statsRegistry == nil {
+		return
+	}
+	ctx := conn.Context()
+	logger := log.FromContext(ctx)
+	ids := conn.Gateway().GatewayIdentifiers
+
+	stored := connectionStats(conn)
+	if err := gs.statsRegistry.Set(ctx, ids, replaceConnectionStats(stored)); err != nil {
+		logger.WithError(err).Warn("Failed to store connection stats")
+	}
+
+	interval := gs.config.UpdateConnectionStatsInterval
+	if interval <= 0 {
+		interval = defaultUpdateConnectionStatsInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			stats := connectionStats(conn)
+			now := time.Now().UTC()
+			stats.DisconnectedAt = &now
+			stats.DisconnectReason = disconnectReason(ctx.Err())
+			// The connection context is done, so the final stats are stored in the Gateway Server context.
+			// If the gateway reconnected in the meantime, the stats of the new connection are kept.
+			if err := gs.statsRegistry.Set(log.NewContext(gs.Context(), logger), ids, func(stored *ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, error) {
+				if stored == nil || stored.ConnectedAt == nil || !stored.ConnectedAt.Equal(*stats.ConnectedAt) {
+					return stored, nil
+				}
+				return stats, nil
+			}); err != nil {
+				logger.WithError(err).Warn("Failed to store connection stats on disconnect")
+			}
+			return
+		case <-ticker.C:
+			stats := connectionStats(conn)
+			if stats.Equal(stored) {
+				continue
+			}
+			if err := gs.statsRegistry.Set(ctx, ids, replaceConnectionStats(stats)); err != nil {
+				logger.WithError(err).Warn("Failed to update connection stats")
+				continue
+			}
+			stored = stats
+		}
+	}
+}
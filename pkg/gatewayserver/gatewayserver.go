@@ -40,8 +40,11 @@ import (
 	iogrpc "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/grpc"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/upstream"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/upstream/ns"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/upstream/packetbroker"
+	upstreamudp "go.thethings.network/lorawan-stack/pkg/gatewayserver/upstream/udp"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
@@ -68,6 +71,11 @@ type GatewayServer struct {
 
 	upstreamHandlers map[string]upstream.Handler
 
+	connectionLimiter *connectionLimiter
+	protocolLimiters  map[string]*rateLimiter
+
+	statsRegistry GatewayConnectionStatsRegistry
+
 	connections sync.Map // string to connectionEntry
 }
 
@@ -117,6 +125,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 	if len(forward) == 0 {
 		forward[""] = []types.DevAddrPrefix{{}}
 	}
+	joinEUIs, err := conf.UpstreamUDP.JoinEUIPrefixes()
+	if err != nil {
+		return nil, err
+	}
 
 	gs = &GatewayServer{
 		Component:                 c,
@@ -125,11 +137,19 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		requireRegisteredGateways: conf.RequireRegisteredGateways,
 		forward:                   forward,
 		upstreamHandlers:          make(map[string]upstream.Handler),
+		statsRegistry:             conf.Stats,
 	}
 	for _, opt := range opts {
 		opt(gs)
 	}
 
+	// Setup rate limiting.
+	gs.connectionLimiter = newConnectionLimiter(gs.Context(), conf.RateLimiting)
+	gs.protocolLimiters = make(map[string]*rateLimiter)
+	for protocol, profile := range conf.RateLimiting.Protocols() {
+		gs.protocolLimiters[protocol] = newRateLimiter(protocol, profile)
+	}
+
 	// Setup forwarding table.
 	for name, prefix := range gs.forward {
 		if name == "" {
@@ -139,9 +159,26 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		switch name {
 		case "cluster":
 			handler = ns.NewHandler(gs.Context(), c, prefix)
+		case "packetbroker":
+			handler = packetbroker.NewHandler(gs.Context(), c, prefix)
 		default:
-			return nil, errInvalidUpstreamName.WithAttributes("name", name)
+			address, ok := conf.UpstreamUDP.Hosts[name]
+			if !ok {
+				return nil, errInvalidUpstreamName.WithAttributes("name", name)
+			}
+			handler = upstreamudp.NewHandler(gs.Context(), name, address, prefix, joinEUIs[name], conf.UpstreamUDP.Config)
+		}
+		if err := handler.Setup(gs.Context()); err != nil {
+			return nil, errSetupUpstream.WithCause(err).WithAttributes("name", name)
 		}
+		gs.upstreamHandlers[name] = handler
+	}
+	// Semtech UDP upstream hosts that are not in the forwarding table only handle join-requests.
+	for name, address := range conf.UpstreamUDP.Hosts {
+		if _, ok := gs.upstreamHandlers[name]; ok {
+			continue
+		}
+		handler := upstreamudp.NewHandler(gs.Context(), name, address, nil, joinEUIs[name], conf.UpstreamUDP.Config)
 		if err := handler.Setup(gs.Context()); err != nil {
 			return nil, errSetupUpstream.WithCause(err).WithAttributes("name", name)
 		}
@@ -213,7 +250,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 						)
 					}
 					defer lis.Close()
-					return mqtt.Serve(ctx, gs, lis, version.Format, endpoint.Protocol())
+					return mqtt.Serve(ctx, gs, lis, version.Format, endpoint.Protocol(), mqtt.WithMaximumPacketSize(version.Config.MaximumPacketSize))
 				}, component.TaskRestartOnFailure)
 		}
 	}
@@ -350,6 +387,11 @@ var errNewConnection = errors.DefineAborted("new_connection", "new connection fr
 type connectionEntry struct {
 	*io.Connection
 	upstreamDone chan struct{}
+
+	// locationUpdates contains the status messages to update the gateway location from.
+	// This is nil if the gateway location should not be updated from status messages.
+	locationUpdates chan *ttnpb.GatewayStatus
+	locationCallOpt grpc.CallOption
 }
 
 // Connect connects a gateway by its identifiers to the Gateway Server, and returns a io.Connection for traffic and
@@ -367,11 +409,18 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 	ctx = log.NewContext(ctx, logger)
 	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:conn:%s", events.NewCorrelationID()))
 
+	if err := gs.connectionLimiter.Allow(uid, frontend.Protocol(), time.Now()); err != nil {
+		logger.WithError(err).Warn("Drop connection")
+		return nil, err
+	}
+
 	var err error
 	var callOpt grpc.CallOption
+	forwardedAuth := true
 	callOpt, err = rpcmetadata.WithForwardedAuth(ctx, gs.AllowInsecureForCredentials())
 	if errors.IsUnauthenticated(err) {
 		callOpt = gs.WithClusterAuth()
+		forwardedAuth = false
 	} else if err != nil {
 		return nil, err
 	}
@@ -391,6 +440,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 				"location_public",
 				"schedule_anytime_delay",
 				"schedule_downlink_late",
+				"update_location_from_status",
 			},
 		},
 	}, callOpt)
@@ -416,7 +466,19 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		return nil, err
 	}
 
-	conn, err := io.NewConnection(ctx, frontend, gtw, gs.FrequencyPlans, gtw.EnforceDutyCycle, gtw.ScheduleAnytimeDelay)
+	var schedulerOpts []scheduling.Option
+	if gs.config.ReserveBeaconWindows {
+		schedulerOpts = append(schedulerOpts, scheduling.WithBeaconReservation())
+	}
+	limiter := &upstreamLimiter{
+		ctx:     ctx,
+		gateway: gtw,
+		limiters: []*rateLimiter{
+			newRateLimiter(uid, gs.config.RateLimiting.Gateway),
+			gs.protocolLimiters[frontend.Protocol()],
+		},
+	}
+	conn, err := io.NewConnection(ctx, frontend, gtw, gs.FrequencyPlans, gtw.EnforceDutyCycle, gtw.ScheduleAnytimeDelay, limiter, schedulerOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -424,6 +486,11 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		Connection:   conn,
 		upstreamDone: make(chan struct{}),
 	}
+	if gtw.UpdateLocationFromStatus && forwardedAuth {
+		// The gateway location can only be updated with the credentials of the gateway.
+		connEntry.locationUpdates = make(chan *ttnpb.GatewayStatus, 1)
+		connEntry.locationCallOpt = callOpt
+	}
 	for existing, exists := gs.connections.LoadOrStore(uid, connEntry); exists; existing, exists = gs.connections.LoadOrStore(uid, connEntry) {
 		existingConnEntry := existing.(connectionEntry)
 		logger.Warn("Disconnect existing connection")
@@ -491,11 +558,23 @@ type upstreamItem struct {
 func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 	ctx := conn.Context()
 	logger := log.FromContext(ctx)
+	statsDone := make(chan struct{})
+	go func() {
+		defer close(statsDone)
+		gs.updateConnectionStats(conn)
+	}()
+	locationDone := make(chan struct{})
+	go func() {
+		defer close(locationDone)
+		gs.updateLocationFromStatus(conn)
+	}()
 	defer func() {
 		ids := conn.Gateway().GatewayIdentifiers
 		gs.connections.Delete(unique.ID(ctx, ids))
 		registerGatewayDisconnect(ctx, ids, conn.Frontend().Protocol())
 		logger.Info("Disconnected")
+		<-statsDone
+		<-locationDone
 		close(conn.upstreamDone)
 	}()
 
@@ -526,6 +605,7 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 						}
 						logger.Debug("Drop message")
 						registerDropUplink(ctx, conn.Gateway(), msg.UplinkMessage, host.name, err)
+						conn.DropUp(msg.UplinkMessage, err)
 					}
 					ids, err := lorawan.GetUplinkMessageIdentifiers(msg.RawPayload)
 					if err != nil {
@@ -549,9 +629,18 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 					}
 					if err := handler.HandleStatus(ctx, conn.Gateway().GatewayIdentifiers, msg); err != nil {
 						registerDropStatus(ctx, conn.Gateway(), msg, item.host.name, err)
+						conn.DropStatus(msg, err)
 					} else {
 						registerForwardStatus(ctx, conn.Gateway(), msg, item.host.name)
 					}
+				case *ttnpb.TxAcknowledgment:
+					handler := item.host.handler(msg.DownlinkMessage.EndDeviceIDs)
+					if handler == nil {
+						break
+					}
+					if err := handler.HandleTxAck(ctx, conn.Gateway().GatewayIdentifiers, msg); err != nil {
+						logger.WithError(err).WithField("host", item.host.name).Debug("Failed to forward Tx acknowledgment")
+					}
 				}
 			}
 		}
@@ -568,12 +657,23 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 			}
 			return false
 		}
+		passJoinEUI := func(prefixes []types.EUI64Prefix, joinEUI types.EUI64) bool {
+			for _, prefix := range prefixes {
+				if joinEUI.HasPrefix(prefix) {
+					return true
+				}
+			}
+			return false
+		}
 		hosts = append(hosts, &upstreamHost{
 			name: name,
 			handler: func(ids *ttnpb.EndDeviceIdentifiers) upstream.Handler {
 				if ids != nil && ids.DevAddr != nil && !passDevAddr(handler.GetDevAddrPrefixes(), *ids.DevAddr) {
 					return nil
 				}
+				if ids != nil && ids.JoinEUI != nil && !passJoinEUI(handler.GetJoinEUIPrefixes(), *ids.JoinEUI) {
+					return nil
+				}
 				return handler
 			},
 			handleCh: make(chan upstreamItem),
@@ -596,6 +696,12 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 			val = msg
 		case msg := <-conn.Status():
 			ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:status:%s", events.NewCorrelationID()))
+			if conn.locationUpdates != nil && len(msg.AntennaLocations) > 0 {
+				select {
+				case conn.locationUpdates <- msg:
+				default:
+				}
+			}
 			val = msg
 		case msg := <-conn.TxAck():
 			ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:tx_ack:%s", events.NewCorrelationID()))
@@ -605,8 +711,11 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 			} else {
 				registerFailDownlink(ctx, conn.Gateway(), msg)
 			}
-			// TODO: Send Tx acknowledgement upstream (https://github.com/TheThingsNetwork/lorawan-stack/issues/76)
-			continue
+			// Only acknowledgments of downlink messages that are requested on a downlink path are sent upstream.
+			if msg.DownlinkMessage == nil {
+				continue
+			}
+			val = msg
 		}
 		for _, host := range hosts {
 			item := upstreamItem{
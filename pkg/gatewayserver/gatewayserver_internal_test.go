@@ -14,10 +14,57 @@
 
 package gatewayserver
 
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
 var (
-	ErrSchedule = errSchedule
+	ErrSchedule    = errSchedule
+	ErrRateLimited = errRateLimited
 )
 
+// TokenBucket is a token bucket rate limiter.
+type TokenBucket interface {
+	Allow(now time.Time) bool
+	Full(now time.Time) bool
+}
+
+// NewTokenBucket returns a new token bucket.
+func NewTokenBucket(conf RateLimitConfig) TokenBucket {
+	return newTokenBucket(conf)
+}
+
+// RateLimiter limits uplinks and status messages.
+type RateLimiter interface {
+	AllowUplink(now time.Time) error
+	AllowStatus(now time.Time) error
+}
+
+// NewRateLimiter returns a new rate limiter.
+func NewRateLimiter(scope string, profile RateLimitingProfile) RateLimiter {
+	return newRateLimiter(scope, profile)
+}
+
+// NewConnectionLimiter returns a new connection limiter.
+func NewConnectionLimiter(ctx context.Context, conf RateLimitingConfig) interface {
+	Allow(uid, protocol string, now time.Time) error
+} {
+	return newConnectionLimiter(ctx, conf)
+}
+
+// LocationDistance returns the distance in meters between the given locations.
+func LocationDistance(a, b ttnpb.Location) float64 {
+	return locationDistance(a, b)
+}
+
+// AntennasFromStatus returns the antennas with the locations reported in the status message.
+func AntennasFromStatus(antennas []ttnpb.GatewayAntenna, status *ttnpb.GatewayStatus, threshold float64) ([]ttnpb.GatewayAntenna, bool) {
+	return antennasFromStatus(antennas, status, threshold)
+}
+
 func init() {
 	maxUpstreamHandlers = 1
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
 	"go.thethings.network/lorawan-stack/pkg/config"
@@ -38,6 +39,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/basicstationlns/messages"
+	iomock "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/mock"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/upstream/mock"
 	"go.thethings.network/lorawan-stack/pkg/rpcclient"
@@ -1204,3 +1206,111 @@ func TestGatewayServer(t *testing.T) {
 		}
 	}
 }
+
+func TestGatewayConnectionStats(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	is, isAddr := startMockIS(ctx)
+
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: registeredGatewayID,
+		EUI:       &registeredGatewayEUI,
+	}
+	is.add(ctx, ids, registeredGatewayKey, false)
+
+	// Both Gateway Server instances share the connection stats registry.
+	registry := newMockConnectionStatsRegistry()
+	newGatewayServer := func() *gatewayserver.GatewayServer {
+		c := componenttest.NewComponent(t, &component.Config{
+			ServiceBase: config.ServiceBase{
+				Cluster: config.Cluster{
+					IdentityServer: isAddr,
+				},
+			},
+		})
+		c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+		gs, err := gatewayserver.New(c, &gatewayserver.Config{
+			Stats:                         registry,
+			UpdateConnectionStatsInterval: timeout / 4,
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		componenttest.StartComponent(t, c)
+		mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+		return gs
+	}
+	gs1 := newGatewayServer()
+	defer gs1.Close()
+	gs2 := newGatewayServer()
+	defer gs2.Close()
+
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK, ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		},
+	})
+
+	// The gateway is not connected and has not been connected before.
+	_, err := gs1.GetGatewayConnectionStats(gtwCtx, &ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	conn1Ctx, conn1Cancel := context.WithCancel(gtwCtx)
+	defer conn1Cancel()
+	frontend1, err := iomock.ConnectFrontend(conn1Ctx, ids, gs1)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	frontend1.Status <- &ttnpb.GatewayStatus{
+		Time: time.Unix(424242, 0).UTC(),
+	}
+	time.Sleep(timeout)
+
+	// The gateway is connected to the first Gateway Server, so the second Gateway Server falls back to the registry.
+	stats1, err := gs1.GetGatewayConnectionStats(gtwCtx, &ids)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(stats1.LastStatus, should.NotBeNil)
+	a.So(stats1.DisconnectedAt, should.BeNil)
+	stats, err := gs2.GetGatewayConnectionStats(gtwCtx, &ids)
+	if a.So(err, should.BeNil) {
+		a.So(stats, should.Resemble, stats1)
+	}
+
+	// The gateway reconnects to the second Gateway Server before the first connection is closed.
+	conn2Ctx, conn2Cancel := context.WithCancel(gtwCtx)
+	defer conn2Cancel()
+	_, err = iomock.ConnectFrontend(conn2Ctx, ids, gs2)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	time.Sleep(timeout)
+	stats2, err := gs2.GetGatewayConnectionStats(gtwCtx, &ids)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(*stats2.ConnectedAt, should.HappenAfter, *stats1.ConnectedAt)
+
+	// Closing the first connection does not overwrite the stats of the second connection.
+	conn1Cancel()
+	time.Sleep(timeout)
+	stats, err = gs1.GetGatewayConnectionStats(gtwCtx, &ids)
+	if a.So(err, should.BeNil) {
+		a.So(*stats.ConnectedAt, should.Equal, *stats2.ConnectedAt)
+		a.So(stats.DisconnectedAt, should.BeNil)
+	}
+
+	// The stats of the second connection remain available after it is closed.
+	conn2Cancel()
+	time.Sleep(timeout)
+	for _, gs := range []*gatewayserver.GatewayServer{gs1, gs2} {
+		stats, err := gs.GetGatewayConnectionStats(gtwCtx, &ids)
+		if a.So(err, should.BeNil) {
+			a.So(*stats.ConnectedAt, should.Equal, *stats2.ConnectedAt)
+			a.So(stats.DisconnectedAt, should.NotBeNil)
+			a.So(stats.DisconnectReason, should.NotBeNil)
+		}
+	}
+}
@@ -18,8 +18,10 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
+	"github.com/mohae/deepcopy"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
@@ -88,6 +90,47 @@ func (is *mockIS) add(ctx context.Context, ids ttnpb.GatewayIdentifiers, key str
 
 var errNotFound = errors.DefineNotFound("not_found", "not found")
 
+type mockConnectionStatsRegistry struct {
+	mu    sync.Mutex
+	stats map[string]*ttnpb.GatewayConnectionStats
+}
+
+func newMockConnectionStatsRegistry() *mockConnectionStatsRegistry {
+	return &mockConnectionStatsRegistry{
+		stats: make(map[string]*ttnpb.GatewayConnectionStats),
+	}
+}
+
+func (r *mockConnectionStatsRegistry) Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*ttnpb.GatewayConnectionStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats, ok := r.stats[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound
+	}
+	return deepcopy.Copy(stats).(*ttnpb.GatewayConnectionStats), nil
+}
+
+func (r *mockConnectionStatsRegistry) Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, f func(*ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, error)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	var stored *ttnpb.GatewayConnectionStats
+	if stats, ok := r.stats[uid]; ok {
+		stored = deepcopy.Copy(stats).(*ttnpb.GatewayConnectionStats)
+	}
+	stats, err := f(stored)
+	if err != nil {
+		return err
+	}
+	if stats == nil {
+		delete(r.stats, uid)
+		return nil
+	}
+	r.stats[uid] = deepcopy.Copy(stats).(*ttnpb.GatewayConnectionStats)
+	return nil
+}
+
 func (is *mockIS) Get(ctx context.Context, req *ttnpb.GetGatewayRequest) (*ttnpb.Gateway, error) {
 	uid := unique.ID(ctx, req.GatewayIdentifiers)
 	gtw, ok := is.gateways[uid]
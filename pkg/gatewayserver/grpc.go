@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 )
@@ -29,35 +30,47 @@ func (gs *GatewayServer) GetGatewayConnectionStats(ctx context.Context, ids *ttn
 	}
 
 	uid := unique.ID(ctx, ids)
-	val, ok := gs.connections.Load(uid)
-	if !ok {
-		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	if val, ok := gs.connections.Load(uid); ok {
+		return connectionStats(val.(connectionEntry)), nil
 	}
-	conn := val.(connectionEntry)
-
-	stats := &ttnpb.GatewayConnectionStats{}
-	ct := conn.ConnectTime()
-	stats.ConnectedAt = &ct
-	stats.Protocol = conn.Frontend().Protocol()
-	if s, t, ok := conn.StatusStats(); ok {
-		stats.LastStatusReceivedAt = &t
-		stats.LastStatus = s
+	if gs.statsRegistry != nil {
+		stats, err := gs.statsRegistry.Get(ctx, *ids)
+		if err == nil {
+			return stats, nil
+		}
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
 	}
-	if c, t, ok := conn.UpStats(); ok {
-		stats.LastUplinkReceivedAt = &t
-		stats.UplinkCount = c
+	return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+}
+
+var errGatewayDisconnected = errors.DefineAborted("gateway_disconnected", "gateway `{gateway_uid}` disconnected")
+
+// TailGateway streams the traffic of a gateway that is connected to this Gateway Server, including the messages that
+// are dropped. The stream ends when the gateway disconnects.
+func (gs *GatewayServer) TailGateway(ids *ttnpb.GatewayIdentifiers, stream ttnpb.Gs_TailGatewayServer) error {
+	ctx := stream.Context()
+	if err := rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_TRAFFIC_READ); err != nil {
+		return err
 	}
-	if c, t, ok := conn.DownStats(); ok {
-		stats.LastDownlinkReceivedAt = &t
-		stats.DownlinkCount = c
+
+	uid := unique.ID(ctx, ids)
+	conn, ok := gs.GetConnection(ctx, *ids)
+	if !ok {
+		return errNotConnected.WithAttributes("gateway_uid", uid)
 	}
-	if min, max, median, count := conn.RTTStats(); count > 0 {
-		stats.RoundTripTimes = &ttnpb.GatewayConnectionStats_RoundTripTimes{
-			Min:    min,
-			Max:    max,
-			Median: median,
-			Count:  uint32(count),
+	trafficCh := conn.Tap(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-conn.Context().Done():
+			return errGatewayDisconnected.WithCause(conn.Context().Err()).WithAttributes("gateway_uid", uid)
+		case msg := <-trafficCh:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
 		}
 	}
-	return stats, nil
 }
@@ -96,3 +96,22 @@ func (gs *GatewayServer) ScheduleDownlink(ctx context.Context, down *ttnpb.Downl
 		PathErrors: protoErrs,
 	})
 }
+
+// GetGatewayLoads returns the downlink load of the given gateways.
+// Gateways that are not connected to this Gateway Server are omitted.
+func (gs *GatewayServer) GetGatewayLoads(ctx context.Context, req *ttnpb.GetGatewayLoadsRequest) (*ttnpb.GatewayLoads, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.GatewayLoads{
+		Loads: make([]*ttnpb.GatewayLoad, 0, len(req.GatewayIDs)),
+	}
+	for _, ids := range req.GatewayIDs {
+		conn, ok := gs.GetConnection(ctx, ids)
+		if !ok {
+			continue
+		}
+		res.Loads = append(res.Loads, conn.Load())
+	}
+	return res, nil
+}
@@ -39,6 +39,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	pfconfig "go.thethings.network/lorawan-stack/pkg/pfconfig/basicstationlns"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/unique"
@@ -104,7 +105,7 @@ func New(ctx context.Context, server io.Server, useTrafficTLSAddress bool, wsPin
 }
 
 func (s *srv) handleDiscover(c echo.Context) error {
-	ctx := c.Request().Context()
+	ctx := rpcmetadata.NewContextWithRemoteAddress(c.Request().Context(), c.Request().RemoteAddr)
 	logger := log.FromContext(ctx).WithFields(log.Fields(
 		"endpoint", "discover",
 		"remote_addr", c.Request().RemoteAddr,
@@ -175,7 +176,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 	var sessionID int32
 	id := c.Param("id")
 	auth := c.Request().Header.Get(echo.HeaderAuthorization)
-	ctx := c.Request().Context()
+	ctx := rpcmetadata.NewContextWithRemoteAddress(c.Request().Context(), c.Request().RemoteAddr)
 
 	logger := log.FromContext(ctx).WithFields(log.Fields(
 		"endpoint", "traffic",
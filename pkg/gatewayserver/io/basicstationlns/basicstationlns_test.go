@@ -891,6 +891,31 @@ func TestTraffic(t *testing.T) {
 			ExpectedNetworkUpstream: ttnpb.TxAcknowledgment{
 				CorrelationIDs: []string{"correlation1", "correlation2"},
 				Result:         ttnpb.TxAcknowledgment_SUCCESS,
+				DownlinkMessage: &ttnpb.DownlinkMessage{
+					RawPayload: []byte("Ymxhamthc25kJ3M=="),
+					EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+						DeviceID: "testdevice",
+						DevEUI:   eui64Ptr(types.EUI64{0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11}),
+					},
+					Settings: &ttnpb.DownlinkMessage_Request{
+						Request: &ttnpb.TxRequest{
+							Class: ttnpb.CLASS_A,
+							DownlinkPaths: []*ttnpb.DownlinkPath{
+								{
+									Path: &ttnpb.DownlinkPath_UplinkToken{
+										UplinkToken: io.MustUplinkToken(ttnpb.GatewayAntennaIdentifiers{GatewayIdentifiers: registeredGatewayID}, 1553759666),
+									},
+								},
+							},
+							Priority:         ttnpb.TxSchedulePriority_NORMAL,
+							Rx1Delay:         ttnpb.RX_DELAY_1,
+							Rx1DataRateIndex: 5,
+							Rx1Frequency:     868100000,
+							FrequencyPlanID:  test.EUFrequencyPlanID,
+						},
+					},
+					CorrelationIDs: []string{"correlation1", "correlation2"},
+				},
 			},
 		},
 		{
@@ -17,6 +17,7 @@ package io
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -33,6 +34,9 @@ import (
 const (
 	bufferSize = 1 << 4
 	maxRTTs    = 1 << 5
+
+	// pendingDownlinkTTL is the time after which a downlink message is no longer expected to be acknowledged.
+	pendingDownlinkTTL = time.Minute
 )
 
 // Frontend provides supported features by the gateway frontend.
@@ -61,6 +65,14 @@ type Server interface {
 	UnclaimDownlink(ctx context.Context, ids ttnpb.GatewayIdentifiers) error
 }
 
+// UpstreamLimiter limits the upstream messages of a gateway connection.
+type UpstreamLimiter interface {
+	// AllowUplink returns nil if the uplink message is allowed, and an error otherwise.
+	AllowUplink(*ttnpb.UplinkMessage) error
+	// AllowStatus returns nil if the status message is allowed, and an error otherwise.
+	AllowStatus(*ttnpb.GatewayStatus) error
+}
+
 // Connection is a connection to a gateway managed by a frontend.
 type Connection struct {
 	// Align for sync/atomic.
@@ -82,11 +94,25 @@ type Connection struct {
 	fps        *frequencyplans.Store
 	scheduler  *scheduling.Scheduler
 	rtts       *rtts
+	limiter    UpstreamLimiter
 
 	upCh     chan *ttnpb.GatewayUplinkMessage
 	downCh   chan *ttnpb.DownlinkMessage
 	statusCh chan *ttnpb.GatewayStatus
 	txAckCh  chan *ttnpb.TxAcknowledgment
+
+	pendingDownlinksMu sync.Mutex
+	pendingDownlinks   map[string]pendingDownlink
+
+	tapsMu sync.RWMutex
+	taps   map[chan *ttnpb.GatewayTraffic]struct{}
+}
+
+// pendingDownlink is a downlink message that has been sent to the gateway and that has not been acknowledged yet.
+type pendingDownlink struct {
+	frequency uint64
+	message   *ttnpb.DownlinkMessage
+	sentAt    time.Time
 }
 
 var (
@@ -101,7 +127,9 @@ var (
 )
 
 // NewConnection instantiates a new gateway connection.
-func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gateway, fps *frequencyplans.Store, enforceDutyCycle bool, scheduleAnytimeDelay *time.Duration) (*Connection, error) {
+// If limiter is not nil, upstream messages that are not allowed by the limiter are dropped before they are buffered.
+// The scheduler options are passed to the scheduler of the connection.
+func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gateway, fps *frequencyplans.Store, enforceDutyCycle bool, scheduleAnytimeDelay *time.Duration, limiter UpstreamLimiter, schedulerOpts ...scheduling.Option) (*Connection, error) {
 	gatewayFPs := make(map[string]*frequencyplans.FrequencyPlan, len(gateway.FrequencyPlanIDs))
 	fp0ID := gateway.FrequencyPlanID
 	fp0, err := fps.GetByID(fp0ID)
@@ -128,7 +156,7 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 	}
 
 	ctx, cancelCtx := errorcontext.New(ctx)
-	scheduler, err := scheduling.NewScheduler(ctx, gatewayFPs, enforceDutyCycle, scheduleAnytimeDelay, nil)
+	scheduler, err := scheduling.NewScheduler(ctx, gatewayFPs, enforceDutyCycle, scheduleAnytimeDelay, nil, schedulerOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -143,11 +171,15 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 		fps:         fps,
 		scheduler:   scheduler,
 		rtts:        newRTTs(maxRTTs),
+		limiter:     limiter,
 		upCh:        make(chan *ttnpb.GatewayUplinkMessage, bufferSize),
 		downCh:      make(chan *ttnpb.DownlinkMessage, bufferSize),
 		statusCh:    make(chan *ttnpb.GatewayStatus, bufferSize),
 		txAckCh:     make(chan *ttnpb.TxAcknowledgment, bufferSize),
 		connectTime: time.Now().UnixNano(),
+
+		pendingDownlinks: make(map[string]pendingDownlink),
+		taps:             make(map[chan *ttnpb.GatewayTraffic]struct{}),
 	}, nil
 }
 
@@ -169,6 +201,12 @@ var errBufferFull = errors.DefineInternal("buffer_full", "buffer is full")
 
 // HandleUp updates the uplink stats and sends the message to the upstream channel.
 func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
+	if c.limiter != nil {
+		if err := c.limiter.AllowUplink(up); err != nil {
+			c.DropUp(up, err)
+			return err
+		}
+	}
 	if up.Settings.Time != nil {
 		c.scheduler.SyncWithGatewayAbsolute(up.Settings.Timestamp, up.ReceivedAt, *up.Settings.Time)
 		log.FromContext(c.ctx).WithFields(log.Fields(
@@ -195,6 +233,7 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 			AntennaIndex:       md.AntennaIndex,
 		}, md.Timestamp)
 		if err != nil {
+			c.DropUp(up, err)
 			return err
 		}
 		md.UplinkToken = buf
@@ -213,13 +252,16 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 		BandID:        c.bandID,
 	}
 
+	traffic := c.upTraffic(up)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.upCh <- msg:
 		atomic.AddUint64(&c.uplinks, 1)
 		atomic.StoreInt64(&c.lastUplinkTime, up.ReceivedAt.UnixNano())
+		c.tap(traffic, nil)
 	default:
+		c.tap(traffic, errBufferFull)
 		return errBufferFull
 	}
 	return nil
@@ -227,30 +269,93 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 
 // HandleStatus updates the status stats and sends the status to the status channel.
 func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
+	if c.limiter != nil {
+		if err := c.limiter.AllowStatus(status); err != nil {
+			c.DropStatus(status, err)
+			return err
+		}
+	}
+	traffic := c.statusTraffic(status)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.statusCh <- status:
 		c.lastStatus.Store(status)
 		atomic.StoreInt64(&c.lastStatusTime, time.Now().UnixNano())
+		c.tap(traffic, nil)
 	default:
+		c.tap(traffic, errBufferFull)
 		return errBufferFull
 	}
 	return nil
 }
 
 // HandleTxAck sends the acknowledgment to the status channel.
+// The acknowledgment is completed with the downlink message as it was requested on the downlink path of the gateway,
+// so that the sender of the downlink message can retry it.
+// If listen-before-talk failed because the channel is busy, the channel of the acknowledged downlink message is marked
+// busy in the scheduler.
 func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) error {
+	if pending, ok := c.ackPendingDownlink(ack.CorrelationIDs); ok {
+		ack.DownlinkMessage = pending.message
+		if ack.Result == ttnpb.TxAcknowledgment_CHANNEL_BUSY {
+			c.scheduler.ChannelBusy(pending.frequency)
+		}
+	}
+	traffic := c.txAckTraffic(ack)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.txAckCh <- ack:
+		c.tap(traffic, nil)
 	default:
+		c.tap(traffic, errBufferFull)
 		return errBufferFull
 	}
 	return nil
 }
 
+// addPendingDownlink adds the downlink message that is sent to the gateway to the downlink messages that are expected
+// to be acknowledged. The requested downlink message is returned in the acknowledgment.
+func (c *Connection) addPendingDownlink(msg, requested *ttnpb.DownlinkMessage) {
+	settings := msg.GetScheduled()
+	if settings == nil {
+		return
+	}
+	now := time.Now()
+	c.pendingDownlinksMu.Lock()
+	defer c.pendingDownlinksMu.Unlock()
+	for cid, pending := range c.pendingDownlinks {
+		if now.Sub(pending.sentAt) > pendingDownlinkTTL {
+			delete(c.pendingDownlinks, cid)
+		}
+	}
+	for _, cid := range msg.CorrelationIDs {
+		c.pendingDownlinks[cid] = pendingDownlink{
+			frequency: settings.Frequency,
+			message:   requested,
+			sentAt:    now,
+		}
+	}
+}
+
+// ackPendingDownlink removes and returns the pending downlink message that matches any of the given correlation IDs.
+func (c *Connection) ackPendingDownlink(correlationIDs []string) (pendingDownlink, bool) {
+	c.pendingDownlinksMu.Lock()
+	defer c.pendingDownlinksMu.Unlock()
+	for _, cid := range correlationIDs {
+		if pending, ok := c.pendingDownlinks[cid]; ok {
+			for cid, other := range c.pendingDownlinks {
+				if other == pending {
+					delete(c.pendingDownlinks, cid)
+				}
+			}
+			return pending, true
+		}
+	}
+	return pendingDownlink{}, false
+}
+
 // RecordRTT records the given round-trip time.
 func (c *Connection) RecordRTT(d time.Duration) { c.rtts.Record(d) }
 
@@ -267,6 +372,7 @@ var (
 	errDataRate         = errors.DefineInvalidArgument("data_rate", "no data rate with index `{index}`")
 	errTooLong          = errors.DefineInvalidArgument("too_long", "the payload length `{payload_length}` exceeds maximum `{maximum_length}` at data rate index `{data_rate_index}`")
 	errTxSchedule       = errors.DefineAborted("tx_schedule", "failed to schedule")
+	errTxScheduleBusy   = errors.DefineUnavailable("tx_schedule_busy", "failed to schedule because the channels are busy")
 )
 
 func getDownlinkPath(path *ttnpb.DownlinkPath, class ttnpb.Class) (ids ttnpb.GatewayAntennaIdentifiers, uplinkTimestamp uint32, err error) {
@@ -288,13 +394,16 @@ func getDownlinkPath(path *ttnpb.DownlinkPath, class ttnpb.Class) (ids ttnpb.Gat
 
 // SendDown sends the downlink message directly on the downlink channel.
 func (c *Connection) SendDown(msg *ttnpb.DownlinkMessage) error {
+	traffic := c.downTraffic(msg)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.downCh <- msg:
 		atomic.AddUint64(&c.downlinks, 1)
 		atomic.StoreInt64(&c.lastDownlinkTime, time.Now().UnixNano())
+		c.tap(traffic, nil)
 	default:
+		c.tap(traffic, errBufferFull)
 		return errBufferFull
 	}
 	return nil
@@ -477,10 +586,29 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 		for _, rxErr := range rxErrs {
 			protoErrs = append(protoErrs, ttnpb.ErrorDetailsToProto(rxErr))
 		}
-		return 0, errTxSchedule.WithDetails(&ttnpb.ScheduleDownlinkErrorDetails{
+		txErr := errTxSchedule
+		// If the channels of all Rx windows are busy, scheduling may succeed when tried again later.
+		busy := true
+		for _, rxErr := range rxErrs {
+			busy = busy && errors.IsUnavailable(rxErr)
+		}
+		if busy {
+			txErr = errTxScheduleBusy
+		}
+		return 0, txErr.WithDetails(&ttnpb.ScheduleDownlinkErrorDetails{
 			PathErrors: protoErrs,
 		})
 	}
+	pendingRequest := *request
+	pendingRequest.DownlinkPaths = []*ttnpb.DownlinkPath{path}
+	c.addPendingDownlink(msg, &ttnpb.DownlinkMessage{
+		RawPayload:     msg.RawPayload,
+		EndDeviceIDs:   msg.EndDeviceIDs,
+		CorrelationIDs: msg.CorrelationIDs,
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &pendingRequest,
+		},
+	})
 	err = c.SendDown(msg)
 	if err != nil {
 		return 0, err
@@ -542,6 +670,24 @@ func (c *Connection) RTTStats() (min, max, median time.Duration, count int) {
 	return c.rtts.Stats()
 }
 
+// Load returns the downlink load of the gateway.
+func (c *Connection) Load() *ttnpb.GatewayLoad {
+	subBands := c.scheduler.SubBands()
+	load := &ttnpb.GatewayLoad{
+		GatewayIdentifiers: c.gateway.GatewayIdentifiers,
+		SubBands:           make([]*ttnpb.GatewayLoad_SubBand, 0, len(subBands)),
+		QueuedEmissions:    uint32(c.scheduler.QueuedEmissions()),
+	}
+	for _, sb := range subBands {
+		load.SubBands = append(load.SubBands, &ttnpb.GatewayLoad_SubBand{
+			MinFrequency:         sb.MinFrequency,
+			MaxFrequency:         sb.MaxFrequency,
+			DutyCycleUtilization: sb.DutyCycleUtilization(),
+		})
+	}
+	return load
+}
+
 // FrequencyPlans returns the frequency plans for the gateway.
 func (c *Connection) FrequencyPlans() map[string]*frequencyplans.FrequencyPlan { return c.gatewayFPs }
 
@@ -16,6 +16,7 @@ package io_test
 
 import (
 	"bytes"
+	"context"
 	"testing"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
@@ -529,3 +531,316 @@ func TestSubBandEIRPOverride(t *testing.T) {
 		})
 	}
 }
+
+func TestTxAckChannelBusy(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	// Keep the channel busy beyond the Rx windows of the uplink message.
+	oldBackoff := scheduling.ChannelBusyBackoff
+	scheduling.ChannelBusyBackoff = 10 * time.Second
+	defer func() {
+		scheduling.ChannelBusyBackoff = oldBackoff
+	}()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs := mock.NewServer(c)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"}
+	gtw := &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanID:    "EU_863_870",
+	}
+	gs.RegisterGateway(ctx, ids, gtw)
+
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK),
+		},
+	})
+	frontend, err := mock.ConnectFrontend(gtwCtx, ids, gs)
+	if err != nil {
+		panic(err)
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	frontend.Up <- &ttnpb.UplinkMessage{
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				AntennaIndex: 0,
+				Timestamp:    100,
+			},
+		},
+	}
+	select {
+	case <-conn.Up():
+	case <-time.After(timeout):
+		t.Fatalf("Expected uplink message time-out")
+	}
+
+	_, err = conn.ScheduleDown(&ttnpb.DownlinkPath{
+		Path: &ttnpb.DownlinkPath_Fixed{
+			Fixed: &ttnpb.GatewayAntennaIdentifiers{
+				GatewayIdentifiers: ids,
+			},
+		},
+	}, &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x01},
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class:            ttnpb.CLASS_C,
+				Priority:         ttnpb.TxSchedulePriority_NORMAL,
+				Rx2DataRateIndex: 0,
+				Rx2Frequency:     869525000,
+				FrequencyPlanID:  test.EUFrequencyPlanID,
+			},
+		},
+		CorrelationIDs: []string{"test:downlink:1"},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case <-frontend.Down:
+	case <-time.After(timeout):
+		t.Fatalf("Expected downlink message timeout")
+	}
+
+	frontend.TxAck <- &ttnpb.TxAcknowledgment{
+		CorrelationIDs: []string{"test:downlink:1"},
+		Result:         ttnpb.TxAcknowledgment_CHANNEL_BUSY,
+	}
+	select {
+	case ack := <-conn.TxAck():
+		// The acknowledgment contains the downlink message as requested on the path of the gateway.
+		a.So(ack.DownlinkMessage, should.Resemble, &ttnpb.DownlinkMessage{
+			RawPayload: []byte{0x01},
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: &ttnpb.TxRequest{
+					Class: ttnpb.CLASS_C,
+					DownlinkPaths: []*ttnpb.DownlinkPath{
+						{
+							Path: &ttnpb.DownlinkPath_Fixed{
+								Fixed: &ttnpb.GatewayAntennaIdentifiers{
+									GatewayIdentifiers: ids,
+								},
+							},
+						},
+					},
+					Priority:         ttnpb.TxSchedulePriority_NORMAL,
+					Rx2DataRateIndex: 0,
+					Rx2Frequency:     869525000,
+					FrequencyPlanID:  test.EUFrequencyPlanID,
+				},
+			},
+			CorrelationIDs: []string{"test:downlink:1"},
+		})
+	case <-time.After(timeout):
+		t.Fatalf("Expected Tx acknowledgement time-out")
+	}
+
+	// Both Rx windows are on the busy channel.
+	_, err = conn.ScheduleDown(&ttnpb.DownlinkPath{
+		Path: &ttnpb.DownlinkPath_UplinkToken{
+			UplinkToken: io.MustUplinkToken(ttnpb.GatewayAntennaIdentifiers{GatewayIdentifiers: ids}, 100),
+		},
+	}, &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x01},
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class:            ttnpb.CLASS_A,
+				Priority:         ttnpb.TxSchedulePriority_NORMAL,
+				Rx1Delay:         ttnpb.RX_DELAY_1,
+				Rx1DataRateIndex: 5,
+				Rx1Frequency:     869525000,
+				Rx2DataRateIndex: 0,
+				Rx2Frequency:     869525000,
+				FrequencyPlanID:  test.EUFrequencyPlanID,
+			},
+		},
+	})
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+}
+
+func TestTap(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs := mock.NewServer(c)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"}
+	gs.RegisterGateway(ctx, ids, &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanID:    "EU_863_870",
+	})
+
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK),
+		},
+	})
+	frontend, err := mock.ConnectFrontend(gtwCtx, ids, gs)
+	if err != nil {
+		panic(err)
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	tapCtx, cancelTap := context.WithCancel(ctx)
+	trafficCh := conn.Tap(tapCtx)
+
+	expectTraffic := func(t *testing.T) *ttnpb.GatewayTraffic {
+		select {
+		case msg := <-trafficCh:
+			return msg
+		case <-time.After(timeout):
+			t.Fatal("Expected traffic message")
+			return nil
+		}
+	}
+
+	t.Run("Uplink", func(t *testing.T) {
+		a := assertions.New(t)
+		frontend.Up <- &ttnpb.UplinkMessage{
+			RawPayload: []byte{0x01, 0x02, 0x03},
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					Timestamp: 100,
+				},
+			},
+		}
+		<-conn.Up()
+		msg := expectTraffic(t)
+		a.So(msg.GetUplinkMessage().GetRawPayload(), should.Resemble, []byte{0x01, 0x02, 0x03})
+		a.So(msg.DropReason, should.BeNil)
+		a.So(time.Since(msg.Time), should.BeLessThan, timeout)
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		a := assertions.New(t)
+		frontend.Status <- &ttnpb.GatewayStatus{
+			Versions: map[string]string{"firmware": "1.0"},
+		}
+		<-conn.Status()
+		msg := expectTraffic(t)
+		a.So(msg.GetGatewayStatus().GetVersions(), should.Resemble, map[string]string{"firmware": "1.0"})
+	})
+
+	t.Run("Downlink", func(t *testing.T) {
+		a := assertions.New(t)
+		down := &ttnpb.DownlinkMessage{
+			RawPayload:     []byte{0x04},
+			CorrelationIDs: []string{"test"},
+		}
+		if !a.So(conn.SendDown(down), should.BeNil) {
+			t.FailNow()
+		}
+		<-frontend.Down
+		msg := expectTraffic(t)
+		a.So(msg.GetDownlinkMessage(), should.Resemble, down)
+	})
+
+	t.Run("TxAck", func(t *testing.T) {
+		a := assertions.New(t)
+		frontend.TxAck <- &ttnpb.TxAcknowledgment{
+			Result: ttnpb.TxAcknowledgment_TOO_LATE,
+		}
+		<-conn.TxAck()
+		msg := expectTraffic(t)
+		a.So(msg.GetTxAcknowledgment().GetResult(), should.Equal, ttnpb.TxAcknowledgment_TOO_LATE)
+	})
+
+	t.Run("Drop", func(t *testing.T) {
+		a := assertions.New(t)
+		errTest := errors.DefineResourceExhausted("test_tap_drop", "drop")
+		conn.DropUp(&ttnpb.UplinkMessage{RawPayload: []byte{0x05}}, errTest)
+		msg := expectTraffic(t)
+		a.So(msg.GetUplinkMessage().GetRawPayload(), should.Resemble, []byte{0x05})
+		if a.So(msg.DropReason, should.NotBeNil) {
+			a.So(msg.DropReason.Name, should.Equal, "test_tap_drop")
+		}
+	})
+
+	cancelTap()
+	time.Sleep(timeout / 2)
+	frontend.Status <- &ttnpb.GatewayStatus{}
+	<-conn.Status()
+	select {
+	case msg := <-trafficCh:
+		t.Fatalf("Unexpected traffic message %v after canceling the tap", msg)
+	case <-time.After(timeout / 2):
+	}
+	a.So(conn.Context().Err(), should.BeNil)
+}
+
+type mockLimiter struct {
+	uplinks, statuses int
+}
+
+var errTestLimited = errors.DefineResourceExhausted("test_limited", "limited")
+
+func (l *mockLimiter) AllowUplink(*ttnpb.UplinkMessage) error {
+	if l.uplinks == 0 {
+		return errTestLimited
+	}
+	l.uplinks--
+	return nil
+}
+
+func (l *mockLimiter) AllowStatus(*ttnpb.GatewayStatus) error {
+	if l.statuses == 0 {
+		return errTestLimited
+	}
+	l.statuses--
+	return nil
+}
+
+func TestUpstreamLimiter(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gtw := &ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"},
+		FrequencyPlanID:    "EU_863_870",
+	}
+	conn, err := io.NewConnection(ctx, &mock.Frontend{}, gtw, fps, true, nil, &mockLimiter{uplinks: 1, statuses: 1})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	tapCtx, cancelTap := context.WithCancel(ctx)
+	defer cancelTap()
+	trafficCh := conn.Tap(tapCtx)
+
+	up := func() *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			RawPayload: []byte{0x01},
+			RxMetadata: []*ttnpb.RxMetadata{{Timestamp: 100}},
+			ReceivedAt: time.Now(),
+		}
+	}
+	a.So(conn.HandleUp(up()), should.BeNil)
+	a.So(errors.IsResourceExhausted(conn.HandleUp(up())), should.BeTrue)
+	a.So(conn.HandleStatus(&ttnpb.GatewayStatus{}), should.BeNil)
+	a.So(errors.IsResourceExhausted(conn.HandleStatus(&ttnpb.GatewayStatus{})), should.BeTrue)
+
+	// Messages that are not allowed are dropped before they are buffered.
+	a.So(len(conn.Up()), should.Equal, 1)
+	a.So(len(conn.Status()), should.Equal, 1)
+	for _, dropped := range []bool{false, true, false, true} {
+		select {
+		case msg := <-trafficCh:
+			if dropped {
+				if a.So(msg.DropReason, should.NotBeNil) {
+					a.So(msg.DropReason.Name, should.Equal, "test_limited")
+				}
+			} else {
+				a.So(msg.DropReason, should.BeNil)
+			}
+		case <-time.After(timeout):
+			t.Fatal("Expected traffic message")
+		}
+	}
+}
@@ -32,6 +32,7 @@ type IdentityServer struct {
 	ttnpb.GatewayAccessServer
 	gateways     map[string]*ttnpb.Gateway
 	gatewayAuths map[string][]string
+	gatewayCIDRs map[string][]*net.IPNet
 }
 
 // NewIS creates and starts an instance of the IS.
@@ -39,6 +40,7 @@ func NewIS(ctx context.Context) (*IdentityServer, string) {
 	is := &IdentityServer{
 		gateways:     make(map[string]*ttnpb.Gateway),
 		gatewayAuths: make(map[string][]string),
+		gatewayCIDRs: make(map[string][]*net.IPNet),
 	}
 	srv := rpcserver.New(ctx)
 	ttnpb.RegisterGatewayRegistryServer(srv.Server, is)
@@ -62,6 +64,37 @@ func (is *IdentityServer) Add(ctx context.Context, ids ttnpb.GatewayIdentifiers,
 	}
 }
 
+// SetAllowedCIDRs restricts the rights of the gateway to requests forwarded for clients in the given CIDRs.
+func (is *IdentityServer) SetAllowedCIDRs(ctx context.Context, ids ttnpb.GatewayIdentifiers, cidrs ...string) {
+	uid := unique.ID(ctx, ids)
+	is.gatewayCIDRs[uid] = nil
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		is.gatewayCIDRs[uid] = append(is.gatewayCIDRs[uid], ipNet)
+	}
+}
+
+func (is *IdentityServer) allowedFrom(uid string, md metadata.MD) bool {
+	cidrs := is.gatewayCIDRs[uid]
+	if len(cidrs) == 0 {
+		return true
+	}
+	forwardedFor := md["x-forwarded-for"]
+	if len(forwardedFor) == 0 {
+		return false
+	}
+	addr := net.ParseIP(forwardedFor[len(forwardedFor)-1])
+	for _, cidr := range cidrs {
+		if addr != nil && cidr.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 var errNotFound = errors.DefineNotFound("not_found", "not found")
 
 // Get retrives the Gateway.
@@ -85,8 +118,9 @@ func (is *IdentityServer) ListRights(ctx context.Context, ids *ttnpb.GatewayIden
 	if !ok || len(authorization) == 0 {
 		return
 	}
-	auths, ok := is.gatewayAuths[unique.ID(ctx, *ids)]
-	if !ok {
+	uid := unique.ID(ctx, *ids)
+	auths, ok := is.gatewayAuths[uid]
+	if !ok || !is.allowedFrom(uid, md) {
 		return
 	}
 	for _, auth := range auths {
@@ -85,7 +85,7 @@ func (s *server) Connect(ctx context.Context, frontend io.Frontend, ids ttnpb.Ga
 			FrequencyPlanID:    test.EUFrequencyPlanID,
 		}
 	}
-	conn, err := io.NewConnection(ctx, frontend, gtw, s.FrequencyPlans, true, nil)
+	conn, err := io.NewConnection(ctx, frontend, gtw, s.FrequencyPlans, true, nil, nil)
 	if err != nil {
 		return nil, err
 	}
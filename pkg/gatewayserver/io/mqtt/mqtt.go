@@ -19,6 +19,7 @@ import (
 	"fmt"
 	stdio "io"
 	"net"
+	"time"
 
 	"github.com/TheThingsIndustries/mystique/pkg/auth"
 	mqttlog "github.com/TheThingsIndustries/mystique/pkg/log"
@@ -30,6 +31,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"google.golang.org/grpc/metadata"
@@ -38,21 +40,43 @@ import (
 const qosDownlink byte = 0
 
 type srv struct {
-	ctx    context.Context
-	server io.Server
-	format Format
-	lis    mqttnet.Listener
+	ctx           context.Context
+	server        io.Server
+	format        Format
+	lis           mqttnet.Listener
+	maxPacketSize uint32
+}
+
+// Option configures the MQTT frontend.
+type Option func(*srv)
+
+// WithMaximumPacketSize sets the maximum size of MQTT 5 packets that clients may send.
+// If size is zero, mqtt.DefaultMaximumPacketSize is used.
+func WithMaximumPacketSize(size uint32) Option {
+	return func(s *srv) {
+		s.maxPacketSize = size
+	}
 }
 
 // Serve serves the MQTT frontend.
-func Serve(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string) error {
+func Serve(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string, opts ...Option) error {
 	ctx = log.NewContextWithField(ctx, "namespace", "gatewayserver/io/mqtt")
 	ctx = mqttlog.NewContext(ctx, mqtt.Logger(log.FromContext(ctx)))
-	s := &srv{ctx, server, format, mqttnet.NewListener(listener, protocol)}
+	v3, v5 := mqtt.SplitListener(ctx, listener)
+	s := &srv{
+		ctx:    ctx,
+		server: server,
+		format: format,
+		lis:    mqttnet.NewListener(v3, protocol),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	go func() {
 		<-ctx.Done()
 		s.lis.Close()
 	}()
+	go s.acceptV5(v5)
 	return s.accept()
 }
 
@@ -68,6 +92,7 @@ func (s *srv) accept() error {
 
 		go func() {
 			ctx := log.NewContextWithFields(s.ctx, log.Fields("remote_addr", mqttConn.RemoteAddr().String()))
+			ctx = rpcmetadata.NewContextWithRemoteAddress(ctx, mqttConn.RemoteAddr().String())
 			conn := &connection{server: s.server, mqtt: mqttConn, format: s.format}
 			if err := conn.setup(ctx); err != nil {
 				log.FromContext(ctx).WithError(err).Warn("Failed to setup connection")
@@ -189,17 +214,46 @@ type topicAccess struct {
 	writes [][]string
 }
 
+func (a topicAccess) canRead(topicParts []string) bool {
+	for _, reads := range a.reads {
+		if topic.MatchPath(topicParts, reads) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a topicAccess) canWrite(topicParts []string) bool {
+	for _, writes := range a.writes {
+		if topic.MatchPath(topicParts, writes) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Context, error) {
+	access, err := c.connect(ctx, info.Username, string(info.Password))
+	if err != nil {
+		return nil, err
+	}
+	info.Metadata = access
+	info.Interface = c
+	return c.io.Context(), nil
+}
+
+// connect authenticates the gateway and connects it to the Gateway Server.
+func (c *connection) connect(ctx context.Context, username, password string) (topicAccess, error) {
 	ids := ttnpb.GatewayIdentifiers{
-		GatewayID: info.Username,
+		GatewayID: username,
 	}
 	if err := ids.ValidateContext(ctx); err != nil {
-		return nil, err
+		return topicAccess{}, err
 	}
 
 	md := metadata.New(map[string]string{
 		"id":            ids.GatewayID,
-		"authorization": fmt.Sprintf("Bearer %s", info.Password),
+		"authorization": fmt.Sprintf("Bearer %s", password),
 	})
 	if ctxMd, ok := metadata.FromIncomingContext(ctx); ok {
 		md = metadata.Join(ctxMd, md)
@@ -208,17 +262,17 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 
 	ctx, ids, err := c.server.FillGatewayContext(ctx, ids)
 	if err != nil {
-		return nil, err
+		return topicAccess{}, err
 	}
 
 	uid := unique.ID(ctx, ids)
 	ctx = log.NewContextWithField(ctx, "gateway_uid", uid)
 	c.io, err = c.server.Connect(ctx, c, ids)
 	if err != nil {
-		return nil, err
+		return topicAccess{}, err
 	}
 
-	access := topicAccess{
+	return topicAccess{
 		gtwUID: uid,
 		reads: [][]string{
 			c.format.DownlinkTopic(uid),
@@ -230,16 +284,16 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 			c.format.StatusTopic(uid),
 			c.format.TxAckTopic(uid),
 		},
-	}
-	info.Metadata = access
-	info.Interface = c
-	return c.io.Context(), nil
+	}, nil
 }
 
 var errNotAuthorized = errors.DefinePermissionDenied("not_authorized", "not authorized")
 
 func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
-	access := info.Metadata.(topicAccess)
+	return c.subscribe(info.Metadata.(topicAccess), requestedTopic, requestedQoS)
+}
+
+func (c *connection) subscribe(access topicAccess, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
 	acceptedTopicParts := c.format.DownlinkTopic(access.gtwUID)
 	if !topic.MatchPath(acceptedTopicParts, topic.Split(requestedTopic)) {
 		return "", 0, errNotAuthorized
@@ -250,42 +304,37 @@ func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requested
 }
 
 func (c *connection) CanRead(info *auth.Info, topicParts ...string) bool {
-	access := info.Metadata.(topicAccess)
-	for _, reads := range access.reads {
-		if topic.MatchPath(topicParts, reads) {
-			return true
-		}
-	}
-	return false
+	return info.Metadata.(topicAccess).canRead(topicParts)
 }
 
 func (c *connection) CanWrite(info *auth.Info, topicParts ...string) bool {
-	access := info.Metadata.(topicAccess)
-	for _, writes := range access.writes {
-		if topic.MatchPath(topicParts, writes) {
-			return true
-		}
-	}
-	return false
+	return info.Metadata.(topicAccess).canWrite(topicParts)
 }
 
 func (c *connection) deliver(pkt *packet.PublishPacket) {
-	logger := log.FromContext(c.io.Context()).WithField("topic", pkt.TopicName)
+	c.handleUp(pkt.TopicParts, pkt.Message, pkt.Received, nil)
+}
+
+// handleUp handles the message published by the gateway.
+// The correlation IDs are added to the handled message.
+func (c *connection) handleUp(topicParts []string, payload []byte, receivedAt time.Time, correlationIDs []string) {
+	logger := log.FromContext(c.io.Context()).WithField("topic", topic.Join(topicParts))
 	switch {
-	case c.format.IsBirthTopic(pkt.TopicParts):
-	case c.format.IsLastWillTopic(pkt.TopicParts):
-	case c.format.IsUplinkTopic(pkt.TopicParts):
-		up, err := c.format.ToUplink(pkt.Message, c.io.Gateway().GatewayIdentifiers)
+	case c.format.IsBirthTopic(topicParts):
+	case c.format.IsLastWillTopic(topicParts):
+	case c.format.IsUplinkTopic(topicParts):
+		up, err := c.format.ToUplink(payload, c.io.Gateway().GatewayIdentifiers)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal uplink message")
 			return
 		}
-		up.ReceivedAt = pkt.Received
+		up.ReceivedAt = receivedAt
+		up.CorrelationIDs = append(up.CorrelationIDs, correlationIDs...)
 		if err := c.io.HandleUp(up); err != nil {
 			logger.WithError(err).Warn("Failed to handle uplink message")
 		}
-	case c.format.IsStatusTopic(pkt.TopicParts):
-		status, err := c.format.ToStatus(pkt.Message, c.io.Gateway().GatewayIdentifiers)
+	case c.format.IsStatusTopic(topicParts):
+		status, err := c.format.ToStatus(payload, c.io.Gateway().GatewayIdentifiers)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal status message")
 			return
@@ -293,12 +342,13 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		if err := c.io.HandleStatus(status); err != nil {
 			logger.WithError(err).Warn("Failed to handle status message")
 		}
-	case c.format.IsTxAckTopic(pkt.TopicParts):
-		ack, err := c.format.ToTxAck(pkt.Message, c.io.Gateway().GatewayIdentifiers)
+	case c.format.IsTxAckTopic(topicParts):
+		ack, err := c.format.ToTxAck(payload, c.io.Gateway().GatewayIdentifiers)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal Tx acknowledgment message")
 			return
 		}
+		ack.CorrelationIDs = append(ack.CorrelationIDs, correlationIDs...)
 		if err := c.io.HandleTxAck(ack); err != nil {
 			logger.WithError(err).Warn("Failed to handle Tx acknowledgment message")
 		}
@@ -33,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/mock"
 	. "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	ttnmqtt "go.thethings.network/lorawan-stack/pkg/mqtt"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -125,6 +126,172 @@ func TestAuthentication(t *testing.T) {
 	}
 }
 
+func TestAuthenticationAllowedCIDRs(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	localGatewayID := ttnpb.GatewayIdentifiers{GatewayID: "local-gateway"}
+	remoteGatewayID := ttnpb.GatewayIdentifiers{GatewayID: "remote-gateway"}
+	is, isAddr := mock.NewIS(ctx)
+	is.Add(ctx, localGatewayID, registeredGatewayKey)
+	is.SetAllowedCIDRs(ctx, localGatewayID, "127.0.0.0/8")
+	is.Add(ctx, remoteGatewayID, registeredGatewayKey)
+	is.SetAllowedCIDRs(ctx, remoteGatewayID, "192.0.2.0/24")
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: config.Cluster{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	gs := mock.NewServer(c)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	go Serve(ctx, gs, lis, Protobuf, "tcp")
+
+	// The address of the MQTT client is forwarded to the Identity Server, which checks it against the allowed CIDRs.
+	for _, tc := range []struct {
+		UID string
+		OK  bool
+	}{
+		{
+			UID: unique.ID(ctx, localGatewayID),
+			OK:  true,
+		},
+		{
+			UID: unique.ID(ctx, remoteGatewayID),
+			OK:  false,
+		},
+	} {
+		t.Run(tc.UID, func(t *testing.T) {
+			a := assertions.New(t)
+
+			clientOpts := mqtt.NewClientOptions()
+			clientOpts.AddBroker(fmt.Sprintf("tcp://%v", lis.Addr()))
+			clientOpts.SetUsername(tc.UID)
+			clientOpts.SetPassword(registeredGatewayKey)
+			client := mqtt.NewClient(clientOpts)
+			token := client.Connect()
+			if tc.OK {
+				if !token.WaitTimeout(timeout) {
+					t.Fatal("Connection timeout")
+				}
+				if !a.So(token.Error(), should.BeNil) {
+					t.FailNow()
+				}
+			} else if token.Wait() && !a.So(token.Error(), should.NotBeNil) {
+				t.FailNow()
+			}
+			client.Disconnect(uint(timeout / time.Millisecond))
+		})
+	}
+}
+
+func TestAuthenticationV5(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, isAddr := mock.NewIS(ctx)
+	is.Add(ctx, registeredGatewayID, registeredGatewayKey)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: config.Cluster{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	gs := mock.NewServer(c)
+	lis, err := net.Listen("tcp", ":0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	go Serve(ctx, gs, lis, Protobuf, "tcp")
+
+	for _, tc := range []struct {
+		UID string
+		Key string
+		OK  bool
+	}{
+		{
+			UID: registeredGatewayUID,
+			Key: registeredGatewayKey,
+			OK:  true,
+		},
+		{
+			UID: registeredGatewayUID,
+			Key: "invalid-key",
+			OK:  false,
+		},
+		{
+			UID: "invalid-gateway",
+			Key: "invalid-key",
+			OK:  false,
+		},
+	} {
+		t.Run(fmt.Sprintf("%v:%v", tc.UID, tc.Key), func(t *testing.T) {
+			a := assertions.New(t)
+
+			conn, err := net.Dial("tcp", lis.Addr().String())
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			defer conn.Close()
+			conn.SetDeadline(time.Now().Add(timeout))
+			if !a.So(ttnmqtt.WritePacket(conn, &ttnmqtt.ConnectPacket{
+				ProtocolName:  "MQTT",
+				ProtocolLevel: ttnmqtt.ProtocolLevel5,
+				CleanStart:    true,
+				Username:      tc.UID,
+				Password:      []byte(tc.Key),
+			}), should.BeNil) {
+				t.FailNow()
+			}
+			pkt, err := ttnmqtt.ReadPacket(conn, 0)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			connack, ok := pkt.(*ttnmqtt.ConnackPacket)
+			if !a.So(ok, should.BeTrue) {
+				t.FailNow()
+			}
+			if tc.OK {
+				a.So(connack.ReasonCode, should.Equal, ttnmqtt.Success)
+				a.So(ttnmqtt.WritePacket(conn, &ttnmqtt.DisconnectPacket{}), should.BeNil)
+			} else {
+				a.So(connack.ReasonCode.IsError(), should.BeTrue)
+			}
+		})
+	}
+}
+
 func TestTraffic(t *testing.T) {
 	a := assertions.New(t)
 
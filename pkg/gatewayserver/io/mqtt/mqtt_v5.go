@@ -0,0 +1,142 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// downlinkMessageExpiry is the lifetime of downlink messages that are not scheduled at an absolute time.
+const downlinkMessageExpiry = 5 * time.Second
+
+// downlinkExpiry returns the lifetime of the downlink message.
+// The message expires when it is scheduled for transmission, or after downlinkMessageExpiry.
+func downlinkExpiry(down *ttnpb.DownlinkMessage) time.Duration {
+	if t := down.GetScheduled().GetTime(); t != nil {
+		if d := time.Until(*t); d > downlinkMessageExpiry {
+			return d
+		}
+	}
+	return downlinkMessageExpiry
+}
+
+func (s *srv) acceptV5(lis net.Listener) {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if s.ctx.Err() == nil {
+				log.FromContext(s.ctx).WithError(err).Warn("Accept failed")
+			}
+			return
+		}
+		go s.serveV5(conn)
+	}
+}
+
+func (s *srv) serveV5(netConn net.Conn) {
+	ctx := log.NewContextWithFields(s.ctx, log.Fields(
+		"remote_addr", netConn.RemoteAddr().String(),
+		"protocol_level", mqtt.ProtocolLevel5,
+	))
+	ctx = rpcmetadata.NewContextWithRemoteAddress(ctx, netConn.RemoteAddr().String())
+	c := &connectionV5{
+		connection: &connection{server: s.server, format: s.format},
+	}
+	c.session = mqtt.NewSession(ctx, netConn, c, mqtt.WithMaximumPacketSize(s.maxPacketSize))
+	sessionCtx, err := c.session.Connect()
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to setup connection")
+		netConn.Close()
+		return
+	}
+	logger := log.FromContext(sessionCtx)
+	logger.Info("Connected")
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-c.io.Context().Done():
+				err := c.io.Context().Err()
+				logger.WithError(err).Debug("Done sending downlink")
+				c.session.Disconnect(err)
+				return
+			case down := <-c.io.Down():
+				buf, err := c.format.FromDownlink(down, c.io.Gateway().GatewayIdentifiers)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to marshal downlink message")
+					continue
+				}
+				logger.Info("Publish downlink message")
+				c.session.Publish(c.format.DownlinkTopic(c.access.gtwUID), buf, mqtt.PublishOptions{
+					QoS:            qosDownlink,
+					MessageExpiry:  downlinkExpiry(down),
+					UserProperties: mqtt.CorrelationIDUserProperties(down.CorrelationIDs...),
+				})
+			}
+		}
+	}()
+
+	err = c.session.Run()
+	logger.WithError(err).Info("Disconnected")
+	c.io.Disconnect(err)
+}
+
+// connectionV5 is an MQTT 5 gateway connection.
+type connectionV5 struct {
+	*connection
+	session *mqtt.Session
+	access  topicAccess
+}
+
+// Connect implements mqtt.Handler.
+func (c *connectionV5) Connect(ctx context.Context, info *mqtt.ConnectInfo) (context.Context, error) {
+	access, err := c.connect(ctx, info.Username, string(info.Password))
+	if err != nil {
+		return nil, err
+	}
+	c.access = access
+	return c.io.Context(), nil
+}
+
+// Subscribe implements mqtt.Handler.
+func (c *connectionV5) Subscribe(ctx context.Context, topicFilter string, qos byte) (string, byte, error) {
+	return c.subscribe(c.access, topicFilter, qos)
+}
+
+// CanRead implements mqtt.Handler.
+func (c *connectionV5) CanRead(ctx context.Context, topicParts ...string) bool {
+	return c.access.canRead(topicParts)
+}
+
+// CanWrite implements mqtt.Handler.
+func (c *connectionV5) CanWrite(ctx context.Context, topicParts ...string) bool {
+	return c.access.canWrite(topicParts)
+}
+
+// Deliver implements mqtt.Handler.
+func (c *connectionV5) Deliver(ctx context.Context, topicParts []string, pkt *mqtt.PublishPacket) {
+	c.handleUp(topicParts, pkt.Payload, time.Now(), pkt.Properties.UserPropertyValues(mqtt.UserPropertyCorrelationID))
+}
@@ -0,0 +1,129 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// tapBufferSize is the number of traffic messages that are buffered per tap.
+const tapBufferSize = 1 << 6
+
+// Tap returns a channel that receives the traffic of the connection, including the dropped messages, until the
+// context or the connection is done. Messages are discarded if the receiver does not keep up.
+func (c *Connection) Tap(ctx context.Context) <-chan *ttnpb.GatewayTraffic {
+	ch := make(chan *ttnpb.GatewayTraffic, tapBufferSize)
+	c.tapsMu.Lock()
+	c.taps[ch] = struct{}{}
+	c.tapsMu.Unlock()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-c.ctx.Done():
+		}
+		c.tapsMu.Lock()
+		delete(c.taps, ch)
+		c.tapsMu.Unlock()
+	}()
+	return ch
+}
+
+func (c *Connection) tapped() bool {
+	c.tapsMu.RLock()
+	defer c.tapsMu.RUnlock()
+	return len(c.taps) > 0
+}
+
+// tap sends the traffic message to the taps of the connection. If dropErr is not nil, the message is marked as
+// dropped. A nil message is ignored.
+func (c *Connection) tap(msg *ttnpb.GatewayTraffic, dropErr error) {
+	if msg == nil {
+		return
+	}
+	msg.Time = time.Now()
+	if ttnErr, ok := errors.From(dropErr); ok {
+		msg.DropReason = ttnpb.ErrorDetailsToProto(ttnErr)
+	} else if dropErr != nil {
+		msg.DropReason = ttnpb.ErrorDetailsToProto(errors.New(dropErr.Error()))
+	}
+	c.tapsMu.RLock()
+	defer c.tapsMu.RUnlock()
+	for ch := range c.taps {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+}
+
+// upTraffic returns the traffic message of the uplink message, or nil if the connection is not tapped.
+// The correlation IDs are copied, as these are appended while the uplink message is handled.
+func (c *Connection) upTraffic(up *ttnpb.UplinkMessage) *ttnpb.GatewayTraffic {
+	if !c.tapped() {
+		return nil
+	}
+	msg := *up
+	msg.CorrelationIDs = append([]string(nil), up.CorrelationIDs...)
+	return &ttnpb.GatewayTraffic{
+		Message: &ttnpb.GatewayTraffic_UplinkMessage{UplinkMessage: &msg},
+	}
+}
+
+// statusTraffic returns the traffic message of the status message, or nil if the connection is not tapped.
+func (c *Connection) statusTraffic(status *ttnpb.GatewayStatus) *ttnpb.GatewayTraffic {
+	if !c.tapped() {
+		return nil
+	}
+	return &ttnpb.GatewayTraffic{
+		Message: &ttnpb.GatewayTraffic_GatewayStatus{GatewayStatus: status},
+	}
+}
+
+// downTraffic returns the traffic message of the downlink message, or nil if the connection is not tapped.
+func (c *Connection) downTraffic(down *ttnpb.DownlinkMessage) *ttnpb.GatewayTraffic {
+	if !c.tapped() {
+		return nil
+	}
+	return &ttnpb.GatewayTraffic{
+		Message: &ttnpb.GatewayTraffic_DownlinkMessage{DownlinkMessage: down},
+	}
+}
+
+// txAckTraffic returns the traffic message of the Tx acknowledgment, or nil if the connection is not tapped.
+// The correlation IDs are copied, as these are appended while the acknowledgment is handled.
+func (c *Connection) txAckTraffic(ack *ttnpb.TxAcknowledgment) *ttnpb.GatewayTraffic {
+	if !c.tapped() {
+		return nil
+	}
+	msg := *ack
+	msg.CorrelationIDs = append([]string(nil), ack.CorrelationIDs...)
+	return &ttnpb.GatewayTraffic{
+		Message: &ttnpb.GatewayTraffic_TxAcknowledgment{TxAcknowledgment: &msg},
+	}
+}
+
+// DropUp reports to the taps of the connection that the uplink message is dropped because of the given error.
+func (c *Connection) DropUp(up *ttnpb.UplinkMessage, err error) {
+	c.tap(c.upTraffic(up), err)
+}
+
+// DropStatus reports to the taps of the connection that the status message is dropped because of the given error.
+func (c *Connection) DropStatus(status *ttnpb.GatewayStatus, err error) {
+	c.tap(c.statusTraffic(status), err)
+}
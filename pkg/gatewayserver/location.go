@@ -0,0 +1,133 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"math"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+const (
+	// defaultUpdateGatewayLocationDebounceTime is used when no debounce time for location updates is configured.
+	defaultUpdateGatewayLocationDebounceTime = time.Hour
+	// earthRadius is the mean radius of the Earth in meters.
+	earthRadius = 6371008.8
+)
+
+// locationDistance returns the distance in meters between the given locations, including the altitude difference.
+func locationDistance(a, b ttnpb.Location) float64 {
+	toRadians := func(deg float64) float64 { return deg * math.Pi / 180 }
+	lat1, lat2 := toRadians(a.Latitude), toRadians(b.Latitude)
+	dLat, dLon := lat2-lat1, toRadians(b.Longitude-a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	surface := 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+	return math.Hypot(surface, float64(b.Altitude-a.Altitude))
+}
+
+// antennasFromStatus returns the antennas with the locations reported in the status message.
+// The locations of the given antennas are only updated when the reported location moved more than the threshold in
+// meters. Antennas for which the status reports a location but that are not registered, are added.
+// This function returns false if no antenna location changed.
+func antennasFromStatus(antennas []ttnpb.GatewayAntenna, status *ttnpb.GatewayStatus, threshold float64) ([]ttnpb.GatewayAntenna, bool) {
+	res := append([]ttnpb.GatewayAntenna(nil), antennas...)
+	var changed bool
+	for i, loc := range status.AntennaLocations {
+		if loc == nil || loc.Latitude == 0 && loc.Longitude == 0 {
+			continue
+		}
+		if i >= len(res) {
+			res = append(res, make([]ttnpb.GatewayAntenna, i-len(res)+1)...)
+		} else if locationDistance(res[i].Location, *loc) <= threshold {
+			continue
+		}
+		res[i].Location = *loc
+		changed = true
+	}
+	return res, changed
+}
+
+// updateLocationFromStatus updates the antenna locations of the gateway in the registry from the status messages that
+// are received on the connection. The registry is updated at most once per debounce time, and only if the location
+// moved more than the configured threshold. The current antennas are read from the registry before updating, so that
+// changes to the antennas made since the gateway connected are not overwritten.
+// This function blocks until the connection is closed.
+func (gs *GatewayServer) updateLocationFromStatus(conn connectionEntry) {
+	if conn.locationUpdates == nil {
+		return
+	}
+	ctx := conn.Context()
+	logger := log.FromContext(ctx)
+	ids := conn.Gateway().GatewayIdentifiers
+	antennas := conn.Gateway().Antennas
+
+	debounceTime := gs.config.UpdateGatewayLocationDebounceTime
+	if debounceTime <= 0 {
+		debounceTime = defaultUpdateGatewayLocationDebounceTime
+	}
+	var lastUpdate time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case status := <-conn.locationUpdates:
+			if time.Since(lastUpdate) < debounceTime {
+				continue
+			}
+			if _, changed := antennasFromStatus(antennas, status, gs.config.UpdateGatewayLocationThreshold); !changed {
+				continue
+			}
+			lastUpdate = time.Now()
+			registry, err := gs.getRegistry(ctx, &ids)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to get gateway registry to update location")
+				continue
+			}
+			gtw, err := registry.Get(ctx, &ttnpb.GetGatewayRequest{
+				GatewayIdentifiers: ids,
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{"antennas"},
+				},
+			}, conn.locationCallOpt)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to get gateway antennas to update location")
+				continue
+			}
+			antennas = gtw.Antennas
+			updated, changed := antennasFromStatus(antennas, status, gs.config.UpdateGatewayLocationThreshold)
+			if !changed {
+				continue
+			}
+			_, err = registry.Update(ctx, &ttnpb.UpdateGatewayRequest{
+				Gateway: ttnpb.Gateway{
+					GatewayIdentifiers: ids,
+					Antennas:           updated,
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{"antennas"},
+				},
+			}, conn.locationCallOpt)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to update gateway location")
+				continue
+			}
+			logger.Debug("Updated gateway location from status")
+			antennas = updated
+		}
+	}
+}
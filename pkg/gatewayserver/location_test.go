@@ -0,0 +1,110 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestLocationDistance(t *testing.T) {
+	a := assertions.New(t)
+
+	amsterdam := ttnpb.Location{Latitude: 52.3676, Longitude: 4.9041}
+	utrecht := ttnpb.Location{Latitude: 52.0907, Longitude: 5.1214}
+
+	a.So(gatewayserver.LocationDistance(amsterdam, amsterdam), should.Equal, float64(0))
+	a.So(gatewayserver.LocationDistance(amsterdam, utrecht), should.AlmostEqual, 34000, 500)
+	a.So(gatewayserver.LocationDistance(utrecht, amsterdam), should.AlmostEqual, 34000, 500)
+
+	elevated := amsterdam
+	elevated.Altitude = 100
+	a.So(gatewayserver.LocationDistance(amsterdam, elevated), should.AlmostEqual, 100, 0.001)
+}
+
+func TestAntennasFromStatus(t *testing.T) {
+	registered := []ttnpb.GatewayAntenna{
+		{
+			Gain:     3,
+			Location: ttnpb.Location{Latitude: 52.3676, Longitude: 4.9041, Altitude: 10, Source: ttnpb.SOURCE_REGISTRY},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		Locations []*ttnpb.Location
+		Expected  []ttnpb.GatewayAntenna
+		Changed   bool
+	}{
+		{
+			Name: "NoLocations",
+		},
+		{
+			Name: "InvalidLocation",
+			Locations: []*ttnpb.Location{
+				{Latitude: 0, Longitude: 0, Source: ttnpb.SOURCE_GPS},
+			},
+		},
+		{
+			Name: "WithinThreshold",
+			Locations: []*ttnpb.Location{
+				{Latitude: 52.36761, Longitude: 4.90411, Altitude: 10, Source: ttnpb.SOURCE_GPS},
+			},
+		},
+		{
+			Name: "Moved",
+			Locations: []*ttnpb.Location{
+				{Latitude: 52.0907, Longitude: 5.1214, Altitude: 20, Source: ttnpb.SOURCE_GPS},
+			},
+			Expected: []ttnpb.GatewayAntenna{
+				{
+					Gain:     3,
+					Location: ttnpb.Location{Latitude: 52.0907, Longitude: 5.1214, Altitude: 20, Source: ttnpb.SOURCE_GPS},
+				},
+			},
+			Changed: true,
+		},
+		{
+			Name: "NewAntenna",
+			Locations: []*ttnpb.Location{
+				nil,
+				{Latitude: 52.0907, Longitude: 5.1214, Altitude: 20, Source: ttnpb.SOURCE_GPS},
+			},
+			Expected: []ttnpb.GatewayAntenna{
+				registered[0],
+				{
+					Location: ttnpb.Location{Latitude: 52.0907, Longitude: 5.1214, Altitude: 20, Source: ttnpb.SOURCE_GPS},
+				},
+			},
+			Changed: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			antennas, changed := gatewayserver.AntennasFromStatus(registered, &ttnpb.GatewayStatus{
+				AntennaLocations: tc.Locations,
+			}, 10)
+			a.So(changed, should.Equal, tc.Changed)
+			if tc.Changed {
+				a.So(antennas, should.Resemble, tc.Expected)
+			}
+			a.So(registered[0].Location.Source, should.Equal, ttnpb.SOURCE_REGISTRY)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errRateLimited = errors.DefineResourceExhausted("rate_limited", "{message_type} rate limit of `{scope}` exceeded")
+
+// tokenBucket is a token bucket rate limiter. A nil tokenBucket does not limit.
+type tokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a new full token bucket. If the rate is not positive, this function returns nil.
+func newTokenBucket(conf RateLimitConfig) *tokenBucket {
+	if conf.Rate <= 0 {
+		return nil
+	}
+	burst := float64(conf.Burst)
+	if burst < 1 {
+		burst = math.Max(1, math.Ceil(conf.Rate))
+	}
+	return &tokenBucket{
+		rate:   conf.Rate,
+		burst:  burst,
+		tokens: burst,
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if !b.last.IsZero() && now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+}
+
+// Allow takes a token from the bucket and returns whether a token was available at the given time.
+func (b *tokenBucket) Allow(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Full returns whether the bucket is full at the given time.
+func (b *tokenBucket) Full(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	return b.tokens >= b.burst
+}
+
+// rateLimiter limits the uplinks and status messages of a scope.
+type rateLimiter struct {
+	scope  string
+	uplink *tokenBucket
+	status *tokenBucket
+}
+
+func newRateLimiter(scope string, profile RateLimitingProfile) *rateLimiter {
+	return &rateLimiter{
+		scope:  scope,
+		uplink: newTokenBucket(profile.Uplink),
+		status: newTokenBucket(profile.Status),
+	}
+}
+
+// AllowUplink returns nil if the uplink is allowed at the given time, and errRateLimited otherwise.
+func (l *rateLimiter) AllowUplink(now time.Time) error {
+	if l == nil || l.uplink.Allow(now) {
+		return nil
+	}
+	return errRateLimited.WithAttributes("message_type", "uplink", "scope", l.scope)
+}
+
+// AllowStatus returns nil if the status message is allowed at the given time, and errRateLimited otherwise.
+func (l *rateLimiter) AllowStatus(now time.Time) error {
+	if l == nil || l.status.Allow(now) {
+		return nil
+	}
+	return errRateLimited.WithAttributes("message_type", "status", "scope", l.scope)
+}
+
+// upstreamLimiter implements io.UpstreamLimiter with the rate limiters of a gateway and its frontend protocol.
+// Dropped messages are registered; the frontends log the error.
+type upstreamLimiter struct {
+	ctx      context.Context
+	gateway  *ttnpb.Gateway
+	limiters []*rateLimiter
+}
+
+// AllowUplink implements io.UpstreamLimiter.
+func (l *upstreamLimiter) AllowUplink(up *ttnpb.UplinkMessage) error {
+	for _, rl := range l.limiters {
+		if err := rl.AllowUplink(time.Now()); err != nil {
+			registerDropUplink(l.ctx, l.gateway, up, "", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// AllowStatus implements io.UpstreamLimiter.
+func (l *upstreamLimiter) AllowStatus(status *ttnpb.GatewayStatus) error {
+	for _, rl := range l.limiters {
+		if err := rl.AllowStatus(time.Now()); err != nil {
+			registerDropStatus(l.ctx, l.gateway, status, "", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// connectionLimiter limits the connection attempts of gateways and frontend protocols.
+type connectionLimiter struct {
+	gateway   RateLimitConfig
+	gateways  sync.Map // string to *tokenBucket
+	protocols map[string]*tokenBucket
+}
+
+func newConnectionLimiter(ctx context.Context, conf RateLimitingConfig) *connectionLimiter {
+	l := &connectionLimiter{
+		gateway:   conf.Gateway.Connection,
+		protocols: make(map[string]*tokenBucket),
+	}
+	for protocol, profile := range conf.Protocols() {
+		if bucket := newTokenBucket(profile.Connection); bucket != nil {
+			l.protocols[protocol] = bucket
+		}
+	}
+	if conf.Gateway.Connection.Rate > 0 {
+		go func() {
+			ticker := time.NewTicker(connectionLimiterGCInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case now := <-ticker.C:
+					l.gc(now)
+				}
+			}
+		}()
+	}
+	return l
+}
+
+// connectionLimiterGCInterval is the interval at which idle connection limiters of gateways are removed.
+var connectionLimiterGCInterval = time.Minute
+
+// Allow returns nil if the connection attempt of the gateway with the given unique ID over the given protocol is
+// allowed at the given time, and errRateLimited otherwise.
+func (l *connectionLimiter) Allow(uid, protocol string, now time.Time) error {
+	if !l.protocols[protocol].Allow(now) {
+		return errRateLimited.WithAttributes("message_type", "connection", "scope", protocol)
+	}
+	if l.gateway.Rate <= 0 {
+		return nil
+	}
+	val, _ := l.gateways.LoadOrStore(uid, newTokenBucket(l.gateway))
+	if !val.(*tokenBucket).Allow(now) {
+		return errRateLimited.WithAttributes("message_type", "connection", "scope", uid)
+	}
+	return nil
+}
+
+// gc removes the connection limiters of gateways that are full, as these are equivalent to new limiters.
+func (l *connectionLimiter) gc(now time.Time) {
+	l.gateways.Range(func(k, val interface{}) bool {
+		if val.(*tokenBucket).Full(now) {
+			l.gateways.Delete(k)
+		}
+		return true
+	})
+}
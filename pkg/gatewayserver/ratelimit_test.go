@@ -0,0 +1,128 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestTokenBucket(t *testing.T) {
+	a := assertions.New(t)
+	now := time.Unix(0, 0)
+
+	unlimited := gatewayserver.NewTokenBucket(gatewayserver.RateLimitConfig{})
+	for i := 0; i < 100; i++ {
+		a.So(unlimited.Allow(now), should.BeTrue)
+	}
+
+	bucket := gatewayserver.NewTokenBucket(gatewayserver.RateLimitConfig{
+		Rate:  2,
+		Burst: 4,
+	})
+	a.So(bucket.Full(now), should.BeTrue)
+	for i := 0; i < 4; i++ {
+		a.So(bucket.Allow(now), should.BeTrue)
+	}
+	a.So(bucket.Allow(now), should.BeFalse)
+	a.So(bucket.Full(now), should.BeFalse)
+
+	// Two tokens per second.
+	now = now.Add(500 * time.Millisecond)
+	a.So(bucket.Allow(now), should.BeTrue)
+	a.So(bucket.Allow(now), should.BeFalse)
+
+	// The bucket does not fill beyond the burst.
+	now = now.Add(time.Minute)
+	a.So(bucket.Full(now), should.BeTrue)
+	for i := 0; i < 4; i++ {
+		a.So(bucket.Allow(now), should.BeTrue)
+	}
+	a.So(bucket.Allow(now), should.BeFalse)
+
+	// The burst defaults to the rate.
+	bucket = gatewayserver.NewTokenBucket(gatewayserver.RateLimitConfig{
+		Rate: 3,
+	})
+	for i := 0; i < 3; i++ {
+		a.So(bucket.Allow(now), should.BeTrue)
+	}
+	a.So(bucket.Allow(now), should.BeFalse)
+}
+
+func TestRateLimiter(t *testing.T) {
+	a := assertions.New(t)
+	now := time.Unix(0, 0)
+
+	limiter := gatewayserver.NewRateLimiter("test-gateway", gatewayserver.RateLimitingProfile{
+		Uplink: gatewayserver.RateLimitConfig{
+			Rate:  1,
+			Burst: 2,
+		},
+	})
+	a.So(limiter.AllowUplink(now), should.BeNil)
+	a.So(limiter.AllowUplink(now), should.BeNil)
+	err := limiter.AllowUplink(now)
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+	a.So(err, should.HaveSameErrorDefinitionAs, gatewayserver.ErrRateLimited)
+
+	// Status messages are not limited.
+	for i := 0; i < 10; i++ {
+		a.So(limiter.AllowStatus(now), should.BeNil)
+	}
+}
+
+func TestConnectionLimiter(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	now := time.Unix(0, 0)
+
+	limiter := gatewayserver.NewConnectionLimiter(ctx, gatewayserver.RateLimitingConfig{
+		Gateway: gatewayserver.RateLimitingProfile{
+			Connection: gatewayserver.RateLimitConfig{
+				Rate:  0.1,
+				Burst: 2,
+			},
+		},
+		UDP: gatewayserver.RateLimitingProfile{
+			Connection: gatewayserver.RateLimitConfig{
+				Rate:  1,
+				Burst: 3,
+			},
+		},
+	})
+
+	// Gateways are limited individually.
+	a.So(limiter.Allow("gtw-1", "mqtt", now), should.BeNil)
+	a.So(limiter.Allow("gtw-1", "mqtt", now), should.BeNil)
+	a.So(errors.IsResourceExhausted(limiter.Allow("gtw-1", "mqtt", now)), should.BeTrue)
+	a.So(limiter.Allow("gtw-2", "mqtt", now), should.BeNil)
+
+	// Frontend protocols are limited for all gateways together.
+	a.So(limiter.Allow("gtw-3", "udp", now), should.BeNil)
+	a.So(limiter.Allow("gtw-4", "udp", now), should.BeNil)
+	a.So(limiter.Allow("gtw-5", "udp", now), should.BeNil)
+	a.So(errors.IsResourceExhausted(limiter.Allow("gtw-6", "udp", now)), should.BeTrue)
+
+	now = now.Add(10 * time.Second)
+	a.So(limiter.Allow("gtw-1", "mqtt", now), should.BeNil)
+	a.So(limiter.Allow("gtw-6", "udp", now), should.BeNil)
+}
@@ -0,0 +1,94 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the Gateway Server registries with Redis.
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// GatewayConnectionStatsRegistry is a Redis gateway connection stats registry.
+type GatewayConnectionStatsRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayConnectionStatsRegistry) key(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get returns the connection stats of the gateway.
+func (r *GatewayConnectionStatsRegistry) Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*ttnpb.GatewayConnectionStats, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get gateway connection stats").End()
+
+	pb := &ttnpb.GatewayConnectionStats{}
+	if err := ttnredis.GetProto(r.Redis, r.key(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Set stores the connection stats of the gateway returned by f.
+// f is called with the stored connection stats, which is nil if there are none.
+// If f returns nil, the connection stats are deleted.
+func (r *GatewayConnectionStatsRegistry) Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, f func(*ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, error)) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+
+	defer trace.StartRegion(ctx, "set gateway connection stats").End()
+
+	k := r.key(unique.ID(ctx, ids))
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		stored := &ttnpb.GatewayConnectionStats{}
+		if err := ttnredis.GetProto(tx, k).ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		stats, err := f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && stats == nil {
+			return nil
+		}
+
+		_, err = tx.Pipelined(func(p redis.Pipeliner) error {
+			if stats == nil {
+				p.Del(k)
+				return nil
+			}
+			_, err := ttnredis.SetProto(p, k, stats, 0)
+			return err
+		})
+		return err
+	}, k)
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	. "go.thethings.network/lorawan-stack/pkg/gatewayserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var _ gatewayserver.GatewayConnectionStatsRegistry = &GatewayConnectionStatsRegistry{}
+
+func TestGatewayConnectionStatsRegistry(t *testing.T) {
+	a := assertions.New(t)
+
+	cl, flush := test.NewRedis(t, "gatewayserver_test", "connection-stats")
+	defer flush()
+	defer cl.Close()
+
+	r := &GatewayConnectionStatsRegistry{Redis: cl}
+	ctx := test.Context()
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"}
+
+	_, err := r.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	connectedAt := time.Unix(1574163000, 0).UTC()
+	stats := &ttnpb.GatewayConnectionStats{
+		ConnectedAt:          &connectedAt,
+		Protocol:             "udp",
+		LastUplinkReceivedAt: &connectedAt,
+		UplinkCount:          42,
+	}
+	a.So(r.Set(ctx, ids, func(stored *ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, error) {
+		a.So(stored, should.BeNil)
+		return stats, nil
+	}), should.BeNil)
+
+	retrieved, err := r.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(retrieved, should.Resemble, stats)
+
+	disconnectedAt := connectedAt.Add(time.Hour)
+	stats.DisconnectedAt = &disconnectedAt
+	stats.DisconnectReason = &ttnpb.ErrorDetails{
+		Namespace: "pkg/gatewayserver",
+		Name:      "connection_closed",
+	}
+	a.So(r.Set(ctx, ids, func(stored *ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, error) {
+		a.So(stored, should.Resemble, retrieved)
+		return stats, nil
+	}), should.BeNil)
+
+	retrieved, err = r.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(retrieved, should.Resemble, stats)
+
+	errTest := errors.New("test")
+	a.So(r.Set(ctx, ids, func(*ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, error) {
+		return nil, errTest
+	}), should.HaveSameErrorDefinitionAs, errTest)
+	retrieved, err = r.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(retrieved, should.Resemble, stats)
+
+	a.So(r.Set(ctx, ids, func(*ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, error) {
+		return nil, nil
+	}), should.BeNil)
+	_, err = r.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
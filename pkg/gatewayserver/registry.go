@@ -0,0 +1,32 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GatewayConnectionStatsRegistry stores the connection stats of gateways, so that they are available to all Gateway
+// Server instances and after the gateway disconnects.
+type GatewayConnectionStatsRegistry interface {
+	// Get returns the connection stats of the gateway.
+	Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*ttnpb.GatewayConnectionStats, error)
+	// Set stores the connection stats of the gateway returned by f.
+	// f is called with the stored connection stats, which is nil if there are none.
+	// If f returns nil, the connection stats are deleted.
+	Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, f func(*ttnpb.GatewayConnectionStats) (*ttnpb.GatewayConnectionStats, error)) error
+}
@@ -0,0 +1,47 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+)
+
+const (
+	// BeaconPeriod is the period of Class B beacons. Beacons are transmitted when the GPS time is a multiple of the
+	// beacon period.
+	BeaconPeriod = 128 * time.Second
+	// BeaconGuard is the time before a beacon in which no downlink may be transmitted.
+	BeaconGuard = 3 * time.Second
+	// BeaconReserved is the time from the start of a beacon that is reserved for the beacon transmission.
+	BeaconReserved = 2120 * time.Millisecond
+)
+
+// beaconReservedUntil returns the end of the beacon reserved window if an emission with the given duration at gateway
+// time t overlaps with the guard or reserved window of a beacon.
+func beaconReservedUntil(t time.Time, d time.Duration) (time.Time, bool) {
+	offset := gpstime.ToGPS(t) % BeaconPeriod
+	if offset < 0 {
+		offset += BeaconPeriod
+	}
+	if offset < BeaconReserved {
+		return t.Add(BeaconReserved - offset), true
+	}
+	if offset+d > BeaconPeriod-BeaconGuard {
+		return t.Add(BeaconPeriod - offset + BeaconReserved), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,66 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+)
+
+// ChannelBusyBackoff is the time that a channel is considered busy after the gateway reported that listen-before-talk
+// failed because of activity on the channel.
+var ChannelBusyBackoff = 2 * time.Second
+
+var errChannelBusy = errors.DefineUnavailable("channel_busy", "channel `{frequency}` Hz is busy")
+
+// listenBeforeTalkScanTime returns the time that the gateway senses the channel before transmission in the given
+// frequency plan. The frequency plan takes precedence over the requirements of the band.
+func listenBeforeTalkScanTime(fp *frequencyplans.FrequencyPlan) (time.Duration, error) {
+	if fp.LBT != nil {
+		return fp.LBT.ScanTime, nil
+	}
+	phy, err := band.GetByID(fp.BandID)
+	if err != nil {
+		return 0, err
+	}
+	if phy.ListenBeforeTalk == nil {
+		return 0, nil
+	}
+	return phy.ListenBeforeTalk.ScanTime, nil
+}
+
+// ChannelBusy marks the channel with the given frequency busy for ChannelBusyBackoff.
+// Emissions on the channel that start before the backoff ends are postponed or rejected with a retryable error.
+func (s *Scheduler) ChannelBusy(frequency uint64) {
+	s.mu.Lock()
+	if s.busyChannels == nil {
+		s.busyChannels = make(map[uint64]time.Time)
+	}
+	s.busyChannels[frequency] = s.timeSource.Now().Add(ChannelBusyBackoff)
+	s.mu.Unlock()
+}
+
+// channelBusyUntil returns the server time until the channel with the given frequency is busy.
+// This method must be called with the read lock held.
+func (s *Scheduler) channelBusyUntil(frequency uint64) (time.Time, bool) {
+	until, ok := s.busyChannels[frequency]
+	if !ok || !until.After(s.timeSource.Now()) {
+		return time.Time{}, false
+	}
+	return until, true
+}
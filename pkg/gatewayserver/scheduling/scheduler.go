@@ -70,9 +70,21 @@ var (
 	errFrequencyPlansOverlapSubBand = errors.DefineInvalidArgument("frequency_plans_overlap_sub_band", "frequency plans must not have overlapping sub bands")
 )
 
+// Option configures the Scheduler.
+type Option func(*Scheduler)
+
+// WithBeaconReservation reserves the guard and reserved windows of Class B beacons.
+// The windows are only reserved when the scheduler is synchronized with the absolute gateway time, i.e. GPS time.
+func WithBeaconReservation() Option {
+	return func(s *Scheduler) {
+		s.reserveBeacons = true
+	}
+}
+
 // NewScheduler instantiates a new Scheduler for the given frequency plan.
 // If no time source is specified, the system time is used.
-func NewScheduler(ctx context.Context, fps map[string]*frequencyplans.FrequencyPlan, enforceDutyCycle bool, scheduleAnytimeDelay *time.Duration, timeSource TimeSource) (*Scheduler, error) {
+// If listen-before-talk is required by the frequency plans or their bands, the time-off-air is extended by the scan time.
+func NewScheduler(ctx context.Context, fps map[string]*frequencyplans.FrequencyPlan, enforceDutyCycle bool, scheduleAnytimeDelay *time.Duration, timeSource TimeSource, opts ...Option) (*Scheduler, error) {
 	logger := log.FromContext(ctx)
 	if timeSource == nil {
 		timeSource = SystemTimeSource
@@ -88,16 +100,27 @@ func NewScheduler(ctx context.Context, fps map[string]*frequencyplans.FrequencyP
 		scheduleAnytimeDelay = &ScheduleTimeShort
 	}
 
-	var toa *frequencyplans.TimeOffAir
+	var (
+		toa         *frequencyplans.TimeOffAir
+		lbtScanTime time.Duration
+	)
 	for _, fp := range fps {
 		if toa != nil && fp.TimeOffAir != *toa {
 			return nil, errFrequencyPlansTimeOffAir
 		}
 		toa = &fp.TimeOffAir
+		scanTime, err := listenBeforeTalkScanTime(fp)
+		if err != nil {
+			return nil, err
+		}
+		if scanTime > lbtScanTime {
+			lbtScanTime = scanTime
+		}
 	}
 
-	if toa.Duration < QueueDelay {
-		toa.Duration = QueueDelay
+	// The gateway senses the channel before each transmission, so the next emission cannot start before that time.
+	if min := QueueDelay + lbtScanTime; toa.Duration < min {
+		toa.Duration = min
 	}
 
 	s := &Scheduler{
@@ -107,6 +130,9 @@ func NewScheduler(ctx context.Context, fps map[string]*frequencyplans.FrequencyP
 		timeSource:           timeSource,
 		scheduleAnytimeDelay: *scheduleAnytimeDelay,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	if enforceDutyCycle {
 		for _, fp := range fps {
 			if subBands := fp.SubBands; len(subBands) > 0 {
@@ -181,6 +207,8 @@ type Scheduler struct {
 	mu                   sync.RWMutex
 	emissions            Emissions
 	scheduleAnytimeDelay time.Duration
+	reserveBeacons       bool
+	busyChannels         map[uint64]time.Time
 }
 
 var errSubBandNotFound = errors.DefineFailedPrecondition("sub_band_not_found", "sub-band not found for frequency `{frequency}` Hz")
@@ -212,6 +240,13 @@ func (s *Scheduler) gc(ctx context.Context) error {
 			for _, subBand := range s.subBands {
 				subBand.gc(to)
 			}
+			s.mu.Lock()
+			for frequency := range s.busyChannels {
+				if _, ok := s.channelBusyUntil(frequency); !ok {
+					delete(s.busyChannels, frequency)
+				}
+			}
+			s.mu.Unlock()
 		}
 	}
 }
@@ -231,13 +266,56 @@ func (s *Scheduler) newEmission(payloadSize int, settings ttnpb.TxSettings, star
 	return Emission{}, errDwellTime
 }
 
+// beaconConflict returns the concentrator time when the beacon reserved window ends if the given emission overlaps
+// with the guard or reserved window of a beacon.
+// This method must be called with the read lock held.
+func (s *Scheduler) beaconConflict(em Emission) (ConcentratorTime, bool) {
+	if !s.reserveBeacons {
+		return 0, false
+	}
+	t, ok := s.clock.ToGatewayTime(em.t)
+	if !ok {
+		return 0, false
+	}
+	until, ok := beaconReservedUntil(t, em.d)
+	if !ok {
+		return 0, false
+	}
+	return em.t + ConcentratorTime(until.Sub(t)), true
+}
+
 // SubBandCount returns the number of sub bands in the scheduler.
 func (s *Scheduler) SubBandCount() int {
 	return len(s.subBands)
 }
 
+// SubBands returns the sub-bands in the scheduler.
+func (s *Scheduler) SubBands() []*SubBand {
+	return s.subBands
+}
+
+// QueuedEmissions returns the number of scheduled emissions that have not started yet.
+// This method returns 0 if the clock is not synced with the server.
+func (s *Scheduler) QueuedEmissions() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.clock.IsSynced() {
+		return 0
+	}
+	now, ok := s.clock.FromServerTime(s.timeSource.Now())
+	if !ok {
+		return 0
+	}
+	n := 0
+	for i := len(s.emissions) - 1; i >= 0 && s.emissions[i].Starts() > now; i-- {
+		n++
+	}
+	return n
+}
+
 var (
 	errConflict              = errors.DefineResourceExhausted("conflict", "scheduling conflict")
+	errConflictBeacon        = errors.DefineResourceExhausted("conflict_beacon", "scheduling conflict with beacon")
 	errTooLate               = errors.DefineFailedPrecondition("too_late", "too late to transmission scheduled time (delta is `{delta}`)")
 	errNoClockSync           = errors.DefineUnavailable("no_clock_sync", "no clock sync")
 	errNoAbsoluteGatewayTime = errors.DefineAborted("no_absolute_gateway_time", "no absolute gateway time")
@@ -291,6 +369,9 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, payloadSize int, settings tt
 		if delta := time.Duration(starts - now); delta < minScheduleTime {
 			return Emission{}, errTooLate.WithAttributes("delta", delta)
 		}
+		if until, busy := s.channelBusyUntil(settings.Frequency); busy && s.clock.ToServerTime(starts).Before(until) {
+			return Emission{}, errChannelBusy.WithAttributes("frequency", settings.Frequency)
+		}
 	}
 	sb, err := s.findSubBand(settings.Frequency)
 	if err != nil {
@@ -300,6 +381,9 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, payloadSize int, settings tt
 	if err != nil {
 		return Emission{}, err
 	}
+	if _, ok := s.beaconConflict(em); ok {
+		return Emission{}, errConflictBeacon
+	}
 	for _, other := range s.emissions {
 		if em.OverlapsWithOffAir(other, s.timeOffAir) {
 			return Emission{}, errConflict
@@ -349,6 +433,13 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, payloadSize int, settin
 			settings.Timestamp += uint32(delta / time.Microsecond)
 		}
 	}
+	if until, busy := s.channelBusyUntil(settings.Frequency); busy {
+		// Postpone the emission until the channel is no longer considered busy.
+		if t, ok := s.clock.FromServerTime(until); ok && t > starts {
+			settings.Timestamp += uint32(time.Duration(t-starts) / time.Microsecond)
+			starts = t
+		}
+	}
 	sb, err := s.findSubBand(settings.Frequency)
 	if err != nil {
 		return Emission{}, err
@@ -387,6 +478,20 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, payloadSize int, settin
 		}
 		return em.t
 	}
+	if s.reserveBeacons {
+		scheduleNext := next
+		next = func() ConcentratorTime {
+			for {
+				em.t = scheduleNext()
+				until, ok := s.beaconConflict(em)
+				if !ok {
+					return em.t
+				}
+				// Schedule right after the beacon to resolve conflict.
+				em.t = until
+			}
+		}
+	}
 	em, err = sb.ScheduleAnytime(em.d, next, priority)
 	if err != nil {
 		return Emission{}, err
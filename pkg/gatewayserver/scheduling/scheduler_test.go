@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/pkg/toa"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -1003,3 +1004,217 @@ func TestSchedulingWithMultipleFrequencyPlans(t *testing.T) {
 	a.So(err, should.BeNil)
 	a.So(time.Duration(em.Starts()), should.Equal, 9*time.Second+scheduling.ScheduleTimeLong)
 }
+
+func TestScheduleWithBeaconReservation(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fps := map[string]*frequencyplans.FrequencyPlan{test.EUFrequencyPlanID: {
+		BandID: band.EU_863_870,
+	}}
+	settingsAt := func(timestamp uint32) ttnpb.TxSettings {
+		return ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+					},
+				},
+			},
+			CodingRate: "4/5",
+			Frequency:  869525000,
+			Timestamp:  timestamp,
+		}
+	}
+	// The concentrator starts at a beacon.
+	beacon := gpstime.Parse(1000 * scheduling.BeaconPeriod)
+
+	// Without beacon reservation.
+	{
+		timeSource := &mockTimeSource{
+			Time: time.Unix(0, 0),
+		}
+		scheduler, err := scheduling.NewScheduler(ctx, fps, true, nil, timeSource)
+		a.So(err, should.BeNil)
+		scheduler.SyncWithGatewayAbsolute(0, timeSource.Time, beacon)
+		_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(1000000), nil, ttnpb.TxSchedulePriority_NORMAL)
+		a.So(err, should.BeNil)
+	}
+
+	// Without GPS time.
+	{
+		timeSource := &mockTimeSource{
+			Time: time.Unix(0, 0),
+		}
+		scheduler, err := scheduling.NewScheduler(ctx, fps, true, nil, timeSource, scheduling.WithBeaconReservation())
+		a.So(err, should.BeNil)
+		scheduler.Sync(0, timeSource.Time)
+		_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(1000000), nil, ttnpb.TxSchedulePriority_NORMAL)
+		a.So(err, should.BeNil)
+	}
+
+	// With beacon reservation and GPS time.
+	{
+		timeSource := &mockTimeSource{
+			Time: time.Unix(0, 0),
+		}
+		scheduler, err := scheduling.NewScheduler(ctx, fps, true, nil, timeSource, scheduling.WithBeaconReservation())
+		a.So(err, should.BeNil)
+		scheduler.SyncWithGatewayAbsolute(0, timeSource.Time, beacon)
+
+		// In the beacon reserved window.
+		_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(1000000), nil, ttnpb.TxSchedulePriority_NORMAL)
+		a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+		// After the beacon reserved window.
+		_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(3000000), nil, ttnpb.TxSchedulePriority_NORMAL)
+		a.So(err, should.BeNil)
+
+		// Ends in the guard window of the next beacon.
+		_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(124980000), nil, ttnpb.TxSchedulePriority_NORMAL)
+		a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+		// Before the guard window of the next beacon.
+		_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(124000000), nil, ttnpb.TxSchedulePriority_NORMAL)
+		a.So(err, should.BeNil)
+
+		// Any time in the guard window gets scheduled after the beacon reserved window.
+		em, err := scheduler.ScheduleAnytime(ctx, 10, settingsAt(126000000), nil, ttnpb.TxSchedulePriority_NORMAL)
+		a.So(err, should.BeNil)
+		a.So(time.Duration(em.Starts()), should.Equal, scheduling.BeaconPeriod+scheduling.BeaconReserved)
+	}
+}
+
+func TestScheduleChannelBusy(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fps := map[string]*frequencyplans.FrequencyPlan{test.EUFrequencyPlanID: {
+		BandID: band.EU_863_870,
+	}}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, true, nil, timeSource)
+	a.So(err, should.BeNil)
+	scheduler.Sync(0, timeSource.Time)
+
+	settingsAt := func(frequency uint64, timestamp uint32) ttnpb.TxSettings {
+		return ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+					},
+				},
+			},
+			CodingRate: "4/5",
+			Frequency:  frequency,
+			Timestamp:  timestamp,
+		}
+	}
+
+	scheduler.ChannelBusy(869525000)
+
+	// The channel is busy.
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, 1000000), nil, ttnpb.TxSchedulePriority_NORMAL)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+
+	// Another channel is not busy.
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(868100000, 1000000), nil, ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+
+	// Any time gets scheduled after the channel is no longer considered busy.
+	em, err := scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 1000000), nil, ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+	a.So(time.Duration(em.Starts()), should.Equal, scheduling.ChannelBusyBackoff)
+
+	// Fast forward until after the backoff.
+	timeSource.Time = time.Unix(0, 0).Add(scheduling.ChannelBusyBackoff + time.Second)
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, uint32((scheduling.ChannelBusyBackoff+2*time.Second)/time.Microsecond)), nil, ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+}
+
+func TestScheduleWithListenBeforeTalk(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fps := map[string]*frequencyplans.FrequencyPlan{test.KRFrequencyPlanID: {
+		BandID: band.KR_920_923,
+	}}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, true, nil, timeSource)
+	a.So(err, should.BeNil)
+	scheduler.Sync(0, timeSource.Time)
+
+	settingsAt := func(timestamp uint32) ttnpb.TxSettings {
+		return ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+					},
+				},
+			},
+			CodingRate: "4/5",
+			Frequency:  922100000,
+			Timestamp:  timestamp,
+		}
+	}
+
+	em, err := scheduler.ScheduleAt(ctx, 10, settingsAt(1000000), nil, ttnpb.TxSchedulePriority_NORMAL)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The gateway cannot sense the channel in time after the previous emission.
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(uint32((time.Duration(em.Ends())+scheduling.QueueDelay)/time.Microsecond)), nil, ttnpb.TxSchedulePriority_NORMAL)
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+	// The gateway can sense the channel after the previous emission.
+	phy, err := band.GetByID(band.KR_920_923)
+	a.So(err, should.BeNil)
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(uint32((time.Duration(em.Ends())+scheduling.QueueDelay+phy.ListenBeforeTalk.ScanTime)/time.Microsecond)+1), nil, ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+}
+
+func TestQueuedEmissions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fps := map[string]*frequencyplans.FrequencyPlan{test.EUFrequencyPlanID: {
+		BandID: band.EU_863_870,
+	}}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, false, nil, timeSource)
+	a.So(err, should.BeNil)
+	a.So(scheduler.QueuedEmissions(), should.Equal, 0)
+	scheduler.Sync(0, timeSource.Time)
+
+	for _, timestamp := range []uint32{1000000, 2000000} {
+		_, err := scheduler.ScheduleAt(ctx, 10, ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+					},
+				},
+			},
+			CodingRate: "4/5",
+			Frequency:  868100000,
+			Timestamp:  timestamp,
+		}, nil, ttnpb.TxSchedulePriority_NORMAL)
+		a.So(err, should.BeNil)
+	}
+	a.So(scheduler.QueuedEmissions(), should.Equal, 2)
+
+	timeSource.Time = time.Unix(0, 0).Add(1500 * time.Millisecond)
+	a.So(scheduler.QueuedEmissions(), should.Equal, 1)
+
+	timeSource.Time = time.Unix(0, 0).Add(3 * time.Second)
+	a.So(scheduler.QueuedEmissions(), should.Equal, 0)
+}
@@ -44,6 +44,9 @@ func (c *mockClock) ToServerTime(t scheduling.ConcentratorTime) time.Time {
 func (c *mockClock) FromGatewayTime(t time.Time) (scheduling.ConcentratorTime, bool) {
 	return scheduling.ConcentratorTime(t.Sub(time.Unix(0, 0))), true
 }
+func (c *mockClock) ToGatewayTime(t scheduling.ConcentratorTime) (time.Time, bool) {
+	return time.Unix(0, 0).Add(time.Duration(t)), true
+}
 func (c *mockClock) FromTimestampTime(timestamp uint32) scheduling.ConcentratorTime {
 	return c.t + scheduling.ConcentratorTime(time.Duration(timestamp)*time.Microsecond)
 }
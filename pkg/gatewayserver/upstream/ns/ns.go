@@ -54,6 +54,12 @@ func (h *Handler) GetDevAddrPrefixes() []types.DevAddrPrefix {
 	return h.devAddrPrefixes
 }
 
+// GetJoinEUIPrefixes implements upstream.Handler.
+// The Network Servers in the cluster handle all join-requests.
+func (h *Handler) GetJoinEUIPrefixes() []types.EUI64Prefix {
+	return []types.EUI64Prefix{{}}
+}
+
 // Setup implements upstream.Handler.
 func (h *Handler) Setup(context.Context) error {
 	return nil
@@ -91,3 +97,21 @@ func (h *Handler) HandleUplink(ctx context.Context, _ ttnpb.GatewayIdentifiers,
 func (h *Handler) HandleStatus(context.Context, ttnpb.GatewayIdentifiers, *ttnpb.GatewayStatus) error {
 	return nil
 }
+
+// HandleTxAck implements upstream.Handler.
+// The acknowledgment is reported to the Network Server of the end device of the acknowledged downlink message.
+func (h *Handler) HandleTxAck(ctx context.Context, ids ttnpb.GatewayIdentifiers, msg *ttnpb.TxAcknowledgment) error {
+	devIDs := msg.GetDownlinkMessage().GetEndDeviceIDs()
+	if devIDs == nil {
+		return nil
+	}
+	nsConn, err := h.cluster.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, *devIDs)
+	if err != nil {
+		return errNetworkServerNotFound.WithCause(err)
+	}
+	_, err = ttnpb.NewGsNsClient(nsConn).ReportTxAcknowledgment(ctx, &ttnpb.GatewayTxAcknowledgment{
+		GatewayIdentifiers: ids,
+		TxAck:              msg,
+	}, h.cluster.WithClusterAuth())
+	return err
+}
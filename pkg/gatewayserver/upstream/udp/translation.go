@@ -0,0 +1,133 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	encoding "go.thethings.network/lorawan-stack/pkg/ttnpb/udp"
+)
+
+// fromUplinkMessage converts the uplink message to the UDP format.
+func fromUplinkMessage(msg *ttnpb.UplinkMessage) *encoding.RxPacket {
+	rxs, _, _ := encoding.FromGatewayUp(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{msg},
+	})
+	rx := rxs[0]
+	// The CRC status is not known past the frontend. Hosts discard packets that are not marked as valid.
+	rx.Stat = 1
+	if t := msg.RxMetadata[0].Time; t != nil {
+		ct := encoding.CompactTime(*t)
+		rx.Time = &ct
+	}
+	return rx
+}
+
+// fromGatewayStatus converts the gateway status to the UDP format.
+func fromGatewayStatus(status *ttnpb.GatewayStatus) *encoding.Stat {
+	stat := &encoding.Stat{
+		Time: encoding.ExpandedTime(status.Time),
+		RxNb: uint32(status.Metrics["rxin"]),
+		RxOk: uint32(status.Metrics["rxok"]),
+		RxFW: uint32(status.Metrics["rxfw"]),
+		ACKR: float64(status.Metrics["ackr"]),
+		DWNb: uint32(status.Metrics["txin"]),
+		TxNb: uint32(status.Metrics["txok"]),
+	}
+	if !status.BootTime.IsZero() {
+		boot := encoding.ExpandedTime(status.BootTime)
+		stat.Boot = &boot
+	}
+	if len(status.AntennaLocations) > 0 && status.AntennaLocations[0] != nil {
+		loc := status.AntennaLocations[0]
+		stat.Lati, stat.Long, stat.Alti = &loc.Latitude, &loc.Longitude, &loc.Altitude
+	}
+	return stat
+}
+
+var (
+	errDataRate      = errors.DefineInvalidArgument("data_rate", "unknown data rate `{data_rate}` in band `{band_id}`")
+	errUplinkMissing = errors.DefineNotFound("uplink_missing", "no uplink found for downlink at timestamp `{timestamp}`")
+)
+
+// toDownlinkMessage converts the UDP Tx packet to a downlink message with a Tx request that can be scheduled on the
+// gateway connection.
+// Packets that are sent immediately or at GPS time are scheduled as class C downlinks. Packets that are sent at a
+// concentrator timestamp are matched with a recent uplink and scheduled as class A downlinks in its first receive window.
+func toDownlinkMessage(gtw *gatewayConn, tx *encoding.TxPacket) (*ttnpb.DownlinkMessage, *ttnpb.DownlinkPath, error) {
+	payload, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(tx.Data, "="))
+	if err != nil {
+		return nil, nil, err
+	}
+	phy, err := band.GetByID(gtw.conn.BandID())
+	if err != nil {
+		return nil, nil, err
+	}
+	drIdx, _, ok := phy.FindDataRate(tx.DatR.DataRate)
+	if !ok {
+		return nil, nil, errDataRate.WithAttributes(
+			"data_rate", tx.DatR.DataRate,
+			"band_id", phy.ID,
+		)
+	}
+	req := &ttnpb.TxRequest{
+		Rx1DataRateIndex: drIdx,
+		Rx1Frequency:     uint64(tx.Freq * 1000000),
+		Priority:         ttnpb.TxSchedulePriority_NORMAL,
+	}
+	if fpIDs := gtw.conn.Gateway().FrequencyPlanIDs; len(fpIDs) > 0 {
+		req.FrequencyPlanID = fpIDs[0]
+	}
+	var path *ttnpb.DownlinkPath
+	switch {
+	case tx.Imme || tx.Tmms != nil:
+		req.Class = ttnpb.CLASS_C
+		if tx.Tmms != nil {
+			t := gpstime.Parse(time.Duration(*tx.Tmms) * time.Millisecond)
+			req.AbsoluteTime = &t
+		}
+		path = &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_Fixed{
+				Fixed: &ttnpb.GatewayAntennaIdentifiers{
+					GatewayIdentifiers: gtw.conn.Gateway().GatewayIdentifiers,
+				},
+			},
+		}
+	default:
+		up, delay, ok := gtw.matchUplink(tx.Tmst)
+		if !ok {
+			return nil, nil, errUplinkMissing.WithAttributes("timestamp", tx.Tmst)
+		}
+		req.Class = ttnpb.CLASS_A
+		req.Rx1Delay = delay
+		path = &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_UplinkToken{
+				UplinkToken: up.token,
+			},
+		}
+	}
+	return &ttnpb.DownlinkMessage{
+		RawPayload: payload,
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: req,
+		},
+	}, path, nil
+}
@@ -0,0 +1,335 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package udp implements the upstream.Handler interface for hosts that speak the Semtech UDP packet forwarder protocol.
+package udp
+
+import (
+	"context"
+	"crypto/rand"
+	"net"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	encoding "go.thethings.network/lorawan-stack/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// Config contains configuration settings for the Semtech UDP upstream.
+// Use DefaultConfig for recommended settings.
+type Config struct {
+	// KeepAliveInterval defines the interval of PULL_DATA messages that keep the downlink path from the host open.
+	KeepAliveInterval time.Duration `name:"keep-alive-interval" description:"Interval of PULL_DATA messages to keep the downlink path open"`
+	// UplinkHistory defines how many uplink timestamps per gateway are kept to match class A downlinks from the host.
+	UplinkHistory int `name:"uplink-history" description:"Number of uplink timestamps per gateway to match class A downlinks"`
+}
+
+// DefaultConfig contains the default configuration.
+var DefaultConfig = Config{
+	KeepAliveInterval: 5 * time.Second, // The Semtech packet forwarder reference implementation pulls data every 5 seconds.
+	UplinkHistory:     1 << 4,
+}
+
+// Handler is the upstream handler.
+type Handler struct {
+	ctx             context.Context
+	name            string
+	address         string
+	config          Config
+	devAddrPrefixes []types.DevAddrPrefix
+	joinEUIPrefixes []types.EUI64Prefix
+
+	remoteAddr *net.UDPAddr
+	gateways   sync.Map
+}
+
+// NewHandler returns a new upstream handler that forwards traffic to the Semtech UDP host at the given address.
+func NewHandler(ctx context.Context, name, address string, devAddrPrefixes []types.DevAddrPrefix, joinEUIPrefixes []types.EUI64Prefix, config Config) *Handler {
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"namespace", "gatewayserver/upstream/udp",
+		"upstream", name,
+	))
+	return &Handler{
+		ctx:             ctx,
+		name:            name,
+		address:         address,
+		config:          config,
+		devAddrPrefixes: devAddrPrefixes,
+		joinEUIPrefixes: joinEUIPrefixes,
+	}
+}
+
+// GetDevAddrPrefixes implements upstream.Handler.
+func (h *Handler) GetDevAddrPrefixes() []types.DevAddrPrefix {
+	return h.devAddrPrefixes
+}
+
+// GetJoinEUIPrefixes implements upstream.Handler.
+func (h *Handler) GetJoinEUIPrefixes() []types.EUI64Prefix {
+	return h.joinEUIPrefixes
+}
+
+var errResolveAddress = errors.DefineInvalidArgument("resolve_address", "failed to resolve address `{address}`")
+
+// Setup implements upstream.Handler.
+func (h *Handler) Setup(context.Context) error {
+	addr, err := net.ResolveUDPAddr("udp", h.address)
+	if err != nil {
+		return errResolveAddress.WithCause(err).WithAttributes("address", h.address)
+	}
+	h.remoteAddr = addr
+	return nil
+}
+
+type gatewayConn struct {
+	eui     types.EUI64
+	conn    *io.Connection
+	udpConn *net.UDPConn
+
+	uplinksMu sync.Mutex
+	uplinks   []uplink
+	uplinkIdx int
+}
+
+type uplink struct {
+	timestamp uint32
+	token     []byte
+}
+
+// recordUplink stores the concentrator timestamp and the uplink token of the given uplink message.
+func (c *gatewayConn) recordUplink(md *ttnpb.RxMetadata) {
+	c.uplinksMu.Lock()
+	if len(c.uplinks) == 0 {
+		c.uplinksMu.Unlock()
+		return
+	}
+	c.uplinks[c.uplinkIdx] = uplink{
+		timestamp: md.Timestamp,
+		token:     md.UplinkToken,
+	}
+	c.uplinkIdx = (c.uplinkIdx + 1) % len(c.uplinks)
+	c.uplinksMu.Unlock()
+}
+
+// matchUplink returns the most recent uplink that the given concentrator timestamp is a class A receive window of.
+func (c *gatewayConn) matchUplink(timestamp uint32) (uplink, ttnpb.RxDelay, bool) {
+	c.uplinksMu.Lock()
+	defer c.uplinksMu.Unlock()
+	for i := 1; i <= len(c.uplinks); i++ {
+		up := c.uplinks[(c.uplinkIdx-i+len(c.uplinks))%len(c.uplinks)]
+		if up.token == nil {
+			continue
+		}
+		delta := time.Duration(timestamp-up.timestamp) * time.Microsecond
+		if delta%time.Second != 0 {
+			continue
+		}
+		if delay := ttnpb.RxDelay(delta / time.Second); delay >= ttnpb.RX_DELAY_1 && delay <= ttnpb.RX_DELAY_15 {
+			return up, delay, true
+		}
+	}
+	return uplink{}, 0, false
+}
+
+func (c *gatewayConn) write(packet encoding.Packet) error {
+	// TX_ACK packets carry the token of the PULL_RESP packet they acknowledge.
+	if packet.PacketType != encoding.TxAck {
+		if _, err := rand.Read(packet.Token[:]); err != nil {
+			return err
+		}
+	}
+	packet.ProtocolVersion = encoding.Version2
+	buf, err := packet.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = c.udpConn.Write(buf)
+	return err
+}
+
+var errDial = errors.DefineUnavailable("dial", "failed to dial `{address}`")
+
+// ConnectGateway implements upstream.Handler.
+// The handler opens a UDP socket to the host for each gateway, as the host identifies the gateway by its EUI and
+// sends downlinks to the address of the most recent PULL_DATA message. This method blocks until the gateway disconnects.
+func (h *Handler) ConnectGateway(ctx context.Context, ids ttnpb.GatewayIdentifiers, conn *io.Connection) error {
+	logger := log.FromContext(h.ctx).WithField("gateway_uid", unique.ID(ctx, ids))
+	eui := conn.Gateway().EUI
+	if eui == nil {
+		logger.Debug("No gateway EUI, do not connect to upstream")
+		return nil
+	}
+	udpConn, err := net.DialUDP("udp", nil, h.remoteAddr)
+	if err != nil {
+		return errDial.WithCause(err).WithAttributes("address", h.address)
+	}
+	defer udpConn.Close()
+
+	gtw := &gatewayConn{
+		eui:     *eui,
+		conn:    conn,
+		udpConn: udpConn,
+		uplinks: make([]uplink, h.config.UplinkHistory),
+	}
+	uid := unique.ID(ctx, ids)
+	h.gateways.Store(uid, gtw)
+	defer func() {
+		if val, ok := h.gateways.Load(uid); ok && val.(*gatewayConn) == gtw {
+			h.gateways.Delete(uid)
+		}
+	}()
+
+	ctx = log.NewContext(ctx, logger)
+	go h.readLoop(ctx, gtw)
+
+	ticker := time.NewTicker(h.config.KeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		if err := gtw.write(encoding.Packet{
+			PacketType: encoding.PullData,
+			GatewayEUI: &gtw.eui,
+		}); err != nil {
+			logger.WithError(err).Warn("Failed to send PULL_DATA")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Handler) readLoop(ctx context.Context, gtw *gatewayConn) {
+	logger := log.FromContext(ctx)
+	var buf [65507]byte
+	for {
+		n, err := gtw.udpConn.Read(buf[:])
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.WithError(err).Warn("Read failed")
+			}
+			return
+		}
+		var packet encoding.Packet
+		if err := packet.UnmarshalBinary(buf[:n]); err != nil {
+			logger.WithError(err).Debug("Failed to unmarshal packet")
+			continue
+		}
+		logger := logger.WithField("packet_type", packet.PacketType)
+		switch packet.PacketType {
+		case encoding.PushAck, encoding.PullAck:
+			logger.Debug("Received acknowledgment")
+		case encoding.PullResp:
+			if packet.Data == nil || packet.Data.TxPacket == nil {
+				logger.Debug("No Tx packet in PULL_RESP")
+				continue
+			}
+			ack := encoding.TxPacketAck{Error: encoding.TxErrNone}
+			if err := h.handleDownlink(gtw, packet.Data.TxPacket); err != nil {
+				logger.WithError(err).Debug("Failed to schedule downlink")
+				ack.Error = encoding.TxErrCollisionPacket
+			}
+			if packet.ProtocolVersion == encoding.Version1 {
+				continue
+			}
+			if err := gtw.write(encoding.Packet{
+				Token:      packet.Token,
+				PacketType: encoding.TxAck,
+				GatewayEUI: &gtw.eui,
+				Data: &encoding.Data{
+					TxPacketAck: &ack,
+				},
+			}); err != nil {
+				logger.WithError(err).Warn("Failed to send TX_ACK")
+			}
+		default:
+			logger.Debug("Invalid packet type for downlink")
+		}
+	}
+}
+
+func (h *Handler) handleDownlink(gtw *gatewayConn, tx *encoding.TxPacket) error {
+	msg, path, err := toDownlinkMessage(gtw, tx)
+	if err != nil {
+		return err
+	}
+	_, err = gtw.conn.ScheduleDown(path, msg)
+	return err
+}
+
+var (
+	errNotConnected = errors.DefineUnavailable("not_connected", "gateway `{gateway_uid}` not connected to upstream")
+	errNoRxMetadata = errors.DefineInvalidArgument("no_rx_metadata", "no Rx metadata")
+	errWrite        = errors.DefineUnavailable("write", "failed to write to upstream")
+)
+
+func (h *Handler) getGateway(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*gatewayConn, error) {
+	uid := unique.ID(ctx, ids)
+	val, ok := h.gateways.Load(uid)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	return val.(*gatewayConn), nil
+}
+
+// HandleUplink implements upstream.Handler.
+func (h *Handler) HandleUplink(ctx context.Context, gtwIDs ttnpb.GatewayIdentifiers, _ ttnpb.EndDeviceIdentifiers, msg *ttnpb.GatewayUplinkMessage) error {
+	if len(msg.RxMetadata) == 0 {
+		return errNoRxMetadata
+	}
+	gtw, err := h.getGateway(ctx, gtwIDs)
+	if err != nil {
+		return err
+	}
+	gtw.recordUplink(msg.RxMetadata[0])
+	if err := gtw.write(encoding.Packet{
+		PacketType: encoding.PushData,
+		GatewayEUI: &gtw.eui,
+		Data: &encoding.Data{
+			RxPacket: []*encoding.RxPacket{fromUplinkMessage(msg.UplinkMessage)},
+		},
+	}); err != nil {
+		return errWrite.WithCause(err)
+	}
+	return nil
+}
+
+// HandleStatus implements upstream.Handler.
+func (h *Handler) HandleStatus(ctx context.Context, gtwIDs ttnpb.GatewayIdentifiers, status *ttnpb.GatewayStatus) error {
+	gtw, err := h.getGateway(ctx, gtwIDs)
+	if err != nil {
+		return err
+	}
+	if err := gtw.write(encoding.Packet{
+		PacketType: encoding.PushData,
+		GatewayEUI: &gtw.eui,
+		Data: &encoding.Data{
+			Stat: fromGatewayStatus(status),
+		},
+	}); err != nil {
+		return errWrite.WithCause(err)
+	}
+	return nil
+}
+
+// HandleTxAck implements upstream.Handler.
+// Downlink messages from the upstream hosts are not requested through this handler.
+func (h *Handler) HandleTxAck(context.Context, ttnpb.GatewayIdentifiers, *ttnpb.TxAcknowledgment) error {
+	return nil
+}
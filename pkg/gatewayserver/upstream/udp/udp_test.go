@@ -0,0 +1,269 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/mock"
+	. "go.thethings.network/lorawan-stack/pkg/gatewayserver/upstream/udp"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	encoding "go.thethings.network/lorawan-stack/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/datarate"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var timeout = (1 << 5) * test.Delay
+
+// readPacket reads the next packet of the given type from the host connection.
+func readPacket(t *testing.T, conn *net.UDPConn, packetType encoding.PacketType) (encoding.Packet, *net.UDPAddr) {
+	var buf [65507]byte
+	conn.SetReadDeadline(time.Now().Add(timeout))
+	for {
+		n, addr, err := conn.ReadFromUDP(buf[:])
+		if err != nil {
+			t.Fatalf("Failed to read %s packet: %v", packetType, err)
+		}
+		var packet encoding.Packet
+		if err := packet.UnmarshalBinary(buf[:n]); err != nil {
+			t.Fatalf("Failed to unmarshal packet: %v", err)
+		}
+		if packet.PacketType == packetType {
+			return packet, addr
+		}
+	}
+}
+
+func writePacket(t *testing.T, conn *net.UDPConn, addr *net.UDPAddr, packet encoding.Packet) {
+	buf, err := packet.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Failed to marshal packet: %v", err)
+	}
+	if _, err := conn.WriteToUDP(buf, addr); err != nil {
+		t.Fatalf("Failed to write packet: %v", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	host, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer host.Close()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	gs := mock.NewServer(c)
+
+	eui := types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01}
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "test-gateway", EUI: &eui}
+	gs.RegisterGateway(ctx, ids, &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanID:    test.EUFrequencyPlanID,
+	})
+	gtwCtx := rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_LINK),
+		},
+	})
+	frontend, err := mock.ConnectFrontend(gtwCtx, ids, gs)
+	if err != nil {
+		t.Fatalf("Failed to connect frontend: %v", err)
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	devAddrPrefixes := []types.DevAddrPrefix{{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16}}
+	joinEUIPrefixes := []types.EUI64Prefix{{EUI64: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 40}}
+	h := NewHandler(ctx, "legacy", host.LocalAddr().String(), devAddrPrefixes, joinEUIPrefixes, DefaultConfig)
+	a.So(h.GetDevAddrPrefixes(), should.Resemble, devAddrPrefixes)
+	a.So(h.GetJoinEUIPrefixes(), should.Resemble, joinEUIPrefixes)
+	if err := h.Setup(ctx); err != nil {
+		t.Fatalf("Failed to setup handler: %v", err)
+	}
+
+	// Traffic cannot be handled before the gateway is connected.
+	err = h.HandleStatus(ctx, ids, &ttnpb.GatewayStatus{})
+	a.So(err, should.NotBeNil)
+
+	go h.ConnectGateway(conn.Context(), ids, conn)
+
+	pullData, gtwAddr := readPacket(t, host, encoding.PullData)
+	a.So(pullData.GatewayEUI, should.Resemble, &eui)
+
+	frontend.Up <- &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x00, 0x01, 0x26, 0x00, 0x01, 0x00, 0x01, 0x42, 0x42, 0x42, 0x42},
+		Settings: ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+				SpreadingFactor: 7,
+				Bandwidth:       125000,
+			}}},
+			CodingRate: "4/5",
+			Frequency:  868100000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ids,
+				Timestamp:          1000000,
+				RSSI:               -42,
+				SNR:                7.5,
+			},
+		},
+	}
+	var up *ttnpb.GatewayUplinkMessage
+	select {
+	case msg := <-conn.Up():
+		up = msg
+	case <-time.After(timeout):
+		t.Fatal("Expected uplink message time-out")
+	}
+
+	t.Run("Uplink", func(t *testing.T) {
+		a := assertions.New(t)
+		err := h.HandleUplink(ctx, ids, ttnpb.EndDeviceIdentifiers{}, up)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		pushData, _ := readPacket(t, host, encoding.PushData)
+		a.So(pushData.GatewayEUI, should.Resemble, &eui)
+		if !a.So(pushData.Data, should.NotBeNil) || !a.So(pushData.Data.RxPacket, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		rx := pushData.Data.RxPacket[0]
+		a.So(rx.Data, should.Equal, base64.StdEncoding.EncodeToString(up.RawPayload))
+		a.So(rx.Tmst, should.Equal, 1000000)
+		a.So(rx.Freq, should.Equal, 868.1)
+		a.So(rx.Stat, should.Equal, 1)
+		a.So(rx.RSSI, should.Equal, -42)
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		a := assertions.New(t)
+		err := h.HandleStatus(ctx, ids, &ttnpb.GatewayStatus{
+			Time: time.Unix(1575000000, 0),
+			Metrics: map[string]float32{
+				"rxin": 2,
+				"rxok": 1,
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		pushData, _ := readPacket(t, host, encoding.PushData)
+		if !a.So(pushData.Data, should.NotBeNil) || !a.So(pushData.Data.Stat, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(time.Time(pushData.Data.Stat.Time).Unix(), should.Equal, 1575000000)
+		a.So(pushData.Data.Stat.RxNb, should.Equal, 2)
+		a.So(pushData.Data.Stat.RxOk, should.Equal, 1)
+	})
+
+	for _, tc := range []struct {
+		Name          string
+		TxPacket      *encoding.TxPacket
+		ExpectedError encoding.TxError
+		AssertDown    func(a *assertions.Assertion, msg *ttnpb.DownlinkMessage)
+	}{
+		{
+			Name: "ClassA",
+			TxPacket: &encoding.TxPacket{
+				Tmst: 2000000,
+				Freq: 868.1,
+				Modu: "LORA",
+				DatR: datarate.DR{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+					SpreadingFactor: 7,
+					Bandwidth:       125000,
+				}}}},
+				CodR: "4/5",
+				IPol: true,
+				Size: 4,
+				Data: "AQIDBA==",
+			},
+			ExpectedError: encoding.TxErrNone,
+			AssertDown: func(a *assertions.Assertion, msg *ttnpb.DownlinkMessage) {
+				a.So(msg.RawPayload, should.Resemble, []byte{0x01, 0x02, 0x03, 0x04})
+				scheduled := msg.GetScheduled()
+				if !a.So(scheduled, should.NotBeNil) {
+					return
+				}
+				a.So(scheduled.Timestamp, should.Equal, 2000000)
+				a.So(scheduled.Frequency, should.Equal, 868100000)
+			},
+		},
+		{
+			Name: "NoMatchingUplink",
+			TxPacket: &encoding.TxPacket{
+				Tmst: 2500000,
+				Freq: 868.1,
+				Modu: "LORA",
+				DatR: datarate.DR{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+					SpreadingFactor: 7,
+					Bandwidth:       125000,
+				}}}},
+				CodR: "4/5",
+				IPol: true,
+				Size: 4,
+				Data: "AQIDBA==",
+			},
+			ExpectedError: encoding.TxErrCollisionPacket,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			token := [2]byte{0x42, 0x01}
+			writePacket(t, host, gtwAddr, encoding.Packet{
+				ProtocolVersion: encoding.Version2,
+				Token:           token,
+				PacketType:      encoding.PullResp,
+				Data: &encoding.Data{
+					TxPacket: tc.TxPacket,
+				},
+			})
+			txAck, _ := readPacket(t, host, encoding.TxAck)
+			a.So(txAck.Token, should.Equal, token)
+			a.So(txAck.GatewayEUI, should.Resemble, &eui)
+			if !a.So(txAck.Data, should.NotBeNil) || !a.So(txAck.Data.TxPacketAck, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(txAck.Data.TxPacketAck.Error, should.Equal, tc.ExpectedError)
+			if tc.AssertDown == nil {
+				return
+			}
+			select {
+			case msg := <-frontend.Down:
+				tc.AssertDown(a, msg)
+			case <-time.After(timeout):
+				t.Fatal("Expected downlink message time-out")
+			}
+		})
+	}
+}
@@ -26,6 +26,8 @@ import (
 type Handler interface {
 	// GetDevAddrPrefixes returns the device addr prefixes for this upstream handler. It's used to claim an uplink based on it's DevAddr.
 	GetDevAddrPrefixes() []types.DevAddrPrefix
+	// GetJoinEUIPrefixes returns the JoinEUI prefixes for this upstream handler. It's used to claim a join-request based on it's JoinEUI.
+	GetJoinEUIPrefixes() []types.EUI64Prefix
 	// Setup performs all the preparation necessary to connect the handler to a particular upstream host.
 	Setup(context.Context) error
 	// ConnectGateway informs the upstream handler that a particular gateway is connected to the front end.
@@ -34,4 +36,6 @@ type Handler interface {
 	HandleUplink(context.Context, ttnpb.GatewayIdentifiers, ttnpb.EndDeviceIdentifiers, *ttnpb.GatewayUplinkMessage) error
 	// HandleStatus handles ttnpb.GatewayStatus.
 	HandleStatus(context.Context, ttnpb.GatewayIdentifiers, *ttnpb.GatewayStatus) error
+	// HandleTxAck handles ttnpb.TxAcknowledgment of downlink messages that are requested through the upstream handler.
+	HandleTxAck(context.Context, ttnpb.GatewayIdentifiers, *ttnpb.TxAcknowledgment) error
 }
@@ -0,0 +1,94 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/email"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// notifyExpiringAPIKeys periodically notifies the owners of API keys that are about to expire.
+// Each API key is notified only once, unless its expiry is changed.
+func (is *IdentityServer) notifyExpiringAPIKeys(ctx context.Context) error {
+	config := is.configFromContext(ctx).APIKeys.ExpiryNotification
+	interval := config.Interval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := is.notifyExpiringAPIKeysBefore(ctx, time.Now().Add(config.Before)); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to notify expiring API keys")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (is *IdentityServer) notifyExpiringAPIKeysBefore(ctx context.Context, before time.Time) error {
+	var keys []store.ExpiringAPIKey
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		keys, err = store.GetAPIKeyStore(db).FindExpiringAPIKeys(ctx, before)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		var notify bool
+		err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+			notify, err = store.GetAPIKeyStore(db).SetAPIKeyExpiryNotified(ctx, key.ID)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if !notify {
+			continue // Another instance already notified about this API key.
+		}
+		ids := key.EntityIdentifiers.EntityIdentifiers()
+		makeMessage := func(data emails.Data) email.MessageData {
+			data.SetEntity(ids)
+			return &emails.APIKeyExpiring{Data: data, Identifier: key.PrettyName(), ExpiresAt: *key.ExpiresAt}
+		}
+		logger := log.FromContext(ctx).WithFields(log.Fields(
+			"entity_type", ids.EntityType(),
+			"entity_id", ids.IDString(),
+			"api_key_id", key.ID,
+		))
+		if usrIDs, ok := key.EntityIdentifiers.(*ttnpb.UserIdentifiers); ok {
+			err = is.SendUserEmail(ctx, usrIDs, makeMessage)
+		} else {
+			err = is.SendContactsEmail(ctx, ids, makeMessage)
+		}
+		if err != nil {
+			logger.WithError(err).Error("Could not send API key expiry notification email")
+			continue
+		}
+		logger.Debug("Sent API key expiry notification email")
+	}
+	return nil
+}
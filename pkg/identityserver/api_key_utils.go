@@ -16,8 +16,11 @@ package identityserver
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -42,3 +45,45 @@ func generateAPIKey(ctx context.Context, name string, rights ...ttnpb.Right) (ke
 	}
 	return key, token, nil
 }
+
+var (
+	errAPIKeyCIDR      = errors.DefineInvalidArgument("api_key_cidr", "invalid allowed CIDR `{cidr}`")
+	errAPIKeyExpiresAt = errors.DefineInvalidArgument("api_key_expires_at", "API key expiry `{expires_at}` is in the past")
+)
+
+// validateAPIKey validates the expiry and allowed CIDRs of the API key.
+func validateAPIKey(key *ttnpb.APIKey, fieldMask ...string) error {
+	if len(fieldMask) == 0 || ttnpb.HasAnyField(fieldMask, "expires_at") {
+		if key.ExpiresAt != nil && key.ExpiresAt.Before(time.Now()) {
+			return errAPIKeyExpiresAt.WithAttributes("expires_at", key.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+	if len(fieldMask) == 0 || ttnpb.HasAnyField(fieldMask, "allowed_cidrs") {
+		for _, cidr := range key.AllowedCIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return errAPIKeyCIDR.WithCause(err).WithAttributes("cidr", cidr)
+			}
+		}
+	}
+	return nil
+}
+
+// apiKeyAllowedFrom returns whether the API key can be used from the given address.
+func apiKeyAllowedFrom(key *ttnpb.APIKey, addr net.IP) bool {
+	if len(key.AllowedCIDRs) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	for _, cidr := range key.AllowedCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if ipNet.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
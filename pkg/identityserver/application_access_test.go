@@ -16,13 +16,17 @@ package identityserver
 
 import (
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func init() {
@@ -559,3 +563,120 @@ func TestApplicationAccessRights(t *testing.T) {
 		}
 	})
 }
+
+func TestApplicationAccessAPIKeyRestrictions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		applicationID := userApplications(&userID).Applications[0].ApplicationIdentifiers
+
+		reg := ttnpb.NewApplicationAccessClient(cc)
+
+		expiredAt := time.Now().Add(-time.Hour)
+		_, err := reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			Name:                   "test-expired-api-key",
+			Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			ExpiresAt:              &expiredAt,
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			Name:                   "test-invalid-cidr-api-key",
+			Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			AllowedCIDRs:           []string{"192.0.2.1"},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		expiresAt := time.Now().Add(time.Hour)
+		APIKey, err := reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			Name:                   "test-restricted-api-key",
+			Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			ExpiresAt:              &expiresAt,
+			AllowedCIDRs:           []string{"192.0.2.0/24"},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if !a.So(APIKey, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(APIKey.AllowedCIDRs, should.Resemble, []string{"192.0.2.0/24"})
+
+		keyCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+			AuthType:      "bearer",
+			AuthValue:     APIKey.Key,
+			AllowInsecure: true,
+		})
+
+		_, err = reg.ListRights(ctx, &applicationID, keyCreds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsUnauthenticated(err), should.BeTrue)
+		}
+
+		_, err = reg.ListRights(metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", "198.51.100.1"), &applicationID, keyCreds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsUnauthenticated(err), should.BeTrue)
+		}
+
+		rights, err := reg.ListRights(metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", "192.0.2.1"), &applicationID, keyCreds)
+
+		a.So(err, should.BeNil)
+		if a.So(rights, should.NotBeNil) {
+			a.So(rights.Rights, should.Contain, ttnpb.RIGHT_APPLICATION_INFO)
+		}
+
+		updated, err := reg.UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			APIKey: ttnpb.APIKey{
+				ID:        APIKey.ID,
+				ExpiresAt: &expiredAt,
+			},
+			FieldMask: types.FieldMask{Paths: []string{"expires_at"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+		a.So(updated, should.BeNil)
+
+		updated, err = reg.UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			APIKey: ttnpb.APIKey{
+				ID: APIKey.ID,
+			},
+			FieldMask: types.FieldMask{Paths: []string{"allowed_cidrs"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(updated, should.NotBeNil) {
+			a.So(updated.Name, should.Equal, APIKey.Name)
+			a.So(updated.Rights, should.Resemble, APIKey.Rights)
+			a.So(updated.AllowedCIDRs, should.BeEmpty)
+		}
+
+		_, err = reg.ListRights(ctx, &applicationID, keyCreds)
+
+		a.So(err, should.BeNil)
+
+		_, err = reg.UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			APIKey: ttnpb.APIKey{
+				ID: APIKey.ID,
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+	})
+}
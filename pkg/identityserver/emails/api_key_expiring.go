@@ -0,0 +1,41 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails
+
+import "time"
+
+// APIKeyExpiring is the email that is sent when an API key is about to expire.
+type APIKeyExpiring struct {
+	Data
+	Identifier string
+	ExpiresAt  time.Time
+}
+
+// TemplateName returns the name of the template to use for this email.
+func (APIKeyExpiring) TemplateName() string { return "api_key_expiring" }
+
+const apiKeyExpiringSubject = `An API key is about to expire`
+
+const apiKeyExpiringText = `Dear {{.User.Name}},
+
+The API key "{{.Identifier}}" for {{.Entity.Type}} "{{.Entity.ID}}" on {{.Network.Name}} will expire at {{.ExpiresAt.Format "2006-01-02 15:04:05 MST"}}.
+
+After that time, the API key can no longer be used. If you still need it, you can extend its expiry or create a new API key.
+`
+
+// DefaultTemplates returns the default templates for this email.
+func (APIKeyExpiring) DefaultTemplates() (subject, html, text string) {
+	return apiKeyExpiringSubject, "", apiKeyExpiringText
+}
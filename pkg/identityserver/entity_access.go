@@ -36,6 +36,8 @@ var (
 	errUnauthenticated          = errors.DefineUnauthenticated("unauthenticated", "unauthenticated")
 	errUnsupportedAuthorization = errors.DefineUnauthenticated("unsupported_authorization", "unsupported authorization method")
 	errAPIKeyNotFound           = errors.DefineUnauthenticated("api_key_not_found", "API key not found")
+	errAPIKeyAddress            = errors.DefineUnauthenticated("api_key_address", "API key not allowed from address `{address}`")
+	errAPIKeyUnknownAddress     = errors.DefineUnauthenticated("api_key_unknown_address", "API key not allowed from unknown address")
 	errInvalidAuthorization     = errors.DefineUnauthenticated("invalid_authorization", "invalid authorization")
 	errTokenNotFound            = errors.DefineUnauthenticated("token_not_found", "access token not found")
 	errTokenExpired             = errors.DefineUnauthenticated("token_expired", "access token expired")
@@ -96,7 +98,7 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 
 	var fetch func(db *gorm.DB) error
 	res := &ttnpb.AuthInfoResponse{}
-	userFieldMask := &types.FieldMask{Paths: []string{"admin", "state", "primary_email_address_validated_at"}}
+	userFieldMask := &types.FieldMask{Paths: []string{"admin", "state", "primary_email_address_validated_at", "totp_enabled_at"}}
 	clientFieldMask := &types.FieldMask{Paths: []string{"state"}}
 	var user *ttnpb.User
 	var userRights *ttnpb.Rights
@@ -120,6 +122,15 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 			if !valid {
 				return errInvalidAuthorization
 			}
+			if len(apiKey.AllowedCIDRs) > 0 {
+				addr, ok := rpcmetadata.RemoteAddress(ctx, is.trustedProxies...)
+				if !ok {
+					return errAPIKeyUnknownAddress
+				}
+				if !apiKeyAllowedFrom(apiKey, addr) {
+					return errAPIKeyAddress.WithAttributes("address", addr.String())
+				}
+			}
 			apiKey.Key = ""
 			apiKey.Rights = ttnpb.RightsFrom(apiKey.Rights...).Implied().GetRights()
 			res.AccessMethod = &ttnpb.AuthInfoResponse_APIKey{
@@ -217,6 +228,18 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 			warning.Add(ctx, "Restricted rights until email address validated")
 		}
 
+		if user.TOTPEnabledAt == nil {
+			mfaRequired, err := is.mfaRequired(ctx, user.UserIdentifiers)
+			if err != nil {
+				return nil, err
+			}
+			if mfaRequired {
+				// Go to profile page, set up two-factor authentication.
+				restrictRights(res, ttnpb.RightsFrom(ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_USER_SETTINGS_BASIC))
+				warning.Add(ctx, "Restricted rights until two-factor authentication enabled")
+			}
+		}
+
 		switch user.State {
 		case ttnpb.STATE_REQUESTED:
 			// Go to profile page, edit basic settings (such as email), delete account.
@@ -20,10 +20,12 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/blacklist"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
 )
 
 var (
@@ -233,9 +235,45 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 	return gtws, nil
 }
 
+var errUpdateGatewayLocationDisabled = errors.DefinePermissionDenied(
+	"update_gateway_location_disabled",
+	"location updates from status messages are disabled for gateway `{gateway_uid}`",
+)
+
+var errUpdateGatewayAntennaFields = errors.DefinePermissionDenied(
+	"update_gateway_antenna_fields",
+	"only antenna locations of gateway `{gateway_uid}` can be updated from status messages",
+)
+
+// onlyAntennaLocationsChanged returns whether the updated antennas only differ from the existing antennas in their
+// locations. Antennas may be added, but only with a location.
+func onlyAntennaLocationsChanged(existing, updated []ttnpb.GatewayAntenna) bool {
+	if len(updated) < len(existing) {
+		return false
+	}
+	for i, antenna := range updated {
+		var expected ttnpb.GatewayAntenna
+		if i < len(existing) {
+			expected = existing[i]
+		}
+		antenna.Location, expected.Location = ttnpb.Location{}, ttnpb.Location{}
+		if !antenna.Equal(&expected) {
+			return false
+		}
+	}
+	return true
+}
+
 func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGatewayRequest) (gtw *ttnpb.Gateway, err error) {
+	var requireUpdateLocationFromStatus bool
 	if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
-		return nil, err
+		// The Gateway Server updates the antenna locations of gateways that have location updates from status
+		// messages enabled, using the credentials that the gateway connected with.
+		if len(req.FieldMask.Paths) == 0 || !ttnpb.HasOnlyAllowedFields(req.FieldMask.Paths, "antennas") ||
+			rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_LINK) != nil {
+			return nil, err
+		}
+		requireUpdateLocationFromStatus = true
 	}
 
 	// Backwards compatibility for frequency_plan_id field.
@@ -258,6 +296,20 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 		}
 	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if requireUpdateLocationFromStatus {
+			existing, err := store.GetGatewayStore(db).GetGateway(ctx, &req.GatewayIdentifiers, &types.FieldMask{
+				Paths: []string{"antennas", "update_location_from_status"},
+			})
+			if err != nil {
+				return err
+			}
+			if !existing.UpdateLocationFromStatus {
+				return errUpdateGatewayLocationDisabled.WithAttributes("gateway_uid", unique.ID(ctx, req.GatewayIdentifiers))
+			}
+			if !onlyAntennaLocationsChanged(existing.Antennas, req.Antennas) {
+				return errUpdateGatewayAntennaFields.WithAttributes("gateway_uid", unique.ID(ctx, req.GatewayIdentifiers))
+			}
+		}
 		gtw, err = store.GetGatewayStore(db).UpdateGateway(ctx, &req.Gateway, &req.FieldMask)
 		if err != nil {
 			return err
@@ -21,6 +21,7 @@ import (
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -221,6 +222,142 @@ func TestGatewaysCRUD(t *testing.T) {
 	})
 }
 
+func TestGatewaysUpdateLocationFromStatus(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewGatewayRegistryClient(cc)
+		access := ttnpb.NewGatewayAccessClient(cc)
+
+		userID, creds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+
+		created, err := reg.Create(ctx, &ttnpb.CreateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "bar"},
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		apiKey, err := access.CreateAPIKey(ctx, &ttnpb.CreateGatewayAPIKeyRequest{
+			GatewayIdentifiers: created.GatewayIdentifiers,
+			Name:               "link key",
+			Rights:             []ttnpb.Right{ttnpb.RIGHT_GATEWAY_LINK},
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		linkCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+			AuthType:      "bearer",
+			AuthValue:     apiKey.Key,
+			AllowInsecure: true,
+		})
+
+		updateAntennas := func() error {
+			_, err := reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+				Gateway: ttnpb.Gateway{
+					GatewayIdentifiers: created.GatewayIdentifiers,
+					Antennas: []ttnpb.GatewayAntenna{
+						{Location: ttnpb.Location{Latitude: 12.345, Longitude: 23.456, Source: ttnpb.SOURCE_GPS}},
+					},
+				},
+				FieldMask: ptypes.FieldMask{Paths: []string{"antennas"}},
+			}, linkCreds)
+			return err
+		}
+
+		err = updateAntennas()
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers:       created.GatewayIdentifiers,
+				UpdateLocationFromStatus: true,
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"update_location_from_status"}},
+		}, creds)
+		a.So(err, should.BeNil)
+
+		a.So(updateAntennas(), should.BeNil)
+
+		got, err := reg.Get(ctx, &ttnpb.GetGatewayRequest{
+			GatewayIdentifiers: created.GatewayIdentifiers,
+			FieldMask:          ptypes.FieldMask{Paths: []string{"antennas"}},
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(got.Antennas, should.HaveLength, 1) {
+			a.So(got.Antennas[0].Location.Latitude, should.Equal, 12.345)
+		}
+
+		for _, antennas := range [][]ttnpb.GatewayAntenna{
+			{
+				{Gain: 3, Location: ttnpb.Location{Latitude: 12.345, Longitude: 23.456, Source: ttnpb.SOURCE_GPS}},
+			},
+			{
+				{
+					Attributes: map[string]string{"foo": "bar"},
+					Location:   ttnpb.Location{Latitude: 12.345, Longitude: 23.456, Source: ttnpb.SOURCE_GPS},
+				},
+			},
+			{
+				{Location: ttnpb.Location{Latitude: 12.345, Longitude: 23.456, Source: ttnpb.SOURCE_GPS}},
+				{Gain: 3, Location: ttnpb.Location{Latitude: 34.567, Longitude: 45.678, Source: ttnpb.SOURCE_GPS}},
+			},
+			nil,
+		} {
+			_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+				Gateway: ttnpb.Gateway{
+					GatewayIdentifiers: created.GatewayIdentifiers,
+					Antennas:           antennas,
+				},
+				FieldMask: ptypes.FieldMask{Paths: []string{"antennas"}},
+			}, linkCreds)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			}
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: created.GatewayIdentifiers,
+				Antennas: []ttnpb.GatewayAntenna{
+					{Location: ttnpb.Location{Latitude: 12.345, Longitude: 23.456, Source: ttnpb.SOURCE_GPS}},
+					{Location: ttnpb.Location{Latitude: 34.567, Longitude: 45.678, Source: ttnpb.SOURCE_GPS}},
+				},
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"antennas"}},
+		}, linkCreds)
+		a.So(err, should.BeNil)
+
+		got, err = reg.Get(ctx, &ttnpb.GetGatewayRequest{
+			GatewayIdentifiers: created.GatewayIdentifiers,
+			FieldMask:          ptypes.FieldMask{Paths: []string{"antennas"}},
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(got.Antennas, should.HaveLength, 2) {
+			a.So(got.Antennas[0].Gain, should.BeZeroValue)
+			a.So(got.Antennas[1].Location.Latitude, should.Equal, 34.567)
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: created.GatewayIdentifiers,
+				Name:               "Updated Name",
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"name"}},
+		}, linkCreds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Delete(ctx, &created.GatewayIdentifiers, creds)
+		a.So(err, should.BeNil)
+	})
+}
+
 func TestGatewaysPagination(t *testing.T) {
 	a := assertions.New(t)
 
@@ -16,8 +16,10 @@ package identityserver
 
 import (
 	"context"
+	"net"
 	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres" // Postgres database driver.
@@ -63,6 +65,18 @@ type Config struct {
 	AuthCache struct {
 		MembershipTTL time.Duration `name:"membership-ttl" description:"TTL of membership caches"`
 	} `name:"auth-cache"`
+	APIKeys struct {
+		TrustedProxies     []string `name:"trusted-proxies" description:"CIDRs of proxies and cluster components that are trusted to forward the address of clients"`
+		ExpiryNotification struct {
+			Before   time.Duration `name:"before" description:"Notify the owners of API keys this long before the keys expire (0 is disabled)"`
+			Interval time.Duration `name:"interval" description:"Interval of checking for API keys that are about to expire"`
+		} `name:"expiry-notification"`
+	} `name:"api-keys"`
+	MFA struct {
+		Required           bool          `name:"required" description:"Require two-factor authentication for all users"`
+		TOTPSecretKEKLabel string        `name:"totp-secret-kek-label" description:"Label of the key encryption key to encrypt TOTP secrets with"`
+		OrganizationsTTL   time.Duration `name:"organizations-ttl" description:"TTL of the cache of whether any organization requires two-factor authentication"`
+	} `name:"mfa"`
 	OAuth          oauth.Config `name:"oauth"`
 	ProfilePicture struct {
 		UseGravatar bool   `name:"use-gravatar" description:"Use Gravatar fallback for users without profile picture"`
@@ -93,6 +107,9 @@ type IdentityServer struct {
 	redis          *redis.Client
 	emailTemplates *email.TemplateRegistry
 	oauth          oauth.Server
+	trustedProxies []*net.IPNet
+
+	mfaOrganizations mfaOrganizationsCache
 }
 
 // Context returns the context of the Identity Server.
@@ -116,7 +133,10 @@ func (is *IdentityServer) configFromContext(ctx context.Context) *Config {
 	return is.config
 }
 
-var errDBNeedsMigration = errors.Define("db_needs_migration", "the database needs to be migrated")
+var (
+	errDBNeedsMigration = errors.Define("db_needs_migration", "the database needs to be migrated")
+	errTrustedProxy     = errors.DefineInvalidArgument("trusted_proxy", "invalid trusted proxy `{cidr}`")
+)
 
 // New returns new *IdentityServer.
 func New(c *component.Component, config *Config) (is *IdentityServer, err error) {
@@ -145,16 +165,28 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		return nil, err
 	}
 
+	for _, cidr := range is.config.APIKeys.TrustedProxies {
+		_, trustedProxy, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errTrustedProxy.WithCause(err).WithAttributes("cidr", cidr)
+		}
+		is.trustedProxies = append(is.trustedProxies, trustedProxy)
+	}
+
 	is.oauth = oauth.NewServer(is.Context(), struct {
 		store.UserStore
 		store.UserSessionStore
 		store.ClientStore
 		store.OAuthStore
+		store.ExternalUserStore
+		store.MembershipStore
 	}{
-		UserStore:        store.GetUserStore(is.db),
-		UserSessionStore: store.GetUserSessionStore(is.db),
-		ClientStore:      store.GetClientStore(is.db),
-		OAuthStore:       store.GetOAuthStore(is.db),
+		UserStore:         oauthUserStore{UserStore: store.GetUserStore(is.db), is: is},
+		UserSessionStore:  store.GetUserSessionStore(is.db),
+		ClientStore:       store.GetClientStore(is.db),
+		OAuthStore:        store.GetOAuthStore(is.db),
+		ExternalUserStore: store.GetExternalUserStore(is.db),
+		MembershipStore:   oauthMembershipStore{MembershipStore: store.GetMembershipStore(is.db), is: is},
 	}, is.config.OAuth)
 
 	c.AddContextFiller(func(ctx context.Context) context.Context {
@@ -187,6 +219,10 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.OAuthAuthorizationRegistry", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
 
+	if is.config.APIKeys.ExpiryNotification.Before > 0 {
+		c.RegisterTask(is.Context(), "api_key_expiry_notification", is.notifyExpiringAPIKeys, component.TaskRestartOnFailure)
+	}
+
 	c.RegisterGRPC(is)
 	c.RegisterWeb(is.oauth)
 
@@ -253,3 +289,51 @@ func (is *IdentityServer) getMembershipStore(ctx context.Context, db *gorm.DB) s
 	}
 	return s
 }
+
+// oauthUserStore is the UserStore of the OAuth server. Users that it creates, such as users that log in with an
+// upstream OpenID Connect provider for the first time, get their state from the user registration configuration.
+// Users that it gets have their TOTP secret decrypted.
+type oauthUserStore struct {
+	store.UserStore
+	is *IdentityServer
+}
+
+func (s oauthUserStore) CreateUser(ctx context.Context, usr *ttnpb.User) (*ttnpb.User, error) {
+	if s.is.configFromContext(ctx).UserRegistration.AdminApproval.Required {
+		usr.State = ttnpb.STATE_REQUESTED
+	} else {
+		usr.State = ttnpb.STATE_APPROVED
+	}
+	return s.UserStore.CreateUser(ctx, usr)
+}
+
+func (s oauthUserStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fieldMask *types.FieldMask) (*ttnpb.User, error) {
+	if fieldMask != nil && ttnpb.HasAnyField(fieldMask.Paths, "totp_secret") {
+		fieldMask = &types.FieldMask{
+			Paths: append(append([]string(nil), fieldMask.Paths...), "totp_encrypted_secret", "totp_secret_kek_label"),
+		}
+	}
+	usr, err := s.UserStore.GetUser(ctx, id, fieldMask)
+	if err != nil {
+		return nil, err
+	}
+	if usr.TOTPSecret, err = s.is.totpSecret(ctx, usr); err != nil {
+		return nil, err
+	}
+	return usr, nil
+}
+
+// oauthMembershipStore is the MembershipStore of the OAuth server. It gets and sets
+// members through the membership cache, which is configured after the OAuth server is created.
+type oauthMembershipStore struct {
+	store.MembershipStore
+	is *IdentityServer
+}
+
+func (s oauthMembershipStore) GetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) (*ttnpb.Rights, error) {
+	return s.is.getMembershipStore(ctx, s.is.db).GetMember(ctx, id, entityID)
+}
+
+func (s oauthMembershipStore) SetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers, rights *ttnpb.Rights) error {
+	return s.is.getMembershipStore(ctx, s.is.db).SetMember(ctx, id, entityID, rights)
+}
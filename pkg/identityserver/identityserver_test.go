@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
 	"go.thethings.network/lorawan-stack/pkg/config"
@@ -29,6 +30,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 	"google.golang.org/grpc"
 )
 
@@ -324,6 +326,36 @@ func testWithIdentityServer(t *testing.T, f func(is *IdentityServer, cc *grpc.Cl
 	f(getIdentityServer(t))
 }
 
+func TestOAuthUserStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userStore := oauthUserStore{UserStore: store.GetUserStore(is.db), is: is}
+
+		usr, err := userStore.CreateUser(ctx, &ttnpb.User{
+			UserIdentifiers:     ttnpb.UserIdentifiers{UserID: "oauth-approved"},
+			PrimaryEmailAddress: "oauth-approved@example.com",
+			State:               ttnpb.STATE_REJECTED,
+		})
+		if a.So(err, should.BeNil) {
+			a.So(usr.State, should.Equal, ttnpb.STATE_APPROVED)
+		}
+
+		is.config.UserRegistration.AdminApproval.Required = true
+		defer func() { is.config.UserRegistration.AdminApproval.Required = false }()
+
+		usr, err = userStore.CreateUser(ctx, &ttnpb.User{
+			UserIdentifiers:     ttnpb.UserIdentifiers{UserID: "oauth-requested"},
+			PrimaryEmailAddress: "oauth-requested@example.com",
+			State:               ttnpb.STATE_APPROVED,
+		})
+		if a.So(err, should.BeNil) {
+			a.So(usr.State, should.Equal, ttnpb.STATE_REQUESTED)
+		}
+	})
+}
+
 func reverse(s string) string {
 	b := []byte(s)
 	first := 0
@@ -58,6 +58,9 @@ func (is *IdentityServer) createOrganization(ctx context.Context, req *ttnpb.Cre
 	if err := validateContactInfo(req.Organization.ContactInfo); err != nil {
 		return nil, err
 	}
+	if !is.IsAdmin(ctx) {
+		req.Organization.RequireMFA = false
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		org, err = store.GetOrganizationStore(db).CreateOrganization(ctx, &req.Organization)
 		if err != nil {
@@ -83,6 +86,9 @@ func (is *IdentityServer) createOrganization(ctx context.Context, req *ttnpb.Cre
 	if err != nil {
 		return nil, err
 	}
+	if req.Organization.RequireMFA {
+		is.invalidateMFAOrganizations()
+	}
 	events.Publish(evtCreateOrganization(ctx, req.OrganizationIdentifiers, nil))
 	return org, nil
 }
@@ -182,6 +188,8 @@ func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.List
 	return orgs, nil
 }
 
+var errUpdateOrganizationAdminField = errors.DefinePermissionDenied("organization_update_admin_field", "only admins can update the `{field}` field")
+
 func (is *IdentityServer) updateOrganization(ctx context.Context, req *ttnpb.UpdateOrganizationRequest) (org *ttnpb.Organization, err error) {
 	if err = rights.RequireOrganization(ctx, req.OrganizationIdentifiers, ttnpb.RIGHT_ORGANIZATION_SETTINGS_BASIC); err != nil {
 		return nil, err
@@ -195,6 +203,9 @@ func (is *IdentityServer) updateOrganization(ctx context.Context, req *ttnpb.Upd
 			return nil, err
 		}
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "require_mfa") && !is.IsAdmin(ctx) {
+		return nil, errUpdateOrganizationAdminField.WithAttributes("field", "require_mfa")
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		org, err = store.GetOrganizationStore(db).UpdateOrganization(ctx, &req.Organization, &req.FieldMask)
 		if err != nil {
@@ -212,6 +223,9 @@ func (is *IdentityServer) updateOrganization(ctx context.Context, req *ttnpb.Upd
 	if err != nil {
 		return nil, err
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "require_mfa") {
+		is.invalidateMFAOrganizations()
+	}
 	events.Publish(evtUpdateOrganization(ctx, req.OrganizationIdentifiers, req.FieldMask.Paths))
 	return org, nil
 }
@@ -14,7 +14,13 @@
 
 package store
 
-import "go.thethings.network/lorawan-stack/pkg/ttnpb"
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
 
 // APIKey model.
 type APIKey struct {
@@ -26,6 +32,10 @@ type APIKey struct {
 	Rights Rights `gorm:"type:INT ARRAY"`
 	Name   string `gorm:"type:VARCHAR"`
 
+	AllowedCIDRs     pq.StringArray `gorm:"type:VARCHAR ARRAY;column:allowed_cidrs"`
+	ExpiresAt        *time.Time     `gorm:"index:api_key_expires_at_index"`
+	ExpiryNotifiedAt *time.Time
+
 	EntityID   string `gorm:"type:UUID;index:api_key_entity_index;not null"`
 	EntityType string `gorm:"type:VARCHAR(32);index:api_key_entity_index;not null"`
 }
@@ -36,9 +46,36 @@ func init() {
 
 func (k APIKey) toPB() *ttnpb.APIKey {
 	return &ttnpb.APIKey{
-		ID:     k.APIKeyID,
-		Key:    k.Key,
-		Name:   k.Name,
-		Rights: k.Rights.Rights,
+		ID:           k.APIKeyID,
+		Key:          k.Key,
+		Name:         k.Name,
+		Rights:       k.Rights.Rights,
+		ExpiresAt:    cleanTimePtr(k.ExpiresAt),
+		AllowedCIDRs: k.AllowedCIDRs,
+	}
+}
+
+// defaultAPIKeyFieldMask is the field mask that is used when updating an API key without field mask.
+var defaultAPIKeyFieldMask = &types.FieldMask{Paths: []string{nameField, rightsField}}
+
+// fromPB updates the API key model from the given proto and returns the updated columns.
+func (k *APIKey) fromPB(pb *ttnpb.APIKey, fieldMask *types.FieldMask) (columns []string) {
+	for _, path := range ttnpb.TopLevelFields(fieldMask.GetPaths()) {
+		switch path {
+		case nameField:
+			k.Name = pb.Name
+			columns = append(columns, "name")
+		case rightsField:
+			k.Rights = Rights{Rights: pb.Rights}
+			columns = append(columns, "rights")
+		case expiresAtField:
+			k.ExpiresAt = cleanTimePtr(pb.ExpiresAt)
+			k.ExpiryNotifiedAt = nil
+			columns = append(columns, "expires_at", "expiry_notified_at")
+		case allowedCIDRsField:
+			k.AllowedCIDRs = pq.StringArray(pb.AllowedCIDRs)
+			columns = append(columns, "allowed_cidrs")
+		}
 	}
+	return columns
 }
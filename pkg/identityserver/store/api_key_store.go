@@ -17,8 +17,11 @@ package store
 import (
 	"context"
 	"runtime/trace"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -39,12 +42,14 @@ func (s *apiKeyStore) CreateAPIKey(ctx context.Context, entityID ttnpb.Identifie
 		return err
 	}
 	model := &APIKey{
-		APIKeyID:   key.ID,
-		Key:        key.Key,
-		Rights:     Rights{Rights: key.Rights},
-		Name:       key.Name,
-		EntityID:   entity.PrimaryKey(),
-		EntityType: entityTypeForID(entityID),
+		APIKeyID:     key.ID,
+		Key:          key.Key,
+		Rights:       Rights{Rights: key.Rights},
+		Name:         key.Name,
+		ExpiresAt:    cleanTimePtr(key.ExpiresAt),
+		AllowedCIDRs: pq.StringArray(key.AllowedCIDRs),
+		EntityID:     entity.PrimaryKey(),
+		EntityType:   entityTypeForID(entityID),
 	}
 	return s.createEntity(ctx, model)
 }
@@ -76,7 +81,10 @@ func (s *apiKeyStore) FindAPIKeys(ctx context.Context, entityID ttnpb.Identifier
 	return keyProtos, nil
 }
 
-var errAPIKeyEntity = errors.DefineCorruption("api_key_entity", "API key not linked to an entity")
+var (
+	errAPIKeyEntity  = errors.DefineCorruption("api_key_entity", "API key not linked to an entity")
+	errAPIKeyExpired = errors.DefineNotFound("api_key_expired", "API key expired")
+)
 
 func (s *apiKeyStore) GetAPIKey(ctx context.Context, id string) (ttnpb.Identifiers, *ttnpb.APIKey, error) {
 	defer trace.StartRegion(ctx, "get api key").End()
@@ -88,6 +96,9 @@ func (s *apiKeyStore) GetAPIKey(ctx context.Context, id string) (ttnpb.Identifie
 		}
 		return nil, nil, err
 	}
+	if keyModel.ExpiresAt != nil && keyModel.ExpiresAt.Before(time.Now()) {
+		return nil, nil, errAPIKeyExpired
+	}
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return nil, nil, err
 	}
@@ -103,7 +114,7 @@ func (s *apiKeyStore) GetAPIKey(ctx context.Context, id string) (ttnpb.Identifie
 	return ids, keyModel.toPB(), nil
 }
 
-func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error) {
+func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey, fieldMask *types.FieldMask) (*ttnpb.APIKey, error) {
 	defer trace.StartRegion(ctx, "update api key").End()
 	entity, err := s.findEntity(ctx, entityID, "id")
 	if err != nil {
@@ -122,13 +133,62 @@ func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifie
 		}
 		return nil, err
 	}
-	if len(key.Rights) == 0 {
+	if len(fieldMask.GetPaths()) == 0 {
+		fieldMask = defaultAPIKeyFieldMask
+	}
+	if ttnpb.HasAnyField(fieldMask.Paths, rightsField) && len(key.Rights) == 0 {
 		return nil, query.Delete(&keyModel).Error
 	}
-	keyModel.Name = key.Name
-	keyModel.Rights = Rights{Rights: key.Rights}
-	if err = query.Select("name", "rights", "updated_at").Save(&keyModel).Error; err != nil {
+	columns := keyModel.fromPB(key, fieldMask)
+	if err = query.Select(append(columns, "updated_at")).Save(&keyModel).Error; err != nil {
 		return nil, err
 	}
 	return keyModel.toPB(), nil
 }
+
+// ExpiringAPIKey is an API key that is about to expire, with the identifiers of the entity it belongs to.
+type ExpiringAPIKey struct {
+	EntityIdentifiers ttnpb.Identifiers
+	*ttnpb.APIKey
+}
+
+func (s *apiKeyStore) FindExpiringAPIKeys(ctx context.Context, before time.Time) ([]ExpiringAPIKey, error) {
+	defer trace.StartRegion(ctx, "find expiring api keys").End()
+	var keyModels []APIKey
+	err := s.query(ctx, APIKey{}).
+		Where("expires_at > ? AND expires_at <= ? AND expiry_notified_at IS NULL", cleanTime(time.Now()), cleanTime(before)).
+		Order("expires_at").
+		Find(&keyModels).Error
+	if err != nil {
+		return nil, err
+	}
+	entities := make([]polymorphicEntity, len(keyModels))
+	for i, keyModel := range keyModels {
+		entities[i] = polymorphicEntity{EntityType: keyModel.EntityType, EntityUUID: keyModel.EntityID}
+	}
+	identifiers, err := s.findIdentifiers(entities...)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]ExpiringAPIKey, 0, len(keyModels))
+	for i, keyModel := range keyModels {
+		ids, ok := identifiers[entities[i]]
+		if !ok {
+			continue
+		}
+		keys = append(keys, ExpiringAPIKey{EntityIdentifiers: ids, APIKey: keyModel.toPB()})
+	}
+	return keys, nil
+}
+
+func (s *apiKeyStore) SetAPIKeyExpiryNotified(ctx context.Context, id string) (bool, error) {
+	defer trace.StartRegion(ctx, "set api key expiry notified").End()
+	now := cleanTime(time.Now())
+	res := s.query(ctx, APIKey{}).
+		Where("api_key_id = ? AND expiry_notified_at IS NULL", id).
+		Update(APIKey{ExpiryNotifiedAt: &now})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
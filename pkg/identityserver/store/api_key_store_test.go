@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
@@ -109,7 +111,7 @@ func TestAPIKeyStore(t *testing.T) {
 					ID:     strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
 					Name:   fmt.Sprintf("Updated %s API key", tt.Name),
 					Rights: tt.Rights,
-				})
+				}, nil)
 
 				a.So(err, should.BeNil)
 
@@ -126,7 +128,7 @@ func TestAPIKeyStore(t *testing.T) {
 				updated, err = store.UpdateAPIKey(ctx, tt.Identifiers, &ttnpb.APIKey{
 					ID: strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
 					// Empty rights
-				})
+				}, nil)
 
 				a.So(err, should.BeNil)
 				a.So(updated, should.BeNil)
@@ -145,3 +147,102 @@ func TestAPIKeyStore(t *testing.T) {
 		}
 	})
 }
+
+func TestAPIKeyStoreExpiry(t *testing.T) {
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db,
+			&APIKey{},
+			&Application{},
+		)
+
+		s := newStore(db)
+		store := GetAPIKeyStore(db)
+
+		s.createEntity(ctx, &Application{ApplicationID: "test-app"})
+		appIDs := &ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+
+		a := assertions.New(t)
+
+		expiresAt := cleanTime(time.Now().Add(time.Hour))
+		key := &ttnpb.APIKey{
+			ID:           "EXPIRINGKEYID",
+			Key:          "EXPIRINGKEY",
+			Name:         "Expiring API key",
+			Rights:       []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL},
+			ExpiresAt:    &expiresAt,
+			AllowedCIDRs: []string{"192.0.2.0/24"},
+		}
+
+		err := store.CreateAPIKey(ctx, appIDs, key)
+
+		a.So(err, should.BeNil)
+
+		_, got, err := store.GetAPIKey(ctx, key.ID)
+
+		a.So(err, should.BeNil)
+		a.So(got, should.Resemble, key)
+
+		expiring, err := store.FindExpiringAPIKeys(ctx, time.Now().Add(30*time.Minute))
+
+		a.So(err, should.BeNil)
+		a.So(expiring, should.BeEmpty)
+
+		expiring, err = store.FindExpiringAPIKeys(ctx, time.Now().Add(2*time.Hour))
+
+		a.So(err, should.BeNil)
+		if a.So(expiring, should.HaveLength, 1) {
+			a.So(expiring[0].EntityIdentifiers, should.Resemble, appIDs)
+			a.So(expiring[0].APIKey, should.Resemble, key)
+		}
+
+		notified, err := store.SetAPIKeyExpiryNotified(ctx, key.ID)
+
+		a.So(err, should.BeNil)
+		a.So(notified, should.BeTrue)
+
+		notified, err = store.SetAPIKeyExpiryNotified(ctx, key.ID)
+
+		a.So(err, should.BeNil)
+		a.So(notified, should.BeFalse)
+
+		expiring, err = store.FindExpiringAPIKeys(ctx, time.Now().Add(2*time.Hour))
+
+		a.So(err, should.BeNil)
+		a.So(expiring, should.BeEmpty)
+
+		updated, err := store.UpdateAPIKey(ctx, appIDs, &ttnpb.APIKey{
+			ID:           key.ID,
+			AllowedCIDRs: []string{"192.0.2.0/24", "2001:db8::/32"},
+		}, &types.FieldMask{Paths: []string{"allowed_cidrs"}})
+
+		a.So(err, should.BeNil)
+		if a.So(updated, should.NotBeNil) {
+			a.So(updated.Name, should.Equal, key.Name)
+			a.So(updated.Rights, should.Resemble, key.Rights)
+			a.So(updated.AllowedCIDRs, should.Resemble, []string{"192.0.2.0/24", "2001:db8::/32"})
+		}
+
+		expiresAt = cleanTime(time.Now().Add(-time.Minute))
+		_, err = store.UpdateAPIKey(ctx, appIDs, &ttnpb.APIKey{
+			ID:        key.ID,
+			ExpiresAt: &expiresAt,
+		}, &types.FieldMask{Paths: []string{"expires_at"}})
+
+		a.So(err, should.BeNil)
+
+		_, _, err = store.GetAPIKey(ctx, key.ID)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		keys, err := store.FindAPIKeys(ctx, appIDs)
+
+		a.So(err, should.BeNil)
+		if a.So(keys, should.HaveLength, 1) {
+			a.So(keys[0].ExpiresAt, should.Resemble, &expiresAt)
+		}
+	})
+}
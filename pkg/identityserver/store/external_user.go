@@ -0,0 +1,30 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+// ExternalUser is the link between a user and an account at an upstream identity provider.
+type ExternalUser struct {
+	Model
+
+	User   *User
+	UserID string `gorm:"type:UUID;index:external_user_user_index;not null"`
+
+	Provider   string `gorm:"type:VARCHAR;unique_index:external_user_external_id_index;not null"`
+	ExternalID string `gorm:"type:VARCHAR;unique_index:external_user_external_id_index;not null"`
+}
+
+func init() {
+	registerModel(&ExternalUser{})
+}
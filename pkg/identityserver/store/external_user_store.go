@@ -0,0 +1,78 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetExternalUserStore returns an ExternalUserStore on the given db (or transaction).
+func GetExternalUserStore(db *gorm.DB) ExternalUserStore {
+	return &externalUserStore{store: newStore(db)}
+}
+
+type externalUserStore struct {
+	*store
+}
+
+var errExternalUserNotFound = errors.DefineNotFound(
+	"external_user_not_found",
+	"user with external ID `{external_id}` of provider `{provider}` not found",
+)
+
+func (s *externalUserStore) CreateExternalUser(ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider, externalID string) error {
+	defer trace.StartRegion(ctx, "create external user").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	return convertError(s.createEntity(ctx, &ExternalUser{
+		UserID:     user.PrimaryKey(),
+		Provider:   provider,
+		ExternalID: externalID,
+	}))
+}
+
+func (s *externalUserStore) GetExternalUser(ctx context.Context, provider, externalID string) (*ttnpb.UserIdentifiers, error) {
+	defer trace.StartRegion(ctx, "get external user").End()
+	var externalUserModel ExternalUser
+	err := s.query(ctx, ExternalUser{}).Where(ExternalUser{
+		Provider:   provider,
+		ExternalID: externalID,
+	}).First(&externalUserModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errExternalUserNotFound.WithAttributes("provider", provider, "external_id", externalID)
+		}
+		return nil, convertError(err)
+	}
+	var accountModel Account
+	err = s.query(ctx, Account{}).Where(Account{
+		AccountType: "user",
+		AccountID:   externalUserModel.UserID,
+	}).First(&accountModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errExternalUserNotFound.WithAttributes("provider", provider, "external_id", externalID)
+		}
+		return nil, convertError(err)
+	}
+	return &ttnpb.UserIdentifiers{UserID: accountModel.UID}, nil
+}
@@ -0,0 +1,96 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestExternalUserStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &Account{}, &User{}, &ExternalUser{})
+
+		user := &User{
+			Account: Account{
+				UID: "test",
+			},
+			Name: "Test User",
+		}
+
+		userIDs := ttnpb.UserIdentifiers{UserID: "test"}
+		doesNotExistIDs := ttnpb.UserIdentifiers{UserID: "does_not_exist"}
+
+		if err := newStore(db).createEntity(ctx, user); err != nil {
+			panic(err)
+		}
+
+		store := GetExternalUserStore(db)
+
+		err := store.CreateExternalUser(ctx, &doesNotExistIDs, "sso", "subject")
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		_, err = store.GetExternalUser(ctx, "sso", "subject")
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.CreateExternalUser(ctx, &userIDs, "sso", "subject")
+
+		a.So(err, should.BeNil)
+
+		err = store.CreateExternalUser(ctx, &userIDs, "sso", "subject")
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		}
+
+		got, err := store.GetExternalUser(ctx, "sso", "subject")
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.UserID, should.Equal, "test")
+		}
+
+		_, err = store.GetExternalUser(ctx, "other", "subject")
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = GetUserStore(db).DeleteUser(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+
+		_, err = store.GetExternalUser(ctx, "sso", "subject")
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+}
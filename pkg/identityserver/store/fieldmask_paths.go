@@ -17,6 +17,7 @@ package store
 const (
 	// NOTE: please keep this sorted
 	adminField                          = "admin"
+	allowedCIDRsField                   = "allowed_cidrs"
 	antennasField                       = "antennas"
 	applicationServerAddressField       = "application_server_address"
 	attributesField                     = "attributes"
@@ -27,6 +28,7 @@ const (
 	downlinkPathConstraintField         = "downlink_path_constraint"
 	endorsedField                       = "endorsed"
 	enforceDutyCycleField               = "enforce_duty_cycle"
+	expiresAtField                      = "expires_at"
 	firmwareVersionField                = "version_ids.firmware_version"
 	frequencyPlanIDsField               = "frequency_plan_ids"
 	gatewayServerAddressField           = "gateway_server_address"
@@ -45,6 +47,7 @@ const (
 	primaryEmailAddressValidatedAtField = "primary_email_address_validated_at"
 	profilePictureField                 = "profile_picture"
 	redirectURIsField                   = "redirect_uris"
+	requireMFAField                     = "require_mfa"
 	requirePasswordUpdateField          = "require_password_update"
 	rightsField                         = "rights"
 	scheduleDownlinkLateField           = "schedule_downlink_late"
@@ -57,6 +60,15 @@ const (
 	temporaryPasswordCreatedAtField     = "temporary_password_created_at"
 	temporaryPasswordExpiresAtField     = "temporary_password_expires_at"
 	temporaryPasswordField              = "temporary_password"
+	totpEnabledAtField                  = "totp_enabled_at"
+	totpEncryptedSecretField            = "totp_encrypted_secret"
+	totpFailedAttemptsField             = "totp_failed_attempts"
+	totpLastTimeStepField               = "totp_last_time_step"
+	totpLockedUntilField                = "totp_locked_until"
+	totpRecoveryCodesField              = "totp_recovery_codes"
+	totpSecretField                     = "totp_secret"
+	totpSecretKEKLabelField             = "totp_secret_kek_label"
 	updateChannelField                  = "update_channel"
+	updateLocationFromStatusField       = "update_location_from_status"
 	versionIDsField                     = "version_ids"
 )
@@ -60,6 +60,8 @@ type Gateway struct {
 	ScheduleAnytimeDelay   int64 `gorm:"default:0 not null"`
 	DownlinkPathConstraint int
 
+	UpdateLocationFromStatus bool `gorm:"default:false not null"`
+
 	Antennas []GatewayAntenna
 }
 
@@ -106,6 +108,7 @@ var gatewayPBSetters = map[string]func(*ttnpb.Gateway, *Gateway){
 	downlinkPathConstraintField: func(pb *ttnpb.Gateway, gtw *Gateway) {
 		pb.DownlinkPathConstraint = ttnpb.DownlinkPathConstraint(gtw.DownlinkPathConstraint)
 	},
+	updateLocationFromStatusField: func(pb *ttnpb.Gateway, gtw *Gateway) { pb.UpdateLocationFromStatus = gtw.UpdateLocationFromStatus },
 	antennasField: func(pb *ttnpb.Gateway, gtw *Gateway) {
 		sort.Slice(gtw.Antennas, func(i int, j int) bool { return gtw.Antennas[i].Index < gtw.Antennas[j].Index })
 		pb.Antennas = make([]ttnpb.GatewayAntenna, len(gtw.Antennas))
@@ -149,6 +152,9 @@ var gatewayModelSetters = map[string]func(*Gateway, *ttnpb.Gateway){
 	},
 	enforceDutyCycleField:       func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.EnforceDutyCycle = pb.EnforceDutyCycle },
 	downlinkPathConstraintField: func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.DownlinkPathConstraint = int(pb.DownlinkPathConstraint) },
+	updateLocationFromStatusField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.UpdateLocationFromStatus = pb.UpdateLocationFromStatus
+	},
 	antennasField: func(gtw *Gateway, pb *ttnpb.Gateway) {
 		sort.Slice(gtw.Antennas, func(i int, j int) bool { return gtw.Antennas[i].Index < gtw.Antennas[j].Index })
 		antennas := make([]GatewayAntenna, len(pb.Antennas))
@@ -178,27 +184,28 @@ func init() {
 
 // fieldmask path to column name in gateways table.
 var gatewayColumnNames = map[string][]string{
-	"ids.eui":                   {"gateway_eui"},
-	attributesField:             {},
-	contactInfoField:            {},
-	nameField:                   {nameField},
-	descriptionField:            {descriptionField},
-	gatewayServerAddressField:   {gatewayServerAddressField},
-	versionIDsField:             {"brand_id", "model_id", "hardware_version", "firmware_version"},
-	brandIDField:                {"brand_id"},
-	modelIDField:                {"model_id"},
-	hardwareVersionField:        {"hardware_version"},
-	firmwareVersionField:        {"firmware_version"},
-	autoUpdateField:             {autoUpdateField},
-	updateChannelField:          {updateChannelField},
-	frequencyPlanIDsField:       {"frequency_plan_id"},
-	statusPublicField:           {statusPublicField},
-	locationPublicField:         {locationPublicField},
-	scheduleDownlinkLateField:   {scheduleDownlinkLateField},
-	scheduleAnytimeDelayField:   {scheduleAnytimeDelayField},
-	enforceDutyCycleField:       {enforceDutyCycleField},
-	downlinkPathConstraintField: {downlinkPathConstraintField},
-	antennasField:               {},
+	"ids.eui":                     {"gateway_eui"},
+	attributesField:               {},
+	contactInfoField:              {},
+	nameField:                     {nameField},
+	descriptionField:              {descriptionField},
+	gatewayServerAddressField:     {gatewayServerAddressField},
+	versionIDsField:               {"brand_id", "model_id", "hardware_version", "firmware_version"},
+	brandIDField:                  {"brand_id"},
+	modelIDField:                  {"model_id"},
+	hardwareVersionField:          {"hardware_version"},
+	firmwareVersionField:          {"firmware_version"},
+	autoUpdateField:               {autoUpdateField},
+	updateChannelField:            {updateChannelField},
+	frequencyPlanIDsField:         {"frequency_plan_id"},
+	statusPublicField:             {statusPublicField},
+	locationPublicField:           {locationPublicField},
+	scheduleDownlinkLateField:     {scheduleDownlinkLateField},
+	scheduleAnytimeDelayField:     {scheduleAnytimeDelayField},
+	enforceDutyCycleField:         {enforceDutyCycleField},
+	downlinkPathConstraintField:   {downlinkPathConstraintField},
+	updateLocationFromStatusField: {updateLocationFromStatusField},
+	antennasField:                 {},
 }
 
 func (gtw Gateway) toPB(pb *ttnpb.Gateway, fieldMask *pbtypes.FieldMask) {
@@ -24,12 +24,18 @@ func (org *Organization) AfterDelete(db *gorm.DB) error {
 	}).Delete(Account{}).Error
 }
 
-// AfterDelete deletes the Account of a User after it is deleted.
+// AfterDelete deletes the Account and the links to external accounts of a User after it is deleted.
 func (usr *User) AfterDelete(db *gorm.DB) error {
-	return db.Where(Account{
+	err := db.Where(Account{
 		AccountType: "user",
 		AccountID:   usr.PrimaryKey(),
 	}).Delete(Account{}).Error
+	if err != nil {
+		return err
+	}
+	return db.Where(ExternalUser{
+		UserID: usr.PrimaryKey(),
+	}).Delete(ExternalUser{}).Error
 }
 
 // AfterDelete releases the EUI of a Gateway after it is deleted.
@@ -33,6 +33,8 @@ type Organization struct {
 	APIKeys     []APIKey     `gorm:"polymorphic:Entity;polymorphic_value:organization"`
 	Memberships []Membership `gorm:"polymorphic:Entity;polymorphic_value:organization"`
 	// END common fields
+
+	RequireMFA bool `gorm:"default:false not null"`
 }
 
 func init() {
@@ -44,6 +46,7 @@ var organizationPBSetters = map[string]func(*ttnpb.Organization, *Organization){
 	nameField:        func(pb *ttnpb.Organization, org *Organization) { pb.Name = org.Name },
 	descriptionField: func(pb *ttnpb.Organization, org *Organization) { pb.Description = org.Description },
 	attributesField:  func(pb *ttnpb.Organization, org *Organization) { pb.Attributes = attributes(org.Attributes).toMap() },
+	requireMFAField:  func(pb *ttnpb.Organization, org *Organization) { pb.RequireMFA = org.RequireMFA },
 }
 
 // functions to set fields from the organization proto into the organization model.
@@ -53,6 +56,7 @@ var organizationModelSetters = map[string]func(*Organization, *ttnpb.Organizatio
 	attributesField: func(org *Organization, pb *ttnpb.Organization) {
 		org.Attributes = attributes(org.Attributes).updateFromMap(pb.Attributes)
 	},
+	requireMFAField: func(org *Organization, pb *ttnpb.Organization) { org.RequireMFA = pb.RequireMFA },
 }
 
 // fieldMask to use if a nil or empty fieldmask is passed.
@@ -74,6 +78,7 @@ var organizationColumnNames = map[string][]string{
 	contactInfoField: {},
 	nameField:        {nameField},
 	descriptionField: {descriptionField},
+	requireMFAField:  {requireMFAField},
 }
 
 func (org Organization) toPB(pb *ttnpb.Organization, fieldMask *types.FieldMask) {
@@ -158,3 +158,12 @@ func (s *organizationStore) DeleteOrganization(ctx context.Context, id *ttnpb.Or
 	defer trace.StartRegion(ctx, "delete organization").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *organizationStore) AnyOrganizationRequiresMFA(ctx context.Context) (bool, error) {
+	defer trace.StartRegion(ctx, "find organizations requiring mfa").End()
+	var total uint64
+	if err := s.query(ctx, Organization{}).Where(&Organization{RequireMFA: true}).Count(&total).Error; err != nil {
+		return false, err
+	}
+	return total > 0, nil
+}
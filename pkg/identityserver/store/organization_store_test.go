@@ -107,6 +107,23 @@ func TestOrganizationStore(t *testing.T) {
 			a.So(got.UpdatedAt, should.Equal, updated.UpdatedAt)
 		}
 
+		requiresMFA, err := store.AnyOrganizationRequiresMFA(ctx)
+
+		a.So(err, should.BeNil)
+		a.So(requiresMFA, should.BeFalse)
+
+		_, err = store.UpdateOrganization(ctx, &ttnpb.Organization{
+			OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "foo"},
+			RequireMFA:              true,
+		}, &types.FieldMask{Paths: []string{"require_mfa"}})
+
+		a.So(err, should.BeNil)
+
+		requiresMFA, err = store.AnyOrganizationRequiresMFA(ctx)
+
+		a.So(err, should.BeNil)
+		a.So(requiresMFA, should.BeTrue)
+
 		list, err := store.FindOrganizations(ctx, nil, &types.FieldMask{Paths: []string{"name"}})
 
 		a.So(err, should.BeNil)
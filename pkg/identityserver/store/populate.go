@@ -62,6 +62,7 @@ func NewPopulator(size int, seed int64) *Populator {
 		)
 		organization := ttnpb.NewPopulatedOrganization(randy, false)
 		organization.Description = fmt.Sprintf("Random Organization %d", i+1)
+		organization.RequireMFA = false
 		organizationID := organization.EntityIdentifiers()
 		p.Organizations = append(p.Organizations, organization)
 		p.APIKeys[organizationID] = append(
@@ -73,6 +74,9 @@ func NewPopulator(size int, seed int64) *Populator {
 		)
 		user := ttnpb.NewPopulatedUser(randy, false)
 		user.Description = fmt.Sprintf("Random User %d", i+1)
+		user.TOTPSecret, user.TOTPEnabledAt, user.TOTPRecoveryCodes = "", nil, nil
+		user.TOTPLastTimeStep, user.TOTPFailedAttempts, user.TOTPLockedUntil = 0, 0, nil
+		user.TOTPEncryptedSecret, user.TOTPSecretKEKLabel = nil, ""
 		userID := user.EntityIdentifiers()
 		p.Users = append(p.Users, user)
 		p.APIKeys[userID] = append(
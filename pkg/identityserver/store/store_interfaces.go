@@ -16,6 +16,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -81,6 +82,8 @@ type OrganizationStore interface {
 	GetOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Organization, error)
 	UpdateOrganization(ctx context.Context, org *ttnpb.Organization, fieldMask *types.FieldMask) (*ttnpb.Organization, error)
 	DeleteOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
+	// AnyOrganizationRequiresMFA returns whether any organization requires two-factor authentication.
+	AnyOrganizationRequiresMFA(ctx context.Context) (bool, error)
 }
 
 // UserStore interface for storing Users.
@@ -106,6 +109,17 @@ type UserSessionStore interface {
 	DeleteSession(ctx context.Context, userIDs *ttnpb.UserIdentifiers, sessionID string) error
 }
 
+// ExternalUserStore interface for storing the links between users and their
+// accounts at upstream identity providers.
+//
+// For internal use (by the OAuth server) only.
+type ExternalUserStore interface {
+	// Link the user to the account with the external ID at the provider.
+	CreateExternalUser(ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider, externalID string) error
+	// Get the user that is linked to the account with the external ID at the provider.
+	GetExternalUser(ctx context.Context, provider, externalID string) (*ttnpb.UserIdentifiers, error)
+}
+
 // MembershipStore interface for storing membership (collaboration) relations
 // between accounts (users or organizations) and entities (applications, clients,
 // gateways or organizations).
@@ -133,10 +147,15 @@ type APIKeyStore interface {
 	CreateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey) error
 	// Find API keys of the given entity.
 	FindAPIKeys(ctx context.Context, entityID ttnpb.Identifiers) ([]*ttnpb.APIKey, error)
-	// Get an API key by its ID.
+	// Get an API key by its ID. Expired API keys are not returned.
 	GetAPIKey(ctx context.Context, id string) (ttnpb.Identifiers, *ttnpb.APIKey, error)
-	// Update key rights on an entity. Rights can be deleted by not passing any rights, in which case the returned API key will be nil.
-	UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error)
+	// Update an API key of an entity. If the field mask is empty, the name and rights are updated.
+	// The API key can be deleted by not passing any rights, in which case the returned API key will be nil.
+	UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey, fieldMask *types.FieldMask) (*ttnpb.APIKey, error)
+	// Find API keys that expire before the given time, and of which the expiry was not yet notified.
+	FindExpiringAPIKeys(ctx context.Context, before time.Time) ([]ExpiringAPIKey, error)
+	// Set the expiry of the API key as notified. Returns false if the expiry was already notified.
+	SetAPIKeyExpiryNotified(ctx context.Context, id string) (bool, error)
 }
 
 // OAuthStore interface for the OAuth server.
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -54,6 +55,15 @@ type User struct {
 
 	ProfilePicture   *Picture
 	ProfilePictureID *string `gorm:"type:UUID;index:user_profile_picture_index"`
+
+	TOTPSecret          string `gorm:"type:VARCHAR"`
+	TOTPEncryptedSecret []byte `gorm:"type:BYTEA"`
+	TOTPSecretKEKLabel  string `gorm:"type:VARCHAR"`
+	TOTPEnabledAt       *time.Time
+	TOTPRecoveryCodes   pq.StringArray `gorm:"type:VARCHAR ARRAY;column:totp_recovery_codes"` // these are hashes
+	TOTPLastTimeStep    int64          `gorm:"default:0 not null"`
+	TOTPFailedAttempts  int            `gorm:"default:0 not null"`
+	TOTPLockedUntil     *time.Time
 }
 
 func init() {
@@ -93,6 +103,20 @@ var userPBSetters = map[string]func(*ttnpb.User, *User){
 			pb.ProfilePicture = usr.ProfilePicture.toPB()
 		}
 	},
+	totpSecretField: func(pb *ttnpb.User, usr *User) { pb.TOTPSecret = usr.TOTPSecret },
+	totpEnabledAtField: func(pb *ttnpb.User, usr *User) {
+		pb.TOTPEnabledAt = cleanTimePtr(usr.TOTPEnabledAt)
+	},
+	totpRecoveryCodesField: func(pb *ttnpb.User, usr *User) { pb.TOTPRecoveryCodes = usr.TOTPRecoveryCodes },
+	totpLastTimeStepField:  func(pb *ttnpb.User, usr *User) { pb.TOTPLastTimeStep = uint64(usr.TOTPLastTimeStep) },
+	totpFailedAttemptsField: func(pb *ttnpb.User, usr *User) {
+		pb.TOTPFailedAttempts = uint32(usr.TOTPFailedAttempts)
+	},
+	totpLockedUntilField: func(pb *ttnpb.User, usr *User) {
+		pb.TOTPLockedUntil = cleanTimePtr(usr.TOTPLockedUntil)
+	},
+	totpEncryptedSecretField: func(pb *ttnpb.User, usr *User) { pb.TOTPEncryptedSecret = usr.TOTPEncryptedSecret },
+	totpSecretKEKLabelField:  func(pb *ttnpb.User, usr *User) { pb.TOTPSecretKEKLabel = usr.TOTPSecretKEKLabel },
 }
 
 // functions to set fields from the user proto into the user model.
@@ -133,6 +157,22 @@ var userModelSetters = map[string]func(*User, *ttnpb.User){
 			usr.ProfilePicture.fromPB(pb.ProfilePicture)
 		}
 	},
+	totpSecretField: func(usr *User, pb *ttnpb.User) { usr.TOTPSecret = pb.TOTPSecret },
+	totpEnabledAtField: func(usr *User, pb *ttnpb.User) {
+		usr.TOTPEnabledAt = cleanTimePtr(pb.TOTPEnabledAt)
+	},
+	totpRecoveryCodesField: func(usr *User, pb *ttnpb.User) {
+		usr.TOTPRecoveryCodes = pq.StringArray(pb.TOTPRecoveryCodes)
+	},
+	totpLastTimeStepField: func(usr *User, pb *ttnpb.User) { usr.TOTPLastTimeStep = int64(pb.TOTPLastTimeStep) },
+	totpFailedAttemptsField: func(usr *User, pb *ttnpb.User) {
+		usr.TOTPFailedAttempts = int(pb.TOTPFailedAttempts)
+	},
+	totpLockedUntilField: func(usr *User, pb *ttnpb.User) {
+		usr.TOTPLockedUntil = cleanTimePtr(pb.TOTPLockedUntil)
+	},
+	totpEncryptedSecretField: func(usr *User, pb *ttnpb.User) { usr.TOTPEncryptedSecret = pb.TOTPEncryptedSecret },
+	totpSecretKEKLabelField:  func(usr *User, pb *ttnpb.User) { usr.TOTPSecretKEKLabel = pb.TOTPSecretKEKLabel },
 }
 
 // fieldMask to use if a nil or empty fieldmask is passed.
@@ -164,6 +204,14 @@ var userColumnNames = map[string][]string{
 	temporaryPasswordField:              {temporaryPasswordField},
 	temporaryPasswordCreatedAtField:     {temporaryPasswordCreatedAtField},
 	temporaryPasswordExpiresAtField:     {temporaryPasswordExpiresAtField},
+	totpSecretField:                     {totpSecretField},
+	totpEnabledAtField:                  {totpEnabledAtField},
+	totpRecoveryCodesField:              {totpRecoveryCodesField},
+	totpLastTimeStepField:               {totpLastTimeStepField},
+	totpFailedAttemptsField:             {totpFailedAttemptsField},
+	totpLockedUntilField:                {totpLockedUntilField},
+	totpEncryptedSecretField:            {totpEncryptedSecretField},
+	totpSecretKEKLabelField:             {totpSecretKEKLabelField},
 }
 
 func (usr User) toPB(pb *ttnpb.User, fieldMask *types.FieldMask) {
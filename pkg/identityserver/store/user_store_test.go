@@ -122,6 +122,25 @@ func TestUserStore(t *testing.T) {
 			a.So(got.UpdatedAt, should.Equal, updated.UpdatedAt)
 		}
 
+		totpEnabledAt := cleanTime(time.Now())
+		_, err = store.UpdateUser(ctx, &ttnpb.User{
+			UserIdentifiers:   ttnpb.UserIdentifiers{UserID: "foo"},
+			TOTPSecret:        "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+			TOTPEnabledAt:     &totpEnabledAt,
+			TOTPRecoveryCodes: []string{"hash1", "hash2"},
+		}, &types.FieldMask{Paths: []string{"totp_secret", "totp_enabled_at", "totp_recovery_codes"}})
+
+		a.So(err, should.BeNil)
+
+		got, err = store.GetUser(ctx, &ttnpb.UserIdentifiers{UserID: "foo"}, &types.FieldMask{Paths: []string{"totp_secret", "totp_enabled_at", "totp_recovery_codes"}})
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.TOTPSecret, should.Equal, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+			a.So(got.TOTPEnabledAt, should.Resemble, &totpEnabledAt)
+			a.So(got.TOTPRecoveryCodes, should.Resemble, []string{"hash1", "hash2"})
+		}
+
 		list, err := store.FindUsers(ctx, nil, &types.FieldMask{Paths: []string{"name"}})
 
 		a.So(err, should.BeNil)
@@ -63,6 +63,10 @@ func (is *IdentityServer) createUserAPIKey(ctx context.Context, req *ttnpb.Creat
 	if err != nil {
 		return nil, err
 	}
+	key.ExpiresAt, key.AllowedCIDRs = req.ExpiresAt, req.AllowedCIDRs
+	if err = validateAPIKey(key); err != nil {
+		return nil, err
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.UserIdentifiers, key)
 	})
@@ -131,9 +135,12 @@ func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.Updat
 	if err = rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_SETTINGS_API_KEYS); err != nil {
 		return nil, err
 	}
+	if err = validateAPIKey(&req.APIKey, req.FieldMask.Paths...); err != nil {
+		return nil, err
+	}
 
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		if len(req.APIKey.Rights) > 0 {
+		if (len(req.FieldMask.Paths) == 0 || ttnpb.HasAnyField(req.FieldMask.Paths, "rights")) && len(req.APIKey.Rights) > 0 {
 			_, key, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.APIKey.ID)
 			if err != nil {
 				return err
@@ -152,27 +159,24 @@ func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.Updat
 			}
 		}
 
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.UserIdentifiers, &req.APIKey)
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.UserIdentifiers, &req.APIKey, &req.FieldMask)
 		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 	if key == nil {
+		events.Publish(evtDeleteUserAPIKey(ctx, req.UserIdentifiers, nil))
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
-		events.Publish(evtUpdateUserAPIKey(ctx, req.UserIdentifiers, nil))
-		err = is.SendUserEmail(ctx, &req.UserIdentifiers, func(data emails.Data) email.MessageData {
-			data.SetEntity(req.EntityIdentifiers())
-			return &emails.APIKeyChanged{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
-		})
-		if err != nil {
-			log.FromContext(ctx).WithError(err).Error("Could not send API key update notification email")
-		}
-	} else {
-		events.Publish(evtDeleteUserAPIKey(ctx, req.UserIdentifiers, nil))
+	events.Publish(evtUpdateUserAPIKey(ctx, req.UserIdentifiers, nil))
+	err = is.SendUserEmail(ctx, &req.UserIdentifiers, func(data emails.Data) email.MessageData {
+		data.SetEntity(req.EntityIdentifiers())
+		return &emails.APIKeyChanged{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
+	})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("Could not send API key update notification email")
 	}
 	return key, nil
 }
@@ -128,6 +128,9 @@ func (is *IdentityServer) createUser(ctx context.Context, req *ttnpb.CreateUserR
 		req.User.TemporaryPasswordExpiresAt = nil
 		cleanContactInfo(req.User.ContactInfo)
 	}
+	req.User.TOTPSecret, req.User.TOTPEnabledAt, req.User.TOTPRecoveryCodes = "", nil, nil
+	req.User.TOTPLastTimeStep, req.User.TOTPFailedAttempts, req.User.TOTPLockedUntil = 0, 0, nil
+	req.User.TOTPEncryptedSecret, req.User.TOTPSecretKEKLabel = nil, ""
 
 	var primaryEmailAddressFound bool
 	for _, contactInfo := range req.User.ContactInfo {
@@ -630,3 +633,19 @@ func (ur *userRegistry) CreateTemporaryPassword(ctx context.Context, req *ttnpb.
 func (ur *userRegistry) Delete(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
 	return ur.deleteUser(ctx, req)
 }
+
+func (ur *userRegistry) SetupTOTP(ctx context.Context, req *ttnpb.SetupUserTOTPRequest) (*ttnpb.UserTOTPSetup, error) {
+	return ur.setupUserTOTP(ctx, req)
+}
+
+func (ur *userRegistry) EnableTOTP(ctx context.Context, req *ttnpb.EnableUserTOTPRequest) (*ttnpb.UserTOTPRecoveryCodes, error) {
+	return ur.enableUserTOTP(ctx, req)
+}
+
+func (ur *userRegistry) DisableTOTP(ctx context.Context, req *ttnpb.DisableUserTOTPRequest) (*types.Empty, error) {
+	return ur.disableUserTOTP(ctx, req)
+}
+
+func (ur *userRegistry) ResetTOTP(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	return ur.resetUserTOTP(ctx, req)
+}
@@ -0,0 +1,405 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"runtime/trace"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	qrcodegen "github.com/skip2/go-qrcode"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/qrcode"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var evtUpdateUserIncorrectTOTPCode = events.Define(
+	"user.update.incorrect_totp_code", "update user failure: incorrect TOTP code",
+	ttnpb.RIGHT_USER_INFO,
+)
+
+var (
+	errTOTPAlreadyEnabled    = errors.DefineFailedPrecondition("totp_already_enabled", "two-factor authentication already enabled")
+	errTOTPNotSetUp          = errors.DefineFailedPrecondition("totp_not_set_up", "two-factor authentication not set up")
+	errTOTPNotEnabled        = errors.DefineFailedPrecondition("totp_not_enabled", "two-factor authentication not enabled")
+	errIncorrectTOTPCode     = errors.DefineInvalidArgument("totp_code", "incorrect TOTP code")
+	errTOTPLocked            = errors.DefineResourceExhausted("totp_locked", "too many incorrect TOTP codes, try again later")
+	errIncorrectTOTPPassword = errors.DefineUnauthenticated("totp_password", "incorrect password")
+)
+
+const (
+	totpRecoveryCodeCount = 10
+	totpQRCodeSize        = 256
+)
+
+var (
+	totpFieldMask = &types.FieldMask{Paths: []string{
+		"totp_enabled_at", "totp_encrypted_secret", "totp_failed_attempts", "totp_last_time_step", "totp_locked_until",
+		"totp_recovery_codes", "totp_secret", "totp_secret_kek_label",
+	}}
+	setupTOTPFieldMask = &types.FieldMask{Paths: []string{
+		"totp_encrypted_secret", "totp_last_time_step", "totp_secret", "totp_secret_kek_label",
+	}}
+	enableTOTPFieldMask = &types.FieldMask{Paths: []string{
+		"totp_enabled_at", "totp_failed_attempts", "totp_last_time_step", "totp_recovery_codes",
+	}}
+	incorrectTOTPCodeFieldMask = &types.FieldMask{Paths: []string{
+		"totp_failed_attempts", "totp_locked_until",
+	}}
+)
+
+// totpSecret returns the TOTP secret of the user, decrypting it if it is encrypted.
+func (is *IdentityServer) totpSecret(ctx context.Context, usr *ttnpb.User) (string, error) {
+	if len(usr.TOTPEncryptedSecret) == 0 {
+		return usr.TOTPSecret, nil
+	}
+	secret, err := cryptoutil.UnwrapBytes(ctx, usr.TOTPEncryptedSecret, usr.TOTPSecretKEKLabel, is.KeyVault)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+// setTOTPSecret sets the TOTP secret of the user, encrypting it if a KEK label is configured.
+func (is *IdentityServer) setTOTPSecret(ctx context.Context, usr *ttnpb.User, secret string) error {
+	kekLabel := is.configFromContext(ctx).MFA.TOTPSecretKEKLabel
+	if kekLabel == "" {
+		usr.TOTPSecret, usr.TOTPEncryptedSecret, usr.TOTPSecretKEKLabel = secret, nil, ""
+		return nil
+	}
+	encryptedSecret, err := cryptoutil.WrapBytes(ctx, []byte(secret), kekLabel, is.KeyVault)
+	if err != nil {
+		return err
+	}
+	usr.TOTPSecret, usr.TOTPEncryptedSecret, usr.TOTPSecretKEKLabel = "", encryptedSecret, kekLabel
+	return nil
+}
+
+// validateUserTOTP validates the TOTP code of the user. Codes of time steps that were already used are rejected.
+// On success, the time step of the code is stored in the user and the failed attempts are reset. On failure, the
+// failed attempts are counted in the user, locking validation after too many incorrect codes.
+func (is *IdentityServer) validateUserTOTP(ctx context.Context, usr *ttnpb.User, code string, now time.Time) (bool, error) {
+	secret, err := is.totpSecret(ctx, usr)
+	if err != nil {
+		return false, err
+	}
+	timeStep, valid, err := totp.Validate(secret, code, now, usr.TOTPLastTimeStep)
+	if err != nil {
+		return false, err
+	}
+	if valid {
+		usr.TOTPLastTimeStep, usr.TOTPFailedAttempts = timeStep, 0
+	}
+	return valid, nil
+}
+
+// requireUserTOTPAccess requires the caller to be allowed to set up two-factor authentication for the user.
+// Callers that are logged in with an OAuth access token need the basic settings right of the user. Other callers,
+// such as API keys, need all rights of the user and the current password of the user.
+func (is *IdentityServer) requireUserTOTPAccess(ctx context.Context, usrIDs ttnpb.UserIdentifiers, password string) error {
+	authInfo, err := is.authInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if authInfo.GetOAuthAccessToken() != nil {
+		return rights.RequireUser(ctx, usrIDs, ttnpb.RIGHT_USER_SETTINGS_BASIC)
+	}
+	if err := rights.RequireUser(ctx, usrIDs, ttnpb.RIGHT_USER_ALL); err != nil {
+		return err
+	}
+	var valid bool
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		usr, err := store.GetUserStore(db).GetUser(ctx, &usrIDs, &types.FieldMask{Paths: []string{"password"}})
+		if err != nil {
+			return err
+		}
+		region := trace.StartRegion(ctx, "validate password")
+		valid, err = auth.Validate(usr.Password, password)
+		region.End()
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if !valid {
+		events.Publish(evtUpdateUserIncorrectPassword(ctx, usrIDs, nil))
+		return errIncorrectTOTPPassword
+	}
+	return nil
+}
+
+// mfaOrganizationsCache caches whether any organization requires two-factor authentication.
+type mfaOrganizationsCache struct {
+	mu       sync.Mutex
+	required bool
+	expires  time.Time
+}
+
+// anyOrganizationRequiresMFA returns whether any organization requires two-factor authentication.
+// The result is cached for the configured TTL.
+func (is *IdentityServer) anyOrganizationRequiresMFA(ctx context.Context) (required bool, err error) {
+	c := &is.mfaOrganizations
+	now := time.Now()
+	c.mu.Lock()
+	required, expires := c.required, c.expires
+	c.mu.Unlock()
+	if now.Before(expires) {
+		return required, nil
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		required, err = store.GetOrganizationStore(db).AnyOrganizationRequiresMFA(ctx)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	if ttl := is.configFromContext(ctx).MFA.OrganizationsTTL; ttl > 0 {
+		c.mu.Lock()
+		c.required, c.expires = required, now.Add(ttl)
+		c.mu.Unlock()
+	}
+	return required, nil
+}
+
+// invalidateMFAOrganizations invalidates the cache of whether any organization requires two-factor authentication.
+// Other Identity Server instances see the change when their cache expires.
+func (is *IdentityServer) invalidateMFAOrganizations() {
+	c := &is.mfaOrganizations
+	c.mu.Lock()
+	c.expires = time.Time{}
+	c.mu.Unlock()
+}
+
+// mfaRequired returns whether the user is required to enable two-factor authentication,
+// either by the configuration or by one of the organizations that the user is a member of.
+// The memberships of the user are only checked if any organization requires two-factor authentication.
+func (is *IdentityServer) mfaRequired(ctx context.Context, usrIDs ttnpb.UserIdentifiers) (required bool, err error) {
+	if is.configFromContext(ctx).MFA.Required {
+		return true, nil
+	}
+	if required, err = is.anyOrganizationRequiresMFA(ctx); err != nil || !required {
+		return false, err
+	}
+	required = false
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		ids, err := is.getMembershipStore(ctx, db).FindMemberships(ctx, usrIDs.OrganizationOrUserIdentifiers(), "organization", false)
+		if err != nil {
+			return err
+		}
+		orgIDs := make([]*ttnpb.OrganizationIdentifiers, 0, len(ids))
+		for _, id := range ids {
+			if orgID := id.EntityIdentifiers().GetOrganizationIDs(); orgID != nil {
+				orgIDs = append(orgIDs, orgID)
+			}
+		}
+		if len(orgIDs) == 0 {
+			return nil
+		}
+		orgs, err := store.GetOrganizationStore(db).FindOrganizations(ctx, orgIDs, &types.FieldMask{Paths: []string{"require_mfa"}})
+		if err != nil {
+			return err
+		}
+		for _, org := range orgs {
+			if org.RequireMFA {
+				required = true
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return required, nil
+}
+
+func (is *IdentityServer) setupUserTOTP(ctx context.Context, req *ttnpb.SetupUserTOTPRequest) (*ttnpb.UserTOTPSetup, error) {
+	if err := is.requireUserTOTPAccess(ctx, req.UserIdentifiers, req.Password); err != nil {
+		return nil, err
+	}
+	secret := totp.GenerateSecret()
+	provisioning := qrcode.TOTPProvisioning{
+		Issuer:      strings.Replace(is.configFromContext(ctx).Email.Network.Name, ":", "", -1),
+		AccountName: req.UserID,
+		Secret:      secret,
+	}
+	text, err := provisioning.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	qr, err := qrcodegen.New(string(text), qrcodegen.Medium)
+	if err != nil {
+		return nil, err
+	}
+	png, err := qr.PNG(totpQRCodeSize)
+	if err != nil {
+		return nil, err
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		usr, err := store.GetUserStore(db).GetUser(ctx, &req.UserIdentifiers, totpFieldMask)
+		if err != nil {
+			return err
+		}
+		if usr.TOTPEnabledAt != nil {
+			return errTOTPAlreadyEnabled
+		}
+		if err = is.setTOTPSecret(ctx, usr, secret); err != nil {
+			return err
+		}
+		usr.TOTPLastTimeStep = 0
+		_, err = store.GetUserStore(db).UpdateUser(ctx, usr, setupTOTPFieldMask)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtUpdateUser(ctx, req.UserIdentifiers, setupTOTPFieldMask))
+	return &ttnpb.UserTOTPSetup{
+		Secret:          secret,
+		ProvisioningURI: string(text),
+		QRCode: &ttnpb.Picture{
+			Embedded: &ttnpb.Picture_Embedded{
+				MimeType: "image/png",
+				Data:     png,
+			},
+		},
+	}, nil
+}
+
+func (is *IdentityServer) enableUserTOTP(ctx context.Context, req *ttnpb.EnableUserTOTPRequest) (*ttnpb.UserTOTPRecoveryCodes, error) {
+	if err := is.requireUserTOTPAccess(ctx, req.UserIdentifiers, req.Password); err != nil {
+		return nil, err
+	}
+	codes, hashedCodes, err := totp.GenerateRecoveryCodes(ctx, totpRecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	var incorrectCode bool
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		usr, err := store.GetUserStore(db).GetUser(ctx, &req.UserIdentifiers, totpFieldMask)
+		if err != nil {
+			return err
+		}
+		if usr.TOTPEnabledAt != nil {
+			return errTOTPAlreadyEnabled
+		}
+		if usr.TOTPSecret == "" && len(usr.TOTPEncryptedSecret) == 0 {
+			return errTOTPNotSetUp
+		}
+		now := time.Now()
+		if totp.Locked(usr.TOTPLockedUntil, now) {
+			return errTOTPLocked
+		}
+		valid, err := is.validateUserTOTP(ctx, usr, req.Code, now)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			// The failed attempt is stored, so the transaction is committed and the error is returned afterwards.
+			incorrectCode = true
+			usr.TOTPFailedAttempts, usr.TOTPLockedUntil = totp.Failed(usr.TOTPFailedAttempts, now)
+			_, err = store.GetUserStore(db).UpdateUser(ctx, usr, incorrectTOTPCodeFieldMask)
+			return err
+		}
+		usr.TOTPEnabledAt, usr.TOTPRecoveryCodes = &now, hashedCodes
+		_, err = store.GetUserStore(db).UpdateUser(ctx, usr, enableTOTPFieldMask)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if incorrectCode {
+		events.Publish(evtUpdateUserIncorrectTOTPCode(ctx, req.UserIdentifiers, nil))
+		return nil, errIncorrectTOTPCode
+	}
+	events.Publish(evtUpdateUser(ctx, req.UserIdentifiers, enableTOTPFieldMask))
+	return &ttnpb.UserTOTPRecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+func (is *IdentityServer) disableUserTOTP(ctx context.Context, req *ttnpb.DisableUserTOTPRequest) (*types.Empty, error) {
+	if err := rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	var incorrectCode bool
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		usr, err := store.GetUserStore(db).GetUser(ctx, &req.UserIdentifiers, totpFieldMask)
+		if err != nil {
+			return err
+		}
+		if usr.TOTPEnabledAt == nil {
+			return errTOTPNotEnabled
+		}
+		now := time.Now()
+		if totp.Locked(usr.TOTPLockedUntil, now) {
+			return errTOTPLocked
+		}
+		valid, err := is.validateUserTOTP(ctx, usr, req.Code, now)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			region := trace.StartRegion(ctx, "validate recovery code")
+			i, err := totp.ValidateRecoveryCode(usr.TOTPRecoveryCodes, req.Code)
+			region.End()
+			if err != nil {
+				return err
+			}
+			valid = i != -1
+		}
+		if !valid {
+			// The failed attempt is stored, so the transaction is committed and the error is returned afterwards.
+			incorrectCode = true
+			usr.TOTPFailedAttempts, usr.TOTPLockedUntil = totp.Failed(usr.TOTPFailedAttempts, now)
+			_, err = store.GetUserStore(db).UpdateUser(ctx, usr, incorrectTOTPCodeFieldMask)
+			return err
+		}
+		_, err = store.GetUserStore(db).UpdateUser(ctx, &ttnpb.User{UserIdentifiers: req.UserIdentifiers}, totpFieldMask)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if incorrectCode {
+		events.Publish(evtUpdateUserIncorrectTOTPCode(ctx, req.UserIdentifiers, nil))
+		return nil, errIncorrectTOTPCode
+	}
+	events.Publish(evtUpdateUser(ctx, req.UserIdentifiers, totpFieldMask))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) resetUserTOTP(ctx context.Context, ids *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		_, err := store.GetUserStore(db).UpdateUser(ctx, &ttnpb.User{UserIdentifiers: *ids}, totpFieldMask)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtUpdateUser(ctx, ids, totpFieldMask))
+	return ttnpb.Empty, nil
+}
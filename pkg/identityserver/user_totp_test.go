@@ -0,0 +1,350 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// incorrectTOTPCode returns a code that differs from the given code in every digit.
+func incorrectTOTPCode(code string) string {
+	b := []byte(code)
+	for i := range b {
+		b[i] = '0' + (b[i]-'0'+5)%10
+	}
+	return string(b)
+}
+
+func TestUserTOTP(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		adminCreds := userCreds(adminUserIdx)
+
+		reg := ttnpb.NewUserRegistryClient(cc)
+
+		enable := func() *ttnpb.UserTOTPRecoveryCodes {
+			setup, err := reg.SetupTOTP(ctx, &ttnpb.SetupUserTOTPRequest{
+				UserIdentifiers: userID,
+				Password:        defaultUser.Password,
+			}, creds)
+			if !a.So(err, should.BeNil) || !a.So(setup, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(setup.Secret, should.NotBeEmpty)
+			a.So(setup.ProvisioningURI, should.StartWith, "otpauth://totp/")
+			a.So(setup.ProvisioningURI, should.ContainSubstring, "secret="+setup.Secret)
+			if a.So(setup.QRCode.GetEmbedded(), should.NotBeNil) {
+				a.So(setup.QRCode.Embedded.MimeType, should.Equal, "image/png")
+				a.So(setup.QRCode.Embedded.Data, should.NotBeEmpty)
+			}
+
+			code, err := totp.Code(setup.Secret, time.Now())
+			a.So(err, should.BeNil)
+
+			_, err = reg.EnableTOTP(ctx, &ttnpb.EnableUserTOTPRequest{
+				UserIdentifiers: userID,
+				Code:            code,
+				Password:        "incorrect password",
+			}, creds)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsUnauthenticated(err), should.BeTrue)
+			}
+
+			_, err = reg.EnableTOTP(ctx, &ttnpb.EnableUserTOTPRequest{
+				UserIdentifiers: userID,
+				Code:            incorrectTOTPCode(code),
+				Password:        defaultUser.Password,
+			}, creds)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+
+			recoveryCodes, err := reg.EnableTOTP(ctx, &ttnpb.EnableUserTOTPRequest{
+				UserIdentifiers: userID,
+				Code:            code,
+				Password:        defaultUser.Password,
+			}, creds)
+			if !a.So(err, should.BeNil) || !a.So(recoveryCodes, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(recoveryCodes.RecoveryCodes, should.HaveLength, totpRecoveryCodeCount)
+			return recoveryCodes
+		}
+
+		for _, password := range []string{"", "incorrect password"} {
+			_, err := reg.SetupTOTP(ctx, &ttnpb.SetupUserTOTPRequest{
+				UserIdentifiers: userID,
+				Password:        password,
+			}, creds)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsUnauthenticated(err), should.BeTrue)
+			}
+		}
+
+		recoveryCodes := enable()
+
+		_, err := reg.SetupTOTP(ctx, &ttnpb.SetupUserTOTPRequest{
+			UserIdentifiers: userID,
+			Password:        defaultUser.Password,
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		}
+
+		got, err := reg.Get(ctx, &ttnpb.GetUserRequest{
+			UserIdentifiers: userID,
+			FieldMask:       types.FieldMask{Paths: []string{"totp_enabled_at"}},
+		}, creds)
+		if a.So(err, should.BeNil) {
+			a.So(got.TOTPEnabledAt, should.NotBeNil)
+			a.So(got.TOTPSecret, should.BeEmpty)
+			a.So(got.TOTPRecoveryCodes, should.BeEmpty)
+		}
+
+		_, err = reg.DisableTOTP(ctx, &ttnpb.DisableUserTOTPRequest{
+			UserIdentifiers: userID,
+			Code:            "aaaa-bbbb",
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = reg.DisableTOTP(ctx, &ttnpb.DisableUserTOTPRequest{
+			UserIdentifiers: userID,
+			Code:            strings.ToUpper(recoveryCodes.RecoveryCodes[3]),
+		}, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.DisableTOTP(ctx, &ttnpb.DisableUserTOTPRequest{
+			UserIdentifiers: userID,
+			Code:            recoveryCodes.RecoveryCodes[3],
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		}
+
+		enable()
+
+		_, err = reg.ResetTOTP(ctx, &userID, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.ResetTOTP(ctx, &userID, adminCreds)
+		a.So(err, should.BeNil)
+
+		got, err = reg.Get(ctx, &ttnpb.GetUserRequest{
+			UserIdentifiers: userID,
+			FieldMask:       types.FieldMask{Paths: []string{"totp_enabled_at"}},
+		}, creds)
+		if a.So(err, should.BeNil) {
+			a.So(got.TOTPEnabledAt, should.BeNil)
+		}
+	})
+}
+
+func TestUserTOTPProtection(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		adminCreds := userCreds(adminUserIdx)
+
+		is.KeyVault = cryptoutil.NewMemKeyVault(map[string][]byte{
+			"totp": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+		})
+		is.config.MFA.TOTPSecretKEKLabel = "totp"
+		defer func() { is.config.MFA.TOTPSecretKEKLabel = "" }()
+
+		reg := ttnpb.NewUserRegistryClient(cc)
+
+		setup, err := reg.SetupTOTP(ctx, &ttnpb.SetupUserTOTPRequest{
+			UserIdentifiers: userID,
+			Password:        defaultUser.Password,
+		}, creds)
+		if !a.So(err, should.BeNil) || !a.So(setup, should.NotBeNil) {
+			t.FailNow()
+		}
+
+		usr, err := store.GetUserStore(is.db).GetUser(ctx, &userID, totpFieldMask)
+		if a.So(err, should.BeNil) {
+			a.So(usr.TOTPSecret, should.BeEmpty)
+			a.So(usr.TOTPEncryptedSecret, should.NotBeEmpty)
+			a.So(usr.TOTPSecretKEKLabel, should.Equal, "totp")
+		}
+
+		usr, err = oauthUserStore{UserStore: store.GetUserStore(is.db), is: is}.GetUser(
+			ctx, &userID, &types.FieldMask{Paths: []string{"totp_secret"}},
+		)
+		if a.So(err, should.BeNil) {
+			a.So(usr.TOTPSecret, should.Equal, setup.Secret)
+		}
+
+		code, err := totp.Code(setup.Secret, time.Now())
+		a.So(err, should.BeNil)
+
+		_, err = reg.EnableTOTP(ctx, &ttnpb.EnableUserTOTPRequest{
+			UserIdentifiers: userID,
+			Code:            code,
+			Password:        defaultUser.Password,
+		}, creds)
+		a.So(err, should.BeNil)
+
+		// The code was already used.
+		_, err = reg.DisableTOTP(ctx, &ttnpb.DisableUserTOTPRequest{
+			UserIdentifiers: userID,
+			Code:            code,
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		for i := 1; i < totp.MaxFailedAttempts; i++ {
+			_, err = reg.DisableTOTP(ctx, &ttnpb.DisableUserTOTPRequest{
+				UserIdentifiers: userID,
+				Code:            incorrectTOTPCode(code),
+			}, creds)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		}
+
+		nextCode, err := totp.Code(setup.Secret, time.Now().Add(totp.Period))
+		a.So(err, should.BeNil)
+
+		_, err = reg.DisableTOTP(ctx, &ttnpb.DisableUserTOTPRequest{
+			UserIdentifiers: userID,
+			Code:            nextCode,
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+		}
+
+		_, err = reg.ResetTOTP(ctx, &userID, adminCreds)
+		a.So(err, should.BeNil)
+
+		usr, err = store.GetUserStore(is.db).GetUser(ctx, &userID, totpFieldMask)
+		if a.So(err, should.BeNil) {
+			a.So(usr.TOTPEncryptedSecret, should.BeEmpty)
+			a.So(usr.TOTPFailedAttempts, should.BeZeroValue)
+			a.So(usr.TOTPLockedUntil, should.BeNil)
+		}
+	})
+}
+
+func TestUserTOTPRequired(t *testing.T) {
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		adminCreds := userCreds(adminUserIdx)
+
+		cli := ttnpb.NewEntityAccessClient(cc)
+		orgReg := ttnpb.NewOrganizationRegistryClient(cc)
+
+		assertRestricted := func(t *testing.T, restricted bool) {
+			a := assertions.New(t)
+			var md metadata.MD
+			authInfo, err := cli.AuthInfo(ctx, ttnpb.Empty, creds, grpc.Header(&md))
+			if !a.So(err, should.BeNil) || !a.So(authInfo.GetAPIKey(), should.NotBeNil) {
+				t.FailNow()
+			}
+			rights := ttnpb.RightsFrom(authInfo.GetAPIKey().GetRights()...)
+			if restricted {
+				a.So(md.Get("warning"), should.Contain, "Restricted rights until two-factor authentication enabled")
+				a.So(rights.IncludesAll(ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_USER_SETTINGS_BASIC), should.BeTrue)
+				a.So(rights.IncludesAll(ttnpb.RIGHT_USER_APPLICATIONS_LIST), should.BeFalse)
+			} else {
+				a.So(md.Get("warning"), should.NotContain, "Restricted rights until two-factor authentication enabled")
+				a.So(rights.IncludesAll(ttnpb.RIGHT_USER_APPLICATIONS_LIST), should.BeTrue)
+			}
+		}
+
+		t.Run("Config", func(t *testing.T) {
+			is.config.MFA.Required = true
+			defer func() { is.config.MFA.Required = false }()
+
+			assertRestricted(t, true)
+		})
+
+		t.Run("Organization", func(t *testing.T) {
+			a := assertions.New(t)
+
+			_, err := orgReg.Create(ctx, &ttnpb.CreateOrganizationRequest{
+				Organization: ttnpb.Organization{
+					OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "mfa-org"},
+					RequireMFA:              true,
+				},
+				Collaborator: *userID.OrganizationOrUserIdentifiers(),
+			}, creds)
+			a.So(err, should.BeNil)
+
+			assertRestricted(t, false)
+
+			_, err = orgReg.Update(ctx, &ttnpb.UpdateOrganizationRequest{
+				Organization: ttnpb.Organization{
+					OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "mfa-org"},
+					RequireMFA:              true,
+				},
+				FieldMask: types.FieldMask{Paths: []string{"require_mfa"}},
+			}, creds)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			}
+
+			_, err = orgReg.Update(ctx, &ttnpb.UpdateOrganizationRequest{
+				Organization: ttnpb.Organization{
+					OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "mfa-org"},
+					RequireMFA:              true,
+				},
+				FieldMask: types.FieldMask{Paths: []string{"require_mfa"}},
+			}, adminCreds)
+			a.So(err, should.BeNil)
+
+			assertRestricted(t, true)
+
+			_, err = orgReg.Update(ctx, &ttnpb.UpdateOrganizationRequest{
+				Organization: ttnpb.Organization{
+					OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "mfa-org"},
+				},
+				FieldMask: types.FieldMask{Paths: []string{"require_mfa"}},
+			}, adminCreds)
+			a.So(err, should.BeNil)
+
+			assertRestricted(t, false)
+
+			_, err = orgReg.Delete(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "mfa-org"}, creds)
+			a.So(err, should.BeNil)
+		})
+	})
+}
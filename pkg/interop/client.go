@@ -107,6 +107,14 @@ func (p jsRPCPaths) rejoin() string  { return p.Rejoin }
 func (p jsRPCPaths) appSKey() string { return p.AppSKey }
 func (p jsRPCPaths) homeNS() string  { return p.HomeNS }
 
+type nsRPCPaths struct {
+	SNS string `yaml:"sns"`
+	FNS string `yaml:"fns"`
+}
+
+func (p nsRPCPaths) sns() string { return p.SNS }
+func (p nsRPCPaths) fns() string { return p.FNS }
+
 func serverURL(scheme, fqdn, path string, port uint32) string {
 	if scheme == "" {
 		scheme = "https"
@@ -157,6 +165,16 @@ func JoinServerFQDN(eui types.EUI64, domain string) string {
 	)
 }
 
+// NetworkServerFQDN constructs Network Server FQDN using specified NetID under domain
+// according to LoRaWAN Backend Interfaces specification.
+// If domain is empty, LoRaAllianceNetIDDomain is used.
+func NetworkServerFQDN(netID types.NetID, domain string) string {
+	if domain == "" {
+		domain = LoRaAllianceNetIDDomain
+	}
+	return fmt.Sprintf("%s.%s", strings.ToLower(netID.String()), domain)
+}
+
 func httpExchange(ctx context.Context, httpReq *http.Request, res interface{}, do func(*http.Request) (*http.Response, error)) error {
 	logger := log.FromContext(ctx).WithField("url", httpReq.URL)
 
@@ -336,6 +354,69 @@ func makeJoinServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPat
 	}
 }
 
+type networkServerHTTPClient struct {
+	Client         http.Client
+	NewRequestFunc func(types.NetID, func(nsRPCPaths) string, interface{}) (*http.Request, error)
+}
+
+func (cl networkServerHTTPClient) exchange(ctx context.Context, netID types.NetID, pathFunc func(nsRPCPaths) string, req, res interface{}) error {
+	httpReq, err := cl.NewRequestFunc(netID, pathFunc, req)
+	if err != nil {
+		return err
+	}
+	return httpExchange(ctx, httpReq.WithContext(ctx), res, cl.Client.Do)
+}
+
+// PRStartRequest performs passive roaming start request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	ans := &PRStartAns{}
+	if err := cl.exchange(ctx, types.NetID(req.ReceiverID), nsRPCPaths.sns, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// XmitDataRequest performs data transmission request according to LoRaWAN Backend Interfaces specification.
+// Downlink messages are sent to the fNS, uplink messages are sent to the sNS.
+func (cl networkServerHTTPClient) XmitDataRequest(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+	pathFunc := nsRPCPaths.sns
+	if req.DLMetaData != nil {
+		pathFunc = nsRPCPaths.fns
+	}
+	ans := &XmitDataAns{}
+	if err := cl.exchange(ctx, types.NetID(req.ReceiverID), pathFunc, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+func makeNetworkServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPaths nsRPCPaths, headers map[string]string) func(types.NetID, func(nsRPCPaths) string, interface{}) (*http.Request, error) {
+	return func(netID types.NetID, pathFunc func(nsRPCPaths) string, pld interface{}) (*http.Request, error) {
+		fqdn := fqdn // Create a new reference to fqdn to avoid mutating the variable in the outside scope.
+		if fqdn == "" {
+			fqdn = NetworkServerFQDN(netID, dns)
+		}
+		return newHTTPRequest(serverURL(scheme, fqdn, pathFunc(rpcPaths), port), pld, headers)
+	}
+}
+
+type networkServerClient interface {
+	PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error)
+	XmitDataRequest(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error)
+}
+
+type netIDNetworkServerClient struct {
+	networkServerClient
+	netID  types.NetID
+	prefix types.DevAddrPrefix
+}
+
 type joinServerClient interface {
 	HandleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
 	GetAppSKey(ctx context.Context, asID string, req *ttnpb.SessionKeyRequest) (*ttnpb.AppSKeyResponse, error)
@@ -347,7 +428,8 @@ type prefixJoinServerClient struct {
 }
 
 type Client struct {
-	joinServers []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	joinServers    []prefixJoinServerClient   // Sorted by JoinEUI prefix range length.
+	networkServers []netIDNetworkServerClient // Sorted by DevAddr prefix length.
 }
 
 var errUnknownProtocol = errors.DefineInvalidArgument("unknown_protocol", "unknown protocol")
@@ -430,6 +512,10 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 			File     string              `yaml:"file"`
 			JoinEUIs []types.EUI64Prefix `yaml:"join-euis"`
 		} `yaml:"join-servers"`
+		NetworkServers []struct {
+			File   string        `yaml:"file"`
+			NetIDs []types.NetID `yaml:"net-ids"`
+		} `yaml:"network-servers"`
 	}
 	if err := yaml.UnmarshalStrict(confFileBytes, &yamlConf); err != nil {
 		return nil, err
@@ -503,8 +589,66 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 		}
 		return pi.EUI64.MarshalNumber() > pj.EUI64.MarshalNumber()
 	})
+
+	nss := make([]netIDNetworkServerClient, 0, len(yamlConf.NetworkServers))
+	for _, nsConf := range yamlConf.NetworkServers {
+		nsConfEls := strings.Split(filepath.ToSlash(nsConf.File), "/")
+
+		fetcher := fetch.WithBasePath(fetcher, nsConfEls[:len(nsConfEls)-1]...)
+		nsFileBytes, err := fetcher.File(nsConfEls[len(nsConfEls)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		var yamlNSConf struct {
+			ComponentConfig `yaml:",inline"`
+			Paths           nsRPCPaths `yaml:"paths"`
+		}
+		if err := yaml.UnmarshalStrict(nsFileBytes, &yamlNSConf); err != nil {
+			return nil, err
+		}
+
+		tlsConf := fallbackTLS
+		if !yamlNSConf.TLS.IsZero() {
+			tlsConf, err = yamlNSConf.TLS.TLSConfig(fetcher)
+			if err != nil {
+				return nil, err
+			}
+		}
+		var tr *http.Transport
+		if tlsConf != nil {
+			tr = &http.Transport{
+				TLSClientConfig: tlsConf,
+			}
+		}
+		ns := &networkServerHTTPClient{
+			Client: http.Client{
+				Transport: tr,
+			},
+			NewRequestFunc: makeNetworkServerHTTPRequestFunc("https", yamlNSConf.DNS, yamlNSConf.FQDN, yamlNSConf.Port, yamlNSConf.Paths, yamlNSConf.Headers),
+		}
+		for _, netID := range nsConf.NetIDs {
+			devAddr, err := types.NewDevAddr(netID, nil)
+			if err != nil {
+				return nil, err
+			}
+			nss = append(nss, netIDNetworkServerClient{
+				networkServerClient: ns,
+				netID:               netID,
+				prefix: types.DevAddrPrefix{
+					DevAddr: devAddr,
+					Length:  uint8(32 - types.NwkAddrBits(netID)),
+				},
+			})
+		}
+	}
+	sort.Slice(nss, func(i, j int) bool {
+		return nss[i].prefix.Length > nss[j].prefix.Length
+	})
+
 	return &Client{
-		joinServers: jss,
+		joinServers:    jss,
+		networkServers: nss,
 	}, nil
 }
 
@@ -539,3 +683,46 @@ func (cl Client) HandleJoinRequest(ctx context.Context, netID types.NetID, req *
 	}
 	return js.HandleJoinRequest(ctx, netID, req)
 }
+
+// HasNetworkServers returns whether the client is configured with roaming partner Network Servers.
+func (cl Client) HasNetworkServers() bool {
+	return len(cl.networkServers) > 0
+}
+
+// NetworkServerNetID returns the NetID of the roaming partner Network Server which is associated with addr.
+func (cl Client) NetworkServerNetID(addr types.DevAddr) (types.NetID, bool) {
+	// NOTE: networkServers slice is sorted by prefix length, hence the first match is the most specific one.
+	for _, ns := range cl.networkServers {
+		if ns.prefix.Matches(addr) {
+			return ns.netID, true
+		}
+	}
+	return types.NetID{}, false
+}
+
+func (cl Client) networkServer(netID types.NetID) (networkServerClient, bool) {
+	for _, ns := range cl.networkServers {
+		if ns.netID == netID {
+			return ns.networkServerClient, true
+		}
+	}
+	return nil, false
+}
+
+// PRStartRequest performs passive roaming start request to Network Server associated with req.ReceiverID.
+func (cl Client) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	ns, ok := cl.networkServer(types.NetID(req.ReceiverID))
+	if !ok {
+		return nil, errNotRegistered
+	}
+	return ns.PRStartRequest(ctx, req)
+}
+
+// XmitDataRequest performs data transmission request to Network Server associated with req.ReceiverID.
+func (cl Client) XmitDataRequest(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+	ns, ok := cl.networkServer(types.NetID(req.ReceiverID))
+	if !ok {
+		return nil, errNotRegistered
+	}
+	return ns.XmitDataRequest(ctx, req)
+}
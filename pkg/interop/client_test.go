@@ -1354,3 +1354,214 @@ paths:
 		})
 	}
 }
+
+type mockServingNetworkServer struct {
+	PRStartRequestFunc  func(context.Context, *PRStartReq) (*PRStartAns, error)
+	XmitDataRequestFunc func(context.Context, *XmitDataReq) (*XmitDataAns, error)
+}
+
+func (m mockServingNetworkServer) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	return m.PRStartRequestFunc(ctx, req)
+}
+
+func (m mockServingNetworkServer) XmitDataRequest(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+	return m.XmitDataRequestFunc(ctx, req)
+}
+
+type mockForwardingNetworkServer struct {
+	XmitDataRequestFunc func(context.Context, *XmitDataReq) (*XmitDataAns, error)
+}
+
+func (m mockForwardingNetworkServer) XmitDataRequest(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+	return m.XmitDataRequestFunc(ctx, req)
+}
+
+func TestPassiveRoaming(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	ctx = log.NewContext(ctx, test.GetLogger(t))
+
+	senderNetID, receiverNetID := types.NetID{0x00, 0x00, 0x01}, types.NetID{0x00, 0x00, 0x02}
+	phyPayload := Buffer{0x40, 0x04, 0x03, 0x02, 0x05, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04}
+	ulFreq, dataRate := 868.1, 5
+
+	s, err := NewServer(ctx, nil, config.InteropServer{
+		SenderClientCA: config.SenderClientCA{
+			Source:    "directory",
+			Directory: "testdata",
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.Fatal("Could not create an interop instance")
+	}
+	s.RegisterSNS(mockServingNetworkServer{
+		PRStartRequestFunc: func(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+			a := assertions.New(t)
+			a.So(req.SenderID, should.Equal, NetID(senderNetID))
+			a.So(req.ReceiverID, should.Equal, NetID(receiverNetID))
+			a.So(req.PHYPayload, should.Resemble, phyPayload)
+			if !a.So(req.ULMetaData.DataRate, should.NotBeNil) || !a.So(req.ULMetaData.ULFreq, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(*req.ULMetaData.DataRate, should.Equal, dataRate)
+			a.So(*req.ULMetaData.ULFreq, should.Equal, ulFreq)
+			if len(req.ULMetaData.GWInfo) != 1 {
+				return nil, ErrMalformedMessage
+			}
+			if !req.ULMetaData.GWInfo[0].DLAllowed {
+				return nil, ErrUnknownDevAddr
+			}
+			header, err := req.AnswerHeader()
+			if err != nil {
+				return nil, err
+			}
+			var lifetime uint32
+			return &PRStartAns{
+				NsMessageHeader: header,
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+				Lifetime: &lifetime,
+			}, nil
+		},
+		XmitDataRequestFunc: func(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+			t.Error("XmitDataRequest called on sNS")
+			return nil, ErrMalformedMessage
+		},
+	})
+	s.RegisterFNS(mockForwardingNetworkServer{
+		XmitDataRequestFunc: func(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+			a := assertions.New(t)
+			a.So(req.PHYPayload, should.Resemble, phyPayload)
+			if !a.So(req.DLMetaData, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(req.DLMetaData.GWInfo, should.HaveLength, 1)
+			header, err := req.AnswerHeader()
+			if err != nil {
+				return nil, err
+			}
+			return &XmitDataAns{
+				NsMessageHeader: header,
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+			}, nil
+		},
+	})
+
+	srv := newTLSServer(s)
+	defer srv.Close()
+
+	host := strings.Split(test.Must(url.Parse(srv.URL)).(*url.URL).Host, ":")
+	if len(host) != 2 {
+		t.Fatalf("Invalid server host: %s", host)
+	}
+
+	confDir := test.Must(ioutil.TempDir("", "lorawan-stack-ns-interop-test")).(string)
+	defer os.RemoveAll(confDir)
+	test.MustMultiple(os.Mkdir(filepath.Join(confDir, "testdata"), 0755))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientCertPath), ClientCert, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientKeyPath), ClientKey, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, RootCAPath), RootCA, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, InteropClientConfigurationName), []byte(`network-servers:
+   - file: test-ns.yml
+     net-ids:
+        - 000002`,
+	), 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, "test-ns.yml"), []byte(fmt.Sprintf(`fqdn: %s
+port: %s
+paths:
+   sns: sns
+   fns: fns
+tls:
+   root-ca: %s
+   certificate: %s
+   key: %s`,
+		host[0],
+		host[1],
+		RootCAPath,
+		ClientCertPath,
+		ClientKeyPath,
+	)), 0644))
+
+	cl, err := NewClient(ctx, config.InteropClient{
+		Directory:            confDir,
+		GetFallbackTLSConfig: func(context.Context) (*tls.Config, error) { return nil, nil },
+	})
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Failed to create new client: %s", err)
+	}
+	a.So(cl.HasNetworkServers(), should.BeTrue)
+
+	netID, ok := cl.NetworkServerNetID(types.DevAddr{0x04, 0x03, 0x02, 0x01})
+	a.So(ok, should.BeTrue)
+	a.So(netID, should.Equal, receiverNetID)
+	_, ok = cl.NetworkServerNetID(types.DevAddr{0x26, 0x03, 0x02, 0x01})
+	a.So(ok, should.BeFalse)
+
+	makeHeader := func(typ MessageType, receiverID types.NetID) NsMessageHeader {
+		return NsMessageHeader{
+			MessageHeader: MessageHeader{
+				ProtocolVersion: "1.0",
+				MessageType:     typ,
+			},
+			SenderID:   NetID(senderNetID),
+			ReceiverID: NetID(receiverID),
+		}
+	}
+	makeULMetaData := func(dlAllowed bool) ULMetaData {
+		return ULMetaData{
+			DataRate: &dataRate,
+			ULFreq:   &ulFreq,
+			RFRegion: "EU_863_870",
+			GWInfo: []GWInfoElement{
+				{
+					ULToken:   Buffer{0x01, 0x02, 0x03},
+					DLAllowed: dlAllowed,
+				},
+			},
+		}
+	}
+
+	prStartAns, err := cl.PRStartRequest(ctx, &PRStartReq{
+		NsMessageHeader: makeHeader(MessageTypePRStartReq, receiverNetID),
+		PHYPayload:      phyPayload,
+		ULMetaData:      makeULMetaData(true),
+	})
+	if a.So(err, should.BeNil) && a.So(prStartAns.Lifetime, should.NotBeNil) {
+		a.So(*prStartAns.Lifetime, should.Equal, 0)
+		a.So(prStartAns.SenderID, should.Equal, NetID(receiverNetID))
+	}
+
+	_, err = cl.PRStartRequest(ctx, &PRStartReq{
+		NsMessageHeader: makeHeader(MessageTypePRStartReq, receiverNetID),
+		PHYPayload:      phyPayload,
+		ULMetaData:      makeULMetaData(false),
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, ErrUnknownDevAddr)
+
+	_, err = cl.PRStartRequest(ctx, &PRStartReq{
+		NsMessageHeader: makeHeader(MessageTypePRStartReq, types.NetID{0x00, 0x00, 0x03}),
+		PHYPayload:      phyPayload,
+		ULMetaData:      makeULMetaData(true),
+	})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	xmitDataAns, err := cl.XmitDataRequest(ctx, &XmitDataReq{
+		NsMessageHeader: makeHeader(MessageTypeXmitDataReq, receiverNetID),
+		PHYPayload:      phyPayload,
+		DLMetaData: &DLMetaData{
+			GWInfo: []GWInfoElement{
+				{
+					ULToken:   Buffer{0x01, 0x02, 0x03},
+					DLAllowed: true,
+				},
+			},
+		},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(xmitDataAns.Result.ResultCode, should.Equal, ResultSuccess)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	echo "github.com/labstack/echo/v4"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -219,6 +220,109 @@ type HomeNSAns struct {
 	HNetID NetID
 }
 
+// NsMessageHeader contains the message header for NS to NS messages.
+type NsMessageHeader struct {
+	MessageHeader
+	SenderID   NetID
+	ReceiverID NetID
+}
+
+// AnswerHeader returns the header of the answer message.
+func (h NsMessageHeader) AnswerHeader() (NsMessageHeader, error) {
+	header, err := h.MessageHeader.AnswerHeader()
+	if err != nil {
+		return NsMessageHeader{}, err
+	}
+	return NsMessageHeader{
+		MessageHeader: header,
+		SenderID:      h.ReceiverID,
+		ReceiverID:    h.SenderID,
+	}, nil
+}
+
+// GWInfoElement contains the metadata of a gateway that received an uplink message.
+type GWInfoElement struct {
+	ID        Buffer
+	RFRegion  string   `json:",omitempty"`
+	RSSI      *float32 `json:",omitempty"`
+	SNR       *float32 `json:",omitempty"`
+	Lat       *float64 `json:",omitempty"`
+	Lon       *float64 `json:",omitempty"`
+	ULToken   Buffer   `json:",omitempty"`
+	DLAllowed bool
+}
+
+// ULMetaData contains the metadata of an uplink message.
+type ULMetaData struct {
+	DevAddr   *DevAddr `json:",omitempty"`
+	FPort     *uint8   `json:",omitempty"`
+	FCntUp    *uint32  `json:",omitempty"`
+	Confirmed bool
+	DataRate  *int `json:",omitempty"`
+	// ULFreq is the uplink frequency in MHz.
+	ULFreq     *float64 `json:",omitempty"`
+	RecvTime   time.Time
+	RFRegion   string `json:",omitempty"`
+	FNSULToken Buffer `json:",omitempty"`
+	GWCnt      *int   `json:",omitempty"`
+	GWInfo     []GWInfoElement
+}
+
+// DLMetaData contains the metadata of a downlink message.
+type DLMetaData struct {
+	DevAddr *DevAddr `json:",omitempty"`
+	FPort   *uint8   `json:",omitempty"`
+	// DLFreq1 is the frequency of the first receive window in MHz.
+	DLFreq1 *float64 `json:",omitempty"`
+	// DLFreq2 is the frequency of the second receive window in MHz.
+	DLFreq2 *float64 `json:",omitempty"`
+	// RXDelay1 is the delay of the first receive window in seconds.
+	RXDelay1       *int    `json:",omitempty"`
+	ClassMode      *string `json:",omitempty"`
+	DataRate1      *int    `json:",omitempty"`
+	DataRate2      *int    `json:",omitempty"`
+	FNSULToken     Buffer  `json:",omitempty"`
+	GWInfo         []GWInfoElement
+	HiPriorityFlag bool
+}
+
+// PRStartReq is a passive roaming start request message.
+type PRStartReq struct {
+	NsMessageHeader
+	PHYPayload Buffer
+	ULMetaData ULMetaData
+}
+
+// PRStartAns is an answer to a PRStartReq message.
+type PRStartAns struct {
+	NsMessageHeader
+	Result Result
+	// Lifetime is the lifetime of the passive roaming session in seconds. A zero lifetime means that the
+	// roaming is stateless and that each uplink message is forwarded with a PRStartReq message.
+	Lifetime *uint32 `json:",omitempty"`
+	FCntUp   *uint32 `json:",omitempty"`
+	DevEUI   *EUI64  `json:",omitempty"`
+}
+
+// XmitDataReq is a data transmission request message.
+// It carries either an uplink message with ULMetaData or a downlink message with DLMetaData.
+type XmitDataReq struct {
+	NsMessageHeader
+	PHYPayload Buffer
+	ULMetaData *ULMetaData `json:",omitempty"`
+	DLMetaData *DLMetaData `json:",omitempty"`
+}
+
+// XmitDataAns is an answer to a XmitDataReq message.
+type XmitDataAns struct {
+	NsMessageHeader
+	Result Result
+	// DLFreq1 is the frequency of the first receive window in MHz, which is used by the fNS.
+	DLFreq1 *float64 `json:",omitempty"`
+	// DLFreq2 is the frequency of the second receive window in MHz, which is used by the fNS.
+	DLFreq2 *float64 `json:",omitempty"`
+}
+
 // parseMessage parses the header and the message type of the request body.
 // This middleware sets the header in the context on the `headerKey` and the message on the `messageKey`.
 func parseMessage() echo.MiddlewareFunc {
@@ -258,6 +362,14 @@ func parseMessage() echo.MiddlewareFunc {
 				msg = &HomeNSReq{}
 			case MessageTypeHomeNSAns:
 				msg = &HomeNSAns{}
+			case MessageTypePRStartReq:
+				msg = &PRStartReq{}
+			case MessageTypePRStartAns:
+				msg = &PRStartAns{}
+			case MessageTypeXmitDataReq:
+				msg = &XmitDataReq{}
+			case MessageTypeXmitDataAns:
+				msg = &XmitDataAns{}
 			default:
 				return ErrMalformedMessage
 			}
@@ -57,10 +57,13 @@ type HomeNetworkServer interface {
 
 // ServingNetworkServer represents a Serving Network Server.
 type ServingNetworkServer interface {
+	PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error)
+	XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error)
 }
 
 // ForwardingNetworkServer represents a Forwarding Network Server.
 type ForwardingNetworkServer interface {
+	XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error)
 }
 
 // ApplicationServer represents an Application Server.
@@ -81,6 +84,14 @@ func (noopServer) HomeNSRequest(context.Context, *HomeNSReq) (*HomeNSAns, error)
 	return nil, errNotRegistered
 }
 
+func (noopServer) PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error) {
+	return nil, errNotRegistered
+}
+
+func (noopServer) XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error) {
+	return nil, errNotRegistered
+}
+
 // Server is the server.
 type Server struct {
 	SenderClientCAs map[string][]*x509.Certificate
@@ -260,12 +271,17 @@ func (s *Server) RegisterAS(as ApplicationServer) {
 	s.as = as
 }
 
-func (s *Server) handleRequest(c echo.Context) error {
+func requestContext(c echo.Context) context.Context {
 	cid := fmt.Sprintf("interop:%s:%s", c.Request().URL.Path, c.Request().Header.Get(echo.HeaderXRequestID))
 	ctx := events.ContextWithCorrelationID(c.Request().Context(), cid)
 	if state := c.Request().TLS; state != nil {
 		ctx = auth.NewContextWithX509DN(ctx, state.PeerCertificates[0].Subject)
 	}
+	return ctx
+}
+
+func (s *Server) handleRequest(c echo.Context) error {
+	ctx := requestContext(c)
 
 	var ans interface{}
 	var err error
@@ -276,6 +292,15 @@ func (s *Server) handleRequest(c echo.Context) error {
 		ans, err = s.js.HomeNSRequest(ctx, req)
 	case *AppSKeyReq:
 		ans, err = s.js.AppSKeyRequest(ctx, req)
+	case *PRStartReq:
+		ans, err = s.sNS.PRStartRequest(ctx, req)
+	case *XmitDataReq:
+		// In 1.0, uplink messages are sent to the sNS and downlink messages are sent to the fNS.
+		if req.DLMetaData != nil {
+			ans, err = s.fNS.XmitDataRequest(ctx, req)
+		} else {
+			ans, err = s.sNS.XmitDataRequest(ctx, req)
+		}
 	default:
 		return ErrMalformedMessage
 	}
@@ -286,6 +311,33 @@ func (s *Server) handleRequest(c echo.Context) error {
 }
 
 func (s *Server) handleNsRequest(c echo.Context) error {
-	// TODO: Implement LoRaWAN roaming (https://github.com/TheThingsNetwork/lorawan-stack/issues/230)
-	return echo.NewHTTPError(http.StatusNotFound)
+	ctx := requestContext(c)
+
+	var ans interface{}
+	var err error
+	switch c.Request().URL.Path {
+	case "/sns":
+		switch req := c.Get(messageKey).(type) {
+		case *PRStartReq:
+			ans, err = s.sNS.PRStartRequest(ctx, req)
+		case *XmitDataReq:
+			ans, err = s.sNS.XmitDataRequest(ctx, req)
+		default:
+			return ErrMalformedMessage
+		}
+	case "/fns":
+		switch req := c.Get(messageKey).(type) {
+		case *XmitDataReq:
+			ans, err = s.fNS.XmitDataRequest(ctx, req)
+		default:
+			return ErrMalformedMessage
+		}
+	default:
+		// TODO: Implement handover roaming (https://github.com/TheThingsNetwork/lorawan-stack/issues/230)
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, ans)
 }
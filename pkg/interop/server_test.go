@@ -87,6 +87,24 @@ func TestServeHTTP(t *testing.T) {
 				return a.So(res.StatusCode, should.Equal, http.StatusNotFound)
 			},
 		},
+		{
+			Name: "PRStartReq/NotRegistered",
+			RequestBody: &PRStartReq{
+				NsMessageHeader: NsMessageHeader{
+					MessageHeader: MessageHeader{
+						MessageType:     MessageTypePRStartReq,
+						ProtocolVersion: "1.0",
+					},
+					SenderID:   NetID{0x0, 0x0, 0x01},
+					ReceiverID: NetID{0x0, 0x0, 0x02},
+				},
+				PHYPayload: Buffer{0x40, 0x04, 0x03, 0x02, 0x01, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04},
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				return a.So(res.StatusCode, should.Equal, http.StatusNotFound)
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -108,6 +126,9 @@ func TestServeHTTP(t *testing.T) {
 			if tc.sNS != nil {
 				s.RegisterSNS(tc.sNS)
 			}
+			if tc.fNS != nil {
+				s.RegisterFNS(tc.fNS)
+			}
 			if tc.AS != nil {
 				s.RegisterAS(tc.AS)
 			}
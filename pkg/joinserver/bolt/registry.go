@@ -0,0 +1,485 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"runtime/trace"
+	"time"
+
+	"go.etcd.io/bbolt"
+	ttnbolt "go.thethings.network/lorawan-stack/pkg/bolt"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/provisioning"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	errAlreadyProvisioned   = errors.DefineAlreadyExists("already_provisioned", "device already provisioned")
+	errDuplicateIdentifiers = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
+	errInvalidFieldmask     = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers   = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField        = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errProvisionerNotFound  = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
+	errDeviceNotFound       = errors.DefineNotFound("device_not_found", "device not found")
+)
+
+// DeviceRegistry is an implementation of joinserver.DeviceRegistry.
+type DeviceRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+func provisionerUniqueID(dev *ttnpb.EndDevice) (string, error) {
+	if dev.ProvisionerID == "" {
+		return "", nil
+	}
+	provisioner := provisioning.Get(dev.ProvisionerID)
+	if provisioner == nil {
+		return "", errProvisionerNotFound.WithAttributes("id", dev.ProvisionerID)
+	}
+	return provisioner.UniqueID(dev.ProvisioningData)
+}
+
+func (r *DeviceRegistry) uidKey(uid string) []byte {
+	return r.Bolt.Key("uid", uid)
+}
+
+func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) []byte {
+	return r.Bolt.Key("eui", joinEUI.String(), devEUI.String())
+}
+
+func (r *DeviceRegistry) provisionerKey(provisionerID, pid string) []byte {
+	return r.Bolt.Key("provisioner", provisionerID, pid)
+}
+
+// GetByID gets device by appID, devID.
+func (r *DeviceRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appID,
+		DeviceID:               devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device by id").End()
+
+	pb := &ttnpb.EndDevice{}
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.GetProto(b, r.uidKey(unique.ID(ctx, ids)), pb)
+	}); err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(pb, paths...)
+}
+
+// GetByEUI gets device by joinEUI, devEUI.
+func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.ContextualEndDevice, error) {
+	if devEUI.IsZero() {
+		return nil, errInvalidIdentifiers
+	}
+
+	defer trace.StartRegion(ctx, "get end device by eui").End()
+
+	pb := &ttnpb.EndDevice{}
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.FindProto(b, r.euiKey(joinEUI, devEUI), r.uidKey, pb)
+	}); err != nil {
+		return nil, err
+	}
+	filtered, err := ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ContextualEndDevice{
+		Context:   ctx,
+		EndDevice: filtered,
+	}, nil
+}
+
+func equalEUI64(x, y *types.EUI64) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Equal(*y)
+}
+
+func (r *DeviceRegistry) set(ctx context.Context, tx *ttnbolt.WatchTx, uid string, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.ContextualEndDevice, func(*bbolt.Bucket) error, error) {
+	ctx, err := unique.WithContext(ctx, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	uk := r.uidKey(uid)
+
+	v, err := tx.Get(uk)
+	if err != nil {
+		return nil, nil, err
+	}
+	var stored *ttnpb.EndDevice
+	var pb *ttnpb.EndDevice
+	if v != nil {
+		stored = &ttnpb.EndDevice{}
+		if err := ttnbolt.UnmarshalProto(v, stored); err != nil {
+			return nil, nil, err
+		}
+		pb = &ttnpb.EndDevice{}
+		if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+			return nil, nil, err
+		}
+		pb, err = ttnpb.FilterGetEndDevice(pb, gets...)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var sets []string
+	pb, sets, err = f(ctx, pb)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ttnpb.ProhibitFields(sets,
+		"created_at",
+		"updated_at",
+	); err != nil {
+		return nil, nil, errInvalidFieldmask.WithCause(err)
+	}
+
+	if stored == nil && pb == nil {
+		return nil, nil, nil
+	}
+	if pb != nil && len(sets) == 0 {
+		filtered, err := ttnpb.FilterGetEndDevice(stored, gets...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &ttnpb.ContextualEndDevice{
+			Context:   ctx,
+			EndDevice: filtered,
+		}, nil, nil
+	}
+
+	if pb == nil && len(sets) == 0 {
+		pid, err := provisionerUniqueID(stored)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, func(b *bbolt.Bucket) error {
+			if err := b.Delete(uk); err != nil {
+				return err
+			}
+			if stored.JoinEUI != nil && stored.DevEUI != nil {
+				if err := b.Delete(r.euiKey(*stored.JoinEUI, *stored.DevEUI)); err != nil {
+					return err
+				}
+			}
+			if pid != "" {
+				return b.Delete(r.provisionerKey(stored.ProvisionerID, pid))
+			}
+			return nil
+		}, nil
+	}
+
+	if pb == nil {
+		pb = &ttnpb.EndDevice{}
+	}
+
+	pb.UpdatedAt = time.Now().UTC()
+	sets = append(append(sets[:0:0], sets...),
+		"updated_at",
+	)
+
+	updated := &ttnpb.EndDevice{}
+	var updatedPID string
+	if stored == nil {
+		if err := ttnpb.RequireFields(sets,
+			"ids.application_ids",
+			"ids.dev_eui",
+			"ids.device_id",
+			"ids.join_eui",
+		); err != nil {
+			return nil, nil, errInvalidFieldmask.WithCause(err)
+		}
+
+		pb.CreatedAt = pb.UpdatedAt
+		sets = append(sets, "created_at")
+
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+		if err != nil {
+			return nil, nil, err
+		}
+		updatedPID, err = provisionerUniqueID(updated)
+		if err != nil {
+			return nil, nil, err
+		}
+		if updated.JoinEUI == nil || updated.DevEUI == nil || updated.DevEUI.IsZero() {
+			return nil, nil, errInvalidIdentifiers
+		}
+	} else {
+		if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+			return nil, nil, errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.device_id") && pb.DeviceID != stored.DeviceID {
+			return nil, nil, errReadOnlyField.WithAttributes("field", "ids.device_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.join_eui") && !equalEUI64(pb.JoinEUI, stored.JoinEUI) {
+			return nil, nil, errReadOnlyField.WithAttributes("field", "ids.join_eui")
+		}
+		if ttnpb.HasAnyField(sets, "ids.dev_eui") && !equalEUI64(pb.DevEUI, stored.DevEUI) {
+			return nil, nil, errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+		}
+		if ttnpb.HasAnyField(sets, "provisioner_id") && pb.ProvisionerID != stored.ProvisionerID {
+			return nil, nil, errReadOnlyField.WithAttributes("field", "provisioner_id")
+		}
+		if ttnpb.HasAnyField(sets, "provisioning_data") && !pb.ProvisioningData.Equal(stored.ProvisioningData) {
+			return nil, nil, errReadOnlyField.WithAttributes("field", "provisioning_data")
+		}
+		if err := ttnbolt.UnmarshalProto(v, updated); err != nil {
+			return nil, nil, err
+		}
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := updated.ValidateFields(sets...); err != nil {
+		return nil, nil, err
+	}
+	pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ttnpb.ContextualEndDevice{
+		Context:   ctx,
+		EndDevice: pb,
+	}, func(b *bbolt.Bucket) error {
+		if stored == nil {
+			ek := r.euiKey(*updated.JoinEUI, *updated.DevEUI)
+			if b.Get(ek) != nil {
+				return errDuplicateIdentifiers
+			}
+			if err := b.Put(ek, []byte(uid)); err != nil {
+				return err
+			}
+		}
+		if updatedPID != "" {
+			pk := r.provisionerKey(updated.ProvisionerID, updatedPID)
+			if b.Get(pk) != nil {
+				return errAlreadyProvisioned
+			}
+			if err := b.Put(pk, []byte(uid)); err != nil {
+				return err
+			}
+		}
+		return ttnbolt.SetProto(b, uk, updated)
+	}, nil
+}
+
+// SetByEUI sets device by joinEUI, devEUI.
+// SetByEUI will only succeed if the device is set via SetByID first.
+func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.ContextualEndDevice, error) {
+	if devEUI.IsZero() {
+		return nil, errInvalidIdentifiers
+	}
+	ek := r.euiKey(joinEUI, devEUI)
+
+	defer trace.StartRegion(ctx, "set end device by eui").End()
+
+	var pb *ttnpb.ContextualEndDevice
+	err := r.Bolt.Watch(func(tx *ttnbolt.WatchTx) (func(*bbolt.Bucket) error, error) {
+		uid, err := tx.Get(ek)
+		if err != nil {
+			return nil, err
+		}
+		if uid == nil {
+			return nil, errDeviceNotFound
+		}
+		var update func(*bbolt.Bucket) error
+		pb, update, err = r.set(ctx, tx, string(uid), gets, f)
+		return update, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// SetByID sets device by appID, devID.
+func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appID,
+		DeviceID:               devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "set end device by id").End()
+
+	var pb *ttnpb.ContextualEndDevice
+	err := r.Bolt.Watch(func(tx *ttnbolt.WatchTx) (func(*bbolt.Bucket) error, error) {
+		var update func(*bbolt.Bucket) error
+		var err error
+		pb, update, err = r.set(ctx, tx, uid, gets, func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			updated, sets, err := f(stored)
+			if err != nil {
+				return nil, nil, err
+			}
+			if stored == nil && updated != nil && (updated.ApplicationIdentifiers != appID || updated.DeviceID != devID) {
+				return nil, nil, errInvalidIdentifiers
+			}
+			return updated, sets, nil
+		})
+		return update, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pb == nil {
+		return nil, nil
+	}
+	return pb.EndDevice, nil
+}
+
+// KeyRegistry is an implementation of joinserver.KeyRegistry.
+type KeyRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+func (r *KeyRegistry) idKey(joinEUI, devEUI types.EUI64, id []byte) []byte {
+	return r.Bolt.Key("id", joinEUI.String(), devEUI.String(), base64.RawStdEncoding.EncodeToString(id))
+}
+
+// GetByID gets session keys by joinEUI, devEUI, id.
+func (r *KeyRegistry) GetByID(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error) {
+	if devEUI.IsZero() || len(id) == 0 {
+		return nil, errInvalidIdentifiers
+	}
+
+	defer trace.StartRegion(ctx, "get session keys").End()
+
+	pb := &ttnpb.SessionKeys{}
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.GetProto(b, r.idKey(joinEUI, devEUI, id), pb)
+	}); err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetSessionKeys(pb, paths...)
+}
+
+// SetByID sets session keys by joinEUI, devEUI, id.
+func (r *KeyRegistry) SetByID(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, gets []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
+	if devEUI.IsZero() || len(id) == 0 {
+		return nil, errInvalidIdentifiers
+	}
+	ik := r.idKey(joinEUI, devEUI, id)
+
+	defer trace.StartRegion(ctx, "set session keys").End()
+
+	var pb *ttnpb.SessionKeys
+	err := r.Bolt.Watch(func(tx *ttnbolt.WatchTx) (func(*bbolt.Bucket) error, error) {
+		v, err := tx.Get(ik)
+		if err != nil {
+			return nil, err
+		}
+		var stored *ttnpb.SessionKeys
+		if v != nil {
+			stored = &ttnpb.SessionKeys{}
+			if err := ttnbolt.UnmarshalProto(v, stored); err != nil {
+				return nil, err
+			}
+			pb = &ttnpb.SessionKeys{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return nil, err
+			}
+			pb, err = ttnpb.FilterGetSessionKeys(pb, gets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return nil, err
+		}
+		if stored == nil && pb == nil {
+			return nil, nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = ttnpb.FilterGetSessionKeys(stored, gets...)
+			return nil, err
+		}
+
+		if pb == nil && len(sets) == 0 {
+			return func(b *bbolt.Bucket) error {
+				return b.Delete(ik)
+			}, nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.SessionKeys{}
+		}
+
+		updated := &ttnpb.SessionKeys{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"session_key_id",
+			); err != nil {
+				return nil, errInvalidFieldmask.WithCause(err)
+			}
+			updated, err = ttnpb.ApplySessionKeysFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+			if !bytes.Equal(updated.SessionKeyID, id) {
+				return nil, errInvalidIdentifiers
+			}
+		} else {
+			if err := ttnpb.ProhibitFields(sets,
+				"session_key_id",
+			); err != nil {
+				return nil, errInvalidFieldmask.WithCause(err)
+			}
+			if err := ttnbolt.UnmarshalProto(v, updated); err != nil {
+				return nil, err
+			}
+			updated, err = ttnpb.ApplySessionKeysFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := updated.ValidateFields(sets...); err != nil {
+			return nil, err
+		}
+		pb, err = ttnpb.FilterGetSessionKeys(updated, gets...)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(b *bbolt.Bucket) error {
+			return ttnbolt.SetProto(b, ik, updated)
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
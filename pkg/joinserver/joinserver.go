@@ -212,6 +212,30 @@ func (js *JoinServer) wrapKeyIfKEKExists(ctx context.Context, key types.AES128Ke
 	return &env, nil
 }
 
+// rootKeysCryptoService returns a network and application service using the given wrapped root keys.
+// If the configured key vault performs the cryptographic operations itself, the root keys are not unwrapped.
+func (js *JoinServer) rootKeysCryptoService(ctx context.Context, nwkKeyEnc, appKeyEnc *ttnpb.KeyEnvelope) (cryptoservices.NetworkApplication, error) {
+	if kv, ok := js.KeyVault.(cryptoservices.KeyVault); ok {
+		return kv.NetworkApplication(ctx, nwkKeyEnc, appKeyEnc)
+	}
+	var nwkKey, appKey *types.AES128Key
+	if nwkKeyEnc != nil {
+		key, err := cryptoutil.UnwrapAES128Key(ctx, *nwkKeyEnc, js.KeyVault)
+		if err != nil {
+			return nil, err
+		}
+		nwkKey = &key
+	}
+	if appKeyEnc != nil {
+		key, err := cryptoutil.UnwrapAES128Key(ctx, *appKeyEnc, js.KeyVault)
+		if err != nil {
+			return nil, err
+		}
+		appKey = &key
+	}
+	return cryptoservices.NewMemory(nwkKey, appKey), nil
+}
+
 // HandleJoin handles the given join-request.
 func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (res *ttnpb.JoinResponse, err error) {
 	if _, ok := auth.X509DNFromContext(ctx); !ok {
@@ -386,25 +410,24 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			var networkCryptoService cryptoservices.Network
 			if req.SelectedMACVersion.UseNwkKey() && dev.RootKeys != nil && dev.RootKeys.NwkKey != nil {
 				// LoRaWAN 1.1 and higher use a NwkKey.
-				nwkKey, err := cryptoutil.UnwrapAES128Key(ctx, *dev.RootKeys.NwkKey, js.KeyVault)
+				networkCryptoService, err = js.rootKeysCryptoService(ctx, dev.RootKeys.NwkKey, nil)
 				if err != nil {
 					return nil, nil, err
 				}
-				networkCryptoService = cryptoservices.NewMemory(&nwkKey, nil)
 			} else if cc != nil && dev.ProvisionerID != "" {
 				networkCryptoService = cryptoservices.NewNetworkRPCClient(cc, js.KeyVault, js.WithClusterAuth())
 			}
 
 			var applicationCryptoService cryptoservices.Application
 			if dev.RootKeys != nil && dev.RootKeys.AppKey != nil {
-				appKey, err := cryptoutil.UnwrapAES128Key(ctx, *dev.RootKeys.AppKey, js.KeyVault)
+				appKeyCryptoService, err := js.rootKeysCryptoService(ctx, nil, dev.RootKeys.AppKey)
 				if err != nil {
 					return nil, nil, err
 				}
-				applicationCryptoService = cryptoservices.NewMemory(nil, &appKey)
+				applicationCryptoService = appKeyCryptoService
 				if !req.SelectedMACVersion.UseNwkKey() {
 					// LoRaWAN 1.0.x use the AppKey for network security operations.
-					networkCryptoService = cryptoservices.NewMemory(nil, &appKey)
+					networkCryptoService = appKeyCryptoService
 				}
 			} else if cc != nil && dev.ProvisionerID != "" {
 				applicationCryptoService = cryptoservices.NewApplicationRPCClient(cc, js.KeyVault, js.WithClusterAuth())
@@ -24,6 +24,7 @@ import (
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	. "go.thethings.network/lorawan-stack/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/joinserver/bolt"
 	"go.thethings.network/lorawan-stack/pkg/joinserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -223,6 +224,18 @@ func TestDeviceRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "Bolt",
+			New: func(t testing.TB) (DeviceRegistry, func() error) {
+				cl, closeFn := test.NewBolt(t, namespace[:]...)
+				reg := &bolt.DeviceRegistry{Bolt: cl}
+				return reg, func() error {
+					closeFn()
+					return nil
+				}
+			},
+			N: 8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
@@ -377,6 +390,18 @@ func TestSessionKeyRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "Bolt",
+			New: func(t testing.TB) (KeyRegistry, func() error) {
+				cl, closeFn := test.NewBolt(t, namespace[:]...)
+				reg := &bolt.KeyRegistry{Bolt: cl}
+				return reg, func() error {
+					closeFn()
+					return nil
+				}
+			},
+			N: 8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
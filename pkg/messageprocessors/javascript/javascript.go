@@ -61,6 +61,11 @@ var (
 	errOutputRange = errors.Define("output_range", "output value `{value}` does not fall between `{low}` and `{high}`")
 )
 
+// runtimeContext returns a derived context in which scripts run in runtimes of the application of the end device.
+func (h *host) runtimeContext(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) context.Context {
+	return scripting.NewContextWithRuntimeKey(ctx, ids.ApplicationID)
+}
+
 // Encode encodes the message's DecodedPayload to FRMPayload using the given script.
 func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, script string) error {
 	defer trace.StartRegion(ctx, "encode message").End()
@@ -80,7 +85,7 @@ func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, versi
 		%s
 		Encoder(env.payload, env.f_port)
 	`, script)
-	value, err := h.engine.Run(ctx, script, env)
+	value, err := h.engine.Run(h.runtimeContext(ctx, ids), script, env)
 	if err != nil {
 		return err
 	}
@@ -138,7 +143,7 @@ func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, versi
 		%s
 		Decoder(env.payload, env.f_port)
 	`, script)
-	value, err := h.engine.Run(ctx, script, env)
+	value, err := h.engine.Run(h.runtimeContext(ctx, ids), script, env)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,170 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+)
+
+// ConnectTimeout is the time in which clients must send the CONNECT packet.
+var ConnectTimeout = 10 * time.Second
+
+var (
+	errNotConnect     = errors.DefineInvalidArgument("not_connect", "first packet is not a CONNECT packet")
+	errListenerClosed = errors.DefineAborted("listener_closed", "listener closed")
+)
+
+// SplitListener splits the given listener in a listener that accepts MQTT 3.1 and 3.1.1 connections, and a listener
+// that accepts MQTT 5 connections. The protocol level is peeked from the CONNECT packet, which is left in the
+// connection to be read by the protocol implementation.
+// Closing either listener closes the given listener.
+func SplitListener(ctx context.Context, lis net.Listener) (v3, v5 net.Listener) {
+	s := &splitListener{
+		Listener: lis,
+		v3:       make(chan net.Conn),
+		v5:       make(chan net.Conn),
+		closed:   make(chan struct{}),
+	}
+	go s.accept(ctx)
+	return &levelListener{splitListener: s, conns: s.v3}, &levelListener{splitListener: s, conns: s.v5}
+}
+
+type splitListener struct {
+	net.Listener
+	v3, v5    chan net.Conn
+	closeOnce sync.Once
+	closed    chan struct{}
+	err       error
+}
+
+func (s *splitListener) accept(ctx context.Context) {
+	for {
+		conn, err := s.Listener.Accept()
+		if err != nil {
+			s.close(err)
+			return
+		}
+		go func() {
+			level, conn, err := peekProtocolLevel(conn)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).WithField("remote_addr", conn.RemoteAddr().String()).Debug("Failed to peek MQTT protocol level")
+				conn.Close()
+				return
+			}
+			ch := s.v3
+			if level == ProtocolLevel5 {
+				ch = s.v5
+			}
+			select {
+			case ch <- conn:
+			case <-s.closed:
+				conn.Close()
+			}
+		}()
+	}
+}
+
+func (s *splitListener) close(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		close(s.closed)
+		s.Listener.Close()
+	})
+}
+
+type levelListener struct {
+	*splitListener
+	conns chan net.Conn
+}
+
+// Accept implements net.Listener.
+func (l *levelListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, l.err
+	}
+}
+
+// Close implements net.Listener.
+func (l *levelListener) Close() error {
+	l.close(errListenerClosed)
+	return nil
+}
+
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+// Read implements net.Conn.
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// peekProtocolLevel returns the protocol level of the CONNECT packet sent by the client.
+// The returned connection contains the peeked data.
+func peekProtocolLevel(conn net.Conn) (byte, net.Conn, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(ConnectTimeout)); err != nil {
+		return 0, conn, err
+	}
+	r := bufio.NewReader(conn)
+	buffered := &bufferedConn{Conn: conn, r: r}
+	header, err := r.Peek(1)
+	if err != nil {
+		return 0, buffered, err
+	}
+	if PacketType(header[0]>>4) != CONNECT {
+		return 0, buffered, errNotConnect
+	}
+	// Skip the fixed header with the variable byte integer of the remaining length.
+	offset := 1
+	for i := 0; ; i++ {
+		if i == 4 {
+			return 0, buffered, errMalformedVarInt
+		}
+		b, err := r.Peek(offset + 1)
+		if err != nil {
+			return 0, buffered, err
+		}
+		offset++
+		if b[offset-1]&0x80 == 0 {
+			break
+		}
+	}
+	// The variable header starts with the protocol name, followed by the protocol level.
+	b, err := r.Peek(offset + 2)
+	if err != nil {
+		return 0, buffered, err
+	}
+	offset += 2 + int(binary.BigEndian.Uint16(b[offset:]))
+	b, err = r.Peek(offset + 1)
+	if err != nil {
+		return 0, buffered, err
+	}
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return 0, buffered, err
+	}
+	return b[offset], buffered, nil
+}
@@ -0,0 +1,838 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"unicode/utf8"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// ProtocolLevel5 is the protocol level of MQTT 5 in the CONNECT packet.
+const ProtocolLevel5 byte = 5
+
+// PacketType is the type of an MQTT control packet.
+type PacketType byte
+
+// MQTT control packet types.
+const (
+	CONNECT     PacketType = 1
+	CONNACK     PacketType = 2
+	PUBLISH     PacketType = 3
+	PUBACK      PacketType = 4
+	PUBREC      PacketType = 5
+	PUBREL      PacketType = 6
+	PUBCOMP     PacketType = 7
+	SUBSCRIBE   PacketType = 8
+	SUBACK      PacketType = 9
+	UNSUBSCRIBE PacketType = 10
+	UNSUBACK    PacketType = 11
+	PINGREQ     PacketType = 12
+	PINGRESP    PacketType = 13
+	DISCONNECT  PacketType = 14
+	AUTH        PacketType = 15
+)
+
+var packetTypeNames = map[PacketType]string{
+	CONNECT:     "CONNECT",
+	CONNACK:     "CONNACK",
+	PUBLISH:     "PUBLISH",
+	PUBACK:      "PUBACK",
+	PUBREC:      "PUBREC",
+	PUBREL:      "PUBREL",
+	PUBCOMP:     "PUBCOMP",
+	SUBSCRIBE:   "SUBSCRIBE",
+	SUBACK:      "SUBACK",
+	UNSUBSCRIBE: "UNSUBSCRIBE",
+	UNSUBACK:    "UNSUBACK",
+	PINGREQ:     "PINGREQ",
+	PINGRESP:    "PINGRESP",
+	DISCONNECT:  "DISCONNECT",
+	AUTH:        "AUTH",
+}
+
+// String implements fmt.Stringer.
+func (t PacketType) String() string {
+	if name, ok := packetTypeNames[t]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// ReasonCode is an MQTT 5 reason code.
+type ReasonCode byte
+
+// MQTT 5 reason codes.
+const (
+	Success                             ReasonCode = 0x00
+	NormalDisconnection                 ReasonCode = 0x00
+	GrantedQoS0                         ReasonCode = 0x00
+	GrantedQoS1                         ReasonCode = 0x01
+	GrantedQoS2                         ReasonCode = 0x02
+	DisconnectWithWillMessage           ReasonCode = 0x04
+	NoMatchingSubscribers               ReasonCode = 0x10
+	NoSubscriptionExisted               ReasonCode = 0x11
+	UnspecifiedError                    ReasonCode = 0x80
+	MalformedPacket                     ReasonCode = 0x81
+	ProtocolError                       ReasonCode = 0x82
+	ImplementationSpecificError         ReasonCode = 0x83
+	UnsupportedProtocolVersion          ReasonCode = 0x84
+	ClientIdentifierNotValid            ReasonCode = 0x85
+	BadUsernameOrPassword               ReasonCode = 0x86
+	NotAuthorized                       ReasonCode = 0x87
+	ServerUnavailable                   ReasonCode = 0x88
+	ServerBusy                          ReasonCode = 0x89
+	Banned                              ReasonCode = 0x8A
+	ServerShuttingDown                  ReasonCode = 0x8B
+	BadAuthenticationMethod             ReasonCode = 0x8C
+	KeepAliveTimeout                    ReasonCode = 0x8D
+	SessionTakenOver                    ReasonCode = 0x8E
+	TopicFilterInvalid                  ReasonCode = 0x8F
+	TopicNameInvalid                    ReasonCode = 0x90
+	PacketIdentifierInUse               ReasonCode = 0x91
+	PacketIdentifierNotFound            ReasonCode = 0x92
+	ReceiveMaximumExceeded              ReasonCode = 0x93
+	TopicAliasInvalid                   ReasonCode = 0x94
+	PacketTooLarge                      ReasonCode = 0x95
+	MessageRateTooHigh                  ReasonCode = 0x96
+	QuotaExceeded                       ReasonCode = 0x97
+	AdministrativeAction                ReasonCode = 0x98
+	PayloadFormatInvalid                ReasonCode = 0x99
+	RetainNotSupported                  ReasonCode = 0x9A
+	QoSNotSupported                     ReasonCode = 0x9B
+	UseAnotherServer                    ReasonCode = 0x9C
+	ServerMoved                         ReasonCode = 0x9D
+	SharedSubscriptionsNotSupported     ReasonCode = 0x9E
+	ConnectionRateExceeded              ReasonCode = 0x9F
+	MaximumConnectTime                  ReasonCode = 0xA0
+	SubscriptionIdentifiersNotSupported ReasonCode = 0xA1
+	WildcardSubscriptionsNotSupported   ReasonCode = 0xA2
+)
+
+// IsError returns whether the reason code indicates a failure.
+func (c ReasonCode) IsError() bool { return c >= 0x80 }
+
+// Packet is an MQTT 5 control packet.
+type Packet interface {
+	PacketType() PacketType
+	encode(w *bytes.Buffer) (flags byte)
+	decode(r *reader, flags byte) error
+}
+
+// Will is the will message of a CONNECT packet.
+type Will struct {
+	QoS        byte
+	Retain     bool
+	Properties Properties
+	Topic      string
+	Payload    []byte
+}
+
+// ConnectPacket is the CONNECT packet.
+type ConnectPacket struct {
+	ProtocolName  string
+	ProtocolLevel byte
+	CleanStart    bool
+	KeepAlive     uint16
+	Properties    Properties
+	ClientID      string
+	Will          *Will
+	Username      string
+	Password      []byte
+}
+
+// PacketType implements Packet.
+func (*ConnectPacket) PacketType() PacketType { return CONNECT }
+
+const (
+	connectFlagCleanStart   = 0x02
+	connectFlagWill         = 0x04
+	connectFlagWillQoSShift = 3
+	connectFlagWillRetain   = 0x20
+	connectFlagPassword     = 0x40
+	connectFlagUsername     = 0x80
+)
+
+var errUnsupportedProtocol = errors.DefineInvalidArgument("unsupported_protocol", "unsupported protocol `{name}` level `{level}`")
+
+func (p *ConnectPacket) encode(w *bytes.Buffer) byte {
+	writeString(w, p.ProtocolName)
+	w.WriteByte(p.ProtocolLevel)
+	var flags byte
+	if p.CleanStart {
+		flags |= connectFlagCleanStart
+	}
+	if p.Will != nil {
+		flags |= connectFlagWill | p.Will.QoS<<connectFlagWillQoSShift
+		if p.Will.Retain {
+			flags |= connectFlagWillRetain
+		}
+	}
+	if p.Password != nil {
+		flags |= connectFlagPassword
+	}
+	if p.Username != "" {
+		flags |= connectFlagUsername
+	}
+	w.WriteByte(flags)
+	writeUint16(w, p.KeepAlive)
+	p.Properties.encode(w)
+	writeString(w, p.ClientID)
+	if p.Will != nil {
+		p.Will.Properties.encode(w)
+		writeString(w, p.Will.Topic)
+		writeBinary(w, p.Will.Payload)
+	}
+	if p.Username != "" {
+		writeString(w, p.Username)
+	}
+	if p.Password != nil {
+		writeBinary(w, p.Password)
+	}
+	return 0
+}
+
+func (p *ConnectPacket) decode(r *reader, _ byte) (err error) {
+	if p.ProtocolName, err = r.string(); err != nil {
+		return err
+	}
+	if p.ProtocolLevel, err = r.byte(); err != nil {
+		return err
+	}
+	if p.ProtocolName != "MQTT" || p.ProtocolLevel != ProtocolLevel5 {
+		return errUnsupportedProtocol.WithAttributes("name", p.ProtocolName, "level", p.ProtocolLevel)
+	}
+	flags, err := r.byte()
+	if err != nil {
+		return err
+	}
+	if flags&0x01 != 0 {
+		return errMalformedPacket.WithAttributes("type", CONNECT.String())
+	}
+	p.CleanStart = flags&connectFlagCleanStart != 0
+	if p.KeepAlive, err = r.uint16(); err != nil {
+		return err
+	}
+	if err = p.Properties.decode(r); err != nil {
+		return err
+	}
+	if p.ClientID, err = r.string(); err != nil {
+		return err
+	}
+	if flags&connectFlagWill != 0 {
+		p.Will = &Will{
+			QoS:    (flags >> connectFlagWillQoSShift) & 0x03,
+			Retain: flags&connectFlagWillRetain != 0,
+		}
+		if err = p.Will.Properties.decode(r); err != nil {
+			return err
+		}
+		if p.Will.Topic, err = r.string(); err != nil {
+			return err
+		}
+		if p.Will.Payload, err = r.binary(); err != nil {
+			return err
+		}
+	}
+	if flags&connectFlagUsername != 0 {
+		if p.Username, err = r.string(); err != nil {
+			return err
+		}
+	}
+	if flags&connectFlagPassword != 0 {
+		if p.Password, err = r.binary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConnackPacket is the CONNACK packet.
+type ConnackPacket struct {
+	SessionPresent bool
+	ReasonCode     ReasonCode
+	Properties     Properties
+}
+
+// PacketType implements Packet.
+func (*ConnackPacket) PacketType() PacketType { return CONNACK }
+
+func (p *ConnackPacket) encode(w *bytes.Buffer) byte {
+	if p.SessionPresent {
+		w.WriteByte(0x01)
+	} else {
+		w.WriteByte(0x00)
+	}
+	w.WriteByte(byte(p.ReasonCode))
+	p.Properties.encode(w)
+	return 0
+}
+
+func (p *ConnackPacket) decode(r *reader, _ byte) error {
+	flags, err := r.byte()
+	if err != nil {
+		return err
+	}
+	p.SessionPresent = flags&0x01 != 0
+	code, err := r.byte()
+	if err != nil {
+		return err
+	}
+	p.ReasonCode = ReasonCode(code)
+	return p.Properties.decode(r)
+}
+
+// PublishPacket is the PUBLISH packet.
+type PublishPacket struct {
+	Duplicate  bool
+	QoS        byte
+	Retain     bool
+	TopicName  string
+	PacketID   uint16
+	Properties Properties
+	Payload    []byte
+}
+
+// PacketType implements Packet.
+func (*PublishPacket) PacketType() PacketType { return PUBLISH }
+
+func (p *PublishPacket) encode(w *bytes.Buffer) byte {
+	writeString(w, p.TopicName)
+	if p.QoS > 0 {
+		writeUint16(w, p.PacketID)
+	}
+	p.Properties.encode(w)
+	w.Write(p.Payload)
+	flags := p.QoS << 1
+	if p.Duplicate {
+		flags |= 0x08
+	}
+	if p.Retain {
+		flags |= 0x01
+	}
+	return flags
+}
+
+func (p *PublishPacket) decode(r *reader, flags byte) (err error) {
+	p.Duplicate = flags&0x08 != 0
+	p.QoS = (flags >> 1) & 0x03
+	p.Retain = flags&0x01 != 0
+	if p.QoS > 2 {
+		return errMalformedPacket.WithAttributes("type", PUBLISH.String())
+	}
+	if p.TopicName, err = r.string(); err != nil {
+		return err
+	}
+	if p.QoS > 0 {
+		if p.PacketID, err = r.uint16(); err != nil {
+			return err
+		}
+	}
+	if err = p.Properties.decode(r); err != nil {
+		return err
+	}
+	p.Payload = r.rest()
+	return nil
+}
+
+// PubackPacket is the PUBACK packet.
+type PubackPacket struct {
+	PacketID   uint16
+	ReasonCode ReasonCode
+	Properties Properties
+}
+
+// PacketType implements Packet.
+func (*PubackPacket) PacketType() PacketType { return PUBACK }
+
+func (p *PubackPacket) encode(w *bytes.Buffer) byte {
+	writeUint16(w, p.PacketID)
+	w.WriteByte(byte(p.ReasonCode))
+	p.Properties.encode(w)
+	return 0
+}
+
+func (p *PubackPacket) decode(r *reader, _ byte) (err error) {
+	if p.PacketID, err = r.uint16(); err != nil {
+		return err
+	}
+	if r.len() == 0 {
+		return nil
+	}
+	code, err := r.byte()
+	if err != nil {
+		return err
+	}
+	p.ReasonCode = ReasonCode(code)
+	if r.len() == 0 {
+		return nil
+	}
+	return p.Properties.decode(r)
+}
+
+// Subscription is a topic filter with options in a SUBSCRIBE packet.
+type Subscription struct {
+	TopicFilter       string
+	QoS               byte
+	NoLocal           bool
+	RetainAsPublished bool
+	RetainHandling    byte
+}
+
+// SubscribePacket is the SUBSCRIBE packet.
+type SubscribePacket struct {
+	PacketID      uint16
+	Properties    Properties
+	Subscriptions []Subscription
+}
+
+// PacketType implements Packet.
+func (*SubscribePacket) PacketType() PacketType { return SUBSCRIBE }
+
+func (p *SubscribePacket) encode(w *bytes.Buffer) byte {
+	writeUint16(w, p.PacketID)
+	p.Properties.encode(w)
+	for _, sub := range p.Subscriptions {
+		writeString(w, sub.TopicFilter)
+		opts := sub.QoS&0x03 | (sub.RetainHandling&0x03)<<4
+		if sub.NoLocal {
+			opts |= 0x04
+		}
+		if sub.RetainAsPublished {
+			opts |= 0x08
+		}
+		w.WriteByte(opts)
+	}
+	return 0x02
+}
+
+func (p *SubscribePacket) decode(r *reader, flags byte) (err error) {
+	if flags != 0x02 {
+		return errMalformedPacket.WithAttributes("type", SUBSCRIBE.String())
+	}
+	if p.PacketID, err = r.uint16(); err != nil {
+		return err
+	}
+	if err = p.Properties.decode(r); err != nil {
+		return err
+	}
+	for r.len() > 0 {
+		var sub Subscription
+		if sub.TopicFilter, err = r.string(); err != nil {
+			return err
+		}
+		opts, err := r.byte()
+		if err != nil {
+			return err
+		}
+		sub.QoS = opts & 0x03
+		sub.NoLocal = opts&0x04 != 0
+		sub.RetainAsPublished = opts&0x08 != 0
+		sub.RetainHandling = (opts >> 4) & 0x03
+		p.Subscriptions = append(p.Subscriptions, sub)
+	}
+	if len(p.Subscriptions) == 0 {
+		return errMalformedPacket.WithAttributes("type", SUBSCRIBE.String())
+	}
+	return nil
+}
+
+// SubackPacket is the SUBACK packet.
+type SubackPacket struct {
+	PacketID    uint16
+	Properties  Properties
+	ReasonCodes []ReasonCode
+}
+
+// PacketType implements Packet.
+func (*SubackPacket) PacketType() PacketType { return SUBACK }
+
+func (p *SubackPacket) encode(w *bytes.Buffer) byte {
+	writeUint16(w, p.PacketID)
+	p.Properties.encode(w)
+	for _, code := range p.ReasonCodes {
+		w.WriteByte(byte(code))
+	}
+	return 0
+}
+
+func (p *SubackPacket) decode(r *reader, _ byte) (err error) {
+	if p.PacketID, err = r.uint16(); err != nil {
+		return err
+	}
+	if err = p.Properties.decode(r); err != nil {
+		return err
+	}
+	for _, code := range r.rest() {
+		p.ReasonCodes = append(p.ReasonCodes, ReasonCode(code))
+	}
+	return nil
+}
+
+// UnsubscribePacket is the UNSUBSCRIBE packet.
+type UnsubscribePacket struct {
+	PacketID     uint16
+	Properties   Properties
+	TopicFilters []string
+}
+
+// PacketType implements Packet.
+func (*UnsubscribePacket) PacketType() PacketType { return UNSUBSCRIBE }
+
+func (p *UnsubscribePacket) encode(w *bytes.Buffer) byte {
+	writeUint16(w, p.PacketID)
+	p.Properties.encode(w)
+	for _, filter := range p.TopicFilters {
+		writeString(w, filter)
+	}
+	return 0x02
+}
+
+func (p *UnsubscribePacket) decode(r *reader, flags byte) (err error) {
+	if flags != 0x02 {
+		return errMalformedPacket.WithAttributes("type", UNSUBSCRIBE.String())
+	}
+	if p.PacketID, err = r.uint16(); err != nil {
+		return err
+	}
+	if err = p.Properties.decode(r); err != nil {
+		return err
+	}
+	for r.len() > 0 {
+		filter, err := r.string()
+		if err != nil {
+			return err
+		}
+		p.TopicFilters = append(p.TopicFilters, filter)
+	}
+	return nil
+}
+
+// UnsubackPacket is the UNSUBACK packet.
+type UnsubackPacket struct {
+	PacketID    uint16
+	Properties  Properties
+	ReasonCodes []ReasonCode
+}
+
+// PacketType implements Packet.
+func (*UnsubackPacket) PacketType() PacketType { return UNSUBACK }
+
+func (p *UnsubackPacket) encode(w *bytes.Buffer) byte {
+	writeUint16(w, p.PacketID)
+	p.Properties.encode(w)
+	for _, code := range p.ReasonCodes {
+		w.WriteByte(byte(code))
+	}
+	return 0
+}
+
+func (p *UnsubackPacket) decode(r *reader, _ byte) (err error) {
+	if p.PacketID, err = r.uint16(); err != nil {
+		return err
+	}
+	if err = p.Properties.decode(r); err != nil {
+		return err
+	}
+	for _, code := range r.rest() {
+		p.ReasonCodes = append(p.ReasonCodes, ReasonCode(code))
+	}
+	return nil
+}
+
+// PingreqPacket is the PINGREQ packet.
+type PingreqPacket struct{}
+
+// PacketType implements Packet.
+func (*PingreqPacket) PacketType() PacketType { return PINGREQ }
+
+func (*PingreqPacket) encode(*bytes.Buffer) byte { return 0 }
+
+func (*PingreqPacket) decode(*reader, byte) error { return nil }
+
+// PingrespPacket is the PINGRESP packet.
+type PingrespPacket struct{}
+
+// PacketType implements Packet.
+func (*PingrespPacket) PacketType() PacketType { return PINGRESP }
+
+func (*PingrespPacket) encode(*bytes.Buffer) byte { return 0 }
+
+func (*PingrespPacket) decode(*reader, byte) error { return nil }
+
+// DisconnectPacket is the DISCONNECT packet.
+type DisconnectPacket struct {
+	ReasonCode ReasonCode
+	Properties Properties
+}
+
+// PacketType implements Packet.
+func (*DisconnectPacket) PacketType() PacketType { return DISCONNECT }
+
+func (p *DisconnectPacket) encode(w *bytes.Buffer) byte {
+	w.WriteByte(byte(p.ReasonCode))
+	p.Properties.encode(w)
+	return 0
+}
+
+func (p *DisconnectPacket) decode(r *reader, _ byte) error {
+	if r.len() == 0 {
+		return nil
+	}
+	code, err := r.byte()
+	if err != nil {
+		return err
+	}
+	p.ReasonCode = ReasonCode(code)
+	if r.len() == 0 {
+		return nil
+	}
+	return p.Properties.decode(r)
+}
+
+var (
+	errMalformedPacket   = errors.DefineInvalidArgument("malformed_packet", "malformed `{type}` packet")
+	errUnsupportedPacket = errors.DefineUnimplemented("unsupported_packet", "unsupported `{type}` packet")
+	errPacketTooLarge    = errors.DefineResourceExhausted("packet_too_large", "packet of `{size}` bytes exceeds maximum of `{max}` bytes")
+	errMalformedVarInt   = errors.DefineInvalidArgument("malformed_variable_byte_integer", "malformed variable byte integer")
+	errMalformedString   = errors.DefineInvalidArgument("malformed_string", "malformed UTF-8 string")
+	errUnexpectedEnd     = errors.DefineInvalidArgument("unexpected_end_of_packet", "unexpected end of packet")
+)
+
+func newPacket(t PacketType) (Packet, error) {
+	switch t {
+	case CONNECT:
+		return &ConnectPacket{}, nil
+	case CONNACK:
+		return &ConnackPacket{}, nil
+	case PUBLISH:
+		return &PublishPacket{}, nil
+	case PUBACK:
+		return &PubackPacket{}, nil
+	case SUBSCRIBE:
+		return &SubscribePacket{}, nil
+	case SUBACK:
+		return &SubackPacket{}, nil
+	case UNSUBSCRIBE:
+		return &UnsubscribePacket{}, nil
+	case UNSUBACK:
+		return &UnsubackPacket{}, nil
+	case PINGREQ:
+		return &PingreqPacket{}, nil
+	case PINGRESP:
+		return &PingrespPacket{}, nil
+	case DISCONNECT:
+		return &DisconnectPacket{}, nil
+	default:
+		return nil, errUnsupportedPacket.WithAttributes("type", t.String())
+	}
+}
+
+// ReadPacket reads an MQTT 5 control packet from r.
+// If maxSize is not zero, packets that exceed the maximum size are rejected.
+func ReadPacket(r io.Reader, maxSize uint32) (Packet, error) {
+	var header [1]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	var remaining uint32
+	for i, shift := 0, uint(0); ; i, shift = i+1, shift+7 {
+		if i == 4 {
+			return nil, errMalformedVarInt
+		}
+		var b [1]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, err
+		}
+		remaining |= uint32(b[0]&0x7f) << shift
+		if b[0]&0x80 == 0 {
+			break
+		}
+	}
+	if maxSize != 0 && remaining > maxSize {
+		return nil, errPacketTooLarge.WithAttributes("size", remaining, "max", maxSize)
+	}
+	t := PacketType(header[0] >> 4)
+	pkt, err := newPacket(t)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, remaining)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	if err := pkt.decode(&reader{buf: buf}, header[0]&0x0f); err != nil {
+		return nil, err
+	}
+	return pkt, nil
+}
+
+// WritePacket writes the MQTT 5 control packet to w.
+func WritePacket(w io.Writer, pkt Packet) error {
+	return writePacket(w, pkt, 0)
+}
+
+// writePacket writes the MQTT 5 control packet to w.
+// If maxSize is not zero, packets that exceed the maximum size are not written.
+func writePacket(w io.Writer, pkt Packet, maxSize uint32) error {
+	var body bytes.Buffer
+	flags := pkt.encode(&body)
+	var buf bytes.Buffer
+	buf.WriteByte(byte(pkt.PacketType())<<4 | flags&0x0f)
+	writeVarInt(&buf, uint32(body.Len()))
+	buf.Write(body.Bytes())
+	if maxSize != 0 && uint32(buf.Len()) > maxSize {
+		return errPacketTooLarge.WithAttributes("size", buf.Len(), "max", maxSize)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+type reader struct {
+	buf []byte
+}
+
+func (r *reader) len() int { return len(r.buf) }
+
+func (r *reader) next(n int) ([]byte, error) {
+	if n < 0 || len(r.buf) < n {
+		return nil, errUnexpectedEnd
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b, nil
+}
+
+func (r *reader) sub(n int) (*reader, error) {
+	b, err := r.next(n)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{buf: b}, nil
+}
+
+func (r *reader) rest() []byte {
+	b := r.buf
+	r.buf = nil
+	return b
+}
+
+func (r *reader) byte() (byte, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (r *reader) bytePtr() (*byte, error) {
+	b, err := r.byte()
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (r *reader) uint16() (uint16, error) {
+	b, err := r.next(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint16(b), nil
+}
+
+func (r *reader) uint32() (uint32, error) {
+	b, err := r.next(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(b), nil
+}
+
+func (r *reader) varInt() (uint32, error) {
+	var v uint32
+	for i := uint(0); i < 4; i++ {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		v |= uint32(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return v, nil
+		}
+	}
+	return 0, errMalformedVarInt
+}
+
+func (r *reader) binary() ([]byte, error) {
+	n, err := r.uint16()
+	if err != nil {
+		return nil, err
+	}
+	b, err := r.next(int(n))
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{}, b...), nil
+}
+
+func (r *reader) string() (string, error) {
+	b, err := r.binary()
+	if err != nil {
+		return "", err
+	}
+	if !utf8.Valid(b) {
+		return "", errMalformedString
+	}
+	return string(b), nil
+}
+
+func writeUint16(w *bytes.Buffer, v uint16) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	w.Write(b[:])
+}
+
+func writeUint32(w *bytes.Buffer, v uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	w.Write(b[:])
+}
+
+func writeVarInt(w *bytes.Buffer, v uint32) {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v > 0 {
+			b |= 0x80
+		}
+		w.WriteByte(b)
+		if v == 0 {
+			return
+		}
+	}
+}
+
+func writeBinary(w *bytes.Buffer, b []byte) {
+	writeUint16(w, uint16(len(b)))
+	w.Write(b)
+}
+
+func writeString(w *bytes.Buffer, s string) {
+	writeBinary(w, []byte(s))
+}
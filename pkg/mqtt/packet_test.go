@@ -0,0 +1,205 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/mqtt"
+)
+
+func TestPacketRoundTrip(t *testing.T) {
+	one := byte(1)
+	for _, tc := range []struct {
+		Name   string
+		Packet mqtt.Packet
+	}{
+		{
+			Name: "Connect",
+			Packet: &mqtt.ConnectPacket{
+				ProtocolName:  "MQTT",
+				ProtocolLevel: mqtt.ProtocolLevel5,
+				CleanStart:    true,
+				KeepAlive:     30,
+				Properties: mqtt.Properties{
+					SessionExpiryInterval:     60,
+					RequestProblemInformation: &one,
+					TopicAliasMaximum:         10,
+					MaximumPacketSize:         1024,
+					UserProperties: []mqtt.UserProperty{
+						{Key: "foo", Value: "bar"},
+					},
+				},
+				ClientID: "test-client",
+				Will: &mqtt.Will{
+					QoS: 1,
+					Properties: mqtt.Properties{
+						WillDelayInterval: 5,
+					},
+					Topic:   "foo/bar",
+					Payload: []byte{0x01, 0x02},
+				},
+				Username: "test-user",
+				Password: []byte("test-password"),
+			},
+		},
+		{
+			Name: "Connack",
+			Packet: &mqtt.ConnackPacket{
+				ReasonCode: mqtt.BadUsernameOrPassword,
+				Properties: mqtt.Properties{
+					ReasonString:             "invalid credentials",
+					AssignedClientIdentifier: "assigned",
+					MaximumQoS:               &one,
+				},
+			},
+		},
+		{
+			Name: "Publish/QoS0",
+			Packet: &mqtt.PublishPacket{
+				TopicName: "foo/bar",
+				Properties: mqtt.Properties{
+					TopicAlias: 1,
+				},
+				Payload: []byte("payload"),
+			},
+		},
+		{
+			Name: "Publish/QoS1",
+			Packet: &mqtt.PublishPacket{
+				Duplicate: true,
+				QoS:       1,
+				PacketID:  42,
+				Properties: mqtt.Properties{
+					MessageExpiryInterval: 10,
+					TopicAlias:            2,
+					UserProperties: []mqtt.UserProperty{
+						{Key: mqtt.UserPropertyCorrelationID, Value: "cid:1"},
+						{Key: mqtt.UserPropertyCorrelationID, Value: "cid:2"},
+					},
+				},
+				Payload: []byte("payload"),
+			},
+		},
+		{
+			Name: "Puback",
+			Packet: &mqtt.PubackPacket{
+				PacketID:   42,
+				ReasonCode: mqtt.NotAuthorized,
+			},
+		},
+		{
+			Name: "Subscribe",
+			Packet: &mqtt.SubscribePacket{
+				PacketID: 1,
+				Subscriptions: []mqtt.Subscription{
+					{TopicFilter: "foo/#", QoS: 1, NoLocal: true},
+					{TopicFilter: "bar/+", RetainHandling: 2},
+				},
+			},
+		},
+		{
+			Name: "Suback",
+			Packet: &mqtt.SubackPacket{
+				PacketID:    1,
+				ReasonCodes: []mqtt.ReasonCode{mqtt.GrantedQoS1, mqtt.NotAuthorized},
+			},
+		},
+		{
+			Name: "Unsubscribe",
+			Packet: &mqtt.UnsubscribePacket{
+				PacketID:     2,
+				TopicFilters: []string{"foo/#"},
+			},
+		},
+		{
+			Name: "Unsuback",
+			Packet: &mqtt.UnsubackPacket{
+				PacketID:    2,
+				ReasonCodes: []mqtt.ReasonCode{mqtt.NoSubscriptionExisted},
+			},
+		},
+		{
+			Name:   "Pingreq",
+			Packet: &mqtt.PingreqPacket{},
+		},
+		{
+			Name:   "Pingresp",
+			Packet: &mqtt.PingrespPacket{},
+		},
+		{
+			Name: "Disconnect",
+			Packet: &mqtt.DisconnectPacket{
+				ReasonCode: mqtt.SessionTakenOver,
+				Properties: mqtt.Properties{
+					ReasonString: "new connection",
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			var buf bytes.Buffer
+			if !a.So(mqtt.WritePacket(&buf, tc.Packet), should.BeNil) {
+				t.FailNow()
+			}
+			pkt, err := mqtt.ReadPacket(&buf, 0)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(pkt, should.Resemble, tc.Packet)
+			a.So(buf.Len(), should.Equal, 0)
+		})
+	}
+}
+
+func TestReadPacketErrors(t *testing.T) {
+	t.Run("UnsupportedProtocol", func(t *testing.T) {
+		a := assertions.New(t)
+		var buf bytes.Buffer
+		if !a.So(mqtt.WritePacket(&buf, &mqtt.ConnectPacket{
+			ProtocolName:  "MQTT",
+			ProtocolLevel: 4,
+			ClientID:      "test-client",
+		}), should.BeNil) {
+			t.FailNow()
+		}
+		_, err := mqtt.ReadPacket(&buf, 0)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		a := assertions.New(t)
+		var buf bytes.Buffer
+		if !a.So(mqtt.WritePacket(&buf, &mqtt.PublishPacket{
+			TopicName: "foo/bar",
+			Payload:   make([]byte, 64),
+		}), should.BeNil) {
+			t.FailNow()
+		}
+		_, err := mqtt.ReadPacket(&buf, 32)
+		a.So(errors.IsResourceExhausted(err), should.BeTrue)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := mqtt.ReadPacket(bytes.NewReader([]byte{byte(mqtt.AUTH) << 4, 0x00}), 0)
+		a.So(err, should.NotBeNil)
+	})
+}
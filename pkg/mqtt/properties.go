@@ -0,0 +1,302 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"bytes"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// Property identifiers of MQTT 5.
+const (
+	propPayloadFormatIndicator          = 0x01
+	propMessageExpiryInterval           = 0x02
+	propContentType                     = 0x03
+	propResponseTopic                   = 0x08
+	propCorrelationData                 = 0x09
+	propSubscriptionIdentifier          = 0x0B
+	propSessionExpiryInterval           = 0x11
+	propAssignedClientIdentifier        = 0x12
+	propServerKeepAlive                 = 0x13
+	propAuthenticationMethod            = 0x15
+	propAuthenticationData              = 0x16
+	propRequestProblemInformation       = 0x17
+	propWillDelayInterval               = 0x18
+	propRequestResponseInformation      = 0x19
+	propResponseInformation             = 0x1A
+	propServerReference                 = 0x1C
+	propReasonString                    = 0x1F
+	propReceiveMaximum                  = 0x21
+	propTopicAliasMaximum               = 0x22
+	propTopicAlias                      = 0x23
+	propMaximumQoS                      = 0x24
+	propRetainAvailable                 = 0x25
+	propUserProperty                    = 0x26
+	propMaximumPacketSize               = 0x27
+	propWildcardSubscriptionAvailable   = 0x28
+	propSubscriptionIdentifierAvailable = 0x29
+	propSharedSubscriptionAvailable     = 0x2A
+)
+
+// UserProperty is a name-value pair of an MQTT 5 user property.
+type UserProperty struct {
+	Key, Value string
+}
+
+// Properties are the MQTT 5 properties of a packet.
+// Properties that have a meaningful zero value are pointers; nil means that the property is absent.
+type Properties struct {
+	PayloadFormatIndicator          *byte
+	MessageExpiryInterval           uint32
+	ContentType                     string
+	ResponseTopic                   string
+	CorrelationData                 []byte
+	SubscriptionIdentifiers         []uint32
+	SessionExpiryInterval           uint32
+	AssignedClientIdentifier        string
+	ServerKeepAlive                 *uint16
+	AuthenticationMethod            string
+	AuthenticationData              []byte
+	RequestProblemInformation       *byte
+	WillDelayInterval               uint32
+	RequestResponseInformation      *byte
+	ResponseInformation             string
+	ServerReference                 string
+	ReasonString                    string
+	ReceiveMaximum                  uint16
+	TopicAliasMaximum               uint16
+	TopicAlias                      uint16
+	MaximumQoS                      *byte
+	RetainAvailable                 *byte
+	UserProperties                  []UserProperty
+	MaximumPacketSize               uint32
+	WildcardSubscriptionAvailable   *byte
+	SubscriptionIdentifierAvailable *byte
+	SharedSubscriptionAvailable     *byte
+}
+
+// UserPropertyValues returns the values of the user properties with the given key.
+func (p Properties) UserPropertyValues(key string) []string {
+	var values []string
+	for _, prop := range p.UserProperties {
+		if prop.Key == key {
+			values = append(values, prop.Value)
+		}
+	}
+	return values
+}
+
+var errProperty = errors.DefineInvalidArgument("property", "invalid property `{identifier}`")
+
+func (p *Properties) decode(r *reader) error {
+	n, err := r.varInt()
+	if err != nil {
+		return err
+	}
+	pr, err := r.sub(int(n))
+	if err != nil {
+		return err
+	}
+	for pr.len() > 0 {
+		id, err := pr.varInt()
+		if err != nil {
+			return err
+		}
+		switch id {
+		case propPayloadFormatIndicator:
+			p.PayloadFormatIndicator, err = pr.bytePtr()
+		case propMessageExpiryInterval:
+			p.MessageExpiryInterval, err = pr.uint32()
+		case propContentType:
+			p.ContentType, err = pr.string()
+		case propResponseTopic:
+			p.ResponseTopic, err = pr.string()
+		case propCorrelationData:
+			p.CorrelationData, err = pr.binary()
+		case propSubscriptionIdentifier:
+			var v uint32
+			if v, err = pr.varInt(); err == nil {
+				p.SubscriptionIdentifiers = append(p.SubscriptionIdentifiers, v)
+			}
+		case propSessionExpiryInterval:
+			p.SessionExpiryInterval, err = pr.uint32()
+		case propAssignedClientIdentifier:
+			p.AssignedClientIdentifier, err = pr.string()
+		case propServerKeepAlive:
+			var v uint16
+			if v, err = pr.uint16(); err == nil {
+				p.ServerKeepAlive = &v
+			}
+		case propAuthenticationMethod:
+			p.AuthenticationMethod, err = pr.string()
+		case propAuthenticationData:
+			p.AuthenticationData, err = pr.binary()
+		case propRequestProblemInformation:
+			p.RequestProblemInformation, err = pr.bytePtr()
+		case propWillDelayInterval:
+			p.WillDelayInterval, err = pr.uint32()
+		case propRequestResponseInformation:
+			p.RequestResponseInformation, err = pr.bytePtr()
+		case propResponseInformation:
+			p.ResponseInformation, err = pr.string()
+		case propServerReference:
+			p.ServerReference, err = pr.string()
+		case propReasonString:
+			p.ReasonString, err = pr.string()
+		case propReceiveMaximum:
+			p.ReceiveMaximum, err = pr.uint16()
+		case propTopicAliasMaximum:
+			p.TopicAliasMaximum, err = pr.uint16()
+		case propTopicAlias:
+			p.TopicAlias, err = pr.uint16()
+		case propMaximumQoS:
+			p.MaximumQoS, err = pr.bytePtr()
+		case propRetainAvailable:
+			p.RetainAvailable, err = pr.bytePtr()
+		case propUserProperty:
+			var prop UserProperty
+			if prop.Key, err = pr.string(); err == nil {
+				if prop.Value, err = pr.string(); err == nil {
+					p.UserProperties = append(p.UserProperties, prop)
+				}
+			}
+		case propMaximumPacketSize:
+			p.MaximumPacketSize, err = pr.uint32()
+		case propWildcardSubscriptionAvailable:
+			p.WildcardSubscriptionAvailable, err = pr.bytePtr()
+		case propSubscriptionIdentifierAvailable:
+			p.SubscriptionIdentifierAvailable, err = pr.bytePtr()
+		case propSharedSubscriptionAvailable:
+			p.SharedSubscriptionAvailable, err = pr.bytePtr()
+		default:
+			return errProperty.WithAttributes("identifier", id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p Properties) encode(w *bytes.Buffer) {
+	var buf bytes.Buffer
+	putByte := func(id, v byte) {
+		buf.WriteByte(id)
+		buf.WriteByte(v)
+	}
+	putUint16 := func(id byte, v uint16) {
+		buf.WriteByte(id)
+		writeUint16(&buf, v)
+	}
+	putUint32 := func(id byte, v uint32) {
+		buf.WriteByte(id)
+		writeUint32(&buf, v)
+	}
+	putString := func(id byte, v string) {
+		buf.WriteByte(id)
+		writeString(&buf, v)
+	}
+	putBinary := func(id byte, v []byte) {
+		buf.WriteByte(id)
+		writeBinary(&buf, v)
+	}
+	if p.PayloadFormatIndicator != nil {
+		putByte(propPayloadFormatIndicator, *p.PayloadFormatIndicator)
+	}
+	if p.MessageExpiryInterval != 0 {
+		putUint32(propMessageExpiryInterval, p.MessageExpiryInterval)
+	}
+	if p.ContentType != "" {
+		putString(propContentType, p.ContentType)
+	}
+	if p.ResponseTopic != "" {
+		putString(propResponseTopic, p.ResponseTopic)
+	}
+	if p.CorrelationData != nil {
+		putBinary(propCorrelationData, p.CorrelationData)
+	}
+	for _, v := range p.SubscriptionIdentifiers {
+		buf.WriteByte(propSubscriptionIdentifier)
+		writeVarInt(&buf, v)
+	}
+	if p.SessionExpiryInterval != 0 {
+		putUint32(propSessionExpiryInterval, p.SessionExpiryInterval)
+	}
+	if p.AssignedClientIdentifier != "" {
+		putString(propAssignedClientIdentifier, p.AssignedClientIdentifier)
+	}
+	if p.ServerKeepAlive != nil {
+		putUint16(propServerKeepAlive, *p.ServerKeepAlive)
+	}
+	if p.AuthenticationMethod != "" {
+		putString(propAuthenticationMethod, p.AuthenticationMethod)
+	}
+	if p.AuthenticationData != nil {
+		putBinary(propAuthenticationData, p.AuthenticationData)
+	}
+	if p.RequestProblemInformation != nil {
+		putByte(propRequestProblemInformation, *p.RequestProblemInformation)
+	}
+	if p.WillDelayInterval != 0 {
+		putUint32(propWillDelayInterval, p.WillDelayInterval)
+	}
+	if p.RequestResponseInformation != nil {
+		putByte(propRequestResponseInformation, *p.RequestResponseInformation)
+	}
+	if p.ResponseInformation != "" {
+		putString(propResponseInformation, p.ResponseInformation)
+	}
+	if p.ServerReference != "" {
+		putString(propServerReference, p.ServerReference)
+	}
+	if p.ReasonString != "" {
+		putString(propReasonString, p.ReasonString)
+	}
+	if p.ReceiveMaximum != 0 {
+		putUint16(propReceiveMaximum, p.ReceiveMaximum)
+	}
+	if p.TopicAliasMaximum != 0 {
+		putUint16(propTopicAliasMaximum, p.TopicAliasMaximum)
+	}
+	if p.TopicAlias != 0 {
+		putUint16(propTopicAlias, p.TopicAlias)
+	}
+	if p.MaximumQoS != nil {
+		putByte(propMaximumQoS, *p.MaximumQoS)
+	}
+	if p.RetainAvailable != nil {
+		putByte(propRetainAvailable, *p.RetainAvailable)
+	}
+	for _, prop := range p.UserProperties {
+		buf.WriteByte(propUserProperty)
+		writeString(&buf, prop.Key)
+		writeString(&buf, prop.Value)
+	}
+	if p.MaximumPacketSize != 0 {
+		putUint32(propMaximumPacketSize, p.MaximumPacketSize)
+	}
+	if p.WildcardSubscriptionAvailable != nil {
+		putByte(propWildcardSubscriptionAvailable, *p.WildcardSubscriptionAvailable)
+	}
+	if p.SubscriptionIdentifierAvailable != nil {
+		putByte(propSubscriptionIdentifierAvailable, *p.SubscriptionIdentifierAvailable)
+	}
+	if p.SharedSubscriptionAvailable != nil {
+		putByte(propSharedSubscriptionAvailable, *p.SharedSubscriptionAvailable)
+	}
+	writeVarInt(w, uint32(buf.Len()))
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,668 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"go.thethings.network/lorawan-stack/pkg/errorcontext"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/random"
+)
+
+// UserPropertyCorrelationID is the key of the MQTT 5 user property that contains a correlation ID.
+// A message may contain multiple correlation IDs.
+const UserPropertyCorrelationID = "correlation_id"
+
+const (
+	// topicAliasMaximum is the maximum number of topic aliases that clients may use.
+	topicAliasMaximum = 16
+	// maximumQoS is the maximum QoS supported by the session.
+	maximumQoS byte = 1
+	// publishBufferSize is the number of messages that can be queued for publishing to the client.
+	publishBufferSize = 32
+)
+
+// DefaultMaximumPacketSize is the default maximum size of packets that clients may send.
+const DefaultMaximumPacketSize uint32 = 1 << 16
+
+// ConnectInfo contains the information of a connecting MQTT 5 client.
+type ConnectInfo struct {
+	RemoteAddr string
+	ClientID   string
+	Username   string
+	Password   []byte
+	Properties Properties
+}
+
+// Handler handles MQTT 5 sessions.
+type Handler interface {
+	// Connect authenticates the client and returns the context of the session.
+	Connect(ctx context.Context, info *ConnectInfo) (context.Context, error)
+	// Subscribe authorizes the subscription to the topic filter and returns the accepted topic filter and QoS.
+	Subscribe(ctx context.Context, topicFilter string, qos byte) (acceptedTopicFilter string, acceptedQoS byte, err error)
+	// CanRead returns whether the client is allowed to receive messages published to the topic.
+	CanRead(ctx context.Context, topicParts ...string) bool
+	// CanWrite returns whether the client is allowed to publish to the topic.
+	CanWrite(ctx context.Context, topicParts ...string) bool
+	// Deliver handles a message published by the client.
+	Deliver(ctx context.Context, topicParts []string, pkt *PublishPacket)
+}
+
+// PublishOptions are the options for publishing a message to an MQTT 5 client.
+type PublishOptions struct {
+	// QoS is the requested QoS. The message is published with the lowest of the requested and subscribed QoS.
+	QoS byte
+	// MessageExpiry is the lifetime of the message. Messages that are not sent within their lifetime are dropped.
+	MessageExpiry time.Duration
+	// UserProperties are the user properties of the message.
+	UserProperties []UserProperty
+}
+
+// CorrelationIDUserProperties returns the user properties for the given correlation IDs.
+func CorrelationIDUserProperties(correlationIDs ...string) []UserProperty {
+	props := make([]UserProperty, 0, len(correlationIDs))
+	for _, cid := range correlationIDs {
+		props = append(props, UserProperty{Key: UserPropertyCorrelationID, Value: cid})
+	}
+	return props
+}
+
+// ConnectReasonCode returns the CONNACK reason code for the given error.
+func ConnectReasonCode(err error) ReasonCode {
+	switch {
+	case err == nil:
+		return Success
+	case errors.IsUnauthenticated(err), errors.IsInvalidArgument(err), errors.IsNotFound(err):
+		return BadUsernameOrPassword
+	case errors.IsPermissionDenied(err):
+		return NotAuthorized
+	case errors.IsResourceExhausted(err):
+		return ConnectionRateExceeded
+	case errors.IsUnavailable(err), errors.IsCanceled(err), errors.IsDeadlineExceeded(err):
+		return ServerUnavailable
+	default:
+		return UnspecifiedError
+	}
+}
+
+// DisconnectReasonCode returns the DISCONNECT reason code for the given error.
+func DisconnectReasonCode(err error) ReasonCode {
+	switch {
+	case err == nil:
+		return NormalDisconnection
+	case errors.IsCanceled(err):
+		return ServerShuttingDown
+	case errors.IsAborted(err):
+		return SessionTakenOver
+	case errors.IsUnauthenticated(err), errors.IsPermissionDenied(err):
+		return NotAuthorized
+	case errors.IsResourceExhausted(err):
+		return QuotaExceeded
+	case errors.IsUnavailable(err):
+		return ServerBusy
+	default:
+		return UnspecifiedError
+	}
+}
+
+type publication struct {
+	topicParts []string
+	payload    []byte
+	opts       PublishOptions
+	expiresAt  time.Time
+}
+
+// Session is a server-side MQTT 5 session.
+// Sessions are not persisted; the server always starts a clean session.
+type Session struct {
+	ctx     context.Context
+	cancel  errorcontext.CancelFunc
+	conn    net.Conn
+	handler Handler
+
+	keepAlive           time.Duration
+	maxPacketSize       uint32
+	clientMaxPacketSize uint32
+	topicAliasMaximum   uint16
+	problemInfo         bool
+
+	controlCh chan Packet
+	publishCh chan publication
+
+	writeMu sync.Mutex
+
+	mu            sync.RWMutex
+	will          *Will
+	subscriptions map[string]byte
+	inAliases     map[uint16]string
+	outAliases    map[string]uint16
+	packetID      uint16
+}
+
+// SessionOption configures the Session.
+type SessionOption func(*Session)
+
+// WithMaximumPacketSize sets the maximum size of packets that the client may send.
+// If size is zero, DefaultMaximumPacketSize is used.
+func WithMaximumPacketSize(size uint32) SessionOption {
+	return func(s *Session) {
+		if size == 0 {
+			size = DefaultMaximumPacketSize
+		}
+		s.maxPacketSize = size
+	}
+}
+
+// NewSession returns a new MQTT 5 session on the given connection.
+func NewSession(ctx context.Context, conn net.Conn, handler Handler, opts ...SessionOption) *Session {
+	s := &Session{
+		ctx:           ctx,
+		conn:          conn,
+		handler:       handler,
+		maxPacketSize: DefaultMaximumPacketSize,
+		problemInfo:   true,
+		controlCh:     make(chan Packet),
+		publishCh:     make(chan publication, publishBufferSize),
+		subscriptions: make(map[string]byte),
+		inAliases:     make(map[uint16]string),
+		outAliases:    make(map[string]uint16),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Context returns the context of the session.
+// After a successful Connect, the context is the context returned by the handler.
+func (s *Session) Context() context.Context { return s.ctx }
+
+var (
+	errConnect        = errors.DefineUnauthenticated("connect", "connect rejected with reason code `{reason_code}`")
+	errWillRetain     = errors.DefineInvalidArgument("will_retain", "retained will messages are not supported")
+	errWillQoS        = errors.DefineInvalidArgument("will_qos", "will QoS `{qos}` is not supported")
+	errProtocol       = errors.DefineInvalidArgument("protocol", "protocol error")
+	errQoS            = errors.DefineInvalidArgument("qos", "QoS `{qos}` is not supported")
+	errRetain         = errors.DefineInvalidArgument("retain", "retained messages are not supported")
+	errTopicAlias     = errors.DefineInvalidArgument("topic_alias", "invalid topic alias `{alias}`")
+	errTopicName      = errors.DefineInvalidArgument("topic_name", "invalid topic name")
+	errSubscriptionID = errors.DefineInvalidArgument("subscription_identifier", "subscription identifiers are not supported")
+	errDisconnected   = errors.DefineAborted("disconnected", "client disconnected")
+)
+
+// Connect reads the CONNECT packet, authenticates the client with the handler and sends the CONNACK packet.
+// The returned context is the session context returned by the handler.
+func (s *Session) Connect() (context.Context, error) {
+	if err := s.conn.SetReadDeadline(time.Now().Add(ConnectTimeout)); err != nil {
+		return nil, err
+	}
+	pkt, err := ReadPacket(s.conn, s.maxPacketSize)
+	if err != nil {
+		if errors.Resemble(err, errUnsupportedProtocol) {
+			s.writeConnack(UnsupportedProtocolVersion, nil)
+		} else if errors.Resemble(err, errPacketTooLarge) {
+			s.writeConnack(PacketTooLarge, nil)
+		} else if err != io.EOF {
+			s.writeConnack(MalformedPacket, nil)
+		}
+		return nil, err
+	}
+	connect, ok := pkt.(*ConnectPacket)
+	if !ok {
+		return nil, errNotConnect
+	}
+	if connect.Properties.RequestProblemInformation != nil && *connect.Properties.RequestProblemInformation == 0 {
+		s.problemInfo = false
+	}
+	s.clientMaxPacketSize = connect.Properties.MaximumPacketSize
+	if will := connect.Will; will != nil {
+		if will.Retain {
+			s.writeConnack(RetainNotSupported, errWillRetain)
+			return nil, errWillRetain
+		}
+		if will.QoS > maximumQoS {
+			err := errWillQoS.WithAttributes("qos", will.QoS)
+			s.writeConnack(QoSNotSupported, err)
+			return nil, err
+		}
+	}
+
+	info := &ConnectInfo{
+		RemoteAddr: s.conn.RemoteAddr().String(),
+		ClientID:   connect.ClientID,
+		Username:   connect.Username,
+		Password:   connect.Password,
+		Properties: connect.Properties,
+	}
+	var assignedClientID string
+	if info.ClientID == "" {
+		assignedClientID = random.String(16)
+		info.ClientID = assignedClientID
+	}
+	ctx := log.NewContextWithField(s.ctx, "client_id", info.ClientID)
+	ctx, err = s.handler.Connect(ctx, info)
+	if err != nil {
+		code := ConnectReasonCode(err)
+		s.writeConnack(code, err)
+		return nil, errConnect.WithCause(err).WithAttributes("reason_code", code)
+	}
+	s.ctx, s.cancel = errorcontext.New(ctx)
+	s.keepAlive = time.Duration(connect.KeepAlive) * time.Second
+	s.topicAliasMaximum = connect.Properties.TopicAliasMaximum
+	s.will = connect.Will
+
+	if err := s.conn.SetReadDeadline(time.Time{}); err != nil {
+		s.cancel(err)
+		return nil, err
+	}
+
+	no, yes := byte(0), byte(1)
+	maxQoS := maximumQoS
+	if err := s.write(&ConnackPacket{
+		ReasonCode: Success,
+		Properties: Properties{
+			AssignedClientIdentifier:        assignedClientID,
+			MaximumPacketSize:               s.maxPacketSize,
+			TopicAliasMaximum:               topicAliasMaximum,
+			MaximumQoS:                      &maxQoS,
+			RetainAvailable:                 &no,
+			WildcardSubscriptionAvailable:   &yes,
+			SubscriptionIdentifierAvailable: &no,
+			SharedSubscriptionAvailable:     &no,
+		},
+	}); err != nil {
+		s.cancel(err)
+		return nil, err
+	}
+	return s.ctx, nil
+}
+
+func (s *Session) writeConnack(code ReasonCode, err error) {
+	pkt := &ConnackPacket{ReasonCode: code}
+	if err != nil && s.problemInfo {
+		pkt.Properties.ReasonString = err.Error()
+	}
+	if err := s.write(pkt); err != nil {
+		log.FromContext(s.ctx).WithError(err).Debug("Failed to write CONNACK packet")
+	}
+}
+
+// write writes the packet to the client.
+// Packets that exceed the maximum packet size of the client are not written; the reason string is omitted if that
+// makes the packet fit.
+func (s *Session) write(pkt Packet) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	err := writePacket(s.conn, pkt, s.clientMaxPacketSize)
+	if errors.Resemble(err, errPacketTooLarge) && removeReasonString(pkt) {
+		err = writePacket(s.conn, pkt, s.clientMaxPacketSize)
+	}
+	return err
+}
+
+// removeReasonString removes the reason string from the packet and returns whether the packet had one.
+func removeReasonString(pkt Packet) bool {
+	var props *Properties
+	switch pkt := pkt.(type) {
+	case *ConnackPacket:
+		props = &pkt.Properties
+	case *DisconnectPacket:
+		props = &pkt.Properties
+	default:
+		return false
+	}
+	if props.ReasonString == "" {
+		return false
+	}
+	props.ReasonString = ""
+	return true
+}
+
+// Run reads and handles packets from the client and writes packets to the client, until the client disconnects or the
+// session context is done. Run must be called after a successful Connect.
+// Run returns the error that ended the session.
+func (s *Session) Run() error {
+	go s.writePackets()
+	defer s.close()
+	for {
+		if s.keepAlive > 0 {
+			if err := s.conn.SetReadDeadline(time.Now().Add(s.keepAlive * 3 / 2)); err != nil {
+				s.cancel(err)
+				return err
+			}
+		}
+		pkt, err := ReadPacket(s.conn, s.maxPacketSize)
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return s.ctx.Err()
+			}
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				s.disconnect(KeepAliveTimeout, nil)
+			} else if errors.Resemble(err, errPacketTooLarge) {
+				s.disconnect(PacketTooLarge, err)
+			} else if err != io.EOF {
+				s.disconnect(MalformedPacket, err)
+			}
+			s.cancel(err)
+			return err
+		}
+		if err := s.handle(pkt); err != nil {
+			if !errors.Resemble(err, errDisconnected) {
+				s.disconnect(protocolErrorReasonCode(err), err)
+			}
+			s.cancel(err)
+			return err
+		}
+	}
+}
+
+func protocolErrorReasonCode(err error) ReasonCode {
+	switch {
+	case errors.Resemble(err, errQoS):
+		return QoSNotSupported
+	case errors.Resemble(err, errRetain):
+		return RetainNotSupported
+	case errors.Resemble(err, errTopicAlias):
+		return TopicAliasInvalid
+	case errors.Resemble(err, errTopicName):
+		return TopicNameInvalid
+	case errors.Resemble(err, errSubscriptionID):
+		return SubscriptionIdentifiersNotSupported
+	default:
+		return ProtocolError
+	}
+}
+
+func (s *Session) handle(pkt Packet) error {
+	logger := log.FromContext(s.ctx)
+	switch pkt := pkt.(type) {
+	case *PublishPacket:
+		return s.handlePublish(pkt)
+	case *PubackPacket:
+		return nil
+	case *SubscribePacket:
+		return s.handleSubscribe(pkt)
+	case *UnsubscribePacket:
+		codes := make([]ReasonCode, 0, len(pkt.TopicFilters))
+		s.mu.Lock()
+		for _, filter := range pkt.TopicFilters {
+			if _, ok := s.subscriptions[filter]; ok {
+				delete(s.subscriptions, filter)
+				codes = append(codes, Success)
+			} else {
+				codes = append(codes, NoSubscriptionExisted)
+			}
+		}
+		s.mu.Unlock()
+		return s.sendControl(&UnsubackPacket{PacketID: pkt.PacketID, ReasonCodes: codes})
+	case *PingreqPacket:
+		return s.sendControl(&PingrespPacket{})
+	case *DisconnectPacket:
+		logger.WithField("reason_code", pkt.ReasonCode).Debug("Client disconnected")
+		if pkt.ReasonCode != DisconnectWithWillMessage {
+			s.mu.Lock()
+			s.will = nil
+			s.mu.Unlock()
+		}
+		return errDisconnected
+	default:
+		logger.WithField("packet_type", pkt.PacketType()).Debug("Unexpected packet")
+		return errProtocol
+	}
+}
+
+func (s *Session) handlePublish(pkt *PublishPacket) error {
+	if pkt.QoS > maximumQoS {
+		return errQoS.WithAttributes("qos", pkt.QoS)
+	}
+	if pkt.Retain {
+		return errRetain
+	}
+	if alias := pkt.Properties.TopicAlias; alias != 0 {
+		if alias > topicAliasMaximum {
+			return errTopicAlias.WithAttributes("alias", alias)
+		}
+		s.mu.Lock()
+		if pkt.TopicName != "" {
+			s.inAliases[alias] = pkt.TopicName
+		} else if name, ok := s.inAliases[alias]; ok {
+			pkt.TopicName = name
+		}
+		s.mu.Unlock()
+		if pkt.TopicName == "" {
+			return errTopicAlias.WithAttributes("alias", alias)
+		}
+	}
+	if pkt.TopicName == "" || strings.ContainsAny(pkt.TopicName, "+#") {
+		return errTopicName
+	}
+	topicParts := topic.Split(pkt.TopicName)
+	code := Success
+	if s.handler.CanWrite(s.ctx, topicParts...) {
+		s.handler.Deliver(s.ctx, topicParts, pkt)
+	} else {
+		log.FromContext(s.ctx).WithField("topic", pkt.TopicName).Debug("Not authorized to publish to topic")
+		code = NotAuthorized
+	}
+	if pkt.QoS > 0 {
+		return s.sendControl(&PubackPacket{PacketID: pkt.PacketID, ReasonCode: code})
+	}
+	return nil
+}
+
+func (s *Session) handleSubscribe(pkt *SubscribePacket) error {
+	if len(pkt.Properties.SubscriptionIdentifiers) > 0 {
+		return errSubscriptionID
+	}
+	codes := make([]ReasonCode, 0, len(pkt.Subscriptions))
+	for _, sub := range pkt.Subscriptions {
+		logger := log.FromContext(s.ctx).WithField("topic_filter", sub.TopicFilter)
+		if strings.HasPrefix(sub.TopicFilter, "$share/") {
+			codes = append(codes, SharedSubscriptionsNotSupported)
+			continue
+		}
+		if sub.TopicFilter == "" {
+			codes = append(codes, TopicFilterInvalid)
+			continue
+		}
+		qos := sub.QoS
+		if qos > maximumQoS {
+			qos = maximumQoS
+		}
+		filter, qos, err := s.handler.Subscribe(s.ctx, sub.TopicFilter, qos)
+		if err != nil {
+			logger.WithError(err).Debug("Subscription rejected")
+			codes = append(codes, NotAuthorized)
+			continue
+		}
+		if qos > maximumQoS {
+			qos = maximumQoS
+		}
+		s.mu.Lock()
+		s.subscriptions[filter] = qos
+		s.mu.Unlock()
+		logger.WithField("accepted_topic_filter", filter).Debug("Subscribed")
+		codes = append(codes, ReasonCode(qos))
+	}
+	return s.sendControl(&SubackPacket{PacketID: pkt.PacketID, ReasonCodes: codes})
+}
+
+func (s *Session) sendControl(pkt Packet) error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.controlCh <- pkt:
+		return nil
+	}
+}
+
+// Publish queues the message for publishing to the client, if the client is subscribed to the topic and is allowed
+// to read the topic.
+func (s *Session) Publish(topicParts []string, payload []byte, opts PublishOptions) {
+	if !s.handler.CanRead(s.ctx, topicParts...) {
+		return
+	}
+	pub := publication{
+		topicParts: topicParts,
+		payload:    payload,
+		opts:       opts,
+	}
+	if opts.MessageExpiry > 0 {
+		pub.expiresAt = time.Now().Add(opts.MessageExpiry)
+	}
+	select {
+	case <-s.ctx.Done():
+	case s.publishCh <- pub:
+	}
+}
+
+// subscribedQoS returns the highest QoS of the subscriptions that match the topic.
+func (s *Session) subscribedQoS(topicParts []string) (byte, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var (
+		qos byte
+		ok  bool
+	)
+	for filter, subQoS := range s.subscriptions {
+		if !topic.MatchPath(topicParts, topic.Split(filter)) {
+			continue
+		}
+		if !ok || subQoS > qos {
+			qos = subQoS
+		}
+		ok = true
+	}
+	return qos, ok
+}
+
+func (s *Session) publishPacket(pub publication) (*PublishPacket, bool) {
+	logger := log.FromContext(s.ctx)
+	subQoS, ok := s.subscribedQoS(pub.topicParts)
+	if !ok {
+		return nil, false
+	}
+	pkt := &PublishPacket{
+		QoS:       pub.opts.QoS,
+		TopicName: topic.Join(pub.topicParts),
+		Payload:   pub.payload,
+		Properties: Properties{
+			UserProperties: pub.opts.UserProperties,
+		},
+	}
+	if subQoS < pkt.QoS {
+		pkt.QoS = subQoS
+	}
+	if !pub.expiresAt.IsZero() {
+		remaining := time.Until(pub.expiresAt)
+		if remaining <= 0 {
+			logger.WithField("topic", pkt.TopicName).Debug("Drop expired message")
+			return nil, false
+		}
+		pkt.Properties.MessageExpiryInterval = uint32((remaining + time.Second - 1) / time.Second)
+	}
+	if pkt.QoS > 0 {
+		s.packetID++
+		if s.packetID == 0 {
+			s.packetID++
+		}
+		pkt.PacketID = s.packetID
+	}
+	if alias, ok := s.outAliases[pkt.TopicName]; ok {
+		pkt.Properties.TopicAlias = alias
+		pkt.TopicName = ""
+	} else if n := len(s.outAliases); n < int(s.topicAliasMaximum) {
+		alias := uint16(n + 1)
+		s.outAliases[pkt.TopicName] = alias
+		pkt.Properties.TopicAlias = alias
+	}
+	return pkt, true
+}
+
+func (s *Session) writePackets() {
+	logger := log.FromContext(s.ctx)
+	for {
+		var pkt Packet
+		select {
+		case <-s.ctx.Done():
+			return
+		case pkt = <-s.controlCh:
+		case pub := <-s.publishCh:
+			var ok bool
+			if pkt, ok = s.publishPacket(pub); !ok {
+				continue
+			}
+		}
+		if err := s.write(pkt); err != nil {
+			if errors.Resemble(err, errPacketTooLarge) {
+				// The client can not receive the packet, so it is discarded as if it was sent.
+				logger.WithError(err).WithField("packet_type", pkt.PacketType()).Warn("Drop packet that exceeds client maximum packet size")
+				if pub, ok := pkt.(*PublishPacket); ok && pub.TopicName != "" && pub.Properties.TopicAlias != 0 {
+					// The client did not receive the topic alias mapping.
+					delete(s.outAliases, pub.TopicName)
+				}
+				continue
+			}
+			logger.WithError(err).Warn("Failed to write packet")
+			s.cancel(err)
+			return
+		}
+	}
+}
+
+// Disconnect sends a DISCONNECT packet with the reason code for the given error and closes the session.
+func (s *Session) Disconnect(err error) {
+	s.disconnect(DisconnectReasonCode(err), err)
+	s.cancel(err)
+	s.conn.Close()
+}
+
+func (s *Session) disconnect(code ReasonCode, err error) {
+	pkt := &DisconnectPacket{ReasonCode: code}
+	if err != nil && s.problemInfo {
+		pkt.Properties.ReasonString = err.Error()
+	}
+	if err := s.write(pkt); err != nil {
+		log.FromContext(s.ctx).WithError(err).Debug("Failed to write DISCONNECT packet")
+	}
+}
+
+// close delivers the will message, if any, and closes the connection.
+func (s *Session) close() {
+	s.mu.Lock()
+	will := s.will
+	s.will = nil
+	s.mu.Unlock()
+	if will != nil {
+		topicParts := topic.Split(will.Topic)
+		if s.handler.CanWrite(s.ctx, topicParts...) {
+			s.handler.Deliver(s.ctx, topicParts, &PublishPacket{
+				QoS:        will.QoS,
+				TopicName:  will.Topic,
+				Properties: will.Properties,
+				Payload:    will.Payload,
+			})
+		}
+	}
+	s.conn.Close()
+}
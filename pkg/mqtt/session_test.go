@@ -0,0 +1,509 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+var (
+	errTestUnauthenticated = errors.DefineUnauthenticated("test_unauthenticated", "unauthenticated")
+	errTestNotAuthorized   = errors.DefinePermissionDenied("test_not_authorized", "not authorized")
+	errTestTakenOver       = errors.DefineAborted("test_taken_over", "taken over")
+)
+
+type delivery struct {
+	topicParts     []string
+	payload        []byte
+	correlationIDs []string
+}
+
+type mockHandler struct {
+	password  string
+	delivered chan delivery
+}
+
+func (h *mockHandler) Connect(ctx context.Context, info *mqtt.ConnectInfo) (context.Context, error) {
+	if string(info.Password) != h.password {
+		return nil, errTestUnauthenticated
+	}
+	return ctx, nil
+}
+
+func (h *mockHandler) Subscribe(ctx context.Context, topicFilter string, qos byte) (string, byte, error) {
+	if topicFilter != "foo/#" {
+		return "", 0, errTestNotAuthorized
+	}
+	return topicFilter, qos, nil
+}
+
+func (h *mockHandler) CanRead(ctx context.Context, topicParts ...string) bool {
+	return topicParts[0] == "foo"
+}
+
+func (h *mockHandler) CanWrite(ctx context.Context, topicParts ...string) bool {
+	return topicParts[0] == "foo"
+}
+
+func (h *mockHandler) Deliver(ctx context.Context, topicParts []string, pkt *mqtt.PublishPacket) {
+	h.delivered <- delivery{
+		topicParts:     topicParts,
+		payload:        pkt.Payload,
+		correlationIDs: pkt.Properties.UserPropertyValues(mqtt.UserPropertyCorrelationID),
+	}
+}
+
+var timeout = 10 * test.Delay
+
+func writePacket(t *testing.T, conn net.Conn, pkt mqtt.Packet) {
+	conn.SetWriteDeadline(time.Now().Add(timeout))
+	if err := mqtt.WritePacket(conn, pkt); err != nil {
+		t.Fatalf("Failed to write %s packet: %v", pkt.PacketType(), err)
+	}
+}
+
+func readPacket(t *testing.T, conn net.Conn) mqtt.Packet {
+	conn.SetReadDeadline(time.Now().Add(timeout))
+	pkt, err := mqtt.ReadPacket(conn, 0)
+	if err != nil {
+		t.Fatalf("Failed to read packet: %v", err)
+	}
+	return pkt
+}
+
+func TestSessionConnect(t *testing.T) {
+	for _, tc := range []struct {
+		Name       string
+		Connect    *mqtt.ConnectPacket
+		ReasonCode mqtt.ReasonCode
+	}{
+		{
+			Name: "Success",
+			Connect: &mqtt.ConnectPacket{
+				Username: "test",
+				Password: []byte("secret"),
+			},
+			ReasonCode: mqtt.Success,
+		},
+		{
+			Name: "BadPassword",
+			Connect: &mqtt.ConnectPacket{
+				Username: "test",
+				Password: []byte("invalid"),
+			},
+			ReasonCode: mqtt.BadUsernameOrPassword,
+		},
+		{
+			Name: "RetainedWill",
+			Connect: &mqtt.ConnectPacket{
+				Username: "test",
+				Password: []byte("secret"),
+				Will: &mqtt.Will{
+					Retain: true,
+					Topic:  "foo/will",
+				},
+			},
+			ReasonCode: mqtt.RetainNotSupported,
+		},
+		{
+			Name: "WillQoS2",
+			Connect: &mqtt.ConnectPacket{
+				Username: "test",
+				Password: []byte("secret"),
+				Will: &mqtt.Will{
+					QoS:   2,
+					Topic: "foo/will",
+				},
+			},
+			ReasonCode: mqtt.QoSNotSupported,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+			serverConn, clientConn := net.Pipe()
+			defer clientConn.Close()
+			session := mqtt.NewSession(ctx, serverConn, &mockHandler{password: "secret"})
+			errCh := make(chan error, 1)
+			go func() {
+				_, err := session.Connect()
+				errCh <- err
+			}()
+
+			tc.Connect.ProtocolName, tc.Connect.ProtocolLevel = "MQTT", mqtt.ProtocolLevel5
+			writePacket(t, clientConn, tc.Connect)
+			connack, ok := readPacket(t, clientConn).(*mqtt.ConnackPacket)
+			if !a.So(ok, should.BeTrue) {
+				t.FailNow()
+			}
+			a.So(connack.ReasonCode, should.Equal, tc.ReasonCode)
+			if tc.ReasonCode == mqtt.Success {
+				a.So(connack.Properties.AssignedClientIdentifier, should.NotBeEmpty)
+				a.So(<-errCh, should.BeNil)
+			} else {
+				a.So(connack.Properties.ReasonString, should.NotBeEmpty)
+				a.So(<-errCh, should.NotBeNil)
+			}
+		})
+	}
+}
+
+func TestSessionTraffic(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+	handler := &mockHandler{
+		password:  "secret",
+		delivered: make(chan delivery, 4),
+	}
+	session := mqtt.NewSession(ctx, serverConn, handler)
+	go func() {
+		if _, err := session.Connect(); err != nil {
+			return
+		}
+		session.Run()
+	}()
+
+	writePacket(t, clientConn, &mqtt.ConnectPacket{
+		ProtocolName:  "MQTT",
+		ProtocolLevel: mqtt.ProtocolLevel5,
+		ClientID:      "test-client",
+		Username:      "test",
+		Password:      []byte("secret"),
+		Properties: mqtt.Properties{
+			TopicAliasMaximum: 2,
+		},
+	})
+	connack := readPacket(t, clientConn).(*mqtt.ConnackPacket)
+	if !a.So(connack.ReasonCode, should.Equal, mqtt.Success) {
+		t.FailNow()
+	}
+	a.So(connack.Properties.TopicAliasMaximum, should.BeGreaterThan, uint16(0))
+
+	writePacket(t, clientConn, &mqtt.SubscribePacket{
+		PacketID: 1,
+		Subscriptions: []mqtt.Subscription{
+			{TopicFilter: "foo/#", QoS: 2},
+			{TopicFilter: "bar/#"},
+			{TopicFilter: "$share/group/foo/#"},
+		},
+	})
+	a.So(readPacket(t, clientConn), should.Resemble, &mqtt.SubackPacket{
+		PacketID: 1,
+		ReasonCodes: []mqtt.ReasonCode{
+			mqtt.GrantedQoS1,
+			mqtt.NotAuthorized,
+			mqtt.SharedSubscriptionsNotSupported,
+		},
+	})
+
+	t.Run("Publish", func(t *testing.T) {
+		a := assertions.New(t)
+
+		opts := mqtt.PublishOptions{
+			QoS:            1,
+			MessageExpiry:  time.Minute,
+			UserProperties: mqtt.CorrelationIDUserProperties("cid:1", "cid:2"),
+		}
+		session.Publish([]string{"foo", "down"}, []byte("first"), opts)
+		session.Publish([]string{"foo", "down"}, []byte("second"), opts)
+		session.Publish([]string{"foo", "expired"}, []byte("expired"), mqtt.PublishOptions{MessageExpiry: time.Nanosecond})
+		session.Publish([]string{"bar", "down"}, []byte("unsubscribed"), mqtt.PublishOptions{})
+		session.Publish([]string{"foo", "other"}, []byte("third"), mqtt.PublishOptions{})
+		session.Publish([]string{"foo", "more"}, []byte("fourth"), mqtt.PublishOptions{})
+
+		pkt := readPacket(t, clientConn).(*mqtt.PublishPacket)
+		a.So(pkt.TopicName, should.Equal, "foo/down")
+		a.So(pkt.QoS, should.Equal, byte(1))
+		a.So(pkt.PacketID, should.Equal, uint16(1))
+		a.So(pkt.Properties.TopicAlias, should.Equal, uint16(1))
+		a.So(pkt.Properties.MessageExpiryInterval, should.BeBetweenOrEqual, uint32(59), uint32(60))
+		a.So(pkt.Properties.UserPropertyValues(mqtt.UserPropertyCorrelationID), should.Resemble, []string{"cid:1", "cid:2"})
+		a.So(pkt.Payload, should.Resemble, []byte("first"))
+
+		pkt = readPacket(t, clientConn).(*mqtt.PublishPacket)
+		a.So(pkt.TopicName, should.BeEmpty)
+		a.So(pkt.PacketID, should.Equal, uint16(2))
+		a.So(pkt.Properties.TopicAlias, should.Equal, uint16(1))
+		a.So(pkt.Payload, should.Resemble, []byte("second"))
+
+		pkt = readPacket(t, clientConn).(*mqtt.PublishPacket)
+		a.So(pkt.TopicName, should.Equal, "foo/other")
+		a.So(pkt.QoS, should.Equal, byte(0))
+		a.So(pkt.Properties.TopicAlias, should.Equal, uint16(2))
+		a.So(pkt.Properties.MessageExpiryInterval, should.Equal, uint32(0))
+		a.So(pkt.Payload, should.Resemble, []byte("third"))
+
+		pkt = readPacket(t, clientConn).(*mqtt.PublishPacket)
+		a.So(pkt.TopicName, should.Equal, "foo/more")
+		a.So(pkt.Properties.TopicAlias, should.Equal, uint16(0))
+		a.So(pkt.Payload, should.Resemble, []byte("fourth"))
+	})
+
+	t.Run("Deliver", func(t *testing.T) {
+		a := assertions.New(t)
+
+		writePacket(t, clientConn, &mqtt.PublishPacket{
+			QoS:       1,
+			PacketID:  7,
+			TopicName: "foo/up",
+			Properties: mqtt.Properties{
+				TopicAlias:     1,
+				UserProperties: mqtt.CorrelationIDUserProperties("cid:3"),
+			},
+			Payload: []byte("up"),
+		})
+		a.So(readPacket(t, clientConn), should.Resemble, &mqtt.PubackPacket{
+			PacketID:   7,
+			ReasonCode: mqtt.Success,
+		})
+		select {
+		case d := <-handler.delivered:
+			a.So(d.topicParts, should.Resemble, []string{"foo", "up"})
+			a.So(d.payload, should.Resemble, []byte("up"))
+			a.So(d.correlationIDs, should.Resemble, []string{"cid:3"})
+		case <-time.After(timeout):
+			t.Fatal("Expected delivery")
+		}
+
+		writePacket(t, clientConn, &mqtt.PublishPacket{
+			Properties: mqtt.Properties{
+				TopicAlias: 1,
+			},
+			Payload: []byte("aliased"),
+		})
+		select {
+		case d := <-handler.delivered:
+			a.So(d.topicParts, should.Resemble, []string{"foo", "up"})
+			a.So(d.payload, should.Resemble, []byte("aliased"))
+		case <-time.After(timeout):
+			t.Fatal("Expected delivery")
+		}
+
+		writePacket(t, clientConn, &mqtt.PublishPacket{
+			QoS:       1,
+			PacketID:  8,
+			TopicName: "bar/up",
+			Payload:   []byte("unauthorized"),
+		})
+		a.So(readPacket(t, clientConn), should.Resemble, &mqtt.PubackPacket{
+			PacketID:   8,
+			ReasonCode: mqtt.NotAuthorized,
+		})
+		select {
+		case d := <-handler.delivered:
+			t.Fatalf("Unexpected delivery to %v", d.topicParts)
+		default:
+		}
+	})
+
+	t.Run("Ping", func(t *testing.T) {
+		a := assertions.New(t)
+		writePacket(t, clientConn, &mqtt.PingreqPacket{})
+		a.So(readPacket(t, clientConn), should.Resemble, &mqtt.PingrespPacket{})
+	})
+
+	t.Run("Unsubscribe", func(t *testing.T) {
+		a := assertions.New(t)
+		writePacket(t, clientConn, &mqtt.UnsubscribePacket{
+			PacketID:     2,
+			TopicFilters: []string{"foo/#", "bar/#"},
+		})
+		a.So(readPacket(t, clientConn), should.Resemble, &mqtt.UnsubackPacket{
+			PacketID:    2,
+			ReasonCodes: []mqtt.ReasonCode{mqtt.Success, mqtt.NoSubscriptionExisted},
+		})
+	})
+
+	t.Run("Disconnect", func(t *testing.T) {
+		a := assertions.New(t)
+		go session.Disconnect(errTestTakenOver)
+		pkt, ok := readPacket(t, clientConn).(*mqtt.DisconnectPacket)
+		if !a.So(ok, should.BeTrue) {
+			t.FailNow()
+		}
+		a.So(pkt.ReasonCode, should.Equal, mqtt.SessionTakenOver)
+	})
+}
+
+func TestSessionMaximumPacketSize(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+	handler := &mockHandler{
+		password:  "secret",
+		delivered: make(chan delivery, 1),
+	}
+	session := mqtt.NewSession(ctx, serverConn, handler, mqtt.WithMaximumPacketSize(128))
+	runCh := make(chan error, 1)
+	go func() {
+		if _, err := session.Connect(); err != nil {
+			runCh <- err
+			return
+		}
+		runCh <- session.Run()
+	}()
+
+	writePacket(t, clientConn, &mqtt.ConnectPacket{
+		ProtocolName:  "MQTT",
+		ProtocolLevel: mqtt.ProtocolLevel5,
+		Username:      "test",
+		Password:      []byte("secret"),
+		Properties: mqtt.Properties{
+			MaximumPacketSize: 64,
+		},
+	})
+	connack := readPacket(t, clientConn).(*mqtt.ConnackPacket)
+	if !a.So(connack.ReasonCode, should.Equal, mqtt.Success) {
+		t.FailNow()
+	}
+	a.So(connack.Properties.MaximumPacketSize, should.Equal, uint32(128))
+
+	writePacket(t, clientConn, &mqtt.SubscribePacket{
+		PacketID:      1,
+		Subscriptions: []mqtt.Subscription{{TopicFilter: "foo/#"}},
+	})
+	a.So(readPacket(t, clientConn), should.Resemble, &mqtt.SubackPacket{
+		PacketID:    1,
+		ReasonCodes: []mqtt.ReasonCode{mqtt.GrantedQoS0},
+	})
+
+	// Publications that exceed the maximum packet size of the client are dropped.
+	session.Publish([]string{"foo", "down"}, make([]byte, 64), mqtt.PublishOptions{})
+	session.Publish([]string{"foo", "down"}, []byte("small"), mqtt.PublishOptions{})
+	pkt := readPacket(t, clientConn).(*mqtt.PublishPacket)
+	a.So(pkt.TopicName, should.Equal, "foo/down")
+	a.So(pkt.Payload, should.Resemble, []byte("small"))
+
+	// Packets that exceed the maximum packet size of the server disconnect the client.
+	go mqtt.WritePacket(clientConn, &mqtt.PublishPacket{
+		TopicName: "foo/up",
+		Payload:   make([]byte, 256),
+	})
+	disconnect, ok := readPacket(t, clientConn).(*mqtt.DisconnectPacket)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(disconnect.ReasonCode, should.Equal, mqtt.PacketTooLarge)
+	select {
+	case err := <-runCh:
+		a.So(err, should.NotBeNil)
+	case <-time.After(timeout):
+		t.Fatal("Expected session to end")
+	}
+	select {
+	case d := <-handler.delivered:
+		t.Fatalf("Unexpected delivery to %v", d.topicParts)
+	default:
+	}
+}
+
+func TestSessionWill(t *testing.T) {
+	for _, tc := range []struct {
+		Name       string
+		Disconnect *mqtt.DisconnectPacket
+		Delivered  bool
+	}{
+		{
+			Name:      "ConnectionLost",
+			Delivered: true,
+		},
+		{
+			Name:       "NormalDisconnection",
+			Disconnect: &mqtt.DisconnectPacket{ReasonCode: mqtt.NormalDisconnection},
+		},
+		{
+			Name:       "DisconnectWithWillMessage",
+			Disconnect: &mqtt.DisconnectPacket{ReasonCode: mqtt.DisconnectWithWillMessage},
+			Delivered:  true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+			serverConn, clientConn := net.Pipe()
+			handler := &mockHandler{
+				password:  "secret",
+				delivered: make(chan delivery, 1),
+			}
+			session := mqtt.NewSession(ctx, serverConn, handler)
+			runCh := make(chan error, 1)
+			go func() {
+				if _, err := session.Connect(); err != nil {
+					runCh <- err
+					return
+				}
+				runCh <- session.Run()
+			}()
+
+			writePacket(t, clientConn, &mqtt.ConnectPacket{
+				ProtocolName:  "MQTT",
+				ProtocolLevel: mqtt.ProtocolLevel5,
+				Username:      "test",
+				Password:      []byte("secret"),
+				Will: &mqtt.Will{
+					Topic:   "foo/will",
+					Payload: []byte("gone"),
+				},
+			})
+			if !a.So(readPacket(t, clientConn).(*mqtt.ConnackPacket).ReasonCode, should.Equal, mqtt.Success) {
+				t.FailNow()
+			}
+			if tc.Disconnect != nil {
+				writePacket(t, clientConn, tc.Disconnect)
+			}
+			clientConn.Close()
+
+			select {
+			case <-runCh:
+			case <-time.After(timeout):
+				t.Fatal("Expected session to end")
+			}
+			select {
+			case d := <-handler.delivered:
+				a.So(tc.Delivered, should.BeTrue)
+				a.So(d.topicParts, should.Resemble, []string{"foo", "will"})
+				a.So(d.payload, should.Resemble, []byte("gone"))
+			default:
+				a.So(tc.Delivered, should.BeFalse)
+			}
+		})
+	}
+}
+
+func TestReasonCodes(t *testing.T) {
+	a := assertions.New(t)
+	a.So(mqtt.ConnectReasonCode(errTestUnauthenticated), should.Equal, mqtt.BadUsernameOrPassword)
+	a.So(mqtt.ConnectReasonCode(errTestNotAuthorized), should.Equal, mqtt.NotAuthorized)
+	a.So(mqtt.DisconnectReasonCode(nil), should.Equal, mqtt.NormalDisconnection)
+	a.So(mqtt.DisconnectReasonCode(errTestTakenOver), should.Equal, mqtt.SessionTakenOver)
+	a.So(mqtt.DisconnectReasonCode(context.Canceled), should.Equal, mqtt.ServerShuttingDown)
+}
@@ -0,0 +1,138 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"go.etcd.io/bbolt"
+	ttnbolt "go.thethings.network/lorawan-stack/pkg/bolt"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// ApplicationUplinkQueue is an implementation of networkserver.ApplicationUplinkQueue.
+type ApplicationUplinkQueue struct {
+	Bolt *ttnbolt.Client
+
+	subscriptions sync.Map
+}
+
+// NewApplicationUplinkQueue returns new application uplink queue.
+func NewApplicationUplinkQueue(cl *ttnbolt.Client) *ApplicationUplinkQueue {
+	return &ApplicationUplinkQueue{
+		Bolt: cl,
+	}
+}
+
+func (q *ApplicationUplinkQueue) uidUplinkPrefix(uid string) []byte {
+	return q.Bolt.Key("uid", uid, "uplinks", "")
+}
+
+func (q *ApplicationUplinkQueue) uidUplinkKey(uid string, seq uint64) []byte {
+	prefix := q.uidUplinkPrefix(uid)
+	k := make([]byte, len(prefix)+8)
+	copy(k, prefix)
+	binary.BigEndian.PutUint64(k[len(prefix):], seq)
+	return k
+}
+
+// Add adds application uplinks ups to queue.
+func (q *ApplicationUplinkQueue) Add(ctx context.Context, ups ...*ttnpb.ApplicationUp) error {
+	uids := make([]string, 0, len(ups))
+	if err := q.Bolt.Update(func(b *bbolt.Bucket) error {
+		for _, up := range ups {
+			uid := unique.ID(ctx, up.ApplicationIdentifiers)
+			seq, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+			if err := ttnbolt.SetProto(b, q.uidUplinkKey(uid, seq), up); err != nil {
+				return err
+			}
+			uids = append(uids, uid)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, uid := range uids {
+		upCh, ok := q.subscriptions.Load(uid)
+		if ok {
+			select {
+			case upCh.(chan struct{}) <- struct{}{}:
+			default:
+			}
+		}
+	}
+	return nil
+}
+
+// next returns the key and value of the oldest application uplink in the queue of the application identified by uid.
+func (q *ApplicationUplinkQueue) next(uid string) ([]byte, *ttnpb.ApplicationUp, error) {
+	var k []byte
+	var up *ttnpb.ApplicationUp
+	if err := q.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.ForEachPrefix(b, q.uidUplinkPrefix(uid), func(bk, v []byte) (bool, error) {
+			k = append(k[:0:0], bk...)
+			up = &ttnpb.ApplicationUp{}
+			return false, ttnbolt.UnmarshalProto(v, up)
+		})
+	}); err != nil {
+		return nil, nil, err
+	}
+	return k, up, nil
+}
+
+// Subscribe calls f sequentially for each application uplink in the queue of the application identified by appID.
+// Subscribe assumes that there's at most 1 active consumer per application at all times.
+func (q *ApplicationUplinkQueue) Subscribe(ctx context.Context, appID ttnpb.ApplicationIdentifiers, f func(context.Context, *ttnpb.ApplicationUp) error) error {
+	uid := unique.ID(ctx, appID)
+
+	upCh := make(chan struct{}, 1)
+	_, ok := q.subscriptions.LoadOrStore(uid, upCh)
+	if ok {
+		panic(fmt.Sprintf("duplicate subscription for application %s", uid))
+	}
+	defer q.subscriptions.Delete(uid)
+
+	for {
+		k, up, err := q.next(uid)
+		if err != nil {
+			return err
+		}
+		if up != nil {
+			if err := f(ctx, up); err != nil {
+				return err
+			}
+			if err := q.Bolt.Update(func(b *bbolt.Bucket) error {
+				return b.Delete(k)
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-upCh:
+		}
+	}
+}
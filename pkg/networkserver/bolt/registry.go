@@ -0,0 +1,344 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"go.etcd.io/bbolt"
+	ttnbolt "go.thethings.network/lorawan-stack/pkg/bolt"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	errInvalidFieldmask     = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers   = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errDuplicateIdentifiers = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
+	errReadOnlyField        = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+)
+
+// DeviceRegistry is an implementation of networkserver.DeviceRegistry.
+type DeviceRegistry struct {
+	Bolt *ttnbolt.Client
+}
+
+func (r *DeviceRegistry) uidKey(uid string) []byte {
+	return r.Bolt.Key("uid", uid)
+}
+
+func (r *DeviceRegistry) addrPrefix(addr types.DevAddr) []byte {
+	return r.Bolt.Key("addr", addr.String(), "")
+}
+
+func (r *DeviceRegistry) addrKey(addr types.DevAddr, uid string) []byte {
+	return r.Bolt.Key("addr", addr.String(), uid)
+}
+
+func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) []byte {
+	return r.Bolt.Key("eui", joinEUI.String(), devEUI.String())
+}
+
+// GetByID gets device by appID, devID.
+func (r *DeviceRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appID,
+		DeviceID:               devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, ctx, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device by id").End()
+
+	pb := &ttnpb.EndDevice{}
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.GetProto(b, r.uidKey(unique.ID(ctx, ids)), pb)
+	}); err != nil {
+		return nil, ctx, err
+	}
+	pb, err := ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+// GetByEUI gets device by joinEUI, devEUI.
+func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+	defer trace.StartRegion(ctx, "get end device by eui").End()
+
+	pb := &ttnpb.EndDevice{}
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.FindProto(b, r.euiKey(joinEUI, devEUI), r.uidKey, pb)
+	}); err != nil {
+		return nil, ctx, err
+	}
+	pb, err := ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+// RangeByAddr ranges over devices by addr.
+func (r *DeviceRegistry) RangeByAddr(ctx context.Context, addr types.DevAddr, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	defer trace.StartRegion(ctx, "range end devices by dev_addr").End()
+
+	var pbs []*ttnpb.EndDevice
+	prefix := r.addrPrefix(addr)
+	if err := r.Bolt.View(func(b *bbolt.Bucket) error {
+		return ttnbolt.ForEachPrefix(b, prefix, func(k, _ []byte) (bool, error) {
+			pb := &ttnpb.EndDevice{}
+			if err := ttnbolt.GetProto(b, r.uidKey(string(k[len(prefix):])), pb); err != nil {
+				return false, err
+			}
+			pbs = append(pbs, pb)
+			return true, nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, pb := range pbs {
+		pb, err := ttnpb.FilterGetEndDevice(pb, paths...)
+		if err != nil {
+			return err
+		}
+		if !f(ctx, pb) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func getDevAddrs(pb *ttnpb.EndDevice) (addrs struct{ current, pending *types.DevAddr }) {
+	if pb == nil {
+		return
+	}
+
+	if pb.Session != nil {
+		var addr types.DevAddr
+		copy(addr[:], pb.Session.DevAddr[:])
+		addrs.current = &addr
+	}
+	if pb.PendingSession != nil {
+		var addr types.DevAddr
+		copy(addr[:], pb.PendingSession.DevAddr[:])
+		addrs.pending = &addr
+	}
+	return addrs
+}
+
+func equalAddr(x, y *types.DevAddr) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Equal(*y)
+}
+
+func equalEUI64(x, y *types.EUI64) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.Equal(*y)
+}
+
+// SetByID sets device by appID, devID.
+func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(ctx context.Context, pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: appID,
+		DeviceID:               devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, ctx, err
+	}
+	uid := unique.ID(ctx, ids)
+	uk := r.uidKey(uid)
+
+	defer trace.StartRegion(ctx, "set end device by id").End()
+
+	var pb *ttnpb.EndDevice
+	err := r.Bolt.Watch(func(tx *ttnbolt.WatchTx) (func(*bbolt.Bucket) error, error) {
+		v, err := tx.Get(uk)
+		if err != nil {
+			return nil, err
+		}
+		var stored *ttnpb.EndDevice
+		if v != nil {
+			stored = &ttnpb.EndDevice{}
+			if err := ttnbolt.UnmarshalProto(v, stored); err != nil {
+				return nil, err
+			}
+			pb = &ttnpb.EndDevice{}
+			if err := ttnbolt.UnmarshalProto(v, pb); err != nil {
+				return nil, err
+			}
+			pb, err = ttnpb.FilterGetEndDevice(pb, gets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(ctx, pb)
+		if err != nil {
+			return nil, err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+
+		if stored == nil && pb == nil {
+			return nil, nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			return nil, err
+		}
+
+		if pb == nil && len(sets) == 0 {
+			return func(b *bbolt.Bucket) error {
+				if err := b.Delete(uk); err != nil {
+					return err
+				}
+				if stored.JoinEUI != nil && stored.DevEUI != nil {
+					if err := b.Delete(r.euiKey(*stored.JoinEUI, *stored.DevEUI)); err != nil {
+						return err
+					}
+				}
+				if stored.PendingSession != nil {
+					if err := b.Delete(r.addrKey(stored.PendingSession.DevAddr, uid)); err != nil {
+						return err
+					}
+				}
+				if stored.Session != nil {
+					if err := b.Delete(r.addrKey(stored.Session.DevAddr, uid)); err != nil {
+						return err
+					}
+				}
+				return nil
+			}, nil
+		}
+
+		if pb == nil {
+			pb = &ttnpb.EndDevice{}
+		}
+
+		pb.UpdatedAt = time.Now().UTC()
+		sets = append(append(sets[:0:0], sets...),
+			"updated_at",
+		)
+
+		updated := &ttnpb.EndDevice{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.device_id",
+			); err != nil {
+				return nil, errInvalidFieldmask.WithCause(err)
+			}
+
+			pb.CreatedAt = pb.UpdatedAt
+			sets = append(sets, "created_at")
+
+			updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+			if updated.ApplicationIdentifiers != appID || updated.DeviceID != devID {
+				return nil, errInvalidIdentifiers
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.device_id") && pb.DeviceID != stored.DeviceID {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.device_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.join_eui") && !equalEUI64(pb.JoinEUI, stored.JoinEUI) {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.join_eui")
+			}
+			if ttnpb.HasAnyField(sets, "ids.dev_eui") && !equalEUI64(pb.DevEUI, stored.DevEUI) {
+				return nil, errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+			}
+			if err := ttnbolt.UnmarshalProto(v, updated); err != nil {
+				return nil, err
+			}
+			updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := updated.ValidateFields(sets...); err != nil {
+			return nil, err
+		}
+		pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(b *bbolt.Bucket) error {
+			if stored == nil && updated.JoinEUI != nil && updated.DevEUI != nil {
+				ek := r.euiKey(*updated.JoinEUI, *updated.DevEUI)
+				if b.Get(ek) != nil {
+					return errDuplicateIdentifiers
+				}
+				if err := b.Put(ek, []byte(uid)); err != nil {
+					return err
+				}
+			}
+
+			if err := ttnbolt.SetProto(b, uk, updated); err != nil {
+				return err
+			}
+
+			storedAddrs := getDevAddrs(stored)
+			updatedAddrs := getDevAddrs(updated)
+
+			if storedAddrs.pending != nil && !equalAddr(storedAddrs.pending, updatedAddrs.pending) && !equalAddr(storedAddrs.pending, updatedAddrs.current) {
+				if err := b.Delete(r.addrKey(*storedAddrs.pending, uid)); err != nil {
+					return err
+				}
+			}
+			if storedAddrs.current != nil && !equalAddr(storedAddrs.current, updatedAddrs.pending) && !equalAddr(storedAddrs.current, updatedAddrs.current) {
+				if err := b.Delete(r.addrKey(*storedAddrs.current, uid)); err != nil {
+					return err
+				}
+			}
+			if updatedAddrs.pending != nil {
+				if err := b.Put(r.addrKey(*updatedAddrs.pending, uid), []byte{}); err != nil {
+					return err
+				}
+			}
+			if updatedAddrs.current != nil {
+				if err := b.Put(r.addrKey(*updatedAddrs.current, uid), []byte{}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, nil
+	})
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
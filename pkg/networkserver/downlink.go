@@ -578,6 +578,8 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 type downlinkPath struct {
 	ttnpb.GatewayIdentifiers
 	*ttnpb.DownlinkPath
+	snr         float32
+	preferOther bool
 }
 
 func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
@@ -600,12 +602,14 @@ func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 					UplinkToken: md.UplinkToken,
 				},
 			},
+			snr: md.SNR,
 		}
 		switch md.DownlinkPathConstraint {
 		case ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE:
 			head = append(head, path)
 
 		case ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER:
+			path.preferOther = true
 			tail = append(tail, path)
 		}
 	}
@@ -696,67 +700,266 @@ func nonRetryableFixedPathGatewayError(err error) bool {
 	return errors.IsNotFound(err) || errors.IsDataLoss(err) || errors.IsFailedPrecondition(err)
 }
 
+const (
+	// dutyCycleUtilizationPenalty is the penalty in dB for a gateway that fully utilizes the duty-cycle of a sub-band.
+	dutyCycleUtilizationPenalty = 10
+	// queuedEmissionPenalty is the penalty in dB for each emission that is queued on a gateway.
+	queuedEmissionPenalty = 1
+)
+
+// downlinkPathScore returns the score of a downlink path that balances the link margin against the gateway load.
+func downlinkPathScore(snr, dutyCycleUtilization float32, queuedEmissions uint32) float32 {
+	return snr - dutyCycleUtilization*dutyCycleUtilizationPenalty - float32(queuedEmissions)*queuedEmissionPenalty
+}
+
+// selectDownlinkPaths sorts paths by score considering the given gateway loads, and returns the selection.
+// Paths that prefer other paths are kept after the other paths.
+func selectDownlinkPaths(ctx context.Context, req *ttnpb.TxRequest, paths []downlinkPath, loads []*ttnpb.GatewayLoad) *ttnpb.DownlinkPathSelection {
+	frequency := req.Rx1Frequency
+	if frequency == 0 {
+		frequency = req.Rx2Frequency
+	}
+	loadsByUID := make(map[string]*ttnpb.GatewayLoad, len(loads))
+	for _, load := range loads {
+		loadsByUID[unique.ID(ctx, load.GatewayIdentifiers)] = load
+	}
+	type candidate struct {
+		path downlinkPath
+		*ttnpb.DownlinkPathSelection_Candidate
+	}
+	candidates := make([]candidate, 0, len(paths))
+	for _, path := range paths {
+		c := &ttnpb.DownlinkPathSelection_Candidate{
+			GatewayIDs: path.GatewayIdentifiers,
+			SNR:        path.snr,
+		}
+		if load, ok := loadsByUID[unique.ID(ctx, path.GatewayIdentifiers)]; ok {
+			for _, sb := range load.SubBands {
+				if frequency >= sb.MinFrequency && frequency <= sb.MaxFrequency {
+					c.DutyCycleUtilization = sb.DutyCycleUtilization
+					break
+				}
+			}
+			c.QueuedEmissions = load.QueuedEmissions
+		}
+		c.Score = downlinkPathScore(c.SNR, c.DutyCycleUtilization, c.QueuedEmissions)
+		candidates = append(candidates, candidate{
+			path:                            path,
+			DownlinkPathSelection_Candidate: c,
+		})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].path.preferOther != candidates[j].path.preferOther {
+			return !candidates[i].path.preferOther
+		}
+		return candidates[i].Score > candidates[j].Score
+	})
+	sel := &ttnpb.DownlinkPathSelection{
+		Candidates: make([]*ttnpb.DownlinkPathSelection_Candidate, 0, len(candidates)),
+	}
+	for i, c := range candidates {
+		paths[i] = c.path
+		sel.Candidates = append(sel.Candidates, c.DownlinkPathSelection_Candidate)
+	}
+	return sel
+}
+
 // scheduleDownlinkByPaths attempts to schedule payload b using parameters in req using paths.
+// The paths are ordered by the load reported by the Gateway Servers that serve them before the paths are grouped per
+// Gateway Server, Packet Broker Agent or roaming network.
+// The path selection is published as event for the end device identified by devIDs, if not nil.
 // scheduleDownlinkByPaths discards req.DownlinkPaths and mutates it arbitrarily.
 // scheduleDownlinkByPaths returns the scheduled downlink or error.
-func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb.TxRequest, b []byte, paths ...downlinkPath) (*scheduledDownlink, error) {
+func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb.TxRequest, devIDs *ttnpb.EndDeviceIdentifiers, b []byte, paths ...downlinkPath) (*scheduledDownlink, error) {
 	if len(paths) == 0 {
 		return nil, errNoPath
 	}
 
 	logger := log.FromContext(ctx)
 
-	type attempt struct {
-		peer  cluster.Peer
-		paths []*ttnpb.DownlinkPath
+	type target struct {
+		peer         cluster.Peer
+		roamingToken *roamingUplinkToken
+		packetBroker bool
 	}
-	attempts := make([]*attempt, 0, len(paths))
-	lastAttempt := func() *attempt {
-		return attempts[len(attempts)-1]
-	}
-
+	targets := make(map[*ttnpb.DownlinkPath]target, len(paths))
+	resolved := make([]downlinkPath, 0, len(paths))
 	for _, path := range paths {
 		logger := logger.WithField(
 			"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
 		)
 
-		p, err := ns.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, path.GatewayIdentifiers)
-		if err != nil {
-			logger.WithError(err).Debug("Could not get Gateway Server")
+		if token := path.GetUplinkToken(); isRoamingUplinkToken(token) {
+			if ns.roamingClient == nil {
+				logger.Debug("Roaming is not configured, skip roaming downlink path")
+				continue
+			}
+			rt := &roamingUplinkToken{}
+			if err := rt.unmarshal(token); err != nil {
+				logger.WithError(err).Debug("Failed to decode roaming uplink token")
+				continue
+			}
+			targets[path.DownlinkPath] = target{
+				roamingToken: rt,
+			}
+			resolved = append(resolved, path)
+			continue
+		}
+
+		packetBroker := ttnpb.IsPacketBrokerUplinkToken(path.GetUplinkToken())
+		var p cluster.Peer
+		var err error
+		if packetBroker {
+			p, err = ns.GetPeer(ctx, ttnpb.ClusterRole_PACKET_BROKER_AGENT, nil)
+			if err != nil {
+				logger.WithError(err).Debug("Could not get Packet Broker Agent")
+				continue
+			}
+		} else {
+			p, err = ns.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, path.GatewayIdentifiers)
+			if err != nil {
+				logger.WithError(err).Debug("Could not get Gateway Server")
+				continue
+			}
+		}
+		targets[path.DownlinkPath] = target{
+			peer:         p,
+			packetBroker: packetBroker,
+		}
+		resolved = append(resolved, path)
+	}
+
+	if len(resolved) > 1 {
+		var gatewayServers []cluster.Peer
+		gatewayIDs := make(map[cluster.Peer][]ttnpb.GatewayIdentifiers)
+		for _, path := range resolved {
+			t := targets[path.DownlinkPath]
+			if t.roamingToken != nil || t.packetBroker {
+				continue
+			}
+			if _, ok := gatewayIDs[t.peer]; !ok {
+				gatewayServers = append(gatewayServers, t.peer)
+			}
+			gatewayIDs[t.peer] = append(gatewayIDs[t.peer], path.GatewayIdentifiers)
+		}
+		var loads []*ttnpb.GatewayLoad
+		var hasLoads bool
+		if len(gatewayServers) > 0 {
+			callOpt := ns.WithClusterAuth()
+			for _, p := range gatewayServers {
+				cc, err := p.Conn()
+				if err != nil {
+					logger.WithError(err).Debug("Failed to get Gateway Server connection, select downlink paths without gateway loads")
+					continue
+				}
+				res, err := ttnpb.NewNsGsClient(cc).GetGatewayLoads(ctx, &ttnpb.GetGatewayLoadsRequest{
+					GatewayIDs: gatewayIDs[p],
+				}, callOpt)
+				if err != nil {
+					logger.WithError(err).Debug("Failed to get gateway loads, select downlink paths without gateway loads")
+					continue
+				}
+				loads = append(loads, res.Loads...)
+				hasLoads = true
+			}
+		}
+		if hasLoads {
+			sel := selectDownlinkPaths(ctx, req, resolved, loads)
+			logger.WithField("path_count", len(resolved)).Debug("Selected downlink paths by gateway load")
+			if devIDs != nil {
+				events.Publish(evtSelectDownlinkPaths(ctx, devIDs, sel))
+			}
+		}
+	}
+
+	type attempt struct {
+		peer         cluster.Peer
+		paths        []downlinkPath
+		roamingPaths []roamingDownlinkPath
+		packetBroker bool
+	}
+	attempts := make([]*attempt, 0, len(resolved))
+	lastAttempt := func() *attempt {
+		return attempts[len(attempts)-1]
+	}
+	for _, path := range resolved {
+		t := targets[path.DownlinkPath]
+		if rt := t.roamingToken; rt != nil {
+			var a *attempt
+			if len(attempts) > 0 && len(lastAttempt().roamingPaths) > 0 && lastAttempt().roamingPaths[0].NetID == rt.NetID {
+				a = lastAttempt()
+			} else {
+				a = &attempt{}
+				attempts = append(attempts, a)
+			}
+			a.roamingPaths = append(a.roamingPaths, roamingDownlinkPath{
+				NetID:   rt.NetID,
+				ULToken: rt.ULToken,
+			})
 			continue
 		}
 
 		var a *attempt
-		if len(attempts) > 0 && lastAttempt().peer == p {
+		if len(attempts) > 0 && len(lastAttempt().roamingPaths) == 0 && lastAttempt().peer == t.peer && lastAttempt().packetBroker == t.packetBroker {
 			a = lastAttempt()
 		} else {
 			a = &attempt{
-				peer: p,
+				peer:         t.peer,
+				packetBroker: t.packetBroker,
 			}
 			attempts = append(attempts, a)
 		}
-		a.paths = append(a.paths, path.DownlinkPath)
+		a.paths = append(a.paths, path)
 	}
 
 	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("ns:downlink:%s", events.NewCorrelationID()))
 	errs := make([]error, 0, len(attempts))
 	for _, a := range attempts {
-		req.DownlinkPaths = a.paths
+		if len(a.roamingPaths) > 0 {
+			req.DownlinkPaths = nil
+			down, err := ns.transmitRoamingDownlink(ctx, req, b, a.roamingPaths...)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			return down, nil
+		}
+
+		cc, err := a.peer.Conn()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		callOpt := ns.WithClusterAuth()
+		req.DownlinkPaths = make([]*ttnpb.DownlinkPath, 0, len(a.paths))
+		for _, path := range a.paths {
+			req.DownlinkPaths = append(req.DownlinkPaths, path.DownlinkPath)
+		}
 		down := &ttnpb.DownlinkMessage{
 			RawPayload:     b,
+			EndDeviceIDs:   devIDs,
 			CorrelationIDs: events.CorrelationIDsFromContext(ctx),
 			Settings: &ttnpb.DownlinkMessage_Request{
 				Request: req,
 			},
 		}
+		if a.packetBroker {
+			logger.WithField("path_count", len(req.DownlinkPaths)).Debug("Publish downlink to Packet Broker")
+			if _, err := ttnpb.NewNsPbaClient(cc).PublishDownlink(ctx, down, callOpt); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			// The Forwarder does not report the transmission delay, so the downlink is assumed to be transmitted immediately.
+			transmitAt := timeNow()
+			logger.WithField("transmit_at", transmitAt).Debug("Published downlink to Packet Broker")
+			return &scheduledDownlink{
+				Message:    down,
+				TransmitAt: transmitAt,
+			}, nil
+		}
 
 		logger.WithField("path_count", len(req.DownlinkPaths)).Debug("Schedule downlink")
-		cc, err := a.peer.Conn()
-		if err != nil {
-			errs = append(errs, err)
-			continue
-		}
-		res, err := ttnpb.NewNsGsClient(cc).ScheduleDownlink(ctx, down, ns.WithClusterAuth())
+		res, err := ttnpb.NewNsGsClient(cc).ScheduleDownlink(ctx, down, callOpt)
 		if err != nil {
 			errs = append(errs, err)
 			continue
@@ -1105,6 +1308,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 	down, err := ns.scheduleDownlinkByPaths(
 		log.NewContext(ctx, loggerWithTxRequestFields(logger, req, attemptRx1, attemptRx2).WithField("rx1_delay", req.Rx1Delay)),
 		req,
+		&dev.EndDeviceIdentifiers,
 		genDown.Payload,
 		paths...,
 	)
@@ -1244,6 +1448,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					down, err := ns.scheduleDownlinkByPaths(
 						log.NewContext(ctx, loggerWithTxRequestFields(logger, req, attemptRx1, attemptRx2).WithField("rx1_delay", req.Rx1Delay)),
 						req,
+						&dev.EndDeviceIdentifiers,
 						dev.PendingMACState.QueuedJoinAccept.Payload,
 						paths...,
 					)
@@ -1431,6 +1636,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 				down, err := ns.scheduleDownlinkByPaths(
 					log.NewContext(ctx, loggerWithTxRequestFields(logger, req, false, true)),
 					req,
+					&dev.EndDeviceIdentifiers,
 					genDown.Payload,
 					paths...,
 				)
@@ -206,7 +206,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 		)
 	}
 
-	assertScheduleRxMetadataGateways := func(ctx context.Context, authCh <-chan test.ClusterAuthRequest, scheduleDownlink124Ch, scheduleDownlink3Ch <-chan NsGsScheduleDownlinkRequest, payload []byte, makeTxRequest func(paths ...*ttnpb.DownlinkPath) *ttnpb.TxRequest, resps ...NsGsScheduleDownlinkResponse) (*ttnpb.DownlinkMessage, bool) {
+	assertScheduleRxMetadataGateways := func(ctx context.Context, devIDs *ttnpb.EndDeviceIdentifiers, authCh <-chan test.ClusterAuthRequest, scheduleDownlink124Ch, scheduleDownlink3Ch <-chan NsGsScheduleDownlinkRequest, payload []byte, makeTxRequest func(paths ...*ttnpb.DownlinkPath) *ttnpb.TxRequest, resps ...NsGsScheduleDownlinkResponse) (*ttnpb.DownlinkMessage, bool) {
 		if len(resps) < 1 || len(resps) > 3 {
 			panic("invalid response count specified")
 		}
@@ -216,6 +216,11 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 		a := assertions.New(t)
 
+		if !a.So(test.AssertClusterAuthRequest(ctx, authCh, grpc.EmptyCallOption{}), should.BeTrue) {
+			t.Error("Cluster auth assertion failed for gateway loads")
+			return nil, false
+		}
+
 		var lastDown *ttnpb.DownlinkMessage
 		var correlationIDs []string
 		if !a.So(AssertAuthNsGsScheduleDownlinkRequest(ctx, authCh, scheduleDownlink124Ch,
@@ -224,6 +229,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 				lastDown = &ttnpb.DownlinkMessage{
 					CorrelationIDs: correlationIDs,
 					RawPayload:     payload,
+					EndDeviceIDs:   devIDs,
 					Settings: &ttnpb.DownlinkMessage_Request{
 						Request: makeTxRequest(
 							&ttnpb.DownlinkPath{
@@ -255,6 +261,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 		lastDown = &ttnpb.DownlinkMessage{
 			CorrelationIDs: correlationIDs,
 			RawPayload:     payload,
+			EndDeviceIDs:   devIDs,
 			Settings: &ttnpb.DownlinkMessage_Request{
 				Request: makeTxRequest(
 					&ttnpb.DownlinkPath{
@@ -282,6 +289,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 		lastDown = &ttnpb.DownlinkMessage{
 			CorrelationIDs: correlationIDs,
 			RawPayload:     payload,
+			EndDeviceIDs:   devIDs,
 			Settings: &ttnpb.DownlinkMessage_Request{
 				Request: makeTxRequest(
 					&ttnpb.DownlinkPath{
@@ -1740,6 +1748,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -2011,6 +2020,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -2305,6 +2315,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -2592,6 +2603,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -2914,6 +2926,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -3214,6 +3227,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -3545,6 +3559,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				_, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -3627,6 +3642,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -3915,6 +3931,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -4190,6 +4207,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -4286,6 +4304,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok = assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -4602,6 +4621,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -4698,6 +4718,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok = assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -5369,6 +5390,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 
 				lastDown, ok := assertScheduleRxMetadataGateways(
 					ctx,
+					&getDevice.EndDeviceIdentifiers,
 					env.Cluster.Auth,
 					scheduleDownlink124Ch,
 					scheduleDownlink3Ch,
@@ -6546,3 +6568,258 @@ func TestGenerateDownlink(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectDownlinkPaths(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	gtwIDs := func(id string) ttnpb.GatewayIdentifiers {
+		return ttnpb.GatewayIdentifiers{GatewayID: id}
+	}
+	pathTo := func(id string, snr float32, preferOther bool) downlinkPath {
+		return downlinkPath{
+			GatewayIdentifiers: gtwIDs(id),
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: []byte(id),
+				},
+			},
+			snr:         snr,
+			preferOther: preferOther,
+		}
+	}
+	subBands := func(utilization float32) []*ttnpb.GatewayLoad_SubBand {
+		return []*ttnpb.GatewayLoad_SubBand{
+			{
+				MinFrequency:         863000000,
+				MaxFrequency:         865000000,
+				DutyCycleUtilization: 0,
+			},
+			{
+				MinFrequency:         868000000,
+				MaxFrequency:         868600000,
+				DutyCycleUtilization: utilization,
+			},
+		}
+	}
+
+	paths := []downlinkPath{
+		pathTo("gtw-busy", 8, false),
+		pathTo("gtw-queued", 6, false),
+		pathTo("gtw-idle", 5.5, false),
+		pathTo("gtw-unknown", 2, false),
+		pathTo("gtw-other", 10, true),
+	}
+	sel := selectDownlinkPaths(ctx, &ttnpb.TxRequest{
+		Rx1Frequency: 868100000,
+		Rx2Frequency: 869525000,
+	}, paths, []*ttnpb.GatewayLoad{
+		{
+			GatewayIdentifiers: gtwIDs("gtw-busy"),
+			SubBands:           subBands(0.75),
+		},
+		{
+			GatewayIdentifiers: gtwIDs("gtw-queued"),
+			SubBands:           subBands(0),
+			QueuedEmissions:    3,
+		},
+		{
+			GatewayIdentifiers: gtwIDs("gtw-idle"),
+			SubBands:           subBands(0.25),
+		},
+		{
+			GatewayIdentifiers: gtwIDs("gtw-other"),
+			SubBands:           subBands(0),
+		},
+	})
+
+	var ids []string
+	for _, path := range paths {
+		ids = append(ids, path.GatewayID)
+	}
+	a.So(ids, should.Resemble, []string{"gtw-queued", "gtw-idle", "gtw-unknown", "gtw-busy", "gtw-other"})
+	a.So(sel, should.Resemble, &ttnpb.DownlinkPathSelection{
+		Candidates: []*ttnpb.DownlinkPathSelection_Candidate{
+			{
+				GatewayIDs:      gtwIDs("gtw-queued"),
+				SNR:             6,
+				QueuedEmissions: 3,
+				Score:           3,
+			},
+			{
+				GatewayIDs:           gtwIDs("gtw-idle"),
+				SNR:                  5.5,
+				DutyCycleUtilization: 0.25,
+				Score:                3,
+			},
+			{
+				GatewayIDs: gtwIDs("gtw-unknown"),
+				SNR:        2,
+				Score:      2,
+			},
+			{
+				GatewayIDs:           gtwIDs("gtw-busy"),
+				SNR:                  8,
+				DutyCycleUtilization: 0.75,
+				Score:                0.5,
+			},
+			{
+				GatewayIDs: gtwIDs("gtw-other"),
+				SNR:        10,
+				Score:      10,
+			},
+		},
+	})
+}
+
+func TestScheduleDownlinkByPaths(t *testing.T) {
+	a := assertions.New(t)
+
+	ns, ctx, env, stop := StartTest(t, Config{}, (1<<10)*test.Delay, true)
+	defer stop()
+
+	<-env.DownlinkTasks.Pop
+
+	gtwIDs := func(id string) ttnpb.GatewayIdentifiers {
+		return ttnpb.GatewayIdentifiers{GatewayID: id}
+	}
+	pathTo := func(id string, snr float32) downlinkPath {
+		return downlinkPath{
+			GatewayIdentifiers: gtwIDs(id),
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: []byte(id),
+				},
+			},
+			snr: snr,
+		}
+	}
+	load := func(id string, utilization float32) *ttnpb.GatewayLoad {
+		return &ttnpb.GatewayLoad{
+			GatewayIdentifiers: gtwIDs(id),
+			SubBands: []*ttnpb.GatewayLoad_SubBand{
+				{
+					MinFrequency:         868000000,
+					MaxFrequency:         868600000,
+					DutyCycleUtilization: utilization,
+				},
+			},
+		}
+	}
+	req := &ttnpb.TxRequest{
+		Class:            ttnpb.CLASS_A,
+		Rx1Delay:         ttnpb.RX_DELAY_1,
+		Rx1DataRateIndex: ttnpb.DATA_RATE_0,
+		Rx1Frequency:     868100000,
+		Priority:         ttnpb.TxSchedulePriority_NORMAL,
+		FrequencyPlanID:  test.EUFrequencyPlanID,
+	}
+	payload := []byte{0x60, 0xff, 0xff, 0xff, 0x42}
+
+	// The busy gateway on the first Gateway Server has the best signal quality, but the idle gateway on the second
+	// Gateway Server has the best score, so the second Gateway Server is attempted first.
+	type scheduleResult struct {
+		down *scheduledDownlink
+		err  error
+	}
+	resultCh := make(chan scheduleResult, 1)
+	go func() {
+		down, err := ns.scheduleDownlinkByPaths(ctx, deepcopy.Copy(req).(*ttnpb.TxRequest), nil, payload,
+			pathTo("gtw-a-busy", 10),
+			pathTo("gtw-b-idle", 8),
+			pathTo("gtw-a-idle", 5),
+		)
+		resultCh <- scheduleResult{
+			down: down,
+			err:  err,
+		}
+	}()
+
+	scheduleDownlinkACh := make(chan NsGsScheduleDownlinkRequest)
+	gsA := NewGSPeer(ctx, &MockNsGsServer{
+		ScheduleDownlinkFunc: MakeNsGsScheduleDownlinkChFunc(scheduleDownlinkACh),
+		GetGatewayLoadsFunc: func(ctx context.Context, req *ttnpb.GetGatewayLoadsRequest) (*ttnpb.GatewayLoads, error) {
+			a.So(req.GatewayIDs, should.Resemble, []ttnpb.GatewayIdentifiers{gtwIDs("gtw-a-busy"), gtwIDs("gtw-a-idle")})
+			return &ttnpb.GatewayLoads{
+				Loads: []*ttnpb.GatewayLoad{
+					load("gtw-a-busy", 1),
+					load("gtw-a-idle", 0),
+				},
+			}, nil
+		},
+	})
+	scheduleDownlinkBCh := make(chan NsGsScheduleDownlinkRequest)
+	gsB := NewGSPeer(ctx, &MockNsGsServer{
+		ScheduleDownlinkFunc: MakeNsGsScheduleDownlinkChFunc(scheduleDownlinkBCh),
+		GetGatewayLoadsFunc: func(ctx context.Context, req *ttnpb.GetGatewayLoadsRequest) (*ttnpb.GatewayLoads, error) {
+			a.So(req.GatewayIDs, should.Resemble, []ttnpb.GatewayIdentifiers{gtwIDs("gtw-b-idle")})
+			return &ttnpb.GatewayLoads{
+				Loads: []*ttnpb.GatewayLoad{
+					load("gtw-b-idle", 0),
+				},
+			}, nil
+		},
+	})
+	for _, peer := range []struct {
+		id   string
+		peer cluster.Peer
+	}{
+		{"gtw-a-busy", gsA},
+		{"gtw-b-idle", gsB},
+		{"gtw-a-idle", gsA},
+	} {
+		if !a.So(test.AssertClusterGetPeerRequest(ctx, env.Cluster.GetPeer,
+			func(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) bool {
+				return a.So(role, should.Equal, ttnpb.ClusterRole_GATEWAY_SERVER) &&
+					a.So(ids, should.Resemble, gtwIDs(peer.id))
+			},
+			test.ClusterGetPeerResponse{Peer: peer.peer},
+		), should.BeTrue) {
+			t.FailNow()
+		}
+	}
+	if !a.So(test.AssertClusterAuthRequest(ctx, env.Cluster.Auth, grpc.EmptyCallOption{}), should.BeTrue) {
+		t.FailNow()
+	}
+
+	expectedRequest := func(ids ...string) *ttnpb.TxRequest {
+		req := deepcopy.Copy(req).(*ttnpb.TxRequest)
+		for _, id := range ids {
+			req.DownlinkPaths = append(req.DownlinkPaths, pathTo(id, 0).DownlinkPath)
+		}
+		return req
+	}
+	if !a.So(AssertAuthNsGsScheduleDownlinkRequest(ctx, env.Cluster.Auth, scheduleDownlinkBCh,
+		func(ctx context.Context, msg *ttnpb.DownlinkMessage) bool {
+			return a.So(msg.GetRequest(), should.Resemble, expectedRequest("gtw-b-idle"))
+		},
+		grpc.EmptyCallOption{},
+		NsGsScheduleDownlinkResponse{
+			Error: errors.New("test"),
+		},
+	), should.BeTrue) {
+		t.FailNow()
+	}
+	if !a.So(AssertAuthNsGsScheduleDownlinkRequest(ctx, env.Cluster.Auth, scheduleDownlinkACh,
+		func(ctx context.Context, msg *ttnpb.DownlinkMessage) bool {
+			return a.So(msg.GetRequest(), should.Resemble, expectedRequest("gtw-a-idle", "gtw-a-busy"))
+		},
+		grpc.EmptyCallOption{},
+		NsGsScheduleDownlinkResponse{
+			Response: &ttnpb.ScheduleDownlinkResponse{
+				Delay: time.Second,
+			},
+		},
+	), should.BeTrue) {
+		t.FailNow()
+	}
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("Timed out while waiting for scheduleDownlinkByPaths to return")
+	case res := <-resultCh:
+		a.So(res.err, should.BeNil)
+		a.So(res.down, should.NotBeNil)
+	}
+	a.So(AssertNetworkServerClose(ctx, ns), should.BeTrue)
+}
@@ -218,6 +218,11 @@ func TestDownlinkTaskQueues(t *testing.T) {
 			New:  NewRedisDownlinkTaskQueue,
 			N:    8,
 		},
+		{
+			Name: "Bolt",
+			New:  NewBoltDownlinkTaskQueue,
+			N:    8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
@@ -1969,6 +1969,11 @@ func TestApplicationUplinkQueues(t *testing.T) {
 			New:  NewRedisApplicationUplinkQueue,
 			N:    8,
 		},
+		{
+			Name: "Bolt",
+			New:  NewBoltApplicationUplinkQueue,
+			N:    8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
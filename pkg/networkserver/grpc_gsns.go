@@ -21,6 +21,7 @@ import (
 	"hash"
 	"math"
 	"sort"
+	"strings"
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
@@ -54,6 +55,16 @@ const (
 	maxConfNbTrans = 5
 )
 
+// UplinkDeduplicator represents an entity, that deduplicates uplinks and accumulates their metadata.
+// UplinkDeduplicator allows several Network Server instances to share the deduplication state.
+type UplinkDeduplicator interface {
+	// DeduplicateUplink stores the metadata of up and marks up as seen for window.
+	// DeduplicateUplink returns true if up was seen for the first time within window and false if up is a duplicate.
+	DeduplicateUplink(ctx context.Context, up *ttnpb.UplinkMessage, window time.Duration) (bool, error)
+	// AccumulatedMetadata returns the metadata accumulated for up.
+	AccumulatedMetadata(ctx context.Context, up *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error)
+}
+
 func (ns *NetworkServer) deduplicateUplink(ctx context.Context, up *ttnpb.UplinkMessage) (*metadataAccumulator, func(), bool) {
 	h := ns.hashPool.Get().(hash.Hash64)
 	_, _ = h.Write(up.RawPayload)
@@ -76,6 +87,20 @@ func (ns *NetworkServer) deduplicateUplink(ctx context.Context, up *ttnpb.Uplink
 	}, false
 }
 
+// accumulatedMetadata returns the metadata accumulated for up.
+// If the metadata cannot be retrieved from the uplink deduplicator, the metadata of up is returned.
+func (ns *NetworkServer) accumulatedMetadata(ctx context.Context, up *ttnpb.UplinkMessage, acc *metadataAccumulator) []*ttnpb.RxMetadata {
+	if ns.uplinkDeduplicator == nil {
+		return acc.Accumulated()
+	}
+	mds, err := ns.uplinkDeduplicator.AccumulatedMetadata(ctx, up)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to retrieve accumulated metadata, use metadata of uplink")
+		return up.RxMetadata
+	}
+	return mds
+}
+
 func resetsFCnt(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) bool {
 	if dev.MACSettings != nil && dev.MACSettings.ResetsFCnt != nil {
 		return dev.MACSettings.ResetsFCnt.Value
@@ -859,6 +884,12 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		return err
 	}
 
+	if len(addrMatches) == 0 && ns.roamingClient != nil && !receivedThroughRoaming(up) {
+		if netID, ok := ns.roamingClient.NetworkServerNetID(pld.DevAddr); ok && !netID.Equal(ns.netID) {
+			return ns.forwardDataUplink(ctx, netID, up, acc)
+		}
+	}
+
 	matched, err := ns.matchAndHandleDataUplink(up, false, addrMatches...)
 	if err != nil {
 		registerDropDataUplink(ctx, up, err)
@@ -879,7 +910,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	case <-ns.deduplicationDone(ctx, up):
 	}
 
-	up.RxMetadata = acc.Accumulated()
+	up.RxMetadata = ns.accumulatedMetadata(ctx, up, acc)
 	logger = logger.WithField("metadata_count", len(up.RxMetadata))
 	logger.Debug("Merged metadata")
 	ctx = log.NewContext(ctx, logger)
@@ -1130,7 +1161,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	case <-ns.deduplicationDone(ctx, up):
 	}
 
-	up.RxMetadata = acc.Accumulated()
+	up.RxMetadata = ns.accumulatedMetadata(ctx, up, acc)
 	events.Publish(evtMergeMetadata(ctx, dev.EndDeviceIdentifiers, len(up.RxMetadata)))
 	registerMergeMetadata(ctx, up)
 
@@ -1242,6 +1273,11 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 		)
 	}
 
+	return ttnpb.Empty, ns.handleUplink(ctx, up)
+}
+
+// handleUplink deduplicates and handles the decoded uplink up.
+func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessage) error {
 	logger := log.FromContext(ctx).WithFields(log.Fields(
 		"m_type", up.Payload.MType,
 		"major", up.Payload.Major,
@@ -1260,15 +1296,32 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 		handle = ns.handleRejoinRequest
 	default:
 		logger.Debug("Unmatched MType")
-		return ttnpb.Empty, nil
+		return nil
 	}
 
 	logger.Debug("Deduplicate uplink")
+	if ns.uplinkDeduplicator != nil {
+		first, err := ns.uplinkDeduplicator.DeduplicateUplink(ctx, up, ns.collectionWindow)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to deduplicate uplink")
+			return err
+		}
+		if !first {
+			logger.Debug("Dropped duplicate uplink")
+			registerReceiveUplinkDuplicate(ctx, up)
+			return nil
+		}
+		registerReceiveUplink(ctx, up)
+
+		logger.Debug("Handle uplink")
+		return handle(ctx, up, nil)
+	}
+
 	acc, stopDedup, ok := ns.deduplicateUplink(ctx, up)
 	if ok {
 		logger.Debug("Dropped duplicate uplink")
 		registerReceiveUplinkDuplicate(ctx, up)
-		return ttnpb.Empty, nil
+		return nil
 	}
 	registerReceiveUplink(ctx, up)
 
@@ -1279,5 +1332,74 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	}()
 
 	logger.Debug("Handle uplink")
-	return ttnpb.Empty, handle(ctx, up, acc)
+	return handle(ctx, up, acc)
+}
+
+// maxChannelBusyRetries is the maximum number of times that a class B or C downlink message is retried after the
+// channel was busy.
+const maxChannelBusyRetries = 3
+
+// ReportTxAcknowledgment is called by the Gateway Server when a gateway acknowledges the transmission of a downlink
+// message.
+// If the channel of a class B or C downlink message was busy, the downlink message is scheduled again on the same path.
+func (ns *NetworkServer) ReportTxAcknowledgment(ctx context.Context, req *ttnpb.GatewayTxAcknowledgment) (*pbtypes.Empty, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+
+	down := req.TxAck.GetDownlinkMessage()
+	if req.TxAck.GetResult() != ttnpb.TxAcknowledgment_CHANNEL_BUSY || down.GetEndDeviceIDs() == nil || down.GetRequest() == nil {
+		return ttnpb.Empty, nil
+	}
+	devIDs := *down.EndDeviceIDs
+	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, devIDs))
+	ctx = events.ContextWithCorrelationID(ctx, down.CorrelationIDs...)
+	logger := log.FromContext(ctx)
+
+	var attempts int
+	for _, cid := range down.CorrelationIDs {
+		if strings.HasPrefix(cid, "ns:downlink:") {
+			attempts++
+		}
+	}
+	if attempts > maxChannelBusyRetries {
+		logger.Debug("Channel busy, maximum number of downlink retries reached")
+		return ttnpb.Empty, nil
+	}
+
+	txReq := *down.GetRequest()
+	switch txReq.Class {
+	case ttnpb.CLASS_B:
+		dev, _, err := ns.devices.GetByID(ctx, devIDs.ApplicationIdentifiers, devIDs.DeviceID, []string{
+			"mac_state.ping_slot_periodicity",
+			"session.dev_addr",
+		})
+		if err != nil {
+			return nil, err
+		}
+		transmitAt, ok := nextPingSlotAt(ctx, dev, timeNow().Add(downlinkRetryInterval))
+		if !ok {
+			return ttnpb.Empty, nil
+		}
+		txReq.AbsoluteTime = &transmitAt
+	case ttnpb.CLASS_C:
+		txReq.AbsoluteTime = nil
+	default:
+		// The receive windows of class A downlink messages have passed.
+		return ttnpb.Empty, nil
+	}
+
+	paths := make([]downlinkPath, 0, len(txReq.DownlinkPaths))
+	for _, path := range txReq.DownlinkPaths {
+		paths = append(paths, downlinkPath{
+			GatewayIdentifiers: req.GatewayIdentifiers,
+			DownlinkPath:       path,
+		})
+	}
+	logger.WithField("attempt", attempts+1).Debug("Channel busy, retry downlink")
+	events.Publish(evtRetryDataDownlink(ctx, devIDs, req.TxAck.Result))
+	if _, err := ns.scheduleDownlinkByPaths(ctx, &txReq, &devIDs, down.RawPayload, paths...); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
 }
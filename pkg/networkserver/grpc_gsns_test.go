@@ -19,10 +19,14 @@ import (
 	"context"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/smartystreets/assertions"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	. "go.thethings.network/lorawan-stack/pkg/networkserver"
@@ -3338,3 +3342,235 @@ func TestHandleUplink(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleUplinkRedisDeduplication(t *testing.T) {
+	a := assertions.New(t)
+
+	// Both Network Server instances share the deduplication state in Redis.
+	deduplicator, closeDeduplicator := NewRedisUplinkDeduplicator(t)
+	defer closeDeduplicator()
+
+	errTest := errors.New("test")
+
+	var rangeByAddrCalls uint64
+	newNetworkServer := func() *NetworkServer {
+		ns := test.Must(New(
+			componenttest.NewComponent(t, &component.Config{}),
+			&Config{
+				Devices: &MockDeviceRegistry{
+					RangeByAddrFunc: func(context.Context, types.DevAddr, []string, func(context.Context, *ttnpb.EndDevice) bool) error {
+						atomic.AddUint64(&rangeByAddrCalls, 1)
+						return errTest
+					},
+				},
+				DownlinkTasks: &MockDownlinkTaskQueue{
+					PopFunc: DownlinkTaskPopBlockFunc,
+				},
+				UplinkDeduplicator:  deduplicator,
+				DeduplicationWindow: (1 << 8) * test.Delay,
+				CooldownWindow:      (1 << 8) * test.Delay,
+			})).(*NetworkServer)
+		componenttest.StartComponent(t, ns.Component)
+		return ns
+	}
+	ns1 := newNetworkServer()
+	defer ns1.Close()
+	ns2 := newNetworkServer()
+	defer ns2.Close()
+
+	makeUplink := func(gtwID string) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			RawPayload: []byte{
+				/* MHDR */
+				0b010_000_00,
+				/* MACPayload */
+				/** FHDR **/
+				/*** DevAddr ***/
+				0x04, 0x03, 0x02, 0x01,
+				/*** FCtrl ***/
+				0x00,
+				/*** FCnt ***/
+				0x42, 0x00,
+				/* MIC */
+				0x01, 0x02, 0x03, 0x04,
+			},
+			RxMetadata: []*ttnpb.RxMetadata{
+				{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: gtwID}},
+			},
+		}
+	}
+
+	ctx := clusterauth.NewContext(test.Context(), nil)
+
+	_, err := ns1.HandleUplink(ctx, makeUplink("gateway-1"))
+	a.So(err, should.HaveSameErrorDefinitionAs, errTest)
+	a.So(atomic.LoadUint64(&rangeByAddrCalls), should.Equal, 1)
+
+	_, err = ns2.HandleUplink(ctx, makeUplink("gateway-2"))
+	a.So(err, should.BeNil)
+	a.So(atomic.LoadUint64(&rangeByAddrCalls), should.Equal, 1)
+
+	time.Sleep((1 << 10) * test.Delay)
+
+	_, err = ns2.HandleUplink(ctx, makeUplink("gateway-3"))
+	a.So(err, should.HaveSameErrorDefinitionAs, errTest)
+	a.So(atomic.LoadUint64(&rangeByAddrCalls), should.Equal, 2)
+}
+
+func TestReportTxAcknowledgment(t *testing.T) {
+	devIDs := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "test-app-id",
+		},
+		DeviceID: "test-dev-id",
+		DevAddr:  &types.DevAddr{0x42, 0xff, 0xff, 0xff},
+	}
+	gtwIDs := ttnpb.GatewayIdentifiers{
+		GatewayID: "test-gtw-id",
+	}
+	path := &ttnpb.DownlinkPath{
+		Path: &ttnpb.DownlinkPath_Fixed{
+			Fixed: &ttnpb.GatewayAntennaIdentifiers{
+				GatewayIdentifiers: gtwIDs,
+			},
+		},
+	}
+	makeAck := func(class ttnpb.Class, result ttnpb.TxAcknowledgment_Result, correlationIDs ...string) *ttnpb.GatewayTxAcknowledgment {
+		absoluteTime := time.Unix(42, 0).UTC()
+		return &ttnpb.GatewayTxAcknowledgment{
+			GatewayIdentifiers: gtwIDs,
+			TxAck: &ttnpb.TxAcknowledgment{
+				CorrelationIDs: correlationIDs,
+				Result:         result,
+				DownlinkMessage: &ttnpb.DownlinkMessage{
+					RawPayload:   []byte{0x60, 0xff, 0xff, 0xff, 0x42},
+					EndDeviceIDs: devIDs,
+					Settings: &ttnpb.DownlinkMessage_Request{
+						Request: &ttnpb.TxRequest{
+							Class:            class,
+							DownlinkPaths:    []*ttnpb.DownlinkPath{path},
+							Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+							Rx2Frequency:     869525000,
+							Priority:         ttnpb.TxSchedulePriority_NORMAL,
+							AbsoluteTime:     &absoluteTime,
+							FrequencyPlanID:  test.EUFrequencyPlanID,
+						},
+					},
+					CorrelationIDs: correlationIDs,
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name    string
+		Ack     *ttnpb.GatewayTxAcknowledgment
+		Handler func(context.Context, TestEnvironment) bool
+	}{
+		{
+			Name: "success",
+			Ack:  makeAck(ttnpb.CLASS_C, ttnpb.TxAcknowledgment_SUCCESS, "ns:downlink:1"),
+		},
+		{
+			Name: "no downlink message",
+			Ack: &ttnpb.GatewayTxAcknowledgment{
+				GatewayIdentifiers: gtwIDs,
+				TxAck: &ttnpb.TxAcknowledgment{
+					Result: ttnpb.TxAcknowledgment_CHANNEL_BUSY,
+				},
+			},
+		},
+		{
+			Name: "channel busy/class A",
+			Ack:  makeAck(ttnpb.CLASS_A, ttnpb.TxAcknowledgment_CHANNEL_BUSY, "ns:downlink:1"),
+		},
+		{
+			Name: "channel busy/class C/maximum retries",
+			Ack:  makeAck(ttnpb.CLASS_C, ttnpb.TxAcknowledgment_CHANNEL_BUSY, "ns:downlink:1", "ns:downlink:2", "ns:downlink:3", "ns:downlink:4"),
+		},
+		{
+			Name: "channel busy/class C",
+			Ack:  makeAck(ttnpb.CLASS_C, ttnpb.TxAcknowledgment_CHANNEL_BUSY, "ns:downlink:1"),
+			Handler: func(ctx context.Context, env TestEnvironment) bool {
+				t := test.MustTFromContext(ctx)
+				a := assertions.New(t)
+
+				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
+					return a.So(ev.Name(), should.Equal, "ns.down.data.retry") &&
+						a.So(ev.Identifiers(), should.Resemble, []*ttnpb.EntityIdentifiers{devIDs.EntityIdentifiers()}) &&
+						a.So(ev.Data(), should.Equal, ttnpb.TxAcknowledgment_CHANNEL_BUSY)
+				}), should.BeTrue) {
+					return false
+				}
+
+				scheduleDownlinkCh := make(chan NsGsScheduleDownlinkRequest)
+				if !a.So(test.AssertClusterGetPeerRequest(ctx, env.Cluster.GetPeer,
+					func(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) bool {
+						return a.So(role, should.Equal, ttnpb.ClusterRole_GATEWAY_SERVER) &&
+							a.So(ids, should.Resemble, gtwIDs)
+					},
+					test.ClusterGetPeerResponse{
+						Peer: NewGSPeer(ctx, &MockNsGsServer{
+							ScheduleDownlinkFunc: MakeNsGsScheduleDownlinkChFunc(scheduleDownlinkCh),
+						}),
+					},
+				), should.BeTrue) {
+					return false
+				}
+
+				return a.So(AssertAuthNsGsScheduleDownlinkRequest(ctx, env.Cluster.Auth, scheduleDownlinkCh,
+					func(ctx context.Context, msg *ttnpb.DownlinkMessage) bool {
+						return a.So(msg.CorrelationIDs, should.HaveLength, 2) &&
+							a.So(msg.CorrelationIDs, should.Contain, "ns:downlink:1") &&
+							a.So(msg, should.Resemble, &ttnpb.DownlinkMessage{
+								RawPayload:   []byte{0x60, 0xff, 0xff, 0xff, 0x42},
+								EndDeviceIDs: devIDs,
+								Settings: &ttnpb.DownlinkMessage_Request{
+									Request: &ttnpb.TxRequest{
+										Class:            ttnpb.CLASS_C,
+										DownlinkPaths:    []*ttnpb.DownlinkPath{path},
+										Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+										Rx2Frequency:     869525000,
+										Priority:         ttnpb.TxSchedulePriority_NORMAL,
+										FrequencyPlanID:  test.EUFrequencyPlanID,
+									},
+								},
+								CorrelationIDs: msg.CorrelationIDs,
+							})
+					},
+					grpc.EmptyCallOption{},
+					NsGsScheduleDownlinkResponse{
+						Response: &ttnpb.ScheduleDownlinkResponse{
+							Delay: time.Second,
+						},
+					},
+				), should.BeTrue)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ns, ctx, env, stop := StartTest(t, Config{}, (1<<10)*test.Delay, true)
+			defer stop()
+
+			<-env.DownlinkTasks.Pop
+
+			errCh := make(chan error, 1)
+			go func() {
+				_, err := ns.ReportTxAcknowledgment(clusterauth.NewContext(ctx, nil), tc.Ack)
+				errCh <- err
+			}()
+			if tc.Handler != nil {
+				a.So(tc.Handler(ctx, env), should.BeTrue)
+			}
+			select {
+			case <-ctx.Done():
+				t.Error("Timed out while waiting for ReportTxAcknowledgment to return")
+			case err := <-errCh:
+				a.So(err, should.BeNil)
+			}
+			a.So(AssertNetworkServerClose(ctx, ns), should.BeTrue)
+		})
+	}
+}
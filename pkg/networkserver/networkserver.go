@@ -109,6 +109,13 @@ type InteropClient interface {
 	HandleJoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
 }
 
+// RoamingClient is a client, which Network Server can use for passive roaming with other Network Servers.
+type RoamingClient interface {
+	NetworkServerNetID(types.DevAddr) (types.NetID, bool)
+	PRStartRequest(context.Context, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequest(context.Context, *interop.XmitDataReq) (*interop.XmitDataAns, error)
+}
+
 // NetworkServer implements the Network Server component.
 //
 // The Network Server exposes the GsNs, AsNs, DeviceRegistry and ApplicationDownlinkQueue services.
@@ -125,6 +132,7 @@ type NetworkServer struct {
 	applicationUplinks ApplicationUplinkQueue
 
 	metadataAccumulators *sync.Map // uint64 -> *metadataAccumulator
+	uplinkDeduplicator   UplinkDeduplicator
 
 	metadataAccumulatorPool *sync.Pool
 	hashPool                *sync.Pool
@@ -134,10 +142,13 @@ type NetworkServer struct {
 
 	deduplicationDone windowEndFunc
 	collectionDone    windowEndFunc
+	collectionWindow  time.Duration
 
 	defaultMACSettings ttnpb.MACSettings
 
 	interopClient InteropClient
+	roamingClient RoamingClient
+	roamingBandID string
 
 	deviceKEKLabel string
 }
@@ -179,6 +190,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	ctx := log.NewContextWithField(c.Context(), "namespace", "networkserver")
 
 	var interopCl InteropClient
+	var roamingCl RoamingClient
 	if !conf.Interop.IsZero() {
 		interopConf := conf.Interop
 		interopConf.GetFallbackTLSConfig = func(ctx context.Context) (*tls.Config, error) {
@@ -186,10 +198,14 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		}
 		interopConf.BlobConfig = c.GetBaseConfig(ctx).Blob
 
-		interopCl, err = interop.NewClient(ctx, interopConf)
+		cl, err := interop.NewClient(ctx, interopConf)
 		if err != nil {
 			return nil, err
 		}
+		interopCl = cl
+		if cl.HasNetworkServers() {
+			roamingCl = cl
+		}
 	}
 
 	ns := &NetworkServer{
@@ -201,9 +217,11 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		applicationUplinks:   conf.ApplicationUplinks,
 		deduplicationDone:    makeWindowEndAfterFunc(conf.DeduplicationWindow),
 		collectionDone:       makeWindowEndAfterFunc(conf.DeduplicationWindow + conf.CooldownWindow),
+		collectionWindow:     conf.DeduplicationWindow + conf.CooldownWindow,
 		devices:              wrapDeviceRegistryWithDeprecatedFields(conf.Devices, deprecatedDeviceFields...),
 		downlinkTasks:        conf.DownlinkTasks,
 		metadataAccumulators: &sync.Map{},
+		uplinkDeduplicator:   conf.UplinkDeduplicator,
 		metadataAccumulatorPool: &sync.Pool{
 			New: func() interface{} {
 				return &metadataAccumulator{}
@@ -221,6 +239,8 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			StatusTimePeriodicity: conf.DefaultMACSettings.StatusTimePeriodicity,
 		},
 		interopClient:  interopCl,
+		roamingClient:  roamingCl,
+		roamingBandID:  conf.RoamingBandID,
 		deviceKEKLabel: conf.DeviceKEKLabel,
 	}
 	if conf.DefaultMACSettings.ADRMargin != nil {
@@ -273,6 +293,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	}, component.TaskRestartOnFailure)
 
 	c.RegisterGRPC(ns)
+	c.RegisterInterop(ns)
 	return ns, nil
 }
 
@@ -295,6 +316,12 @@ func (ns *NetworkServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Client
 	ttnpb.RegisterNsHandler(ns.Context(), s, conn)
 }
 
+// RegisterInterop registers the sNS and fNS interop services for passive roaming.
+func (ns *NetworkServer) RegisterInterop(srv *interop.Server) {
+	srv.RegisterSNS(servingInteropServer{NS: ns})
+	srv.RegisterFNS(forwardingInteropServer{NS: ns})
+}
+
 // Roles returns the roles that the Network Server fulfills.
 func (ns *NetworkServer) Roles() []ttnpb.ClusterRole {
 	return []ttnpb.ClusterRole{ttnpb.ClusterRole_NETWORK_SERVER}
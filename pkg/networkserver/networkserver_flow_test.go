@@ -488,12 +488,23 @@ func handleClassAOTAAEU868FlowTest1_0_2(ctx context.Context, conn *grpc.ClientCo
 			return
 		}
 
+		if !a.So(test.AssertClusterAuthRequest(ctx, env.Cluster.Auth, grpc.EmptyCallOption{}), should.BeTrue) {
+			t.Error("Cluster auth assertion failed for gateway loads")
+			return
+		}
+
 		a.So(AssertAuthNsGsScheduleDownlinkRequest(ctx, env.Cluster.Auth, scheduleDownlinkCh,
 			func(ctx context.Context, msg *ttnpb.DownlinkMessage) bool {
 				return a.So(msg.CorrelationIDs, should.Contain, "GsNs-1") &&
 					a.So(msg.CorrelationIDs, should.Contain, "GsNs-2") &&
 					a.So(msg.CorrelationIDs, should.HaveLength, 5) &&
 					a.So(msg, should.Resemble, &ttnpb.DownlinkMessage{
+						EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+							DeviceID:               devID,
+							ApplicationIdentifiers: appID,
+							JoinEUI:                &types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevEUI:                 &types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+						},
 						RawPayload: bytes.Repeat([]byte{0x42}, 33),
 						Settings: &ttnpb.DownlinkMessage_Request{
 							Request: &ttnpb.TxRequest{
@@ -835,12 +846,24 @@ func handleClassAOTAAEU868FlowTest1_0_2(ctx context.Context, conn *grpc.ClientCo
 			test.ClusterGetPeerResponse{Peer: gsPeer},
 		), should.BeTrue)
 
+		if !a.So(test.AssertClusterAuthRequest(ctx, env.Cluster.Auth, grpc.EmptyCallOption{}), should.BeTrue) {
+			t.Error("Cluster auth assertion failed for gateway loads")
+			return
+		}
+
 		a.So(AssertAuthNsGsScheduleDownlinkRequest(ctx, env.Cluster.Auth, scheduleDownlinkCh,
 			func(ctx context.Context, msg *ttnpb.DownlinkMessage) bool {
 				return a.So(msg.CorrelationIDs, should.Contain, "GsNs-1") &&
 					a.So(msg.CorrelationIDs, should.Contain, "GsNs-2") &&
 					a.So(msg.CorrelationIDs, should.HaveLength, 5) &&
 					a.So(msg, should.Resemble, &ttnpb.DownlinkMessage{
+						EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+							DeviceID:               devID,
+							ApplicationIdentifiers: appID,
+							JoinEUI:                &types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevEUI:                 &types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevAddr:                &devAddr,
+						},
 						RawPayload: MustAppendLegacyDownlinkMIC(
 							fNwkSIntKey,
 							devAddr,
@@ -1290,12 +1313,23 @@ func handleClassAOTAAUS915FlowTest1_0_3(ctx context.Context, conn *grpc.ClientCo
 			return
 		}
 
+		if !a.So(test.AssertClusterAuthRequest(ctx, env.Cluster.Auth, grpc.EmptyCallOption{}), should.BeTrue) {
+			t.Error("Cluster auth assertion failed for gateway loads")
+			return
+		}
+
 		a.So(AssertAuthNsGsScheduleDownlinkRequest(ctx, env.Cluster.Auth, scheduleDownlinkCh,
 			func(ctx context.Context, msg *ttnpb.DownlinkMessage) bool {
 				return a.So(msg.CorrelationIDs, should.Contain, "GsNs-1") &&
 					a.So(msg.CorrelationIDs, should.Contain, "GsNs-2") &&
 					a.So(msg.CorrelationIDs, should.HaveLength, 5) &&
 					a.So(msg, should.Resemble, &ttnpb.DownlinkMessage{
+						EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+							DeviceID:               devID,
+							ApplicationIdentifiers: appID,
+							JoinEUI:                &types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevEUI:                 &types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+						},
 						RawPayload: bytes.Repeat([]byte{0x42}, 33),
 						Settings: &ttnpb.DownlinkMessage_Request{
 							Request: &ttnpb.TxRequest{
@@ -1637,12 +1671,24 @@ func handleClassAOTAAUS915FlowTest1_0_3(ctx context.Context, conn *grpc.ClientCo
 			test.ClusterGetPeerResponse{Peer: gsPeer},
 		), should.BeTrue)
 
+		if !a.So(test.AssertClusterAuthRequest(ctx, env.Cluster.Auth, grpc.EmptyCallOption{}), should.BeTrue) {
+			t.Error("Cluster auth assertion failed for gateway loads")
+			return
+		}
+
 		a.So(AssertAuthNsGsScheduleDownlinkRequest(ctx, env.Cluster.Auth, scheduleDownlinkCh,
 			func(ctx context.Context, msg *ttnpb.DownlinkMessage) bool {
 				return a.So(msg.CorrelationIDs, should.Contain, "GsNs-1") &&
 					a.So(msg.CorrelationIDs, should.Contain, "GsNs-2") &&
 					a.So(msg.CorrelationIDs, should.HaveLength, 5) &&
 					a.So(msg, should.Resemble, &ttnpb.DownlinkMessage{
+						EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+							DeviceID:               devID,
+							ApplicationIdentifiers: appID,
+							JoinEUI:                &types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevEUI:                 &types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevAddr:                &devAddr,
+						},
 						RawPayload: MustAppendLegacyDownlinkMIC(
 							fNwkSIntKey,
 							devAddr,
@@ -1728,6 +1774,12 @@ func TestFlow(t *testing.T) {
 			NewDeviceRegistry:         NewRedisDeviceRegistry,
 			NewDownlinkTaskQueue:      NewRedisDownlinkTaskQueue,
 		},
+		{
+			Name:                      "Bolt application uplink queue/Bolt registry/Bolt downlink task queue",
+			NewApplicationUplinkQueue: NewBoltApplicationUplinkQueue,
+			NewDeviceRegistry:         NewBoltDeviceRegistry,
+			NewDownlinkTaskQueue:      NewBoltDownlinkTaskQueue,
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			t.Parallel()
@@ -34,6 +34,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/networkserver/bolt"
 	"go.thethings.network/lorawan-stack/pkg/networkserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -41,6 +42,8 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -811,6 +814,7 @@ var _ ttnpb.NsGsServer = &MockNsGsServer{}
 
 type MockNsGsServer struct {
 	ScheduleDownlinkFunc func(context.Context, *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error)
+	GetGatewayLoadsFunc  func(context.Context, *ttnpb.GetGatewayLoadsRequest) (*ttnpb.GatewayLoads, error)
 }
 
 // ScheduleDownlink calls ScheduleDownlinkFunc if set and panics otherwise.
@@ -821,6 +825,14 @@ func (m MockNsGsServer) ScheduleDownlink(ctx context.Context, msg *ttnpb.Downlin
 	return m.ScheduleDownlinkFunc(ctx, msg)
 }
 
+// GetGatewayLoads calls GetGatewayLoadsFunc if set and returns an Unimplemented error otherwise.
+func (m MockNsGsServer) GetGatewayLoads(ctx context.Context, req *ttnpb.GetGatewayLoadsRequest) (*ttnpb.GatewayLoads, error) {
+	if m.GetGatewayLoadsFunc == nil {
+		return nil, status.Error(codes.Unimplemented, "GetGatewayLoads not set")
+	}
+	return m.GetGatewayLoadsFunc(ctx, req)
+}
+
 type NsGsScheduleDownlinkResponse struct {
 	Response *ttnpb.ScheduleDownlinkResponse
 	Error    error
@@ -1446,6 +1458,17 @@ func NewRedisDeviceRegistry(t testing.TB) (DeviceRegistry, func() error) {
 		}
 }
 
+func NewRedisUplinkDeduplicator(t testing.TB) (UplinkDeduplicator, func() error) {
+	cl, flush := test.NewRedis(t, append(redisNamespace[:], "uplink-deduplication")...)
+	return &redis.UplinkDeduplicator{
+			Redis: cl,
+		},
+		func() error {
+			flush()
+			return cl.Close()
+		}
+}
+
 func NewRedisDownlinkTaskQueue(t testing.TB) (DownlinkTaskQueue, func() error) {
 	a := assertions.New(t)
 
@@ -1490,3 +1513,36 @@ func NewRedisDownlinkTaskQueue(t testing.TB) (DownlinkTaskQueue, func() error) {
 			return closeErr
 		}
 }
+
+var boltNamespace = [...]string{
+	"networkserver_test",
+}
+
+func NewBoltApplicationUplinkQueue(t testing.TB) (ApplicationUplinkQueue, func() error) {
+	cl, closeFn := test.NewBolt(t, append(boltNamespace[:], "application-uplinks")...)
+	return bolt.NewApplicationUplinkQueue(cl),
+		func() error {
+			closeFn()
+			return nil
+		}
+}
+
+func NewBoltDeviceRegistry(t testing.TB) (DeviceRegistry, func() error) {
+	cl, closeFn := test.NewBolt(t, append(boltNamespace[:], "devices")...)
+	return &bolt.DeviceRegistry{
+			Bolt: cl,
+		},
+		func() error {
+			closeFn()
+			return nil
+		}
+}
+
+func NewBoltDownlinkTaskQueue(t testing.TB) (DownlinkTaskQueue, func() error) {
+	cl, closeFn := test.NewBolt(t, append(boltNamespace[:], "downlink-tasks")...)
+	return bolt.NewDownlinkTaskQueue(cl),
+		func() error {
+			closeFn()
+			return nil
+		}
+}
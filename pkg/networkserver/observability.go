@@ -136,6 +136,14 @@ var (
 		"ns.up.rejoin.forward", "forward rejoin-request",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtSelectDownlinkPaths = events.Define(
+		"ns.down.paths.select", "select downlink paths",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+	evtRetryDataDownlink = events.Define(
+		"ns.down.data.retry", "retry data downlink",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtEnqueueProprietaryMACAnswer  = defineEnqueueMACAnswerEvent("proprietary", "proprietary MAC command")
 	evtEnqueueProprietaryMACRequest = defineEnqueueMACRequestEvent("proprietary", "proprietary MAC command")
 	evtReceiveProprietaryMAC        = events.Define(
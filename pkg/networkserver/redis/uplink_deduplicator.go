@@ -0,0 +1,99 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-redis/redis"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// UplinkDeduplicator is an implementation of networkserver.UplinkDeduplicator.
+// Uplinks are identified by the SHA-256 hash of their PHYPayload.
+type UplinkDeduplicator struct {
+	Redis *ttnredis.Client
+}
+
+// deduplicateUplinkScript atomically acquires the deduplication lock at KEYS[1] for ARGV[1] milliseconds
+// and appends the metadata in ARGV[2:] to the list at KEYS[2], which expires together with the lock.
+// If the lock is acquired, metadata left over from a previous window is discarded.
+// The script returns 1 if the lock is acquired and 0 otherwise.
+var deduplicateUplinkScript = redis.NewScript(`local first = redis.call('set', KEYS[1], '', 'px', ARGV[1], 'nx')
+if first then
+	redis.call('del', KEYS[2])
+end
+if #ARGV > 1 then
+	redis.call('rpush', KEYS[2], unpack(ARGV, 2))
+	redis.call('pexpire', KEYS[2], redis.call('pttl', KEYS[1]))
+end
+if first then
+	return 1
+end
+return 0`)
+
+func uplinkHash(up *ttnpb.UplinkMessage) string {
+	h := sha256.Sum256(up.RawPayload)
+	return hex.EncodeToString(h[:])
+}
+
+func (d *UplinkDeduplicator) lockKey(h string) string {
+	return d.Redis.Key("uplink", h, "lock")
+}
+
+func (d *UplinkDeduplicator) metadataKey(h string) string {
+	return d.Redis.Key("uplink", h, "metadata")
+}
+
+// DeduplicateUplink implements networkserver.UplinkDeduplicator.
+// Since Redis expiry has millisecond precision, window is truncated to milliseconds.
+func (d *UplinkDeduplicator) DeduplicateUplink(ctx context.Context, up *ttnpb.UplinkMessage, window time.Duration) (bool, error) {
+	h := uplinkHash(up)
+	args := make([]interface{}, 0, 1+len(up.RxMetadata))
+	args = append(args, int64(window/time.Millisecond))
+	for _, md := range up.RxMetadata {
+		s, err := ttnredis.MarshalProto(md)
+		if err != nil {
+			return false, err
+		}
+		args = append(args, s)
+	}
+	v, err := deduplicateUplinkScript.Run(d.Redis, []string{d.lockKey(h), d.metadataKey(h)}, args...).Int64()
+	if err != nil {
+		return false, ttnredis.ConvertError(err)
+	}
+	return v == 1, nil
+}
+
+// AccumulatedMetadata implements networkserver.UplinkDeduplicator.
+func (d *UplinkDeduplicator) AccumulatedMetadata(ctx context.Context, up *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error) {
+	ss, err := d.Redis.LRange(d.metadataKey(uplinkHash(up)), 0, -1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	mds := make([]*ttnpb.RxMetadata, 0, len(ss))
+	for _, s := range ss {
+		md := &ttnpb.RxMetadata{}
+		if err := ttnredis.UnmarshalProto(s, md); err != nil {
+			return nil, err
+		}
+		mds = append(mds, md)
+	}
+	return mds, nil
+}
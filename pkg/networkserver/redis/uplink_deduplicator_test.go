@@ -0,0 +1,84 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.UplinkDeduplicator = &UplinkDeduplicator{}
+
+func TestUplinkDeduplicator(t *testing.T) {
+	a := assertions.New(t)
+
+	cl, flush := test.NewRedis(t, "networkserver_test", "uplink-deduplication")
+	defer flush()
+	defer cl.Close()
+
+	d := &UplinkDeduplicator{Redis: cl}
+	ctx := test.Context()
+
+	const window = 200 * time.Millisecond
+
+	newUplink := func(gtwID string) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+			RxMetadata: []*ttnpb.RxMetadata{
+				{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: gtwID}},
+			},
+		}
+	}
+
+	first, err := d.DeduplicateUplink(ctx, newUplink("gateway-1"), window)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeTrue)
+
+	first, err = d.DeduplicateUplink(ctx, newUplink("gateway-2"), window)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeFalse)
+
+	other := newUplink("gateway-3")
+	other.RawPayload = []byte{0x40, 0x04, 0x03, 0x02, 0x01}
+	first, err = d.DeduplicateUplink(ctx, other, window)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeTrue)
+
+	mds, err := d.AccumulatedMetadata(ctx, newUplink("gateway-1"))
+	a.So(err, should.BeNil)
+	a.So(mds, should.HaveSameElementsDeep, []*ttnpb.RxMetadata{
+		{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"}},
+		{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-2"}},
+	})
+
+	time.Sleep(2 * window)
+
+	first, err = d.DeduplicateUplink(ctx, newUplink("gateway-4"), window)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeTrue)
+
+	mds, err = d.AccumulatedMetadata(ctx, newUplink("gateway-4"))
+	a.So(err, should.BeNil)
+	a.So(mds, should.HaveSameElementsDeep, []*ttnpb.RxMetadata{
+		{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-4"}},
+	})
+}
@@ -361,6 +361,11 @@ func TestRegistries(t *testing.T) {
 			New:  NewRedisDeviceRegistry,
 			N:    8,
 		},
+		{
+			Name: "Bolt",
+			New:  NewBoltDeviceRegistry,
+			N:    8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
@@ -0,0 +1,511 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+const (
+	// roamingProtocolVersion is the LoRaWAN Backend Interfaces version used for passive roaming.
+	roamingProtocolVersion = "1.0"
+	// roamingDownlinkTimeout is the timeout for scheduling a downlink received from the sNS.
+	roamingDownlinkTimeout = 5 * time.Second
+)
+
+var (
+	errRoamingBand         = errors.DefineFailedPrecondition("roaming_band", "roaming band ID is not configured")
+	errRoamingDataRate     = errors.DefineInvalidArgument("roaming_data_rate", "data rate `{data_rate}` is not valid in RF region `{rf_region}`")
+	errRoamingNoDataRate   = errors.DefineInvalidArgument("roaming_no_data_rate", "no data rate or frequency in uplink metadata")
+	errRoamingUplinkToken  = errors.DefineInvalidArgument("roaming_uplink_token", "invalid roaming uplink token")
+	errRoamingUplinkMType  = errors.DefineInvalidArgument("roaming_uplink_m_type", "uplink with MType `{m_type}` can not be roamed")
+	errRoamingNoULMetaData = errors.DefineInvalidArgument("roaming_no_ul_meta_data", "no uplink metadata")
+)
+
+// roamingUplinkTokenPrefix is the prefix of uplink tokens of uplinks received through passive roaming.
+var roamingUplinkTokenPrefix = []byte("ttn-lw-roaming:")
+
+// roamingUplinkToken is the uplink token of an uplink received through passive roaming.
+// It contains the NetID of the fNS, which is needed to route downlink back, and the opaque uplink token of the fNS.
+type roamingUplinkToken struct {
+	NetID   types.NetID `json:"net_id"`
+	ULToken []byte      `json:"ul_token,omitempty"`
+}
+
+func (t roamingUplinkToken) marshal() ([]byte, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(roamingUplinkTokenPrefix[:0:0], roamingUplinkTokenPrefix...), b...), nil
+}
+
+// isRoamingUplinkToken returns whether b is an uplink token of an uplink received through passive roaming.
+func isRoamingUplinkToken(b []byte) bool {
+	return bytes.HasPrefix(b, roamingUplinkTokenPrefix)
+}
+
+func (t *roamingUplinkToken) unmarshal(b []byte) error {
+	if !isRoamingUplinkToken(b) {
+		return errRoamingUplinkToken
+	}
+	if err := json.Unmarshal(b[len(roamingUplinkTokenPrefix):], t); err != nil {
+		return errRoamingUplinkToken.WithCause(err)
+	}
+	return nil
+}
+
+// receivedThroughRoaming returns whether up was received through passive roaming.
+func receivedThroughRoaming(up *ttnpb.UplinkMessage) bool {
+	for _, md := range up.RxMetadata {
+		if isRoamingUplinkToken(md.UplinkToken) {
+			return true
+		}
+	}
+	return false
+}
+
+// roamingGatewayIdentifiers returns the gateway identifiers used for gateways of the fNS identified by netID.
+func roamingGatewayIdentifiers(netID types.NetID, id []byte) ttnpb.GatewayIdentifiers {
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: fmt.Sprintf("roaming-%s", strings.ToLower(netID.String())),
+	}
+	if len(id) == 8 {
+		var eui types.EUI64
+		copy(eui[:], id)
+		ids.EUI = &eui
+	}
+	return ids
+}
+
+// ulMetaData returns the uplink metadata of up to be sent to the sNS.
+func ulMetaData(bandID string, up *ttnpb.UplinkMessage) (*interop.ULMetaData, error) {
+	phy, err := band.GetByID(bandID)
+	if err != nil {
+		return nil, err
+	}
+	drIdx, _, ok := phy.FindDataRate(up.Settings.DataRate)
+	if !ok {
+		return nil, errDataRateNotFound
+	}
+	pld := up.Payload.GetMACPayload()
+	devAddr := interop.DevAddr(pld.DevAddr)
+	dataRate := int(drIdx)
+	ulFreq := float64(up.Settings.Frequency) / 1e6
+	gwCnt := len(up.RxMetadata)
+	md := &interop.ULMetaData{
+		DevAddr:   &devAddr,
+		FCntUp:    &pld.FCnt,
+		Confirmed: up.Payload.MType == ttnpb.MType_CONFIRMED_UP,
+		DataRate:  &dataRate,
+		ULFreq:    &ulFreq,
+		RecvTime:  up.ReceivedAt,
+		RFRegion:  bandID,
+		GWCnt:     &gwCnt,
+		GWInfo:    make([]interop.GWInfoElement, 0, len(up.RxMetadata)),
+	}
+	if pld.FPort != 0 || len(pld.FRMPayload) > 0 {
+		fPort := uint8(pld.FPort)
+		md.FPort = &fPort
+	}
+	for _, rxMD := range up.RxMetadata {
+		rssi, snr := rxMD.RSSI, rxMD.SNR
+		gwInfo := interop.GWInfoElement{
+			RFRegion:  bandID,
+			RSSI:      &rssi,
+			SNR:       &snr,
+			ULToken:   interop.Buffer(rxMD.UplinkToken),
+			DLAllowed: len(rxMD.UplinkToken) > 0 && rxMD.DownlinkPathConstraint != ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+		}
+		if rxMD.EUI != nil {
+			gwInfo.ID = interop.Buffer(rxMD.EUI[:])
+		}
+		if loc := rxMD.Location; loc != nil {
+			lat, lon := loc.Latitude, loc.Longitude
+			gwInfo.Lat, gwInfo.Lon = &lat, &lon
+		}
+		md.GWInfo = append(md.GWInfo, gwInfo)
+	}
+	return md, nil
+}
+
+// uplinkFromRoaming returns the uplink message received from the fNS identified by netID.
+func uplinkFromRoaming(netID types.NetID, phyPayload []byte, md *interop.ULMetaData) (*ttnpb.UplinkMessage, error) {
+	if md == nil {
+		return nil, errRoamingNoULMetaData
+	}
+	if md.DataRate == nil || md.ULFreq == nil {
+		return nil, errRoamingNoDataRate
+	}
+	phy, err := band.GetByID(md.RFRegion)
+	if err != nil {
+		return nil, err
+	}
+	dr, ok := phy.DataRates[ttnpb.DataRateIndex(*md.DataRate)]
+	if !ok {
+		return nil, errRoamingDataRate.WithAttributes(
+			"data_rate", *md.DataRate,
+			"rf_region", md.RFRegion,
+		)
+	}
+	up := &ttnpb.UplinkMessage{
+		RawPayload: phyPayload,
+		Settings: ttnpb.TxSettings{
+			DataRate:  dr.Rate,
+			Frequency: uint64(*md.ULFreq*1e6 + 0.5),
+		},
+		RxMetadata: make([]*ttnpb.RxMetadata, 0, len(md.GWInfo)),
+	}
+	for _, gwInfo := range md.GWInfo {
+		rxMD := &ttnpb.RxMetadata{
+			GatewayIdentifiers: roamingGatewayIdentifiers(netID, gwInfo.ID),
+		}
+		if gwInfo.RSSI != nil {
+			rxMD.RSSI = *gwInfo.RSSI
+		}
+		if gwInfo.SNR != nil {
+			rxMD.SNR = *gwInfo.SNR
+		}
+		if gwInfo.Lat != nil && gwInfo.Lon != nil {
+			rxMD.Location = &ttnpb.Location{
+				Latitude:  *gwInfo.Lat,
+				Longitude: *gwInfo.Lon,
+				Source:    ttnpb.SOURCE_REGISTRY,
+			}
+		}
+		if gwInfo.DLAllowed {
+			token, err := roamingUplinkToken{
+				NetID:   netID,
+				ULToken: gwInfo.ULToken,
+			}.marshal()
+			if err != nil {
+				return nil, err
+			}
+			rxMD.UplinkToken = token
+		}
+		up.RxMetadata = append(up.RxMetadata, rxMD)
+	}
+	return up, nil
+}
+
+// forwardDataUplink forwards the data uplink up to the sNS identified by netID with a PRStartReq message.
+// Passive roaming is stateless: each uplink message is forwarded with a PRStartReq message.
+func (ns *NetworkServer) forwardDataUplink(ctx context.Context, netID types.NetID, up *ttnpb.UplinkMessage, acc *metadataAccumulator) error {
+	logger := log.FromContext(ctx).WithField("net_id", netID)
+	ctx = log.NewContext(ctx, logger)
+
+	if ns.roamingBandID == "" {
+		logger.Warn("Roaming band ID is not configured, drop uplink")
+		registerDropDataUplink(ctx, up, errRoamingBand)
+		return errRoamingBand
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ns.deduplicationDone(ctx, up):
+	}
+	up.RxMetadata = ns.accumulatedMetadata(ctx, up, acc)
+
+	md, err := ulMetaData(ns.roamingBandID, up)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to determine uplink metadata")
+		registerDropDataUplink(ctx, up, err)
+		return err
+	}
+
+	logger.Debug("Forward uplink to roaming partner")
+	ans, err := ns.roamingClient.PRStartRequest(ctx, &interop.PRStartReq{
+		NsMessageHeader: interop.NsMessageHeader{
+			MessageHeader: interop.MessageHeader{
+				ProtocolVersion: roamingProtocolVersion,
+				MessageType:     interop.MessageTypePRStartReq,
+			},
+			SenderID:   interop.NetID(ns.netID),
+			ReceiverID: interop.NetID(netID),
+		},
+		PHYPayload: interop.Buffer(up.RawPayload),
+		ULMetaData: *md,
+	})
+	if err != nil {
+		logger.WithError(err).Debug("Roaming partner rejected uplink")
+		registerDropDataUplink(ctx, up, err)
+		return err
+	}
+	if ans.Lifetime != nil && *ans.Lifetime > 0 {
+		logger.WithField("lifetime", *ans.Lifetime).Debug("Roaming partner requested stateful passive roaming, continue stateless")
+	}
+	logger.Debug("Forwarded uplink to roaming partner")
+	registerForwardDataUplink(ctx, up)
+	return nil
+}
+
+// handleRoamingUplink handles the data uplink received from the fNS identified by netID.
+func (ns *NetworkServer) handleRoamingUplink(ctx context.Context, netID types.NetID, phyPayload []byte, md *interop.ULMetaData) error {
+	ctx = log.NewContextWithField(ctx, "net_id", netID)
+	up, err := uplinkFromRoaming(netID, phyPayload, md)
+	if err != nil {
+		return interop.ErrMalformedMessage.WithCause(err)
+	}
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("ns:uplink:%s", events.NewCorrelationID()))
+	up.CorrelationIDs = events.CorrelationIDsFromContext(ctx)
+	up.ReceivedAt = timeNow().UTC()
+	up.Payload = &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
+		return interop.ErrMalformedMessage.WithCause(err)
+	}
+	if up.Payload.Major != ttnpb.Major_LORAWAN_R1 {
+		return interop.ErrMalformedMessage.WithCause(errUnsupportedLoRaWANVersion.WithAttributes(
+			"version", up.Payload.Major,
+		))
+	}
+	switch up.Payload.MType {
+	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
+	default:
+		return interop.ErrMalformedMessage.WithCause(errRoamingUplinkMType.WithAttributes("m_type", up.Payload.MType))
+	}
+	if err := ns.handleUplink(ctx, up); err != nil {
+		if errors.Resemble(err, errDeviceNotFound) {
+			return interop.ErrUnknownDevAddr.WithCause(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// roamingDownlinkPath is a downlink path through the fNS identified by NetID.
+type roamingDownlinkPath struct {
+	NetID   types.NetID
+	ULToken []byte
+}
+
+// transmitRoamingDownlink transmits payload b through the fNS with a XmitDataReq message using parameters in req.
+func (ns *NetworkServer) transmitRoamingDownlink(ctx context.Context, req *ttnpb.TxRequest, b []byte, paths ...roamingDownlinkPath) (*scheduledDownlink, error) {
+	netID := paths[0].NetID
+	logger := log.FromContext(ctx).WithField("net_id", netID)
+
+	md := &interop.DLMetaData{
+		GWInfo:         make([]interop.GWInfoElement, 0, len(paths)),
+		HiPriorityFlag: req.Priority >= ttnpb.TxSchedulePriority_HIGH,
+	}
+	var classMode string
+	switch req.Class {
+	case ttnpb.CLASS_A:
+		classMode = "A"
+		rxDelay := int(req.Rx1Delay)
+		md.RXDelay1 = &rxDelay
+	case ttnpb.CLASS_B:
+		classMode = "B"
+	case ttnpb.CLASS_C:
+		classMode = "C"
+	}
+	md.ClassMode = &classMode
+	if req.Rx1Frequency != 0 {
+		freq, dr := float64(req.Rx1Frequency)/1e6, int(req.Rx1DataRateIndex)
+		md.DLFreq1, md.DataRate1 = &freq, &dr
+	}
+	if req.Rx2Frequency != 0 {
+		freq, dr := float64(req.Rx2Frequency)/1e6, int(req.Rx2DataRateIndex)
+		md.DLFreq2, md.DataRate2 = &freq, &dr
+	}
+	for _, path := range paths {
+		md.GWInfo = append(md.GWInfo, interop.GWInfoElement{
+			ULToken:   interop.Buffer(path.ULToken),
+			DLAllowed: true,
+		})
+	}
+
+	down := &ttnpb.DownlinkMessage{
+		RawPayload:     b,
+		CorrelationIDs: events.CorrelationIDsFromContext(ctx),
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: req,
+		},
+	}
+	logger.WithField("path_count", len(paths)).Debug("Transmit downlink through roaming partner")
+	if _, err := ns.roamingClient.XmitDataRequest(ctx, &interop.XmitDataReq{
+		NsMessageHeader: interop.NsMessageHeader{
+			MessageHeader: interop.MessageHeader{
+				ProtocolVersion: roamingProtocolVersion,
+				MessageType:     interop.MessageTypeXmitDataReq,
+			},
+			SenderID:   interop.NetID(ns.netID),
+			ReceiverID: interop.NetID(netID),
+		},
+		PHYPayload: interop.Buffer(b),
+		DLMetaData: md,
+	}); err != nil {
+		return nil, err
+	}
+	// The fNS does not report the transmission delay, so the downlink is assumed to be transmitted immediately.
+	transmitAt := timeNow()
+	logger.WithField("transmit_at", transmitAt).Debug("Transmitted downlink through roaming partner")
+	return &scheduledDownlink{
+		Message:    down,
+		TransmitAt: transmitAt,
+	}, nil
+}
+
+// txRequestFromRoaming returns the transmission request for the downlink metadata received from the sNS.
+func txRequestFromRoaming(md *interop.DLMetaData) *ttnpb.TxRequest {
+	req := &ttnpb.TxRequest{
+		Class:    ttnpb.CLASS_A,
+		Priority: ttnpb.TxSchedulePriority_NORMAL,
+	}
+	if md.HiPriorityFlag {
+		req.Priority = ttnpb.TxSchedulePriority_HIGHEST
+	}
+	if md.ClassMode != nil {
+		switch *md.ClassMode {
+		case "B":
+			req.Class = ttnpb.CLASS_B
+		case "C":
+			req.Class = ttnpb.CLASS_C
+		}
+	}
+	if md.RXDelay1 != nil {
+		req.Rx1Delay = ttnpb.RxDelay(*md.RXDelay1)
+	}
+	if md.DLFreq1 != nil && md.DataRate1 != nil {
+		req.Rx1Frequency = uint64(*md.DLFreq1*1e6 + 0.5)
+		req.Rx1DataRateIndex = ttnpb.DataRateIndex(*md.DataRate1)
+	}
+	if md.DLFreq2 != nil && md.DataRate2 != nil {
+		req.Rx2Frequency = uint64(*md.DLFreq2*1e6 + 0.5)
+		req.Rx2DataRateIndex = ttnpb.DataRateIndex(*md.DataRate2)
+	}
+	return req
+}
+
+// handleRoamingDownlink schedules the downlink received from the sNS on the gateways identified by the uplink tokens.
+func (ns *NetworkServer) handleRoamingDownlink(ctx context.Context, phyPayload []byte, md *interop.DLMetaData) error {
+	paths := make([]downlinkPath, 0, len(md.GWInfo))
+	for _, gwInfo := range md.GWInfo {
+		if len(gwInfo.ULToken) == 0 {
+			continue
+		}
+		var token ttnpb.UplinkToken
+		if err := token.Unmarshal(gwInfo.ULToken); err != nil {
+			return interop.ErrMalformedMessage.WithCause(err)
+		}
+		paths = append(paths, downlinkPath{
+			GatewayIdentifiers: token.GatewayIdentifiers,
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: gwInfo.ULToken,
+				},
+			},
+		})
+	}
+	if _, err := ns.scheduleDownlinkByPaths(ctx, txRequestFromRoaming(md), nil, phyPayload, paths...); err != nil {
+		return interop.ErrTransmitFailed.WithCause(err)
+	}
+	return nil
+}
+
+// servingInteropServer is the interop server of the Network Server in the sNS role.
+type servingInteropServer struct {
+	NS *NetworkServer
+}
+
+// PRStartRequest implements interop.ServingNetworkServer.
+func (srv servingInteropServer) PRStartRequest(ctx context.Context, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	if srv.NS.roamingClient == nil {
+		return nil, interop.ErrNoRoamingAgreement
+	}
+	if err := srv.NS.handleRoamingUplink(ctx, types.NetID(req.SenderID), req.PHYPayload, &req.ULMetaData); err != nil {
+		return nil, err
+	}
+	header, err := req.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	var lifetime uint32
+	return &interop.PRStartAns{
+		NsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		Lifetime: &lifetime,
+	}, nil
+}
+
+// XmitDataRequest implements interop.ServingNetworkServer.
+func (srv servingInteropServer) XmitDataRequest(ctx context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	if srv.NS.roamingClient == nil {
+		return nil, interop.ErrNoRoamingAgreement
+	}
+	if err := srv.NS.handleRoamingUplink(ctx, types.NetID(req.SenderID), req.PHYPayload, req.ULMetaData); err != nil {
+		return nil, err
+	}
+	header, err := req.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// forwardingInteropServer is the interop server of the Network Server in the fNS role.
+type forwardingInteropServer struct {
+	NS *NetworkServer
+}
+
+// XmitDataRequest implements interop.ForwardingNetworkServer.
+func (srv forwardingInteropServer) XmitDataRequest(ctx context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	if srv.NS.roamingClient == nil {
+		return nil, interop.ErrNoRoamingAgreement
+	}
+	if req.DLMetaData == nil {
+		return nil, interop.ErrMalformedMessage
+	}
+	ctx = log.NewContextWithField(ctx, "net_id", types.NetID(req.SenderID))
+	ctx, cancel := context.WithTimeout(ctx, roamingDownlinkTimeout)
+	defer cancel()
+	if err := srv.NS.handleRoamingDownlink(ctx, req.PHYPayload, req.DLMetaData); err != nil {
+		return nil, err
+	}
+	header, err := req.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
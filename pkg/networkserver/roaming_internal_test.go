@@ -0,0 +1,169 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRoamingUplinkToken(t *testing.T) {
+	a := assertions.New(t)
+
+	token := roamingUplinkToken{
+		NetID:   types.NetID{0x00, 0x00, 0x13},
+		ULToken: []byte{0x01, 0x02, 0x03},
+	}
+	b, err := token.marshal()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(isRoamingUplinkToken(b), should.BeTrue)
+	a.So(isRoamingUplinkToken([]byte{0x01, 0x02, 0x03}), should.BeFalse)
+
+	var decoded roamingUplinkToken
+	a.So(decoded.unmarshal(b), should.BeNil)
+	a.So(decoded, should.Resemble, token)
+	a.So(decoded.unmarshal([]byte{0x01, 0x02, 0x03}), should.HaveSameErrorDefinitionAs, errRoamingUplinkToken)
+}
+
+func TestRoamingULMetaData(t *testing.T) {
+	a := assertions.New(t)
+
+	netID := types.NetID{0x00, 0x00, 0x13}
+	eui := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	receivedAt := time.Unix(42, 0).UTC()
+	phy, err := band.GetByID(band.EU_863_870)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x04, 0x03, 0x02, 0x26, 0x00, 0x2a, 0x00, 0x01, 0x02, 0x03, 0x04},
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_UNCONFIRMED_UP,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{
+				FHDR: ttnpb.FHDR{
+					DevAddr: types.DevAddr{0x26, 0x02, 0x03, 0x04},
+					FCnt:    42,
+				},
+			}},
+		},
+		Settings: ttnpb.TxSettings{
+			DataRate:  phy.DataRates[ttnpb.DATA_RATE_5].Rate,
+			Frequency: 868100000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+					GatewayID: "test-gtw",
+					EUI:       &eui,
+				},
+				RSSI:        -42,
+				SNR:         5.5,
+				UplinkToken: []byte{0x01, 0x02, 0x03},
+			},
+			{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+					GatewayID: "test-gtw-no-downlink",
+				},
+				RSSI: -100,
+				SNR:  -2,
+			},
+		},
+		ReceivedAt: receivedAt,
+	}
+
+	md, err := ulMetaData(band.EU_863_870, up)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(*md.DevAddr, should.Equal, interop.DevAddr{0x26, 0x02, 0x03, 0x04})
+	a.So(*md.FCntUp, should.Equal, 42)
+	a.So(*md.DataRate, should.Equal, 5)
+	a.So(*md.ULFreq, should.Equal, 868.1)
+	a.So(*md.GWCnt, should.Equal, 2)
+	a.So(md.FPort, should.BeNil)
+	a.So(md.RecvTime, should.Equal, receivedAt)
+	if !a.So(md.GWInfo, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(md.GWInfo[0].DLAllowed, should.BeTrue)
+	a.So(md.GWInfo[1].DLAllowed, should.BeFalse)
+
+	roamed, err := uplinkFromRoaming(netID, up.RawPayload, md)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(roamed.RawPayload, should.Resemble, up.RawPayload)
+	a.So(roamed.Settings.DataRate, should.Resemble, up.Settings.DataRate)
+	a.So(roamed.Settings.Frequency, should.Equal, up.Settings.Frequency)
+	a.So(receivedThroughRoaming(roamed), should.BeTrue)
+	if !a.So(roamed.RxMetadata, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(roamed.RxMetadata[0].GatewayIdentifiers, should.Resemble, roamingGatewayIdentifiers(netID, eui[:]))
+	a.So(roamed.RxMetadata[0].RSSI, should.Equal, -42)
+	a.So(roamed.RxMetadata[0].SNR, should.Equal, 5.5)
+	var token roamingUplinkToken
+	if a.So(token.unmarshal(roamed.RxMetadata[0].UplinkToken), should.BeNil) {
+		a.So(token.NetID, should.Equal, netID)
+		a.So(token.ULToken, should.Resemble, []byte{0x01, 0x02, 0x03})
+	}
+	a.So(roamed.RxMetadata[1].UplinkToken, should.BeEmpty)
+
+	_, err = uplinkFromRoaming(netID, up.RawPayload, nil)
+	a.So(err, should.HaveSameErrorDefinitionAs, errRoamingNoULMetaData)
+	invalidDataRate := 42
+	md.DataRate = &invalidDataRate
+	_, err = uplinkFromRoaming(netID, up.RawPayload, md)
+	a.So(err, should.HaveSameErrorDefinitionAs, errRoamingDataRate)
+}
+
+func TestTxRequestFromRoaming(t *testing.T) {
+	a := assertions.New(t)
+
+	dlFreq1, dlFreq2 := 868.1, 869.525
+	dataRate1, dataRate2 := 5, 0
+	rxDelay1 := 1
+	classMode := "A"
+	req := txRequestFromRoaming(&interop.DLMetaData{
+		DLFreq1:        &dlFreq1,
+		DLFreq2:        &dlFreq2,
+		DataRate1:      &dataRate1,
+		DataRate2:      &dataRate2,
+		RXDelay1:       &rxDelay1,
+		ClassMode:      &classMode,
+		HiPriorityFlag: true,
+	})
+	a.So(req, should.Resemble, &ttnpb.TxRequest{
+		Class:            ttnpb.CLASS_A,
+		Priority:         ttnpb.TxSchedulePriority_HIGHEST,
+		Rx1Delay:         ttnpb.RX_DELAY_1,
+		Rx1Frequency:     868100000,
+		Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+		Rx2Frequency:     869525000,
+		Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+	})
+}
@@ -0,0 +1,350 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/cluster"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	. "go.thethings.network/lorawan-stack/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+const (
+	roamingRootCAPath     = "rootCA.pem"
+	roamingClientCertPath = "clientcert.pem"
+	roamingClientKeyPath  = "clientkey.pem"
+)
+
+func readInteropTestdata(t *testing.T, name string) []byte {
+	return test.Must(ioutil.ReadFile(filepath.Join("..", "interop", "testdata", name))).([]byte)
+}
+
+// newRoamingInteropServer returns a new interop server, which accepts messages from netIDs, served over mutual TLS.
+func newRoamingInteropServer(t *testing.T, netIDs ...types.NetID) (*interop.Server, *httptest.Server) {
+	rootCA := readInteropTestdata(t, roamingRootCAPath)
+	senderClientCAs := make(map[string][]byte, len(netIDs))
+	for _, netID := range netIDs {
+		senderClientCAs[netID.String()] = rootCA
+	}
+	s := test.Must(interop.NewServer(test.Context(), nil, config.InteropServer{
+		SenderClientCA: config.SenderClientCA{
+			Static: senderClientCAs,
+		},
+	})).(*interop.Server)
+
+	certPool := x509.NewCertPool()
+	certPool.AppendCertsFromPEM(rootCA)
+	srv := httptest.NewUnstartedServer(s)
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{
+			test.Must(tls.X509KeyPair(readInteropTestdata(t, "servercert.pem"), readInteropTestdata(t, "serverkey.pem"))).(tls.Certificate),
+		},
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  certPool,
+	}
+	srv.StartTLS()
+	return s, srv
+}
+
+// newRoamingInteropClientDirectory returns a new interop client configuration directory, which configures srv as
+// the roaming partner Network Server of netID.
+func newRoamingInteropClientDirectory(t *testing.T, netID types.NetID, srv *httptest.Server) (string, func() error) {
+	host, port, err := net.SplitHostPort(test.Must(url.Parse(srv.URL)).(*url.URL).Host)
+	if err != nil {
+		t.Fatalf("Invalid server host: %s", err)
+	}
+
+	dir := test.Must(ioutil.TempDir("", "lorawan-stack-ns-roaming-test")).(string)
+	for _, name := range []string{roamingRootCAPath, roamingClientCertPath, roamingClientKeyPath} {
+		test.MustMultiple(ioutil.WriteFile(filepath.Join(dir, name), readInteropTestdata(t, name), 0644))
+	}
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(dir, interop.InteropClientConfigurationName), []byte(fmt.Sprintf(`network-servers:
+   - file: partner-ns.yml
+     net-ids:
+        - %s`,
+		netID,
+	)), 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(dir, "partner-ns.yml"), []byte(fmt.Sprintf(`fqdn: %s
+port: %s
+paths:
+   sns: sns
+   fns: fns
+tls:
+   root-ca: %s
+   certificate: %s
+   key: %s`,
+		host,
+		port,
+		roamingRootCAPath,
+		roamingClientCertPath,
+		roamingClientKeyPath,
+	)), 0644))
+	return dir, func() error {
+		return os.RemoveAll(dir)
+	}
+}
+
+func TestPassiveRoaming(t *testing.T) {
+	a := assertions.New(t)
+
+	fNetID, sNetID := types.NetID{0x00, 0x00, 0x01}, types.NetID{0x00, 0x00, 0x13}
+
+	fInterop, fInteropSrv := newRoamingInteropServer(t, sNetID)
+	defer fInteropSrv.Close()
+	sInterop, sInteropSrv := newRoamingInteropServer(t, fNetID)
+	defer sInteropSrv.Close()
+
+	fInteropDir, closeFInteropDir := newRoamingInteropClientDirectory(t, sNetID, sInteropSrv)
+	defer closeFInteropDir()
+	sInteropDir, closeSInteropDir := newRoamingInteropClientDirectory(t, fNetID, fInteropSrv)
+	defer closeSInteropDir()
+
+	errPeerNotFound := errors.New("peer not found")
+	newNetworkServer := func(conf *Config, gs cluster.Peer) *NetworkServer {
+		conf.DeduplicationWindow = (1 << 4) * test.Delay
+		conf.CooldownWindow = (1 << 5) * test.Delay
+
+		ns := test.Must(New(
+			componenttest.NewComponent(
+				t,
+				&component.Config{},
+				component.WithClusterNew(func(context.Context, *config.Cluster, ...cluster.Option) (cluster.Cluster, error) {
+					return &test.MockCluster{
+						AuthFunc: func() grpc.CallOption {
+							return grpc.EmptyCallOption{}
+						},
+						GetPeerFunc: func(_ context.Context, role ttnpb.ClusterRole, _ ttnpb.Identifiers) (cluster.Peer, error) {
+							if role != ttnpb.ClusterRole_GATEWAY_SERVER || gs == nil {
+								return nil, errPeerNotFound
+							}
+							return gs, nil
+						},
+						JoinFunc: test.ClusterJoinNilFunc,
+						WithVerifiedSourceFunc: func(ctx context.Context) context.Context {
+							return clusterauth.NewContext(ctx, nil)
+						},
+					}, nil
+				}),
+			),
+			conf,
+		)).(*NetworkServer)
+		ns.Component.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+		componenttest.StartComponent(t, ns.Component)
+		return ns
+	}
+
+	fDevices, closeFDevices := NewBoltDeviceRegistry(t)
+	defer closeFDevices()
+	fDownlinkTasks, closeFDownlinkTasks := NewBoltDownlinkTaskQueue(t)
+	defer closeFDownlinkTasks()
+
+	scheduleDownlinkCh := make(chan *ttnpb.DownlinkMessage, 1)
+	fNS := newNetworkServer(&Config{
+		NetID:         fNetID,
+		Devices:       fDevices,
+		DownlinkTasks: fDownlinkTasks,
+		Interop: config.InteropClient{
+			Directory: fInteropDir,
+		},
+		RoamingBandID: band.EU_863_870,
+	}, NewGSPeer(test.Context(), &MockNsGsServer{
+		ScheduleDownlinkFunc: func(ctx context.Context, msg *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error) {
+			select {
+			case scheduleDownlinkCh <- msg:
+			default:
+			}
+			return &ttnpb.ScheduleDownlinkResponse{
+				Delay: time.Second,
+			}, nil
+		},
+	}))
+	defer fNS.Close()
+	fNS.RegisterInterop(fInterop)
+
+	sDevices, closeSDevices := NewBoltDeviceRegistry(t)
+	defer closeSDevices()
+	sApplicationUplinks, closeSApplicationUplinks := NewBoltApplicationUplinkQueue(t)
+	defer closeSApplicationUplinks()
+	sDownlinkTasks, closeSDownlinkTasks := NewBoltDownlinkTaskQueue(t)
+	defer closeSDownlinkTasks()
+
+	sNS := newNetworkServer(&Config{
+		NetID:              sNetID,
+		Devices:            sDevices,
+		ApplicationUplinks: sApplicationUplinks,
+		DownlinkTasks:      sDownlinkTasks,
+		Interop: config.InteropClient{
+			Directory: sInteropDir,
+		},
+	}, nil)
+	defer sNS.Close()
+	sNS.RegisterInterop(sInterop)
+
+	ctx := clusterauth.NewContext(test.Context(), nil)
+
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}
+	devID := "test-dev-id"
+	devAddr := types.DevAddr{0x26, 0x01, 0x02, 0x03}
+	fNwkSIntKey := types.AES128Key{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	_, _, err := sDevices.SetByID(ctx, appID, devID, nil, func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appID,
+				DeviceID:               devID,
+				DevAddr:                &devAddr,
+			},
+			FrequencyPlanID:   test.EUFrequencyPlanID,
+			LoRaWANVersion:    ttnpb.MAC_V1_0_2,
+			LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+			MACState:          MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_0_2, ttnpb.PHY_V1_0_2_REV_B),
+			Session: &ttnpb.Session{
+				DevAddr: devAddr,
+				SessionKeys: ttnpb.SessionKeys{
+					SessionKeyID: []byte("test-session-key-id"),
+					FNwkSIntKey:  &ttnpb.KeyEnvelope{Key: &fNwkSIntKey},
+					SNwkSIntKey:  &ttnpb.KeyEnvelope{Key: &fNwkSIntKey},
+					NwkSEncKey:   &ttnpb.KeyEnvelope{Key: &fNwkSIntKey},
+				},
+				StartedAt: time.Now(),
+			},
+		}, []string{
+			"frequency_plan_id",
+			"ids.application_ids",
+			"ids.dev_addr",
+			"ids.device_id",
+			"lorawan_phy_version",
+			"lorawan_version",
+			"mac_state",
+			"session",
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	gtwIDs := ttnpb.GatewayIdentifiers{GatewayID: "test-gtw-id"}
+	uplinkToken := test.Must((&ttnpb.UplinkToken{
+		GatewayAntennaIdentifiers: ttnpb.GatewayAntennaIdentifiers{
+			GatewayIdentifiers: gtwIDs,
+		},
+		Timestamp: 42,
+	}).Marshal()).([]byte)
+
+	// The forwarding Network Server does not know the device, so it forwards the uplink with a PRStartReq message.
+	// The serving Network Server handles the uplink before it returns a PRStartAns message.
+	_, err = fNS.HandleUplink(ctx, &ttnpb.UplinkMessage{
+		RawPayload: MustAppendLegacyUplinkMIC(
+			fNwkSIntKey,
+			devAddr,
+			1,
+			/* MHDR */
+			0b100_000_00,
+			/* MACPayload */
+			/** FHDR **/
+			/*** DevAddr ***/
+			devAddr[3], devAddr[2], devAddr[1], devAddr[0],
+			/*** FCtrl ***/
+			0b0_0_0_0_0000,
+			/*** FCnt ***/
+			0x01, 0x00,
+		),
+		Settings: ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+					Bandwidth:       125000,
+					SpreadingFactor: 7,
+				}},
+			},
+			EnableCRC: true,
+			Frequency: 868100000,
+			Timestamp: 42,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: gtwIDs,
+				RSSI:               -42,
+				SNR:                4.2,
+				Timestamp:          42,
+				UplinkToken:        uplinkToken,
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	dev, _, err := sDevices.GetByID(ctx, appID, devID, []string{
+		"mac_state.recent_uplinks",
+		"session.last_f_cnt_up",
+	})
+	if a.So(err, should.BeNil) && a.So(dev.MACState.RecentUplinks, should.HaveLength, 1) {
+		a.So(dev.Session.LastFCntUp, should.Equal, 1)
+		up := dev.MACState.RecentUplinks[0]
+		if a.So(up.RxMetadata, should.HaveLength, 1) {
+			a.So(up.RxMetadata[0].GatewayIdentifiers.GatewayID, should.Equal, "roaming-000001")
+		}
+	}
+
+	// The serving Network Server acknowledges the confirmed uplink with a XmitDataReq message. The forwarding Network
+	// Server schedules the downlink on the gateway identified by the uplink token.
+	select {
+	case <-time.After((1 << 12) * test.Delay):
+		t.Fatal("Timed out while waiting for downlink to be scheduled")
+
+	case msg := <-scheduleDownlinkCh:
+		req := msg.GetRequest()
+		if a.So(req, should.NotBeNil) && a.So(req.DownlinkPaths, should.HaveLength, 1) {
+			a.So(req.DownlinkPaths[0].GetUplinkToken(), should.Resemble, uplinkToken)
+			a.So(req.Class, should.Equal, ttnpb.CLASS_A)
+			a.So(req.Rx1Delay, should.Equal, ttnpb.RX_DELAY_1)
+		}
+		var pld ttnpb.Message
+		if a.So(lorawan.UnmarshalMessage(msg.RawPayload, &pld), should.BeNil) {
+			a.So(pld.MType, should.Equal, ttnpb.MType_UNCONFIRMED_DOWN)
+			macPld := pld.GetMACPayload()
+			if a.So(macPld, should.NotBeNil) {
+				a.So(macPld.DevAddr, should.Equal, devAddr)
+				a.So(macPld.FCtrl.Ack, should.BeTrue)
+			}
+		}
+	}
+}
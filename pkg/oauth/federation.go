@@ -0,0 +1,286 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/oauth/oidc"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/web/cookie"
+)
+
+const oidcStateCookieName = "_oidc_state"
+
+func (s *server) oidcStateCookie() *cookie.Cookie {
+	return &cookie.Cookie{
+		Name:     oidcStateCookieName,
+		Path:     s.config.UI.MountPath(),
+		MaxAge:   10 * time.Minute,
+		HTTPOnly: true,
+	}
+}
+
+// oidcState is the state of a login with an upstream OpenID Connect provider.
+type oidcState struct {
+	Provider string
+	Secret   string
+	Nonce    string
+	Next     string
+}
+
+var (
+	errOIDCProviderNotFound = errors.DefineNotFound("oidc_provider_not_found", "OpenID Connect provider `{provider}` not found")
+	errOIDCRefused          = errors.DefinePermissionDenied("oidc_refused", "refused by OpenID Connect provider", "reason")
+	errOIDCState            = errors.DefinePermissionDenied("oidc_state", "invalid OpenID Connect state")
+	errOIDCUserNotFound     = errors.DefinePermissionDenied("oidc_user_not_found", "no user linked to account of OpenID Connect provider `{provider}`")
+	errOIDCUserID           = errors.DefineInvalidArgument("oidc_user_id", "no valid user ID in claims of OpenID Connect provider `{provider}`")
+	errOIDCRight            = errors.DefineInvalidArgument("oidc_right", "invalid organization right `{right}`")
+)
+
+func (s *server) oidcProvider(c echo.Context) (string, *oidc.Provider, error) {
+	id := c.Param("provider")
+	provider, ok := s.oidcProviders[id]
+	if !ok {
+		return "", nil, errOIDCProviderNotFound.WithAttributes("provider", id)
+	}
+	return id, provider, nil
+}
+
+// OIDCLogin redirects the user to the upstream OpenID Connect provider to log in.
+// If the user is already logged in, the account of the provider is linked to the user.
+func (s *server) OIDCLogin(c echo.Context) error {
+	id, provider, err := s.oidcProvider(c)
+	if err != nil {
+		return err
+	}
+	next := c.QueryParam(nextKey)
+	// Only allow relative paths.
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") {
+		next = ""
+	}
+	state := oidcState{
+		Provider: id,
+		Secret:   random.String(16),
+		Nonce:    random.String(16),
+		Next:     next,
+	}
+	authCodeURL, err := provider.AuthCodeURL(c.Request().Context(), state.Secret, state.Nonce)
+	if err != nil {
+		return err
+	}
+	if err = s.oidcStateCookie().Set(c, state); err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusFound, authCodeURL)
+}
+
+// OIDCCallback handles the callback of the upstream OpenID Connect provider.
+// It logs in the user that is linked to the account of the provider.
+func (s *server) OIDCCallback(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, provider, err := s.oidcProvider(c)
+	if err != nil {
+		return err
+	}
+	if e := c.QueryParam("error"); e != "" {
+		return errOIDCRefused.WithAttributes("reason", c.QueryParam("error_description"))
+	}
+	var state oidcState
+	ok, err := s.oidcStateCookie().Get(c, &state)
+	if err != nil {
+		return err
+	}
+	s.oidcStateCookie().Remove(c)
+	if !ok || state.Provider != id || subtle.ConstantTimeCompare([]byte(state.Secret), []byte(c.QueryParam("state"))) != 1 {
+		return errOIDCState
+	}
+	claims, err := provider.Exchange(ctx, c.QueryParam("code"), state.Nonce)
+	if err != nil {
+		return err
+	}
+	config := s.configFromContext(ctx)
+	userIDs, err := s.oidcUser(c, config, id, claims)
+	if err != nil {
+		return err
+	}
+	if err = s.oidcMemberships(ctx, config, *userIDs, claims); err != nil {
+		return err
+	}
+	next := state.Next
+	if next == "" {
+		next = s.config.UI.MountPath()
+	}
+	user, err := s.store.GetUser(ctx, userIDs, &types.FieldMask{Paths: []string{"totp_enabled_at"}})
+	if err != nil {
+		return err
+	}
+	if user.TOTPEnabledAt != nil {
+		// The user completes the login with the two-factor authentication code on the login page.
+		if err = s.totpCookie().Set(c, totpLogin{UserID: userIDs.UserID}); err != nil {
+			return err
+		}
+		values := make(url.Values)
+		values.Set(nextKey, next)
+		return c.Redirect(http.StatusFound, fmt.Sprintf("%s?%s", path.Join(s.config.UI.MountPath(), "login"), values.Encode()))
+	}
+	if err = s.createSession(c, *userIDs); err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusFound, next)
+}
+
+// oidcUser returns the user that is linked to the account of the provider.
+// If no user is linked yet, the account is linked to the user that is logged in,
+// or to a new user if creating users is enabled.
+func (s *server) oidcUser(c echo.Context, config *Config, provider string, claims *oidc.Claims) (*ttnpb.UserIdentifiers, error) {
+	ctx := c.Request().Context()
+	userIDs, err := s.store.GetExternalUser(ctx, provider, claims.Subject)
+	if err == nil {
+		return userIDs, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if session, err := s.getSession(c); err == nil {
+		userIDs = &session.UserIdentifiers
+	} else {
+		if !config.OIDC.CreateUsers {
+			return nil, errOIDCUserNotFound.WithAttributes("provider", provider)
+		}
+		if userIDs, err = s.createOIDCUser(ctx, provider, claims); err != nil {
+			return nil, err
+		}
+	}
+	if err = s.store.CreateExternalUser(ctx, userIDs, provider, claims.Subject); err != nil {
+		return nil, err
+	}
+	events.Publish(evtUserLink(ctx, userIDs, nil))
+	return userIDs, nil
+}
+
+var invalidUserIDChars = regexp.MustCompile("[^a-z0-9]+")
+
+// oidcUserID returns a user ID that is derived from the preferred username or the email address in the claims.
+func oidcUserID(ctx context.Context, claims *oidc.Claims) (string, bool) {
+	candidates := []string{claims.PreferredUsername}
+	if i := strings.Index(claims.Email, "@"); i > 0 {
+		candidates = append(candidates, claims.Email[:i])
+	}
+	for _, candidate := range candidates {
+		id := strings.Trim(invalidUserIDChars.ReplaceAllString(strings.ToLower(candidate), "-"), "-")
+		if len(id) > 36 {
+			id = strings.TrimRight(id[:36], "-")
+		}
+		ids := &ttnpb.UserIdentifiers{UserID: id}
+		if err := ids.ValidateContext(ctx); err == nil {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// maxOIDCUserIDAttempts is the number of user IDs that are tried when creating a user.
+const maxOIDCUserIDAttempts = 10
+
+// createOIDCUser creates a user with the ID that is derived from the claims. If that user ID is taken, a numeric
+// suffix is added to the user ID. The state of the user is set by the store.
+func (s *server) createOIDCUser(ctx context.Context, provider string, claims *oidc.Claims) (*ttnpb.UserIdentifiers, error) {
+	id, ok := oidcUserID(ctx, claims)
+	if !ok {
+		return nil, errOIDCUserID.WithAttributes("provider", provider)
+	}
+	usr := &ttnpb.User{
+		UserIdentifiers:     ttnpb.UserIdentifiers{UserID: id},
+		Name:                claims.Name,
+		PrimaryEmailAddress: claims.Email,
+	}
+	if claims.EmailVerified {
+		now := time.Now()
+		usr.PrimaryEmailAddressValidatedAt = &now
+	}
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			suffix := "-" + strconv.Itoa(attempt)
+			base := id
+			if len(base)+len(suffix) > 36 {
+				base = strings.TrimRight(base[:36-len(suffix)], "-")
+			}
+			usr.UserID = base + suffix
+		}
+		created, err := s.store.CreateUser(ctx, usr)
+		if err == nil {
+			events.Publish(evtUserCreate(ctx, created.UserIdentifiers, nil))
+			return &created.UserIdentifiers, nil
+		}
+		if !errors.IsAlreadyExists(err) || attempt == maxOIDCUserIDAttempts {
+			return nil, err
+		}
+	}
+}
+
+// oidcMemberships adds the user to the organizations of the groups in the claims.
+// Existing memberships are not changed, and memberships are not removed.
+func (s *server) oidcMemberships(ctx context.Context, config *Config, userIDs ttnpb.UserIdentifiers, claims *oidc.Claims) error {
+	if config.OIDC.OrganizationsClaim == "" || len(config.OIDC.Organizations) == 0 {
+		return nil
+	}
+	rights := &ttnpb.Rights{}
+	for _, name := range config.OIDC.OrganizationRights {
+		var right ttnpb.Right
+		if err := right.UnmarshalText([]byte(name)); err != nil {
+			return errOIDCRight.WithCause(err).WithAttributes("right", name)
+		}
+		rights.Rights = append(rights.Rights, right)
+	}
+	memberIDs := userIDs.OrganizationOrUserIdentifiers()
+	for _, group := range claims.Strings(config.OIDC.OrganizationsClaim) {
+		orgID, ok := config.OIDC.Organizations[group]
+		if !ok {
+			continue
+		}
+		orgIDs := ttnpb.OrganizationIdentifiers{OrganizationID: orgID}
+		_, err := s.store.GetMember(ctx, memberIDs, orgIDs)
+		if err == nil {
+			continue
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if err = s.store.SetMember(ctx, memberIDs, orgIDs, rights); err != nil {
+			if errors.IsNotFound(err) {
+				log.FromContext(ctx).WithError(err).WithField("organization_id", orgID).Warn("Failed to add user to organization")
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/oauth"
+	"go.thethings.network/lorawan-stack/pkg/oauth/oidc/oidctest"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/webui"
+	"golang.org/x/net/publicsuffix"
+)
+
+func TestOIDCLogin(t *testing.T) {
+	ctx := test.Context()
+	store := &mockStore{}
+
+	issuer, err := oidctest.NewIssuer("client", "secret")
+	if err != nil {
+		t.Fatalf("Failed to start issuer: %v", err)
+	}
+	defer issuer.Close()
+	issuer.SetClaims(map[string]interface{}{
+		"sub":                "subject",
+		"email":              "sso-user@example.com",
+		"email_verified":     true,
+		"name":               "SSO User",
+		"preferred_username": "SSO.User",
+		"groups":             []string{"admins", "unknown"},
+	})
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+		},
+	})
+	s := oauth.NewServer(ctx, store, oauth.Config{
+		Mount: "/oauth",
+		UI: oauth.UIConfig{
+			TemplateData: webui.TemplateData{
+				SiteName:     "The Things Network",
+				Title:        "OAuth",
+				CanonicalURL: "https://example.com/oauth",
+			},
+		},
+		OIDC: oauth.OIDCConfig{
+			Issuers:            map[string]string{"sso": issuer.URL},
+			ClientIDs:          map[string]string{"sso": "client"},
+			ClientSecrets:      map[string]string{"sso": "secret"},
+			CreateUsers:        true,
+			OrganizationsClaim: "groups",
+			Organizations:      map[string]string{"admins": "admins-org"},
+			OrganizationRights: []string{"RIGHT_ORGANIZATION_INFO"},
+		},
+	})
+	c.RegisterWeb(s)
+	componenttest.StartComponent(t, c)
+
+	issuerClient := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	if err != nil {
+		panic(err)
+	}
+	doRequest := func(method, path string, body io.Reader) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, body)
+		req.URL.Scheme, req.URL.Host = "http", req.Host
+		for _, c := range jar.Cookies(req.URL) {
+			req.AddCookie(c)
+			if c.Name == "_csrf" {
+				req.Header.Set("X-CSRF-Token", c.Value)
+			}
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, req)
+		if cookies := res.Result().Cookies(); len(cookies) > 0 {
+			jar.SetCookies(req.URL, cookies)
+		}
+		return res
+	}
+	do := func(path string) *httptest.ResponseRecorder {
+		return doRequest(http.MethodGet, path, nil)
+	}
+	// login logs in at the OpenID Connect provider, and returns the path of the callback.
+	login := func(t *testing.T) string {
+		a := assertions.New(t)
+		res := do("/oauth/login/sso?n=" + url.QueryEscape("/oauth/authorize?client_id=client"))
+		if !a.So(res.Code, should.Equal, http.StatusFound) {
+			t.FailNow()
+		}
+		location := res.Header().Get("Location")
+		a.So(location, should.StartWith, issuer.URL+"/authorize")
+		authorizeRes, err := issuerClient.Get(location)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		authorizeRes.Body.Close()
+		callback, err := url.Parse(authorizeRes.Header.Get("Location"))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(callback.Host, should.Equal, "example.com")
+		a.So(callback.Path, should.Equal, "/oauth/login/sso/callback")
+		return callback.RequestURI()
+	}
+
+	t.Run("UnknownProvider", func(t *testing.T) {
+		a := assertions.New(t)
+		store.reset()
+		res := do("/oauth/login/unknown")
+		a.So(res.Code, should.Equal, http.StatusNotFound)
+	})
+
+	t.Run("InvalidState", func(t *testing.T) {
+		a := assertions.New(t)
+		store.reset()
+		callback := login(t)
+		res := do(strings.Replace(callback, "state=", "state=invalid", 1))
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(store.calls, should.BeEmpty)
+	})
+
+	t.Run("Refused", func(t *testing.T) {
+		a := assertions.New(t)
+		store.reset()
+		res := do("/oauth/login/sso/callback?error=access_denied")
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(store.calls, should.BeEmpty)
+	})
+
+	t.Run("CreateUser", func(t *testing.T) {
+		a := assertions.New(t)
+		store.reset()
+		store.err.getExternalUser = mockErrNotFound
+		store.err.getMember = mockErrNotFound
+		store.res.user = &ttnpb.User{UserIdentifiers: ttnpb.UserIdentifiers{UserID: "sso-user"}}
+		store.res.session = &ttnpb.UserSession{
+			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "sso-user"},
+			SessionID:       "session_id",
+		}
+		res := do(login(t))
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(res.Header().Get("Location"), should.Equal, "/oauth/authorize?client_id=client")
+		a.So(store.calls, should.Resemble, []string{
+			"GetExternalUser", "CreateUser", "CreateExternalUser", "GetMember", "SetMember", "GetUser", "CreateSession",
+		})
+		a.So(store.req.provider, should.Equal, "sso")
+		a.So(store.req.externalID, should.Equal, "subject")
+		if a.So(store.req.user, should.NotBeNil) {
+			a.So(store.req.user.UserID, should.Equal, "sso-user")
+			a.So(store.req.user.Name, should.Equal, "SSO User")
+			a.So(store.req.user.PrimaryEmailAddress, should.Equal, "sso-user@example.com")
+			a.So(store.req.user.PrimaryEmailAddressValidatedAt, should.NotBeNil)
+			a.So(store.req.user.State, should.Equal, ttnpb.STATE_REQUESTED)
+		}
+		if a.So(store.req.memberIDs, should.NotBeNil) {
+			a.So(store.req.memberIDs.GetUserIDs().GetUserID(), should.Equal, "sso-user")
+		}
+		if a.So(store.req.entityIDs, should.NotBeNil) {
+			a.So(store.req.entityIDs.IDString(), should.Equal, "admins-org")
+		}
+		a.So(store.req.rights, should.Resemble, ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_INFO))
+	})
+
+	t.Run("ExistingUser", func(t *testing.T) {
+		a := assertions.New(t)
+		jar, _ = cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+		store.reset()
+		store.res.externalUser = &ttnpb.UserIdentifiers{UserID: "sso-user"}
+		store.res.memberRights = ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL)
+		store.res.user = &ttnpb.User{UserIdentifiers: ttnpb.UserIdentifiers{UserID: "sso-user"}}
+		store.res.session = &ttnpb.UserSession{
+			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "sso-user"},
+			SessionID:       "session_id",
+		}
+		res := do(login(t))
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(store.calls, should.Resemble, []string{
+			"GetExternalUser", "GetMember", "GetUser", "CreateSession",
+		})
+	})
+
+	t.Run("UserIDTaken", func(t *testing.T) {
+		a := assertions.New(t)
+		jar, _ = cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+		store.reset()
+		store.err.getExternalUser = mockErrNotFound
+		store.res.memberRights = ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL)
+		store.res.takenUserIDs = []string{"sso-user", "sso-user-2"}
+		store.res.user = &ttnpb.User{UserIdentifiers: ttnpb.UserIdentifiers{UserID: "sso-user-3"}}
+		store.res.session = &ttnpb.UserSession{
+			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "sso-user-3"},
+			SessionID:       "session_id",
+		}
+		res := do(login(t))
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(store.calls, should.Resemble, []string{
+			"GetExternalUser", "CreateUser", "CreateUser", "CreateUser", "CreateExternalUser", "GetMember", "GetUser", "CreateSession",
+		})
+		if a.So(store.req.userIDs, should.NotBeNil) {
+			a.So(store.req.userIDs.UserID, should.Equal, "sso-user-3")
+		}
+	})
+
+	t.Run("TOTP", func(t *testing.T) {
+		a := assertions.New(t)
+		jar, _ = cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+		store.reset()
+		store.res.externalUser = &ttnpb.UserIdentifiers{UserID: "user"}
+		store.res.memberRights = ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL)
+		store.res.user = mockTOTPUser
+		store.res.session = mockSession
+
+		loginTOTP := func(code string) *httptest.ResponseRecorder {
+			body, err := json.Marshal(map[string]string{"totp_code": code})
+			if err != nil {
+				panic(err)
+			}
+			return doRequest(http.MethodPost, "/oauth/api/auth/login/totp", strings.NewReader(string(body)))
+		}
+
+		res := do(login(t))
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(res.Header().Get("Location"), should.Equal, "/oauth/login?n="+url.QueryEscape("/oauth/authorize?client_id=client"))
+		a.So(store.calls, should.Resemble, []string{
+			"GetExternalUser", "GetMember", "GetUser",
+		})
+
+		res = do("/oauth/api/me")
+		a.So(res.Code, should.Equal, http.StatusUnauthorized)
+
+		store.calls = nil
+		res = loginTOTP("000000x")
+		a.So(res.Code, should.Equal, http.StatusBadRequest)
+		a.So(store.calls, should.NotContain, "CreateSession")
+
+		store.calls = nil
+		res = loginTOTP(mockTOTPCode())
+		a.So(res.Code, should.Equal, http.StatusNoContent)
+		a.So(store.calls, should.Contain, "CreateSession")
+		if a.So(store.req.session, should.NotBeNil) {
+			a.So(store.req.session.UserID, should.Equal, "user")
+		}
+
+		// The pending login is completed.
+		res = loginTOTP(mockTOTPCode())
+		a.So(res.Code, should.Equal, http.StatusUnauthorized)
+	})
+
+	t.Run("LinkUser", func(t *testing.T) {
+		a := assertions.New(t)
+		store.reset()
+		store.err.getExternalUser = mockErrNotFound
+		store.res.memberRights = ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL)
+		store.res.user = mockUser
+		store.res.session = mockSession
+		res := do(login(t))
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(store.calls, should.Resemble, []string{
+			"GetExternalUser", "GetSession", "CreateExternalUser", "GetMember", "GetUser", "CreateSession",
+		})
+		if a.So(store.req.userIDs, should.NotBeNil) {
+			a.So(store.req.userIDs.UserID, should.Equal, "user")
+		}
+	})
+}
@@ -28,6 +28,14 @@ var (
 		"oauth.user.login_failed", "login user failure",
 		ttnpb.RIGHT_USER_ALL,
 	)
+	evtUserCreate = events.Define(
+		"oauth.user.create", "create user from external account",
+		ttnpb.RIGHT_USER_ALL,
+	)
+	evtUserLink = events.Define(
+		"oauth.user.link", "link user to external account",
+		ttnpb.RIGHT_USER_ALL,
+	)
 	evtUserLogout = events.Define(
 		"oauth.user.logout", "logout user",
 		ttnpb.RIGHT_USER_ALL,
@@ -0,0 +1,287 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oidc implements logging in with upstream OpenID Connect providers.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"golang.org/x/oauth2"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// ScopeOpenID is the scope that is always requested from OpenID Connect providers.
+const ScopeOpenID = "openid"
+
+// Config is the configuration of an upstream OpenID Connect provider.
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// Claims are the claims of an ID token.
+type Claims struct {
+	Subject           string
+	Nonce             string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+
+	// Raw contains all claims of the ID token.
+	Raw map[string]interface{}
+}
+
+// String returns the string value of the claim with the given name.
+func (c *Claims) String(name string) string {
+	s, _ := c.Raw[name].(string)
+	return s
+}
+
+// Strings returns the string values of the claim with the given name.
+// A claim can contain a single string or a list of strings.
+func (c *Claims) Strings(name string) []string {
+	switch v := c.Raw[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		res := make([]string, 0, len(v))
+		for _, v := range v {
+			if s, ok := v.(string); ok {
+				res = append(res, s)
+			}
+		}
+		return res
+	default:
+		return nil
+	}
+}
+
+func newClaims(raw map[string]interface{}) *Claims {
+	c := &Claims{Raw: raw}
+	c.Subject = c.String("sub")
+	c.Nonce = c.String("nonce")
+	c.Email = c.String("email")
+	c.Name = c.String("name")
+	c.PreferredUsername = c.String("preferred_username")
+	// Some providers encode email_verified as string.
+	switch v := raw["email_verified"].(type) {
+	case bool:
+		c.EmailVerified = v
+	case string:
+		c.EmailVerified = v == "true"
+	}
+	return c
+}
+
+// Provider is an upstream OpenID Connect provider.
+// The provider configuration is discovered when the provider is used for the first time.
+type Provider struct {
+	config     Config
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	discovery *discovery
+	keys      *jose.JSONWebKeySet
+}
+
+// NewProvider returns a new upstream OpenID Connect provider.
+func NewProvider(config Config) *Provider {
+	return &Provider{
+		config:     config,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// discovery is the OpenID Connect provider metadata.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+var (
+	errDiscovery      = errors.DefineUnavailable("discovery", "discover OpenID Connect provider `{issuer}`")
+	errIssuerMismatch = errors.DefineInvalidArgument("issuer_mismatch", "issuer `{got}` does not match configured issuer `{expected}`")
+	errKeys           = errors.DefineUnavailable("keys", "fetch keys of OpenID Connect provider `{issuer}`")
+	errStatus         = errors.DefineUnavailable("status", "unexpected response status `{status}`")
+	errExchange       = errors.DefinePermissionDenied("exchange", "token exchange refused")
+	errNoIDToken      = errors.DefinePermissionDenied("no_id_token", "no ID token in token response")
+	errIDToken        = errors.DefinePermissionDenied("id_token", "invalid ID token")
+	errNoKey          = errors.DefinePermissionDenied("no_key", "no key found to verify ID token")
+	errNoExpiry       = errors.DefinePermissionDenied("no_expiry", "ID token does not expire")
+	errNoSubject      = errors.DefinePermissionDenied("no_subject", "ID token has no subject")
+	errNonce          = errors.DefinePermissionDenied("nonce", "ID token nonce does not match")
+)
+
+func (p *Provider) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := p.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errStatus.WithAttributes("status", res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+func (p *Provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	issuer := strings.TrimSuffix(p.config.Issuer, "/")
+	var d discovery
+	if err := p.getJSON(ctx, issuer+"/.well-known/openid-configuration", &d); err != nil {
+		return nil, errDiscovery.WithCause(err).WithAttributes("issuer", p.config.Issuer)
+	}
+	if strings.TrimSuffix(d.Issuer, "/") != issuer {
+		return nil, errIssuerMismatch.WithAttributes("got", d.Issuer, "expected", p.config.Issuer)
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// getKeys returns the keys of the provider with the given key ID.
+// The keys are fetched again if no key is found, so that keys can be rotated by the provider.
+func (p *Provider) getKeys(ctx context.Context, d *discovery, keyID string) ([]jose.JSONWebKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	find := func() []jose.JSONWebKey {
+		if p.keys == nil {
+			return nil
+		}
+		if keyID == "" {
+			return p.keys.Keys
+		}
+		return p.keys.Key(keyID)
+	}
+	if keys := find(); len(keys) > 0 {
+		return keys, nil
+	}
+	var keys jose.JSONWebKeySet
+	if err := p.getJSON(ctx, d.JWKSURI, &keys); err != nil {
+		return nil, errKeys.WithCause(err).WithAttributes("issuer", p.config.Issuer)
+	}
+	p.keys = &keys
+	return find(), nil
+}
+
+func (p *Provider) oauth2(d *discovery) *oauth2.Config {
+	scopes := append([]string{ScopeOpenID}, p.config.Scopes...)
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  d.AuthorizationEndpoint,
+			TokenURL: d.TokenEndpoint,
+		},
+		Scopes: scopes,
+	}
+}
+
+// AuthCodeURL returns the URL of the authorization endpoint of the provider,
+// where the user is redirected to in order to log in.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	d, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return p.oauth2(d).AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce)), nil
+}
+
+// Exchange exchanges the authorization code for tokens, and returns the claims
+// of the verified ID token. The nonce must match the nonce of the authorization request.
+func (p *Provider) Exchange(ctx context.Context, code, nonce string) (*Claims, error) {
+	d, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := p.oauth2(d).Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.httpClient), code)
+	if err != nil {
+		return nil, errExchange.WithCause(err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errNoIDToken
+	}
+	return p.verify(ctx, d, rawIDToken, nonce)
+}
+
+func (p *Provider) verify(ctx context.Context, d *discovery, rawIDToken, nonce string) (*Claims, error) {
+	idToken, err := jwt.ParseSigned(rawIDToken)
+	if err != nil {
+		return nil, errIDToken.WithCause(err)
+	}
+	var keyID string
+	if len(idToken.Headers) > 0 {
+		keyID = idToken.Headers[0].KeyID
+	}
+	keys, err := p.getKeys(ctx, d, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, errNoKey
+	}
+	var (
+		standardClaims jwt.Claims
+		rawClaims      map[string]interface{}
+	)
+	for _, key := range keys {
+		if err = idToken.Claims(key, &standardClaims, &rawClaims); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, errIDToken.WithCause(err)
+	}
+	if standardClaims.Expiry == nil {
+		return nil, errNoExpiry
+	}
+	if err = standardClaims.Validate(jwt.Expected{
+		Issuer:   d.Issuer,
+		Audience: jwt.Audience{p.config.ClientID},
+		Time:     time.Now(),
+	}); err != nil {
+		return nil, errIDToken.WithCause(err)
+	}
+	claims := newClaims(rawClaims)
+	if claims.Subject == "" {
+		return nil, errNoSubject
+	}
+	if claims.Nonce != nonce {
+		return nil, errNonce
+	}
+	return claims, nil
+}
@@ -0,0 +1,146 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/oauth/oidc"
+	"go.thethings.network/lorawan-stack/pkg/oauth/oidc/oidctest"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+const redirectURL = "http://localhost/oauth/login/sso/callback"
+
+func authorize(t *testing.T, authCodeURL string) (code, state string) {
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	res, err := client.Get(authCodeURL)
+	if err != nil {
+		t.Fatalf("Failed to authorize: %v", err)
+	}
+	res.Body.Close()
+	location, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("Failed to parse redirect location: %v", err)
+	}
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func TestProvider(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	issuer, err := oidctest.NewIssuer("client", "secret")
+	if err != nil {
+		t.Fatalf("Failed to start issuer: %v", err)
+	}
+	defer issuer.Close()
+
+	issuer.SetClaims(map[string]interface{}{
+		"sub":                "subject",
+		"email":              "user@example.com",
+		"email_verified":     true,
+		"name":               "Test User",
+		"preferred_username": "test-user",
+		"groups":             []string{"admins", "developers"},
+	})
+
+	provider := NewProvider(Config{
+		Issuer:       issuer.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"email", "profile"},
+	})
+
+	authCodeURL, err := provider.AuthCodeURL(ctx, "state", "nonce")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if u, err := url.Parse(authCodeURL); a.So(err, should.BeNil) {
+		a.So(u.Query().Get("scope"), should.Equal, "openid email profile")
+		a.So(u.Query().Get("redirect_uri"), should.Equal, redirectURL)
+		a.So(u.Query().Get("nonce"), should.Equal, "nonce")
+	}
+
+	t.Run("Exchange", func(t *testing.T) {
+		a := assertions.New(t)
+		code, state := authorize(t, authCodeURL)
+		a.So(state, should.Equal, "state")
+		claims, err := provider.Exchange(ctx, code, "nonce")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(claims.Subject, should.Equal, "subject")
+		a.So(claims.Email, should.Equal, "user@example.com")
+		a.So(claims.EmailVerified, should.BeTrue)
+		a.So(claims.Name, should.Equal, "Test User")
+		a.So(claims.PreferredUsername, should.Equal, "test-user")
+		a.So(claims.Strings("groups"), should.Resemble, []string{"admins", "developers"})
+		a.So(claims.Strings("name"), should.Resemble, []string{"Test User"})
+		a.So(claims.Strings("unknown"), should.BeEmpty)
+
+		// Authorization codes can be used only once.
+		_, err = provider.Exchange(ctx, code, "nonce")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("WrongNonce", func(t *testing.T) {
+		a := assertions.New(t)
+		code, _ := authorize(t, authCodeURL)
+		_, err := provider.Exchange(ctx, code, "other-nonce")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("WrongClient", func(t *testing.T) {
+		a := assertions.New(t)
+		code, _ := authorize(t, authCodeURL)
+		_, err := NewProvider(Config{
+			Issuer:       issuer.URL,
+			ClientID:     "client",
+			ClientSecret: "wrong-secret",
+			RedirectURL:  redirectURL,
+		}).Exchange(ctx, code, "nonce")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("NoSubject", func(t *testing.T) {
+		a := assertions.New(t)
+		issuer.SetClaims(map[string]interface{}{
+			"email": "user@example.com",
+		})
+		code, _ := authorize(t, authCodeURL)
+		_, err := provider.Exchange(ctx, code, "nonce")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("WrongIssuer", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := NewProvider(Config{
+			Issuer:   issuer.URL + "/other",
+			ClientID: "client",
+		}).AuthCodeURL(ctx, "state", "nonce")
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+	})
+}
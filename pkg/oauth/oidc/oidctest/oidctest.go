@@ -0,0 +1,211 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oidctest implements an in-process OpenID Connect provider for testing.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/random"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const keyID = "test"
+
+// Issuer is an in-process OpenID Connect provider.
+// The authorization endpoint does not require interaction: users are logged in
+// with the claims that are set with SetClaims.
+type Issuer struct {
+	*httptest.Server
+
+	clientID     string
+	clientSecret string
+
+	signer jose.Signer
+	keys   jose.JSONWebKeySet
+
+	mu     sync.Mutex
+	claims map[string]interface{}
+	codes  map[string]authorization
+}
+
+type authorization struct {
+	redirectURI string
+	nonce       string
+	claims      map[string]interface{}
+}
+
+// NewIssuer starts a new OpenID Connect provider for the OAuth client with the given ID and secret.
+// The issuer must be closed after use.
+func NewIssuer(clientID, clientSecret string) (*Issuer, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", keyID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	iss := &Issuer{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		signer:       signer,
+		keys: jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{{
+				Key:       &key.PublicKey,
+				KeyID:     keyID,
+				Algorithm: string(jose.RS256),
+				Use:       "sig",
+			}},
+		},
+		codes: make(map[string]authorization),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", iss.handleDiscovery)
+	mux.HandleFunc("/keys", iss.handleKeys)
+	mux.HandleFunc("/authorize", iss.handleAuthorize)
+	mux.HandleFunc("/token", iss.handleToken)
+	iss.Server = httptest.NewServer(mux)
+	return iss, nil
+}
+
+// SetClaims sets the claims of the ID tokens of the next authorizations.
+// The issuer sets the iss, aud, exp, iat and nonce claims.
+func (iss *Issuer) SetClaims(claims map[string]interface{}) {
+	iss.mu.Lock()
+	iss.claims = claims
+	iss.mu.Unlock()
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	writeJSON(w, status, map[string]string{"error": code})
+}
+
+func (iss *Issuer) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                iss.URL,
+		"authorization_endpoint":                iss.URL + "/authorize",
+		"token_endpoint":                        iss.URL + "/token",
+		"jwks_uri":                              iss.URL + "/keys",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{string(jose.RS256)},
+	})
+}
+
+func (iss *Issuer) handleKeys(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, iss.keys)
+}
+
+func (iss *Issuer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("response_type") != "code" {
+		writeError(w, http.StatusBadRequest, "unsupported_response_type")
+		return
+	}
+	if query.Get("client_id") != iss.clientID {
+		writeError(w, http.StatusBadRequest, "unauthorized_client")
+		return
+	}
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		writeError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+	code := random.String(32)
+	iss.mu.Lock()
+	iss.codes[code] = authorization{
+		redirectURI: query.Get("redirect_uri"),
+		nonce:       query.Get("nonce"),
+		claims:      iss.claims,
+	}
+	iss.mu.Unlock()
+	values := redirectURI.Query()
+	values.Set("code", code)
+	values.Set("state", query.Get("state"))
+	redirectURI.RawQuery = values.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (iss *Issuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != iss.clientID || clientSecret != iss.clientSecret {
+		writeError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeError(w, http.StatusBadRequest, "unsupported_grant_type")
+		return
+	}
+	code := r.PostForm.Get("code")
+	iss.mu.Lock()
+	auth, ok := iss.codes[code]
+	delete(iss.codes, code)
+	iss.mu.Unlock()
+	if !ok || auth.redirectURI != r.PostForm.Get("redirect_uri") {
+		writeError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	now := time.Now()
+	claims := make(map[string]interface{}, len(auth.claims)+5)
+	for k, v := range auth.claims {
+		claims[k] = v
+	}
+	claims["iss"] = iss.URL
+	claims["aud"] = iss.clientID
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(time.Hour).Unix()
+	if auth.nonce != "" {
+		claims["nonce"] = auth.nonce
+	}
+	idToken, err := jwt.Signed(iss.signer).Claims(claims).CompactSerialize()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "server_error")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": random.String(32),
+		"token_type":   "Bearer",
+		"expires_in":   int(time.Hour.Seconds()),
+		"id_token":     idToken,
+	})
+}
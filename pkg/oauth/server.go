@@ -16,7 +16,9 @@ package oauth
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
@@ -27,6 +29,7 @@ import (
 	web_errors "go.thethings.network/lorawan-stack/pkg/errors/web"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/oauth/oidc"
 	"go.thethings.network/lorawan-stack/pkg/web"
 	"go.thethings.network/lorawan-stack/pkg/webui"
 )
@@ -36,10 +39,13 @@ type Server interface {
 	web.Registerer
 
 	Login(c echo.Context) error
+	LoginTOTP(c echo.Context) error
 	CurrentUser(c echo.Context) error
 	Logout(c echo.Context) error
 	Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc
 	Token(c echo.Context) error
+	OIDCLogin(c echo.Context) error
+	OIDCCallback(c echo.Context) error
 }
 
 type server struct {
@@ -47,6 +53,8 @@ type server struct {
 	config     Config
 	osinConfig *osin.ServerConfig
 	store      Store
+
+	oidcProviders map[string]*oidc.Provider
 }
 
 // Store used by the OAuth server.
@@ -58,6 +66,9 @@ type Store interface {
 	store.ClientStore
 	// OAuth is needed for OAuth authorizations.
 	store.OAuthStore
+	// ExternalUserStore and MembershipStore are needed for login with upstream OpenID Connect providers.
+	store.ExternalUserStore
+	store.MembershipStore
 }
 
 // UIConfig is the combined configuration for the OAuth UI.
@@ -73,14 +84,50 @@ type StackConfig struct {
 
 // FrontendConfig is the configuration for the OAuth frontend.
 type FrontendConfig struct {
-	Language    string `json:"language" name:"-"`
-	StackConfig `json:"stack_config" name:",squash"`
+	Language      string         `json:"language" name:"-"`
+	OIDCProviders []OIDCProvider `json:"oidc_providers" name:"-"`
+	StackConfig   `json:"stack_config" name:",squash"`
+}
+
+// OIDCProvider is an upstream OpenID Connect provider that users can log in with.
+type OIDCProvider struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// OIDCConfig is the configuration of the upstream OpenID Connect providers.
+// The providers are configured by provider ID.
+type OIDCConfig struct {
+	Issuers            map[string]string   `name:"issuers" description:"Issuer URLs of the upstream OpenID Connect providers"`
+	Names              map[string]string   `name:"names" description:"Display names of the upstream OpenID Connect providers"`
+	ClientIDs          map[string]string   `name:"client-ids" description:"OAuth client IDs at the upstream OpenID Connect providers"`
+	ClientSecrets      map[string]string   `name:"client-secrets" description:"OAuth client secrets at the upstream OpenID Connect providers" json:"-"`
+	Scopes             map[string][]string `name:"scopes" description:"Scopes to request from the upstream OpenID Connect providers in addition to openid"`
+	CreateUsers        bool                `name:"create-users" description:"Create users that log in with an upstream OpenID Connect provider for the first time"`
+	OrganizationsClaim string              `name:"organizations-claim" description:"ID token claim with the groups that are mapped to organization memberships"`
+	Organizations      map[string]string   `name:"organizations" description:"Organization IDs by group in the organizations claim"`
+	OrganizationRights []string            `name:"organization-rights" description:"Rights of users on the organizations of their groups"`
+}
+
+// Providers returns the configured upstream OpenID Connect providers, sorted by ID.
+func (c OIDCConfig) Providers() []OIDCProvider {
+	providers := make([]OIDCProvider, 0, len(c.Issuers))
+	for id := range c.Issuers {
+		name := c.Names[id]
+		if name == "" {
+			name = id
+		}
+		providers = append(providers, OIDCProvider{ID: id, Name: name})
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i].ID < providers[j].ID })
+	return providers
 }
 
 // Config is the configuration for the OAuth server.
 type Config struct {
-	Mount string   `name:"mount" description:"Path on the server where the OAuth server will be served"`
-	UI    UIConfig `name:"ui"`
+	Mount string     `name:"mount" description:"Path on the server where the OAuth server will be served"`
+	UI    UIConfig   `name:"ui"`
+	OIDC  OIDCConfig `name:"oidc"`
 }
 
 // NewServer returns a new OAuth server on top of the given store.
@@ -95,6 +142,17 @@ func NewServer(ctx context.Context, store Store, config Config) Server {
 		s.config.Mount = s.config.UI.MountPath()
 	}
 
+	s.oidcProviders = make(map[string]*oidc.Provider, len(s.config.OIDC.Issuers))
+	for id, issuer := range s.config.OIDC.Issuers {
+		s.oidcProviders[id] = oidc.NewProvider(oidc.Config{
+			Issuer:       issuer,
+			ClientID:     s.config.OIDC.ClientIDs[id],
+			ClientSecret: s.config.OIDC.ClientSecrets[id],
+			RedirectURL:  fmt.Sprintf("%s/login/%s/callback", strings.TrimSuffix(s.config.UI.CanonicalURL, "/"), id),
+			Scopes:       s.config.OIDC.Scopes[id],
+		})
+	}
+
 	s.osinConfig = &osin.ServerConfig{
 		AuthorizationExpiration: int32((5 * time.Minute).Seconds()),
 		AccessExpiration:        int32(time.Hour.Seconds()),
@@ -230,6 +288,7 @@ func (s *server) RegisterRoutes(server *web.Server) {
 				c.Set("template_data", config.UI.TemplateData)
 				frontendConfig := config.UI.FrontendConfig
 				frontendConfig.Language = config.UI.TemplateData.Language
+				frontendConfig.OIDCProviders = config.OIDC.Providers()
 				c.Set("app_config", struct {
 					FrontendConfig
 				}{
@@ -245,6 +304,7 @@ func (s *server) RegisterRoutes(server *web.Server) {
 
 	api := group.Group("/api", middleware.CSRF())
 	api.POST("/auth/login", s.Login)
+	api.POST("/auth/login/totp", s.LoginTOTP)
 	api.POST("/auth/logout", s.Logout, s.requireLogin)
 	api.GET("/me", s.CurrentUser, s.requireLogin)
 
@@ -252,6 +312,8 @@ func (s *server) RegisterRoutes(server *web.Server) {
 		TokenLookup: "form:csrf",
 	}))
 	page.GET("/login", webui.Template.Handler, s.redirectToNext)
+	page.GET("/login/:provider", s.OIDCLogin)
+	page.GET("/login/:provider/callback", s.OIDCCallback)
 	page.GET("/authorize", s.Authorize(webui.Template.Handler), s.redirectToLogin)
 	page.POST("/authorize", s.Authorize(webui.Template.Handler), s.redirectToLogin)
 
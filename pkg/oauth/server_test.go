@@ -31,6 +31,7 @@ import (
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/auth"
 	"go.thethings.network/lorawan-stack/pkg/auth/pbkdf2"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
 	"go.thethings.network/lorawan-stack/pkg/config"
@@ -47,6 +48,13 @@ type loginFormData struct {
 	Password string `json:"password"`
 }
 
+type loginTOTPFormData struct {
+	encoding string
+	UserID   string `json:"user_id"`
+	Password string `json:"password"`
+	TOTPCode string `json:"totp_code"`
+}
+
 type authorizeFormData struct {
 	encoding  string
 	Authorize bool `json:"authorize"`
@@ -61,7 +69,14 @@ var (
 	mockUser = &ttnpb.User{
 		UserIdentifiers: ttnpb.UserIdentifiers{UserID: "user"},
 	}
-	mockClient = &ttnpb.Client{
+	mockTOTPUser = &ttnpb.User{
+		UserIdentifiers: ttnpb.UserIdentifiers{UserID: "user"},
+		TOTPSecret:      totp.GenerateSecret(),
+	}
+	mockTOTPUsedUser      *ttnpb.User
+	mockTOTPLockedUser    *ttnpb.User
+	mockTOTPRecoveryCodes []string
+	mockClient            = &ttnpb.Client{
 		ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "client"},
 		State:             ttnpb.STATE_APPROVED,
 		Grants:            []ttnpb.GrantType{ttnpb.GRANT_AUTHORIZATION_CODE, ttnpb.GRANT_REFRESH_TOKEN},
@@ -83,6 +98,18 @@ func init() {
 	}
 	mockUser.Password = password
 
+	enabledAt := time.Now()
+	mockTOTPUser.Password, mockTOTPUser.TOTPEnabledAt = password, &enabledAt
+	mockTOTPRecoveryCodes, mockTOTPUser.TOTPRecoveryCodes, err = totp.GenerateRecoveryCodes(ctx, 2)
+	if err != nil {
+		panic(err)
+	}
+	usedUser, lockedUser := *mockTOTPUser, *mockTOTPUser
+	usedUser.TOTPLastTimeStep = totp.TimeStep(time.Now().Add(totp.Period))
+	lockedUntil := time.Now().Add(time.Hour)
+	lockedUser.TOTPLockedUntil = &lockedUntil
+	mockTOTPUsedUser, mockTOTPLockedUser = &usedUser, &lockedUser
+
 	secret, err := auth.Hash(ctx, "secret")
 	if err != nil {
 		panic(err)
@@ -90,6 +117,14 @@ func init() {
 	mockClient.Secret = secret
 }
 
+func mockTOTPCode() string {
+	code, err := totp.Code(mockTOTPUser.TOTPSecret, time.Now())
+	if err != nil {
+		panic(err)
+	}
+	return code
+}
+
 func TestOAuthFlow(t *testing.T) {
 	ctx := test.Context()
 	store := &mockStore{}
@@ -215,6 +250,110 @@ func TestOAuthFlow(t *testing.T) {
 			Body:         loginFormData{"json", "user", "pass"},
 			ExpectedCode: http.StatusNoContent,
 		},
+		{
+			Name: "login without TOTP code",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockTOTPUser
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass"},
+			ExpectedCode: http.StatusUnauthorized,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login wrong TOTP code",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockTOTPUser
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginTOTPFormData{"json", "user", "pass", "000000x"},
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "UpdateUser")
+				if a.So(s.req.user, should.NotBeNil) {
+					a.So(s.req.user.TOTPFailedAttempts, should.Equal, 1)
+				}
+				if a.So(s.req.fieldMask, should.NotBeNil) {
+					a.So(s.req.fieldMask.Paths, should.Resemble, []string{"totp_failed_attempts", "totp_locked_until"})
+				}
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login reused TOTP code",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockTOTPUsedUser
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginTOTPFormData{"json", "user", "pass", mockTOTPCode()},
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login TOTP locked",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockTOTPLockedUser
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginTOTPFormData{"json", "user", "pass", mockTOTPCode()},
+			ExpectedCode: http.StatusTooManyRequests,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "UpdateUser")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login TOTP recovery code",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockTOTPUser
+				s.res.session = mockSession
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginTOTPFormData{"form", "user", "pass", mockTOTPRecoveryCodes[1]},
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "UpdateUser")
+				if a.So(s.req.user, should.NotBeNil) {
+					a.So(s.req.user.TOTPRecoveryCodes, should.Resemble, mockTOTPUser.TOTPRecoveryCodes[:1])
+				}
+				if a.So(s.req.fieldMask, should.NotBeNil) {
+					a.So(s.req.fieldMask.Paths, should.Resemble, []string{"totp_failed_attempts", "totp_recovery_codes"})
+				}
+			},
+		},
+		{
+			Name: "login TOTP",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockTOTPUser
+				s.res.session = mockSession
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginTOTPFormData{"json", "user", "pass", mockTOTPCode()},
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "UpdateUser")
+				if a.So(s.req.fieldMask, should.NotBeNil) {
+					a.So(s.req.fieldMask.Paths, should.Resemble, []string{"totp_failed_attempts", "totp_last_time_step"})
+				}
+				a.So(s.calls, should.Contain, "CreateSession")
+			},
+		},
 		{
 			Name: "GET me with auth",
 			StoreSetup: func(s *mockStore) {
@@ -449,6 +588,20 @@ func TestOAuthFlow(t *testing.T) {
 					}.Encode()))
 					contentType = "application/x-www-form-urlencoded"
 				}
+			case loginTOTPFormData:
+				if b.encoding == "json" {
+					json, _ := json.Marshal(b)
+					body = bytes.NewBuffer(json)
+					contentType = "application/json"
+				}
+				if b.encoding == "form" {
+					body = bytes.NewBuffer([]byte(url.Values{
+						"user_id":   []string{b.UserID},
+						"password":  []string{b.Password},
+						"totp_code": []string{b.TOTPCode},
+					}.Encode()))
+					contentType = "application/x-www-form-urlencoded"
+				}
 			case authorizeFormData:
 				if b.encoding == "json" {
 					json, _ := json.Marshal(b)
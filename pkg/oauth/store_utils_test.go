@@ -32,6 +32,7 @@ type mockStoreContents struct {
 		session           *ttnpb.UserSession
 		sessionID         string
 		userIDs           *ttnpb.UserIdentifiers
+		user              *ttnpb.User
 		clientIDs         *ttnpb.ClientIdentifiers
 		authorization     *ttnpb.OAuthClientAuthorization
 		authorizationCode *ttnpb.OAuthAuthorizationCode
@@ -39,6 +40,11 @@ type mockStoreContents struct {
 		token             *ttnpb.OAuthAccessToken
 		previousID        string
 		tokenID           string
+		provider          string
+		externalID        string
+		memberIDs         *ttnpb.OrganizationOrUserIdentifiers
+		entityIDs         ttnpb.Identifiers
+		rights            *ttnpb.Rights
 	}
 	res struct {
 		session           *ttnpb.UserSession
@@ -47,9 +53,14 @@ type mockStoreContents struct {
 		authorization     *ttnpb.OAuthClientAuthorization
 		authorizationCode *ttnpb.OAuthAuthorizationCode
 		accessToken       *ttnpb.OAuthAccessToken
+		externalUser      *ttnpb.UserIdentifiers
+		memberRights      *ttnpb.Rights
+		takenUserIDs      []string
 	}
 	err struct {
 		getUser                 error
+		createUser              error
+		updateUser              error
 		createSession           error
 		getSession              error
 		deleteSession           error
@@ -62,6 +73,10 @@ type mockStoreContents struct {
 		createAccessToken       error
 		getAccessToken          error
 		deleteAccessToken       error
+		createExternalUser      error
+		getExternalUser         error
+		getMember               error
+		setMember               error
 	}
 }
 
@@ -70,6 +85,8 @@ type mockStore struct {
 	store.UserSessionStore
 	store.ClientStore
 	store.OAuthStore
+	store.ExternalUserStore
+	store.MembershipStore
 
 	mockStoreContents
 }
@@ -81,6 +98,7 @@ func (s *mockStore) reset() {
 var (
 	mockErrUnauthenticated = grpc.Errorf(codes.Unauthenticated, "Unauthenticated")
 	mockErrNotFound        = grpc.Errorf(codes.NotFound, "NotFound")
+	mockErrAlreadyExists   = grpc.Errorf(codes.AlreadyExists, "AlreadyExists")
 )
 
 func (s *mockStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fieldMask *types.FieldMask) (*ttnpb.User, error) {
@@ -89,6 +107,23 @@ func (s *mockStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fiel
 	return s.res.user, s.err.getUser
 }
 
+func (s *mockStore) CreateUser(ctx context.Context, usr *ttnpb.User) (*ttnpb.User, error) {
+	s.req.ctx, s.req.user = ctx, usr
+	s.calls = append(s.calls, "CreateUser")
+	for _, id := range s.res.takenUserIDs {
+		if usr.UserID == id {
+			return nil, mockErrAlreadyExists
+		}
+	}
+	return usr, s.err.createUser
+}
+
+func (s *mockStore) UpdateUser(ctx context.Context, usr *ttnpb.User, fieldMask *types.FieldMask) (*ttnpb.User, error) {
+	s.req.ctx, s.req.user, s.req.fieldMask = ctx, usr, fieldMask
+	s.calls = append(s.calls, "UpdateUser")
+	return usr, s.err.updateUser
+}
+
 func (s *mockStore) CreateSession(ctx context.Context, sess *ttnpb.UserSession) (*ttnpb.UserSession, error) {
 	s.req.ctx, s.req.session = ctx, sess
 	s.calls = append(s.calls, "CreateSession")
@@ -163,3 +198,27 @@ func (s *mockStore) DeleteAccessToken(ctx context.Context, tokenID string) error
 	s.calls = append(s.calls, "DeleteAccessToken")
 	return s.err.deleteAccessToken
 }
+
+func (s *mockStore) CreateExternalUser(ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider, externalID string) error {
+	s.req.ctx, s.req.userIDs, s.req.provider, s.req.externalID = ctx, userIDs, provider, externalID
+	s.calls = append(s.calls, "CreateExternalUser")
+	return s.err.createExternalUser
+}
+
+func (s *mockStore) GetExternalUser(ctx context.Context, provider, externalID string) (*ttnpb.UserIdentifiers, error) {
+	s.req.ctx, s.req.provider, s.req.externalID = ctx, provider, externalID
+	s.calls = append(s.calls, "GetExternalUser")
+	return s.res.externalUser, s.err.getExternalUser
+}
+
+func (s *mockStore) GetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) (*ttnpb.Rights, error) {
+	s.req.ctx, s.req.memberIDs, s.req.entityIDs = ctx, id, entityID
+	s.calls = append(s.calls, "GetMember")
+	return s.res.memberRights, s.err.getMember
+}
+
+func (s *mockStore) SetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers, rights *ttnpb.Rights) error {
+	s.req.ctx, s.req.memberIDs, s.req.entityIDs, s.req.rights = ctx, id, entityID, rights
+	s.calls = append(s.calls, "SetMember")
+	return s.err.setMember
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	echo "github.com/labstack/echo/v4"
 	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/jsonpb"
@@ -31,7 +32,10 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/web/cookie"
 )
 
-const authCookieName = "_session"
+const (
+	authCookieName = "_session"
+	totpCookieName = "_totp"
+)
 
 func (s *server) authCookie() *cookie.Cookie {
 	return &cookie.Cookie{
@@ -149,11 +153,22 @@ func (s *server) CurrentUser(c echo.Context) error {
 type loginRequest struct {
 	UserID   string `json:"user_id" form:"user_id"`
 	Password string `json:"password" form:"password"`
+	TOTPCode string `json:"totp_code" form:"totp_code"`
 }
 
-var errIncorrectPasswordOrUserID = errors.DefineInvalidArgument("no_user_id_password_match", "incorrect password or user ID")
+var (
+	errIncorrectPasswordOrUserID = errors.DefineInvalidArgument("no_user_id_password_match", "incorrect password or user ID")
+	errTOTPRequired              = errors.DefineUnauthenticated("totp_required", "two-factor authentication code required")
+	errIncorrectTOTPCode         = errors.DefineInvalidArgument("totp_code", "incorrect two-factor authentication code")
+	errTOTPLocked                = errors.DefineResourceExhausted("totp_locked", "too many incorrect two-factor authentication codes, try again later")
+)
 
-func (s *server) doLogin(ctx context.Context, userID, password string) error {
+// totpPaths are the paths of the user fields that are needed to validate TOTP codes.
+var totpPaths = []string{
+	"totp_enabled_at", "totp_failed_attempts", "totp_last_time_step", "totp_locked_until", "totp_recovery_codes", "totp_secret",
+}
+
+func (s *server) doLogin(ctx context.Context, userID, password, totpCode string) error {
 	ids := &ttnpb.UserIdentifiers{UserID: userID}
 	if err := ids.ValidateContext(ctx); err != nil {
 		return err
@@ -161,7 +176,7 @@ func (s *server) doLogin(ctx context.Context, userID, password string) error {
 	user, err := s.store.GetUser(
 		ctx,
 		ids,
-		&types.FieldMask{Paths: []string{"password"}},
+		&types.FieldMask{Paths: append([]string{"password"}, totpPaths...)},
 	)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -176,7 +191,58 @@ func (s *server) doLogin(ctx context.Context, userID, password string) error {
 		events.Publish(evtUserLoginFailed(ctx, user.UserIdentifiers, nil))
 		return errIncorrectPasswordOrUserID
 	}
-	return nil
+	if user.TOTPEnabledAt == nil {
+		return nil
+	}
+	if totpCode == "" {
+		return errTOTPRequired
+	}
+	return s.validateTOTP(ctx, user, totpCode)
+}
+
+// validateTOTP validates the given TOTP code or recovery code of the user.
+// TOTP codes and recovery codes can only be used once, so the time step of a matching TOTP code is stored and a
+// matching recovery code is removed. After too many incorrect codes, validation is locked for some time.
+func (s *server) validateTOTP(ctx context.Context, user *ttnpb.User, code string) error {
+	now := time.Now()
+	if totp.Locked(user.TOTPLockedUntil, now) {
+		return errTOTPLocked
+	}
+	timeStep, ok, err := totp.Validate(user.TOTPSecret, code, now, user.TOTPLastTimeStep)
+	if err != nil {
+		return err
+	}
+	if ok {
+		_, err = s.store.UpdateUser(ctx, &ttnpb.User{
+			UserIdentifiers:  user.UserIdentifiers,
+			TOTPLastTimeStep: timeStep,
+		}, &types.FieldMask{Paths: []string{"totp_failed_attempts", "totp_last_time_step"}})
+		return err
+	}
+	i, err := totp.ValidateRecoveryCode(user.TOTPRecoveryCodes, code)
+	if err != nil {
+		return err
+	}
+	if i < 0 {
+		events.Publish(evtUserLoginFailed(ctx, user.UserIdentifiers, nil))
+		failedAttempts, lockedUntil := totp.Failed(user.TOTPFailedAttempts, now)
+		if _, err := s.store.UpdateUser(ctx, &ttnpb.User{
+			UserIdentifiers:    user.UserIdentifiers,
+			TOTPFailedAttempts: failedAttempts,
+			TOTPLockedUntil:    lockedUntil,
+		}, &types.FieldMask{Paths: []string{"totp_failed_attempts", "totp_locked_until"}}); err != nil {
+			return err
+		}
+		return errIncorrectTOTPCode
+	}
+	recoveryCodes := make([]string, 0, len(user.TOTPRecoveryCodes)-1)
+	recoveryCodes = append(recoveryCodes, user.TOTPRecoveryCodes[:i]...)
+	recoveryCodes = append(recoveryCodes, user.TOTPRecoveryCodes[i+1:]...)
+	_, err = s.store.UpdateUser(ctx, &ttnpb.User{
+		UserIdentifiers:   user.UserIdentifiers,
+		TOTPRecoveryCodes: recoveryCodes,
+	}, &types.FieldMask{Paths: []string{"totp_failed_attempts", "totp_recovery_codes"}})
+	return err
 }
 
 func (s *server) Login(c echo.Context) error {
@@ -185,10 +251,78 @@ func (s *server) Login(c echo.Context) error {
 	if err := c.Bind(req); err != nil {
 		return err
 	}
-	if err := s.doLogin(ctx, req.UserID, req.Password); err != nil {
+	if err := s.doLogin(ctx, req.UserID, req.Password, req.TOTPCode); err != nil {
 		return err
 	}
-	userIDs := ttnpb.UserIdentifiers{UserID: req.UserID}
+	if err := s.createSession(c, ttnpb.UserIdentifiers{UserID: req.UserID}); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *server) totpCookie() *cookie.Cookie {
+	return &cookie.Cookie{
+		Name:     totpCookieName,
+		Path:     s.config.UI.MountPath(),
+		MaxAge:   5 * time.Minute,
+		HTTPOnly: true,
+	}
+}
+
+// totpLogin is a login that is pending the two-factor authentication code of the user.
+type totpLogin struct {
+	UserID string
+}
+
+type loginTOTPRequest struct {
+	TOTPCode string `json:"totp_code" form:"totp_code"`
+}
+
+var errNoTOTPLogin = errors.DefineUnauthenticated("no_totp_login", "no login pending two-factor authentication")
+
+// LoginTOTP completes a login that is pending the two-factor authentication code of the user, such as a login with
+// an upstream OpenID Connect provider.
+func (s *server) LoginTOTP(c echo.Context) error {
+	ctx := c.Request().Context()
+	req := new(loginTOTPRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	var login totpLogin
+	ok, err := s.totpCookie().Get(c, &login)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errNoTOTPLogin
+	}
+	userIDs := ttnpb.UserIdentifiers{UserID: login.UserID}
+	user, err := s.store.GetUser(
+		ctx,
+		&userIDs,
+		&types.FieldMask{Paths: totpPaths},
+	)
+	if err != nil {
+		return err
+	}
+	if user.TOTPEnabledAt != nil {
+		if req.TOTPCode == "" {
+			return errTOTPRequired
+		}
+		if err = s.validateTOTP(ctx, user, req.TOTPCode); err != nil {
+			return err
+		}
+	}
+	s.totpCookie().Remove(c)
+	if err := s.createSession(c, userIDs); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// createSession creates a new session for the user that logged in, and sets the auth cookie.
+func (s *server) createSession(c echo.Context, userIDs ttnpb.UserIdentifiers) error {
+	ctx := c.Request().Context()
 	session, err := s.store.CreateSession(ctx, &ttnpb.UserSession{
 		UserIdentifiers: userIDs,
 	})
@@ -196,15 +330,11 @@ func (s *server) Login(c echo.Context) error {
 		return err
 	}
 	events.Publish(evtUserLogin(ctx, userIDs, nil))
-	err = s.updateAuthCookie(c, func(cookie *authCookie) error {
+	return s.updateAuthCookie(c, func(cookie *authCookie) error {
 		cookie.UserID = session.UserID
 		cookie.SessionID = session.SessionID
 		return nil
 	})
-	if err != nil {
-		return err
-	}
-	return c.NoContent(http.StatusNoContent)
 }
 
 func (s *server) Logout(c echo.Context) error {
@@ -0,0 +1,43 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import "go.thethings.network/lorawan-stack/pkg/types"
+
+// Config configures the Packet Broker Agent.
+type Config struct {
+	NetID       types.NetID       `name:"net-id" description:"LoRa Alliance NetID"`
+	ClusterID   string            `name:"cluster-id" description:"Cluster ID uniquely identifying the Forwarder in the NetID"`
+	Router      RouterConfig      `name:"router" description:"Packet Broker Router connection settings"`
+	Forwarder   ForwarderConfig   `name:"forwarder" description:"Forwarder configuration for publishing uplink messages and subscribing to downlink messages"`
+	HomeNetwork HomeNetworkConfig `name:"home-network" description:"Home Network configuration for subscribing to uplink messages and publishing downlink messages"`
+}
+
+// RouterConfig configures the connection to the Packet Broker Router.
+type RouterConfig struct {
+	Address  string `name:"address" description:"Address of the Packet Broker Router"`
+	Insecure bool   `name:"insecure" description:"Connect to the Packet Broker Router without TLS"`
+}
+
+// ForwarderConfig configures the Forwarder role.
+type ForwarderConfig struct {
+	Enable bool `name:"enable" description:"Enable Forwarder role"`
+}
+
+// HomeNetworkConfig configures the Home Network role.
+type HomeNetworkConfig struct {
+	Enable          bool                  `name:"enable" description:"Enable Home Network role"`
+	DevAddrPrefixes []types.DevAddrPrefix `name:"dev-addr-prefixes" description:"DevAddr prefixes to subscribe to (default: the DevAddr prefix of the NetID)"`
+}
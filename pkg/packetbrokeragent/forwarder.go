@@ -0,0 +1,63 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errGatewayServerNotFound = errors.DefineNotFound("gateway_server_not_found", "Gateway Server not found")
+
+// subscribeDownlink subscribes to downlink messages for the Forwarder and schedules them on the Gateway Server.
+func (a *Agent) subscribeDownlink(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	stream, err := ttnpb.NewPacketBrokerRouterClient(a.routerConn).SubscribeDownlink(ctx, &ttnpb.PacketBrokerSubscribeDownlinkRequest{
+		ForwarderNetID: a.netID,
+		ForwarderID:    a.clusterID,
+	})
+	if err != nil {
+		return err
+	}
+	logger.Info("Subscribed to downlink messages")
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := a.handleDownlink(ctx, msg); err != nil {
+			logger.WithError(err).Warn("Failed to handle downlink message")
+		}
+	}
+}
+
+// handleDownlink schedules the downlink message from the Home Network on the Gateway Server.
+// The downlink paths of the message contain the uplink tokens of the Gateway Server.
+func (a *Agent) handleDownlink(ctx context.Context, msg *ttnpb.PacketBrokerDownlinkMessage) error {
+	logger := log.FromContext(ctx).WithField("home_network_net_id", msg.HomeNetworkNetID)
+	gsConn, err := a.GetPeerConn(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, nil)
+	if err != nil {
+		return errGatewayServerNotFound.WithCause(err)
+	}
+	res, err := ttnpb.NewNsGsClient(gsConn).ScheduleDownlink(ctx, msg.Message, a.WithClusterAuth())
+	if err != nil {
+		return err
+	}
+	logger.WithField("transmission_delay", res.Delay).Debug("Scheduled downlink")
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type gsPbaServer struct {
+	*Agent
+}
+
+var (
+	errNotEnabled         = errors.DefineFailedPrecondition("not_enabled", "role `{role}` is not enabled")
+	errMessageIdentifiers = errors.DefineInvalidArgument("message_identifiers", "invalid message identifiers")
+	errNoDevAddr          = errors.DefineFailedPrecondition("no_dev_addr", "message has no DevAddr")
+	errOwnDevAddr         = errors.DefineFailedPrecondition("own_dev_addr", "DevAddr `{dev_addr}` belongs to the Home Network")
+)
+
+// PublishUplink is called by the Gateway Server when an uplink message arrives and needs to get forwarded to
+// Packet Broker.
+// Only data uplink messages of end devices that belong to foreign Home Networks are forwarded.
+func (s *gsPbaServer) PublishUplink(ctx context.Context, up *ttnpb.GatewayUplinkMessage) (*pbtypes.Empty, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	if !s.forwarderEnabled {
+		return nil, errNotEnabled.WithAttributes("role", "forwarder")
+	}
+	ids, err := lorawan.GetUplinkMessageIdentifiers(up.RawPayload)
+	if err != nil {
+		return nil, errMessageIdentifiers.WithCause(err)
+	}
+	if ids.DevAddr == nil {
+		return nil, errNoDevAddr
+	}
+	if s.ownsDevAddr(*ids.DevAddr) {
+		return nil, errOwnDevAddr.WithAttributes("dev_addr", *ids.DevAddr)
+	}
+	msg := &ttnpb.PacketBrokerUplinkMessage{
+		ForwarderNetID: s.netID,
+		ForwarderID:    s.clusterID,
+		Message:        up.UplinkMessage,
+	}
+	if _, err := ttnpb.NewPacketBrokerRouterClient(s.routerConn).PublishUplink(ctx, msg); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
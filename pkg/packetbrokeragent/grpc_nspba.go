@@ -0,0 +1,93 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type nsPbaServer struct {
+	*Agent
+}
+
+var (
+	errNoRequest     = errors.DefineInvalidArgument("no_request", "downlink message is not a transmission request")
+	errNoUplinkToken = errors.DefineInvalidArgument("no_uplink_token", "no downlink path with Packet Broker uplink token")
+)
+
+// PublishDownlink is called by the Network Server when a downlink message needs to get transmitted by gateways of
+// a Forwarder through Packet Broker.
+// The downlink message is published to the Forwarder of the first downlink path. Downlink paths through other
+// Forwarders are discarded.
+func (s *nsPbaServer) PublishDownlink(ctx context.Context, down *ttnpb.DownlinkMessage) (*pbtypes.Empty, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	if !s.homeNetworkEnabled {
+		return nil, errNotEnabled.WithAttributes("role", "home_network")
+	}
+	req := down.GetRequest()
+	if req == nil {
+		return nil, errNoRequest
+	}
+	logger := log.FromContext(ctx)
+
+	var forwarder *uplinkToken
+	paths := make([]*ttnpb.DownlinkPath, 0, len(req.DownlinkPaths))
+	for _, path := range req.DownlinkPaths {
+		var token uplinkToken
+		if err := token.unmarshal(path.GetUplinkToken()); err != nil {
+			logger.WithError(err).Debug("Skip downlink path without Packet Broker uplink token")
+			continue
+		}
+		if forwarder == nil {
+			forwarder = &token
+		} else if forwarder.ForwarderNetID != token.ForwarderNetID || forwarder.ForwarderID != token.ForwarderID {
+			logger.Debug("Skip downlink path through another Forwarder")
+			continue
+		}
+		paths = append(paths, &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_UplinkToken{
+				UplinkToken: token.Token,
+			},
+		})
+	}
+	if forwarder == nil {
+		return nil, errNoUplinkToken
+	}
+
+	fwdReq := *req
+	fwdReq.DownlinkPaths = paths
+	fwdDown := *down
+	fwdDown.Settings = &ttnpb.DownlinkMessage_Request{
+		Request: &fwdReq,
+	}
+	msg := &ttnpb.PacketBrokerDownlinkMessage{
+		ForwarderNetID:   forwarder.ForwarderNetID,
+		ForwarderID:      forwarder.ForwarderID,
+		HomeNetworkNetID: s.netID,
+		Message:          &fwdDown,
+	}
+	if _, err := ttnpb.NewPacketBrokerRouterClient(s.routerConn).PublishDownlink(ctx, msg); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
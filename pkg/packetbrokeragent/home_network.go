@@ -0,0 +1,76 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errNetworkServerNotFound = errors.DefineNotFound("network_server_not_found", "Network Server not found")
+
+// subscribeUplink subscribes to uplink messages for the Home Network DevAddr prefixes and passes them to the
+// Network Server.
+func (a *Agent) subscribeUplink(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	stream, err := ttnpb.NewPacketBrokerRouterClient(a.routerConn).SubscribeUplink(ctx, &ttnpb.PacketBrokerSubscribeUplinkRequest{
+		HomeNetworkNetID: a.netID,
+		DevAddrPrefixes:  a.homeNetworkDevAddrPrefixes,
+	})
+	if err != nil {
+		return err
+	}
+	logger.Info("Subscribed to uplink messages")
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := a.handleUplink(ctx, msg); err != nil {
+			logger.WithError(err).Warn("Failed to handle uplink message")
+		}
+	}
+}
+
+// handleUplink passes the uplink message from the Forwarder to the Network Server.
+// The gateway identifiers are replaced by identifiers of the Forwarder and the uplink tokens are wrapped to route
+// downlink messages back to the Forwarder.
+func (a *Agent) handleUplink(ctx context.Context, msg *ttnpb.PacketBrokerUplinkMessage) error {
+	up := *msg.Message
+	up.RxMetadata = make([]*ttnpb.RxMetadata, 0, len(msg.Message.RxMetadata))
+	for _, md := range msg.Message.RxMetadata {
+		token, err := uplinkToken{
+			ForwarderNetID: msg.ForwarderNetID,
+			ForwarderID:    msg.ForwarderID,
+			Token:          md.UplinkToken,
+		}.marshal()
+		if err != nil {
+			return err
+		}
+		md := *md
+		md.GatewayIdentifiers = gatewayIdentifiers(msg.ForwarderNetID, md.GatewayIdentifiers.EUI)
+		md.UplinkToken = token
+		up.RxMetadata = append(up.RxMetadata, &md)
+	}
+	nsConn, err := a.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+	if err != nil {
+		return errNetworkServerNotFound.WithCause(err)
+	}
+	_, err = ttnpb.NewGsNsClient(nsConn).HandleUplink(ctx, &up, a.WithClusterAuth())
+	return err
+}
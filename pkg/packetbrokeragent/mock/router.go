@@ -0,0 +1,189 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mock provides an in-process Packet Broker Router for testing Packet Broker Agents.
+package mock
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+const subscriptionBuffer = 16
+
+type uplinkSubscription struct {
+	ttnpb.PacketBrokerSubscribeUplinkRequest
+	ch chan *ttnpb.PacketBrokerUplinkMessage
+}
+
+func (s *uplinkSubscription) matches(devAddr types.DevAddr) bool {
+	for _, prefix := range s.DevAddrPrefixes {
+		if prefix.Matches(devAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+type downlinkSubscription struct {
+	ttnpb.PacketBrokerSubscribeDownlinkRequest
+	ch chan *ttnpb.PacketBrokerDownlinkMessage
+}
+
+// Router is a mock Packet Broker Router.
+// Uplink messages are routed to the Home Networks that subscribed to a matching DevAddr prefix.
+// Downlink messages are routed to the Forwarder identified by NetID and ID.
+// Messages that cannot be routed, or that do not fit in the buffer of a subscriber, are dropped.
+type Router struct {
+	mu            sync.RWMutex
+	uplinkSubs    map[*uplinkSubscription]struct{}
+	downlinkSubs  map[*downlinkSubscription]struct{}
+	subscribersCh chan struct{}
+}
+
+// StartRouter starts the mock Packet Broker Router on a local address.
+func StartRouter(ctx context.Context) (*Router, string) {
+	r := &Router{
+		uplinkSubs:    make(map[*uplinkSubscription]struct{}),
+		downlinkSubs:  make(map[*downlinkSubscription]struct{}),
+		subscribersCh: make(chan struct{}, subscriptionBuffer),
+	}
+	srv := rpcserver.New(ctx)
+	ttnpb.RegisterPacketBrokerRouterServer(srv.Server, r)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	go srv.Serve(lis)
+	go func() {
+		<-ctx.Done()
+		lis.Close()
+	}()
+	return r, lis.Addr().String()
+}
+
+// Subscribed returns a channel that receives a value when a subscriber is added.
+func (r *Router) Subscribed() <-chan struct{} {
+	return r.subscribersCh
+}
+
+func (r *Router) notifySubscribed() {
+	select {
+	case r.subscribersCh <- struct{}{}:
+	default:
+	}
+}
+
+var errNoDevAddr = errors.DefineInvalidArgument("no_dev_addr", "uplink message has no DevAddr")
+
+// PublishUplink implements ttnpb.PacketBrokerRouterServer.
+func (r *Router) PublishUplink(ctx context.Context, msg *ttnpb.PacketBrokerUplinkMessage) (*pbtypes.Empty, error) {
+	ids, err := lorawan.GetUplinkMessageIdentifiers(msg.Message.RawPayload)
+	if err != nil {
+		return nil, err
+	}
+	if ids.DevAddr == nil {
+		return nil, errNoDevAddr
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for sub := range r.uplinkSubs {
+		if !sub.matches(*ids.DevAddr) {
+			continue
+		}
+		select {
+		case sub.ch <- msg:
+		default:
+		}
+	}
+	return ttnpb.Empty, nil
+}
+
+// SubscribeUplink implements ttnpb.PacketBrokerRouterServer.
+func (r *Router) SubscribeUplink(req *ttnpb.PacketBrokerSubscribeUplinkRequest, stream ttnpb.PacketBrokerRouter_SubscribeUplinkServer) error {
+	sub := &uplinkSubscription{
+		PacketBrokerSubscribeUplinkRequest: *req,
+		ch:                                 make(chan *ttnpb.PacketBrokerUplinkMessage, subscriptionBuffer),
+	}
+	r.mu.Lock()
+	r.uplinkSubs[sub] = struct{}{}
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.uplinkSubs, sub)
+		r.mu.Unlock()
+	}()
+	r.notifySubscribed()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case msg := <-sub.ch:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// PublishDownlink implements ttnpb.PacketBrokerRouterServer.
+func (r *Router) PublishDownlink(ctx context.Context, msg *ttnpb.PacketBrokerDownlinkMessage) (*pbtypes.Empty, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for sub := range r.downlinkSubs {
+		if sub.ForwarderNetID != msg.ForwarderNetID || sub.ForwarderID != msg.ForwarderID {
+			continue
+		}
+		select {
+		case sub.ch <- msg:
+		default:
+		}
+	}
+	return ttnpb.Empty, nil
+}
+
+// SubscribeDownlink implements ttnpb.PacketBrokerRouterServer.
+func (r *Router) SubscribeDownlink(req *ttnpb.PacketBrokerSubscribeDownlinkRequest, stream ttnpb.PacketBrokerRouter_SubscribeDownlinkServer) error {
+	sub := &downlinkSubscription{
+		PacketBrokerSubscribeDownlinkRequest: *req,
+		ch:                                   make(chan *ttnpb.PacketBrokerDownlinkMessage, subscriptionBuffer),
+	}
+	r.mu.Lock()
+	r.downlinkSubs[sub] = struct{}{}
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.downlinkSubs, sub)
+		r.mu.Unlock()
+	}()
+	r.notifySubscribed()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case msg := <-sub.ch:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package packetbrokeragent contains the implementation of the Packet Broker Agent component.
+//
+// The Packet Broker Agent connects the cluster to a Packet Broker Router. As Forwarder, it publishes uplink messages
+// received by the Gateway Server for foreign NetIDs and subscribes to downlink messages for these gateways.
+// As Home Network, it subscribes to uplink messages for the DevAddr prefixes of the cluster, passes them to the
+// Network Server, and publishes downlink messages from the Network Server to the Forwarder.
+package packetbrokeragent
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/pkg/cluster"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/rpclog"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Agent implements the Packet Broker Agent component, acting as Forwarder, Home Network or both.
+//
+// The Agent exposes the GsPba and NsPba services.
+type Agent struct {
+	*component.Component
+	ctx context.Context
+
+	netID                      types.NetID
+	clusterID                  string
+	forwarderEnabled           bool
+	homeNetworkEnabled         bool
+	homeNetworkDevAddrPrefixes []types.DevAddrPrefix
+
+	routerAddress     string
+	routerDialOptions []grpc.DialOption
+	routerConn        *grpc.ClientConn
+
+	grpc struct {
+		gsPba ttnpb.GsPbaServer
+		nsPba ttnpb.NsPbaServer
+	}
+}
+
+// Option configures Agent.
+type Option func(*Agent)
+
+// WithRouterDialOptions configures the gRPC dial options to connect to the Packet Broker Router.
+// The options replace the default transport security.
+func WithRouterDialOptions(opts ...grpc.DialOption) Option {
+	return func(a *Agent) {
+		a.routerDialOptions = opts
+	}
+}
+
+var (
+	errInvalidConfig = errors.DefineInvalidArgument("invalid_config", "invalid configuration")
+	errRouterAddress = errors.DefineInvalidArgument("router_address", "no Packet Broker Router address configured")
+	errForwarderID   = errors.DefineInvalidArgument("forwarder_id", "no cluster ID configured to identify the Forwarder")
+	errDialRouter    = errors.DefineUnavailable("dial_router", "failed to dial Packet Broker Router `{address}`")
+)
+
+// New returns a new Packet Broker Agent.
+func New(c *component.Component, conf *Config, opts ...Option) (*Agent, error) {
+	switch {
+	case conf.Router.Address == "":
+		return nil, errInvalidConfig.WithCause(errRouterAddress)
+	case conf.Forwarder.Enable && conf.ClusterID == "":
+		return nil, errInvalidConfig.WithCause(errForwarderID)
+	}
+
+	homeNetworkDevAddrPrefixes := conf.HomeNetwork.DevAddrPrefixes
+	if len(homeNetworkDevAddrPrefixes) == 0 {
+		devAddr, err := types.NewDevAddr(conf.NetID, nil)
+		if err != nil {
+			return nil, err
+		}
+		homeNetworkDevAddrPrefixes = []types.DevAddrPrefix{
+			{
+				DevAddr: devAddr,
+				Length:  uint8(32 - types.NwkAddrBits(conf.NetID)),
+			},
+		}
+	}
+
+	a := &Agent{
+		Component: c,
+		ctx:       log.NewContextWithField(c.Context(), "namespace", "packetbrokeragent"),
+
+		netID:                      conf.NetID,
+		clusterID:                  conf.ClusterID,
+		forwarderEnabled:           conf.Forwarder.Enable,
+		homeNetworkEnabled:         conf.HomeNetwork.Enable,
+		homeNetworkDevAddrPrefixes: homeNetworkDevAddrPrefixes,
+		routerAddress:              conf.Router.Address,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	if a.routerDialOptions == nil {
+		if conf.Router.Insecure {
+			a.routerDialOptions = []grpc.DialOption{grpc.WithInsecure()}
+		} else {
+			tlsConfig, err := c.GetTLSClientConfig(a.ctx)
+			if err != nil {
+				return nil, err
+			}
+			a.routerDialOptions = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+		}
+	}
+	a.grpc.gsPba = &gsPbaServer{Agent: a}
+	a.grpc.nsPba = &nsPbaServer{Agent: a}
+
+	routerConn, err := grpc.DialContext(a.ctx, a.routerAddress, append(rpcclient.DefaultDialOptions(a.ctx), a.routerDialOptions...)...)
+	if err != nil {
+		return nil, errDialRouter.WithCause(err).WithAttributes("address", a.routerAddress)
+	}
+	a.routerConn = routerConn
+	go func() {
+		<-a.ctx.Done()
+		routerConn.Close()
+	}()
+
+	if a.forwarderEnabled {
+		c.RegisterTask(a.ctx, "pb_subscribe_downlink", a.subscribeDownlink, component.TaskRestartOnFailure, component.TaskBackoffDial...)
+	}
+	if a.homeNetworkEnabled {
+		c.RegisterTask(a.ctx, "pb_subscribe_uplink", a.subscribeUplink, component.TaskRestartOnFailure, component.TaskBackoffDial...)
+	}
+
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GsPba", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("packetbrokeragent"))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GsPba", cluster.HookName, c.ClusterAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsPba", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("packetbrokeragent"))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsPba", cluster.HookName, c.ClusterAuthUnaryHook())
+	c.RegisterGRPC(a)
+	return a, nil
+}
+
+// Context returns the context of the Packet Broker Agent.
+func (a *Agent) Context() context.Context {
+	return a.ctx
+}
+
+// Roles returns the roles that the Packet Broker Agent fulfills.
+func (a *Agent) Roles() []ttnpb.ClusterRole {
+	return []ttnpb.ClusterRole{ttnpb.ClusterRole_PACKET_BROKER_AGENT}
+}
+
+// RegisterServices registers services provided by a at s.
+func (a *Agent) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterGsPbaServer(s, a.grpc.gsPba)
+	ttnpb.RegisterNsPbaServer(s, a.grpc.nsPba)
+}
+
+// RegisterHandlers registers gRPC handlers.
+func (a *Agent) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+// ownsDevAddr returns whether the DevAddr belongs to the Home Network DevAddr prefixes of the cluster.
+func (a *Agent) ownsDevAddr(devAddr types.DevAddr) bool {
+	for _, prefix := range a.homeNetworkDevAddrPrefixes {
+		if prefix.Matches(devAddr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	nsmock "go.thethings.network/lorawan-stack/pkg/gatewayserver/upstream/mock"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	. "go.thethings.network/lorawan-stack/pkg/packetbrokeragent"
+	"go.thethings.network/lorawan-stack/pkg/packetbrokeragent/mock"
+	"go.thethings.network/lorawan-stack/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var timeout = (1 << 5) * test.Delay
+
+type mockGS struct {
+	downCh chan *ttnpb.DownlinkMessage
+}
+
+func startMockGS(ctx context.Context) (*mockGS, string) {
+	gs := &mockGS{
+		downCh: make(chan *ttnpb.DownlinkMessage, 1),
+	}
+	srv := rpcserver.New(ctx)
+	ttnpb.RegisterNsGsServer(srv.Server, gs)
+	lis, err := net.Listen("tcp", ":0")
+	if err != nil {
+		panic(err)
+	}
+	go srv.Serve(lis)
+	return gs, lis.Addr().String()
+}
+
+// ScheduleDownlink implements ttnpb.NsGsServer.
+func (gs *mockGS) ScheduleDownlink(ctx context.Context, msg *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error) {
+	gs.downCh <- msg
+	return &ttnpb.ScheduleDownlinkResponse{}, nil
+}
+
+// GetGatewayLoads implements ttnpb.NsGsServer.
+func (gs *mockGS) GetGatewayLoads(ctx context.Context, req *ttnpb.GetGatewayLoadsRequest) (*ttnpb.GatewayLoads, error) {
+	return &ttnpb.GatewayLoads{}, nil
+}
+
+// clusterKey is shared by the components, as the cluster authentication hooks are registered globally.
+const clusterKey = "16AB00AB8D11A78316AB00AB8D11A783"
+
+func TestForwarderHomeNetwork(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	router, routerAddr := mock.StartRouter(ctx)
+	gs, gsAddr := startMockGS(ctx)
+	ns, nsAddr := nsmock.StartNS(ctx)
+
+	forwarderNetID := types.NetID{0x00, 0x00, 0x13}
+	homeNetworkNetID := types.NetID{0x00, 0x00, 0x14}
+
+	fwdC := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			Cluster: config.Cluster{
+				GatewayServer: gsAddr,
+				Keys:          []string{clusterKey},
+			},
+		},
+	})
+	_, err := New(fwdC, &Config{
+		NetID:     forwarderNetID,
+		ClusterID: "test-forwarder",
+		Router: RouterConfig{
+			Address:  routerAddr,
+			Insecure: true,
+		},
+		Forwarder: ForwarderConfig{
+			Enable: true,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, fwdC)
+	defer fwdC.Close()
+
+	homeC := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			Cluster: config.Cluster{
+				NetworkServer: nsAddr,
+				Keys:          []string{clusterKey},
+			},
+		},
+	})
+	_, err = New(homeC, &Config{
+		NetID: homeNetworkNetID,
+		Router: RouterConfig{
+			Address:  routerAddr,
+			Insecure: true,
+		},
+		HomeNetwork: HomeNetworkConfig{
+			Enable: true,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, homeC)
+	defer homeC.Close()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-router.Subscribed():
+		case <-time.After(timeout):
+			t.Fatal("Expected subscription to router time-out")
+		}
+	}
+
+	gsPba := ttnpb.NewGsPbaClient(fwdC.LoopbackConn())
+	nsPba := ttnpb.NewNsPbaClient(homeC.LoopbackConn())
+
+	newUplink := func(devAddr types.DevAddr, token []byte) *ttnpb.GatewayUplinkMessage {
+		return &ttnpb.GatewayUplinkMessage{
+			UplinkMessage: &ttnpb.UplinkMessage{
+				RawPayload: []byte{0x40, devAddr[3], devAddr[2], devAddr[1], devAddr[0], 0x00, 0x01, 0x00, 0x01, 0x42, 0x42, 0x42, 0x42},
+				Settings: ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+						SpreadingFactor: 7,
+						Bandwidth:       125000,
+					}}},
+					CodingRate: "4/5",
+					Frequency:  868100000,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+							GatewayID: "test-gateway",
+							EUI:       &types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01},
+						},
+						UplinkToken: token,
+					},
+				},
+			},
+			BandID: "EU_863_870",
+		}
+	}
+
+	t.Run("OwnDevAddr", func(t *testing.T) {
+		a := assertions.New(t)
+		devAddr := types.DevAddr{0x26, 0x00, 0x00, 0x01} // NetID 00:00:13
+		_, err := gsPba.PublishUplink(ctx, newUplink(devAddr, []byte{0x01}), fwdC.WithClusterAuth())
+		a.So(err, should.NotBeNil)
+	})
+
+	var up *ttnpb.UplinkMessage
+	t.Run("Uplink", func(t *testing.T) {
+		a := assertions.New(t)
+		devAddr := types.DevAddr{0x28, 0x00, 0x00, 0x01} // NetID 00:00:14
+		_, err := gsPba.PublishUplink(ctx, newUplink(devAddr, []byte{0x01, 0x02}), fwdC.WithClusterAuth())
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case up = <-ns.Up():
+		case <-time.After(timeout):
+			t.Fatal("Expected uplink message time-out")
+		}
+		if !a.So(up.RxMetadata, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		md := up.RxMetadata[0]
+		a.So(md.GatewayID, should.Equal, "packetbroker-000013")
+		a.So(md.EUI, should.Resemble, &types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01})
+		a.So(ttnpb.IsPacketBrokerUplinkToken(md.UplinkToken), should.BeTrue)
+	})
+	if up == nil {
+		t.FailNow()
+	}
+
+	t.Run("Downlink", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := nsPba.PublishDownlink(ctx, &ttnpb.DownlinkMessage{
+			RawPayload: []byte{0x60, 0x01, 0x00, 0x00, 0x28, 0x00, 0x01, 0x00, 0x42, 0x42, 0x42, 0x42},
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: &ttnpb.TxRequest{
+					Class: ttnpb.CLASS_A,
+					DownlinkPaths: []*ttnpb.DownlinkPath{
+						{
+							Path: &ttnpb.DownlinkPath_UplinkToken{
+								UplinkToken: up.RxMetadata[0].UplinkToken,
+							},
+						},
+					},
+					Rx1Delay:         ttnpb.RX_DELAY_1,
+					Rx1DataRateIndex: 5,
+					Rx1Frequency:     868100000,
+					FrequencyPlanID:  test.EUFrequencyPlanID,
+				},
+			},
+		}, homeC.WithClusterAuth())
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case down := <-gs.downCh:
+			req := down.GetRequest()
+			if !a.So(req, should.NotBeNil) || !a.So(req.DownlinkPaths, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			a.So(bytes.Equal(req.DownlinkPaths[0].GetUplinkToken(), []byte{0x01, 0x02}), should.BeTrue)
+			a.So(req.Rx1Frequency, should.Equal, 868100000)
+		case <-time.After(timeout):
+			t.Fatal("Expected downlink message time-out")
+		}
+	})
+
+	t.Run("DownlinkWithoutPacketBrokerToken", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := nsPba.PublishDownlink(ctx, &ttnpb.DownlinkMessage{
+			RawPayload: []byte{0x60, 0x01, 0x00, 0x00, 0x28, 0x00, 0x01, 0x00, 0x42, 0x42, 0x42, 0x42},
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: &ttnpb.TxRequest{
+					Class: ttnpb.CLASS_A,
+					DownlinkPaths: []*ttnpb.DownlinkPath{
+						{
+							Path: &ttnpb.DownlinkPath_UplinkToken{
+								UplinkToken: []byte{0x01, 0x02},
+							},
+						},
+					},
+				},
+			},
+		}, homeC.WithClusterAuth())
+		a.So(err, should.NotBeNil)
+	})
+}
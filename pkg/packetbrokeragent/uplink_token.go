@@ -0,0 +1,64 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var errUplinkToken = errors.DefineInvalidArgument("uplink_token", "invalid Packet Broker uplink token")
+
+var uplinkTokenPrefix = []byte(ttnpb.PacketBrokerUplinkTokenPrefix)
+
+// uplinkToken is the uplink token of an uplink message received through Packet Broker.
+// It contains the Forwarder that received the uplink message, which is needed to route downlink back, and the opaque
+// uplink token of the Forwarder.
+type uplinkToken struct {
+	ForwarderNetID types.NetID `json:"forwarder_net_id"`
+	ForwarderID    string      `json:"forwarder_id"`
+	Token          []byte      `json:"token,omitempty"`
+}
+
+func (t uplinkToken) marshal() ([]byte, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(uplinkTokenPrefix[:0:0], uplinkTokenPrefix...), b...), nil
+}
+
+func (t *uplinkToken) unmarshal(b []byte) error {
+	if !ttnpb.IsPacketBrokerUplinkToken(b) {
+		return errUplinkToken
+	}
+	if err := json.Unmarshal(b[len(uplinkTokenPrefix):], t); err != nil {
+		return errUplinkToken.WithCause(err)
+	}
+	return nil
+}
+
+// gatewayIdentifiers returns the gateway identifiers used for gateways of the Forwarder identified by netID.
+func gatewayIdentifiers(netID types.NetID, eui *types.EUI64) ttnpb.GatewayIdentifiers {
+	return ttnpb.GatewayIdentifiers{
+		GatewayID: fmt.Sprintf("packetbroker-%s", strings.ToLower(netID.String())),
+		EUI:       eui,
+	}
+}
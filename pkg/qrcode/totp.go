@@ -0,0 +1,105 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qrcode
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	totpAlgorithm = "SHA1"
+	totpDigits    = 6
+	totpPeriod    = 30
+)
+
+// validTOTPSecretChars defines the characters of base32 encoded secrets.
+const validTOTPSecretChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+
+// TOTPProvisioning is the Key URI format that authenticator apps use to add TOTP secrets.
+// Only the SHA1 algorithm with 6 digits and a period of 30 seconds is supported.
+type TOTPProvisioning struct {
+	Issuer,
+	AccountName,
+	Secret string
+}
+
+// Validate implements the Data interface.
+func (m TOTPProvisioning) Validate() error {
+	if m.AccountName == "" || m.Secret == "" {
+		return errFormat
+	}
+	for _, s := range []string{m.Issuer, m.AccountName} {
+		if strings.ContainsRune(s, ':') {
+			return errCharacter.WithAttributes("r", ':')
+		}
+	}
+	for _, r := range m.Secret {
+		if strings.IndexRune(validTOTPSecretChars, r) == -1 {
+			return errCharacter.WithAttributes("r", r)
+		}
+	}
+	return nil
+}
+
+// MarshalText implements the TextMarshaler interface.
+func (m TOTPProvisioning) MarshalText() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	label := url.PathEscape(m.AccountName)
+	query := url.Values{
+		"secret":    []string{m.Secret},
+		"algorithm": []string{totpAlgorithm},
+		"digits":    []string{strconv.Itoa(totpDigits)},
+		"period":    []string{strconv.Itoa(totpPeriod)},
+	}
+	if m.Issuer != "" {
+		label = fmt.Sprintf("%s:%s", url.PathEscape(m.Issuer), label)
+		query.Set("issuer", m.Issuer)
+	}
+	return []byte(fmt.Sprintf("otpauth://totp/%s?%s", label, query.Encode())), nil
+}
+
+// UnmarshalText implements the TextUnmarshaler interface.
+func (m *TOTPProvisioning) UnmarshalText(text []byte) error {
+	u, err := url.Parse(string(text))
+	if err != nil || u.Scheme != "otpauth" || u.Host != "totp" {
+		return errFormat
+	}
+	query := u.Query()
+	for key, expected := range map[string]string{
+		"algorithm": totpAlgorithm,
+		"digits":    strconv.Itoa(totpDigits),
+		"period":    strconv.Itoa(totpPeriod),
+	} {
+		if v := query.Get(key); v != "" && v != expected {
+			return errFormat
+		}
+	}
+	*m = TOTPProvisioning{
+		AccountName: strings.TrimPrefix(u.Path, "/"),
+		Secret:      query.Get("secret"),
+	}
+	if parts := strings.SplitN(m.AccountName, ":", 2); len(parts) == 2 {
+		m.Issuer, m.AccountName = parts[0], parts[1]
+	}
+	if issuer := query.Get("issuer"); issuer != "" {
+		m.Issuer = issuer
+	}
+	return m.Validate()
+}
@@ -0,0 +1,113 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qrcode_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/qrcode"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestTOTPProvisioning(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Data           []byte
+		CanonicalData  []byte
+		Expected       TOTPProvisioning
+		ErrorAssertion func(t *testing.T, err error) bool
+	}{
+		{
+			Name: "Simple",
+			Data: []byte("otpauth://totp/The%20Things%20Network:user@example.com?algorithm=SHA1&digits=6&issuer=The+Things+Network&period=30&secret=GEZDGNBVGY3TQOJQ"),
+			Expected: TOTPProvisioning{
+				Issuer:      "The Things Network",
+				AccountName: "user@example.com",
+				Secret:      "GEZDGNBVGY3TQOJQ",
+			},
+		},
+		{
+			Name: "NoIssuer",
+			Data: []byte("otpauth://totp/user?algorithm=SHA1&digits=6&period=30&secret=GEZDGNBVGY3TQOJQ"),
+			Expected: TOTPProvisioning{
+				AccountName: "user",
+				Secret:      "GEZDGNBVGY3TQOJQ",
+			},
+		},
+		{
+			Name:          "Defaults",
+			Data:          []byte("otpauth://totp/TTN:user?secret=GEZDGNBVGY3TQOJQ"),
+			CanonicalData: []byte("otpauth://totp/TTN:user?algorithm=SHA1&digits=6&issuer=TTN&period=30&secret=GEZDGNBVGY3TQOJQ"),
+			Expected: TOTPProvisioning{
+				Issuer:      "TTN",
+				AccountName: "user",
+				Secret:      "GEZDGNBVGY3TQOJQ",
+			},
+		},
+		{
+			Name: "Invalid/Type",
+			Data: []byte("otpauth://hotp/TTN:user?secret=GEZDGNBVGY3TQOJQ&counter=1"),
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
+		{
+			Name: "Invalid/Algorithm",
+			Data: []byte("otpauth://totp/TTN:user?secret=GEZDGNBVGY3TQOJQ&algorithm=SHA256"),
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
+		{
+			Name: "Invalid/NoSecret",
+			Data: []byte("otpauth://totp/TTN:user"),
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
+		{
+			Name: "Invalid/SecretChars",
+			Data: []byte("otpauth://totp/TTN:user?secret=GEZDGNBVGY3TQOJ1"),
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var data TOTPProvisioning
+			err := data.UnmarshalText(tc.Data)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(t, err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) || !a.So(data, should.Resemble, tc.Expected) {
+				t.FailNow()
+			}
+
+			canonical := tc.CanonicalData
+			if canonical == nil {
+				canonical = tc.Data
+			}
+
+			text := test.Must(data.MarshalText()).([]byte)
+			a.So(string(text), should.Equal, string(canonical))
+		})
+	}
+}
@@ -23,6 +23,7 @@ var (
 	errNotFound            = errors.DefineNotFound("not_found", "entity not found")
 	errStore               = errors.Define("store", "store error")
 	errInvalidKeyValueType = errors.DefineInvalidArgument("value_type", "invalid value type for key `{key}`")
+	errInvalidStreamID     = errors.DefineInvalidArgument("stream_id", "invalid stream ID `{id}`")
 )
 
 // ConvertError converts Redis error into errors.Error.
@@ -554,12 +554,108 @@ func PopTask(r redis.Cmdable, group, id string, timeout time.Duration, f func(k
 	return nil
 }
 
+// pendingTaskCount is the number of pending tasks inspected at once by RequeuePendingTasks.
+const pendingTaskCount = 100
+
+// nextStreamID returns the stream entry ID directly following id.
+func nextStreamID(id string) (string, error) {
+	i := strings.IndexByte(id, '-')
+	if i < 0 {
+		return "", errInvalidStreamID.WithAttributes("id", id)
+	}
+	seq, err := strconv.ParseUint(id[i+1:], 10, 64)
+	if err != nil {
+		return "", errInvalidStreamID.WithAttributes("id", id).WithCause(err)
+	}
+	return fmt.Sprintf("%s-%d", id[:i], seq+1), nil
+}
+
+// RequeuePendingTasks moves the tasks, which were read from the streams at ReadyTaskKey(k) for each k in ks
+// by any consumer of group group, but were not acked for at least minIdle, back to the streams at InputTaskKey(k).
+// The tasks are claimed by consumer id and acked once requeued.
+// This recovers the tasks of consumers, which failed to process them or stopped before acking them.
+func RequeuePendingTasks(r redis.Cmdable, group, id string, minIdle time.Duration, maxLen int64, ks ...string) error {
+	for _, k := range ks {
+		stream := ReadyTaskKey(k)
+		start := "-"
+		for {
+			pending, err := r.XPendingExt(&redis.XPendingExtArgs{
+				Stream: stream,
+				Group:  group,
+				Start:  start,
+				End:    "+",
+				Count:  pendingTaskCount,
+			}).Result()
+			if err != nil && err != redis.Nil {
+				return ConvertError(err)
+			}
+			ids := make([]string, 0, len(pending))
+			for _, p := range pending {
+				if p.Idle >= minIdle {
+					ids = append(ids, p.Id)
+				}
+			}
+			if len(ids) > 0 {
+				msgs, err := r.XClaim(&redis.XClaimArgs{
+					Stream:   stream,
+					Group:    group,
+					Consumer: id,
+					MinIdle:  minIdle,
+					Messages: ids,
+				}).Result()
+				if err != nil && err != redis.Nil {
+					return ConvertError(err)
+				}
+				if len(msgs) > 0 {
+					_, err = r.Pipelined(func(p redis.Pipeliner) error {
+						toAck := make([]string, 0, len(msgs))
+						for _, msg := range msgs {
+							toAck = append(toAck, msg.ID)
+							payload, ok := msg.Values[payloadKey]
+							if !ok {
+								continue
+							}
+							m := map[string]interface{}{
+								payloadKey: payload,
+							}
+							if startAt, ok := msg.Values[startAtKey]; ok {
+								m[startAtKey] = startAt
+							}
+							p.XAdd(&redis.XAddArgs{
+								Stream:       InputTaskKey(k),
+								MaxLenApprox: maxLen,
+								Values:       m,
+							})
+						}
+						p.XAck(stream, group, toAck...)
+						return nil
+					})
+					if err != nil {
+						return ConvertError(err)
+					}
+				}
+			}
+			if len(pending) < pendingTaskCount {
+				break
+			}
+			start, err = nextStreamID(pending[len(pending)-1].Id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // TaskQueue is a task queue.
 type TaskQueue struct {
 	Redis     WatchCmdable
 	MaxLen    int64
 	Group, ID string
 	Key       string
+	// PendingTimeout is the time after which popped tasks, which are not acked, are requeued by Run.
+	// If PendingTimeout is 0, such tasks are not requeued.
+	PendingTimeout time.Duration
 }
 
 // Init initializes the task queue.
@@ -569,6 +665,8 @@ func (q *TaskQueue) Init() error {
 }
 
 // Run dispatches tasks until ctx.Deadline() is reached(if present) or read on ctx.Done() succeeds.
+// If PendingTimeout is set, Run requeues the tasks, which were not acked within PendingTimeout, on start and
+// every PendingTimeout thereafter.
 func (q *TaskQueue) Run(ctx context.Context) error {
 	if err := q.Init(); err != nil {
 		return err
@@ -583,6 +681,7 @@ func (q *TaskQueue) Run(ctx context.Context) error {
 		hasDeadline = !dl.IsZero()
 	}
 
+	var requeueAt time.Time
 	for {
 		select {
 		case <-ctx.Done():
@@ -590,8 +689,21 @@ func (q *TaskQueue) Run(ctx context.Context) error {
 		default:
 		}
 
+		deadline := min
+		if q.PendingTimeout > 0 {
+			if now := time.Now(); !now.Before(requeueAt) {
+				if err := RequeuePendingTasks(q.Redis, q.Group, q.ID, q.PendingTimeout, q.MaxLen, q.Key); err != nil {
+					return err
+				}
+				requeueAt = now.Add(q.PendingTimeout)
+			}
+			if deadline.IsZero() || requeueAt.Before(deadline) {
+				deadline = requeueAt
+			}
+		}
+
 		var err error
-		min, err = DispatchTasks(q.Redis, q.Group, q.ID, q.MaxLen, min, q.Key)
+		min, err = DispatchTasks(q.Redis, q.Group, q.ID, q.MaxLen, deadline, q.Key)
 		if err != nil {
 			return err
 		}
@@ -419,6 +419,90 @@ func TestPopTask(t *testing.T) {
 	a.So(xp, should.Resemble, &redis.XPending{})
 }
 
+func TestRequeuePendingTasks(t *testing.T) {
+	a := assertions.New(t)
+
+	cl, flush := test.NewRedis(t, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	group, k := cl.Key("testGroup"), cl.Key("testKey")
+	if err := InitTaskGroup(cl, group, k); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	_, err := cl.XAdd(&redis.XAddArgs{
+		Stream: ReadyTaskKey(k),
+		Values: map[string]interface{}{
+			"start_at": fmt.Sprintf("%d", time.Unix(0, 42).UnixNano()),
+			"payload":  "testPayload",
+		},
+	}).Result()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	errTest := errors.New("test")
+	err = PopTask(cl, group, "testID", -1, func(_ string, payload string, _ time.Time) error {
+		a.So(payload, should.Equal, "testPayload")
+		return errTest
+	}, k)
+	a.So(err, should.Equal, errTest)
+
+	pending := func() []redis.XPendingExt {
+		pending, err := cl.XPendingExt(&redis.XPendingExtArgs{
+			Stream: ReadyTaskKey(k),
+			Group:  group,
+			Start:  "-",
+			End:    "+",
+			Count:  10,
+		}).Result()
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return pending
+	}
+	if a.So(pending(), should.HaveLength, 1) {
+		a.So(pending()[0].Consumer, should.Equal, "testID")
+	}
+
+	// The task is not pending for long enough.
+	err = RequeuePendingTasks(cl, group, "testID2", time.Hour, 42, k)
+	a.So(err, should.BeNil)
+	a.So(pending(), should.HaveLength, 1)
+	n, err := cl.XLen(InputTaskKey(k)).Result()
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, int64(0))
+
+	time.Sleep(test.Delay)
+
+	err = RequeuePendingTasks(cl, group, "testID2", test.Delay, 42, k)
+	a.So(err, should.BeNil)
+	a.So(pending(), should.BeEmpty)
+	msgs, err := cl.XRange(InputTaskKey(k), "-", "+").Result()
+	a.So(err, should.BeNil)
+	if a.So(msgs, should.HaveLength, 1) {
+		a.So(msgs[0].Values, should.Resemble, map[string]interface{}{
+			"start_at": fmt.Sprintf("%d", time.Unix(0, 42).UnixNano()),
+			"payload":  "testPayload",
+		})
+	}
+
+	_, err = DispatchTasks(cl, group, "testID", 42, time.Now(), k)
+	a.So(err, should.BeNil)
+
+	var popped bool
+	err = PopTask(cl, group, "testID", -1, func(_ string, payload string, startAt time.Time) error {
+		popped = true
+		a.So(payload, should.Equal, "testPayload")
+		a.So(startAt, should.Equal, time.Unix(0, 42).UTC())
+		return nil
+	}, k)
+	a.So(err, should.BeNil)
+	a.So(popped, should.BeTrue)
+	a.So(pending(), should.BeEmpty)
+}
+
 func TestInitTaskGroup(t *testing.T) {
 	for _, tc := range []struct {
 		Name           string
@@ -0,0 +1,90 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcmetadata
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// inProcessAuthType is the auth type of the in-process connections of rpcserver.StartLoopback.
+// These connections are used by the HTTP gateway of the gRPC server.
+const inProcessAuthType = "in-process"
+
+// RemoteAddress returns the address of the client of the request in the context.
+//
+// The address forwarded in the x-forwarded-for metadata is only used if the request comes
+// from the in-process connection of the HTTP gateway, or from one of the trusted proxies.
+// Of the forwarded addresses, the last one is used, as that is added by the proxy.
+func RemoteAddress(ctx context.Context, trustedProxies ...*net.IPNet) (net.IP, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	var addr net.IP
+	trusted := p.AuthInfo != nil && p.AuthInfo.AuthType() == inProcessAuthType
+	if !trusted && p.Addr != nil {
+		addr = parseIP(p.Addr.String())
+		for _, proxy := range trustedProxies {
+			if addr != nil && proxy.Contains(addr) {
+				trusted = true
+				break
+			}
+		}
+	}
+	if trusted {
+		if forwarded := forwardedFor(ctx); forwarded != nil {
+			return forwarded, true
+		}
+	}
+	if addr == nil {
+		return nil, false
+	}
+	return addr, true
+}
+
+func forwardedFor(ctx context.Context) net.IP {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md["x-forwarded-for"]
+	if len(values) == 0 {
+		return nil
+	}
+	addrs := strings.Split(values[len(values)-1], ",")
+	return parseIP(addrs[len(addrs)-1])
+}
+
+func parseIP(s string) net.IP {
+	s = strings.TrimSpace(s)
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+	return net.ParseIP(s)
+}
+
+type remoteAddr string
+
+func (remoteAddr) Network() string  { return "tcp" }
+func (a remoteAddr) String() string { return string(a) }
+
+// NewContextWithRemoteAddress returns a derived context with the address of the client of a request that does not
+// come in over gRPC, such as an MQTT connection or an HTTP request. The address is returned by RemoteAddress and
+// forwarded by WithForwardedAuth.
+func NewContextWithRemoteAddress(ctx context.Context, addr string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{Addr: remoteAddr(addr)})
+}
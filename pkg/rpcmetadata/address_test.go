@@ -0,0 +1,110 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcmetadata_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type authInfo string
+
+func (a authInfo) AuthType() string { return string(a) }
+
+func TestRemoteAddress(t *testing.T) {
+	_, trustedProxy, _ := net.ParseCIDR("10.0.0.0/8")
+
+	for _, tc := range []struct {
+		Name           string
+		Peer           *peer.Peer
+		ForwardedFor   []string
+		TrustedProxies []*net.IPNet
+		Address        string
+	}{
+		{
+			Name: "NoPeer",
+		},
+		{
+			Name:    "TCP",
+			Peer:    &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}},
+			Address: "192.0.2.1",
+		},
+		{
+			Name:         "TCPForwarded",
+			Peer:         &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}},
+			ForwardedFor: []string{"198.51.100.1"},
+			Address:      "192.0.2.1",
+		},
+		{
+			Name:           "TrustedProxyForwarded",
+			Peer:           &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}},
+			ForwardedFor:   []string{"198.51.100.1"},
+			TrustedProxies: []*net.IPNet{trustedProxy},
+			Address:        "198.51.100.1",
+		},
+		{
+			Name:           "TrustedProxy",
+			Peer:           &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}},
+			TrustedProxies: []*net.IPNet{trustedProxy},
+			Address:        "10.0.0.1",
+		},
+		{
+			Name:         "InProcessForwarded",
+			Peer:         &peer.Peer{Addr: &net.UnixAddr{Name: "in-process"}, AuthInfo: authInfo("in-process")},
+			ForwardedFor: []string{"203.0.113.1", "192.0.2.1, 198.51.100.1"},
+			Address:      "198.51.100.1",
+		},
+		{
+			Name: "InProcess",
+			Peer: &peer.Peer{Addr: &net.UnixAddr{Name: "in-process"}, AuthInfo: authInfo("in-process")},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+			if tc.Peer != nil {
+				ctx = peer.NewContext(ctx, tc.Peer)
+			}
+			if len(tc.ForwardedFor) > 0 {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{"x-forwarded-for": tc.ForwardedFor})
+			}
+			addr, ok := RemoteAddress(ctx, tc.TrustedProxies...)
+			if tc.Address == "" {
+				a.So(ok, should.BeFalse)
+				return
+			}
+			if a.So(ok, should.BeTrue) {
+				a.So(addr.String(), should.Equal, tc.Address)
+			}
+		})
+	}
+}
+
+func TestNewContextWithRemoteAddress(t *testing.T) {
+	a := assertions.New(t)
+	ctx := metadata.NewIncomingContext(test.Context(), metadata.MD{"x-forwarded-for": []string{"198.51.100.1"}})
+	ctx = NewContextWithRemoteAddress(ctx, "192.0.2.1:1234")
+	addr, ok := RemoteAddress(ctx)
+	if a.So(ok, should.BeTrue) {
+		a.So(addr.String(), should.Equal, "192.0.2.1")
+	}
+}
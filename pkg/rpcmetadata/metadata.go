@@ -37,6 +37,10 @@ type MD struct {
 
 	// URI is the URI the request is directed to.
 	URI string
+
+	// ForwardedFor is the address of the client that the request is forwarded for.
+	// This field is not read from incoming metadata; use RemoteAddress instead.
+	ForwardedFor string
 }
 
 // RequireTransportSecurity returns true if authentication is configured
@@ -68,6 +72,9 @@ func (m MD) ToMetadata() metadata.MD {
 	if m.URI != "" {
 		pairs = append(pairs, "uri", m.URI)
 	}
+	if m.ForwardedFor != "" {
+		pairs = append(pairs, "x-forwarded-for", m.ForwardedFor)
+	}
 	return metadata.Pairs(pairs...)
 }
 
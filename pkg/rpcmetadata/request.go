@@ -30,17 +30,24 @@ func (m MD) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]s
 	if m.AuthType != "" && m.AuthValue != "" {
 		md["authorization"] = m.AuthType + " " + m.AuthValue
 	}
+	if m.ForwardedFor != "" {
+		md["x-forwarded-for"] = m.ForwardedFor
+	}
 	return md, nil
 }
 
 var errUnauthenticated = errors.DefineUnauthenticated("unauthenticated", "the context is not authenticated")
 
 // WithForwardedAuth returns a grpc.CallOption with authentication from the incoming context ctx.
+// The remote address of the incoming request is forwarded as well.
 func WithForwardedAuth(ctx context.Context, allowInsecure bool) (grpc.CallOption, error) {
 	md := FromIncomingContext(ctx)
 	if md.AuthType == "" || md.AuthValue == "" {
 		return nil, errUnauthenticated
 	}
 	md.AllowInsecure = allowInsecure
+	if addr, ok := RemoteAddress(ctx); ok {
+		md.ForwardedFor = addr.String()
+	}
 	return grpc.PerRPCCredentials(md), nil
 }
@@ -15,6 +15,7 @@
 package rpcmetadata_test
 
 import (
+	"net"
 	"testing"
 
 	"github.com/smartystreets/assertions"
@@ -24,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 func TestRequestMetadata(t *testing.T) {
@@ -73,6 +75,22 @@ func TestRequestMetadata(t *testing.T) {
 		})
 	}
 
+	{
+		ctx := metadata.NewIncomingContext(test.Context(), metadata.New(map[string]string{
+			"authorization":   "Key foo",
+			"x-forwarded-for": "192.0.2.1",
+		}))
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.1"), Port: 1234}})
+		callOpt, err := WithForwardedAuth(ctx, true)
+		a.So(err, should.BeNil)
+		requestMD, err := callOpt.(grpc.PerRPCCredsCallOption).Creds.GetRequestMetadata(ctx)
+		a.So(err, should.BeNil)
+		a.So(requestMD, should.Resemble, map[string]string{
+			"authorization":   "Key foo",
+			"x-forwarded-for": "198.51.100.1",
+		})
+	}
+
 	{
 		ctx := metadata.NewIncomingContext(test.Context(), metadata.New(map[string]string{
 			"id":   "some-id",
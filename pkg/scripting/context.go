@@ -0,0 +1,33 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scripting
+
+import "context"
+
+type runtimeKeyType struct{}
+
+var runtimeKey = &runtimeKeyType{}
+
+// NewContextWithRuntimeKey returns a derived context with the runtime key set. Engines that reuse runtimes only reuse
+// them for runs with the same runtime key, such as the unique ID of the application that the script belongs to.
+func NewContextWithRuntimeKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, runtimeKey, key)
+}
+
+// RuntimeKeyFromContext returns the runtime key that is attached to the context, or an empty string if it is not set.
+func RuntimeKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(runtimeKey).(string)
+	return key
+}
@@ -0,0 +1,165 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package javascript
+
+import (
+	"container/list"
+	"crypto/sha256"
+
+	"github.com/robertkrimen/otto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// program is a compiled script with its idle runtimes.
+type program struct {
+	key    [sha256.Size]byte
+	script *otto.Script
+	idle   []*runtime
+}
+
+// runtime is a JavaScript virtual machine.
+type runtime struct {
+	vm *otto.Otto
+	// The following fields are only set for runtimes that are reused.
+	global *otto.Object
+	// pristine are the names of the own properties of the pristine global object.
+	pristine map[string]struct{}
+	// ownPropertyNames is the Object.getOwnPropertyNames function.
+	ownPropertyNames otto.Value
+	// resetProperty is the function that removes a property that a run added to the global object.
+	resetProperty otto.Value
+}
+
+var errReset = errors.DefineInternal("reset", "reset runtime")
+
+// names returns the names of the own properties of the global object.
+func (rt *runtime) names() ([]string, error) {
+	v, err := rt.ownPropertyNames.Call(otto.UndefinedValue(), rt.global)
+	if err != nil {
+		return nil, err
+	}
+	names, err := v.Export()
+	if err != nil {
+		return nil, err
+	}
+	switch names := names.(type) {
+	case []string:
+		return names, nil
+	case []interface{}:
+		if len(names) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, errReset
+}
+
+// reset removes the state that a run left in the global scope, so that the runtime can be reused.
+// If the state cannot be removed, reset returns an error and the runtime must not be reused.
+func (rt *runtime) reset() error {
+	if rt.global == nil {
+		return errReset
+	}
+	names, err := rt.names()
+	if err != nil {
+		return errReset.WithCause(err)
+	}
+	for _, name := range names {
+		if _, ok := rt.pristine[name]; ok {
+			continue
+		}
+		v, err := rt.resetProperty.Call(otto.UndefinedValue(), rt.global, name)
+		if err != nil {
+			return errReset.WithCause(err)
+		}
+		if ok, err := v.ToBoolean(); err != nil || !ok {
+			return errReset
+		}
+	}
+	return nil
+}
+
+// cache is a LRU cache of compiled scripts.
+// The cache is not safe for concurrent use.
+type cache struct {
+	size     int
+	poolSize int
+	programs map[[sha256.Size]byte]*list.Element
+	lru      *list.List
+	idle     int
+}
+
+func newCache(size, poolSize int) *cache {
+	return &cache{
+		size:     size,
+		poolSize: poolSize,
+		programs: make(map[[sha256.Size]byte]*list.Element, size),
+		lru:      list.New(),
+	}
+}
+
+// get returns the program identified by key and an idle runtime of the program, if any.
+func (c *cache) get(key [sha256.Size]byte) (*program, *runtime) {
+	el, ok := c.programs[key]
+	if !ok {
+		return nil, nil
+	}
+	c.lru.MoveToFront(el)
+	prog := el.Value.(*program)
+	n := len(prog.idle)
+	if n == 0 {
+		return prog, nil
+	}
+	rt := prog.idle[n-1]
+	prog.idle[n-1] = nil
+	prog.idle = prog.idle[:n-1]
+	c.idle--
+	return prog, rt
+}
+
+// add adds the compiled script identified by key and returns the cached program.
+// If the cache is full, the least recently used program is evicted with its idle runtimes.
+func (c *cache) add(key [sha256.Size]byte, script *otto.Script) *program {
+	if el, ok := c.programs[key]; ok {
+		c.lru.MoveToFront(el)
+		return el.Value.(*program)
+	}
+	prog := &program{
+		key:    key,
+		script: script,
+	}
+	c.programs[key] = c.lru.PushFront(prog)
+	for c.lru.Len() > c.size {
+		el := c.lru.Back()
+		evicted := c.lru.Remove(el).(*program)
+		delete(c.programs, evicted.key)
+		c.idle -= len(evicted.idle)
+		evicted.idle = nil
+	}
+	return prog
+}
+
+// put returns the idle runtime rt of prog to the pool.
+// The runtime is dropped if the pool is full or if the program has been evicted.
+func (c *cache) put(prog *program, rt *runtime) bool {
+	if c.idle >= c.poolSize {
+		return false
+	}
+	if el, ok := c.programs[prog.key]; !ok || el.Value.(*program) != prog {
+		return false
+	}
+	prog.idle = append(prog.idle, rt)
+	c.idle++
+	return true
+}
@@ -17,7 +17,9 @@ package javascript
 
 import (
 	"context"
+	"crypto/sha256"
 	"runtime/trace"
+	"sync"
 	"time"
 
 	"github.com/robertkrimen/otto"
@@ -27,16 +29,137 @@ import (
 
 type js struct {
 	options scripting.Options
+
+	mu    sync.Mutex
+	cache *cache
 }
 
 // New returns a new Javascript scripting engine.
+// If options.CacheSize is set, compiled scripts are cached by their hash and runtimes are reused for the same script
+// and runtime key.
 func New(options scripting.Options) scripting.Engine {
-	return &js{options}
+	j := &js{
+		options: options,
+	}
+	if options.CacheSize > 0 {
+		j.cache = newCache(options.CacheSize, options.PoolSize)
+	}
+	return j
 }
 
-var errRuntime = errors.Define("runtime", "runtime error")
+var (
+	errRuntime    = errors.Define("runtime", "runtime error")
+	errScriptSize = errors.DefineResourceExhausted("script_size", "script size of {size} bytes exceeds the limit of {limit} bytes", "size", "limit")
+)
+
+// resetPropertyScript is a function that removes a property of the global object. Properties that cannot be deleted,
+// such as global variables, are set to undefined. The function returns false if the property can neither be deleted
+// nor set to undefined.
+const resetPropertyScript = `
+(function (global, name) {
+	if (delete global[name]) {
+		return true;
+	}
+	var descriptor = Object.getOwnPropertyDescriptor(global, name);
+	if (!descriptor.hasOwnProperty("value") || !descriptor.writable) {
+		return false;
+	}
+	global[name] = undefined;
+	return true;
+})
+`
+
+// freezeScript freezes the built-in objects and their prototypes, and makes the built-in global properties read-only,
+// so that a run cannot change the built-in objects for later runs in the same runtime.
+const freezeScript = `
+(function (global) {
+	Object.getOwnPropertyNames(global).forEach(function (name) {
+		var value = global[name];
+		if (value !== null && (typeof value === "object" || typeof value === "function")) {
+			Object.freeze(value);
+			if (value.prototype) {
+				Object.freeze(value.prototype);
+			}
+		}
+		Object.defineProperty(global, name, { writable: false, configurable: false });
+	});
+})(this);
+`
+
+// newRuntime returns a new runtime. The built-in objects of runtimes that are reused are frozen.
+func (j *js) newRuntime(reuse bool) (*runtime, error) {
+	vm := otto.New()
+	vm.SetStackDepthLimit(j.options.StackDepthLimit)
+	vm.Interrupt = make(chan func(), 1)
+	rt := &runtime{
+		vm: vm,
+	}
+	if !reuse {
+		return rt, nil
+	}
+	global, err := vm.Object("this")
+	if err != nil {
+		return nil, err
+	}
+	rt.global = global
+	if rt.ownPropertyNames, err = vm.Run("Object.getOwnPropertyNames"); err != nil {
+		return nil, err
+	}
+	if rt.resetProperty, err = vm.Run(resetPropertyScript); err != nil {
+		return nil, err
+	}
+	names, err := rt.names()
+	if err != nil {
+		return nil, err
+	}
+	rt.pristine = make(map[string]struct{}, len(names))
+	for _, name := range names {
+		rt.pristine[name] = struct{}{}
+	}
+	if _, err := vm.Run(freezeScript); err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
+
+// compiled returns the cached program of script and an idle runtime for the runtime key in the context.
+// If the script is not cached yet, it is compiled and added to the cache.
+// Programs are cached by runtime key, so that runtimes are not shared between runtime keys.
+func (j *js) compiled(ctx context.Context, script string) (*program, *runtime, error) {
+	h := sha256.New()
+	h.Write([]byte(scripting.RuntimeKeyFromContext(ctx)))
+	h.Write([]byte{0})
+	h.Write([]byte(script))
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+	j.mu.Lock()
+	prog, rt := j.cache.get(key)
+	j.mu.Unlock()
+	if prog != nil {
+		cacheLookups.WithLabelValues("hit").Inc()
+	} else {
+		cacheLookups.WithLabelValues("miss").Inc()
+	}
+	if rt == nil {
+		var err error
+		rt, err = j.newRuntime(j.options.PoolSize > 0)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if prog != nil {
+		return prog, rt, nil
+	}
+	compiled, err := rt.vm.Compile("", script)
+	if err != nil {
+		return nil, nil, errRuntime.WithCause(err)
+	}
+	j.mu.Lock()
+	prog = j.cache.add(key, compiled)
+	j.mu.Unlock()
+	return prog, rt, nil
+}
 
-// Run executes the Javascript script in the environment env and returns the output.
 func (j *js) Run(ctx context.Context, script string, env map[string]interface{}) (val interface{}, err error) {
 	defer trace.StartRegion(ctx, "run javascript").End()
 
@@ -50,9 +173,41 @@ func (j *js) Run(ctx context.Context, script string, env map[string]interface{})
 		}
 	}()
 
-	vm := otto.New()
-	vm.SetStackDepthLimit(j.options.StackDepthLimit)
+	if j.options.MaxScriptSize > 0 && len(script) > j.options.MaxScriptSize {
+		return nil, errScriptSize.WithAttributes(
+			"size", len(script),
+			"limit", j.options.MaxScriptSize,
+		)
+	}
+
+	if j.cache == nil {
+		rt, err := j.newRuntime(false)
+		if err != nil {
+			return nil, err
+		}
+		return j.run(ctx, rt, script, env)
+	}
 
+	prog, rt, err := j.compiled(ctx, script)
+	if err != nil {
+		return nil, err
+	}
+	val, err = j.run(ctx, rt, prog.script, env)
+	if err != nil {
+		// The runtime may be left in an inconsistent state after an error, so it is not reused.
+		return nil, err
+	}
+	if rt.reset() == nil {
+		j.mu.Lock()
+		j.cache.put(prog, rt)
+		j.mu.Unlock()
+	}
+	return val, nil
+}
+
+// run runs src, which is either a script or a compiled script, in rt.
+func (j *js) run(ctx context.Context, rt *runtime, src interface{}, env map[string]interface{}) (val interface{}, err error) {
+	vm := rt.vm
 	err = vm.Set("env", env)
 	if err != nil {
 		return
@@ -70,17 +225,29 @@ func (j *js) Run(ctx context.Context, script string, env map[string]interface{})
 		}
 	}()
 
-	vm.Interrupt = make(chan func(), 1)
 	ctx, cancel := context.WithTimeout(ctx, j.options.Timeout)
 	defer cancel()
+	stop, stopped := make(chan struct{}), make(chan struct{})
 	go func() {
-		<-ctx.Done()
-		vm.Interrupt <- func() {
-			panic(context.DeadlineExceeded)
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			vm.Interrupt <- func() {
+				panic(context.DeadlineExceeded)
+			}
+		case <-stop:
+		}
+	}()
+	defer func() {
+		close(stop)
+		<-stopped
+		select {
+		case <-vm.Interrupt:
+		default:
 		}
 	}()
 
-	output, err := vm.Run(script)
+	output, err := vm.Run(src)
 	if err != nil {
 		return nil, errRuntime.WithCause(err)
 	}
@@ -0,0 +1,45 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package javascript
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/scripting"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRuntimeKey(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	e := New(scripting.DefaultOptions).(*js)
+	for _, app := range []string{"foo", "bar", "foo", "bar", ""} {
+		output, err := e.Run(scripting.NewContextWithRuntimeKey(ctx, app), `env.app`, map[string]interface{}{
+			"app": app,
+		})
+		a.So(err, should.BeNil)
+		a.So(output, should.Equal, app)
+	}
+
+	// Runtimes are pooled per runtime key.
+	a.So(e.cache.programs, should.HaveLength, 3)
+	for el := e.cache.lru.Front(); el != nil; el = el.Next() {
+		a.So(el.Value.(*program).idle, should.HaveLength, 1)
+	}
+}
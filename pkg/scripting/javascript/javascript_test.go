@@ -96,3 +96,136 @@ func TestRunTimeout(t *testing.T) {
 	a.So(err, should.NotBeNil)
 	a.So(errors.IsDeadlineExceeded(errors.Cause(err)), should.BeTrue)
 }
+
+func TestRunCached(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	script := `
+		var runs = (typeof runs === "undefined" ? 0 : runs) + 1;
+		this.leaked = this.leaked || env.x;
+		(function () {
+			return {
+				x: env.x,
+				runs: runs,
+				leaked: leaked
+			}
+		})()
+	`
+
+	e := New(scripting.DefaultOptions)
+	for i := 1; i <= 3; i++ {
+		output, err := e.Run(ctx, script, map[string]interface{}{
+			"x": i,
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		m := output.(map[string]interface{})
+		a.So(m["x"], should.Equal, i)
+		a.So(m["runs"], should.Equal, 1)
+		a.So(m["leaked"], should.Equal, i)
+	}
+
+	// Runtimes are discarded after a timeout; the next run is not interrupted.
+	_, err := e.Run(ctx, `while (true) { }`, nil)
+	a.So(errors.IsDeadlineExceeded(errors.Cause(err)), should.BeTrue)
+	_, err = e.Run(ctx, `while (true) { }`, nil)
+	a.So(errors.IsDeadlineExceeded(errors.Cause(err)), should.BeTrue)
+	output, err := e.Run(ctx, script, map[string]interface{}{
+		"x": 42,
+	})
+	a.So(err, should.BeNil)
+	a.So(output.(map[string]interface{})["x"], should.Equal, 42)
+
+	// Built-in objects cannot be changed for later runs.
+	builtins := `
+		var before = [].concat([1]).length + "," + JSON.stringify(1);
+		Array.prototype.concat = function () { return []; };
+		JSON.stringify = function () { return "changed"; };
+		JSON = null;
+		before + "," + [].concat([1]).length + "," + JSON.stringify(1)
+	`
+	for i := 0; i < 2; i++ {
+		output, err = e.Run(ctx, builtins, nil)
+		a.So(err, should.BeNil)
+		a.So(output, should.Equal, "1,1,1,1")
+	}
+
+	// Non-enumerable global properties are removed for later runs.
+	hidden := `
+		var before = typeof hidden;
+		Object.defineProperty(this, "hidden", { value: env.x, enumerable: false, writable: true, configurable: true });
+		before
+	`
+	for i := 0; i < 2; i++ {
+		output, err = e.Run(ctx, hidden, map[string]interface{}{
+			"x": i,
+		})
+		a.So(err, should.BeNil)
+		a.So(output, should.Equal, "undefined")
+	}
+}
+
+func TestRunScriptSize(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	options := scripting.DefaultOptions
+	options.MaxScriptSize = 16
+	e := New(options)
+	_, err := e.Run(ctx, `(function () { return 42 })()`, nil)
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+	output, err := e.Run(ctx, `42`, nil)
+	a.So(err, should.BeNil)
+	a.So(output, should.Equal, 42)
+}
+
+func BenchmarkRun(b *testing.B) {
+	ctx := test.Context()
+
+	script := `
+		function Decoder(bytes, port) {
+			var decoded = {};
+			decoded.temperature = ((bytes[0] << 8) | bytes[1]) / 100;
+			decoded.humidity = bytes[2] / 2;
+			decoded.port = port;
+			return decoded;
+		}
+		Decoder(env.payload, env.f_port)
+	`
+	env := map[string]interface{}{
+		"payload": []byte{0x09, 0x29, 0x64},
+		"f_port":  1,
+	}
+
+	for _, tc := range []struct {
+		Name    string
+		Options scripting.Options
+	}{
+		{
+			Name: "Uncached",
+			Options: scripting.Options{
+				StackDepthLimit: scripting.DefaultOptions.StackDepthLimit,
+				Timeout:         scripting.DefaultOptions.Timeout,
+			},
+		},
+		{
+			Name:    "Cached",
+			Options: scripting.DefaultOptions,
+		},
+	} {
+		b.Run(tc.Name, func(b *testing.B) {
+			e := New(tc.Options)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := e.Run(ctx, script, env); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -38,6 +38,15 @@ var runLatency = metrics.NewHistogram(
 	},
 )
 
+var cacheLookups = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "cache_lookups_total",
+		Help:      "Lookups of compiled JavaScript scripts",
+	},
+	[]string{"result"},
+)
+
 func init() {
-	metrics.MustRegister(runs, runLatency)
+	metrics.MustRegister(runs, runLatency, cacheLookups)
 }
@@ -21,10 +21,21 @@ import "time"
 type Options struct {
 	StackDepthLimit int
 	Timeout         time.Duration
+
+	// MaxScriptSize is the maximum size of a script in bytes. Zero means no limit.
+	MaxScriptSize int
+	// CacheSize is the number of compiled scripts that are kept in memory. Zero disables caching.
+	CacheSize int
+	// PoolSize is the number of idle runtimes of cached scripts that are kept in memory.
+	// Runtimes are only reused for the same script and runtime key (see NewContextWithRuntimeKey), and the built-in
+	// objects of pooled runtimes are frozen. Zero disables pooling.
+	PoolSize int
 }
 
 // DefaultOptions are the default Options.
 var DefaultOptions = Options{
 	StackDepthLimit: 32,
 	Timeout:         100 * time.Millisecond,
+	CacheSize:       1024,
+	PoolSize:        64,
 }
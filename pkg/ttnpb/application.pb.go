@@ -491,10 +491,12 @@ func (m *GetApplicationAPIKeyRequest) GetKeyID() string {
 
 type CreateApplicationAPIKeyRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
-	Name                   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights                 []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	Name                   string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights                 []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt              *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedCIDRs           []string   `protobuf:"bytes,5,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}   `json:"-"`
+	XXX_sizecache          int32      `json:"-"`
 }
 
 func (m *CreateApplicationAPIKeyRequest) Reset()      { *m = CreateApplicationAPIKeyRequest{} }
@@ -543,11 +545,28 @@ func (m *CreateApplicationAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateApplicationAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateApplicationAPIKeyRequest) GetAllowedCIDRs() []string {
+	if m != nil {
+		return m.AllowedCIDRs
+	}
+	return nil
+}
+
 type UpdateApplicationAPIKeyRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	APIKey                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	// The names of the API key fields that should be updated.
+	// If empty, only the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateApplicationAPIKeyRequest) Reset()      { *m = UpdateApplicationAPIKeyRequest{} }
@@ -582,6 +601,13 @@ func (m *UpdateApplicationAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateApplicationAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateApplicationAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type ListApplicationCollaboratorsRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	// Limit the number of results per page.
@@ -762,77 +788,81 @@ func init() {
 }
 
 var fileDescriptor_57d90136b1f4f7b1 = []byte{
-	// 1109 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4b, 0x6c, 0x1b, 0x45,
-	0x18, 0xde, 0xf1, 0x23, 0xa9, 0xc7, 0x79, 0x69, 0x45, 0x61, 0x95, 0xc0, 0xc4, 0xdd, 0x46, 0x55,
-	0x5a, 0xb2, 0x6b, 0xe4, 0x5e, 0xa0, 0x02, 0x22, 0x6f, 0x78, 0xc8, 0x04, 0x1a, 0x58, 0xe8, 0x85,
-	0xaa, 0x58, 0x63, 0xef, 0x78, 0x33, 0xb2, 0xbd, 0xbb, 0xec, 0x8e, 0x53, 0x5c, 0x84, 0x54, 0x71,
-	0xaa, 0x38, 0x55, 0x9c, 0x10, 0x27, 0xd4, 0x03, 0xea, 0x81, 0x43, 0x4f, 0xa8, 0x12, 0x1c, 0x7a,
-	0x82, 0x1c, 0x38, 0xe4, 0x84, 0x7a, 0x0a, 0xf5, 0xfa, 0x12, 0x89, 0x4b, 0x8f, 0x95, 0x4f, 0x68,
-	0x67, 0xd7, 0xf5, 0xfa, 0x41, 0x24, 0x68, 0x65, 0xf5, 0x94, 0x99, 0xd9, 0xef, 0xff, 0xfe, 0xef,
-	0x9f, 0xf9, 0xbf, 0x19, 0x07, 0x9e, 0x6e, 0xd8, 0x2e, 0xbe, 0x8a, 0x2d, 0xc5, 0x63, 0xb8, 0x5a,
-	0xcf, 0x63, 0x87, 0xe6, 0xb1, 0xe3, 0x34, 0x68, 0x15, 0x33, 0x6a, 0x5b, 0xaa, 0xe3, 0xda, 0xcc,
-	0x16, 0x17, 0x18, 0xb3, 0xd4, 0x08, 0xa8, 0xee, 0x9d, 0x5f, 0x2e, 0x9a, 0x94, 0xed, 0xb6, 0x2a,
-	0x6a, 0xd5, 0x6e, 0xe6, 0x89, 0xb5, 0x67, 0xb7, 0x1d, 0xd7, 0xfe, 0xa2, 0x9d, 0xe7, 0xe0, 0xaa,
-	0x62, 0x12, 0x4b, 0xd9, 0xc3, 0x0d, 0x6a, 0x60, 0x46, 0xf2, 0x63, 0x83, 0x90, 0x72, 0x59, 0x89,
-	0x51, 0x98, 0xb6, 0x69, 0x87, 0xc1, 0x95, 0x56, 0x8d, 0xcf, 0xf8, 0x84, 0x8f, 0x22, 0x78, 0xce,
-	0xb4, 0x6d, 0xb3, 0x41, 0x06, 0xa8, 0x1a, 0x25, 0x0d, 0xa3, 0xdc, 0xc4, 0x5e, 0x3d, 0x42, 0xac,
-	0x8e, 0x22, 0x18, 0x6d, 0x12, 0x8f, 0xe1, 0xa6, 0x13, 0x01, 0xd6, 0xc6, 0x2b, 0xad, 0xda, 0x16,
-	0xc3, 0x55, 0x56, 0xa6, 0x56, 0xad, 0x9f, 0x68, 0xc2, 0x7e, 0x50, 0x83, 0x58, 0x8c, 0xd6, 0x28,
-	0x71, 0xbd, 0x08, 0x84, 0xc6, 0x41, 0x2e, 0x35, 0x77, 0x59, 0xf4, 0x5d, 0xfe, 0x31, 0x05, 0xb3,
-	0xc5, 0xc1, 0x2e, 0x8a, 0xef, 0xc1, 0x24, 0x35, 0x3c, 0x09, 0xe4, 0xc0, 0x7a, 0xb6, 0x70, 0x46,
-	0x1d, 0xde, 0x4d, 0x35, 0x86, 0x2c, 0x0d, 0x52, 0x69, 0x4b, 0x3d, 0x2d, 0xfd, 0x0d, 0x48, 0x2c,
-	0x81, 0xfd, 0xc3, 0x55, 0xe1, 0xe0, 0x70, 0x15, 0xe8, 0x01, 0x89, 0xb8, 0x05, 0x61, 0xd5, 0x25,
-	0x98, 0x11, 0xa3, 0x8c, 0x99, 0x94, 0xe0, 0x94, 0xcb, 0x6a, 0x58, 0xbc, 0xda, 0x2f, 0x5e, 0xfd,
-	0xa4, 0x5f, 0xbc, 0x76, 0x22, 0x08, 0xbf, 0xf9, 0xd7, 0x2a, 0xd0, 0x33, 0x51, 0x5c, 0x91, 0x05,
-	0x24, 0x2d, 0xc7, 0xe8, 0x93, 0x24, 0xff, 0x0b, 0x49, 0x14, 0x57, 0x64, 0xe2, 0x0a, 0x4c, 0x59,
-	0xb8, 0x49, 0xa4, 0x54, 0x0e, 0xac, 0x67, 0xb4, 0xd9, 0x9e, 0x96, 0x72, 0x13, 0x52, 0x41, 0xe7,
-	0x8b, 0xe2, 0x39, 0x98, 0x35, 0x88, 0x57, 0x75, 0xa9, 0x13, 0xd4, 0x25, 0xa5, 0x39, 0xe6, 0x44,
-	0x4f, 0x4b, 0xbb, 0x49, 0xe9, 0x60, 0x51, 0x8f, 0x7f, 0x14, 0xdb, 0x10, 0x62, 0xc6, 0x5c, 0x5a,
-	0x69, 0x31, 0xe2, 0x49, 0x33, 0xb9, 0xe4, 0x7a, 0xb6, 0xf0, 0xf2, 0x31, 0xbb, 0xa4, 0x16, 0x1f,
-	0xa3, 0xdf, 0xb6, 0x98, 0xdb, 0xd6, 0x36, 0x7a, 0xda, 0xd9, 0xef, 0xc1, 0x19, 0x79, 0xcd, 0x95,
-	0xa5, 0xb5, 0x02, 0xfa, 0xec, 0x32, 0x56, 0xae, 0xbd, 0xa2, 0xbc, 0x76, 0x65, 0x7d, 0xf3, 0xc2,
-	0x65, 0xe5, 0xca, 0x66, 0x7f, 0x7a, 0xf6, 0xcb, 0xc2, 0xc6, 0x57, 0x6b, 0x7a, 0x2c, 0x99, 0xf8,
-	0x26, 0x9c, 0x8b, 0x37, 0x81, 0x34, 0xcb, 0x93, 0xaf, 0x8c, 0x26, 0xdf, 0x0a, 0x31, 0x25, 0xab,
-	0x66, 0xeb, 0xd9, 0xea, 0x60, 0xb2, 0xfc, 0x06, 0x5c, 0x1c, 0x11, 0x23, 0x2e, 0xc1, 0x64, 0x9d,
-	0xb4, 0xf9, 0x61, 0x67, 0xf4, 0x60, 0x28, 0x3e, 0x07, 0xd3, 0x7b, 0xb8, 0xd1, 0x22, 0xfc, 0xb4,
-	0x32, 0x7a, 0x38, 0xb9, 0x90, 0x78, 0x15, 0xc8, 0x3b, 0x70, 0x2e, 0x56, 0x97, 0x27, 0x6e, 0xc2,
-	0xb9, 0x98, 0xfb, 0x82, 0x8e, 0x99, 0x28, 0x27, 0x16, 0xa3, 0x0f, 0x05, 0xc8, 0xbf, 0x00, 0x78,
-	0xf2, 0x5d, 0xc2, 0xe2, 0x00, 0xf2, 0x79, 0x8b, 0x78, 0x4c, 0xc4, 0x70, 0x31, 0x86, 0x2c, 0x3f,
-	0x8d, 0x7e, 0x5c, 0xc0, 0x71, 0x64, 0xa0, 0x1e, 0x0e, 0x6c, 0xf9, 0xaf, 0xad, 0xf9, 0x4e, 0x00,
-	0xf9, 0x00, 0x7b, 0x75, 0x2d, 0x15, 0x30, 0xe9, 0x99, 0x5a, 0x7f, 0x41, 0xfe, 0x3d, 0x01, 0x5f,
-	0x78, 0x9f, 0x7a, 0x71, 0xf9, 0x5e, 0x5f, 0xff, 0x47, 0xc1, 0x49, 0x35, 0x1a, 0xb8, 0x62, 0xbb,
-	0x98, 0xd9, 0x6e, 0x24, 0x5e, 0x19, 0x15, 0xbf, 0xe3, 0x9a, 0xd8, 0xa2, 0xd7, 0x78, 0xec, 0x8e,
-	0x7b, 0xc9, 0x23, 0x6e, 0xac, 0x06, 0x7d, 0x88, 0xe2, 0x89, 0xf5, 0x8a, 0x06, 0x4c, 0xdb, 0xae,
-	0x41, 0x5c, 0xee, 0xa0, 0x8c, 0x76, 0xb1, 0xa7, 0x6d, 0xbb, 0x25, 0x5d, 0x18, 0xda, 0x98, 0x32,
-	0x35, 0xf4, 0x45, 0x65, 0x64, 0x81, 0x7b, 0x44, 0x4f, 0x2b, 0xfc, 0x4f, 0xcc, 0xcf, 0x7a, 0x56,
-	0x89, 0x4d, 0x42, 0x72, 0x11, 0xc1, 0x74, 0x83, 0x36, 0x29, 0xe3, 0x46, 0x9b, 0xe7, 0x26, 0x3a,
-	0x97, 0x94, 0x8e, 0x66, 0xf5, 0x70, 0x59, 0x14, 0x61, 0xca, 0xc1, 0x26, 0xe1, 0x1e, 0x9b, 0xd7,
-	0xf9, 0x58, 0xfe, 0x03, 0x40, 0x69, 0x8b, 0x33, 0x4d, 0x68, 0x85, 0x1d, 0x98, 0x8d, 0xe9, 0x89,
-	0x76, 0xf2, 0xb8, 0x26, 0x9b, 0x70, 0xf6, 0x71, 0x06, 0xb1, 0x3c, 0x72, 0x36, 0x89, 0xff, 0x71,
-	0x36, 0xda, 0x5c, 0x3c, 0xc7, 0xf0, 0x49, 0xc9, 0x3f, 0x01, 0x28, 0x5d, 0xe2, 0x17, 0xcf, 0x34,
-	0xca, 0x79, 0xe2, 0x3e, 0xfe, 0x19, 0xc0, 0x97, 0x46, 0xfa, 0xb8, 0xf8, 0x61, 0x69, 0x9b, 0xb4,
-	0xbd, 0x29, 0xba, 0xf1, 0x71, 0xdb, 0x24, 0x8e, 0x6f, 0x9b, 0x64, 0xac, 0x6d, 0x6e, 0x01, 0xb8,
-	0x32, 0x7c, 0x7d, 0x84, 0xba, 0xa7, 0x28, 0x3b, 0x07, 0x67, 0xea, 0xa4, 0x5d, 0xa6, 0x46, 0x78,
-	0x5b, 0x6a, 0x19, 0xff, 0x70, 0x35, 0xbd, 0x4d, 0xda, 0xa5, 0xb7, 0xf4, 0x74, 0x9d, 0xb4, 0x4b,
-	0x86, 0x7c, 0x08, 0x20, 0x1a, 0xeb, 0xed, 0xa9, 0xeb, 0xec, 0xbf, 0x7e, 0x89, 0x49, 0xaf, 0xdf,
-	0xeb, 0x70, 0x26, 0xfc, 0x41, 0x20, 0x25, 0x73, 0xc9, 0xf5, 0x85, 0xc2, 0xc9, 0xd1, 0xb4, 0x7a,
-	0xf0, 0x55, 0x9b, 0xef, 0x69, 0xf0, 0x5b, 0x30, 0x2b, 0xa7, 0xbf, 0x0e, 0x52, 0xe9, 0x51, 0x8c,
-	0xfc, 0x1b, 0x80, 0x68, 0xac, 0xdb, 0xa7, 0x5e, 0x60, 0x11, 0xce, 0x62, 0x87, 0x96, 0x83, 0xb7,
-	0x2c, 0xb4, 0xc0, 0xf3, 0x63, 0xd4, 0x5c, 0xd2, 0x04, 0xaa, 0x19, 0xec, 0xd0, 0x6d, 0xd2, 0x96,
-	0x7f, 0x05, 0xf0, 0xf4, 0x88, 0x0f, 0xb6, 0x62, 0xb6, 0x7e, 0xd6, 0xdd, 0xf0, 0x37, 0x80, 0xa7,
-	0x86, 0xdd, 0x10, 0x57, 0x3f, 0x45, 0xf1, 0xd5, 0xa7, 0x71, 0xbf, 0x8e, 0xa7, 0x19, 0xbe, 0x63,
-	0xff, 0x04, 0xf0, 0xd4, 0xc7, 0xcf, 0x42, 0xb5, 0x17, 0x27, 0x56, 0xfb, 0xe2, 0xf8, 0x6f, 0xb2,
-	0x01, 0xe6, 0xb8, 0xc7, 0x43, 0xbb, 0x05, 0xf6, 0x3b, 0x08, 0x1c, 0x74, 0x10, 0xb8, 0xdf, 0x41,
-	0xc2, 0x83, 0x0e, 0x12, 0x8e, 0x3a, 0x48, 0x78, 0xd8, 0x41, 0xc2, 0xa3, 0x0e, 0x02, 0xd7, 0x7d,
-	0x04, 0x6e, 0xf8, 0x48, 0xb8, 0xed, 0x23, 0x70, 0xc7, 0x47, 0xc2, 0x5d, 0x1f, 0x09, 0xf7, 0x7c,
-	0x24, 0xec, 0xfb, 0x08, 0x1c, 0xf8, 0x08, 0xdc, 0xf7, 0x91, 0xf0, 0xc0, 0x47, 0xe0, 0xc8, 0x47,
-	0xc2, 0x43, 0x1f, 0x81, 0x47, 0x3e, 0x12, 0xae, 0x77, 0x91, 0x70, 0xa3, 0x8b, 0xc0, 0xcd, 0x2e,
-	0x12, 0xbe, 0xeb, 0x22, 0xf0, 0x43, 0x17, 0x09, 0xb7, 0xbb, 0x48, 0xb8, 0xd3, 0x45, 0xe0, 0x6e,
-	0x17, 0x81, 0x7b, 0x5d, 0x04, 0x3e, 0xdd, 0x30, 0x6d, 0x95, 0xed, 0x12, 0xb6, 0x4b, 0x2d, 0xd3,
-	0x53, 0x2d, 0xc2, 0xae, 0xda, 0x6e, 0x3d, 0x3f, 0xfc, 0x9f, 0x83, 0x53, 0x37, 0xf3, 0x8c, 0x59,
-	0x4e, 0xa5, 0x32, 0xc3, 0xdf, 0x95, 0xf3, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xf2, 0xf8,
-	0x57, 0x90, 0x0d, 0x00, 0x00,
+	// 1180 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x3d, 0x8c, 0x1b, 0x45,
+	0x14, 0xde, 0xf1, 0xcf, 0x5d, 0x3c, 0xf6, 0xe5, 0x4e, 0x2b, 0x02, 0xab, 0x0b, 0x8c, 0x9d, 0xcd,
+	0x29, 0x72, 0x92, 0xf3, 0x1a, 0x39, 0x0d, 0x44, 0xc0, 0xc9, 0xeb, 0xc0, 0xc9, 0x1c, 0xe4, 0x60,
+	0x21, 0x0d, 0x51, 0xb0, 0xc6, 0xde, 0xf1, 0xde, 0xc8, 0xf6, 0xee, 0xb2, 0x3b, 0xbe, 0x8b, 0x83,
+	0x90, 0x22, 0x1a, 0x22, 0xaa, 0x28, 0x15, 0xa2, 0x42, 0x29, 0x50, 0x0a, 0x8a, 0x54, 0x28, 0x12,
+	0x14, 0xe9, 0xb8, 0x82, 0xe2, 0x2a, 0x94, 0xea, 0x88, 0xd7, 0x14, 0x27, 0xd1, 0xa4, 0x8c, 0x5c,
+	0xa1, 0xfd, 0x71, 0xbc, 0xfe, 0xc9, 0x49, 0x21, 0x91, 0x95, 0xca, 0x33, 0xb3, 0xdf, 0x7b, 0xef,
+	0x7b, 0xf3, 0xde, 0xb7, 0x6f, 0x0d, 0x4f, 0x36, 0x0d, 0x0b, 0xef, 0x60, 0x3d, 0x67, 0x33, 0x5c,
+	0x6b, 0xe4, 0xb1, 0x49, 0xf3, 0xd8, 0x34, 0x9b, 0xb4, 0x86, 0x19, 0x35, 0x74, 0xc9, 0xb4, 0x0c,
+	0x66, 0xf0, 0x47, 0x19, 0xd3, 0xa5, 0x00, 0x28, 0x6d, 0x9f, 0x5b, 0x2e, 0x6a, 0x94, 0x6d, 0xb5,
+	0xab, 0x52, 0xcd, 0x68, 0xe5, 0x89, 0xbe, 0x6d, 0x74, 0x4c, 0xcb, 0xb8, 0xda, 0xc9, 0x7b, 0xe0,
+	0x5a, 0x4e, 0x23, 0x7a, 0x6e, 0x1b, 0x37, 0xa9, 0x8a, 0x19, 0xc9, 0x4f, 0x2c, 0x7c, 0x97, 0xcb,
+	0xb9, 0x90, 0x0b, 0xcd, 0xd0, 0x0c, 0xdf, 0xb8, 0xda, 0xae, 0x7b, 0x3b, 0x6f, 0xe3, 0xad, 0x02,
+	0x78, 0x46, 0x33, 0x0c, 0xad, 0x49, 0x86, 0xa8, 0x3a, 0x25, 0x4d, 0xb5, 0xd2, 0xc2, 0x76, 0x23,
+	0x40, 0xa4, 0xc7, 0x11, 0x8c, 0xb6, 0x88, 0xcd, 0x70, 0xcb, 0x0c, 0x00, 0x2b, 0x93, 0x99, 0xd6,
+	0x0c, 0x9d, 0xe1, 0x1a, 0xab, 0x50, 0xbd, 0x3e, 0x08, 0x34, 0xe5, 0x3e, 0xa8, 0x4a, 0x74, 0x46,
+	0xeb, 0x94, 0x58, 0x76, 0x00, 0x42, 0x93, 0x20, 0x8b, 0x6a, 0x5b, 0x2c, 0x78, 0x2e, 0xfe, 0x1c,
+	0x83, 0xc9, 0xe2, 0xf0, 0x16, 0xf9, 0x0f, 0x61, 0x94, 0xaa, 0xb6, 0x00, 0x32, 0x20, 0x9b, 0x2c,
+	0x9c, 0x92, 0x46, 0x6f, 0x53, 0x0a, 0x21, 0xcb, 0xc3, 0x50, 0xf2, 0x52, 0x5f, 0x8e, 0x7f, 0x0f,
+	0x22, 0x4b, 0x60, 0x77, 0x3f, 0xcd, 0xed, 0xed, 0xa7, 0x81, 0xe2, 0x3a, 0xe1, 0x4b, 0x10, 0xd6,
+	0x2c, 0x82, 0x19, 0x51, 0x2b, 0x98, 0x09, 0x11, 0xcf, 0xe5, 0xb2, 0xe4, 0x27, 0x2f, 0x0d, 0x92,
+	0x97, 0x3e, 0x1f, 0x24, 0x2f, 0x1f, 0x71, 0xcd, 0x6f, 0xfe, 0x9d, 0x06, 0x4a, 0x22, 0xb0, 0x2b,
+	0x32, 0xd7, 0x49, 0xdb, 0x54, 0x07, 0x4e, 0xa2, 0xcf, 0xe2, 0x24, 0xb0, 0x2b, 0x32, 0xfe, 0x38,
+	0x8c, 0xe9, 0xb8, 0x45, 0x84, 0x58, 0x06, 0x64, 0x13, 0xf2, 0x7c, 0x5f, 0x8e, 0x59, 0x11, 0xa1,
+	0xa0, 0x78, 0x87, 0xfc, 0x19, 0x98, 0x54, 0x89, 0x5d, 0xb3, 0xa8, 0xe9, 0xe6, 0x25, 0xc4, 0x3d,
+	0xcc, 0x91, 0xbe, 0x1c, 0xb7, 0xa2, 0xc2, 0xde, 0xa2, 0x12, 0x7e, 0xc8, 0x77, 0x20, 0xc4, 0x8c,
+	0x59, 0xb4, 0xda, 0x66, 0xc4, 0x16, 0xe6, 0x32, 0xd1, 0x6c, 0xb2, 0x70, 0xf6, 0x90, 0x5b, 0x92,
+	0x8a, 0x4f, 0xd0, 0xef, 0xeb, 0xcc, 0xea, 0xc8, 0xab, 0x7d, 0xf9, 0xf4, 0x8f, 0xe0, 0x94, 0xb8,
+	0x62, 0x89, 0xc2, 0x4a, 0x01, 0x7d, 0x79, 0x19, 0xe7, 0xae, 0xbd, 0x99, 0x7b, 0xfb, 0x4a, 0x76,
+	0xed, 0xfc, 0xe5, 0xdc, 0x95, 0xb5, 0xc1, 0xf6, 0xf4, 0xd7, 0x85, 0xd5, 0x6f, 0x56, 0x94, 0x50,
+	0x30, 0xfe, 0x3d, 0x98, 0x0a, 0x37, 0x81, 0x30, 0xef, 0x05, 0x3f, 0x3e, 0x1e, 0xbc, 0xe4, 0x63,
+	0xca, 0x7a, 0xdd, 0x50, 0x92, 0xb5, 0xe1, 0x66, 0xf9, 0x5d, 0xb8, 0x38, 0x46, 0x86, 0x5f, 0x82,
+	0xd1, 0x06, 0xe9, 0x78, 0xc5, 0x4e, 0x28, 0xee, 0x92, 0x7f, 0x05, 0xc6, 0xb7, 0x71, 0xb3, 0x4d,
+	0xbc, 0x6a, 0x25, 0x14, 0x7f, 0x73, 0x3e, 0xf2, 0x16, 0x10, 0x37, 0x61, 0x2a, 0x94, 0x97, 0xcd,
+	0xaf, 0xc1, 0x54, 0x48, 0x7d, 0x6e, 0xc7, 0x4c, 0xa5, 0x13, 0xb2, 0x51, 0x46, 0x0c, 0xc4, 0xdf,
+	0x00, 0x3c, 0xb6, 0x4e, 0x58, 0x18, 0x40, 0xbe, 0x6a, 0x13, 0x9b, 0xf1, 0x18, 0x2e, 0x86, 0x90,
+	0x95, 0x17, 0xd1, 0x8f, 0x47, 0x71, 0x18, 0xe9, 0xb2, 0x87, 0x43, 0x59, 0x3e, 0xb5, 0x35, 0x3f,
+	0x70, 0x21, 0x1f, 0x63, 0xbb, 0x21, 0xc7, 0x5c, 0x4f, 0x4a, 0xa2, 0x3e, 0x38, 0x10, 0xff, 0x88,
+	0xc0, 0xd7, 0x3e, 0xa2, 0x76, 0x98, 0xbe, 0x3d, 0xe0, 0xff, 0xa9, 0x5b, 0xa9, 0x66, 0x13, 0x57,
+	0x0d, 0x0b, 0x33, 0xc3, 0x0a, 0xc8, 0xe7, 0xc6, 0xc9, 0x6f, 0x5a, 0x1a, 0xd6, 0xe9, 0x35, 0xcf,
+	0x76, 0xd3, 0xba, 0x64, 0x13, 0x2b, 0x94, 0x83, 0x32, 0xe2, 0xe2, 0xb9, 0xf9, 0xf2, 0x2a, 0x8c,
+	0x1b, 0x96, 0x4a, 0x2c, 0x4f, 0x41, 0x09, 0xf9, 0x62, 0x5f, 0xde, 0xb0, 0xca, 0x0a, 0x37, 0x72,
+	0x31, 0x15, 0xaa, 0x2a, 0x8b, 0xb9, 0xb1, 0x03, 0x4f, 0x23, 0x4a, 0x3c, 0xe7, 0xfd, 0x84, 0xf4,
+	0xac, 0x24, 0x73, 0xa1, 0x8d, 0xef, 0x9c, 0x47, 0x30, 0xde, 0xa4, 0x2d, 0xca, 0x3c, 0xa1, 0x2d,
+	0x78, 0x22, 0x3a, 0x13, 0x15, 0x0e, 0xe6, 0x15, 0xff, 0x98, 0xe7, 0x61, 0xcc, 0xc4, 0x1a, 0xf1,
+	0x34, 0xb6, 0xa0, 0x78, 0x6b, 0xf1, 0x4f, 0x00, 0x85, 0x92, 0xe7, 0x69, 0x4a, 0x2b, 0x6c, 0xc2,
+	0x64, 0x88, 0x4f, 0x70, 0x93, 0x87, 0x35, 0xd9, 0x94, 0xda, 0x87, 0x3d, 0xf0, 0x95, 0xb1, 0xda,
+	0x44, 0xfe, 0x47, 0x6d, 0xe4, 0x54, 0x38, 0xc6, 0x68, 0xa5, 0xc4, 0x5f, 0x00, 0x14, 0x2e, 0x79,
+	0x2f, 0x9e, 0x59, 0xa4, 0xf3, 0xdc, 0x7d, 0xfc, 0x2b, 0x80, 0x6f, 0x8c, 0xf5, 0x71, 0xf1, 0x93,
+	0xf2, 0x06, 0xe9, 0xd8, 0x33, 0x54, 0xe3, 0x93, 0xb6, 0x89, 0x1c, 0xde, 0x36, 0xd1, 0x50, 0xdb,
+	0xdc, 0x06, 0xf0, 0xf8, 0x3a, 0x99, 0xe4, 0x3d, 0x43, 0xda, 0x19, 0x38, 0xd7, 0x20, 0x9d, 0x0a,
+	0x55, 0xfd, 0xb7, 0xa5, 0x9c, 0x70, 0xf6, 0xd3, 0xf1, 0x0d, 0xd2, 0x29, 0x5f, 0x50, 0xe2, 0x0d,
+	0xd2, 0x29, 0xab, 0xe2, 0x3f, 0x11, 0x88, 0x26, 0x7a, 0x7b, 0xe6, 0x3c, 0x07, 0xd3, 0x2f, 0x32,
+	0x6d, 0xfa, 0xbd, 0x03, 0xe7, 0xfc, 0x0f, 0x02, 0x21, 0x9a, 0x89, 0x66, 0x8f, 0x16, 0x8e, 0x8d,
+	0x87, 0x55, 0xdc, 0xa7, 0xf2, 0x42, 0x5f, 0x86, 0xb7, 0xc0, 0xbc, 0x18, 0xff, 0xd6, 0x0d, 0xa5,
+	0x04, 0x36, 0x6e, 0xff, 0x91, 0xab, 0x26, 0xb5, 0x88, 0x5d, 0xc1, 0xbe, 0xea, 0x0f, 0x9f, 0xce,
+	0x31, 0x7f, 0x32, 0x07, 0x36, 0xde, 0x78, 0x5f, 0xc0, 0xcd, 0xa6, 0xb1, 0x43, 0xd4, 0x4a, 0x8d,
+	0xaa, 0x96, 0x2d, 0xc4, 0x33, 0xd1, 0x6c, 0x42, 0x46, 0x7d, 0x39, 0x79, 0x0b, 0x1c, 0x59, 0x5a,
+	0x12, 0x5d, 0xae, 0x67, 0x9d, 0xfd, 0x74, 0xaa, 0xe8, 0xc3, 0x4a, 0xe5, 0x0b, 0x8a, 0xad, 0xa4,
+	0x02, 0xa3, 0x92, 0x6b, 0x23, 0x7e, 0x17, 0x81, 0x68, 0x42, 0x73, 0x33, 0xbf, 0xe6, 0x22, 0x9c,
+	0xc7, 0x26, 0xad, 0xb8, 0x13, 0xd5, 0x17, 0xe2, 0xab, 0x13, 0xae, 0x3d, 0x4a, 0x53, 0x5c, 0xcd,
+	0x61, 0x93, 0x6e, 0x90, 0xce, 0x98, 0x9c, 0xa3, 0xcf, 0x2e, 0xe7, 0xdf, 0x01, 0x3c, 0x39, 0x26,
+	0xe7, 0x52, 0xe8, 0xed, 0xf4, 0xb2, 0x8b, 0xfa, 0x5f, 0x00, 0x4f, 0xac, 0x93, 0xa7, 0xb1, 0x9f,
+	0x21, 0xf9, 0xda, 0x8b, 0x18, 0x13, 0x93, 0x61, 0x46, 0x47, 0xc5, 0x5f, 0x00, 0x9e, 0xf8, 0xec,
+	0x65, 0xc8, 0xf6, 0xe2, 0xd4, 0x6c, 0x5f, 0x9f, 0xfc, 0xb4, 0x1c, 0x62, 0x0e, 0x9b, 0x81, 0xf2,
+	0x6d, 0xb0, 0xdb, 0x45, 0x60, 0xaf, 0x8b, 0xc0, 0x83, 0x2e, 0xe2, 0x1e, 0x76, 0x11, 0x77, 0xd0,
+	0x45, 0xdc, 0xa3, 0x2e, 0xe2, 0x1e, 0x77, 0x11, 0xb8, 0xee, 0x20, 0x70, 0xc3, 0x41, 0xdc, 0x1d,
+	0x07, 0x81, 0xbb, 0x0e, 0xe2, 0xee, 0x39, 0x88, 0xbb, 0xef, 0x20, 0x6e, 0xd7, 0x41, 0x60, 0xcf,
+	0x41, 0xe0, 0x81, 0x83, 0xb8, 0x87, 0x0e, 0x02, 0x07, 0x0e, 0xe2, 0x1e, 0x39, 0x08, 0x3c, 0x76,
+	0x10, 0x77, 0xbd, 0x87, 0xb8, 0x1b, 0x3d, 0x04, 0x6e, 0xf6, 0x10, 0xf7, 0x43, 0x0f, 0x81, 0x9f,
+	0x7a, 0x88, 0xbb, 0xd3, 0x43, 0xdc, 0xdd, 0x1e, 0x02, 0xf7, 0x7a, 0x08, 0xdc, 0xef, 0x21, 0xf0,
+	0xc5, 0xaa, 0x66, 0x48, 0x6c, 0x8b, 0xb0, 0x2d, 0xaa, 0x6b, 0xb6, 0xa4, 0x13, 0xb6, 0x63, 0x58,
+	0x8d, 0xfc, 0xe8, 0x1f, 0x20, 0xb3, 0xa1, 0xe5, 0x19, 0xd3, 0xcd, 0x6a, 0x75, 0xce, 0xd3, 0xd3,
+	0xb9, 0xff, 0x06, 0x00, 0x76, 0xa3, 0xde, 0x46, 0x57, 0x0e, 0x00, 0x00,
 }
 
 func (this *Application) Equal(that interface{}) bool {
@@ -1123,6 +1153,21 @@ func (this *CreateApplicationAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateApplicationAPIKeyRequest) Equal(that interface{}) bool {
@@ -1150,6 +1195,9 @@ func (this *UpdateApplicationAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *ListApplicationCollaboratorsRequest) Equal(that interface{}) bool {
@@ -1663,21 +1711,40 @@ func (m *CreateApplicationAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedCIDRs) > 0 {
+		for iNdEx := len(m.AllowedCIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCIDRs[iNdEx])
+			copy(dAtA[i:], m.AllowedCIDRs[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.AllowedCIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpiresAt != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintApplication(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Rights) > 0 {
-		dAtA15 := make([]byte, len(m.Rights)*10)
-		var j14 int
+		dAtA16 := make([]byte, len(m.Rights)*10)
+		var j15 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j15++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintApplication(dAtA, i, uint64(j14))
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintApplication(dAtA, i, uint64(j15))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1721,6 +1788,16 @@ func (m *UpdateApplicationAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplication(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2006,6 +2083,14 @@ func NewPopulatedCreateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 	for i := 0; i < v17; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v18 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v18)
+	for i := 0; i < v18; i++ {
+		this.AllowedCIDRs[i] = randStringApplication(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2013,10 +2098,12 @@ func NewPopulatedCreateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 
 func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *UpdateApplicationAPIKeyRequest {
 	this := &UpdateApplicationAPIKeyRequest{}
-	v18 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v18
-	v19 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v19
+	v19 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v19
+	v20 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2024,8 +2111,8 @@ func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 
 func NewPopulatedListApplicationCollaboratorsRequest(r randyApplication, easy bool) *ListApplicationCollaboratorsRequest {
 	this := &ListApplicationCollaboratorsRequest{}
-	v20 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v20
+	v22 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v22
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -2035,10 +2122,10 @@ func NewPopulatedListApplicationCollaboratorsRequest(r randyApplication, easy bo
 
 func NewPopulatedGetApplicationCollaboratorRequest(r randyApplication, easy bool) *GetApplicationCollaboratorRequest {
 	this := &GetApplicationCollaboratorRequest{}
-	v21 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v21
-	v22 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v22
+	v23 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v23
+	v24 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2046,10 +2133,10 @@ func NewPopulatedGetApplicationCollaboratorRequest(r randyApplication, easy bool
 
 func NewPopulatedSetApplicationCollaboratorRequest(r randyApplication, easy bool) *SetApplicationCollaboratorRequest {
 	this := &SetApplicationCollaboratorRequest{}
-	v23 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v23
-	v24 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v24
+	v25 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v25
+	v26 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2074,9 +2161,9 @@ func randUTF8RuneApplication(r randyApplication) rune {
 	return rune(ru + 61)
 }
 func randStringApplication(r randyApplication) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v27 := r.Intn(100)
+	tmps := make([]rune, v27)
+	for i := 0; i < v27; i++ {
 		tmps[i] = randUTF8RuneApplication(r)
 	}
 	return string(tmps)
@@ -2098,11 +2185,11 @@ func randFieldApplication(dAtA []byte, r randyApplication, fieldNumber int, wire
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
-		v26 := r.Int63()
+		v28 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v28 *= -1
 		}
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v28))
 	case 1:
 		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2294,6 +2381,16 @@ func (m *CreateApplicationAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovApplication(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2307,6 +2404,8 @@ func (m *UpdateApplicationAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovApplication(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovApplication(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplication(uint64(l))
 	return n
 }
 
@@ -2483,6 +2582,8 @@ func (this *CreateApplicationAPIKeyRequest) String() string {
 		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedCIDRs:` + fmt.Sprintf("%v", this.AllowedCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2494,6 +2595,7 @@ func (this *UpdateApplicationAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateApplicationAPIKeyRequest{`,
 		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3958,6 +4060,74 @@ func (m *CreateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4077,6 +4247,39 @@ func (m *UpdateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
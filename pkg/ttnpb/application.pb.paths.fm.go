@@ -123,30 +123,38 @@ var GetApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 	"key_id",
 }
 var CreateApplicationAPIKeyRequestFieldPathsNested = []string{
+	"allowed_cidrs",
 	"application_ids",
 	"application_ids.application_id",
+	"expires_at",
 	"name",
 	"rights",
 }
 
 var CreateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_cidrs",
 	"application_ids",
+	"expires_at",
 	"name",
 	"rights",
 }
 var UpdateApplicationAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_cidrs",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
 	"api_key.rights",
 	"application_ids",
 	"application_ids.application_id",
+	"field_mask",
 }
 
 var UpdateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
 	"application_ids",
+	"field_mask",
 }
 var ListApplicationCollaboratorsRequestFieldPathsNested = []string{
 	"application_ids",
@@ -445,6 +445,24 @@ func (dst *CreateApplicationAPIKeyRequest) SetFields(src *CreateApplicationAPIKe
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_cidrs":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_cidrs' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedCIDRs = src.AllowedCIDRs
+			} else {
+				dst.AllowedCIDRs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -492,6 +510,16 @@ func (dst *UpdateApplicationAPIKeyRequest) SetFields(src *UpdateApplicationAPIKe
 					dst.APIKey = zero
 				}
 			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
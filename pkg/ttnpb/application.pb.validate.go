@@ -1008,6 +1008,32 @@ func (m *CreateApplicationAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateApplicationAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_cidrs":
+
+			for idx, item := range m.GetAllowedCIDRs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 43 {
+					return CreateApplicationAPIKeyRequestValidationError{
+						field:  fmt.Sprintf("allowed_cidrs[%v]", idx),
+						reason: "value length must be at most 43 runes",
+					}
+				}
+
+			}
+
 		default:
 			return CreateApplicationAPIKeyRequestValidationError{
 				field:  name,
@@ -1114,6 +1140,18 @@ func (m *UpdateApplicationAPIKeyRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateApplicationAPIKeyRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return UpdateApplicationAPIKeyRequestValidationError{
 				field:  name,
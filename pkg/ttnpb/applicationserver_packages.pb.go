@@ -489,76 +489,82 @@ func init() {
 }
 
 var fileDescriptor_aa4ce58e965b6ca0 = []byte{
-	// 1098 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6c, 0xdc, 0x44,
-	0x14, 0xf6, 0x24, 0xbb, 0x6d, 0x33, 0x49, 0xa3, 0x30, 0x48, 0xb0, 0x5a, 0x60, 0x12, 0xdc, 0x48,
-	0x59, 0x42, 0xd7, 0xae, 0xb6, 0x07, 0xa0, 0x48, 0x44, 0x31, 0x6d, 0xf9, 0x11, 0x54, 0xc1, 0x01,
-	0x0e, 0xb4, 0x65, 0x35, 0xb1, 0x67, 0x1d, 0x6b, 0x77, 0x6d, 0xe3, 0x99, 0x4d, 0x09, 0x51, 0xa4,
-	0xa8, 0x08, 0xa9, 0x42, 0x08, 0x15, 0xf5, 0xd2, 0x23, 0xe2, 0x54, 0xc4, 0xa5, 0xe2, 0x14, 0x71,
-	0xa1, 0xc7, 0x5c, 0x90, 0x22, 0x71, 0xe9, 0xa9, 0x74, 0xbd, 0x1c, 0xd2, 0x5b, 0x0f, 0x1c, 0xaa,
-	0x1c, 0x00, 0x79, 0xec, 0x4d, 0xbc, 0x3f, 0xcd, 0xee, 0xa6, 0xea, 0xcd, 0xe3, 0x79, 0xdf, 0xf3,
-	0xfb, 0xbe, 0xf7, 0xcd, 0x1b, 0xc3, 0x42, 0xc5, 0xf5, 0xc9, 0x15, 0xe2, 0xe4, 0x19, 0x27, 0x46,
-	0x59, 0x25, 0x9e, 0xad, 0x12, 0xcf, 0xab, 0xd8, 0x06, 0xe1, 0xb6, 0xeb, 0x30, 0xea, 0xaf, 0x50,
-	0xbf, 0xe8, 0x11, 0xa3, 0x4c, 0x2c, 0xca, 0x14, 0xcf, 0x77, 0xb9, 0x8b, 0xc6, 0x39, 0x77, 0x94,
-	0x18, 0xa7, 0xac, 0x9c, 0xce, 0xce, 0x5b, 0x36, 0x5f, 0xae, 0x2d, 0x29, 0x86, 0x5b, 0x55, 0xa9,
-	0xb3, 0xe2, 0xae, 0x7a, 0xbe, 0xfb, 0xe5, 0xaa, 0x2a, 0x82, 0x8d, 0xbc, 0x45, 0x9d, 0xfc, 0x0a,
-	0xa9, 0xd8, 0x26, 0xe1, 0x54, 0xed, 0x78, 0x88, 0x52, 0x66, 0xf3, 0x89, 0x14, 0x96, 0x6b, 0xb9,
-	0x11, 0x78, 0xa9, 0x56, 0x12, 0x2b, 0xb1, 0x10, 0x4f, 0x71, 0xf8, 0x8b, 0x96, 0xeb, 0x5a, 0x15,
-	0x1a, 0x95, 0xeb, 0x38, 0x2e, 0x8f, 0xaa, 0x8d, 0x77, 0x5f, 0x88, 0x77, 0xf7, 0x72, 0xd0, 0xaa,
-	0xc7, 0x57, 0xe3, 0xcd, 0xa9, 0xf6, 0xcd, 0x92, 0x4d, 0x2b, 0x66, 0xb1, 0x4a, 0x58, 0x39, 0x8e,
-	0x98, 0x6c, 0x8f, 0xe0, 0x76, 0x95, 0x32, 0x4e, 0xaa, 0x5e, 0xdb, 0xd7, 0xf7, 0x02, 0x18, 0xf7,
-	0x6b, 0x06, 0x8f, 0x77, 0x4f, 0x74, 0x2a, 0x6a, 0x9b, 0xd4, 0xe1, 0x76, 0xc9, 0xa6, 0x7e, 0x5c,
-	0xa2, 0xfc, 0x35, 0x80, 0x68, 0x7e, 0x5f, 0xe7, 0x85, 0x48, 0x60, 0xf4, 0x26, 0x4c, 0x39, 0xa4,
-	0x4a, 0x33, 0x60, 0x0a, 0xe4, 0x46, 0xb4, 0x99, 0x5d, 0x6d, 0xda, 0x97, 0x33, 0xd3, 0x05, 0xfc,
-	0xf9, 0x45, 0x92, 0xff, 0xea, 0x54, 0xfe, 0x8d, 0xcb, 0xb9, 0xb9, 0x33, 0x17, 0xf3, 0x97, 0xe7,
-	0x9a, 0xcb, 0x57, 0xd6, 0x0a, 0x27, 0xd7, 0xa7, 0x75, 0x01, 0x42, 0xa7, 0xe0, 0xb8, 0x49, 0x4b,
-	0xa4, 0x56, 0xe1, 0xc5, 0x52, 0xd1, 0x73, 0x7d, 0x9e, 0x19, 0x9a, 0x02, 0xb9, 0xe3, 0x1a, 0xdc,
-	0xd5, 0x8e, 0xce, 0xa6, 0x33, 0xff, 0x81, 0x1c, 0xd0, 0xc7, 0xe2, 0x88, 0xf3, 0x0b, 0xae, 0xcf,
-	0xe5, 0x4f, 0xe0, 0xb3, 0x9d, 0x45, 0x30, 0xf4, 0x16, 0x3c, 0xd6, 0xec, 0x78, 0x06, 0x4c, 0x0d,
-	0xe7, 0x46, 0x0b, 0xb2, 0xd2, 0xda, 0x72, 0xa5, 0x13, 0xa6, 0xef, 0x61, 0xe4, 0x5f, 0x00, 0xcc,
-	0x75, 0x06, 0xcc, 0x33, 0xe6, 0x1a, 0xb6, 0x78, 0xf3, 0xde, 0xbe, 0x1e, 0xe8, 0x12, 0x1c, 0xa7,
-	0x8e, 0x59, 0x34, 0xe9, 0x8a, 0x6d, 0xd0, 0xa2, 0x6d, 0x32, 0x41, 0x7e, 0xb4, 0x30, 0xdd, 0xfe,
-	0xc9, 0x73, 0x8e, 0x79, 0x56, 0x04, 0x25, 0xd0, 0xda, 0xc4, 0xae, 0x96, 0xfe, 0x16, 0x0c, 0x4d,
-	0x80, 0xad, 0x7b, 0x93, 0xd2, 0xf6, 0xbd, 0x49, 0xa0, 0x8f, 0xd1, 0xfd, 0x38, 0x86, 0x5e, 0x86,
-	0x47, 0x1e, 0xab, 0x45, 0xba, 0x24, 0x44, 0xd8, 0x18, 0x86, 0x2f, 0x1d, 0x58, 0x2d, 0xba, 0x04,
-	0x87, 0xf7, 0xeb, 0x7a, 0xbd, 0xb7, 0x14, 0xdd, 0x99, 0x76, 0xa9, 0x35, 0x4c, 0x8b, 0xde, 0x86,
-	0xd0, 0xf0, 0x29, 0xe1, 0xd4, 0x2c, 0x92, 0xa8, 0xcc, 0xd1, 0x42, 0x56, 0x89, 0x2c, 0xa6, 0x34,
-	0x2d, 0xa6, 0x7c, 0xdc, 0xf4, 0xa0, 0x76, 0x2c, 0x84, 0x5f, 0xff, 0x6b, 0x12, 0xe8, 0x23, 0x31,
-	0x6e, 0x9e, 0x87, 0x49, 0x6a, 0x9e, 0xd9, 0x4c, 0x32, 0x3c, 0x48, 0x92, 0x18, 0x37, 0xcf, 0xd1,
-	0xfb, 0x70, 0x2c, 0xee, 0x61, 0x51, 0xb8, 0x30, 0x35, 0x98, 0x0b, 0x47, 0x63, 0xf0, 0x85, 0xd0,
-	0x8c, 0xaf, 0xc2, 0x94, 0x49, 0x38, 0xc9, 0xa4, 0x45, 0x29, 0xcf, 0x77, 0x94, 0xb2, 0x28, 0x8e,
-	0x8c, 0x2e, 0x82, 0x64, 0x06, 0xf1, 0x81, 0x2a, 0x32, 0xf4, 0x11, 0x1c, 0x23, 0x89, 0x75, 0x6c,
-	0xcb, 0xfc, 0x40, 0xbd, 0xd0, 0x5b, 0x52, 0xc8, 0x5b, 0x00, 0xce, 0xbc, 0x43, 0xf9, 0xc1, 0x10,
-	0xfa, 0x45, 0x8d, 0x32, 0xfe, 0x94, 0x1d, 0x30, 0x07, 0xe1, 0xfe, 0x10, 0x7a, 0xac, 0x03, 0xce,
-	0x87, 0x21, 0x1f, 0x12, 0x56, 0xd6, 0x52, 0x21, 0x5c, 0x1f, 0x29, 0x35, 0x5f, 0xc8, 0x0f, 0x00,
-	0xcc, 0x7d, 0x60, 0xb3, 0xfe, 0xb8, 0xbc, 0x9b, 0xe4, 0x72, 0xd8, 0x53, 0x26, 0xea, 0xc6, 0x30,
-	0x5d, 0xb1, 0xab, 0x76, 0xf3, 0x6c, 0x1d, 0xdb, 0xd5, 0xd2, 0xb3, 0xc3, 0x99, 0x9d, 0xa3, 0x7a,
-	0xf4, 0x1a, 0x21, 0x98, 0xf2, 0x88, 0x45, 0x85, 0x1d, 0x8f, 0xeb, 0xe2, 0xb9, 0x8d, 0x6b, 0x6a,
-	0x70, 0xae, 0x7f, 0x00, 0x38, 0xb3, 0xd8, 0x67, 0xdb, 0x08, 0x1c, 0x4d, 0xb4, 0x3c, 0xa6, 0x3c,
-	0x98, 0x69, 0xba, 0x70, 0x4f, 0xe6, 0x7c, 0xe2, 0xde, 0x15, 0xfe, 0x1d, 0x81, 0xd9, 0x2e, 0xd3,
-	0x94, 0x5a, 0x36, 0xe3, 0xfe, 0x2a, 0xfa, 0x19, 0xc0, 0x54, 0xd8, 0x5a, 0xd4, 0x57, 0xa7, 0xb2,
-	0x27, 0x7a, 0x93, 0x63, 0xf2, 0xa7, 0x57, 0xff, 0xfc, 0xfb, 0xc6, 0xd0, 0x02, 0xba, 0xa0, 0x12,
-	0xd6, 0x72, 0xdb, 0xab, 0x6b, 0x89, 0x55, 0x38, 0x8b, 0x95, 0xd6, 0xf5, 0xba, 0x1a, 0x8d, 0x69,
-	0xa6, 0xae, 0xed, 0xcd, 0xeb, 0x75, 0xb5, 0x39, 0xf7, 0xd1, 0x8d, 0x21, 0x38, 0x1e, 0x9e, 0xa8,
-	0x84, 0x3c, 0xaf, 0xb5, 0xd7, 0xd3, 0xe7, 0x89, 0xcb, 0x0e, 0xd6, 0x25, 0xf9, 0x26, 0x10, 0x9c,
-	0x7e, 0x00, 0xe8, 0x7b, 0xd0, 0xc9, 0x2a, 0x64, 0xd2, 0x7a, 0xc9, 0x28, 0x7d, 0x13, 0xed, 0x82,
-	0xed, 0xc2, 0x5d, 0x4d, 0x8e, 0x96, 0x08, 0x14, 0xdd, 0x3b, 0xeb, 0xe8, 0x01, 0x80, 0x13, 0xe2,
-	0x70, 0x26, 0xe7, 0x59, 0xc7, 0x0c, 0xe9, 0xf7, 0xf8, 0x66, 0x95, 0x81, 0x84, 0x61, 0x72, 0x59,
-	0x08, 0x43, 0x91, 0xd1, 0x5d, 0x96, 0x81, 0x74, 0xe8, 0x45, 0x1c, 0xfd, 0x36, 0x04, 0xc7, 0x17,
-	0x7b, 0x38, 0x60, 0xf1, 0xe9, 0x38, 0xe0, 0xf7, 0xc8, 0x01, 0x9b, 0x20, 0xfb, 0x6b, 0x17, 0x07,
-	0x24, 0xaa, 0x54, 0x9e, 0xc4, 0x0d, 0x3d, 0xf2, 0xf4, 0x76, 0x46, 0x7b, 0x82, 0xd8, 0x25, 0x67,
-	0xc0, 0x2c, 0xfa, 0x07, 0xc0, 0x67, 0xce, 0xd2, 0x0a, 0xe5, 0x2d, 0x3f, 0x1f, 0x87, 0xbe, 0x6d,
-	0xb2, 0xcf, 0x75, 0x8c, 0xa1, 0x73, 0xe1, 0x7f, 0xb0, 0xfc, 0x5d, 0xa4, 0xd4, 0x37, 0x60, 0xf6,
-	0x6a, 0x17, 0xa5, 0x0e, 0xab, 0xcc, 0xc0, 0x4a, 0xc4, 0xcc, 0xb5, 0x9f, 0xc0, 0x56, 0x1d, 0x83,
-	0xed, 0x3a, 0x06, 0x77, 0xeb, 0x58, 0xba, 0x5f, 0xc7, 0xd2, 0x4e, 0x1d, 0x4b, 0x0f, 0xeb, 0x58,
-	0x7a, 0x54, 0xc7, 0x60, 0x23, 0xc0, 0xe0, 0x5a, 0x80, 0xa5, 0x5b, 0x01, 0x06, 0xb7, 0x03, 0x2c,
-	0x6d, 0x06, 0x58, 0xba, 0x13, 0x60, 0x69, 0x2b, 0xc0, 0x60, 0x3b, 0xc0, 0xe0, 0x6e, 0x80, 0xa5,
-	0xfb, 0x01, 0x06, 0x3b, 0x01, 0x96, 0x1e, 0x06, 0x18, 0x3c, 0x0a, 0xb0, 0xb4, 0xd1, 0xc0, 0xd2,
-	0xb5, 0x06, 0x06, 0xd7, 0x1b, 0x58, 0xba, 0xd9, 0xc0, 0xe0, 0xc7, 0x06, 0x96, 0x6e, 0x35, 0xb0,
-	0x74, 0xbb, 0x81, 0xc1, 0x66, 0x03, 0x83, 0x3b, 0x0d, 0x0c, 0x3e, 0x3b, 0x69, 0xb9, 0x0a, 0x5f,
-	0xa6, 0x7c, 0xd9, 0x76, 0x2c, 0xa6, 0x38, 0x94, 0x5f, 0x71, 0xfd, 0xb2, 0xda, 0xfa, 0xef, 0xee,
-	0x95, 0x2d, 0x95, 0x73, 0xc7, 0x5b, 0x5a, 0x3a, 0x22, 0x34, 0x3c, 0xfd, 0x7f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x4d, 0x7b, 0x6d, 0x0e, 0x2f, 0x0d, 0x00, 0x00,
+	// 1189 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xde, 0xf1, 0x4f, 0xdb, 0x4c, 0xd2, 0x28, 0x0c, 0x12, 0x58, 0x06, 0x26, 0x61, 0x1b, 0x29,
+	0x26, 0xd4, 0xbb, 0x95, 0x7b, 0x00, 0x8a, 0x44, 0x14, 0xd3, 0xa6, 0x80, 0x68, 0x15, 0x6c, 0xe0,
+	0x40, 0x5b, 0xac, 0xc9, 0xee, 0x78, 0xb3, 0xb2, 0xbd, 0xbb, 0xec, 0x8c, 0x53, 0x42, 0x14, 0x29,
+	0x2a, 0x42, 0xaa, 0x0a, 0x54, 0x45, 0x05, 0xa9, 0x88, 0x0b, 0xe2, 0x54, 0xc4, 0xa5, 0xe2, 0x14,
+	0x71, 0xa1, 0xc7, 0x5c, 0x90, 0x22, 0x71, 0xe9, 0xa9, 0xd4, 0x6b, 0x0e, 0xe9, 0xad, 0x07, 0x40,
+	0x55, 0x0e, 0x80, 0xf6, 0xc7, 0xc9, 0xfa, 0x27, 0xb1, 0xdd, 0x28, 0xbd, 0xed, 0xec, 0xbc, 0xef,
+	0xf9, 0x7d, 0xdf, 0xfb, 0xe6, 0xed, 0x18, 0x66, 0xca, 0xa6, 0x4d, 0x2e, 0x12, 0x23, 0xcd, 0x38,
+	0x51, 0x4a, 0x32, 0xb1, 0x74, 0x99, 0x58, 0x56, 0x59, 0x57, 0x08, 0xd7, 0x4d, 0x83, 0x51, 0x7b,
+	0x81, 0xda, 0x05, 0x8b, 0x28, 0x25, 0xa2, 0x51, 0x26, 0x59, 0xb6, 0xc9, 0x4d, 0x34, 0xcc, 0xb9,
+	0x21, 0x05, 0x38, 0x69, 0xe1, 0x78, 0x72, 0x5a, 0xd3, 0xf9, 0x7c, 0x75, 0x4e, 0x52, 0xcc, 0x8a,
+	0x4c, 0x8d, 0x05, 0x73, 0xd1, 0xb2, 0xcd, 0x8f, 0x17, 0x65, 0x2f, 0x58, 0x49, 0x6b, 0xd4, 0x48,
+	0x2f, 0x90, 0xb2, 0xae, 0x12, 0x4e, 0xe5, 0xb6, 0x07, 0x3f, 0x65, 0x32, 0x1d, 0x4a, 0xa1, 0x99,
+	0x9a, 0xe9, 0x83, 0xe7, 0xaa, 0x45, 0x6f, 0xe5, 0x2d, 0xbc, 0xa7, 0x20, 0xfc, 0x59, 0xcd, 0x34,
+	0xb5, 0x32, 0xf5, 0xcb, 0x35, 0x0c, 0x93, 0xfb, 0xd5, 0x06, 0xbb, 0xcf, 0x04, 0xbb, 0x5b, 0x39,
+	0x68, 0xc5, 0xe2, 0x8b, 0xc1, 0xe6, 0x58, 0xeb, 0x66, 0x51, 0xa7, 0x65, 0xb5, 0x50, 0x21, 0xac,
+	0x14, 0x44, 0x8c, 0xb6, 0x46, 0x70, 0xbd, 0x42, 0x19, 0x27, 0x15, 0xab, 0xe5, 0xd7, 0xb7, 0x02,
+	0x18, 0xb7, 0xab, 0x0a, 0x0f, 0x76, 0x8f, 0xb4, 0x2b, 0xaa, 0xab, 0xd4, 0xe0, 0x7a, 0x51, 0xa7,
+	0x76, 0x50, 0xa2, 0xf8, 0x29, 0x80, 0x68, 0x7a, 0x5b, 0xe7, 0x59, 0x5f, 0x60, 0xf4, 0x2a, 0x8c,
+	0x19, 0xa4, 0x42, 0x13, 0x60, 0x0c, 0xa4, 0x06, 0xb2, 0x13, 0x9b, 0xd9, 0x71, 0x5b, 0x4c, 0x8c,
+	0x67, 0xf0, 0x87, 0xe7, 0x48, 0xfa, 0x93, 0x63, 0xe9, 0x57, 0x2e, 0xa4, 0xa6, 0x4e, 0x9c, 0x4b,
+	0x5f, 0x98, 0x6a, 0x2c, 0x5f, 0x58, 0xca, 0x1c, 0x5d, 0x1e, 0xcf, 0x79, 0x20, 0x74, 0x0c, 0x0e,
+	0xab, 0xb4, 0x48, 0xaa, 0x65, 0x5e, 0x28, 0x16, 0x2c, 0xd3, 0xe6, 0x89, 0xc8, 0x18, 0x48, 0x1d,
+	0xce, 0xc2, 0xcd, 0xec, 0xc1, 0xc9, 0x78, 0xe2, 0x3f, 0x90, 0x02, 0xb9, 0xa1, 0x20, 0x62, 0x66,
+	0xd6, 0xb4, 0xb9, 0xf8, 0x1e, 0x7c, 0xb2, 0xbd, 0x08, 0x86, 0x5e, 0x83, 0x87, 0x1a, 0x1d, 0x4f,
+	0x80, 0xb1, 0x68, 0x6a, 0x30, 0x23, 0x4a, 0xcd, 0x2d, 0x97, 0xda, 0x61, 0xb9, 0x2d, 0x8c, 0xf8,
+	0x13, 0x80, 0xa9, 0xf6, 0x80, 0x69, 0xc6, 0x4c, 0x45, 0xf7, 0xde, 0xbc, 0xb9, 0xad, 0x07, 0x3a,
+	0x0f, 0x87, 0xa9, 0xa1, 0x16, 0x54, 0xba, 0xa0, 0x2b, 0xb4, 0xa0, 0xab, 0xcc, 0x23, 0x3f, 0x98,
+	0x19, 0x6f, 0xfd, 0xc9, 0x53, 0x86, 0x7a, 0xd2, 0x0b, 0x0a, 0xa1, 0xb3, 0x23, 0x9b, 0xd9, 0xf8,
+	0x15, 0x10, 0x19, 0x01, 0x6b, 0x77, 0x47, 0x85, 0xf5, 0xbb, 0xa3, 0x20, 0x37, 0x44, 0xb7, 0xe3,
+	0x18, 0x7a, 0x1e, 0x1e, 0xd8, 0x51, 0x8b, 0x78, 0xd1, 0x13, 0x61, 0x25, 0x0a, 0x9f, 0xdb, 0xb5,
+	0x5a, 0x74, 0x1e, 0x46, 0xb7, 0xeb, 0x7a, 0xb9, 0xbb, 0x14, 0x9d, 0x99, 0x76, 0xa8, 0xd5, 0x4d,
+	0x8b, 0x5e, 0x87, 0x50, 0xb1, 0x29, 0xe1, 0x54, 0x2d, 0x10, 0xbf, 0xcc, 0xc1, 0x4c, 0x52, 0xf2,
+	0x2d, 0x26, 0x35, 0x2c, 0x26, 0xbd, 0xdb, 0xf0, 0x60, 0xf6, 0x90, 0x0b, 0xbf, 0xf6, 0xc7, 0x28,
+	0xc8, 0x0d, 0x04, 0xb8, 0x69, 0xee, 0x26, 0xa9, 0x5a, 0x6a, 0x23, 0x49, 0xb4, 0x9f, 0x24, 0x01,
+	0x6e, 0x9a, 0xa3, 0xb7, 0xe0, 0x50, 0xd0, 0xc3, 0x82, 0xe7, 0xc2, 0x58, 0x7f, 0x2e, 0x1c, 0x0c,
+	0xc0, 0x67, 0x5d, 0x33, 0xbe, 0x08, 0x63, 0x2a, 0xe1, 0x24, 0x11, 0xf7, 0x4a, 0x79, 0xba, 0xad,
+	0x94, 0xbc, 0x77, 0x64, 0x72, 0x5e, 0x90, 0xc8, 0x20, 0xde, 0x55, 0x45, 0x86, 0xde, 0x81, 0x43,
+	0x24, 0xb4, 0x0e, 0x6c, 0x99, 0xee, 0xab, 0x17, 0xb9, 0xa6, 0x14, 0xe2, 0x1a, 0x80, 0x13, 0xa7,
+	0x29, 0xdf, 0x1d, 0x42, 0x3f, 0xaa, 0x52, 0xc6, 0xf7, 0xd9, 0x01, 0x53, 0x10, 0x6e, 0x0f, 0xa1,
+	0x1d, 0x1d, 0x30, 0xe3, 0x86, 0x9c, 0x21, 0xac, 0x94, 0x8d, 0xb9, 0xf0, 0xdc, 0x40, 0xb1, 0xf1,
+	0x42, 0xbc, 0x0f, 0x60, 0xea, 0x6d, 0x9d, 0xf5, 0xc6, 0xe5, 0x0d, 0x18, 0xdd, 0xfb, 0x29, 0xf3,
+	0xea, 0xc6, 0x30, 0x5e, 0xd6, 0x2b, 0x7a, 0xe3, 0x6c, 0x1d, 0xda, 0xcc, 0xc6, 0x27, 0xa3, 0x89,
+	0x8d, 0x83, 0x39, 0xff, 0x35, 0x42, 0x30, 0x66, 0x11, 0x8d, 0x7a, 0x76, 0x3c, 0x9c, 0xf3, 0x9e,
+	0x5b, 0xb8, 0xc6, 0xfa, 0xe7, 0xfa, 0x1b, 0x80, 0x13, 0xf9, 0x1e, 0xdb, 0x46, 0xe0, 0x60, 0xa8,
+	0xe5, 0x01, 0xe5, 0xfe, 0x4c, 0xd3, 0x81, 0x7b, 0x38, 0xe7, 0x9e, 0x7b, 0x97, 0xf9, 0x77, 0x00,
+	0x26, 0x3b, 0x4c, 0x53, 0xaa, 0xe9, 0x8c, 0xdb, 0x8b, 0xe8, 0x47, 0x00, 0x63, 0x6e, 0x6b, 0x51,
+	0x4f, 0x9d, 0x4a, 0x1e, 0xe9, 0x4e, 0x8e, 0x89, 0xef, 0x5f, 0xfa, 0xfd, 0xcf, 0xeb, 0x91, 0x59,
+	0x74, 0x56, 0x26, 0xac, 0xe9, 0x6b, 0x2f, 0x2f, 0x85, 0x56, 0xee, 0x2c, 0x96, 0x9a, 0xd7, 0xcb,
+	0xb2, 0x3f, 0xa6, 0x99, 0xbc, 0xb4, 0x35, 0xaf, 0x97, 0xe5, 0xc6, 0xdc, 0x47, 0xd7, 0x23, 0x70,
+	0xd8, 0x3d, 0x51, 0x21, 0x79, 0x5e, 0x6a, 0xad, 0xa7, 0xc7, 0x13, 0x97, 0xec, 0xaf, 0x4b, 0xe2,
+	0x0d, 0xe0, 0x71, 0xfa, 0x0a, 0xa0, 0xab, 0xa0, 0x9d, 0x95, 0xcb, 0xa4, 0xf9, 0x23, 0x23, 0xf5,
+	0x4c, 0xb4, 0x03, 0xb6, 0x03, 0x77, 0x39, 0x3c, 0x5a, 0x7c, 0x90, 0xff, 0xdd, 0x59, 0x46, 0xf7,
+	0x01, 0x1c, 0xf1, 0x0e, 0x67, 0x78, 0x9e, 0xb5, 0xcd, 0x90, 0x5e, 0x8f, 0x6f, 0x52, 0xea, 0x4b,
+	0x18, 0x26, 0x96, 0x3c, 0x61, 0x28, 0x52, 0x3a, 0xcb, 0xd2, 0x97, 0x0e, 0xdd, 0x88, 0xa3, 0x5f,
+	0x22, 0x70, 0x38, 0xdf, 0xc5, 0x01, 0xf9, 0xfd, 0x71, 0xc0, 0xaf, 0xbe, 0x03, 0x56, 0x41, 0xf2,
+	0xe7, 0x0e, 0x0e, 0x08, 0x55, 0x29, 0xed, 0xc5, 0x0d, 0x5d, 0xf2, 0x74, 0x77, 0x46, 0x6b, 0x82,
+	0xc0, 0x25, 0x27, 0xc0, 0x24, 0xfa, 0x0b, 0xc0, 0x27, 0x4e, 0xd2, 0x32, 0xe5, 0x4d, 0x97, 0x8f,
+	0x47, 0xfe, 0xda, 0x24, 0x9f, 0x6a, 0x1b, 0x43, 0xa7, 0xdc, 0x7b, 0xb0, 0xf8, 0x85, 0xaf, 0xd4,
+	0x67, 0x60, 0xf2, 0x52, 0x07, 0xa5, 0x1e, 0x55, 0x99, 0xbe, 0x95, 0x08, 0x98, 0x67, 0xbe, 0x8d,
+	0xc0, 0xd1, 0x10, 0xa7, 0x19, 0x9b, 0x68, 0x15, 0x6a, 0xf0, 0xa6, 0x7b, 0xf1, 0xdf, 0x00, 0x0e,
+	0x9c, 0xa6, 0x3c, 0xcf, 0x09, 0xaf, 0xb2, 0x3d, 0x48, 0xb2, 0xd3, 0x3d, 0x44, 0xfc, 0xda, 0xd7,
+	0xe4, 0x4b, 0x80, 0xae, 0x3c, 0x5e, 0x4d, 0x8a, 0x61, 0xba, 0x5b, 0xa2, 0xc8, 0xcc, 0x63, 0x9a,
+	0xf9, 0x2e, 0x02, 0xc7, 0x42, 0xe4, 0xce, 0x54, 0xcb, 0x5c, 0x57, 0x08, 0xe3, 0x79, 0xca, 0xab,
+	0x56, 0x43, 0x9c, 0x7f, 0xf6, 0x5b, 0x9c, 0x6f, 0x7c, 0x71, 0xae, 0x02, 0xf4, 0xf9, 0xe3, 0x15,
+	0xa7, 0xd2, 0xe0, 0xcb, 0x5c, 0xbe, 0xad, 0xea, 0x64, 0x7f, 0x00, 0x6b, 0x35, 0x0c, 0xd6, 0x6b,
+	0x18, 0xdc, 0xa9, 0x61, 0xe1, 0x5e, 0x0d, 0x0b, 0x1b, 0x35, 0x2c, 0x3c, 0xa8, 0x61, 0xe1, 0x61,
+	0x0d, 0x83, 0x15, 0x07, 0x83, 0xcb, 0x0e, 0x16, 0x6e, 0x3a, 0x18, 0xdc, 0x72, 0xb0, 0xb0, 0xea,
+	0x60, 0xe1, 0xb6, 0x83, 0x85, 0x35, 0x07, 0x83, 0x75, 0x07, 0x83, 0x3b, 0x0e, 0x16, 0xee, 0x39,
+	0x18, 0x6c, 0x38, 0x58, 0x78, 0xe0, 0x60, 0xf0, 0xd0, 0xc1, 0xc2, 0x4a, 0x1d, 0x0b, 0x97, 0xeb,
+	0x18, 0x5c, 0xab, 0x63, 0xe1, 0x46, 0x1d, 0x83, 0xef, 0xeb, 0x58, 0xb8, 0x59, 0xc7, 0xc2, 0xad,
+	0x3a, 0x06, 0xab, 0x75, 0x0c, 0x6e, 0xd7, 0x31, 0xf8, 0xe0, 0xa8, 0x66, 0x4a, 0x7c, 0x9e, 0xf2,
+	0x79, 0xdd, 0xd0, 0x98, 0x64, 0x50, 0x7e, 0xd1, 0xb4, 0x4b, 0x72, 0xf3, 0xbf, 0x3e, 0xab, 0xa4,
+	0xc9, 0x9c, 0x1b, 0xd6, 0xdc, 0xdc, 0x01, 0x4f, 0xcd, 0xe3, 0xff, 0x0f, 0x00, 0xd1, 0x4f, 0xb8,
+	0xfa, 0x69, 0x0f, 0x00, 0x00,
 }
 
 func (this *ApplicationPackage) Equal(that interface{}) bool {
@@ -1031,6 +1037,154 @@ var _ApplicationPackageRegistry_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/applicationserver_packages.proto",
 }
 
+// ApplicationFragmentationPackageClient is the client API for ApplicationFragmentationPackage service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationFragmentationPackageClient interface {
+	// GetStatus returns the status of the fragmentation session of the association.
+	GetStatus(ctx context.Context, in *ApplicationPackageAssociationIdentifiers, opts ...grpc.CallOption) (*types.Struct, error)
+}
+
+type applicationFragmentationPackageClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationFragmentationPackageClient(cc *grpc.ClientConn) ApplicationFragmentationPackageClient {
+	return &applicationFragmentationPackageClient{cc}
+}
+
+func (c *applicationFragmentationPackageClient) GetStatus(ctx context.Context, in *ApplicationPackageAssociationIdentifiers, opts ...grpc.CallOption) (*types.Struct, error) {
+	out := new(types.Struct)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationFragmentationPackage/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationFragmentationPackageServer is the server API for ApplicationFragmentationPackage service.
+type ApplicationFragmentationPackageServer interface {
+	// GetStatus returns the status of the fragmentation session of the association.
+	GetStatus(context.Context, *ApplicationPackageAssociationIdentifiers) (*types.Struct, error)
+}
+
+// UnimplementedApplicationFragmentationPackageServer can be embedded to have forward compatible implementations.
+type UnimplementedApplicationFragmentationPackageServer struct {
+}
+
+func (*UnimplementedApplicationFragmentationPackageServer) GetStatus(ctx context.Context, req *ApplicationPackageAssociationIdentifiers) (*types.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+
+func RegisterApplicationFragmentationPackageServer(s *grpc.Server, srv ApplicationFragmentationPackageServer) {
+	s.RegisterService(&_ApplicationFragmentationPackage_serviceDesc, srv)
+}
+
+func _ApplicationFragmentationPackage_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPackageAssociationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationFragmentationPackageServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationFragmentationPackage/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationFragmentationPackageServer).GetStatus(ctx, req.(*ApplicationPackageAssociationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApplicationFragmentationPackage_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.ApplicationFragmentationPackage",
+	HandlerType: (*ApplicationFragmentationPackageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStatus",
+			Handler:    _ApplicationFragmentationPackage_GetStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver_packages.proto",
+}
+
+// ApplicationMulticastSetupPackageClient is the client API for ApplicationMulticastSetupPackage service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationMulticastSetupPackageClient interface {
+	// GetStatus returns the state of the multicast session of the association.
+	GetStatus(ctx context.Context, in *ApplicationPackageAssociationIdentifiers, opts ...grpc.CallOption) (*types.Struct, error)
+}
+
+type applicationMulticastSetupPackageClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationMulticastSetupPackageClient(cc *grpc.ClientConn) ApplicationMulticastSetupPackageClient {
+	return &applicationMulticastSetupPackageClient{cc}
+}
+
+func (c *applicationMulticastSetupPackageClient) GetStatus(ctx context.Context, in *ApplicationPackageAssociationIdentifiers, opts ...grpc.CallOption) (*types.Struct, error) {
+	out := new(types.Struct)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationMulticastSetupPackage/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationMulticastSetupPackageServer is the server API for ApplicationMulticastSetupPackage service.
+type ApplicationMulticastSetupPackageServer interface {
+	// GetStatus returns the state of the multicast session of the association.
+	GetStatus(context.Context, *ApplicationPackageAssociationIdentifiers) (*types.Struct, error)
+}
+
+// UnimplementedApplicationMulticastSetupPackageServer can be embedded to have forward compatible implementations.
+type UnimplementedApplicationMulticastSetupPackageServer struct {
+}
+
+func (*UnimplementedApplicationMulticastSetupPackageServer) GetStatus(ctx context.Context, req *ApplicationPackageAssociationIdentifiers) (*types.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+
+func RegisterApplicationMulticastSetupPackageServer(s *grpc.Server, srv ApplicationMulticastSetupPackageServer) {
+	s.RegisterService(&_ApplicationMulticastSetupPackage_serviceDesc, srv)
+}
+
+func _ApplicationMulticastSetupPackage_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPackageAssociationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationMulticastSetupPackageServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationMulticastSetupPackage/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationMulticastSetupPackageServer).GetStatus(ctx, req.(*ApplicationPackageAssociationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApplicationMulticastSetupPackage_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.ApplicationMulticastSetupPackage",
+	HandlerType: (*ApplicationMulticastSetupPackageServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStatus",
+			Handler:    _ApplicationMulticastSetupPackage_GetStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver_packages.proto",
+}
+
 func (m *ApplicationPackage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -43,3 +43,24 @@ func (q *ApplicationPubSub_MQTTProvider_QoS) UnmarshalText(b []byte) error {
 	}
 	return errCouldNotParse("ApplicationPubSub_MQTTProvider_QoS")(string(b))
 }
+
+// MarshalText implements encoding.TextMarshaler interface.
+func (m ApplicationPubSub_KafkaProvider_SASL_Mechanism) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (m *ApplicationPubSub_KafkaProvider_SASL_Mechanism) UnmarshalText(b []byte) error {
+	s := string(b)
+	if i, ok := ApplicationPubSub_KafkaProvider_SASL_Mechanism_value[s]; ok {
+		*m = ApplicationPubSub_KafkaProvider_SASL_Mechanism(i)
+		return nil
+	}
+	if i, err := strconv.Atoi(s); err == nil {
+		if _, ok := ApplicationPubSub_KafkaProvider_SASL_Mechanism_name[int32(i)]; ok {
+			*m = ApplicationPubSub_KafkaProvider_SASL_Mechanism(int32(i))
+			return nil
+		}
+	}
+	return errCouldNotParse("ApplicationPubSub_KafkaProvider_SASL_Mechanism")(string(b))
+}
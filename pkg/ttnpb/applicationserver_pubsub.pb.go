@@ -65,6 +65,30 @@ func (ApplicationPubSub_MQTTProvider_QoS) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1dce56ec18597200, []int{1, 1, 0}
 }
 
+type ApplicationPubSub_KafkaProvider_SASL_Mechanism int32
+
+const (
+	ApplicationPubSub_KafkaProvider_SASL_PLAIN         ApplicationPubSub_KafkaProvider_SASL_Mechanism = 0
+	ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256 ApplicationPubSub_KafkaProvider_SASL_Mechanism = 1
+	ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512 ApplicationPubSub_KafkaProvider_SASL_Mechanism = 2
+)
+
+var ApplicationPubSub_KafkaProvider_SASL_Mechanism_name = map[int32]string{
+	0: "PLAIN",
+	1: "SCRAM_SHA_256",
+	2: "SCRAM_SHA_512",
+}
+
+var ApplicationPubSub_KafkaProvider_SASL_Mechanism_value = map[string]int32{
+	"PLAIN":         0,
+	"SCRAM_SHA_256": 1,
+	"SCRAM_SHA_512": 2,
+}
+
+func (ApplicationPubSub_KafkaProvider_SASL_Mechanism) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 2, 0, 0}
+}
+
 type ApplicationPubSubIdentifiers struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	PubSubID               string   `protobuf:"bytes,2,opt,name=pub_sub_id,json=pubSubId,proto3" json:"pub_sub_id,omitempty"`
@@ -123,6 +147,8 @@ type ApplicationPubSub struct {
 	// Types that are valid to be assigned to Provider:
 	//	*ApplicationPubSub_NATS
 	//	*ApplicationPubSub_MQTT
+	//	*ApplicationPubSub_Kafka
+	//	*ApplicationPubSub_AMQP
 	Provider isApplicationPubSub_Provider `protobuf_oneof:"provider"`
 	// Base topic name to which the messages topic is appended.
 	BaseTopic string `protobuf:"bytes,6,opt,name=base_topic,json=baseTopic,proto3" json:"base_topic,omitempty"`
@@ -187,9 +213,17 @@ type ApplicationPubSub_NATS struct {
 type ApplicationPubSub_MQTT struct {
 	MQTT *ApplicationPubSub_MQTTProvider `protobuf:"bytes,25,opt,name=mqtt,proto3,oneof" json:"mqtt,omitempty"`
 }
+type ApplicationPubSub_Kafka struct {
+	Kafka *ApplicationPubSub_KafkaProvider `protobuf:"bytes,26,opt,name=kafka,proto3,oneof" json:"kafka,omitempty"`
+}
+type ApplicationPubSub_AMQP struct {
+	AMQP *ApplicationPubSub_AMQPProvider `protobuf:"bytes,27,opt,name=amqp,proto3,oneof" json:"amqp,omitempty"`
+}
 
-func (*ApplicationPubSub_NATS) isApplicationPubSub_Provider() {}
-func (*ApplicationPubSub_MQTT) isApplicationPubSub_Provider() {}
+func (*ApplicationPubSub_NATS) isApplicationPubSub_Provider()  {}
+func (*ApplicationPubSub_MQTT) isApplicationPubSub_Provider()  {}
+func (*ApplicationPubSub_Kafka) isApplicationPubSub_Provider() {}
+func (*ApplicationPubSub_AMQP) isApplicationPubSub_Provider()  {}
 
 func (m *ApplicationPubSub) GetProvider() isApplicationPubSub_Provider {
 	if m != nil {
@@ -233,6 +267,20 @@ func (m *ApplicationPubSub) GetMQTT() *ApplicationPubSub_MQTTProvider {
 	return nil
 }
 
+func (m *ApplicationPubSub) GetKafka() *ApplicationPubSub_KafkaProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_Kafka); ok {
+		return x.Kafka
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub) GetAMQP() *ApplicationPubSub_AMQPProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_AMQP); ok {
+		return x.AMQP
+	}
+	return nil
+}
+
 func (m *ApplicationPubSub) GetBaseTopic() string {
 	if m != nil {
 		return m.BaseTopic
@@ -315,6 +363,8 @@ func (*ApplicationPubSub) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ApplicationPubSub_NATS)(nil),
 		(*ApplicationPubSub_MQTT)(nil),
+		(*ApplicationPubSub_Kafka)(nil),
+		(*ApplicationPubSub_AMQP)(nil),
 	}
 }
 
@@ -486,6 +536,278 @@ func (m *ApplicationPubSub_MQTTProvider) GetTLSClientKey() []byte {
 	return nil
 }
 
+// The Kafka provider settings.
+type ApplicationPubSub_KafkaProvider struct {
+	// The bootstrap brokers, in host:port format.
+	Brokers  []string `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	ClientID string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The consumer group used by the Application Server to consume downlink messages.
+	// If empty, the consumer group is derived from the application ID, the pub/sub ID and the topic.
+	ConsumerGroup string `protobuf:"bytes,3,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumer_group,omitempty"`
+	UseTLS        bool   `protobuf:"varint,4,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	TLSCA []byte `protobuf:"bytes,5,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate. PEM formatted.
+	TLSClientCert []byte `protobuf:"bytes,6,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted.
+	TLSClientKey []byte `protobuf:"bytes,7,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// The SASL authentication settings. If not set, SASL authentication is disabled.
+	SASL                 *ApplicationPubSub_KafkaProvider_SASL `protobuf:"bytes,8,opt,name=sasl,proto3" json:"sasl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *ApplicationPubSub_KafkaProvider) Reset()      { *m = ApplicationPubSub_KafkaProvider{} }
+func (*ApplicationPubSub_KafkaProvider) ProtoMessage() {}
+func (*ApplicationPubSub_KafkaProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 2}
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_KafkaProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.Merge(m, src)
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_KafkaProvider proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_KafkaProvider) GetBrokers() []string {
+	if m != nil {
+		return m.Brokers
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetConsumerGroup() string {
+	if m != nil {
+		return m.ConsumerGroup
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetUseTLS() bool {
+	if m != nil {
+		return m.UseTLS
+	}
+	return false
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSCA() []byte {
+	if m != nil {
+		return m.TLSCA
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetSASL() *ApplicationPubSub_KafkaProvider_SASL {
+	if m != nil {
+		return m.SASL
+	}
+	return nil
+}
+
+type ApplicationPubSub_KafkaProvider_SASL struct {
+	Mechanism            ApplicationPubSub_KafkaProvider_SASL_Mechanism `protobuf:"varint,1,opt,name=mechanism,proto3,enum=ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism" json:"mechanism,omitempty"`
+	Username             string                                         `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string                                         `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) Reset()      { *m = ApplicationPubSub_KafkaProvider_SASL{} }
+func (*ApplicationPubSub_KafkaProvider_SASL) ProtoMessage() {}
+func (*ApplicationPubSub_KafkaProvider_SASL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 2, 0}
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.Merge(m, src)
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetMechanism() ApplicationPubSub_KafkaProvider_SASL_Mechanism {
+	if m != nil {
+		return m.Mechanism
+	}
+	return ApplicationPubSub_KafkaProvider_SASL_PLAIN
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+// The AMQP 0.9.1 provider settings.
+type ApplicationPubSub_AMQPProvider struct {
+	// The server connection URL. Use the amqps scheme to connect using TLS.
+	ServerURL string `protobuf:"bytes,1,opt,name=server_url,json=serverUrl,proto3" json:"server_url,omitempty"`
+	// The username and password are used for SASL PLAIN authentication.
+	// If empty, the credentials of the server URL are used.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// The topic exchange to which the messages are published, and to which the downlink queues are bound.
+	// If empty, the amq.topic exchange is used.
+	Exchange string `protobuf:"bytes,4,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	TLSCA []byte `protobuf:"bytes,5,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate. PEM formatted.
+	TLSClientCert []byte `protobuf:"bytes,6,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted.
+	TLSClientKey         []byte   `protobuf:"bytes,7,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPubSub_AMQPProvider) Reset()      { *m = ApplicationPubSub_AMQPProvider{} }
+func (*ApplicationPubSub_AMQPProvider) ProtoMessage() {}
+func (*ApplicationPubSub_AMQPProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3}
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_AMQPProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_AMQPProvider.Merge(m, src)
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_AMQPProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_AMQPProvider proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_AMQPProvider) GetServerURL() string {
+	if m != nil {
+		return m.ServerURL
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetTLSCA() []byte {
+	if m != nil {
+		return m.TLSCA
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
 type ApplicationPubSub_Message struct {
 	// The topic on which the Application Server publishes or receives the messages.
 	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
@@ -496,7 +818,7 @@ type ApplicationPubSub_Message struct {
 func (m *ApplicationPubSub_Message) Reset()      { *m = ApplicationPubSub_Message{} }
 func (*ApplicationPubSub_Message) ProtoMessage() {}
 func (*ApplicationPubSub_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dce56ec18597200, []int{1, 2}
+	return fileDescriptor_1dce56ec18597200, []int{1, 4}
 }
 func (m *ApplicationPubSub_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -764,6 +1086,8 @@ func (m *SetApplicationPubSubRequest) GetFieldMask() types.FieldMask {
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism", ApplicationPubSub_KafkaProvider_SASL_Mechanism_name, ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism", ApplicationPubSub_KafkaProvider_SASL_Mechanism_name, ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
 	proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
 	golang_proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
 	proto.RegisterType((*ApplicationPubSub)(nil), "ttn.lorawan.v3.ApplicationPubSub")
@@ -772,6 +1096,12 @@ func init() {
 	golang_proto.RegisterType((*ApplicationPubSub_NATSProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.NATSProvider")
 	proto.RegisterType((*ApplicationPubSub_MQTTProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.MQTTProvider")
 	golang_proto.RegisterType((*ApplicationPubSub_MQTTProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.MQTTProvider")
+	proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	proto.RegisterType((*ApplicationPubSub_KafkaProvider_SASL)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL")
+	golang_proto.RegisterType((*ApplicationPubSub_KafkaProvider_SASL)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL")
+	proto.RegisterType((*ApplicationPubSub_AMQPProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AMQPProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_AMQPProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AMQPProvider")
 	proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
 	golang_proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
 	proto.RegisterType((*ApplicationPubSubs)(nil), "ttn.lorawan.v3.ApplicationPubSubs")
@@ -796,109 +1126,127 @@ func init() {
 }
 
 var fileDescriptor_1dce56ec18597200 = []byte{
-	// 1622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0x41, 0x6c, 0xdb, 0xd6,
-	0x19, 0xc7, 0xf9, 0x2c, 0x4b, 0x96, 0x9e, 0x65, 0x59, 0x79, 0xcb, 0x36, 0x56, 0x69, 0x29, 0x4f,
-	0x09, 0x3a, 0x37, 0x8d, 0xa8, 0x40, 0xd9, 0x8a, 0xd6, 0x1d, 0x90, 0x8a, 0x8e, 0x93, 0x76, 0x71,
-	0x1c, 0x9b, 0x52, 0x80, 0xad, 0x45, 0x47, 0x50, 0xe4, 0xb3, 0xcc, 0x8a, 0x22, 0x69, 0xbe, 0x47,
-	0x7b, 0x5e, 0x11, 0xa0, 0xd8, 0xc9, 0xd8, 0x61, 0x30, 0xb0, 0xc3, 0x76, 0xdb, 0xb0, 0xcb, 0x0a,
-	0xec, 0x12, 0xec, 0xd4, 0xdb, 0x0a, 0xec, 0x92, 0x63, 0x80, 0xed, 0xd0, 0x93, 0x56, 0x53, 0x3b,
-	0xf4, 0xb6, 0x1e, 0x03, 0x9f, 0x06, 0x3e, 0x92, 0x92, 0x6c, 0xb9, 0x96, 0xe5, 0x60, 0x3d, 0x99,
-	0xef, 0x7d, 0xdf, 0xf7, 0x7b, 0xff, 0xf7, 0xbd, 0x8f, 0x1f, 0x9f, 0x0c, 0x6f, 0x9a, 0xb6, 0xab,
-	0xee, 0xaa, 0x56, 0x99, 0x50, 0x55, 0x6b, 0x57, 0x54, 0xc7, 0xa8, 0xa8, 0x8e, 0x63, 0x1a, 0x9a,
-	0x4a, 0x0d, 0xdb, 0x22, 0xd8, 0xdd, 0xc1, 0xae, 0xe2, 0x78, 0x4d, 0xe2, 0x35, 0x45, 0xc7, 0xb5,
-	0xa9, 0x8d, 0x72, 0x94, 0x5a, 0x62, 0x14, 0x25, 0xee, 0xdc, 0x2a, 0xd4, 0x5a, 0x06, 0xdd, 0xf2,
-	0x9a, 0xa2, 0x66, 0x77, 0x2a, 0xd8, 0xda, 0xb1, 0xf7, 0x1c, 0xd7, 0xfe, 0xe5, 0x5e, 0x85, 0x39,
-	0x6b, 0xe5, 0x16, 0xb6, 0xca, 0x3b, 0xaa, 0x69, 0xe8, 0x2a, 0xc5, 0x95, 0x91, 0x87, 0x10, 0x59,
-	0x28, 0x0f, 0x21, 0x5a, 0x76, 0xcb, 0x0e, 0x83, 0x9b, 0xde, 0x26, 0x1b, 0xb1, 0x01, 0x7b, 0x8a,
-	0xdc, 0x5f, 0x6e, 0xd9, 0x76, 0xcb, 0xc4, 0xa1, 0x58, 0xcb, 0xb2, 0x69, 0xa8, 0x35, 0xb2, 0x5e,
-	0x89, 0xac, 0x7d, 0x06, 0xee, 0x38, 0x74, 0x2f, 0x32, 0x2e, 0x9c, 0x34, 0x6e, 0x1a, 0xd8, 0xd4,
-	0x95, 0x8e, 0x4a, 0xda, 0x91, 0x47, 0xf1, 0xa4, 0x07, 0x35, 0x3a, 0x98, 0x50, 0xb5, 0xe3, 0x44,
-	0x0e, 0x57, 0x47, 0x33, 0x66, 0xe8, 0xd8, 0xa2, 0xc6, 0xa6, 0x81, 0xdd, 0x48, 0x44, 0xe9, 0x5f,
-	0x00, 0xbe, 0x5c, 0x1b, 0xe4, 0x71, 0xdd, 0x6b, 0xd6, 0xbd, 0xe6, 0x7b, 0x03, 0x37, 0xa4, 0xc2,
-	0xf9, 0xa1, 0x3c, 0x2b, 0x86, 0x4e, 0x78, 0xb0, 0x00, 0x16, 0x67, 0xab, 0xaf, 0x8a, 0xc7, 0xf3,
-	0x2b, 0x0e, 0x61, 0x86, 0x00, 0x52, 0xfe, 0x48, 0x4a, 0xfe, 0x06, 0x4c, 0xe5, 0xc1, 0xd3, 0x6e,
-	0x91, 0x7b, 0xd6, 0x2d, 0x02, 0x39, 0xa7, 0x0e, 0x7b, 0x12, 0xb4, 0x01, 0xa1, 0xe3, 0x35, 0x15,
-	0xe2, 0x35, 0x15, 0x43, 0xe7, 0xa7, 0x16, 0xc0, 0x62, 0x46, 0xba, 0x75, 0x24, 0x5d, 0x73, 0x4b,
-	0xfc, 0xb5, 0xaa, 0xf0, 0x8b, 0x0f, 0xd4, 0xf2, 0xaf, 0x6e, 0x96, 0xdf, 0xfa, 0x70, 0xf1, 0xf6,
-	0xd2, 0x07, 0xe5, 0x0f, 0x6f, 0xc7, 0xc3, 0xd7, 0x3e, 0xae, 0xde, 0x78, 0x7c, 0xcd, 0xef, 0x16,
-	0xd3, 0x91, 0xe8, 0x3b, 0x72, 0xda, 0x89, 0xe4, 0x97, 0xfe, 0x96, 0x87, 0x97, 0x46, 0xb6, 0x85,
-	0xd6, 0x61, 0x62, 0xa0, 0xff, 0xc6, 0x19, 0xfa, 0x47, 0xd2, 0x70, 0xca, 0x2e, 0x02, 0x14, 0x5a,
-	0x86, 0x50, 0x73, 0xb1, 0x4a, 0xb1, 0xae, 0xa8, 0x94, 0x49, 0x9f, 0xad, 0x16, 0xc4, 0xf0, 0x64,
-	0xc4, 0xf8, 0x64, 0xc4, 0x46, 0x7c, 0x32, 0x52, 0x3a, 0x08, 0x3f, 0xf8, 0x77, 0x11, 0xc8, 0x99,
-	0x28, 0xae, 0x46, 0x03, 0x88, 0xe7, 0xe8, 0x31, 0x24, 0x31, 0x09, 0x24, 0x8a, 0xab, 0x51, 0x74,
-	0x1b, 0xa6, 0x36, 0x6d, 0xb7, 0xa3, 0x52, 0x7e, 0x9a, 0x25, 0xf0, 0x87, 0x61, 0x02, 0x2f, 0x8f,
-	0x4b, 0xa0, 0x1c, 0x85, 0xa1, 0x35, 0x38, 0x6d, 0xa9, 0x94, 0xf0, 0x97, 0xd8, 0xfa, 0xe2, 0xd8,
-	0xec, 0x88, 0x6b, 0xb5, 0x46, 0x7d, 0xdd, 0xb5, 0x77, 0x0c, 0x1d, 0xbb, 0x52, 0xda, 0xef, 0x16,
-	0xa7, 0x83, 0x99, 0x77, 0x39, 0x99, 0x71, 0x02, 0x5e, 0x67, 0x9b, 0x52, 0xfe, 0xa5, 0xf3, 0xf2,
-	0x1e, 0x6c, 0x34, 0x1a, 0xc7, 0x79, 0xc1, 0x4c, 0xc0, 0x0b, 0x38, 0xe8, 0x55, 0x08, 0x9b, 0x2a,
-	0xc1, 0x0a, 0xb5, 0x1d, 0x43, 0xe3, 0x53, 0x6c, 0x93, 0x33, 0x47, 0xd2, 0xb4, 0x3b, 0xc5, 0xeb,
-	0x72, 0x26, 0x30, 0x35, 0x02, 0x0b, 0x5a, 0x83, 0x73, 0xba, 0xbd, 0x6b, 0x99, 0x86, 0xd5, 0x56,
-	0x1c, 0x8f, 0x6c, 0xf1, 0x33, 0x4c, 0xc0, 0x6b, 0xe7, 0x10, 0x80, 0x09, 0x51, 0x5b, 0x58, 0xce,
-	0xc6, 0xf1, 0xeb, 0x1e, 0xd9, 0x42, 0x0d, 0x98, 0xef, 0xf3, 0x5c, 0xec, 0x98, 0xaa, 0x86, 0xf9,
-	0xf4, 0xa4, 0xc8, 0xf9, 0x18, 0x21, 0x87, 0x04, 0xb4, 0x0e, 0x73, 0x9e, 0xc3, 0x98, 0x9d, 0xd0,
-	0x85, 0xcf, 0x4c, 0xca, 0x9c, 0x0b, 0x01, 0xd1, 0x10, 0xfd, 0x14, 0xce, 0x7e, 0x64, 0x1b, 0x96,
-	0xa2, 0x6a, 0x1a, 0x76, 0x28, 0x0f, 0x27, 0xc5, 0xc1, 0x20, 0xba, 0xc6, 0x82, 0xd1, 0x2a, 0xec,
-	0xe7, 0x40, 0x51, 0xb5, 0x36, 0x3f, 0x3b, 0x29, 0x6c, 0x36, 0x0e, 0xaf, 0x69, 0xed, 0x63, 0x27,
-	0x62, 0x05, 0xb8, 0xec, 0x85, 0x4f, 0x64, 0x4d, 0x3d, 0xc1, 0x23, 0xd8, 0xa2, 0xfc, 0xdc, 0x85,
-	0x79, 0x75, 0x6c, 0x51, 0x24, 0xc3, 0xfe, 0xf1, 0x28, 0x9b, 0xaa, 0x61, 0x62, 0x9d, 0xcf, 0x4d,
-	0x4a, 0xcc, 0xc5, 0x84, 0xbb, 0x0c, 0x70, 0x8c, 0xb9, 0xed, 0x61, 0x0f, 0xeb, 0xfc, 0xfc, 0x85,
-	0x99, 0x1b, 0x0c, 0x10, 0x30, 0x4d, 0x3b, 0xea, 0xc3, 0xc4, 0x36, 0x77, 0xb0, 0xce, 0xe7, 0x27,
-	0x66, 0xc6, 0x84, 0x3a, 0x03, 0x14, 0xee, 0xc0, 0xec, 0xf0, 0x7b, 0x8c, 0x7e, 0x04, 0x61, 0xf4,
-	0x2d, 0xf5, 0x5c, 0x93, 0x75, 0xca, 0x8c, 0xf4, 0xdd, 0x23, 0x29, 0xe9, 0x26, 0xf6, 0x01, 0xf0,
-	0xbb, 0xc5, 0x4c, 0x9d, 0x59, 0x1f, 0xc9, 0xab, 0x72, 0x26, 0x74, 0x7c, 0xe4, 0x9a, 0x85, 0xfd,
-	0x24, 0xcc, 0x0e, 0xbf, 0xbe, 0x17, 0xc3, 0xa0, 0x9b, 0x30, 0xa3, 0x99, 0x06, 0xb6, 0xe8, 0xe0,
-	0x3b, 0xf0, 0x9d, 0xf0, 0x0d, 0xff, 0x7e, 0xd0, 0xe7, 0x97, 0x99, 0x2d, 0xe8, 0xf3, 0xa1, 0xd7,
-	0x7b, 0x3a, 0xba, 0x0a, 0xd3, 0x1e, 0xc1, 0xae, 0xa5, 0x76, 0x30, 0x6b, 0x9c, 0x43, 0x2d, 0xa1,
-	0x6f, 0x08, 0x9c, 0x1c, 0x95, 0x90, 0x5d, 0xdb, 0xd5, 0xa3, 0xe6, 0x38, 0x70, 0x8a, 0x0d, 0xc8,
-	0x80, 0x73, 0xc4, 0x6b, 0x12, 0xcd, 0x35, 0x9a, 0x58, 0xd9, 0xb6, 0x09, 0x9f, 0x5c, 0x00, 0x8b,
-	0xb9, 0x6a, 0x75, 0xb2, 0xbe, 0x25, 0x6e, 0xd8, 0x75, 0x29, 0xef, 0x77, 0x8b, 0xd9, 0x7a, 0x0c,
-	0xdb, 0xb0, 0xeb, 0x72, 0x96, 0x0c, 0x46, 0x04, 0x69, 0x70, 0xd6, 0xf1, 0x9a, 0xa6, 0x41, 0xb6,
-	0xd8, 0x42, 0xa9, 0x0b, 0x2f, 0x94, 0xf3, 0xbb, 0x45, 0xb8, 0x1e, 0xa2, 0x82, 0x65, 0xa0, 0x13,
-	0x3f, 0x13, 0x74, 0x15, 0xce, 0x78, 0x41, 0xb7, 0x34, 0x09, 0x6b, 0x80, 0x69, 0x09, 0xfa, 0xdd,
-	0x62, 0xea, 0x11, 0xc1, 0x8d, 0xd5, 0xba, 0x9c, 0xf2, 0x08, 0x6e, 0x98, 0x04, 0x2d, 0xc0, 0x14,
-	0x35, 0x89, 0xa2, 0xa9, 0xac, 0xa3, 0x65, 0xa5, 0x8c, 0xdf, 0x2d, 0x26, 0x1b, 0xab, 0xf5, 0xe5,
-	0x9a, 0x9c, 0xa4, 0x26, 0x59, 0x56, 0xd1, 0x5b, 0x70, 0x9e, 0x79, 0x84, 0xc7, 0xa2, 0x61, 0x97,
-	0xb2, 0x46, 0x95, 0x95, 0x2e, 0xf9, 0xdd, 0xe2, 0x5c, 0xe0, 0xca, 0x2c, 0xcb, 0xd8, 0xa5, 0xf2,
-	0x5c, 0x10, 0xd2, 0x1f, 0xa2, 0x37, 0x60, 0x6e, 0x28, 0xb4, 0x8d, 0xf7, 0x58, 0x4f, 0xca, 0x86,
-	0xe9, 0xe9, 0x47, 0xde, 0xc7, 0x7b, 0x72, 0xb6, 0x1f, 0x78, 0x1f, 0xef, 0x95, 0x7e, 0x02, 0x13,
-	0x1b, 0x76, 0x1d, 0xe5, 0x61, 0xb6, 0xd6, 0x50, 0x1e, 0x3c, 0xac, 0x37, 0x94, 0x87, 0x6b, 0xcb,
-	0x2b, 0x79, 0x0e, 0x5d, 0x82, 0x73, 0xb5, 0x86, 0xb2, 0xba, 0x52, 0x8b, 0xa7, 0x40, 0xe0, 0xb4,
-	0xf2, 0xb3, 0xda, 0x72, 0x63, 0xf5, 0xe7, 0xe1, 0xcc, 0x54, 0x61, 0x11, 0xce, 0xc4, 0x1d, 0xf1,
-	0x15, 0x98, 0x0c, 0x3f, 0x16, 0xe0, 0xf8, 0xa1, 0x87, 0xb3, 0xd2, 0x3c, 0x4c, 0x3b, 0x71, 0xbd,
-	0x26, 0x9e, 0x4b, 0xa0, 0xb4, 0x01, 0xd1, 0x48, 0xd2, 0x09, 0x7a, 0x1b, 0xce, 0x84, 0xd7, 0xca,
-	0xe0, 0xe2, 0x90, 0x58, 0x9c, 0xad, 0xfe, 0x60, 0xec, 0x49, 0xc9, 0x71, 0x44, 0xe9, 0x2f, 0x00,
-	0xf2, 0x23, 0xe6, 0xbb, 0xec, 0x83, 0x4b, 0xd0, 0x43, 0x38, 0x13, 0x7e, 0x7b, 0x63, 0xf2, 0x8f,
-	0xc7, 0x92, 0xa3, 0x50, 0x31, 0xfa, 0xbb, 0x62, 0x51, 0x77, 0x4f, 0x8e, 0x29, 0x85, 0x25, 0x98,
-	0x1d, 0x36, 0xa0, 0x3c, 0x4c, 0x04, 0x69, 0x67, 0xdb, 0x97, 0x83, 0x47, 0x74, 0x19, 0x26, 0x77,
-	0x54, 0xd3, 0xc3, 0xe1, 0xdb, 0x25, 0x87, 0x83, 0xa5, 0xa9, 0x37, 0x41, 0xe9, 0x09, 0x80, 0x57,
-	0xee, 0x61, 0x3a, 0xba, 0x17, 0xbc, 0xed, 0x61, 0x42, 0xff, 0x0f, 0x77, 0xa7, 0xdb, 0x10, 0x0e,
-	0x2e, 0xb5, 0xdf, 0x78, 0x77, 0xba, 0x1b, 0xb8, 0x3c, 0x50, 0x49, 0x5b, 0x9a, 0x0e, 0xc2, 0xe5,
-	0xcc, 0x66, 0x3c, 0x51, 0xfa, 0x07, 0x80, 0xaf, 0xac, 0x1a, 0x64, 0x54, 0x33, 0x89, 0x45, 0x7f,
-	0x0b, 0x97, 0xd7, 0x17, 0xde, 0xc5, 0x5f, 0x01, 0xbc, 0x52, 0x3f, 0x23, 0xf1, 0xf7, 0x61, 0x2a,
-	0xac, 0xa6, 0x48, 0xfa, 0xf8, 0xf2, 0x3b, 0x45, 0x75, 0x84, 0x78, 0x61, 0xb5, 0xd5, 0xbf, 0xa7,
-	0xe0, 0x4b, 0xa7, 0x48, 0x6d, 0x19, 0x24, 0x28, 0xb8, 0x8f, 0x20, 0xbc, 0x87, 0x69, 0x5c, 0xdf,
-	0xdf, 0x1b, 0x01, 0xaf, 0x04, 0xbf, 0x70, 0x0a, 0x8b, 0xe7, 0x2d, 0xf3, 0x52, 0xe1, 0xd7, 0xff,
-	0xfc, 0xcf, 0xef, 0xa6, 0x2e, 0x23, 0x54, 0x51, 0x49, 0x25, 0xdc, 0x42, 0x39, 0x2a, 0x76, 0xf4,
-	0x47, 0x00, 0x13, 0xf7, 0x30, 0x45, 0xaf, 0x9f, 0xa4, 0x9d, 0x51, 0xc5, 0x85, 0xf1, 0xc9, 0x2b,
-	0xbd, 0xcb, 0xd6, 0x94, 0xd0, 0x3b, 0x83, 0x35, 0x2b, 0x1f, 0x1b, 0x3a, 0x11, 0x4f, 0x54, 0xd2,
-	0x89, 0xf1, 0xe3, 0xd0, 0x69, 0xf0, 0x43, 0xe6, 0x31, 0xfa, 0x2d, 0x80, 0xd3, 0x41, 0x7d, 0xa2,
-	0xf2, 0xc9, 0x55, 0xcf, 0xac, 0xda, 0x42, 0x69, 0xac, 0x48, 0x52, 0xba, 0xc5, 0x54, 0x96, 0xd1,
-	0xeb, 0xc3, 0x2a, 0xc7, 0x28, 0x44, 0xff, 0x05, 0x30, 0x51, 0x3f, 0x2d, 0x65, 0xf5, 0x17, 0x4b,
-	0xd9, 0xef, 0x01, 0x53, 0x73, 0x00, 0x0a, 0x6b, 0xc3, 0x72, 0xa2, 0x5f, 0xe3, 0xe7, 0xca, 0xdd,
-	0x90, 0xef, 0x50, 0x0a, 0x97, 0xc0, 0xf5, 0xf7, 0xdf, 0x2e, 0xbd, 0x71, 0x31, 0xe8, 0x12, 0xb8,
-	0x8e, 0x0e, 0x00, 0x4c, 0xdd, 0xc1, 0x26, 0xa6, 0x18, 0x4d, 0xd4, 0xb3, 0x0a, 0xdf, 0x50, 0xbb,
-	0xa5, 0x77, 0xd8, 0x4e, 0x97, 0xae, 0xbf, 0x39, 0x41, 0xde, 0x99, 0xe8, 0x78, 0x4b, 0xd2, 0x9f,
-	0xc1, 0xd3, 0x43, 0x01, 0x3c, 0x3b, 0x14, 0xc0, 0x17, 0x87, 0x02, 0xf7, 0xe5, 0xa1, 0xc0, 0x7d,
-	0x75, 0x28, 0x70, 0x5f, 0x1f, 0x0a, 0xdc, 0xf3, 0x43, 0x01, 0x7c, 0xe2, 0x0b, 0x60, 0xdf, 0x17,
-	0xb8, 0x4f, 0x7d, 0x01, 0x3c, 0xf1, 0x05, 0xee, 0x33, 0x5f, 0xe0, 0x3e, 0xf7, 0x05, 0xee, 0xa9,
-	0x2f, 0x80, 0x67, 0xbe, 0x00, 0xbe, 0xf0, 0x05, 0xee, 0x4b, 0x5f, 0x00, 0x5f, 0xf9, 0x02, 0xf7,
-	0xb5, 0x2f, 0x80, 0xe7, 0xbe, 0xc0, 0x7d, 0xd2, 0x13, 0xb8, 0xfd, 0x9e, 0x00, 0x0e, 0x7a, 0x02,
-	0xf7, 0x87, 0x9e, 0x00, 0xfe, 0xd4, 0x13, 0xb8, 0x4f, 0x7b, 0x02, 0xf7, 0xa4, 0x27, 0x80, 0xcf,
-	0x7a, 0x02, 0xf8, 0xbc, 0x27, 0x80, 0xf7, 0x6f, 0xb4, 0x6c, 0x91, 0x6e, 0x61, 0xba, 0x65, 0x58,
-	0x2d, 0x22, 0x5a, 0x98, 0xee, 0xda, 0x6e, 0xbb, 0x72, 0xfc, 0x5f, 0x04, 0x4e, 0xbb, 0x55, 0xa1,
-	0xd4, 0x72, 0x9a, 0xcd, 0x14, 0xdb, 0xf6, 0xad, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x84, 0x92,
-	0x8b, 0x2c, 0x76, 0x11, 0x00, 0x00,
+	// 1910 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0x80, 0x77, 0x48, 0x91, 0x22, 0x47, 0x24, 0x4d, 0x4f, 0xdd, 0x74, 0x4d, 0x27, 0x4b, 0x95,
+	0x36, 0x52, 0xc5, 0x31, 0x97, 0x2e, 0x1d, 0xbb, 0xb1, 0x12, 0xd4, 0xe1, 0xca, 0xb2, 0xec, 0x5a,
+	0x92, 0xa5, 0x5d, 0x1a, 0x68, 0x1d, 0xb8, 0x8b, 0x25, 0x39, 0xa2, 0x36, 0x5c, 0xee, 0xae, 0x76,
+	0x66, 0xe5, 0xa8, 0x86, 0x01, 0x23, 0x27, 0xa3, 0x87, 0xc2, 0x68, 0x0f, 0xed, 0xad, 0x45, 0x2f,
+	0x09, 0xd0, 0x8b, 0x8f, 0xb9, 0x35, 0x40, 0x2f, 0x3e, 0x1a, 0x68, 0x0e, 0x39, 0x14, 0x6c, 0xb4,
+	0xea, 0x21, 0xb7, 0xe6, 0x54, 0x18, 0x3a, 0x15, 0xb3, 0x3f, 0x14, 0x29, 0xd9, 0x92, 0x28, 0xb7,
+	0x45, 0x4f, 0xda, 0x99, 0xf7, 0xde, 0x37, 0x6f, 0xde, 0x7b, 0x7a, 0x33, 0x43, 0x78, 0xde, 0xb0,
+	0x1c, 0xed, 0x9e, 0x66, 0x96, 0x09, 0xd5, 0x9a, 0x9d, 0x8a, 0x66, 0xeb, 0x15, 0xcd, 0xb6, 0x0d,
+	0xbd, 0xa9, 0x51, 0xdd, 0x32, 0x09, 0x76, 0xd6, 0xb1, 0xa3, 0xda, 0x6e, 0x83, 0xb8, 0x0d, 0xd1,
+	0x76, 0x2c, 0x6a, 0xa1, 0x1c, 0xa5, 0xa6, 0x18, 0x5a, 0x89, 0xeb, 0x17, 0x0a, 0xb5, 0xb6, 0x4e,
+	0x57, 0xdd, 0x86, 0xd8, 0xb4, 0xba, 0x15, 0x6c, 0xae, 0x5b, 0x1b, 0xb6, 0x63, 0x7d, 0xbc, 0x51,
+	0xf1, 0x95, 0x9b, 0xe5, 0x36, 0x36, 0xcb, 0xeb, 0x9a, 0xa1, 0xb7, 0x34, 0x8a, 0x2b, 0x7b, 0x3e,
+	0x02, 0x64, 0xa1, 0x3c, 0x80, 0x68, 0x5b, 0x6d, 0x2b, 0x30, 0x6e, 0xb8, 0x2b, 0xfe, 0xc8, 0x1f,
+	0xf8, 0x5f, 0xa1, 0xfa, 0xeb, 0x6d, 0xcb, 0x6a, 0x1b, 0x38, 0x70, 0xd6, 0x34, 0x2d, 0x1a, 0xf8,
+	0x1a, 0x4a, 0x4f, 0x85, 0xd2, 0x3e, 0x03, 0x77, 0x6d, 0xba, 0x11, 0x0a, 0x27, 0x77, 0x0b, 0x57,
+	0x74, 0x6c, 0xb4, 0xd4, 0xae, 0x46, 0x3a, 0xa1, 0x46, 0x71, 0xb7, 0x06, 0xd5, 0xbb, 0x98, 0x50,
+	0xad, 0x6b, 0x87, 0x0a, 0xa7, 0xf7, 0x46, 0x4c, 0x6f, 0x61, 0x93, 0xea, 0x2b, 0x3a, 0x76, 0x42,
+	0x27, 0x4a, 0x5f, 0x02, 0xf8, 0x7a, 0x6d, 0x27, 0x8e, 0x4b, 0x6e, 0x43, 0x71, 0x1b, 0x37, 0x76,
+	0xd4, 0x90, 0x06, 0x8f, 0x0d, 0xc4, 0x59, 0xd5, 0x5b, 0x84, 0x07, 0x93, 0x60, 0x6a, 0xa2, 0xfa,
+	0xa6, 0x38, 0x1c, 0x5f, 0x71, 0x00, 0x33, 0x00, 0x90, 0xf2, 0xdb, 0x52, 0xe2, 0x97, 0x20, 0x96,
+	0x07, 0x4f, 0x7b, 0x45, 0xee, 0x59, 0xaf, 0x08, 0xe4, 0x9c, 0x36, 0xa8, 0x49, 0xd0, 0x32, 0x84,
+	0xb6, 0xdb, 0x50, 0x89, 0xdb, 0x50, 0xf5, 0x16, 0x1f, 0x9b, 0x04, 0x53, 0x69, 0xe9, 0xc2, 0xb6,
+	0x74, 0xc6, 0x29, 0xf1, 0x67, 0xaa, 0xc2, 0xcf, 0x3f, 0xd4, 0xca, 0xbf, 0x38, 0x5f, 0xbe, 0x7c,
+	0x77, 0xea, 0xca, 0xf4, 0x87, 0xe5, 0xbb, 0x57, 0xa2, 0xe1, 0x5b, 0xf7, 0xab, 0xe7, 0x1e, 0x9c,
+	0xf1, 0x7a, 0xc5, 0x54, 0xe8, 0xf4, 0x55, 0x39, 0x65, 0x87, 0xee, 0x97, 0xbe, 0x3c, 0x09, 0x8f,
+	0xef, 0xd9, 0x16, 0x5a, 0x82, 0xf1, 0x1d, 0xff, 0xcf, 0xed, 0xe3, 0xff, 0x9e, 0x30, 0xbc, 0x60,
+	0x17, 0x0c, 0x85, 0x66, 0x20, 0x6c, 0x3a, 0x58, 0xa3, 0xb8, 0xa5, 0x6a, 0xd4, 0x77, 0x7d, 0xa2,
+	0x5a, 0x10, 0x83, 0xcc, 0x88, 0x51, 0x66, 0xc4, 0x7a, 0x94, 0x19, 0x29, 0xc5, 0xcc, 0x1f, 0xff,
+	0xbd, 0x08, 0xe4, 0x74, 0x68, 0x57, 0xa3, 0x0c, 0xe2, 0xda, 0xad, 0x08, 0x12, 0x1f, 0x05, 0x12,
+	0xda, 0xd5, 0x28, 0xba, 0x02, 0x93, 0x2b, 0x96, 0xd3, 0xd5, 0x28, 0x3f, 0xe6, 0x07, 0xf0, 0x07,
+	0x41, 0x00, 0x4f, 0x1c, 0x14, 0x40, 0x39, 0x34, 0x43, 0x8b, 0x70, 0xcc, 0xd4, 0x28, 0xe1, 0x8f,
+	0xfb, 0xeb, 0x8b, 0x07, 0x46, 0x47, 0x5c, 0xac, 0xd5, 0x95, 0x25, 0xc7, 0x5a, 0xd7, 0x5b, 0xd8,
+	0x91, 0x52, 0x5e, 0xaf, 0x38, 0xc6, 0x66, 0xae, 0x73, 0xb2, 0xcf, 0x61, 0xbc, 0xee, 0x1a, 0xa5,
+	0xfc, 0xc9, 0xc3, 0xf2, 0x16, 0x96, 0xeb, 0xf5, 0x61, 0x1e, 0x9b, 0x61, 0x3c, 0xc6, 0x41, 0x73,
+	0x30, 0xd1, 0xd1, 0x56, 0x3a, 0x1a, 0x5f, 0xf0, 0x81, 0x95, 0x83, 0x81, 0x37, 0x99, 0x7a, 0x44,
+	0xbc, 0xce, 0xc9, 0x81, 0x3d, 0x73, 0x4c, 0xeb, 0xae, 0xd9, 0xfc, 0xa9, 0xc3, 0x3a, 0x56, 0x5b,
+	0x58, 0x5e, 0x1a, 0x76, 0x8c, 0xcd, 0x30, 0xc7, 0x18, 0x07, 0xbd, 0x09, 0x61, 0x43, 0x23, 0x58,
+	0xa5, 0x96, 0xad, 0x37, 0xf9, 0xa4, 0x1f, 0xfd, 0xf1, 0x6d, 0x69, 0xcc, 0x89, 0xf1, 0x2d, 0x39,
+	0xcd, 0x44, 0x75, 0x26, 0x41, 0x8b, 0x30, 0xdb, 0xb2, 0xee, 0x99, 0x86, 0x6e, 0x76, 0x54, 0xdb,
+	0x25, 0xab, 0xfc, 0xb8, 0xef, 0xc0, 0x5b, 0x87, 0x88, 0x0c, 0x26, 0x44, 0x6b, 0x63, 0x39, 0x13,
+	0xd9, 0x2f, 0xb9, 0x64, 0x15, 0xd5, 0x61, 0xbe, 0xcf, 0x73, 0xb0, 0x6d, 0x68, 0x4d, 0xcc, 0xa7,
+	0x46, 0x45, 0x1e, 0x8b, 0x10, 0x72, 0x40, 0x40, 0x4b, 0x30, 0xe7, 0xda, 0x3e, 0xb3, 0x1b, 0xa8,
+	0xf0, 0xe9, 0x51, 0x99, 0xd9, 0x00, 0x10, 0x0e, 0xd1, 0x4f, 0xe0, 0xc4, 0x47, 0x96, 0x6e, 0xaa,
+	0x5a, 0xb3, 0x89, 0x6d, 0xca, 0xc3, 0x51, 0x71, 0x90, 0x59, 0xd7, 0x7c, 0x63, 0x34, 0x0f, 0xfb,
+	0x31, 0x50, 0xb5, 0x66, 0x87, 0x9f, 0x18, 0x15, 0x36, 0x11, 0x99, 0xd7, 0x9a, 0x9d, 0xa1, 0x8c,
+	0x98, 0x0c, 0x97, 0x39, 0x72, 0x46, 0x16, 0xb5, 0x5d, 0x3c, 0x82, 0x4d, 0xca, 0x67, 0x8f, 0xcc,
+	0x53, 0xb0, 0x49, 0x91, 0x0c, 0xfb, 0xe9, 0x51, 0x57, 0x34, 0xdd, 0xc0, 0x2d, 0x3e, 0x37, 0x2a,
+	0x31, 0x17, 0x11, 0xae, 0xf9, 0x80, 0x21, 0xe6, 0x9a, 0x8b, 0x5d, 0xdc, 0xe2, 0x8f, 0x1d, 0x99,
+	0xb9, 0xec, 0x03, 0x18, 0xd3, 0xb0, 0xc2, 0x03, 0x82, 0x58, 0xc6, 0x3a, 0x6e, 0xf1, 0xf9, 0x91,
+	0x99, 0x11, 0x41, 0xf1, 0x01, 0x85, 0xab, 0x30, 0x33, 0xd8, 0x60, 0xd0, 0x3b, 0x10, 0x86, 0x87,
+	0xbc, 0xeb, 0x18, 0x7e, 0x0b, 0x4f, 0x4b, 0xdf, 0xdd, 0x96, 0x12, 0x4e, 0xfc, 0x11, 0x00, 0x5e,
+	0xaf, 0x98, 0x56, 0x7c, 0xe9, 0x6d, 0x79, 0x5e, 0x4e, 0x07, 0x8a, 0xb7, 0x1d, 0xa3, 0xf0, 0x28,
+	0x01, 0x33, 0x83, 0x7d, 0xe5, 0x68, 0x18, 0x74, 0x1e, 0xa6, 0x9b, 0x86, 0x8e, 0x4d, 0xba, 0x73,
+	0x40, 0x7d, 0x27, 0xf8, 0x0f, 0xff, 0x1e, 0x3b, 0x80, 0x66, 0x7c, 0x19, 0x3b, 0x80, 0x02, 0xad,
+	0x1b, 0x2d, 0x74, 0x1a, 0xa6, 0x5c, 0x82, 0x1d, 0x53, 0xeb, 0x62, 0x3e, 0x3e, 0xdc, 0x12, 0xfa,
+	0x02, 0xa6, 0x64, 0x6b, 0x84, 0xdc, 0xb3, 0x9c, 0x16, 0x3f, 0xb6, 0x4b, 0x29, 0x12, 0x20, 0x1d,
+	0x66, 0x89, 0xdb, 0x20, 0x4d, 0x47, 0x6f, 0x60, 0x75, 0xcd, 0x22, 0x7c, 0x62, 0x12, 0x4c, 0xe5,
+	0xaa, 0xd5, 0xd1, 0x1a, 0xaa, 0xb8, 0x6c, 0x29, 0x52, 0xde, 0xeb, 0x15, 0x33, 0x4a, 0x04, 0x5b,
+	0xb6, 0x14, 0x39, 0x43, 0x76, 0x46, 0x04, 0x35, 0xe1, 0x84, 0xed, 0x36, 0x0c, 0x9d, 0xac, 0xfa,
+	0x0b, 0x25, 0x8f, 0xbc, 0x50, 0xce, 0xeb, 0x15, 0xe1, 0x52, 0x80, 0x62, 0xcb, 0x40, 0x3b, 0xfa,
+	0x26, 0xe8, 0x34, 0x1c, 0x77, 0x59, 0xb7, 0x34, 0x88, 0xdf, 0x00, 0x53, 0x12, 0xf4, 0x7a, 0xc5,
+	0xe4, 0x6d, 0x82, 0xeb, 0xf3, 0x8a, 0x9c, 0x74, 0x09, 0xae, 0x1b, 0x04, 0x4d, 0xc2, 0x24, 0x35,
+	0x88, 0xda, 0xd4, 0xfc, 0x8e, 0x96, 0x91, 0xd2, 0x5e, 0xaf, 0x98, 0xa8, 0xcf, 0x2b, 0x33, 0x35,
+	0x39, 0x41, 0x0d, 0x32, 0xa3, 0xa1, 0xcb, 0xf0, 0x98, 0xaf, 0x11, 0xa4, 0xa5, 0x89, 0x1d, 0xea,
+	0x37, 0xaa, 0x8c, 0x74, 0xdc, 0xeb, 0x15, 0xb3, 0x4c, 0xd5, 0x97, 0xcc, 0x60, 0x87, 0xca, 0x59,
+	0x66, 0xd2, 0x1f, 0xa2, 0x4b, 0x30, 0x37, 0x60, 0xda, 0xc1, 0x1b, 0x7e, 0x4f, 0xca, 0x04, 0xe1,
+	0xe9, 0x5b, 0xde, 0xc4, 0x1b, 0x72, 0xa6, 0x6f, 0x78, 0x13, 0x6f, 0x94, 0xde, 0x87, 0xf1, 0x65,
+	0x4b, 0x41, 0x79, 0x98, 0xa9, 0xd5, 0xd5, 0x85, 0x5b, 0x4a, 0x5d, 0xbd, 0xb5, 0x38, 0x33, 0x9b,
+	0xe7, 0xd0, 0x71, 0x98, 0xad, 0xd5, 0xd5, 0xf9, 0xd9, 0x5a, 0x34, 0x05, 0x98, 0xd2, 0xec, 0x4f,
+	0x6b, 0x33, 0xf5, 0xf9, 0x9f, 0x05, 0x33, 0xb1, 0xc2, 0xa7, 0x09, 0x98, 0x1d, 0x3a, 0x91, 0x50,
+	0x19, 0x8e, 0x37, 0x1c, 0xab, 0x83, 0x1d, 0x76, 0x25, 0x89, 0x07, 0x35, 0x95, 0xff, 0x35, 0xc8,
+	0xa6, 0x40, 0x1e, 0x96, 0xc6, 0x9d, 0x44, 0x1e, 0xf0, 0x0f, 0x63, 0x72, 0xa4, 0xb3, 0x4f, 0x11,
+	0xb6, 0x5e, 0x52, 0x84, 0x22, 0xcc, 0x35, 0x2d, 0x93, 0xb8, 0x5d, 0xec, 0xa8, 0x6d, 0xc7, 0x72,
+	0xed, 0xdd, 0xa5, 0x98, 0x8d, 0xc4, 0x73, 0x4c, 0x3a, 0x98, 0x9a, 0xb1, 0x43, 0xa4, 0x26, 0x71,
+	0xf8, 0xd4, 0x24, 0x8f, 0x9c, 0x9a, 0xf1, 0xc3, 0xa4, 0x06, 0xc9, 0x70, 0x8c, 0x68, 0xc4, 0x08,
+	0xcf, 0xbf, 0x77, 0x46, 0xbc, 0x1b, 0x88, 0x4a, 0x4d, 0x99, 0x0f, 0x4e, 0x76, 0xf6, 0x25, 0xfb,
+	0xac, 0xc2, 0xbf, 0x00, 0xf4, 0x87, 0x68, 0x05, 0xa6, 0xbb, 0xb8, 0xb9, 0xaa, 0x99, 0x3a, 0xe9,
+	0xfa, 0x2d, 0x23, 0x57, 0xfd, 0xf1, 0x51, 0x56, 0x10, 0x17, 0x22, 0x8a, 0x94, 0xda, 0x96, 0x12,
+	0x9f, 0xb0, 0xeb, 0xa4, 0xbc, 0x83, 0x1e, 0xea, 0x19, 0xb1, 0xc3, 0xf4, 0x8c, 0xf8, 0x4b, 0x7a,
+	0x46, 0xe9, 0x7d, 0x98, 0xee, 0xaf, 0x85, 0xd2, 0x30, 0xb1, 0x34, 0x5f, 0xbb, 0xb1, 0x18, 0x14,
+	0xaa, 0x32, 0x23, 0xd7, 0x16, 0x54, 0xe5, 0x7a, 0x4d, 0xad, 0x5e, 0xbc, 0x94, 0x07, 0xc3, 0x53,
+	0x17, 0x7f, 0x58, 0xcd, 0xc7, 0x0a, 0x7f, 0x8b, 0xc1, 0xcc, 0xe0, 0x9d, 0xe7, 0x88, 0x4d, 0xf3,
+	0x3f, 0xb6, 0x1d, 0xf4, 0x23, 0x98, 0xc2, 0x1f, 0xb3, 0xed, 0xb4, 0x71, 0xd8, 0x27, 0x4f, 0x6d,
+	0x4b, 0xbc, 0xf3, 0x1a, 0xdf, 0xaa, 0x22, 0xff, 0x76, 0x5b, 0x2b, 0xdf, 0x39, 0x5f, 0xbe, 0x5c,
+	0x56, 0xc5, 0xe9, 0xbb, 0x67, 0xcf, 0xc8, 0x7d, 0xe5, 0xff, 0xcb, 0x5a, 0x2d, 0x4c, 0xc1, 0xf1,
+	0xe8, 0x6a, 0xf4, 0x06, 0x4c, 0x04, 0xb7, 0x46, 0x30, 0xbc, 0xf5, 0x60, 0x56, 0x3a, 0x06, 0x53,
+	0x76, 0x94, 0x83, 0xf8, 0x73, 0x09, 0x94, 0x96, 0x21, 0xda, 0x53, 0x68, 0x04, 0xbd, 0x07, 0xc7,
+	0x83, 0x87, 0x6f, 0xd0, 0x47, 0x26, 0xaa, 0xdf, 0x3f, 0xb0, 0x3a, 0xe5, 0xc8, 0xa2, 0xf4, 0x29,
+	0x80, 0xfc, 0x1e, 0xf1, 0x35, 0xff, 0x49, 0x40, 0xd0, 0x2d, 0x38, 0x1e, 0xbc, 0x0e, 0x22, 0xf2,
+	0xc5, 0x03, 0xc9, 0xa1, 0xa9, 0x18, 0xfe, 0x9d, 0x35, 0xa9, 0xb3, 0x21, 0x47, 0x94, 0xc2, 0x34,
+	0xcc, 0x0c, 0x0a, 0x50, 0x1e, 0xc6, 0x59, 0xe0, 0xfc, 0xed, 0xcb, 0xec, 0x13, 0x9d, 0x80, 0x89,
+	0x75, 0xcd, 0x70, 0xc3, 0x92, 0x91, 0x83, 0xc1, 0x74, 0xec, 0x5d, 0x50, 0x7a, 0x02, 0xe0, 0xa9,
+	0x39, 0x4c, 0xf7, 0xee, 0x05, 0xaf, 0xb9, 0x98, 0xd0, 0xff, 0xc2, 0xeb, 0xee, 0x0a, 0x84, 0x3b,
+	0xcf, 0xee, 0x97, 0xbe, 0xee, 0xae, 0x31, 0x95, 0x05, 0x8d, 0x74, 0xa4, 0x31, 0x66, 0x2e, 0xa7,
+	0x57, 0xa2, 0x89, 0xd2, 0x5f, 0x00, 0x7c, 0x63, 0x5e, 0x27, 0x7b, 0x7d, 0x26, 0x91, 0xd3, 0xff,
+	0x83, 0xe7, 0xf5, 0x2b, 0xef, 0xe2, 0x4f, 0x00, 0x9e, 0x52, 0xf6, 0x09, 0xfc, 0x4d, 0x98, 0x0c,
+	0xaa, 0x29, 0x74, 0xfd, 0xe0, 0xf2, 0x7b, 0x81, 0xd7, 0x21, 0xe2, 0x95, 0xbd, 0xad, 0xfe, 0x39,
+	0x09, 0x4f, 0xbe, 0xc0, 0xd5, 0xb6, 0x4e, 0x58, 0xc1, 0x7d, 0x04, 0xe1, 0x1c, 0xa6, 0x51, 0x7d,
+	0xbf, 0xb6, 0x07, 0x3c, 0xcb, 0x7e, 0x83, 0x29, 0x4c, 0x1d, 0xb6, 0xcc, 0x4b, 0x85, 0x4f, 0xfe,
+	0xfa, 0x8f, 0xdf, 0xc4, 0x4e, 0x20, 0x54, 0xd1, 0x48, 0x25, 0xd8, 0x42, 0x39, 0x2c, 0x76, 0xf4,
+	0x7b, 0x00, 0xe3, 0x73, 0x98, 0xa2, 0xb7, 0x77, 0xd3, 0xf6, 0xa9, 0xe2, 0xc2, 0xc1, 0xc1, 0x2b,
+	0x5d, 0xf7, 0xd7, 0x94, 0xd0, 0x07, 0x3b, 0x6b, 0x56, 0xee, 0xeb, 0x2d, 0x22, 0xee, 0xaa, 0xa4,
+	0x5d, 0xe3, 0x07, 0x81, 0xd2, 0xce, 0x4f, 0x2d, 0x0f, 0xd0, 0xaf, 0x00, 0x1c, 0x63, 0xf5, 0x89,
+	0xca, 0xbb, 0x57, 0xdd, 0xb7, 0x6a, 0x0b, 0xa5, 0x03, 0x9d, 0x24, 0xa5, 0x0b, 0xbe, 0x97, 0x65,
+	0xf4, 0xf6, 0xa0, 0x97, 0x07, 0x78, 0x88, 0xfe, 0x09, 0x60, 0x5c, 0x79, 0x51, 0xc8, 0x94, 0x57,
+	0x0b, 0xd9, 0x6f, 0x81, 0xef, 0xcd, 0x63, 0x50, 0x58, 0x1c, 0x74, 0x27, 0xf8, 0x2b, 0x1e, 0x2a,
+	0x76, 0x03, 0xba, 0x03, 0x21, 0x9c, 0x06, 0x67, 0xef, 0xbc, 0x57, 0xba, 0x74, 0x34, 0xe8, 0x34,
+	0x38, 0x8b, 0x1e, 0x03, 0x98, 0xbc, 0x8a, 0x0d, 0x4c, 0x31, 0x1a, 0xa9, 0x67, 0x15, 0x5e, 0x52,
+	0xbb, 0xa5, 0x0f, 0xfc, 0x9d, 0x4e, 0x9f, 0x7d, 0x77, 0x84, 0xb8, 0x57, 0xee, 0x0f, 0x6c, 0x49,
+	0xfa, 0x23, 0x78, 0xba, 0x29, 0x80, 0x67, 0x9b, 0x02, 0xf8, 0x6a, 0x53, 0xe0, 0xbe, 0xde, 0x14,
+	0xb8, 0x6f, 0x36, 0x05, 0xee, 0xdb, 0x4d, 0x81, 0x7b, 0xbe, 0x29, 0x80, 0x87, 0x9e, 0x00, 0x1e,
+	0x79, 0x02, 0xf7, 0x99, 0x27, 0x80, 0x27, 0x9e, 0xc0, 0x7d, 0xee, 0x09, 0xdc, 0x17, 0x9e, 0xc0,
+	0x3d, 0xf5, 0x04, 0xf0, 0xcc, 0x13, 0xc0, 0x57, 0x9e, 0xc0, 0x7d, 0xed, 0x09, 0xe0, 0x1b, 0x4f,
+	0xe0, 0xbe, 0xf5, 0x04, 0xf0, 0xdc, 0x13, 0xb8, 0x87, 0x5b, 0x02, 0xf7, 0x68, 0x4b, 0x00, 0x8f,
+	0xb7, 0x04, 0xee, 0x77, 0x5b, 0x02, 0xf8, 0xc3, 0x96, 0xc0, 0x7d, 0xb6, 0x25, 0x70, 0x4f, 0xb6,
+	0x04, 0xf0, 0xf9, 0x96, 0x00, 0xbe, 0xd8, 0x12, 0xc0, 0x9d, 0x73, 0x6d, 0x4b, 0xa4, 0xab, 0x98,
+	0xae, 0xea, 0x66, 0x9b, 0x88, 0x26, 0xa6, 0xf7, 0x2c, 0xa7, 0x53, 0x19, 0xfe, 0x11, 0xd3, 0xee,
+	0xb4, 0x2b, 0x94, 0x9a, 0x76, 0xa3, 0x91, 0xf4, 0xb7, 0x7d, 0xe1, 0xdf, 0x03, 0x00, 0x2b, 0x46,
+	0xec, 0x6e, 0x18, 0x16, 0x00, 0x00,
 }
 
 func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
@@ -908,6 +1256,13 @@ func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x ApplicationPubSub_KafkaProvider_SASL_Mechanism) String() string {
+	s, ok := ApplicationPubSub_KafkaProvider_SASL_Mechanism_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *ApplicationPubSubIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1058,6 +1413,54 @@ func (this *ApplicationPubSub_MQTT) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationPubSub_Kafka) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_Kafka)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_Kafka)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Kafka.Equal(that1.Kafka) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_AMQP) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_AMQP)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AMQP)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AMQP.Equal(that1.AMQP) {
+		return false
+	}
+	return true
+}
 func (this *ApplicationPubSub_NATSProvider) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1133,14 +1536,14 @@ func (this *ApplicationPubSub_MQTTProvider) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
+func (this *ApplicationPubSub_KafkaProvider) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplicationPubSub_Message)
+	that1, ok := that.(*ApplicationPubSub_KafkaProvider)
 	if !ok {
-		that2, ok := that.(ApplicationPubSub_Message)
+		that2, ok := that.(ApplicationPubSub_KafkaProvider)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1152,20 +1555,142 @@ func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Topic != that1.Topic {
+	if len(this.Brokers) != len(that1.Brokers) {
 		return false
 	}
-	return true
-}
-func (this *ApplicationPubSubs) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	for i := range this.Brokers {
+		if this.Brokers[i] != that1.Brokers[i] {
+			return false
+		}
 	}
-
-	that1, ok := that.(*ApplicationPubSubs)
-	if !ok {
-		that2, ok := that.(ApplicationPubSubs)
-		if ok {
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if this.ConsumerGroup != that1.ConsumerGroup {
+		return false
+	}
+	if this.UseTLS != that1.UseTLS {
+		return false
+	}
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	if !this.SASL.Equal(that1.SASL) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_KafkaProvider_SASL) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_KafkaProvider_SASL)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_KafkaProvider_SASL)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mechanism != that1.Mechanism {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_AMQPProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_AMQPProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AMQPProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ServerURL != that1.ServerURL {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	if this.Exchange != that1.Exchange {
+		return false
+	}
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_Message)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_Message)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Topic != that1.Topic {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSubs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSubs)
+	if !ok {
+		that2, ok := that.(ApplicationPubSubs)
+		if ok {
 			that1 = &that2
 		} else {
 			return false
@@ -1801,6 +2326,52 @@ func (m *ApplicationPubSub_MQTT) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ApplicationPubSub_Kafka) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_Kafka) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ApplicationPubSub_AMQP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_AMQP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AMQP != nil {
+		{
+			size, err := m.AMQP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ApplicationPubSub_NATSProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1923,7 +2494,7 @@ func (m *ApplicationPubSub_MQTTProvider) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPubSub_KafkaProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1933,27 +2504,86 @@ func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPubSub_Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_KafkaProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPubSub_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_KafkaProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Topic) > 0 {
-		i -= len(m.Topic)
-		copy(dAtA[i:], m.Topic)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Topic)))
+	if m.SASL != nil {
+		{
+			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
+	}
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UseTLS {
+		i--
+		if m.UseTLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ConsumerGroup) > 0 {
+		i -= len(m.ConsumerGroup)
+		copy(dAtA[i:], m.ConsumerGroup)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ConsumerGroup)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Brokers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPubSubs) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPubSub_KafkaProvider_SASL) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1963,34 +2593,39 @@ func (m *ApplicationPubSubs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPubSubs) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_KafkaProvider_SASL) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPubSubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_KafkaProvider_SASL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pubsubs) > 0 {
-		for iNdEx := len(m.Pubsubs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pubsubs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Mechanism != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.Mechanism))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPubSub_AMQPProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2000,25 +2635,164 @@ func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPubSubFormats) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_AMQPProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPubSubFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPubSub_AMQPProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Formats) > 0 {
-		for k := range m.Formats {
-			v := m.Formats[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Exchange) > 0 {
+		i -= len(m.Exchange)
+		copy(dAtA[i:], m.Exchange)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Exchange)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServerURL) > 0 {
+		i -= len(m.ServerURL)
+		copy(dAtA[i:], m.ServerURL)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServerURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSubs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSubs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pubsubs) > 0 {
+		for iNdEx := len(m.Pubsubs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pubsubs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSubFormats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSubFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k := range m.Formats {
+			v := m.Formats[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
 			i -= len(k)
 			copy(dAtA[i:], k)
 			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(k)))
@@ -2222,12 +2996,16 @@ func NewPopulatedApplicationPubSub(r randyApplicationserverPubsub, easy bool) *A
 	if r.Intn(5) != 0 {
 		this.LocationSolved = NewPopulatedApplicationPubSub_Message(r, easy)
 	}
-	oneofNumber_Provider := []int32{17, 25}[r.Intn(2)]
+	oneofNumber_Provider := []int32{17, 25, 26, 27}[r.Intn(4)]
 	switch oneofNumber_Provider {
 	case 17:
 		this.Provider = NewPopulatedApplicationPubSub_NATS(r, easy)
 	case 25:
 		this.Provider = NewPopulatedApplicationPubSub_MQTT(r, easy)
+	case 26:
+		this.Provider = NewPopulatedApplicationPubSub_Kafka(r, easy)
+	case 27:
+		this.Provider = NewPopulatedApplicationPubSub_AMQP(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -2244,6 +3022,16 @@ func NewPopulatedApplicationPubSub_MQTT(r randyApplicationserverPubsub, easy boo
 	this.MQTT = NewPopulatedApplicationPubSub_MQTTProvider(r, easy)
 	return this
 }
+func NewPopulatedApplicationPubSub_Kafka(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Kafka {
+	this := &ApplicationPubSub_Kafka{}
+	this.Kafka = NewPopulatedApplicationPubSub_KafkaProvider(r, easy)
+	return this
+}
+func NewPopulatedApplicationPubSub_AMQP(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AMQP {
+	this := &ApplicationPubSub_AMQP{}
+	this.AMQP = NewPopulatedApplicationPubSub_AMQPProvider(r, easy)
+	return this
+}
 func NewPopulatedApplicationPubSub_NATSProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_NATSProvider {
 	this := &ApplicationPubSub_NATSProvider{}
 	this.ServerURL = randStringApplicationserverPubsub(r)
@@ -2281,6 +3069,75 @@ func NewPopulatedApplicationPubSub_MQTTProvider(r randyApplicationserverPubsub,
 	return this
 }
 
+func NewPopulatedApplicationPubSub_KafkaProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_KafkaProvider {
+	this := &ApplicationPubSub_KafkaProvider{}
+	v8 := r.Intn(10)
+	this.Brokers = make([]string, v8)
+	for i := 0; i < v8; i++ {
+		this.Brokers[i] = randStringApplicationserverPubsub(r)
+	}
+	this.ClientID = randStringApplicationserverPubsub(r)
+	this.ConsumerGroup = randStringApplicationserverPubsub(r)
+	this.UseTLS = bool(r.Intn(2) == 0)
+	v9 := r.Intn(100)
+	this.TLSCA = make([]byte, v9)
+	for i := 0; i < v9; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v10 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v10)
+	for i := 0; i < v10; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v11 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v11)
+	for i := 0; i < v11; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		this.SASL = NewPopulatedApplicationPubSub_KafkaProvider_SASL(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_KafkaProvider_SASL(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_KafkaProvider_SASL {
+	this := &ApplicationPubSub_KafkaProvider_SASL{}
+	this.Mechanism = ApplicationPubSub_KafkaProvider_SASL_Mechanism([]int32{0, 1, 2}[r.Intn(3)])
+	this.Username = randStringApplicationserverPubsub(r)
+	this.Password = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_AMQPProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AMQPProvider {
+	this := &ApplicationPubSub_AMQPProvider{}
+	this.ServerURL = randStringApplicationserverPubsub(r)
+	this.Username = randStringApplicationserverPubsub(r)
+	this.Password = randStringApplicationserverPubsub(r)
+	this.Exchange = randStringApplicationserverPubsub(r)
+	v12 := r.Intn(100)
+	this.TLSCA = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v13 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v14 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v14)
+	for i := 0; i < v14; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Message {
 	this := &ApplicationPubSub_Message{}
 	this.Topic = randStringApplicationserverPubsub(r)
@@ -2292,9 +3149,9 @@ func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy
 func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubs {
 	this := &ApplicationPubSubs{}
 	if r.Intn(5) != 0 {
-		v8 := r.Intn(5)
-		this.Pubsubs = make([]*ApplicationPubSub, v8)
-		for i := 0; i < v8; i++ {
+		v15 := r.Intn(5)
+		this.Pubsubs = make([]*ApplicationPubSub, v15)
+		for i := 0; i < v15; i++ {
 			this.Pubsubs[i] = NewPopulatedApplicationPubSub(r, easy)
 		}
 	}
@@ -2306,9 +3163,9 @@ func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *
 func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubFormats {
 	this := &ApplicationPubSubFormats{}
 	if r.Intn(5) != 0 {
-		v9 := r.Intn(10)
+		v16 := r.Intn(10)
 		this.Formats = make(map[string]string)
-		for i := 0; i < v9; i++ {
+		for i := 0; i < v16; i++ {
 			this.Formats[randStringApplicationserverPubsub(r)] = randStringApplicationserverPubsub(r)
 		}
 	}
@@ -2319,10 +3176,10 @@ func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy b
 
 func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *GetApplicationPubSubRequest {
 	this := &GetApplicationPubSubRequest{}
-	v10 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
-	this.ApplicationPubSubIdentifiers = *v10
-	v11 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v11
+	v17 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
+	this.ApplicationPubSubIdentifiers = *v17
+	v18 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v18
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2330,10 +3187,10 @@ func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, eas
 
 func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, easy bool) *ListApplicationPubSubsRequest {
 	this := &ListApplicationPubSubsRequest{}
-	v12 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v12
-	v13 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v13
+	v19 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v19
+	v20 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2341,10 +3198,10 @@ func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, e
 
 func NewPopulatedSetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *SetApplicationPubSubRequest {
 	this := &SetApplicationPubSubRequest{}
-	v14 := NewPopulatedApplicationPubSub(r, easy)
-	this.ApplicationPubSub = *v14
-	v15 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v15
+	v21 := NewPopulatedApplicationPubSub(r, easy)
+	this.ApplicationPubSub = *v21
+	v22 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v22
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2369,9 +3226,9 @@ func randUTF8RuneApplicationserverPubsub(r randyApplicationserverPubsub) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserverPubsub(r randyApplicationserverPubsub) string {
-	v16 := r.Intn(100)
-	tmps := make([]rune, v16)
-	for i := 0; i < v16; i++ {
+	v23 := r.Intn(100)
+	tmps := make([]rune, v23)
+	for i := 0; i < v23; i++ {
 		tmps[i] = randUTF8RuneApplicationserverPubsub(r)
 	}
 	return string(tmps)
@@ -2393,11 +3250,11 @@ func randFieldApplicationserverPubsub(dAtA []byte, r randyApplicationserverPubsu
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
-		v17 := r.Int63()
+		v24 := r.Int63()
 		if r.Intn(2) == 0 {
-			v17 *= -1
+			v24 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v17))
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v24))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2527,6 +3384,30 @@ func (m *ApplicationPubSub_MQTT) Size() (n int) {
 	}
 	return n
 }
+func (m *ApplicationPubSub_Kafka) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationPubSub_AMQP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AMQP != nil {
+		l = m.AMQP.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
 func (m *ApplicationPubSub_NATSProvider) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2586,52 +3467,151 @@ func (m *ApplicationPubSub_MQTTProvider) Size() (n int) {
 	return n
 }
 
-func (m *ApplicationPubSub_Message) Size() (n int) {
+func (m *ApplicationPubSub_KafkaProvider) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Topic)
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovApplicationserverPubsub(uint64(l))
+		}
+	}
+	l = len(m.ClientID)
 	if l > 0 {
 		n += 1 + l + sovApplicationserverPubsub(uint64(l))
 	}
-	return n
-}
-
-func (m *ApplicationPubSubs) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.ConsumerGroup)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
 	}
-	var l int
-	_ = l
-	if len(m.Pubsubs) > 0 {
-		for _, e := range m.Pubsubs {
-			l = e.Size()
-			n += 1 + l + sovApplicationserverPubsub(uint64(l))
-		}
+	if m.UseTLS {
+		n += 2
+	}
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.SASL != nil {
+		l = m.SASL.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
 	}
 	return n
 }
 
-func (m *ApplicationPubSubFormats) Size() (n int) {
+func (m *ApplicationPubSub_KafkaProvider_SASL) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Formats) > 0 {
-		for k, v := range m.Formats {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApplicationserverPubsub(uint64(len(k))) + 1 + len(v) + sovApplicationserverPubsub(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApplicationserverPubsub(uint64(mapEntrySize))
-		}
+	if m.Mechanism != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.Mechanism))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
 	}
 	return n
 }
 
-func (m *GetApplicationPubSubRequest) Size() (n int) {
+func (m *ApplicationPubSub_AMQPProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Exchange)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSubs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pubsubs) > 0 {
+		for _, e := range m.Pubsubs {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverPubsub(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationPubSubFormats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k, v := range m.Formats {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverPubsub(uint64(len(k))) + 1 + len(v) + sovApplicationserverPubsub(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplicationserverPubsub(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetApplicationPubSubRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2732,6 +3712,26 @@ func (this *ApplicationPubSub_MQTT) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationPubSub_Kafka) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_Kafka{`,
+		`Kafka:` + strings.Replace(fmt.Sprintf("%v", this.Kafka), "ApplicationPubSub_KafkaProvider", "ApplicationPubSub_KafkaProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_AMQP) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AMQP{`,
+		`AMQP:` + strings.Replace(fmt.Sprintf("%v", this.AMQP), "ApplicationPubSub_AMQPProvider", "ApplicationPubSub_AMQPProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPubSub_NATSProvider) String() string {
 	if this == nil {
 		return "nil"
@@ -2761,6 +3761,51 @@ func (this *ApplicationPubSub_MQTTProvider) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationPubSub_KafkaProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_KafkaProvider{`,
+		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
+		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
+		`ConsumerGroup:` + fmt.Sprintf("%v", this.ConsumerGroup) + `,`,
+		`UseTLS:` + fmt.Sprintf("%v", this.UseTLS) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`SASL:` + strings.Replace(fmt.Sprintf("%v", this.SASL), "ApplicationPubSub_KafkaProvider_SASL", "ApplicationPubSub_KafkaProvider_SASL", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_KafkaProvider_SASL) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_KafkaProvider_SASL{`,
+		`Mechanism:` + fmt.Sprintf("%v", this.Mechanism) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_AMQPProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AMQPProvider{`,
+		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`Exchange:` + fmt.Sprintf("%v", this.Exchange) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPubSub_Message) String() string {
 	if this == nil {
 		return "nil"
@@ -3587,6 +4632,76 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 			}
 			m.Provider = &ApplicationPubSub_MQTT{v}
 			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_KafkaProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_Kafka{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_AMQPProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_AMQP{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
@@ -4037,6 +5152,732 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationPubSub_KafkaProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseTLS = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SASL == nil {
+				m.SASL = &ApplicationPubSub_KafkaProvider_SASL{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+			}
+			m.Mechanism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mechanism |= ApplicationPubSub_KafkaProvider_SASL_Mechanism(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_AMQPProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AMQPProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AMQPProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exchange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exchange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationPubSub_Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
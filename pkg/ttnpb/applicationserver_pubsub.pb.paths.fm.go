@@ -39,6 +39,26 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"location_solved",
 	"location_solved.topic",
 	"provider",
+	"provider.amqp",
+	"provider.amqp.exchange",
+	"provider.amqp.password",
+	"provider.amqp.server_url",
+	"provider.amqp.tls_ca",
+	"provider.amqp.tls_client_cert",
+	"provider.amqp.tls_client_key",
+	"provider.amqp.username",
+	"provider.kafka",
+	"provider.kafka.brokers",
+	"provider.kafka.client_id",
+	"provider.kafka.consumer_group",
+	"provider.kafka.sasl",
+	"provider.kafka.sasl.mechanism",
+	"provider.kafka.sasl.password",
+	"provider.kafka.sasl.username",
+	"provider.kafka.tls_ca",
+	"provider.kafka.tls_client_cert",
+	"provider.kafka.tls_client_key",
+	"provider.kafka.use_tls",
 	"provider.mqtt",
 	"provider.mqtt.client_id",
 	"provider.mqtt.password",
@@ -140,6 +160,26 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.location_solved",
 	"pubsub.location_solved.topic",
 	"pubsub.provider",
+	"pubsub.provider.amqp",
+	"pubsub.provider.amqp.exchange",
+	"pubsub.provider.amqp.password",
+	"pubsub.provider.amqp.server_url",
+	"pubsub.provider.amqp.tls_ca",
+	"pubsub.provider.amqp.tls_client_cert",
+	"pubsub.provider.amqp.tls_client_key",
+	"pubsub.provider.amqp.username",
+	"pubsub.provider.kafka",
+	"pubsub.provider.kafka.brokers",
+	"pubsub.provider.kafka.client_id",
+	"pubsub.provider.kafka.consumer_group",
+	"pubsub.provider.kafka.sasl",
+	"pubsub.provider.kafka.sasl.mechanism",
+	"pubsub.provider.kafka.sasl.password",
+	"pubsub.provider.kafka.sasl.username",
+	"pubsub.provider.kafka.tls_ca",
+	"pubsub.provider.kafka.tls_client_cert",
+	"pubsub.provider.kafka.tls_client_key",
+	"pubsub.provider.kafka.use_tls",
 	"pubsub.provider.mqtt",
 	"pubsub.provider.mqtt.client_id",
 	"pubsub.provider.mqtt.password",
@@ -194,6 +234,49 @@ var ApplicationPubSub_MQTTProviderFieldPathsTopLevel = []string{
 	"use_tls",
 	"username",
 }
+var ApplicationPubSub_KafkaProviderFieldPathsNested = []string{
+	"brokers",
+	"client_id",
+	"consumer_group",
+	"sasl",
+	"sasl.mechanism",
+	"sasl.password",
+	"sasl.username",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+
+var ApplicationPubSub_KafkaProviderFieldPathsTopLevel = []string{
+	"brokers",
+	"client_id",
+	"consumer_group",
+	"sasl",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+var ApplicationPubSub_AMQPProviderFieldPathsNested = []string{
+	"exchange",
+	"password",
+	"server_url",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"username",
+}
+
+var ApplicationPubSub_AMQPProviderFieldPathsTopLevel = []string{
+	"exchange",
+	"password",
+	"server_url",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"username",
+}
 var ApplicationPubSub_MessageFieldPathsNested = []string{
 	"topic",
 }
@@ -201,3 +284,14 @@ var ApplicationPubSub_MessageFieldPathsNested = []string{
 var ApplicationPubSub_MessageFieldPathsTopLevel = []string{
 	"topic",
 }
+var ApplicationPubSub_KafkaProvider_SASLFieldPathsNested = []string{
+	"mechanism",
+	"password",
+	"username",
+}
+
+var ApplicationPubSub_KafkaProvider_SASLFieldPathsTopLevel = []string{
+	"mechanism",
+	"password",
+	"username",
+}
@@ -4,6 +4,7 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	io "io"
@@ -504,17 +505,22 @@ type ApplicationWebhook struct {
 	TemplateFields map[string]string `protobuf:"bytes,16,rep,name=template_fields,json=templateFields,proto3" json:"template_fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The API key to be used for downlink queue operations.
 	// The field is provided for convenience reasons, and can contain API keys with additional rights (albeit this is discouraged).
-	DownlinkAPIKey       string                      `protobuf:"bytes,17,opt,name=downlink_api_key,json=downlinkApiKey,proto3" json:"downlink_api_key,omitempty"`
-	UplinkMessage        *ApplicationWebhook_Message `protobuf:"bytes,7,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
-	JoinAccept           *ApplicationWebhook_Message `protobuf:"bytes,8,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
-	DownlinkAck          *ApplicationWebhook_Message `protobuf:"bytes,9,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
-	DownlinkNack         *ApplicationWebhook_Message `protobuf:"bytes,10,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
-	DownlinkSent         *ApplicationWebhook_Message `protobuf:"bytes,11,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
-	DownlinkFailed       *ApplicationWebhook_Message `protobuf:"bytes,12,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
-	DownlinkQueued       *ApplicationWebhook_Message `protobuf:"bytes,13,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
-	LocationSolved       *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	DownlinkAPIKey string `protobuf:"bytes,17,opt,name=downlink_api_key,json=downlinkApiKey,proto3" json:"downlink_api_key,omitempty"`
+	// The secret used to sign requests with HMAC-SHA256.
+	// If set, the requests contain the X-Webhook-Timestamp and X-Webhook-Signature headers.
+	SigningSecret string `protobuf:"bytes,18,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// The client TLS certificate used to authenticate to the webhook endpoint.
+	ClientCertificate    *ApplicationWebhook_ClientCertificate `protobuf:"bytes,19,opt,name=client_certificate,json=clientCertificate,proto3" json:"client_certificate,omitempty"`
+	UplinkMessage        *ApplicationWebhook_Message           `protobuf:"bytes,7,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
+	JoinAccept           *ApplicationWebhook_Message           `protobuf:"bytes,8,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
+	DownlinkAck          *ApplicationWebhook_Message           `protobuf:"bytes,9,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
+	DownlinkNack         *ApplicationWebhook_Message           `protobuf:"bytes,10,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
+	DownlinkSent         *ApplicationWebhook_Message           `protobuf:"bytes,11,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
+	DownlinkFailed       *ApplicationWebhook_Message           `protobuf:"bytes,12,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
+	DownlinkQueued       *ApplicationWebhook_Message           `protobuf:"bytes,13,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
+	LocationSolved       *ApplicationWebhook_Message           `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
 func (m *ApplicationWebhook) Reset()      { *m = ApplicationWebhook{} }
@@ -598,6 +604,20 @@ func (m *ApplicationWebhook) GetDownlinkAPIKey() string {
 	return ""
 }
 
+func (m *ApplicationWebhook) GetSigningSecret() string {
+	if m != nil {
+		return m.SigningSecret
+	}
+	return ""
+}
+
+func (m *ApplicationWebhook) GetClientCertificate() *ApplicationWebhook_ClientCertificate {
+	if m != nil {
+		return m.ClientCertificate
+	}
+	return nil
+}
+
 func (m *ApplicationWebhook) GetUplinkMessage() *ApplicationWebhook_Message {
 	if m != nil {
 		return m.UplinkMessage
@@ -654,6 +674,80 @@ func (m *ApplicationWebhook) GetLocationSolved() *ApplicationWebhook_Message {
 	return nil
 }
 
+type ApplicationWebhook_ClientCertificate struct {
+	// PEM encoded X.509 certificate chain.
+	Certificate []byte `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// PEM encoded private key.
+	// The private key is only accepted when setting the client certificate; it is stored encrypted and never returned.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The encrypted private key.
+	EncryptedKey []byte `protobuf:"bytes,3,opt,name=encrypted_key,json=encryptedKey,proto3" json:"encrypted_key,omitempty"`
+	// The label of the key encryption key (KEK) used to encrypt the private key.
+	KEKLabel             string   `protobuf:"bytes,4,opt,name=kek_label,json=kekLabel,proto3" json:"kek_label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationWebhook_ClientCertificate) Reset()      { *m = ApplicationWebhook_ClientCertificate{} }
+func (*ApplicationWebhook_ClientCertificate) ProtoMessage() {}
+func (*ApplicationWebhook_ClientCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{5, 2}
+}
+func (m *ApplicationWebhook_ClientCertificate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhook_ClientCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhook_ClientCertificate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhook_ClientCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhook_ClientCertificate.Merge(m, src)
+}
+func (m *ApplicationWebhook_ClientCertificate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhook_ClientCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhook_ClientCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhook_ClientCertificate proto.InternalMessageInfo
+
+func (m *ApplicationWebhook_ClientCertificate) GetCertificate() []byte {
+	if m != nil {
+		return m.Certificate
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook_ClientCertificate) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook_ClientCertificate) GetEncryptedKey() []byte {
+	if m != nil {
+		return m.EncryptedKey
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook_ClientCertificate) GetKEKLabel() string {
+	if m != nil {
+		return m.KEKLabel
+	}
+	return ""
+}
+
 type ApplicationWebhook_Message struct {
 	// Path to append to the base URL.
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -664,7 +758,7 @@ type ApplicationWebhook_Message struct {
 func (m *ApplicationWebhook_Message) Reset()      { *m = ApplicationWebhook_Message{} }
 func (*ApplicationWebhook_Message) ProtoMessage() {}
 func (*ApplicationWebhook_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{5, 2}
+	return fileDescriptor_2652f2d8eaceda0e, []int{5, 3}
 }
 func (m *ApplicationWebhook_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1022,6 +1116,242 @@ func (m *ListApplicationWebhookTemplatesRequest) GetFieldMask() types.FieldMask
 	return types.FieldMask{}
 }
 
+// ApplicationWebhookDelivery is a message that is (being) delivered to a webhook.
+type ApplicationWebhookDelivery struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// Unique identifier of the delivery.
+	DeliveryID string `protobuf:"bytes,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	// The message to deliver.
+	Up        *ApplicationUp `protobuf:"bytes,3,opt,name=up,proto3" json:"up,omitempty"`
+	CreatedAt time.Time      `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// Number of delivery attempts made so far.
+	Attempts uint32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Time of the last delivery attempt.
+	LastAttemptedAt *time.Time `protobuf:"bytes,6,opt,name=last_attempted_at,json=lastAttemptedAt,proto3,stdtime" json:"last_attempted_at,omitempty"`
+	// Error of the last delivery attempt.
+	LastError            *ErrorDetails `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApplicationWebhookDelivery) Reset()      { *m = ApplicationWebhookDelivery{} }
+func (*ApplicationWebhookDelivery) ProtoMessage() {}
+func (*ApplicationWebhookDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{13}
+}
+func (m *ApplicationWebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDelivery.Merge(m, src)
+}
+func (m *ApplicationWebhookDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookDelivery proto.InternalMessageInfo
+
+func (m *ApplicationWebhookDelivery) GetDeliveryID() string {
+	if m != nil {
+		return m.DeliveryID
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookDelivery) GetUp() *ApplicationUp {
+	if m != nil {
+		return m.Up
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookDelivery) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *ApplicationWebhookDelivery) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ApplicationWebhookDelivery) GetLastAttemptedAt() *time.Time {
+	if m != nil {
+		return m.LastAttemptedAt
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookDelivery) GetLastError() *ErrorDetails {
+	if m != nil {
+		return m.LastError
+	}
+	return nil
+}
+
+type ApplicationWebhookDeliveries struct {
+	Deliveries           []*ApplicationWebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ApplicationWebhookDeliveries) Reset()      { *m = ApplicationWebhookDeliveries{} }
+func (*ApplicationWebhookDeliveries) ProtoMessage() {}
+func (*ApplicationWebhookDeliveries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{14}
+}
+func (m *ApplicationWebhookDeliveries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookDeliveries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookDeliveries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhookDeliveries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDeliveries.Merge(m, src)
+}
+func (m *ApplicationWebhookDeliveries) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookDeliveries) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDeliveries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookDeliveries proto.InternalMessageInfo
+
+func (m *ApplicationWebhookDeliveries) GetDeliveries() []*ApplicationWebhookDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+type ListApplicationWebhookFailedDeliveriesRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// Limit the number of results.
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) Reset() {
+	*m = ListApplicationWebhookFailedDeliveriesRequest{}
+}
+func (*ListApplicationWebhookFailedDeliveriesRequest) ProtoMessage() {}
+func (*ListApplicationWebhookFailedDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{15}
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListApplicationWebhookFailedDeliveriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationWebhookFailedDeliveriesRequest.Merge(m, src)
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationWebhookFailedDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationWebhookFailedDeliveriesRequest proto.InternalMessageInfo
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ReplayApplicationWebhookFailedDeliveriesRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// The identifiers of the failed deliveries to replay.
+	// If empty, all failed deliveries of the webhook are replayed.
+	DeliveryIDs          []string `protobuf:"bytes,2,rep,name=delivery_ids,json=deliveryIds,proto3" json:"delivery_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) Reset() {
+	*m = ReplayApplicationWebhookFailedDeliveriesRequest{}
+}
+func (*ReplayApplicationWebhookFailedDeliveriesRequest) ProtoMessage() {}
+func (*ReplayApplicationWebhookFailedDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{16}
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayApplicationWebhookFailedDeliveriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayApplicationWebhookFailedDeliveriesRequest.Merge(m, src)
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayApplicationWebhookFailedDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayApplicationWebhookFailedDeliveriesRequest proto.InternalMessageInfo
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) GetDeliveryIDs() []string {
+	if m != nil {
+		return m.DeliveryIDs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
 	golang_proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
@@ -1043,6 +1373,8 @@ func init() {
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.HeadersEntry")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.TemplateFieldsEntry")
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.TemplateFieldsEntry")
+	proto.RegisterType((*ApplicationWebhook_ClientCertificate)(nil), "ttn.lorawan.v3.ApplicationWebhook.ClientCertificate")
+	golang_proto.RegisterType((*ApplicationWebhook_ClientCertificate)(nil), "ttn.lorawan.v3.ApplicationWebhook.ClientCertificate")
 	proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
 	golang_proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
 	proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
@@ -1061,6 +1393,14 @@ func init() {
 	golang_proto.RegisterType((*GetApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookTemplateRequest")
 	proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
 	golang_proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
+	proto.RegisterType((*ApplicationWebhookDelivery)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery")
+	golang_proto.RegisterType((*ApplicationWebhookDelivery)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery")
+	proto.RegisterType((*ApplicationWebhookDeliveries)(nil), "ttn.lorawan.v3.ApplicationWebhookDeliveries")
+	golang_proto.RegisterType((*ApplicationWebhookDeliveries)(nil), "ttn.lorawan.v3.ApplicationWebhookDeliveries")
+	proto.RegisterType((*ListApplicationWebhookFailedDeliveriesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookFailedDeliveriesRequest")
+	golang_proto.RegisterType((*ListApplicationWebhookFailedDeliveriesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookFailedDeliveriesRequest")
+	proto.RegisterType((*ReplayApplicationWebhookFailedDeliveriesRequest)(nil), "ttn.lorawan.v3.ReplayApplicationWebhookFailedDeliveriesRequest")
+	golang_proto.RegisterType((*ReplayApplicationWebhookFailedDeliveriesRequest)(nil), "ttn.lorawan.v3.ReplayApplicationWebhookFailedDeliveriesRequest")
 }
 
 func init() {
@@ -1071,120 +1411,149 @@ func init() {
 }
 
 var fileDescriptor_2652f2d8eaceda0e = []byte{
-	// 1797 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0xdc, 0xc6,
-	0x15, 0xde, 0xd1, 0xdf, 0x6a, 0xdf, 0x4a, 0xb2, 0x3c, 0x52, 0x12, 0x76, 0x6d, 0x73, 0x05, 0xc6,
-	0x4d, 0x64, 0xd7, 0xcb, 0x2d, 0x94, 0xb8, 0x6d, 0x84, 0x34, 0x86, 0x36, 0x8a, 0x15, 0xd5, 0x76,
-	0x1c, 0x73, 0xe3, 0x04, 0x89, 0x91, 0x2c, 0xa8, 0xe5, 0xec, 0x8a, 0x5d, 0x2e, 0xc9, 0x90, 0xb3,
-	0x52, 0xd5, 0xc0, 0x68, 0xd0, 0x93, 0xd1, 0x4b, 0x83, 0xe6, 0xd0, 0x9e, 0x8a, 0xa0, 0xbd, 0xa4,
-	0xa7, 0x06, 0x3d, 0xe5, 0x18, 0x14, 0x3d, 0xf8, 0x68, 0xa0, 0x87, 0x06, 0x3d, 0xa8, 0x11, 0xb7,
-	0x87, 0x9c, 0x8a, 0x00, 0xbd, 0x18, 0x3a, 0x15, 0x1c, 0x0e, 0xb9, 0xdc, 0x1f, 0x45, 0xdc, 0x95,
-	0xdd, 0x93, 0x38, 0x9c, 0xf7, 0xbe, 0xf7, 0xbd, 0x37, 0x33, 0xef, 0x9b, 0xa5, 0xa0, 0x60, 0x58,
-	0x8e, 0xba, 0xab, 0x9a, 0x05, 0x97, 0xaa, 0xd5, 0x46, 0x51, 0xb5, 0xf5, 0xa2, 0x6a, 0xdb, 0x86,
-	0x5e, 0x55, 0xa9, 0x6e, 0x99, 0x2e, 0x71, 0x76, 0x88, 0x53, 0xd9, 0x25, 0x5b, 0xb2, 0xed, 0x58,
-	0xd4, 0xc2, 0x73, 0x94, 0x9a, 0x32, 0x77, 0x91, 0x77, 0x9e, 0xcb, 0xad, 0xd5, 0x75, 0xba, 0xdd,
-	0xda, 0x92, 0xab, 0x56, 0xb3, 0x48, 0xcc, 0x1d, 0x6b, 0xcf, 0x76, 0xac, 0x9f, 0xed, 0x15, 0x99,
-	0x71, 0xb5, 0x50, 0x27, 0x66, 0x61, 0x47, 0x35, 0x74, 0x4d, 0xa5, 0xa4, 0xd8, 0xf7, 0x10, 0x40,
-	0xe6, 0x0a, 0x31, 0x88, 0xba, 0x55, 0xb7, 0x02, 0xe7, 0xad, 0x56, 0x8d, 0x8d, 0xd8, 0x80, 0x3d,
-	0x71, 0xf3, 0xb3, 0x75, 0xcb, 0xaa, 0x1b, 0x24, 0x60, 0x6a, 0x9a, 0x16, 0x0d, 0x88, 0xf2, 0xd9,
-	0x33, 0x7c, 0x36, 0xc2, 0x20, 0x4d, 0x9b, 0xee, 0xf1, 0xc9, 0xa5, 0xde, 0xc9, 0x9a, 0x4e, 0x0c,
-	0xad, 0xd2, 0x54, 0xdd, 0x06, 0xb7, 0xc8, 0xf7, 0x5a, 0x50, 0xbd, 0x49, 0x5c, 0xaa, 0x36, 0x6d,
-	0x6e, 0xf0, 0x74, 0x7f, 0xb9, 0x74, 0x8d, 0x98, 0x54, 0xaf, 0xe9, 0xc4, 0xe1, 0x24, 0xa4, 0x7f,
-	0x20, 0x38, 0xb7, 0xd6, 0x29, 0xe2, 0x5b, 0x64, 0x6b, 0xdb, 0xb2, 0x1a, 0x9b, 0x1d, 0x3b, 0xac,
-	0xc2, 0xa9, 0x58, 0x95, 0x2b, 0xba, 0xe6, 0x0a, 0x68, 0x09, 0x2d, 0x67, 0x57, 0x9e, 0x91, 0xbb,
-	0x0b, 0x2c, 0xc7, 0x70, 0x62, 0x00, 0xa5, 0xf9, 0xc3, 0xd2, 0xe4, 0xaf, 0xd0, 0xd8, 0x3c, 0xba,
-	0xbf, 0x9f, 0x4f, 0x3d, 0xd8, 0xcf, 0x23, 0x65, 0x4e, 0x8d, 0x5b, 0xba, 0xb8, 0x0c, 0xb0, 0x1b,
-	0x04, 0xae, 0xe8, 0x9a, 0x30, 0xb6, 0x84, 0x96, 0x33, 0xa5, 0xe7, 0x0f, 0x4b, 0xe7, 0x1d, 0x49,
-	0x38, 0xbf, 0x22, 0xbe, 0x77, 0x47, 0x2d, 0xfc, 0xfc, 0xfb, 0x85, 0x17, 0xde, 0x5d, 0xbe, 0xb2,
-	0x7a, 0xa7, 0xf0, 0xee, 0x95, 0x70, 0x78, 0xe1, 0x83, 0x95, 0x4b, 0x77, 0xcf, 0x7b, 0xfb, 0xf9,
-	0x4c, 0xc8, 0x7a, 0x5d, 0xc9, 0xec, 0x86, 0x09, 0x48, 0xbf, 0x80, 0xef, 0xf6, 0x27, 0xf6, 0x06,
-	0x69, 0xda, 0x86, 0x4a, 0x49, 0x3c, 0xc1, 0x37, 0x21, 0x4b, 0xf9, 0x6b, 0x3f, 0x3c, 0x62, 0xe1,
-	0x2f, 0x27, 0x0f, 0x0f, 0x11, 0xe8, 0xba, 0x02, 0x34, 0x0a, 0x20, 0xfd, 0x07, 0x41, 0xfe, 0x68,
-	0x06, 0x57, 0xfd, 0xf5, 0xc4, 0x3f, 0x86, 0xb1, 0x28, 0x64, 0x21, 0x79, 0xc8, 0xb1, 0xcd, 0x75,
-	0x65, 0x4c, 0xd7, 0xf0, 0x19, 0x98, 0x30, 0xd5, 0x26, 0xe1, 0x25, 0x4b, 0x1f, 0x96, 0x26, 0x9c,
-	0x31, 0x61, 0x51, 0x61, 0x2f, 0xf1, 0x05, 0xc8, 0x6a, 0xc4, 0xad, 0x3a, 0xba, 0xed, 0x87, 0x17,
-	0xc6, 0xe3, 0x36, 0x9a, 0x12, 0x9f, 0xc3, 0x4f, 0xc2, 0x94, 0x4b, 0xaa, 0x0e, 0xa1, 0xc2, 0xc4,
-	0x12, 0x5a, 0x9e, 0x56, 0xf8, 0x08, 0x5f, 0x82, 0x59, 0x8d, 0xd4, 0xd4, 0x96, 0x41, 0x2b, 0x3b,
-	0xaa, 0xd1, 0x22, 0xc2, 0x64, 0x37, 0xc8, 0x0c, 0x9f, 0x7d, 0xd3, 0x9f, 0x94, 0xfe, 0x9b, 0x85,
-	0xdc, 0xd1, 0x09, 0xe3, 0xb7, 0x61, 0xbc, 0xb3, 0x79, 0x2e, 0x7f, 0xcb, 0xe6, 0x39, 0x7a, 0xad,
-	0x06, 0xec, 0x25, 0x1f, 0xf3, 0x91, 0xd5, 0x41, 0x86, 0x69, 0xc3, 0xaa, 0x5b, 0x95, 0x96, 0x63,
-	0xb0, 0x4a, 0x64, 0x4a, 0x0b, 0x87, 0xa5, 0x49, 0x67, 0xfc, 0x1e, 0x42, 0xde, 0x7e, 0x3e, 0x7d,
-	0xdd, 0xaa, 0x5b, 0xb7, 0x95, 0xeb, 0x4a, 0xda, 0x37, 0xba, 0xed, 0x18, 0xbe, 0xbd, 0x6e, 0xd6,
-	0x02, 0xfb, 0xc9, 0x7e, 0xfb, 0x4d, 0xb3, 0x16, 0xd8, 0xfb, 0x46, 0xbe, 0xfd, 0x26, 0x9c, 0xd6,
-	0xac, 0x6a, 0xab, 0x49, 0xcc, 0xa0, 0x15, 0x30, 0xc7, 0x29, 0xe6, 0x78, 0x36, 0xe6, 0x38, 0xbf,
-	0x1e, 0x37, 0xf2, 0x11, 0xe6, 0xbb, 0xdc, 0x78, 0xe8, 0x2d, 0xd5, 0x25, 0x0c, 0x21, 0xdd, 0x1f,
-	0xba, 0xa4, 0xba, 0x84, 0x85, 0xf6, 0x8d, 0x7c, 0xfb, 0x5b, 0x90, 0xde, 0x26, 0xaa, 0x46, 0x1c,
-	0x57, 0x98, 0x5e, 0x1a, 0x5f, 0xce, 0xae, 0xfc, 0x30, 0xf9, 0x0a, 0xc8, 0xaf, 0x06, 0x9e, 0xaf,
-	0x98, 0xd4, 0xd9, 0x53, 0x42, 0x1c, 0x7c, 0x05, 0xa6, 0x6a, 0x96, 0xd3, 0x54, 0xa9, 0x90, 0x61,
-	0x04, 0x9e, 0x0d, 0x36, 0xf0, 0xe2, 0x71, 0x1b, 0x58, 0xe1, 0x6e, 0x78, 0x03, 0xa6, 0x58, 0x5b,
-	0x73, 0x05, 0x60, 0x94, 0x8a, 0xc9, 0x29, 0xb1, 0xe3, 0xa3, 0x70, 0x77, 0x7c, 0x13, 0x9e, 0xaa,
-	0x3a, 0xc4, 0x3f, 0xc0, 0x9a, 0xb5, 0x6b, 0x1a, 0xba, 0xd9, 0xa8, 0xa8, 0xb6, 0x5e, 0x69, 0x90,
-	0x3d, 0x61, 0xc1, 0xdf, 0xd0, 0x25, 0xc1, 0xdb, 0xcf, 0x2f, 0xbe, 0xcc, 0x4c, 0xd6, 0xb9, 0xc5,
-	0xda, 0xeb, 0x9b, 0xd7, 0xc8, 0x9e, 0xb2, 0x58, 0xed, 0x7e, 0x6b, 0xeb, 0xd7, 0xc8, 0x1e, 0x7e,
-	0x1b, 0xe6, 0x5a, 0x36, 0xc3, 0x69, 0x12, 0xd7, 0x55, 0xeb, 0x44, 0xc8, 0xb2, 0x6d, 0xbb, 0x32,
-	0x44, 0xd1, 0x6e, 0x04, 0x9e, 0xca, 0x6c, 0x80, 0xc4, 0x87, 0xb8, 0x0c, 0xd9, 0x9f, 0x5a, 0xba,
-	0x59, 0x51, 0xab, 0x55, 0x62, 0x53, 0x61, 0x66, 0x64, 0x5c, 0xf0, 0x61, 0xd6, 0x18, 0x0a, 0xbe,
-	0x0d, 0x33, 0x9d, 0xcc, 0xab, 0x0d, 0x61, 0x76, 0x64, 0xd4, 0x6c, 0x88, 0xb3, 0x56, 0x6d, 0xe0,
-	0xb7, 0x60, 0x36, 0x82, 0x35, 0x7d, 0xdc, 0xb9, 0x91, 0x71, 0x23, 0x7e, 0xaf, 0xa9, 0x3d, 0xc0,
-	0x2e, 0x31, 0xa9, 0x70, 0xea, 0xe4, 0xc0, 0x65, 0x62, 0x52, 0x7c, 0x07, 0x4e, 0x45, 0xc0, 0x35,
-	0x55, 0x37, 0x88, 0x26, 0xcc, 0x8f, 0x0c, 0x3d, 0x17, 0x42, 0x5d, 0x65, 0x48, 0x5d, 0xe0, 0xef,
-	0xb7, 0x48, 0x8b, 0x68, 0xc2, 0xe9, 0x93, 0x83, 0xdf, 0x62, 0x48, 0x3e, 0xb8, 0x61, 0x71, 0x91,
-	0x75, 0x2d, 0x63, 0x87, 0x68, 0x02, 0x1e, 0x1d, 0x3c, 0x84, 0x2a, 0x33, 0xa4, 0xdc, 0x2a, 0xcc,
-	0xc4, 0xcf, 0x30, 0x9e, 0x87, 0x71, 0xff, 0x70, 0x30, 0xe1, 0x51, 0xfc, 0x47, 0xbc, 0x08, 0x93,
-	0x41, 0x8b, 0x67, 0x3d, 0x54, 0x09, 0x06, 0xab, 0x63, 0x3f, 0x42, 0xb9, 0x73, 0x90, 0x0e, 0xf7,
-	0x2e, 0x86, 0x09, 0x5b, 0xa5, 0xdb, 0xdc, 0x8f, 0x3d, 0x4b, 0x75, 0x38, 0x73, 0x34, 0x21, 0x17,
-	0xbf, 0x0a, 0x99, 0x50, 0x13, 0xfd, 0xde, 0xef, 0x1f, 0xf3, 0x8b, 0xc9, 0x13, 0x52, 0x3a, 0xce,
-	0xd2, 0x3f, 0xb3, 0x80, 0xfb, 0x2d, 0xf1, 0xad, 0xb8, 0xac, 0x14, 0x8e, 0x87, 0x4e, 0x20, 0x27,
-	0x2f, 0x03, 0x04, 0x5d, 0x41, 0xab, 0xa8, 0x94, 0x15, 0x24, 0xbb, 0x92, 0x93, 0x83, 0xfb, 0x96,
-	0x1c, 0xde, 0xb7, 0xe4, 0x37, 0xc2, 0xfb, 0x56, 0x69, 0xda, 0x77, 0xff, 0xe8, 0x5f, 0x79, 0xa4,
-	0x64, 0xb8, 0xdf, 0x1a, 0xf5, 0x41, 0x5a, 0xb6, 0x16, 0x82, 0x8c, 0x0f, 0x03, 0xc2, 0xfd, 0xd6,
-	0x68, 0x57, 0x97, 0x9f, 0x48, 0xd0, 0xe5, 0x37, 0x3b, 0x5d, 0x7e, 0x32, 0x69, 0x4b, 0x3d, 0xb6,
-	0xbb, 0x4f, 0x8d, 0xd6, 0xdd, 0xdf, 0x83, 0x99, 0xd8, 0xbd, 0xca, 0xe5, 0x47, 0x7c, 0x44, 0xe1,
-	0x9f, 0x60, 0xab, 0x93, 0xed, 0x5c, 0xaf, 0x5c, 0x5c, 0x81, 0x53, 0x11, 0x3e, 0x97, 0x91, 0x79,
-	0x96, 0xf3, 0x0f, 0x12, 0xe4, 0xdc, 0xa5, 0x23, 0x3c, 0xf5, 0x39, 0xda, 0xf5, 0x12, 0xbf, 0x08,
-	0xf3, 0x7d, 0x72, 0x72, 0x9a, 0xd5, 0x02, 0x7b, 0xfb, 0xf9, 0xb9, 0x1e, 0x21, 0x89, 0xce, 0x33,
-	0x97, 0x90, 0x5b, 0x7d, 0x12, 0x92, 0x66, 0x05, 0x48, 0xb0, 0xfb, 0x8f, 0x92, 0x8e, 0x6b, 0xdd,
-	0xd2, 0x31, 0x3d, 0x34, 0x5e, 0x5c, 0x32, 0x6e, 0xf4, 0x48, 0x46, 0x66, 0x68, 0xb4, 0x2e, 0xa9,
-	0xb8, 0xd9, 0x2b, 0x15, 0x30, 0x34, 0x5e, 0xb7, 0x44, 0xdc, 0xec, 0x95, 0x88, 0xec, 0xe8, 0x80,
-	0x4c, 0x1a, 0xca, 0xfd, 0xd2, 0x30, 0x33, 0x34, 0x64, 0xaf, 0x24, 0x94, 0xfb, 0x25, 0x61, 0x76,
-	0x74, 0x50, 0x2e, 0x05, 0xe5, 0x7e, 0x29, 0x98, 0x1b, 0x1e, 0xf4, 0x11, 0x4a, 0xc0, 0x1a, 0x2c,
-	0x0c, 0x38, 0x30, 0x8f, 0x52, 0x45, 0x6e, 0xc3, 0x42, 0x7f, 0x2e, 0x2e, 0x7e, 0x09, 0xa6, 0xf9,
-	0x2f, 0xba, 0x50, 0x3c, 0xa4, 0xe3, 0x4b, 0xa0, 0x44, 0x3e, 0xd2, 0x9f, 0x10, 0x7c, 0xa7, 0xdf,
-	0xe0, 0x2a, 0x6b, 0x50, 0x2e, 0x7e, 0x1d, 0xd2, 0x41, 0xaf, 0x0a, 0xc1, 0x13, 0x74, 0x0e, 0xee,
-	0x2b, 0xf3, 0xbf, 0xbc, 0x69, 0x72, 0x18, 0xbf, 0xc8, 0xf1, 0x89, 0x61, 0x2a, 0x24, 0xfd, 0x05,
-	0xc1, 0xd9, 0x0d, 0x42, 0x07, 0xe4, 0x43, 0xde, 0x6f, 0x11, 0x97, 0x3e, 0x0e, 0xa5, 0xbb, 0x02,
-	0xd0, 0xf9, 0xb0, 0x70, 0xa4, 0xd2, 0xb1, 0x35, 0xbf, 0xa1, 0xba, 0x8d, 0xd2, 0x84, 0xef, 0xae,
-	0x64, 0x6a, 0xe1, 0x0b, 0xe9, 0x6f, 0x08, 0xc4, 0xeb, 0xba, 0x3b, 0x80, 0xb5, 0x1b, 0xd2, 0xfe,
-	0x3f, 0x7c, 0x40, 0x38, 0x71, 0x1a, 0x7f, 0x46, 0x70, 0xb6, 0xfc, 0x6d, 0xb5, 0x7f, 0x0d, 0xd2,
-	0x7c, 0x53, 0x71, 0xf2, 0x09, 0xf6, 0xe1, 0x00, 0xe2, 0x21, 0xc8, 0xc9, 0x19, 0xff, 0x15, 0xc1,
-	0xf9, 0x81, 0xbb, 0x25, 0xba, 0x3a, 0x71, 0xe6, 0x8f, 0xf1, 0x67, 0xf7, 0x89, 0x93, 0xd0, 0xe1,
-	0x99, 0xc1, 0x9b, 0x27, 0xba, 0x3f, 0x86, 0x59, 0x74, 0x87, 0x42, 0x43, 0x87, 0x5a, 0xf9, 0x75,
-	0x66, 0xd0, 0xc7, 0x09, 0x85, 0xd4, 0x75, 0xd7, 0x3f, 0xa8, 0x06, 0xc0, 0x06, 0xa1, 0x61, 0x63,
-	0x78, 0xb2, 0x0f, 0xf9, 0x95, 0xa6, 0x4d, 0xf7, 0x72, 0x17, 0x12, 0xf7, 0x07, 0xe9, 0xcc, 0x2f,
-	0xff, 0xfe, 0xef, 0x8f, 0xc7, 0x9e, 0xc0, 0x0b, 0x45, 0xd5, 0x2d, 0xf2, 0x55, 0x2f, 0xf0, 0x36,
-	0x81, 0x3f, 0x41, 0x90, 0xdd, 0x20, 0x34, 0xfa, 0x34, 0xf2, 0x7c, 0x2f, 0x6e, 0x92, 0x95, 0xcd,
-	0x0d, 0x71, 0x8f, 0x96, 0x8a, 0x8c, 0xce, 0x05, 0xfc, 0x6c, 0x9c, 0x4e, 0x74, 0xb7, 0x2e, 0x7e,
-	0xa0, 0x6b, 0xae, 0x1c, 0xbb, 0xad, 0xdd, 0xc5, 0x1f, 0x23, 0x98, 0xf5, 0xd7, 0xa6, 0x73, 0x93,
-	0xef, 0x6b, 0x8e, 0xc9, 0x96, 0x2e, 0xf7, 0xbd, 0xe4, 0x34, 0x5d, 0xe9, 0x1c, 0xe3, 0xf9, 0x14,
-	0x7e, 0x62, 0x20, 0x4f, 0xfc, 0x47, 0x04, 0xe3, 0x1b, 0x84, 0xe2, 0x4b, 0x89, 0x0a, 0x16, 0x32,
-	0x48, 0x70, 0x56, 0xa5, 0x9f, 0xb0, 0xc0, 0xeb, 0xb8, 0x14, 0x0b, 0xcc, 0xeb, 0xd2, 0xd3, 0xbd,
-	0x7a, 0xc6, 0x77, 0x03, 0xa3, 0xce, 0x07, 0xcc, 0xbb, 0xf8, 0x37, 0x08, 0x26, 0xfc, 0xe2, 0x60,
-	0x39, 0x59, 0xc9, 0xa2, 0x52, 0x3d, 0x7d, 0x3c, 0x51, 0x57, 0xba, 0xcc, 0x98, 0x16, 0x71, 0xa1,
-	0x9b, 0xe9, 0x31, 0x2c, 0xf1, 0x43, 0x04, 0xe3, 0xe5, 0x41, 0xa5, 0x2b, 0x9f, 0xb4, 0x74, 0xbf,
-	0x47, 0x8c, 0xd1, 0x6f, 0x51, 0x4e, 0xe9, 0xa6, 0xc4, 0x9f, 0xe4, 0x44, 0x45, 0x8c, 0x1b, 0xc7,
-	0x8a, 0xb9, 0x8a, 0x2e, 0xbe, 0xf3, 0x92, 0xf4, 0xc2, 0xc8, 0xc0, 0xab, 0xe8, 0xa2, 0xbf, 0x97,
-	0xa7, 0xd6, 0x89, 0x41, 0x28, 0xc1, 0xc3, 0xc9, 0x66, 0xee, 0x88, 0x46, 0x20, 0x95, 0x58, 0xc6,
-	0x2f, 0x5e, 0x5c, 0x1d, 0x6a, 0x0d, 0x22, 0xe2, 0xfe, 0xa0, 0xf4, 0x07, 0x74, 0xff, 0x40, 0x44,
-	0x0f, 0x0e, 0x44, 0xf4, 0xe5, 0x81, 0x98, 0xfa, 0xea, 0x40, 0x4c, 0x7d, 0x7d, 0x20, 0xa6, 0xbe,
-	0x39, 0x10, 0x53, 0x0f, 0x0f, 0x44, 0xf4, 0xa1, 0x27, 0xa2, 0x7b, 0x9e, 0x98, 0xfa, 0xd4, 0x13,
-	0xd1, 0x67, 0x9e, 0x98, 0xfa, 0xdc, 0x13, 0x53, 0x5f, 0x78, 0x62, 0xea, 0xbe, 0x27, 0xa2, 0x07,
-	0x9e, 0x88, 0xbe, 0xf4, 0xc4, 0xd4, 0x57, 0x9e, 0x88, 0xbe, 0xf6, 0xc4, 0xd4, 0x37, 0x9e, 0x88,
-	0x1e, 0x7a, 0x62, 0xea, 0xc3, 0xb6, 0x98, 0xba, 0xd7, 0x16, 0xd1, 0x47, 0x6d, 0x31, 0xf5, 0xbb,
-	0xb6, 0x88, 0x3e, 0x69, 0x8b, 0xa9, 0x4f, 0xdb, 0x62, 0xea, 0xb3, 0xb6, 0x88, 0x3e, 0x6f, 0x8b,
-	0xe8, 0x8b, 0xb6, 0x88, 0xde, 0xb9, 0x54, 0xb7, 0x64, 0xba, 0x4d, 0xe8, 0xb6, 0x6e, 0xd6, 0x5d,
-	0xd9, 0x24, 0x74, 0xd7, 0x72, 0x1a, 0xc5, 0xee, 0xff, 0x15, 0xd8, 0x8d, 0x7a, 0x91, 0x52, 0xd3,
-	0xde, 0xda, 0x9a, 0x62, 0x89, 0x3f, 0xf7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x62, 0xbf,
-	0x9a, 0x7c, 0x19, 0x00, 0x00,
+	// 2259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xe6, 0x90, 0x94, 0x44, 0x0e, 0x29, 0x4a, 0x1a, 0x2b, 0xce, 0x96, 0x92, 0x57, 0xc2, 0xda,
+	0x4d, 0x64, 0xc5, 0x24, 0x5b, 0x25, 0x4e, 0x1a, 0x35, 0xb1, 0x21, 0x5a, 0xb6, 0xa3, 0xd8, 0x8e,
+	0xe3, 0xa5, 0xed, 0x20, 0x71, 0x13, 0x76, 0xc5, 0x1d, 0x51, 0x1b, 0x2e, 0x77, 0x37, 0xbb, 0x43,
+	0xa9, 0x4a, 0x60, 0xd4, 0xe8, 0xc9, 0xe8, 0x29, 0x48, 0x0e, 0xed, 0xa9, 0x08, 0xd2, 0x8b, 0x7b,
+	0x6a, 0x50, 0xa0, 0x40, 0x8e, 0x41, 0xd1, 0x83, 0x0f, 0x05, 0x6a, 0xa0, 0x87, 0xa6, 0x17, 0x35,
+	0xa2, 0x7a, 0xf0, 0xa9, 0x08, 0xd0, 0x8b, 0xa1, 0x53, 0x31, 0xb3, 0xb3, 0xe4, 0x92, 0x4b, 0x59,
+	0x4b, 0x2a, 0x6e, 0x4f, 0xdc, 0x9d, 0x79, 0xef, 0x7b, 0x3f, 0xf3, 0xe6, 0x7d, 0xb3, 0x43, 0x98,
+	0xd3, 0x4d, 0x5b, 0xd9, 0x54, 0x8c, 0x9c, 0x43, 0x94, 0x4a, 0xad, 0xa0, 0x58, 0x5a, 0x41, 0xb1,
+	0x2c, 0x5d, 0xab, 0x28, 0x44, 0x33, 0x0d, 0x07, 0xdb, 0x1b, 0xd8, 0x2e, 0x6f, 0xe2, 0xd5, 0xbc,
+	0x65, 0x9b, 0xc4, 0x44, 0x19, 0x42, 0x8c, 0x3c, 0x57, 0xc9, 0x6f, 0x3c, 0x9f, 0x5d, 0xaa, 0x6a,
+	0x64, 0xbd, 0xb1, 0x9a, 0xaf, 0x98, 0xf5, 0x02, 0x36, 0x36, 0xcc, 0x2d, 0xcb, 0x36, 0x7f, 0xb6,
+	0x55, 0x60, 0xc2, 0x95, 0x5c, 0x15, 0x1b, 0xb9, 0x0d, 0x45, 0xd7, 0x54, 0x85, 0xe0, 0x42, 0xe0,
+	0xc1, 0x85, 0xcc, 0xe6, 0x7c, 0x10, 0x55, 0xb3, 0x6a, 0xba, 0xca, 0xab, 0x8d, 0x35, 0xf6, 0xc6,
+	0x5e, 0xd8, 0x13, 0x17, 0x9f, 0xae, 0x9a, 0x66, 0x55, 0xc7, 0xae, 0xa7, 0x86, 0x61, 0x12, 0xd7,
+	0x51, 0x3e, 0x3b, 0xc5, 0x67, 0x5b, 0x18, 0xb8, 0x6e, 0x91, 0x2d, 0x3e, 0x39, 0xdb, 0x3d, 0xb9,
+	0xa6, 0x61, 0x5d, 0x2d, 0xd7, 0x15, 0xa7, 0xc6, 0x25, 0x66, 0xba, 0x25, 0x88, 0x56, 0xc7, 0x0e,
+	0x51, 0xea, 0x16, 0x17, 0x38, 0x16, 0x4c, 0x17, 0xb6, 0x6d, 0xd3, 0xe6, 0xd3, 0xc7, 0x83, 0xd3,
+	0x9a, 0x8a, 0x0d, 0xa2, 0xad, 0x69, 0xd8, 0xf6, 0x7c, 0x9c, 0x0d, 0x0a, 0xd5, 0xb1, 0xe3, 0x28,
+	0x55, 0xcc, 0x25, 0xa4, 0xbf, 0x03, 0x78, 0x6c, 0xa9, 0xbd, 0x0a, 0x6f, 0xe1, 0xd5, 0x75, 0xd3,
+	0xac, 0xad, 0xb4, 0x91, 0x90, 0x02, 0xc7, 0x7c, 0xcb, 0x54, 0xd6, 0x54, 0x47, 0x00, 0xb3, 0x60,
+	0x2e, 0xb5, 0xf0, 0x4c, 0xbe, 0x73, 0x85, 0xf2, 0x3e, 0x1c, 0x1f, 0x40, 0x71, 0x7c, 0xaf, 0x38,
+	0xf4, 0x4b, 0x10, 0x1d, 0x07, 0xf7, 0xb7, 0x67, 0x22, 0x0f, 0xb6, 0x67, 0x80, 0x9c, 0x51, 0xfc,
+	0x92, 0x0e, 0x2a, 0x41, 0xb8, 0xe9, 0x1a, 0x2e, 0x6b, 0xaa, 0x10, 0x9d, 0x05, 0x73, 0xc9, 0xe2,
+	0x0b, 0x7b, 0xc5, 0x13, 0xb6, 0x24, 0x9c, 0x58, 0x10, 0xdf, 0xbb, 0xa5, 0xe4, 0x3e, 0xfc, 0x41,
+	0xee, 0xe5, 0x77, 0xe7, 0xce, 0x2e, 0xde, 0xca, 0xbd, 0x7b, 0xd6, 0x7b, 0x3d, 0xf9, 0xd1, 0xc2,
+	0xa9, 0xdb, 0x27, 0x9a, 0xdb, 0x33, 0x49, 0xcf, 0xeb, 0x65, 0x39, 0xb9, 0xe9, 0x05, 0x20, 0xfd,
+	0x1c, 0x7e, 0x3f, 0x18, 0xd8, 0x75, 0x5c, 0xb7, 0x74, 0x85, 0x60, 0x7f, 0x80, 0x37, 0x61, 0x8a,
+	0xf0, 0x61, 0x6a, 0x1e, 0x30, 0xf3, 0xa7, 0xc3, 0x9b, 0x87, 0x2d, 0xd0, 0x65, 0x19, 0x92, 0x96,
+	0x01, 0xe9, 0xdf, 0x00, 0xce, 0xec, 0xef, 0xc1, 0x05, 0x5a, 0x10, 0xe8, 0x55, 0x18, 0x6d, 0x99,
+	0xcc, 0x85, 0x37, 0x19, 0x5d, 0x59, 0x96, 0xa3, 0x9a, 0x8a, 0xa6, 0x60, 0xdc, 0x50, 0xea, 0x98,
+	0xa7, 0x6c, 0x64, 0xaf, 0x18, 0xb7, 0xa3, 0xc2, 0xa4, 0xcc, 0x06, 0xd1, 0x49, 0x98, 0x52, 0xb1,
+	0x53, 0xb1, 0x35, 0x8b, 0x9a, 0x17, 0x62, 0x7e, 0x19, 0x55, 0xf6, 0xcf, 0xa1, 0xa3, 0x70, 0xd8,
+	0xc1, 0x15, 0x1b, 0x13, 0x21, 0x3e, 0x0b, 0xe6, 0x12, 0x32, 0x7f, 0x43, 0xa7, 0xe0, 0xa8, 0x8a,
+	0xd7, 0x94, 0x86, 0x4e, 0xca, 0x1b, 0x8a, 0xde, 0xc0, 0xc2, 0x50, 0x27, 0x48, 0x9a, 0xcf, 0xde,
+	0xa4, 0x93, 0xd2, 0x7f, 0x52, 0x30, 0xbb, 0x7f, 0xc0, 0xe8, 0x6d, 0x18, 0x6b, 0x17, 0xcf, 0xe9,
+	0xc7, 0x14, 0xcf, 0xfe, 0x6b, 0xd5, 0xa3, 0x96, 0x28, 0xe6, 0x77, 0x96, 0x87, 0x3c, 0x4c, 0xe8,
+	0x66, 0xd5, 0x2c, 0x37, 0x6c, 0x9d, 0x65, 0x22, 0x59, 0x3c, 0xb2, 0x57, 0x1c, 0xb2, 0x63, 0x77,
+	0x01, 0x68, 0x6e, 0xcf, 0x8c, 0x5c, 0x36, 0xab, 0xe6, 0x0d, 0xf9, 0xb2, 0x3c, 0x42, 0x85, 0x6e,
+	0xd8, 0x3a, 0x95, 0xd7, 0x8c, 0x35, 0x57, 0x7e, 0x28, 0x28, 0xbf, 0x62, 0xac, 0xb9, 0xf2, 0x54,
+	0x88, 0xca, 0xaf, 0xc0, 0x09, 0xd5, 0xac, 0x34, 0xea, 0xd8, 0x70, 0x7b, 0x09, 0x53, 0x1c, 0x66,
+	0x8a, 0xd3, 0x3e, 0xc5, 0xf1, 0x65, 0xbf, 0x10, 0x45, 0x18, 0xef, 0x50, 0xe3, 0xa6, 0x57, 0x15,
+	0x07, 0x33, 0x84, 0x91, 0xa0, 0xe9, 0xa2, 0xe2, 0x60, 0x66, 0x9a, 0x0a, 0x51, 0xf9, 0x6b, 0x70,
+	0x64, 0x1d, 0x2b, 0x2a, 0xb6, 0x1d, 0x21, 0x31, 0x1b, 0x9b, 0x4b, 0x2d, 0xbc, 0x14, 0x7e, 0x05,
+	0xf2, 0xaf, 0xb9, 0x9a, 0xe7, 0x0d, 0x62, 0x6f, 0xc9, 0x1e, 0x0e, 0x3a, 0x0b, 0x87, 0xd7, 0x4c,
+	0xbb, 0xae, 0x10, 0x21, 0xc9, 0x1c, 0x78, 0xd6, 0x2d, 0xe0, 0xc9, 0x83, 0x0a, 0x58, 0xe6, 0x6a,
+	0xe8, 0x22, 0x1c, 0x66, 0x7d, 0xd1, 0x11, 0x20, 0x73, 0xa9, 0x10, 0xde, 0x25, 0xb6, 0x7d, 0x64,
+	0xae, 0x8e, 0xae, 0xc2, 0xa7, 0x2b, 0x36, 0xa6, 0x1b, 0x58, 0x35, 0x37, 0x0d, 0x5d, 0x33, 0x6a,
+	0x65, 0xc5, 0xd2, 0xca, 0x35, 0xbc, 0x25, 0x1c, 0xa1, 0x05, 0x5d, 0x14, 0x9a, 0xdb, 0x33, 0x93,
+	0xe7, 0x98, 0xc8, 0x32, 0x97, 0x58, 0x7a, 0x73, 0xe5, 0x12, 0xde, 0x92, 0x27, 0x2b, 0x9d, 0xa3,
+	0x96, 0x76, 0x09, 0x6f, 0xa1, 0xb7, 0x61, 0xa6, 0x61, 0x31, 0x1c, 0xde, 0x2f, 0x85, 0x14, 0x2b,
+	0xdb, 0x85, 0x3e, 0x92, 0x76, 0xc5, 0xd5, 0x94, 0x47, 0x5d, 0x24, 0xfe, 0x8a, 0x4a, 0x30, 0xf5,
+	0xbe, 0xa9, 0x19, 0x65, 0xa5, 0x52, 0xc1, 0x16, 0x11, 0xd2, 0x03, 0xe3, 0x42, 0x0a, 0xb3, 0xc4,
+	0x50, 0xd0, 0x0d, 0x98, 0x6e, 0x47, 0x5e, 0xa9, 0x09, 0xa3, 0x03, 0xa3, 0xa6, 0x3c, 0x9c, 0xa5,
+	0x4a, 0x0d, 0xbd, 0x05, 0x47, 0x5b, 0xb0, 0x06, 0xc5, 0xcd, 0x0c, 0x8c, 0xdb, 0xf2, 0xef, 0x0d,
+	0xa5, 0x0b, 0xd8, 0xc1, 0x06, 0x11, 0xc6, 0x0e, 0x0f, 0x5c, 0xc2, 0x06, 0x41, 0xb7, 0xe0, 0x58,
+	0x0b, 0x78, 0x4d, 0xd1, 0x74, 0xac, 0x0a, 0xe3, 0x03, 0x43, 0x67, 0x3c, 0xa8, 0x0b, 0x0c, 0xa9,
+	0x03, 0xfc, 0x83, 0x06, 0x6e, 0x60, 0x55, 0x98, 0x38, 0x3c, 0xf8, 0x35, 0x86, 0x44, 0xc1, 0x75,
+	0x93, 0x93, 0xac, 0x63, 0xea, 0x1b, 0x58, 0x15, 0xd0, 0xe0, 0xe0, 0x1e, 0x54, 0x89, 0x21, 0x65,
+	0x17, 0x61, 0xda, 0xbf, 0x87, 0xd1, 0x38, 0x8c, 0xd1, 0xcd, 0xc1, 0x88, 0x47, 0xa6, 0x8f, 0x68,
+	0x12, 0x0e, 0xb9, 0x2d, 0x9e, 0xf5, 0x50, 0xd9, 0x7d, 0x59, 0x8c, 0xfe, 0x08, 0x64, 0x8f, 0xc1,
+	0x11, 0xaf, 0x76, 0x11, 0x8c, 0x5b, 0x0a, 0x59, 0xe7, 0x7a, 0xec, 0x59, 0xaa, 0xc2, 0xa9, 0xfd,
+	0x1d, 0x72, 0xd0, 0x6b, 0x30, 0xe9, 0x71, 0x22, 0xed, 0xfd, 0x74, 0x9b, 0xcf, 0x87, 0x0f, 0x48,
+	0x6e, 0x2b, 0x4b, 0xf7, 0x32, 0x10, 0x05, 0x25, 0xd1, 0x35, 0x3f, 0xad, 0xe4, 0x0e, 0x86, 0x0e,
+	0x41, 0x27, 0xe7, 0x20, 0x74, 0xbb, 0x82, 0x5a, 0x56, 0x08, 0x4b, 0x48, 0x6a, 0x21, 0x9b, 0x77,
+	0x0f, 0x6c, 0x79, 0xef, 0xc0, 0x96, 0xbf, 0xee, 0x1d, 0xd8, 0x8a, 0x09, 0xaa, 0xfe, 0xf1, 0x3f,
+	0x67, 0x80, 0x9c, 0xe4, 0x7a, 0x4b, 0x84, 0x82, 0x34, 0x2c, 0xd5, 0x03, 0x89, 0xf5, 0x03, 0xc2,
+	0xf5, 0x96, 0x48, 0x47, 0x97, 0x8f, 0x87, 0xe8, 0xf2, 0x2b, 0xed, 0x2e, 0x3f, 0x14, 0xb6, 0xa5,
+	0x1e, 0xd8, 0xdd, 0x87, 0x07, 0xeb, 0xee, 0xef, 0xc1, 0xb4, 0xef, 0x5c, 0xe5, 0x08, 0x63, 0x87,
+	0x21, 0xfe, 0x38, 0x5b, 0x9d, 0x54, 0xfb, 0x78, 0xe5, 0xa0, 0x32, 0x1c, 0x6b, 0xe1, 0x73, 0x1a,
+	0x19, 0x67, 0x31, 0xbf, 0x18, 0x22, 0xe6, 0x0e, 0x1e, 0xe1, 0xa1, 0x67, 0x48, 0xc7, 0x20, 0x7a,
+	0x05, 0x8e, 0x07, 0xe8, 0x64, 0x82, 0xe5, 0x02, 0x35, 0xb7, 0x67, 0x32, 0x5d, 0x44, 0x92, 0x51,
+	0x3b, 0x29, 0xa4, 0x00, 0x33, 0x8e, 0x56, 0x35, 0x34, 0xa3, 0x5a, 0xe6, 0x67, 0x2b, 0xc4, 0x74,
+	0x13, 0x6c, 0x01, 0x85, 0x3b, 0x40, 0x1e, 0xe5, 0xf3, 0x25, 0x36, 0x8d, 0x2a, 0x10, 0x55, 0x74,
+	0x0d, 0x1b, 0xa4, 0x5c, 0xc1, 0x36, 0x8d, 0xbb, 0xa2, 0x10, 0xcc, 0xf8, 0x2b, 0xb5, 0xf0, 0x42,
+	0x88, 0x90, 0xce, 0x31, 0xe5, 0x73, 0x6d, 0x5d, 0x79, 0xa2, 0xd2, 0x3d, 0x84, 0xae, 0x05, 0x88,
+	0x6d, 0x64, 0x16, 0x84, 0xdb, 0x93, 0xfb, 0x11, 0xda, 0xa5, 0x4e, 0x42, 0x4b, 0xf4, 0x8d, 0xe7,
+	0x27, 0xb2, 0x2b, 0x5d, 0x44, 0x96, 0xec, 0x1b, 0xad, 0x83, 0xc0, 0xae, 0x76, 0x13, 0x18, 0xec,
+	0x1b, 0xaf, 0x93, 0xb8, 0xae, 0x76, 0x13, 0x57, 0x6a, 0x70, 0x40, 0x46, 0x58, 0xa5, 0x20, 0x61,
+	0xa5, 0xfb, 0x86, 0xec, 0x26, 0xaa, 0x52, 0x90, 0xa8, 0x46, 0x07, 0x07, 0xe5, 0x04, 0x55, 0x0a,
+	0x12, 0x54, 0xa6, 0x7f, 0xd0, 0xef, 0x90, 0x98, 0x96, 0xe0, 0x91, 0x1e, 0xdb, 0xb8, 0x2f, 0x88,
+	0x3f, 0x02, 0x38, 0x11, 0xd8, 0x37, 0xe8, 0x39, 0x98, 0xf2, 0x6f, 0x41, 0x8a, 0x94, 0x2e, 0x26,
+	0xf7, 0x8a, 0xc3, 0x1f, 0xc6, 0x85, 0x3b, 0x77, 0x80, 0xec, 0x9f, 0x45, 0x53, 0xae, 0xb9, 0x68,
+	0xb7, 0x10, 0xb3, 0x7c, 0x1c, 0x8e, 0x62, 0xa3, 0x62, 0x6f, 0x59, 0x94, 0x06, 0xa8, 0x18, 0xe5,
+	0x81, 0xb4, 0x9c, 0x6e, 0x0d, 0xd2, 0x4e, 0xf1, 0x43, 0x98, 0xac, 0xe1, 0x5a, 0x59, 0x57, 0x56,
+	0xb1, 0xd7, 0xe5, 0x27, 0x79, 0x93, 0x18, 0x6f, 0x6e, 0xcf, 0x24, 0x2e, 0x9d, 0xbf, 0x74, 0x99,
+	0xce, 0xc9, 0x89, 0x1a, 0xae, 0xb1, 0xa7, 0x83, 0x38, 0xf9, 0x06, 0x3c, 0x12, 0x5c, 0x03, 0x07,
+	0x9d, 0x81, 0x09, 0xfe, 0x7d, 0xec, 0x51, 0xb1, 0x74, 0xf0, 0xd2, 0xc9, 0x2d, 0x1d, 0xe9, 0x77,
+	0x00, 0x7e, 0x2f, 0x28, 0x70, 0x81, 0xb5, 0x7b, 0x07, 0xbd, 0x09, 0x47, 0xdc, 0xce, 0xef, 0x81,
+	0x87, 0xe8, 0xc3, 0x5c, 0x37, 0xcf, 0x7f, 0x39, 0x05, 0x71, 0x18, 0x5a, 0x1c, 0xfe, 0x89, 0x7e,
+	0x56, 0x56, 0xfa, 0x03, 0x80, 0xd3, 0x17, 0x31, 0xe9, 0x11, 0x0f, 0xfe, 0xa0, 0x81, 0x1d, 0xf2,
+	0x24, 0xce, 0x0d, 0x67, 0x21, 0x6c, 0xdf, 0xf3, 0xec, 0x7b, 0x6e, 0x60, 0xb5, 0x7a, 0x45, 0x71,
+	0x6a, 0xc5, 0x38, 0x55, 0x97, 0x93, 0x6b, 0xde, 0x80, 0xf4, 0x67, 0x00, 0xc5, 0xcb, 0x9a, 0xd3,
+	0xc3, 0x6b, 0xc7, 0x73, 0xfb, 0x7f, 0x70, 0x1d, 0x73, 0xe8, 0x30, 0x7e, 0x0f, 0xe0, 0x74, 0xe9,
+	0x71, 0xb9, 0x7f, 0x03, 0x8e, 0xf0, 0xa2, 0xe2, 0xce, 0x87, 0xa8, 0xc3, 0x1e, 0x8e, 0x7b, 0x20,
+	0x87, 0xf7, 0xf8, 0x4f, 0x00, 0x9e, 0xe8, 0x59, 0x2d, 0xad, 0x83, 0x28, 0xf7, 0xfc, 0x09, 0x5e,
+	0x62, 0x1c, 0x3a, 0x08, 0x0d, 0x3e, 0xd3, 0xbb, 0x78, 0x5a, 0xa7, 0x71, 0x2f, 0x8a, 0x4e, 0x53,
+	0xa0, 0x7f, 0x53, 0x7f, 0x89, 0xf5, 0xba, 0xea, 0x59, 0xc6, 0xba, 0xb6, 0x81, 0xed, 0xad, 0x27,
+	0xb1, 0xb7, 0x5e, 0xa4, 0xb7, 0x38, 0x2e, 0x7c, 0xfb, 0x92, 0xf0, 0x29, 0xf7, 0x16, 0x87, 0xdd,
+	0xc2, 0x79, 0xc6, 0xe9, 0x2d, 0x9c, 0x27, 0xb9, 0xa2, 0xa2, 0x97, 0x60, 0xb4, 0x61, 0xf1, 0xe3,
+	0xf7, 0xb1, 0xc7, 0x78, 0x72, 0xc3, 0x2a, 0x26, 0x3c, 0xcb, 0x72, 0xb4, 0x61, 0x75, 0x7d, 0x04,
+	0xc4, 0x07, 0xfb, 0x08, 0xc8, 0xc2, 0x84, 0x42, 0xe8, 0xb1, 0x92, 0x38, 0xec, 0x82, 0x68, 0x54,
+	0x6e, 0xbd, 0xa3, 0xcb, 0x70, 0x42, 0x57, 0x1c, 0x52, 0xe6, 0x03, 0xae, 0x9d, 0xe1, 0x03, 0xed,
+	0xc4, 0x99, 0x8d, 0x31, 0xaa, 0xba, 0xe4, 0x69, 0x2e, 0x11, 0xf4, 0x63, 0x08, 0x19, 0x1a, 0xbb,
+	0x23, 0xe6, 0x87, 0xba, 0xe9, 0xee, 0x78, 0xcf, 0xd3, 0xc9, 0x65, 0x4c, 0x14, 0x4d, 0x77, 0xe4,
+	0x24, 0x95, 0x67, 0x23, 0xd2, 0xfb, 0x70, 0x7a, 0xdf, 0xd5, 0xd4, 0xb0, 0x83, 0x5e, 0x87, 0x5e,
+	0x4a, 0xb5, 0x7e, 0xbe, 0xe2, 0xbc, 0x25, 0x91, 0x7d, 0xda, 0xd2, 0xe7, 0x00, 0xe6, 0x7a, 0x97,
+	0xa9, 0x7b, 0x78, 0x69, 0x9b, 0x7d, 0x82, 0x9d, 0x5a, 0x84, 0x43, 0xba, 0x56, 0xd7, 0xdc, 0x8f,
+	0xbb, 0x51, 0xb6, 0xf2, 0xf3, 0x31, 0xe1, 0xe1, 0x88, 0xec, 0x0e, 0xd3, 0x46, 0x5c, 0x90, 0xb1,
+	0xa5, 0x2b, 0x5b, 0xff, 0x57, 0x37, 0xcf, 0xc0, 0xb4, 0xaf, 0xe8, 0x1d, 0x21, 0x3a, 0x1b, 0x9b,
+	0x4b, 0x16, 0xa7, 0xf6, 0x8a, 0xc9, 0x4f, 0xc0, 0xb0, 0xe4, 0x95, 0x7e, 0xaa, 0x5d, 0xfa, 0x8e,
+	0x9c, 0x6a, 0xd7, 0xbe, 0xb3, 0xf0, 0xd7, 0x74, 0xaf, 0x6d, 0x2a, 0xe3, 0xaa, 0xe6, 0x50, 0x3e,
+	0xd5, 0x21, 0xbc, 0x88, 0x89, 0xc7, 0xdf, 0x47, 0x03, 0x45, 0x77, 0x9e, 0xfe, 0xa3, 0x91, 0x3d,
+	0x19, 0x9a, 0xc6, 0xa5, 0xa9, 0x5f, 0xfc, 0xed, 0x5f, 0x9f, 0x46, 0x9f, 0x42, 0x47, 0x0a, 0x8a,
+	0x53, 0xe0, 0xcd, 0x39, 0xc7, 0xd9, 0x1c, 0x7d, 0x06, 0x60, 0xea, 0x22, 0x26, 0xad, 0xfb, 0xe0,
+	0xc0, 0x37, 0x4d, 0x98, 0x06, 0x9c, 0xed, 0xe3, 0xf2, 0x40, 0x2a, 0x30, 0x77, 0x4e, 0xa2, 0x67,
+	0xfd, 0xee, 0xb4, 0x2e, 0x14, 0x0a, 0x1f, 0x69, 0xaa, 0x93, 0xf7, 0x7d, 0xa2, 0xde, 0x46, 0x9f,
+	0x02, 0x38, 0x4a, 0x6b, 0xb3, 0x7d, 0x7d, 0x11, 0x38, 0xc3, 0x84, 0xeb, 0xb0, 0xd9, 0xe7, 0xc2,
+	0xbb, 0xe9, 0x48, 0xc7, 0x98, 0x9f, 0x4f, 0xa3, 0xa7, 0x7a, 0xfa, 0x89, 0x7e, 0x0b, 0x60, 0xec,
+	0x22, 0xbd, 0x8d, 0x0f, 0x95, 0x30, 0xcf, 0x83, 0x10, 0x94, 0x2a, 0xbd, 0xce, 0x0c, 0x2f, 0xa3,
+	0xa2, 0xcf, 0x30, 0xcf, 0x4b, 0xd7, 0x21, 0xa3, 0xeb, 0xfd, 0xb6, 0x2b, 0xd4, 0xfe, 0xd7, 0xe6,
+	0x36, 0xfa, 0x04, 0xc0, 0x38, 0x4d, 0x0e, 0xca, 0x87, 0x4b, 0x59, 0x2b, 0x55, 0xc7, 0x0f, 0x76,
+	0xd4, 0x91, 0x4e, 0x33, 0x4f, 0x0b, 0x28, 0xd7, 0xe9, 0xe9, 0x01, 0x5e, 0xa2, 0x47, 0x00, 0xc6,
+	0x4a, 0xbd, 0x52, 0x57, 0x3a, 0x6c, 0xea, 0x7e, 0x03, 0x98, 0x47, 0xbf, 0x02, 0x59, 0xb9, 0xd3,
+	0x25, 0xfe, 0x94, 0x0f, 0x95, 0x44, 0xbf, 0xb0, 0x2f, 0x99, 0x8b, 0x60, 0xfe, 0x9d, 0x33, 0xd2,
+	0xcb, 0x03, 0x03, 0x2f, 0x82, 0x79, 0x5a, 0xcb, 0xc3, 0xcb, 0x58, 0xc7, 0x04, 0xa3, 0xfe, 0x9a,
+	0x51, 0x76, 0x9f, 0x46, 0x20, 0x15, 0x59, 0xc4, 0xaf, 0xcc, 0x2f, 0xf6, 0xb5, 0x06, 0x2d, 0xc7,
+	0xd9, 0x82, 0xec, 0x00, 0x38, 0x49, 0xeb, 0xa1, 0xbb, 0x89, 0xa2, 0x57, 0xc3, 0x55, 0xcd, 0x3e,
+	0xcd, 0x37, 0x7b, 0x2a, 0x34, 0x1b, 0x51, 0x0e, 0xfa, 0x09, 0x8b, 0xe4, 0x26, 0xba, 0x7e, 0xf8,
+	0xba, 0x2f, 0xb4, 0xa9, 0xad, 0xe0, 0x7e, 0xbf, 0xa3, 0x7f, 0x00, 0x78, 0xd4, 0x25, 0x8f, 0x40,
+	0x94, 0x67, 0xbb, 0xdd, 0xec, 0x93, 0x64, 0xf6, 0x5d, 0x9b, 0x35, 0x16, 0xd1, 0x4f, 0xa5, 0x5b,
+	0x4f, 0x22, 0xa2, 0x82, 0xcd, 0xbc, 0x5c, 0x04, 0xf3, 0xc5, 0xcf, 0xc1, 0xfd, 0x1d, 0x11, 0x3c,
+	0xd8, 0x11, 0xc1, 0xd7, 0x3b, 0x62, 0xe4, 0x9b, 0x1d, 0x31, 0xf2, 0x70, 0x47, 0x8c, 0x7c, 0xbb,
+	0x23, 0x46, 0x1e, 0xed, 0x88, 0xe0, 0x4e, 0x53, 0x04, 0x77, 0x9b, 0x62, 0xe4, 0x5e, 0x53, 0x04,
+	0x5f, 0x34, 0xc5, 0xc8, 0x97, 0x4d, 0x31, 0xf2, 0x55, 0x53, 0x8c, 0xdc, 0x6f, 0x8a, 0xe0, 0x41,
+	0x53, 0x04, 0x5f, 0x37, 0xc5, 0xc8, 0x37, 0x4d, 0x11, 0x3c, 0x6c, 0x8a, 0x91, 0x6f, 0x9b, 0x22,
+	0x78, 0xd4, 0x14, 0x23, 0x77, 0x76, 0xc5, 0xc8, 0xdd, 0x5d, 0x11, 0x7c, 0xbc, 0x2b, 0x46, 0x7e,
+	0xbd, 0x2b, 0x82, 0xcf, 0x76, 0xc5, 0xc8, 0xbd, 0x5d, 0x31, 0xf2, 0xc5, 0xae, 0x08, 0xbe, 0xdc,
+	0x15, 0xc1, 0x57, 0xbb, 0x22, 0x78, 0xe7, 0x54, 0xd5, 0xcc, 0x93, 0x75, 0x4c, 0xd6, 0x35, 0xa3,
+	0xea, 0xe4, 0x0d, 0x4c, 0x36, 0x4d, 0xbb, 0x56, 0xe8, 0xfc, 0x77, 0xdb, 0xaa, 0x55, 0x0b, 0x84,
+	0x18, 0xd6, 0xea, 0xea, 0x30, 0x4b, 0xce, 0xf3, 0xff, 0x1d, 0x00, 0xc7, 0x8a, 0x63, 0x0a, 0x72,
+	0x20, 0x00, 0x00,
 }
 
 func (this *ApplicationWebhookIdentifiers) Equal(that interface{}) bool {
@@ -1471,6 +1840,12 @@ func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	if this.DownlinkAPIKey != that1.DownlinkAPIKey {
 		return false
 	}
+	if this.SigningSecret != that1.SigningSecret {
+		return false
+	}
+	if !this.ClientCertificate.Equal(that1.ClientCertificate) {
+		return false
+	}
 	if !this.UplinkMessage.Equal(that1.UplinkMessage) {
 		return false
 	}
@@ -1497,6 +1872,39 @@ func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationWebhook_ClientCertificate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhook_ClientCertificate)
+	if !ok {
+		that2, ok := that.(ApplicationWebhook_ClientCertificate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Certificate, that1.Certificate) {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.EncryptedKey, that1.EncryptedKey) {
+		return false
+	}
+	if this.KEKLabel != that1.KEKLabel {
+		return false
+	}
+	return true
+}
 func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1711,39 +2119,178 @@ func (this *ListApplicationWebhookTemplatesRequest) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *ApplicationWebhookDelivery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ApplicationWebhookRegistryClient is the client API for ApplicationWebhookRegistry service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ApplicationWebhookRegistryClient interface {
-	GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationWebhookFormats, error)
-	GetTemplate(ctx context.Context, in *GetApplicationWebhookTemplateRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplate, error)
-	ListTemplates(ctx context.Context, in *ListApplicationWebhookTemplatesRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplates, error)
-	Get(ctx context.Context, in *GetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
-	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
-	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
-	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
-}
-
-type applicationWebhookRegistryClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewApplicationWebhookRegistryClient(cc *grpc.ClientConn) ApplicationWebhookRegistryClient {
-	return &applicationWebhookRegistryClient{cc}
-}
-
-func (c *applicationWebhookRegistryClient) GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationWebhookFormats, error) {
-	out := new(ApplicationWebhookFormats)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetFormats", in, out, opts...)
+	that1, ok := that.(*ApplicationWebhookDelivery)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookDelivery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if this.DeliveryID != that1.DeliveryID {
+		return false
+	}
+	if !this.Up.Equal(that1.Up) {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if that1.LastAttemptedAt == nil {
+		if this.LastAttemptedAt != nil {
+			return false
+		}
+	} else if !this.LastAttemptedAt.Equal(*that1.LastAttemptedAt) {
+		return false
+	}
+	if !this.LastError.Equal(that1.LastError) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationWebhookDeliveries) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookDeliveries)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookDeliveries)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Deliveries) != len(that1.Deliveries) {
+		return false
+	}
+	for i := range this.Deliveries {
+		if !this.Deliveries[i].Equal(that1.Deliveries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ListApplicationWebhookFailedDeliveriesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListApplicationWebhookFailedDeliveriesRequest)
+	if !ok {
+		that2, ok := that.(ListApplicationWebhookFailedDeliveriesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *ReplayApplicationWebhookFailedDeliveriesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplayApplicationWebhookFailedDeliveriesRequest)
+	if !ok {
+		that2, ok := that.(ReplayApplicationWebhookFailedDeliveriesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if len(this.DeliveryIDs) != len(that1.DeliveryIDs) {
+		return false
+	}
+	for i := range this.DeliveryIDs {
+		if this.DeliveryIDs[i] != that1.DeliveryIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ApplicationWebhookRegistryClient is the client API for ApplicationWebhookRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationWebhookRegistryClient interface {
+	GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationWebhookFormats, error)
+	GetTemplate(ctx context.Context, in *GetApplicationWebhookTemplateRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplate, error)
+	ListTemplates(ctx context.Context, in *ListApplicationWebhookTemplatesRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplates, error)
+	Get(ctx context.Context, in *GetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
+	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
+	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
+	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// List the deliveries to the webhook that permanently failed.
+	ListFailedDeliveries(ctx context.Context, in *ListApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error)
+	// Replay deliveries to the webhook that permanently failed.
+	// Replayed deliveries are removed from the failed deliveries and queued for delivery again.
+	ReplayFailedDeliveries(ctx context.Context, in *ReplayApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type applicationWebhookRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationWebhookRegistryClient(cc *grpc.ClientConn) ApplicationWebhookRegistryClient {
+	return &applicationWebhookRegistryClient{cc}
+}
+
+func (c *applicationWebhookRegistryClient) GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationWebhookFormats, error) {
+	out := new(ApplicationWebhookFormats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetFormats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1804,6 +2351,24 @@ func (c *applicationWebhookRegistryClient) Delete(ctx context.Context, in *Appli
 	return out, nil
 }
 
+func (c *applicationWebhookRegistryClient) ListFailedDeliveries(ctx context.Context, in *ListApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error) {
+	out := new(ApplicationWebhookDeliveries)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListFailedDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) ReplayFailedDeliveries(ctx context.Context, in *ReplayApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayFailedDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationWebhookRegistryServer is the server API for ApplicationWebhookRegistry service.
 type ApplicationWebhookRegistryServer interface {
 	GetFormats(context.Context, *types.Empty) (*ApplicationWebhookFormats, error)
@@ -1813,6 +2378,11 @@ type ApplicationWebhookRegistryServer interface {
 	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
 	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
 	Delete(context.Context, *ApplicationWebhookIdentifiers) (*types.Empty, error)
+	// List the deliveries to the webhook that permanently failed.
+	ListFailedDeliveries(context.Context, *ListApplicationWebhookFailedDeliveriesRequest) (*ApplicationWebhookDeliveries, error)
+	// Replay deliveries to the webhook that permanently failed.
+	// Replayed deliveries are removed from the failed deliveries and queued for delivery again.
+	ReplayFailedDeliveries(context.Context, *ReplayApplicationWebhookFailedDeliveriesRequest) (*types.Empty, error)
 }
 
 // UnimplementedApplicationWebhookRegistryServer can be embedded to have forward compatible implementations.
@@ -1840,6 +2410,12 @@ func (*UnimplementedApplicationWebhookRegistryServer) Set(ctx context.Context, r
 func (*UnimplementedApplicationWebhookRegistryServer) Delete(ctx context.Context, req *ApplicationWebhookIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedApplicationWebhookRegistryServer) ListFailedDeliveries(ctx context.Context, req *ListApplicationWebhookFailedDeliveriesRequest) (*ApplicationWebhookDeliveries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedDeliveries not implemented")
+}
+func (*UnimplementedApplicationWebhookRegistryServer) ReplayFailedDeliveries(ctx context.Context, req *ReplayApplicationWebhookFailedDeliveriesRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayFailedDeliveries not implemented")
+}
 
 func RegisterApplicationWebhookRegistryServer(s *grpc.Server, srv ApplicationWebhookRegistryServer) {
 	s.RegisterService(&_ApplicationWebhookRegistry_serviceDesc, srv)
@@ -1971,6 +2547,42 @@ func _ApplicationWebhookRegistry_Delete_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationWebhookRegistry_ListFailedDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationWebhookFailedDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ListFailedDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListFailedDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ListFailedDeliveries(ctx, req.(*ListApplicationWebhookFailedDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_ReplayFailedDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayApplicationWebhookFailedDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ReplayFailedDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayFailedDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ReplayFailedDeliveries(ctx, req.(*ReplayApplicationWebhookFailedDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ApplicationWebhookRegistry",
 	HandlerType: (*ApplicationWebhookRegistryServer)(nil),
@@ -2003,6 +2615,14 @@ var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationWebhookRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "ListFailedDeliveries",
+			Handler:    _ApplicationWebhookRegistry_ListFailedDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayFailedDeliveries",
+			Handler:    _ApplicationWebhookRegistry_ReplayFailedDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver_web.proto",
@@ -2455,6 +3075,29 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClientCertificate != nil {
+		{
+			size, err := m.ClientCertificate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.SigningSecret) > 0 {
+		i -= len(m.SigningSecret)
+		copy(dAtA[i:], m.SigningSecret)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.SigningSecret)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	if len(m.DownlinkAPIKey) > 0 {
 		i -= len(m.DownlinkAPIKey)
 		copy(dAtA[i:], m.DownlinkAPIKey)
@@ -2626,21 +3269,21 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err20 != nil {
-		return 0, err20
-	}
-	i -= n20
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n20))
-	i--
-	dAtA[i] = 0x1a
-	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
 	if err21 != nil {
 		return 0, err21
 	}
 	i -= n21
 	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n21))
 	i--
+	dAtA[i] = 0x1a
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n22))
+	i--
 	dAtA[i] = 0x12
 	{
 		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
@@ -2655,6 +3298,57 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationWebhook_ClientCertificate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhook_ClientCertificate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhook_ClientCertificate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KEKLabel) > 0 {
+		i -= len(m.KEKLabel)
+		copy(dAtA[i:], m.KEKLabel)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.KEKLabel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EncryptedKey) > 0 {
+		i -= len(m.EncryptedKey)
+		copy(dAtA[i:], m.EncryptedKey)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.EncryptedKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Certificate) > 0 {
+		i -= len(m.Certificate)
+		copy(dAtA[i:], m.Certificate)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Certificate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationWebhook_Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2969,22 +3663,226 @@ func (m *ListApplicationWebhookTemplatesRequest) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationserverWeb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationserverWeb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ApplicationWebhookDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func NewPopulatedApplicationWebhookIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookIdentifiers {
-	this := &ApplicationWebhookIdentifiers{}
-	v1 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v1
-	this.WebhookID = randStringApplicationserverWeb(r)
+
+func (m *ApplicationWebhookDelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhookDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastError != nil {
+		{
+			size, err := m.LastError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LastAttemptedAt != nil {
+		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastAttemptedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptedAt):])
+		if err34 != nil {
+			return 0, err34
+		}
+		i -= n34
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n34))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x22
+	if m.Up != nil {
+		{
+			size, err := m.Up.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DeliveryID) > 0 {
+		i -= len(m.DeliveryID)
+		copy(dAtA[i:], m.DeliveryID)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DeliveryID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationWebhookDeliveries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookDeliveries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhookDeliveries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for iNdEx := len(m.Deliveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deliveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeliveryIDs) > 0 {
+		for iNdEx := len(m.DeliveryIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeliveryIDs[iNdEx])
+			copy(dAtA[i:], m.DeliveryIDs[iNdEx])
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DeliveryIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationserverWeb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserverWeb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedApplicationWebhookIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookIdentifiers {
+	this := &ApplicationWebhookIdentifiers{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	this.WebhookID = randStringApplicationserverWeb(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3139,6 +4037,33 @@ func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *App
 		}
 	}
 	this.DownlinkAPIKey = randStringApplicationserverWeb(r)
+	this.SigningSecret = randStringApplicationserverWeb(r)
+	if r.Intn(5) != 0 {
+		this.ClientCertificate = NewPopulatedApplicationWebhook_ClientCertificate(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhook_ClientCertificate(r randyApplicationserverWeb, easy bool) *ApplicationWebhook_ClientCertificate {
+	this := &ApplicationWebhook_ClientCertificate{}
+	v11 := r.Intn(100)
+	this.Certificate = make([]byte, v11)
+	for i := 0; i < v11; i++ {
+		this.Certificate[i] = byte(r.Intn(256))
+	}
+	v12 := r.Intn(100)
+	this.Key = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.Key[i] = byte(r.Intn(256))
+	}
+	v13 := r.Intn(100)
+	this.EncryptedKey = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.EncryptedKey[i] = byte(r.Intn(256))
+	}
+	this.KEKLabel = randStringApplicationserverWeb(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3155,9 +4080,9 @@ func NewPopulatedApplicationWebhook_Message(r randyApplicationserverWeb, easy bo
 func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *ApplicationWebhooks {
 	this := &ApplicationWebhooks{}
 	if r.Intn(5) != 0 {
-		v11 := r.Intn(5)
-		this.Webhooks = make([]*ApplicationWebhook, v11)
-		for i := 0; i < v11; i++ {
+		v14 := r.Intn(5)
+		this.Webhooks = make([]*ApplicationWebhook, v14)
+		for i := 0; i < v14; i++ {
 			this.Webhooks[i] = NewPopulatedApplicationWebhook(r, easy)
 		}
 	}
@@ -3169,9 +4094,9 @@ func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *Ap
 func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy bool) *ApplicationWebhookFormats {
 	this := &ApplicationWebhookFormats{}
 	if r.Intn(5) != 0 {
-		v12 := r.Intn(10)
+		v15 := r.Intn(10)
 		this.Formats = make(map[string]string)
-		for i := 0; i < v12; i++ {
+		for i := 0; i < v15; i++ {
 			this.Formats[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
 		}
 	}
@@ -3182,10 +4107,10 @@ func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy boo
 
 func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookRequest {
 	this := &GetApplicationWebhookRequest{}
-	v13 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
-	this.ApplicationWebhookIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v16 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v16
+	v17 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v17
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3193,10 +4118,10 @@ func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhooksRequest {
 	this := &ListApplicationWebhooksRequest{}
-	v15 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v15
-	v16 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v16
+	v18 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v18
+	v19 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v19
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3204,10 +4129,10 @@ func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, eas
 
 func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *SetApplicationWebhookRequest {
 	this := &SetApplicationWebhookRequest{}
-	v17 := NewPopulatedApplicationWebhook(r, easy)
-	this.ApplicationWebhook = *v17
-	v18 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v18
+	v20 := NewPopulatedApplicationWebhook(r, easy)
+	this.ApplicationWebhook = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3215,10 +4140,10 @@ func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookTemplateRequest {
 	this := &GetApplicationWebhookTemplateRequest{}
-	v19 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
-	this.ApplicationWebhookTemplateIdentifiers = *v19
-	v20 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v20
+	v22 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	this.ApplicationWebhookTemplateIdentifiers = *v22
+	v23 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3226,8 +4151,68 @@ func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWe
 
 func NewPopulatedListApplicationWebhookTemplatesRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhookTemplatesRequest {
 	this := &ListApplicationWebhookTemplatesRequest{}
-	v21 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v21
+	v24 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v24
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookDelivery(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDelivery {
+	this := &ApplicationWebhookDelivery{}
+	v25 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v25
+	this.DeliveryID = randStringApplicationserverWeb(r)
+	if r.Intn(5) == 0 {
+		this.Up = NewPopulatedApplicationUp(r, easy)
+	}
+	v26 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v26
+	this.Attempts = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.LastAttemptedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.LastError = NewPopulatedErrorDetails(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookDeliveries(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDeliveries {
+	this := &ApplicationWebhookDeliveries{}
+	if r.Intn(5) == 0 {
+		v27 := r.Intn(5)
+		this.Deliveries = make([]*ApplicationWebhookDelivery, v27)
+		for i := 0; i < v27; i++ {
+			this.Deliveries[i] = NewPopulatedApplicationWebhookDelivery(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListApplicationWebhookFailedDeliveriesRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhookFailedDeliveriesRequest {
+	this := &ListApplicationWebhookFailedDeliveriesRequest{}
+	v28 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v28
+	this.Limit = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedReplayApplicationWebhookFailedDeliveriesRequest(r randyApplicationserverWeb, easy bool) *ReplayApplicationWebhookFailedDeliveriesRequest {
+	this := &ReplayApplicationWebhookFailedDeliveriesRequest{}
+	v29 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v29
+	v30 := r.Intn(10)
+	this.DeliveryIDs = make([]string, v30)
+	for i := 0; i < v30; i++ {
+		this.DeliveryIDs[i] = randStringApplicationserverWeb(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3252,9 +4237,9 @@ func randUTF8RuneApplicationserverWeb(r randyApplicationserverWeb) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserverWeb(r randyApplicationserverWeb) string {
-	v22 := r.Intn(100)
-	tmps := make([]rune, v22)
-	for i := 0; i < v22; i++ {
+	v31 := r.Intn(100)
+	tmps := make([]rune, v31)
+	for i := 0; i < v31; i++ {
 		tmps[i] = randUTF8RuneApplicationserverWeb(r)
 	}
 	return string(tmps)
@@ -3276,11 +4261,11 @@ func randFieldApplicationserverWeb(dAtA []byte, r randyApplicationserverWeb, fie
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
-		v23 := r.Int63()
+		v32 := r.Int63()
 		if r.Intn(2) == 0 {
-			v23 *= -1
+			v32 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v23))
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v32))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3553,6 +4538,39 @@ func (m *ApplicationWebhook) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovApplicationserverWeb(uint64(l))
 	}
+	l = len(m.SigningSecret)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.ClientCertificate != nil {
+		l = m.ClientCertificate.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhook_ClientCertificate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.EncryptedKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.KEKLabel)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
 	return n
 }
 
@@ -3664,29 +4682,107 @@ func (m *ListApplicationWebhookTemplatesRequest) Size() (n int) {
 	return n
 }
 
-func sovApplicationserverWeb(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozApplicationserverWeb(x uint64) (n int) {
-	return sovApplicationserverWeb((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *ApplicationWebhookIdentifiers) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationWebhookIdentifiers{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`WebhookID:` + fmt.Sprintf("%v", this.WebhookID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationWebhookTemplateIdentifiers) String() string {
-	if this == nil {
-		return "nil"
+func (m *ApplicationWebhookDelivery) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ApplicationWebhookTemplateIdentifiers{`,
-		`TemplateID:` + fmt.Sprintf("%v", this.TemplateID) + `,`,
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.DeliveryID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Up != nil {
+		l = m.Up.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Attempts))
+	}
+	if m.LastAttemptedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptedAt)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.LastError != nil {
+		l = m.LastError.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookDeliveries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for _, e := range m.Deliveries {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if len(m.DeliveryIDs) > 0 {
+		for _, s := range m.DeliveryIDs {
+			l = len(s)
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovApplicationserverWeb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApplicationserverWeb(x uint64) (n int) {
+	return sovApplicationserverWeb((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *ApplicationWebhookIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookIdentifiers{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`WebhookID:` + fmt.Sprintf("%v", this.WebhookID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookTemplateIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookTemplateIdentifiers{`,
+		`TemplateID:` + fmt.Sprintf("%v", this.TemplateID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3815,6 +4911,21 @@ func (this *ApplicationWebhook) String() string {
 		`ApplicationWebhookTemplateIdentifiers:` + strings.Replace(this.ApplicationWebhookTemplateIdentifiers.String(), "ApplicationWebhookTemplateIdentifiers", "ApplicationWebhookTemplateIdentifiers", 1) + `,`,
 		`TemplateFields:` + mapStringForTemplateFields + `,`,
 		`DownlinkAPIKey:` + fmt.Sprintf("%v", this.DownlinkAPIKey) + `,`,
+		`SigningSecret:` + fmt.Sprintf("%v", this.SigningSecret) + `,`,
+		`ClientCertificate:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertificate), "ApplicationWebhook_ClientCertificate", "ApplicationWebhook_ClientCertificate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhook_ClientCertificate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhook_ClientCertificate{`,
+		`Certificate:` + fmt.Sprintf("%v", this.Certificate) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`EncryptedKey:` + fmt.Sprintf("%v", this.EncryptedKey) + `,`,
+		`KEKLabel:` + fmt.Sprintf("%v", this.KEKLabel) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3918,6 +5029,59 @@ func (this *ListApplicationWebhookTemplatesRequest) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhookDelivery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookDelivery{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeliveryID:` + fmt.Sprintf("%v", this.DeliveryID) + `,`,
+		`Up:` + strings.Replace(fmt.Sprintf("%v", this.Up), "ApplicationUp", "ApplicationUp", 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`LastAttemptedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastAttemptedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastError:` + strings.Replace(fmt.Sprintf("%v", this.LastError), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookDeliveries) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeliveries := "[]*ApplicationWebhookDelivery{"
+	for _, f := range this.Deliveries {
+		repeatedStringForDeliveries += strings.Replace(f.String(), "ApplicationWebhookDelivery", "ApplicationWebhookDelivery", 1) + ","
+	}
+	repeatedStringForDeliveries += "}"
+	s := strings.Join([]string{`&ApplicationWebhookDeliveries{`,
+		`Deliveries:` + repeatedStringForDeliveries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListApplicationWebhookFailedDeliveriesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListApplicationWebhookFailedDeliveriesRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplayApplicationWebhookFailedDeliveriesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplayApplicationWebhookFailedDeliveriesRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeliveryIDs:` + fmt.Sprintf("%v", this.DeliveryIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringApplicationserverWeb(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -6083,62 +7247,9 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			}
 			m.DownlinkAPIKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverWeb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecret", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6166,64 +7277,11 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.SigningSecret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverWeb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhooks: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhooks: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertificate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6250,8 +7308,10 @@ func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Webhooks = append(m.Webhooks, &ApplicationWebhook{})
-			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClientCertificate == nil {
+				m.ClientCertificate = &ApplicationWebhook_ClientCertificate{}
+			}
+			if err := m.ClientCertificate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6279,7 +7339,7 @@ func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhook_ClientCertificate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6302,17 +7362,17 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhookFormats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientCertificate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhookFormats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6322,36 +7382,395 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Formats == nil {
-				m.Formats = make(map[string]string)
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplicationserverWeb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedKey = append(m.EncryptedKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedKey == nil {
+				m.EncryptedKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KEKLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KEKLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, &ApplicationWebhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookFormats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookFormats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Formats == nil {
+				m.Formats = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
 					b := dAtA[iNdEx]
 					iNdEx++
 					wire |= uint64(b&0x7F) << shift
@@ -6359,81 +7778,643 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplicationserverWeb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplicationserverWeb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Formats[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationWebhookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListApplicationWebhooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListApplicationWebhooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetApplicationWebhookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationWebhookTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationWebhookTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookTemplateIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookTemplateIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListApplicationWebhookTemplatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListApplicationWebhookTemplatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListApplicationWebhookTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Formats[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6459,7 +8440,7 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookDelivery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6482,10 +8463,10 @@ func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationWebhookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWebhookDelivery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWebhookDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6523,7 +8504,39 @@ func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveryID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Up", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6550,66 +8563,68 @@ func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Up == nil {
+				m.Up = &ApplicationUp{}
+			}
+			if err := m.Up.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverWeb
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListApplicationWebhooksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListApplicationWebhooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6636,13 +8651,16 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastAttemptedAt == nil {
+				m.LastAttemptedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastAttemptedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6669,7 +8687,10 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastError == nil {
+				m.LastError = &ErrorDetails{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6697,7 +8718,7 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookDeliveries) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6720,48 +8741,15 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetApplicationWebhookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWebhookDeliveries: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWebhookDeliveries: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhook", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationWebhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deliveries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6788,7 +8776,8 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deliveries = append(m.Deliveries, &ApplicationWebhookDelivery{})
+			if err := m.Deliveries[len(m.Deliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6816,7 +8805,7 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
+func (m *ListApplicationWebhookFailedDeliveriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6839,15 +8828,15 @@ func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationWebhookTemplateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListApplicationWebhookFailedDeliveriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationWebhookTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListApplicationWebhookFailedDeliveriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookTemplateIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6874,15 +8863,15 @@ func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationWebhookTemplateIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -6892,25 +8881,11 @@ func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
@@ -6935,7 +8910,7 @@ func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListApplicationWebhookTemplatesRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6958,15 +8933,15 @@ func (m *ListApplicationWebhookTemplatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListApplicationWebhookTemplatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplayApplicationWebhookFailedDeliveriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListApplicationWebhookTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplayApplicationWebhookFailedDeliveriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6993,10 +8968,42 @@ func (m *ListApplicationWebhookTemplatesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveryIDs = append(m.DeliveryIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
@@ -95,6 +95,11 @@ var ApplicationWebhookTemplatesFieldPathsTopLevel = []string{
 }
 var ApplicationWebhookFieldPathsNested = []string{
 	"base_url",
+	"client_certificate",
+	"client_certificate.certificate",
+	"client_certificate.encrypted_key",
+	"client_certificate.kek_label",
+	"client_certificate.key",
 	"created_at",
 	"downlink_ack",
 	"downlink_ack.path",
@@ -117,6 +122,7 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"join_accept.path",
 	"location_solved",
 	"location_solved.path",
+	"signing_secret",
 	"template_fields",
 	"template_ids",
 	"template_ids.template_id",
@@ -127,6 +133,7 @@ var ApplicationWebhookFieldPathsNested = []string{
 
 var ApplicationWebhookFieldPathsTopLevel = []string{
 	"base_url",
+	"client_certificate",
 	"created_at",
 	"downlink_ack",
 	"downlink_api_key",
@@ -139,6 +146,7 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"ids",
 	"join_accept",
 	"location_solved",
+	"signing_secret",
 	"template_fields",
 	"template_ids",
 	"updated_at",
@@ -184,6 +192,11 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"field_mask",
 	"webhook",
 	"webhook.base_url",
+	"webhook.client_certificate",
+	"webhook.client_certificate.certificate",
+	"webhook.client_certificate.encrypted_key",
+	"webhook.client_certificate.kek_label",
+	"webhook.client_certificate.key",
 	"webhook.created_at",
 	"webhook.downlink_ack",
 	"webhook.downlink_ack.path",
@@ -206,6 +219,7 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.join_accept.path",
 	"webhook.location_solved",
 	"webhook.location_solved.path",
+	"webhook.signing_secret",
 	"webhook.template_fields",
 	"webhook.template_ids",
 	"webhook.template_ids.template_id",
@@ -235,6 +249,209 @@ var ListApplicationWebhookTemplatesRequestFieldPathsNested = []string{
 var ListApplicationWebhookTemplatesRequestFieldPathsTopLevel = []string{
 	"field_mask",
 }
+var ApplicationWebhookDeliveryFieldPathsNested = []string{
+	"attempts",
+	"created_at",
+	"delivery_id",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"last_attempted_at",
+	"last_error",
+	"last_error.attributes",
+	"last_error.cause",
+	"last_error.cause.attributes",
+	"last_error.cause.correlation_id",
+	"last_error.cause.message_format",
+	"last_error.cause.name",
+	"last_error.cause.namespace",
+	"last_error.code",
+	"last_error.correlation_id",
+	"last_error.details",
+	"last_error.message_format",
+	"last_error.name",
+	"last_error.namespace",
+	"up",
+	"up.correlation_ids",
+	"up.end_device_ids",
+	"up.end_device_ids.application_ids",
+	"up.end_device_ids.application_ids.application_id",
+	"up.end_device_ids.dev_addr",
+	"up.end_device_ids.dev_eui",
+	"up.end_device_ids.device_id",
+	"up.end_device_ids.join_eui",
+	"up.received_at",
+	"up.up",
+	"up.up.downlink_ack",
+	"up.up.downlink_ack.class_b_c",
+	"up.up.downlink_ack.class_b_c.absolute_time",
+	"up.up.downlink_ack.class_b_c.gateways",
+	"up.up.downlink_ack.confirmed",
+	"up.up.downlink_ack.correlation_ids",
+	"up.up.downlink_ack.decoded_payload",
+	"up.up.downlink_ack.f_cnt",
+	"up.up.downlink_ack.f_port",
+	"up.up.downlink_ack.frm_payload",
+	"up.up.downlink_ack.priority",
+	"up.up.downlink_ack.session_key_id",
+	"up.up.downlink_failed",
+	"up.up.downlink_failed.downlink",
+	"up.up.downlink_failed.downlink.class_b_c",
+	"up.up.downlink_failed.downlink.class_b_c.absolute_time",
+	"up.up.downlink_failed.downlink.class_b_c.gateways",
+	"up.up.downlink_failed.downlink.confirmed",
+	"up.up.downlink_failed.downlink.correlation_ids",
+	"up.up.downlink_failed.downlink.decoded_payload",
+	"up.up.downlink_failed.downlink.f_cnt",
+	"up.up.downlink_failed.downlink.f_port",
+	"up.up.downlink_failed.downlink.frm_payload",
+	"up.up.downlink_failed.downlink.priority",
+	"up.up.downlink_failed.downlink.session_key_id",
+	"up.up.downlink_failed.error",
+	"up.up.downlink_failed.error.attributes",
+	"up.up.downlink_failed.error.cause",
+	"up.up.downlink_failed.error.cause.attributes",
+	"up.up.downlink_failed.error.cause.correlation_id",
+	"up.up.downlink_failed.error.cause.message_format",
+	"up.up.downlink_failed.error.cause.name",
+	"up.up.downlink_failed.error.cause.namespace",
+	"up.up.downlink_failed.error.code",
+	"up.up.downlink_failed.error.correlation_id",
+	"up.up.downlink_failed.error.details",
+	"up.up.downlink_failed.error.message_format",
+	"up.up.downlink_failed.error.name",
+	"up.up.downlink_failed.error.namespace",
+	"up.up.downlink_nack",
+	"up.up.downlink_nack.class_b_c",
+	"up.up.downlink_nack.class_b_c.absolute_time",
+	"up.up.downlink_nack.class_b_c.gateways",
+	"up.up.downlink_nack.confirmed",
+	"up.up.downlink_nack.correlation_ids",
+	"up.up.downlink_nack.decoded_payload",
+	"up.up.downlink_nack.f_cnt",
+	"up.up.downlink_nack.f_port",
+	"up.up.downlink_nack.frm_payload",
+	"up.up.downlink_nack.priority",
+	"up.up.downlink_nack.session_key_id",
+	"up.up.downlink_queue_invalidated",
+	"up.up.downlink_queue_invalidated.downlinks",
+	"up.up.downlink_queue_invalidated.last_f_cnt_down",
+	"up.up.downlink_queued",
+	"up.up.downlink_queued.class_b_c",
+	"up.up.downlink_queued.class_b_c.absolute_time",
+	"up.up.downlink_queued.class_b_c.gateways",
+	"up.up.downlink_queued.confirmed",
+	"up.up.downlink_queued.correlation_ids",
+	"up.up.downlink_queued.decoded_payload",
+	"up.up.downlink_queued.f_cnt",
+	"up.up.downlink_queued.f_port",
+	"up.up.downlink_queued.frm_payload",
+	"up.up.downlink_queued.priority",
+	"up.up.downlink_queued.session_key_id",
+	"up.up.downlink_sent",
+	"up.up.downlink_sent.class_b_c",
+	"up.up.downlink_sent.class_b_c.absolute_time",
+	"up.up.downlink_sent.class_b_c.gateways",
+	"up.up.downlink_sent.confirmed",
+	"up.up.downlink_sent.correlation_ids",
+	"up.up.downlink_sent.decoded_payload",
+	"up.up.downlink_sent.f_cnt",
+	"up.up.downlink_sent.f_port",
+	"up.up.downlink_sent.frm_payload",
+	"up.up.downlink_sent.priority",
+	"up.up.downlink_sent.session_key_id",
+	"up.up.join_accept",
+	"up.up.join_accept.app_s_key",
+	"up.up.join_accept.app_s_key.encrypted_key",
+	"up.up.join_accept.app_s_key.kek_label",
+	"up.up.join_accept.app_s_key.key",
+	"up.up.join_accept.invalidated_downlinks",
+	"up.up.join_accept.pending_session",
+	"up.up.join_accept.received_at",
+	"up.up.join_accept.session_key_id",
+	"up.up.location_solved",
+	"up.up.location_solved.attributes",
+	"up.up.location_solved.location",
+	"up.up.location_solved.location.accuracy",
+	"up.up.location_solved.location.altitude",
+	"up.up.location_solved.location.latitude",
+	"up.up.location_solved.location.longitude",
+	"up.up.location_solved.location.source",
+	"up.up.location_solved.service",
+	"up.up.uplink_message",
+	"up.up.uplink_message.app_s_key",
+	"up.up.uplink_message.app_s_key.encrypted_key",
+	"up.up.uplink_message.app_s_key.kek_label",
+	"up.up.uplink_message.app_s_key.key",
+	"up.up.uplink_message.decoded_payload",
+	"up.up.uplink_message.f_cnt",
+	"up.up.uplink_message.f_port",
+	"up.up.uplink_message.frm_payload",
+	"up.up.uplink_message.last_a_f_cnt_down",
+	"up.up.uplink_message.received_at",
+	"up.up.uplink_message.rx_metadata",
+	"up.up.uplink_message.session_key_id",
+	"up.up.uplink_message.settings",
+	"up.up.uplink_message.settings.coding_rate",
+	"up.up.uplink_message.settings.data_rate",
+	"up.up.uplink_message.settings.data_rate.modulation",
+	"up.up.uplink_message.settings.data_rate.modulation.fsk",
+	"up.up.uplink_message.settings.data_rate.modulation.fsk.bit_rate",
+	"up.up.uplink_message.settings.data_rate.modulation.lora",
+	"up.up.uplink_message.settings.data_rate.modulation.lora.bandwidth",
+	"up.up.uplink_message.settings.data_rate.modulation.lora.spreading_factor",
+	"up.up.uplink_message.settings.data_rate_index",
+	"up.up.uplink_message.settings.downlink",
+	"up.up.uplink_message.settings.downlink.antenna_index",
+	"up.up.uplink_message.settings.downlink.invert_polarization",
+	"up.up.uplink_message.settings.downlink.tx_power",
+	"up.up.uplink_message.settings.enable_crc",
+	"up.up.uplink_message.settings.frequency",
+	"up.up.uplink_message.settings.time",
+	"up.up.uplink_message.settings.timestamp",
+}
+
+var ApplicationWebhookDeliveryFieldPathsTopLevel = []string{
+	"attempts",
+	"created_at",
+	"delivery_id",
+	"ids",
+	"last_attempted_at",
+	"last_error",
+	"up",
+}
+var ApplicationWebhookDeliveriesFieldPathsNested = []string{
+	"deliveries",
+}
+
+var ApplicationWebhookDeliveriesFieldPathsTopLevel = []string{
+	"deliveries",
+}
+var ListApplicationWebhookFailedDeliveriesRequestFieldPathsNested = []string{
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"limit",
+}
+
+var ListApplicationWebhookFailedDeliveriesRequestFieldPathsTopLevel = []string{
+	"ids",
+	"limit",
+}
+var ReplayApplicationWebhookFailedDeliveriesRequestFieldPathsNested = []string{
+	"delivery_ids",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+}
+
+var ReplayApplicationWebhookFailedDeliveriesRequestFieldPathsTopLevel = []string{
+	"delivery_ids",
+	"ids",
+}
 var ApplicationWebhookTemplate_MessageFieldPathsNested = []string{
 	"path",
 }
@@ -242,6 +459,19 @@ var ApplicationWebhookTemplate_MessageFieldPathsNested = []string{
 var ApplicationWebhookTemplate_MessageFieldPathsTopLevel = []string{
 	"path",
 }
+var ApplicationWebhook_ClientCertificateFieldPathsNested = []string{
+	"certificate",
+	"encrypted_key",
+	"kek_label",
+	"key",
+}
+
+var ApplicationWebhook_ClientCertificateFieldPathsTopLevel = []string{
+	"certificate",
+	"encrypted_key",
+	"kek_label",
+	"key",
+}
 var ApplicationWebhook_MessageFieldPathsNested = []string{
 	"path",
 }
@@ -591,6 +591,41 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 				var zero string
 				dst.DownlinkAPIKey = zero
 			}
+		case "signing_secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'signing_secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SigningSecret = src.SigningSecret
+			} else {
+				var zero string
+				dst.SigningSecret = zero
+			}
+		case "client_certificate":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhook_ClientCertificate
+				if (src == nil || src.ClientCertificate == nil) && dst.ClientCertificate == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ClientCertificate
+				}
+				if dst.ClientCertificate != nil {
+					newDst = dst.ClientCertificate
+				} else {
+					newDst = &ApplicationWebhook_ClientCertificate{}
+					dst.ClientCertificate = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ClientCertificate = src.ClientCertificate
+				} else {
+					dst.ClientCertificate = nil
+				}
+			}
 		case "uplink_message":
 			if len(subs) > 0 {
 				var newDst, newSrc *ApplicationWebhook_Message
@@ -1016,6 +1051,221 @@ func (dst *ListApplicationWebhookTemplatesRequest) SetFields(src *ListApplicatio
 	return nil
 }
 
+func (dst *ApplicationWebhookDelivery) SetFields(src *ApplicationWebhookDelivery, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "delivery_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'delivery_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeliveryID = src.DeliveryID
+			} else {
+				var zero string
+				dst.DeliveryID = zero
+			}
+		case "up":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationUp
+				if (src == nil || src.Up == nil) && dst.Up == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Up
+				}
+				if dst.Up != nil {
+					newDst = dst.Up
+				} else {
+					newDst = &ApplicationUp{}
+					dst.Up = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Up = src.Up
+				} else {
+					dst.Up = nil
+				}
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				var zero uint32
+				dst.Attempts = zero
+			}
+		case "last_attempted_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_attempted_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastAttemptedAt = src.LastAttemptedAt
+			} else {
+				dst.LastAttemptedAt = nil
+			}
+		case "last_error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.LastError == nil) && dst.LastError == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastError
+				}
+				if dst.LastError != nil {
+					newDst = dst.LastError
+				} else {
+					newDst = &ErrorDetails{}
+					dst.LastError = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastError = src.LastError
+				} else {
+					dst.LastError = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhookDeliveries) SetFields(src *ApplicationWebhookDeliveries, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "deliveries":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deliveries' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deliveries = src.Deliveries
+			} else {
+				dst.Deliveries = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListApplicationWebhookFailedDeliveriesRequest) SetFields(src *ListApplicationWebhookFailedDeliveriesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ReplayApplicationWebhookFailedDeliveriesRequest) SetFields(src *ReplayApplicationWebhookFailedDeliveriesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "delivery_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'delivery_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeliveryIDs = src.DeliveryIDs
+			} else {
+				dst.DeliveryIDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationWebhookTemplate_Message) SetFields(src *ApplicationWebhookTemplate_Message, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1037,6 +1287,54 @@ func (dst *ApplicationWebhookTemplate_Message) SetFields(src *ApplicationWebhook
 	return nil
 }
 
+func (dst *ApplicationWebhook_ClientCertificate) SetFields(src *ApplicationWebhook_ClientCertificate, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "certificate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'certificate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Certificate = src.Certificate
+			} else {
+				dst.Certificate = nil
+			}
+		case "key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Key = src.Key
+			} else {
+				dst.Key = nil
+			}
+		case "encrypted_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'encrypted_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EncryptedKey = src.EncryptedKey
+			} else {
+				dst.EncryptedKey = nil
+			}
+		case "kek_label":
+			if len(subs) > 0 {
+				return fmt.Errorf("'kek_label' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.KEKLabel = src.KEKLabel
+			} else {
+				var zero string
+				dst.KEKLabel = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationWebhook_Message) SetFields(src *ApplicationWebhook_Message, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
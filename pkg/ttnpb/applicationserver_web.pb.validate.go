@@ -882,6 +882,27 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 			// no validation rules for TemplateFields
 		case "downlink_api_key":
 			// no validation rules for DownlinkAPIKey
+		case "signing_secret":
+
+			if utf8.RuneCountInString(m.GetSigningSecret()) > 128 {
+				return ApplicationWebhookValidationError{
+					field:  "signing_secret",
+					reason: "value length must be at most 128 runes",
+				}
+			}
+
+		case "client_certificate":
+
+			if v, ok := interface{}(m.GetClientCertificate()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "client_certificate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		case "uplink_message":
 
 			if v, ok := interface{}(m.GetUplinkMessage()).(interface{ ValidateFields(...string) error }); ok {
@@ -1746,6 +1767,485 @@ var _ interface {
 	ErrorName() string
 } = ListApplicationWebhookTemplatesRequestValidationError{}
 
+// ValidateFields checks the field values on ApplicationWebhookDelivery with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationWebhookDelivery) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeliveryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "delivery_id":
+
+			if utf8.RuneCountInString(m.GetDeliveryID()) > 36 {
+				return ApplicationWebhookDeliveryValidationError{
+					field:  "delivery_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+		case "up":
+
+			if m.Up == nil {
+				return ApplicationWebhookDeliveryValidationError{
+					field:  "up",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetUp()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "up",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "attempts":
+			// no validation rules for Attempts
+		case "last_attempted_at":
+
+			if v, ok := interface{}(m.GetLastAttemptedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "last_attempted_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_error":
+
+			if v, ok := interface{}(m.GetLastError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "last_error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationWebhookDeliveryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeliveryValidationError is the validation error returned
+// by ApplicationWebhookDelivery.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeliveryValidationError) ErrorName() string {
+	return "ApplicationWebhookDeliveryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeliveryValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhookDeliveries with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationWebhookDeliveries) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeliveriesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "deliveries":
+
+			for idx, item := range m.GetDeliveries() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationWebhookDeliveriesValidationError{
+							field:  fmt.Sprintf("deliveries[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationWebhookDeliveriesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeliveriesValidationError is the validation error returned
+// by ApplicationWebhookDeliveries.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationWebhookDeliveriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeliveriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeliveriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeliveriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeliveriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeliveriesValidationError) ErrorName() string {
+	return "ApplicationWebhookDeliveriesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeliveriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDeliveries.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeliveriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeliveriesValidationError{}
+
+// ValidateFields checks the field values on
+// ListApplicationWebhookFailedDeliveriesRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ListApplicationWebhookFailedDeliveriesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListApplicationWebhookFailedDeliveriesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListApplicationWebhookFailedDeliveriesRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListApplicationWebhookFailedDeliveriesRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		default:
+			return ListApplicationWebhookFailedDeliveriesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListApplicationWebhookFailedDeliveriesRequestValidationError is the
+// validation error returned by
+// ListApplicationWebhookFailedDeliveriesRequest.ValidateFields if the
+// designated constraints aren't met.
+type ListApplicationWebhookFailedDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) ErrorName() string {
+	return "ListApplicationWebhookFailedDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationWebhookFailedDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationWebhookFailedDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationWebhookFailedDeliveriesRequestValidationError{}
+
+// ValidateFields checks the field values on
+// ReplayApplicationWebhookFailedDeliveriesRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ReplayApplicationWebhookFailedDeliveriesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ReplayApplicationWebhookFailedDeliveriesRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "delivery_ids":
+
+			for idx, item := range m.GetDeliveryIDs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 36 {
+					return ReplayApplicationWebhookFailedDeliveriesRequestValidationError{
+						field:  fmt.Sprintf("delivery_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+			}
+
+		default:
+			return ReplayApplicationWebhookFailedDeliveriesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ReplayApplicationWebhookFailedDeliveriesRequestValidationError is the
+// validation error returned by
+// ReplayApplicationWebhookFailedDeliveriesRequest.ValidateFields if the
+// designated constraints aren't met.
+type ReplayApplicationWebhookFailedDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) ErrorName() string {
+	return "ReplayApplicationWebhookFailedDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayApplicationWebhookFailedDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayApplicationWebhookFailedDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayApplicationWebhookFailedDeliveriesRequestValidationError{}
+
 // ValidateFields checks the field values on ApplicationWebhookTemplate_Message
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
@@ -1830,6 +2330,117 @@ var _ interface {
 	ErrorName() string
 } = ApplicationWebhookTemplate_MessageValidationError{}
 
+// ValidateFields checks the field values on
+// ApplicationWebhook_ClientCertificate with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ApplicationWebhook_ClientCertificate) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhook_ClientCertificateFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "certificate":
+
+			if len(m.GetCertificate()) > 16384 {
+				return ApplicationWebhook_ClientCertificateValidationError{
+					field:  "certificate",
+					reason: "value length must be at most 16384 bytes",
+				}
+			}
+
+		case "key":
+
+			if len(m.GetKey()) > 16384 {
+				return ApplicationWebhook_ClientCertificateValidationError{
+					field:  "key",
+					reason: "value length must be at most 16384 bytes",
+				}
+			}
+
+		case "encrypted_key":
+			// no validation rules for EncryptedKey
+		case "kek_label":
+
+			if utf8.RuneCountInString(m.GetKEKLabel()) > 2048 {
+				return ApplicationWebhook_ClientCertificateValidationError{
+					field:  "kek_label",
+					reason: "value length must be at most 2048 runes",
+				}
+			}
+
+		default:
+			return ApplicationWebhook_ClientCertificateValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhook_ClientCertificateValidationError is the validation error
+// returned by ApplicationWebhook_ClientCertificate.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationWebhook_ClientCertificateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhook_ClientCertificateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhook_ClientCertificateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhook_ClientCertificateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhook_ClientCertificateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhook_ClientCertificateValidationError) ErrorName() string {
+	return "ApplicationWebhook_ClientCertificateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhook_ClientCertificateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhook_ClientCertificate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhook_ClientCertificateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhook_ClientCertificateValidationError{}
+
 // ValidateFields checks the field values on ApplicationWebhook_Message with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
@@ -72,9 +72,9 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 	"/ttn.lorawan.v3.ApplicationWebhookRegistry/ListTemplates": ApplicationWebhookTemplateFieldPathsNested,
 
 	// Application Webhooks:
-	"/ttn.lorawan.v3.ApplicationWebhookRegistry/Get":  ApplicationWebhookFieldPathsNested,
-	"/ttn.lorawan.v3.ApplicationWebhookRegistry/List": ApplicationWebhookFieldPathsNested,
-	"/ttn.lorawan.v3.ApplicationWebhookRegistry/Set":  ApplicationWebhookFieldPathsNested,
+	"/ttn.lorawan.v3.ApplicationWebhookRegistry/Get":  omitFields(ApplicationWebhookFieldPathsNested, "client_certificate.encrypted_key", "client_certificate.key"),
+	"/ttn.lorawan.v3.ApplicationWebhookRegistry/List": omitFields(ApplicationWebhookFieldPathsNested, "client_certificate.encrypted_key", "client_certificate.key"),
+	"/ttn.lorawan.v3.ApplicationWebhookRegistry/Set":  omitFields(ApplicationWebhookFieldPathsNested, "client_certificate.encrypted_key", "client_certificate.kek_label"),
 
 	// Application PubSubs:
 	"/ttn.lorawan.v3.ApplicationPubSubRegistry/Get":  ApplicationPubSubFieldPathsNested,
@@ -625,6 +625,17 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 	"/ttn.lorawan.v3.GatewayRegistry/List":                GatewayFieldPathsNested,
 	"/ttn.lorawan.v3.GatewayRegistry/Update":              GatewayFieldPathsNested,
 
+	// Gateway Firmware Targets:
+	"/ttn.lorawan.v3.GatewayFirmwareRegistry/Get":  GatewayFirmwareTargetFieldPathsNested,
+	"/ttn.lorawan.v3.GatewayFirmwareRegistry/List": GatewayFirmwareTargetFieldPathsNested,
+	"/ttn.lorawan.v3.GatewayFirmwareRegistry/Set":  omitFields(GatewayFirmwareTargetFieldPathsNested, "created_at", "updated_at"),
+
+	// Gateway Credentials Rotations:
+	"/ttn.lorawan.v3.GatewayCredentialsRotator/Set": {
+		"interval",
+		"rollback_timeout",
+	},
+
 	// Organizations:
 	"/ttn.lorawan.v3.OrganizationRegistry/Get":                 OrganizationFieldPathsNested,
 	"/ttn.lorawan.v3.OrganizationRegistry/List":                OrganizationFieldPathsNested,
@@ -632,10 +643,16 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 	"/ttn.lorawan.v3.EntityRegistrySearch/SearchOrganizations": OrganizationFieldPathsNested,
 
 	// Users:
-	"/ttn.lorawan.v3.UserRegistry/Get":                 omitFields(UserFieldPathsNested, "password", "temporary_password"),
-	"/ttn.lorawan.v3.UserRegistry/List":                omitFields(UserFieldPathsNested, "password", "temporary_password"),
-	"/ttn.lorawan.v3.UserRegistry/Update":              omitFields(UserFieldPathsNested, "password", "password_updated_at"),
-	"/ttn.lorawan.v3.EntityRegistrySearch/SearchUsers": omitFields(UserFieldPathsNested, "password", "temporary_password"),
+	"/ttn.lorawan.v3.UserRegistry/Get":                 omitFields(UserFieldPathsNested, "password", "temporary_password", "totp_secret", "totp_recovery_codes", "totp_last_time_step", "totp_failed_attempts", "totp_locked_until", "totp_encrypted_secret", "totp_secret_kek_label"),
+	"/ttn.lorawan.v3.UserRegistry/List":                omitFields(UserFieldPathsNested, "password", "temporary_password", "totp_secret", "totp_recovery_codes", "totp_last_time_step", "totp_failed_attempts", "totp_locked_until", "totp_encrypted_secret", "totp_secret_kek_label"),
+	"/ttn.lorawan.v3.UserRegistry/Update":              omitFields(UserFieldPathsNested, "password", "password_updated_at", "totp_secret", "totp_enabled_at", "totp_recovery_codes", "totp_last_time_step", "totp_failed_attempts", "totp_locked_until", "totp_encrypted_secret", "totp_secret_kek_label"),
+	"/ttn.lorawan.v3.EntityRegistrySearch/SearchUsers": omitFields(UserFieldPathsNested, "password", "temporary_password", "totp_secret", "totp_recovery_codes", "totp_last_time_step", "totp_failed_attempts", "totp_locked_until", "totp_encrypted_secret", "totp_secret_kek_label"),
+
+	// API Keys:
+	"/ttn.lorawan.v3.ApplicationAccess/UpdateAPIKey":  omitFields(APIKeyFieldPathsNested, "id", "key"),
+	"/ttn.lorawan.v3.GatewayAccess/UpdateAPIKey":      omitFields(APIKeyFieldPathsNested, "id", "key"),
+	"/ttn.lorawan.v3.OrganizationAccess/UpdateAPIKey": omitFields(APIKeyFieldPathsNested, "id", "key"),
+	"/ttn.lorawan.v3.UserAccess/UpdateAPIKey":         omitFields(APIKeyFieldPathsNested, "id", "key"),
 }
 
 func omitFields(fields []string, fieldsToOmit ...string) []string {
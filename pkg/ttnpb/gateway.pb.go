@@ -480,8 +480,11 @@ type Gateway struct {
 	DownlinkPathConstraint DownlinkPathConstraint `protobuf:"varint,18,opt,name=downlink_path_constraint,json=downlinkPathConstraint,proto3,enum=ttn.lorawan.v3.DownlinkPathConstraint" json:"downlink_path_constraint,omitempty"`
 	// Adjust the time that GS schedules class C messages in advance. This is useful for gateways that have a known high latency backhaul, like 3G and satellite.
 	ScheduleAnytimeDelay *time.Duration `protobuf:"bytes,19,opt,name=schedule_anytime_delay,json=scheduleAnytimeDelay,proto3,stdduration" json:"schedule_anytime_delay,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	// Update the location of this gateway from status messages. This only works for gateways connecting with
+	// authentication; gateways connected over UDP are not supported.
+	UpdateLocationFromStatus bool     `protobuf:"varint,21,opt,name=update_location_from_status,json=updateLocationFromStatus,proto3" json:"update_location_from_status,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *Gateway) Reset()      { *m = Gateway{} }
@@ -642,6 +645,13 @@ func (m *Gateway) GetScheduleAnytimeDelay() *time.Duration {
 	return nil
 }
 
+func (m *Gateway) GetUpdateLocationFromStatus() bool {
+	if m != nil {
+		return m.UpdateLocationFromStatus
+	}
+	return false
+}
+
 type Gateways struct {
 	Gateways             []*Gateway `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -1050,10 +1060,12 @@ func (m *GetGatewayAPIKeyRequest) GetKeyID() string {
 
 type CreateGatewayAPIKeyRequest struct {
 	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights               []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt            *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedCIDRs         []string   `protobuf:"bytes,5,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CreateGatewayAPIKeyRequest) Reset()      { *m = CreateGatewayAPIKeyRequest{} }
@@ -1102,11 +1114,28 @@ func (m *CreateGatewayAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateGatewayAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateGatewayAPIKeyRequest) GetAllowedCIDRs() []string {
+	if m != nil {
+		return m.AllowedCIDRs
+	}
+	return nil
+}
+
 type UpdateGatewayAPIKeyRequest struct {
-	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
-	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	APIKey             `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
+	// The names of the API key fields that should be updated.
+	// If empty, only the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateGatewayAPIKeyRequest) Reset()      { *m = UpdateGatewayAPIKeyRequest{} }
@@ -1141,6 +1170,13 @@ func (m *UpdateGatewayAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateGatewayAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateGatewayAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type ListGatewayCollaboratorsRequest struct {
 	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
 	// Limit the number of results per page.
@@ -1472,8 +1508,12 @@ type GatewayConnectionStats struct {
 	LastDownlinkReceivedAt *time.Time                             `protobuf:"bytes,7,opt,name=last_downlink_received_at,json=lastDownlinkReceivedAt,proto3,stdtime" json:"last_downlink_received_at,omitempty"`
 	DownlinkCount          uint64                                 `protobuf:"varint,8,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
 	RoundTripTimes         *GatewayConnectionStats_RoundTripTimes `protobuf:"bytes,9,opt,name=round_trip_times,json=roundTripTimes,proto3" json:"round_trip_times,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                               `json:"-"`
-	XXX_sizecache          int32                                  `json:"-"`
+	// Time when the gateway disconnected. This is not set while the gateway is connected.
+	DisconnectedAt *time.Time `protobuf:"bytes,10,opt,name=disconnected_at,json=disconnectedAt,proto3,stdtime" json:"disconnected_at,omitempty"`
+	// Reason why the gateway disconnected.
+	DisconnectReason     *ErrorDetails `protobuf:"bytes,11,opt,name=disconnect_reason,json=disconnectReason,proto3" json:"disconnect_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *GatewayConnectionStats) Reset()      { *m = GatewayConnectionStats{} }
@@ -1571,6 +1611,20 @@ func (m *GatewayConnectionStats) GetRoundTripTimes() *GatewayConnectionStats_Rou
 	return nil
 }
 
+func (m *GatewayConnectionStats) GetDisconnectedAt() *time.Time {
+	if m != nil {
+		return m.DisconnectedAt
+	}
+	return nil
+}
+
+func (m *GatewayConnectionStats) GetDisconnectReason() *ErrorDetails {
+	if m != nil {
+		return m.DisconnectReason
+	}
+	return nil
+}
+
 type GatewayConnectionStats_RoundTripTimes struct {
 	Min                  time.Duration `protobuf:"bytes,1,opt,name=min,proto3,stdduration" json:"min"`
 	Max                  time.Duration `protobuf:"bytes,2,opt,name=max,proto3,stdduration" json:"max"`
@@ -1705,167 +1759,176 @@ func init() {
 }
 
 var fileDescriptor_1df6bae1ac946b39 = []byte{
-	// 2558 bytes of a gzipped FileDescriptorProto
+	// 2689 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x90, 0xa2, 0x44, 0x0d, 0xf5, 0x43, 0x4f, 0x54, 0x65, 0x4d, 0xdb, 0x4b, 0x85, 0x71,
-	0x12, 0xc9, 0x35, 0xa9, 0x96, 0x49, 0x8a, 0x56, 0x6d, 0xa2, 0x70, 0x29, 0x3b, 0x20, 0x62, 0x37,
-	0xee, 0xca, 0x4a, 0xd0, 0xd8, 0xf1, 0x62, 0xb4, 0x3b, 0x24, 0xb7, 0x5a, 0xee, 0xb2, 0xbb, 0xb3,
-	0xb2, 0x98, 0x38, 0x40, 0x50, 0x04, 0x68, 0x10, 0x14, 0x6d, 0xe0, 0x53, 0x50, 0xf4, 0x10, 0x14,
-	0x68, 0x11, 0xb4, 0x3d, 0x04, 0x3d, 0x14, 0x39, 0xf4, 0x90, 0x4b, 0x8b, 0x9c, 0x0a, 0x9f, 0x82,
-	0xa0, 0x05, 0x94, 0x88, 0xba, 0xa4, 0xb7, 0xa0, 0xa7, 0x40, 0xa7, 0x62, 0x66, 0x67, 0x97, 0x4b,
-	0xca, 0x52, 0xac, 0x38, 0x4e, 0x7b, 0xe2, 0xcc, 0x9b, 0xef, 0xfd, 0xcc, 0x7b, 0x6f, 0xdf, 0xcc,
-	0x1b, 0xc2, 0x82, 0xe5, 0xb8, 0xf8, 0x3a, 0xb6, 0x4b, 0x1e, 0xc5, 0xfa, 0xc6, 0x22, 0xee, 0x98,
-	0x8b, 0x4d, 0x4c, 0xc9, 0x75, 0xdc, 0x2d, 0x77, 0x5c, 0x87, 0x3a, 0x68, 0x8a, 0x52, 0xbb, 0x2c,
-	0x40, 0xe5, 0xcd, 0x47, 0xf3, 0xd5, 0xa6, 0x49, 0x5b, 0xfe, 0x7a, 0x59, 0x77, 0xda, 0x8b, 0xc4,
-	0xde, 0x74, 0xba, 0x1d, 0xd7, 0xd9, 0xea, 0x2e, 0x72, 0xb0, 0x5e, 0x6a, 0x12, 0xbb, 0xb4, 0x89,
-	0x2d, 0xd3, 0xc0, 0x94, 0x2c, 0xee, 0x1b, 0x04, 0x22, 0xf3, 0xa5, 0x98, 0x88, 0xa6, 0xd3, 0x74,
-	0x02, 0xe6, 0x75, 0xbf, 0xc1, 0x67, 0x7c, 0xc2, 0x47, 0x02, 0x2e, 0x37, 0x1d, 0xa7, 0x69, 0x91,
-	0x3e, 0xca, 0xf0, 0x5d, 0x4c, 0x4d, 0xc7, 0x16, 0xeb, 0x73, 0xc3, 0xeb, 0x0d, 0x93, 0x58, 0x86,
-	0xd6, 0xc6, 0xde, 0x86, 0x40, 0x9c, 0x1c, 0x46, 0x78, 0xd4, 0xf5, 0x75, 0x2a, 0x56, 0x0b, 0xc3,
-	0xab, 0xd4, 0x6c, 0x13, 0x8f, 0xe2, 0x76, 0x47, 0x00, 0x4e, 0xef, 0xf7, 0x91, 0xee, 0xd8, 0x14,
-	0xeb, 0x54, 0x33, 0xed, 0x46, 0x68, 0xe6, 0xa9, 0xfd, 0x28, 0x62, 0xfb, 0x6d, 0x4f, 0x2c, 0x3f,
-	0xb8, 0x7f, 0xd9, 0x34, 0x88, 0x4d, 0xcd, 0x86, 0x49, 0xdc, 0x10, 0x34, 0xb7, 0x1f, 0xd4, 0x26,
-	0x14, 0x1b, 0x98, 0xe2, 0xd0, 0x19, 0xfb, 0x11, 0xae, 0xd9, 0x6c, 0x51, 0x21, 0xa1, 0xb8, 0x01,
-	0x27, 0x9e, 0x0e, 0xe2, 0xa7, 0xb8, 0xd8, 0x36, 0xd0, 0x2c, 0x4c, 0x9a, 0x86, 0x04, 0xe6, 0xc0,
-	0xfc, 0xb8, 0x32, 0xda, 0xdb, 0x2e, 0x24, 0xeb, 0x2b, 0x6a, 0xd2, 0x34, 0x10, 0x82, 0x23, 0x36,
-	0x6e, 0x13, 0x29, 0xc9, 0x56, 0x54, 0x3e, 0x46, 0xc7, 0x61, 0xca, 0x77, 0x2d, 0x29, 0xc5, 0xc1,
-	0x63, 0xbd, 0xed, 0x42, 0x6a, 0x4d, 0xbd, 0xa0, 0x32, 0x1a, 0x9a, 0x81, 0x69, 0xcb, 0x69, 0x3a,
-	0x9e, 0x34, 0x32, 0x97, 0x9a, 0x1f, 0x57, 0x83, 0x49, 0xf1, 0x5d, 0x10, 0x69, 0xbb, 0xe8, 0x18,
-	0xc4, 0x42, 0x17, 0x61, 0x66, 0x9d, 0xa9, 0xd5, 0x22, 0x9d, 0x95, 0x3d, 0xe5, 0xb4, 0x5b, 0x94,
-	0x4e, 0x57, 0xe4, 0x6b, 0x57, 0x70, 0xe9, 0xa5, 0x6f, 0x95, 0xbe, 0xf7, 0xe2, 0xfc, 0xf2, 0xd2,
-	0x95, 0xd2, 0x8b, 0xcb, 0xe1, 0x74, 0xe1, 0xe5, 0xca, 0xd9, 0x57, 0x4e, 0xf7, 0xb6, 0x0b, 0x63,
-	0xdc, 0xe2, 0xfa, 0x8a, 0x3a, 0xc6, 0x65, 0xd4, 0x0d, 0xf4, 0x04, 0x37, 0x9e, 0x9b, 0xa8, 0x94,
-	0xee, 0x5c, 0xd0, 0xf0, 0x1e, 0x53, 0xfd, 0x3d, 0x16, 0x7f, 0x95, 0x84, 0xc7, 0x85, 0xc9, 0xcf,
-	0x11, 0xd7, 0x33, 0x1d, 0xbb, 0xde, 0x8f, 0xc2, 0x57, 0x6d, 0xff, 0x45, 0x98, 0x69, 0x33, 0xbf,
-	0x68, 0xd1, 0x2e, 0x8e, 0x22, 0x8e, 0xbb, 0x94, 0x89, 0xe3, 0x32, 0xea, 0x06, 0x5a, 0x80, 0xb9,
-	0x16, 0x76, 0x8d, 0xeb, 0xd8, 0x25, 0xda, 0x66, 0x60, 0xbc, 0xd8, 0xdb, 0x74, 0x48, 0x17, 0x7b,
-	0x62, 0xd0, 0x86, 0xe9, 0xb6, 0x07, 0xa0, 0x23, 0x01, 0x34, 0xa4, 0x0b, 0x68, 0xf1, 0x3f, 0xc9,
-	0x28, 0x88, 0x2a, 0x36, 0x4c, 0x07, 0xcd, 0xc2, 0x51, 0x62, 0xe3, 0x75, 0x8b, 0x70, 0x17, 0x64,
-	0x54, 0x31, 0x43, 0x27, 0xe0, 0xb8, 0xde, 0x32, 0x3b, 0x1a, 0xed, 0x76, 0xc2, 0xbc, 0xc9, 0x30,
-	0xc2, 0xe5, 0x6e, 0x87, 0xa0, 0x93, 0x70, 0xbc, 0xe1, 0x92, 0x9f, 0xfa, 0xc4, 0xd6, 0xbb, 0xdc,
-	0xa8, 0x11, 0xb5, 0x4f, 0x40, 0x8b, 0x30, 0xeb, 0x7a, 0x9e, 0xa9, 0x39, 0x8d, 0x86, 0x47, 0x28,
-	0xb7, 0x24, 0xa9, 0x4c, 0xf5, 0xb6, 0x0b, 0x50, 0x5d, 0x5d, 0xad, 0x3f, 0xcb, 0xa9, 0x2a, 0x64,
-	0x90, 0x60, 0x8c, 0x9e, 0x87, 0x39, 0xba, 0xa5, 0xe9, 0x8e, 0xdd, 0x30, 0x9b, 0xe2, 0x6b, 0x97,
-	0xd2, 0x73, 0x60, 0x3e, 0x5b, 0x39, 0x5b, 0x1e, 0x2c, 0x48, 0xe5, 0xb8, 0xed, 0xe5, 0xcb, 0x5b,
-	0xb5, 0x38, 0x8f, 0x3a, 0x4d, 0x07, 0x09, 0xf9, 0xd7, 0x00, 0x9c, 0x1e, 0x02, 0xa1, 0x07, 0xe1,
-	0x64, 0xdb, 0xb4, 0xb5, 0xbe, 0xfd, 0x80, 0xdb, 0x3f, 0xd1, 0x36, 0xed, 0xf3, 0xd1, 0x16, 0x18,
-	0x08, 0x6f, 0xc5, 0x40, 0x49, 0x01, 0xc2, 0x5b, 0x7d, 0xd0, 0x23, 0x70, 0xda, 0x76, 0xa8, 0xde,
-	0xd2, 0x86, 0x7d, 0x31, 0xc5, 0xc9, 0x11, 0xb0, 0xf8, 0x21, 0x80, 0x53, 0x83, 0x69, 0x88, 0x2e,
-	0xc2, 0x94, 0x69, 0x78, 0x5c, 0x77, 0xb6, 0xb2, 0x70, 0xc0, 0x2e, 0xf7, 0xe7, 0xac, 0x92, 0xdb,
-	0x53, 0xd2, 0x6f, 0x80, 0x64, 0x0e, 0x7c, 0xb0, 0x5d, 0x48, 0xdc, 0xda, 0x2e, 0x00, 0x95, 0xc9,
-	0x61, 0x51, 0xec, 0xb4, 0x1c, 0xea, 0x78, 0x52, 0x92, 0x7f, 0xb2, 0x62, 0x86, 0x1e, 0x83, 0xa3,
-	0x2e, 0x73, 0x95, 0x27, 0xa5, 0xe6, 0x52, 0xf3, 0xd9, 0xca, 0xc9, 0xc3, 0xfc, 0xa9, 0x0a, 0x2c,
-	0x7a, 0x00, 0x4e, 0xe8, 0x96, 0xa3, 0x6f, 0x68, 0x9e, 0xe3, 0xbb, 0x3a, 0x91, 0xc6, 0xe6, 0xc0,
-	0xfc, 0xa4, 0x9a, 0xe5, 0xb4, 0x55, 0x4e, 0x5a, 0x1a, 0x79, 0xef, 0xed, 0x42, 0xa2, 0xb8, 0x9b,
-	0x85, 0x63, 0x42, 0x02, 0x3a, 0x1f, 0xdf, 0x51, 0xf1, 0x00, 0x3d, 0x77, 0xb0, 0x95, 0x1a, 0x84,
-	0xba, 0x4b, 0x30, 0x25, 0x86, 0x86, 0x29, 0xf7, 0x7b, 0xb6, 0x92, 0x2f, 0x07, 0x55, 0xbb, 0x1c,
-	0x56, 0xed, 0xf2, 0xe5, 0xb0, 0x6a, 0x2b, 0x19, 0xc6, 0xfe, 0xe6, 0xc7, 0x05, 0xa0, 0x8e, 0x0b,
-	0xbe, 0x2a, 0x65, 0x42, 0xfc, 0x8e, 0x11, 0x0a, 0x49, 0x1d, 0x45, 0x88, 0xe0, 0xab, 0x52, 0x74,
-	0x42, 0x54, 0x94, 0x91, 0xa0, 0x44, 0xee, 0x29, 0x23, 0x6e, 0x52, 0xaa, 0x88, 0xf2, 0x79, 0x06,
-	0x66, 0x0d, 0xe2, 0xe9, 0xae, 0xd9, 0x89, 0xd2, 0x75, 0x5c, 0xc9, 0xec, 0x29, 0x69, 0x37, 0x25,
-	0xdd, 0x9a, 0x56, 0xe3, 0x8b, 0xc8, 0x87, 0x10, 0x53, 0xea, 0x9a, 0xeb, 0x3e, 0x25, 0x9e, 0x34,
-	0xca, 0x23, 0xf1, 0xc8, 0x01, 0x1e, 0x2a, 0x57, 0x23, 0xe4, 0x39, 0x9b, 0xba, 0x5d, 0xe5, 0xec,
-	0x9e, 0xb2, 0xf0, 0x6b, 0xf0, 0x70, 0xf1, 0x8e, 0x2a, 0x89, 0x1a, 0x53, 0x84, 0x9e, 0x84, 0x13,
-	0xf1, 0x93, 0x4b, 0x1a, 0xe3, 0x8a, 0x4f, 0x0c, 0x2b, 0xae, 0x05, 0x98, 0xba, 0xdd, 0x70, 0xd4,
-	0xac, 0xde, 0x9f, 0xa0, 0xab, 0x30, 0x2b, 0xaa, 0x89, 0xc6, 0x22, 0x9b, 0xb9, 0xfb, 0x5c, 0x85,
-	0x9b, 0x21, 0xca, 0x43, 0x7f, 0x03, 0x70, 0x56, 0x5c, 0x3e, 0x34, 0x8f, 0xb8, 0x9b, 0xc4, 0xd5,
-	0xb0, 0x61, 0xb8, 0xc4, 0xf3, 0xa4, 0x71, 0xee, 0xcc, 0x5f, 0x82, 0x3d, 0xe5, 0x0d, 0xe0, 0xfe,
-	0x1c, 0x54, 0x5e, 0x03, 0xd7, 0xe6, 0x97, 0x97, 0xd8, 0x86, 0x71, 0xe9, 0xa5, 0x6a, 0xe9, 0x05,
-	0xb6, 0xdf, 0x1b, 0xb1, 0x71, 0x7f, 0x78, 0xb5, 0xf4, 0xe2, 0x99, 0xd8, 0xc2, 0xc2, 0xd5, 0xf2,
-	0xc2, 0x19, 0xc6, 0x57, 0x2d, 0xbd, 0x20, 0xfc, 0x74, 0x23, 0x36, 0xee, 0x0f, 0x39, 0x5f, 0x7f,
-	0x61, 0x61, 0x7e, 0x79, 0x69, 0xe9, 0x0a, 0x1b, 0xbd, 0xfc, 0xed, 0xb3, 0x8f, 0xbf, 0xb2, 0xb0,
-	0x7c, 0xfa, 0xc6, 0xb5, 0xd3, 0xea, 0x8c, 0x30, 0x77, 0x95, 0x5b, 0x5b, 0x0d, 0x8c, 0x45, 0x05,
-	0x98, 0xc5, 0x3e, 0x75, 0xb4, 0x20, 0x6f, 0x24, 0xc8, 0xab, 0x28, 0x64, 0xa4, 0x35, 0x4e, 0x41,
-	0x0f, 0xc1, 0xa9, 0x60, 0x4d, 0xd3, 0x5b, 0xd8, 0xb6, 0x89, 0x25, 0x65, 0x79, 0x39, 0x9d, 0x0c,
-	0xa8, 0xb5, 0x80, 0x88, 0xce, 0xc3, 0x63, 0x51, 0x1d, 0xd1, 0x3a, 0x16, 0x66, 0x4e, 0x97, 0x26,
-	0xb8, 0x27, 0xf2, 0x41, 0xea, 0x3d, 0xd5, 0xdb, 0x2e, 0x4c, 0x47, 0x55, 0xe5, 0x92, 0x85, 0xed,
-	0xfa, 0x8a, 0x3a, 0xdd, 0x18, 0x20, 0x18, 0xe8, 0x39, 0x88, 0xf6, 0xc9, 0xf1, 0xa4, 0x19, 0x56,
-	0x16, 0x94, 0xf9, 0x3d, 0x25, 0x7d, 0x13, 0x24, 0x73, 0x99, 0x3d, 0x65, 0xfc, 0x26, 0x18, 0x2d,
-	0x86, 0x52, 0x73, 0x43, 0x52, 0x3d, 0x35, 0x37, 0x24, 0xd6, 0x43, 0x4f, 0xc1, 0x0c, 0xb6, 0x29,
-	0xb1, 0x6d, 0xec, 0x49, 0x93, 0x3c, 0x93, 0xe4, 0x03, 0x52, 0xa1, 0x1a, 0xc0, 0x94, 0x11, 0x16,
-	0x77, 0x35, 0xe2, 0x62, 0x45, 0xd5, 0xa3, 0x98, 0xfa, 0x9e, 0xd6, 0xf1, 0xd7, 0x2d, 0x53, 0x97,
-	0xa6, 0xb8, 0xaf, 0x26, 0x02, 0xe2, 0x25, 0x4e, 0x63, 0x45, 0xd5, 0x72, 0x74, 0x5e, 0xaa, 0x43,
-	0xd8, 0x34, 0x87, 0x4d, 0x85, 0x64, 0x01, 0x7c, 0x0c, 0xce, 0x7a, 0x7a, 0x8b, 0x18, 0xbe, 0x45,
-	0x34, 0xc3, 0xb9, 0x6e, 0x5b, 0xa6, 0xbd, 0xa1, 0x59, 0x2c, 0x04, 0x39, 0x8e, 0x9f, 0x09, 0x57,
-	0x57, 0xc4, 0xe2, 0x05, 0x16, 0x8c, 0xb3, 0x10, 0x11, 0xbb, 0xe1, 0xb8, 0x3a, 0xd1, 0x0c, 0x9f,
-	0x76, 0x35, 0xbd, 0xab, 0x5b, 0x44, 0x3a, 0xc6, 0x39, 0x72, 0x62, 0x65, 0xc5, 0xa7, 0xdd, 0x1a,
-	0xa3, 0xa3, 0x9f, 0x40, 0x29, 0x12, 0xdd, 0xc1, 0xb4, 0xc5, 0xce, 0x28, 0x8f, 0xba, 0xd8, 0xb4,
-	0xa9, 0x84, 0xe6, 0xc0, 0xfc, 0x54, 0xe5, 0xe1, 0x61, 0x1f, 0x84, 0xda, 0x2e, 0x61, 0xda, 0xaa,
-	0x45, 0x68, 0x5e, 0x19, 0x7e, 0xc6, 0xbe, 0x05, 0x75, 0xd6, 0xb8, 0x2d, 0x02, 0xfd, 0x38, 0xb6,
-	0x1f, 0x6c, 0x77, 0xd9, 0xb5, 0x54, 0x33, 0x88, 0x85, 0xbb, 0xd2, 0x7d, 0xfc, 0xc3, 0x3b, 0xbe,
-	0xaf, 0x7c, 0xad, 0x88, 0x23, 0x8d, 0x57, 0x2f, 0xf0, 0x16, 0xab, 0x5e, 0xd1, 0xa6, 0xab, 0x81,
-	0x84, 0x15, 0x26, 0x20, 0xff, 0x04, 0x9c, 0x1e, 0xaa, 0x2a, 0x28, 0x07, 0x53, 0x1b, 0x24, 0x38,
-	0xfb, 0xc6, 0x55, 0x36, 0x64, 0x97, 0xbe, 0x4d, 0x6c, 0xf9, 0xe1, 0x61, 0x1f, 0x4c, 0x96, 0x92,
-	0xdf, 0x05, 0xc5, 0x65, 0x98, 0x11, 0x91, 0xf5, 0xd0, 0xa3, 0x30, 0x23, 0xbe, 0x02, 0x56, 0xea,
-	0x59, 0x16, 0xdc, 0x7f, 0xd0, 0x91, 0x12, 0x01, 0x8b, 0x7f, 0x04, 0xf0, 0xd8, 0xd3, 0x84, 0x86,
-	0x0b, 0x2c, 0xb1, 0x3c, 0x8a, 0xd6, 0x60, 0x36, 0xfc, 0xfe, 0xef, 0xf6, 0xe0, 0x80, 0xcd, 0x10,
-	0xe5, 0xa1, 0x65, 0x08, 0xfb, 0x2d, 0xc1, 0x81, 0xe7, 0xc7, 0x79, 0x06, 0xb9, 0x88, 0xbd, 0x0d,
-	0x91, 0xa5, 0xe3, 0x8d, 0x90, 0x50, 0xec, 0xc2, 0x62, 0xdf, 0xd8, 0x98, 0xde, 0xf3, 0x8e, 0x7b,
-	0x6e, 0xad, 0x1e, 0x5a, 0xbf, 0x0a, 0x53, 0xc4, 0x37, 0xb9, 0xd5, 0x13, 0x4a, 0x95, 0xc9, 0xf8,
-	0xe7, 0x76, 0xa1, 0xd2, 0x74, 0xca, 0xb4, 0x45, 0x68, 0xcb, 0xb4, 0x9b, 0x5e, 0xd9, 0x26, 0xf4,
-	0xba, 0xe3, 0x6e, 0x2c, 0x0e, 0x5e, 0xe2, 0x3b, 0x1b, 0xcd, 0x45, 0x76, 0xa9, 0xf2, 0xca, 0xe7,
-	0xd6, 0xea, 0xdf, 0x79, 0x8c, 0x5d, 0xbc, 0x99, 0x58, 0x26, 0xad, 0xf8, 0x61, 0x12, 0xde, 0x77,
-	0xc1, 0xf4, 0x42, 0xe5, 0x5e, 0xa8, 0xec, 0x47, 0xac, 0x92, 0x5b, 0x16, 0x5e, 0x77, 0x5c, 0x4c,
-	0x1d, 0x57, 0xf8, 0xaa, 0x34, 0xec, 0xab, 0x67, 0xdd, 0x26, 0xb6, 0xcd, 0x97, 0x78, 0x52, 0x3c,
-	0xeb, 0xae, 0x79, 0xc4, 0x8d, 0x99, 0xaf, 0x0e, 0x88, 0xb8, 0x6b, 0x37, 0xa1, 0xeb, 0x30, 0xed,
-	0xb8, 0x06, 0x71, 0x45, 0x07, 0x81, 0xf7, 0x94, 0x6b, 0xee, 0x55, 0x35, 0x11, 0xc5, 0x42, 0x33,
-	0x0d, 0x35, 0x5b, 0x8a, 0x4f, 0xc2, 0x31, 0xf1, 0x4d, 0x75, 0xa2, 0x14, 0x9f, 0xf1, 0x23, 0x55,
-	0x4d, 0x97, 0xf8, 0x4f, 0xec, 0xf8, 0x57, 0xb3, 0xa5, 0xd8, 0x24, 0xd0, 0x87, 0x64, 0x98, 0xb6,
-	0xcc, 0xb6, 0x19, 0x5c, 0x2c, 0x27, 0xf9, 0x97, 0x75, 0x26, 0x25, 0x7d, 0x3a, 0xa6, 0x06, 0x64,
-	0xd6, 0x08, 0x74, 0x70, 0x93, 0xf0, 0x23, 0x79, 0x52, 0xe5, 0xe3, 0xe2, 0x5f, 0x01, 0x9c, 0xa9,
-	0x71, 0x49, 0x43, 0x49, 0x58, 0x83, 0x63, 0xc2, 0x10, 0xe1, 0xd4, 0x83, 0xd2, 0xf9, 0x36, 0x59,
-	0x17, 0x72, 0x22, 0x6d, 0x28, 0x3c, 0xc9, 0x2f, 0x11, 0x1e, 0x65, 0x22, 0x2e, 0x7f, 0x30, 0x58,
-	0xc5, 0xdf, 0x00, 0x38, 0x13, 0x9c, 0x26, 0xf7, 0xc2, 0xfc, 0xbb, 0xfe, 0x62, 0x7e, 0x0f, 0xe0,
-	0xf1, 0x58, 0xda, 0x56, 0x2f, 0xd5, 0x9f, 0x21, 0xfd, 0xe4, 0xbd, 0x47, 0xdf, 0x79, 0x94, 0x06,
-	0xc9, 0xc3, 0xd3, 0x20, 0x15, 0x4b, 0x83, 0x9b, 0x00, 0xde, 0xdf, 0xff, 0xb6, 0x03, 0x3b, 0xef,
-	0xb1, 0x99, 0x73, 0x70, 0x74, 0x83, 0x74, 0xfb, 0x3d, 0xe1, 0x78, 0x6f, 0xbb, 0x90, 0x7e, 0x86,
-	0x74, 0xeb, 0x2b, 0x6a, 0x7a, 0x83, 0x74, 0xeb, 0x46, 0xf1, 0x1f, 0x00, 0xe6, 0x07, 0x72, 0xf3,
-	0x6b, 0xb1, 0xeb, 0x44, 0xfc, 0x49, 0x60, 0xf8, 0x72, 0xfb, 0x03, 0x38, 0x1a, 0xbc, 0x33, 0xf0,
-	0xb6, 0x61, 0xaa, 0xf2, 0x8d, 0x61, 0x75, 0x2a, 0x5b, 0x55, 0x26, 0xf7, 0x14, 0x78, 0x13, 0x8c,
-	0x15, 0xc5, 0xc9, 0x26, 0x78, 0x8a, 0x7f, 0x01, 0x30, 0x3f, 0x90, 0xad, 0x5f, 0xcb, 0x86, 0xaa,
-	0x70, 0x0c, 0x77, 0x4c, 0x8d, 0x9d, 0x6a, 0x41, 0x0a, 0xcf, 0x0e, 0x8b, 0x0c, 0xcc, 0xb8, 0x8d,
-	0x98, 0x51, 0xdc, 0x31, 0x9f, 0x21, 0xdd, 0xe2, 0x9f, 0x00, 0x2c, 0xc4, 0xf2, 0xb8, 0x16, 0xfb,
-	0x04, 0xff, 0x1f, 0xb3, 0xf9, 0x5f, 0x00, 0x9e, 0xea, 0x67, 0x73, 0xdc, 0xda, 0x7b, 0x6c, 0xac,
-	0xfe, 0x55, 0xd4, 0xbb, 0xfd, 0x2a, 0x06, 0x6b, 0xde, 0xdf, 0x01, 0x3c, 0xb5, 0xfa, 0xbf, 0xd8,
-	0xdd, 0x0f, 0x6f, 0xbb, 0xbb, 0x93, 0xfb, 0xdb, 0xa6, 0x3e, 0xe6, 0xd0, 0xe2, 0xfd, 0xbb, 0x64,
-	0xd4, 0xfd, 0x8b, 0x9b, 0x31, 0x8b, 0x66, 0x13, 0x9b, 0x36, 0x37, 0x39, 0xa9, 0xf2, 0x31, 0x52,
-	0x60, 0x26, 0xbc, 0xe1, 0x0a, 0x95, 0xd2, 0xb0, 0xca, 0x0b, 0x62, 0x7d, 0x48, 0x5d, 0xc4, 0x87,
-	0x6e, 0x0c, 0x34, 0x9a, 0x41, 0xcb, 0x5f, 0x3e, 0xfc, 0x96, 0xfe, 0xd5, 0xf5, 0x9b, 0x77, 0x7b,
-	0xcd, 0xfc, 0x45, 0x1a, 0x4e, 0x0a, 0xdb, 0x56, 0x79, 0x47, 0x80, 0x9e, 0x82, 0x23, 0xec, 0x12,
-	0x2b, 0x22, 0x7b, 0x58, 0xff, 0xce, 0x22, 0xfa, 0x67, 0x90, 0xcc, 0x80, 0xa8, 0x8f, 0xe7, 0x9c,
-	0xa8, 0x0a, 0xc7, 0xd7, 0x1d, 0x87, 0x6a, 0x5c, 0xcc, 0x51, 0xde, 0x12, 0x32, 0x8c, 0x8d, 0x2d,
-	0x20, 0x1f, 0x66, 0x44, 0xd7, 0x1a, 0x7a, 0xf4, 0x9b, 0x07, 0x78, 0x34, 0xb0, 0xba, 0x2c, 0x3a,
-	0xe1, 0x2f, 0xe5, 0xce, 0x48, 0x15, 0x3a, 0x07, 0x8f, 0x89, 0xc6, 0x49, 0x0b, 0xc3, 0x1b, 0xbc,
-	0xc7, 0x1e, 0x92, 0x17, 0x6a, 0x4e, 0xb0, 0x84, 0x04, 0x8f, 0xbf, 0x08, 0x77, 0xa4, 0x34, 0xef,
-	0xfe, 0x82, 0x17, 0xe1, 0x4b, 0x6a, 0xd2, 0xec, 0x20, 0x17, 0x8e, 0xb5, 0x09, 0x75, 0x4d, 0x3d,
-	0x7c, 0x8f, 0x38, 0x73, 0xf8, 0xa6, 0x2e, 0x06, 0xe0, 0x2f, 0xb3, 0xa7, 0x50, 0x11, 0xeb, 0x1d,
-	0xb0, 0xb1, 0x89, 0x6d, 0x9d, 0x18, 0x92, 0x2e, 0x6e, 0x2b, 0xc3, 0xb1, 0x58, 0xe5, 0x6f, 0xf5,
-	0x6a, 0x04, 0xcc, 0x7f, 0x1f, 0x4e, 0x0e, 0x38, 0xf4, 0x28, 0x29, 0x95, 0x5f, 0x82, 0x13, 0x71,
-	0xc3, 0xbf, 0x88, 0x37, 0x19, 0x4f, 0xc7, 0x8f, 0x47, 0xe1, 0x6c, 0x54, 0x7c, 0x6c, 0x9b, 0xe8,
-	0xcc, 0xa1, 0xcc, 0x1b, 0x1e, 0xaa, 0xf1, 0x87, 0x15, 0x46, 0x0a, 0xde, 0x97, 0xbe, 0x38, 0x3f,
-	0x47, 0x78, 0x52, 0x65, 0x23, 0xae, 0x2a, 0x45, 0x79, 0x98, 0x09, 0xfe, 0x46, 0x71, 0xac, 0xf0,
-	0x7d, 0x35, 0x9c, 0xa3, 0xe7, 0xe1, 0xfd, 0x16, 0xf6, 0xa8, 0x26, 0xda, 0x65, 0x97, 0xe8, 0xc4,
-	0xdc, 0xbc, 0xd3, 0xb7, 0xac, 0x40, 0xd7, 0x0c, 0x13, 0x10, 0x04, 0x4f, 0x15, 0xec, 0x55, 0x8a,
-	0x9e, 0x84, 0xd9, 0x98, 0x60, 0x7e, 0x83, 0xce, 0x56, 0x4e, 0x1d, 0x1a, 0x7a, 0x15, 0xf6, 0x25,
-	0x45, 0x86, 0xf9, 0x1d, 0xde, 0x13, 0xc7, 0x0d, 0x4b, 0x1f, 0xc5, 0xb0, 0x35, 0xce, 0x1f, 0x33,
-	0xec, 0x01, 0x38, 0x21, 0x64, 0xea, 0x8e, 0x6f, 0x53, 0x69, 0x94, 0x3f, 0xa4, 0x66, 0x03, 0x5a,
-	0x8d, 0x91, 0xd0, 0x15, 0x78, 0x9c, 0xeb, 0x8e, 0x3a, 0xf2, 0xb8, 0xf6, 0xb1, 0x3b, 0xd4, 0x3e,
-	0xcb, 0x44, 0x84, 0x3d, 0x7a, 0x4c, 0xff, 0x43, 0x70, 0x2a, 0x92, 0x1b, 0x58, 0x90, 0xe1, 0x16,
-	0x4c, 0x86, 0xd4, 0xc0, 0x06, 0x0d, 0xe6, 0x5c, 0xc7, 0xb7, 0x0d, 0x8d, 0xba, 0x66, 0x87, 0x57,
-	0x95, 0xe0, 0xb5, 0x2a, 0x5b, 0x79, 0xfc, 0x00, 0x27, 0x0e, 0xe5, 0x4e, 0x59, 0x65, 0xec, 0x97,
-	0x5d, 0xb3, 0xc3, 0x2d, 0x53, 0xa7, 0xdc, 0x81, 0x79, 0xfe, 0xdf, 0x00, 0x4e, 0x0d, 0x42, 0xd0,
-	0x13, 0x30, 0xd5, 0x16, 0x67, 0xc5, 0xa1, 0xaf, 0x00, 0xac, 0x06, 0xfe, 0x21, 0xac, 0x81, 0xfc,
-	0x35, 0x80, 0xf1, 0x71, 0x76, 0xbc, 0x25, 0x8a, 0xdf, 0x11, 0xd9, 0xf1, 0x16, 0xaa, 0xc1, 0xd1,
-	0x36, 0x31, 0x4c, 0x6c, 0x8b, 0xcc, 0x3b, 0x92, 0x04, 0xc1, 0xca, 0xbe, 0xb2, 0xc0, 0xa9, 0xbc,
-	0x65, 0x53, 0x83, 0x89, 0xf2, 0x5b, 0xf0, 0xc1, 0x8e, 0x0c, 0x6e, 0xed, 0xc8, 0xe0, 0xa3, 0x1d,
-	0x39, 0xf1, 0xc9, 0x8e, 0x9c, 0xf8, 0x74, 0x47, 0x4e, 0x7c, 0xb6, 0x23, 0x27, 0x3e, 0xdf, 0x91,
-	0xc1, 0xab, 0x3d, 0x19, 0xbc, 0xde, 0x93, 0x13, 0xef, 0xf4, 0x64, 0xf0, 0x6e, 0x4f, 0x4e, 0xbc,
-	0xd7, 0x93, 0x13, 0xef, 0xf7, 0xe4, 0xc4, 0x07, 0x3d, 0x19, 0xdc, 0xea, 0xc9, 0xe0, 0xa3, 0x9e,
-	0x9c, 0xf8, 0xa4, 0x27, 0x83, 0x4f, 0x7b, 0x72, 0xe2, 0xb3, 0x9e, 0x0c, 0x3e, 0xef, 0xc9, 0x89,
-	0x57, 0x77, 0xe5, 0xc4, 0xeb, 0xbb, 0x32, 0x78, 0x73, 0x57, 0x4e, 0xbc, 0xb5, 0x2b, 0x83, 0xb7,
-	0x77, 0xe5, 0xc4, 0x3b, 0xbb, 0x72, 0xe2, 0xdd, 0x5d, 0x19, 0xbc, 0xb7, 0x2b, 0x83, 0xf7, 0x77,
-	0x65, 0xf0, 0xc2, 0xd9, 0x3b, 0x6d, 0xd2, 0xa9, 0xdd, 0x59, 0x5f, 0x1f, 0xe5, 0xfb, 0x7c, 0xf4,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xd7, 0xc7, 0x63, 0x3b, 0x1d, 0x00, 0x00,
+	0xf5, 0xe7, 0x90, 0xfa, 0xa0, 0x86, 0x14, 0x45, 0x4f, 0x1c, 0x65, 0x2d, 0xdb, 0x4b, 0x85, 0x71,
+	0x12, 0xc9, 0x31, 0xa9, 0xff, 0x9f, 0x49, 0x8a, 0x56, 0xad, 0xa3, 0x90, 0x94, 0x6d, 0x10, 0xb1,
+	0x1b, 0x77, 0x65, 0x35, 0x68, 0xec, 0x78, 0x31, 0xda, 0x1d, 0x52, 0x5b, 0x2d, 0x77, 0xd9, 0xd9,
+	0xa1, 0x24, 0x26, 0x0e, 0x10, 0x14, 0x01, 0x1a, 0x04, 0x45, 0x1b, 0xe4, 0x14, 0x14, 0x39, 0x04,
+	0x05, 0x5a, 0x04, 0x6d, 0x0f, 0x41, 0x4f, 0x39, 0xf4, 0x90, 0x4b, 0x8b, 0x1c, 0x7d, 0x28, 0x82,
+	0xa0, 0x05, 0xd4, 0x88, 0xba, 0xa4, 0xb7, 0xa0, 0x40, 0xd1, 0x40, 0xa7, 0x62, 0x66, 0x67, 0x97,
+	0x4b, 0xca, 0x52, 0x25, 0x3b, 0x4e, 0x7b, 0xe2, 0xce, 0x9b, 0xdf, 0xfb, 0x98, 0x37, 0x6f, 0xde,
+	0xbc, 0x79, 0x84, 0x39, 0xdb, 0xa5, 0x78, 0x03, 0x3b, 0x05, 0x8f, 0x61, 0x63, 0x6d, 0x0e, 0xb7,
+	0xac, 0xb9, 0x06, 0x66, 0x64, 0x03, 0x77, 0x8a, 0x2d, 0xea, 0x32, 0x17, 0x65, 0x18, 0x73, 0x8a,
+	0x12, 0x54, 0x5c, 0x7f, 0x72, 0xaa, 0xdc, 0xb0, 0xd8, 0x6a, 0x7b, 0xa5, 0x68, 0xb8, 0xcd, 0x39,
+	0xe2, 0xac, 0xbb, 0x9d, 0x16, 0x75, 0x37, 0x3b, 0x73, 0x02, 0x6c, 0x14, 0x1a, 0xc4, 0x29, 0xac,
+	0x63, 0xdb, 0x32, 0x31, 0x23, 0x73, 0x7b, 0x3e, 0x7c, 0x91, 0x53, 0x85, 0x88, 0x88, 0x86, 0xdb,
+	0x70, 0x7d, 0xe6, 0x95, 0x76, 0x5d, 0x8c, 0xc4, 0x40, 0x7c, 0x49, 0xb8, 0xda, 0x70, 0xdd, 0x86,
+	0x4d, 0x7a, 0x28, 0xb3, 0x4d, 0x31, 0xb3, 0x5c, 0x47, 0xce, 0x4f, 0x0f, 0xce, 0xd7, 0x2d, 0x62,
+	0x9b, 0x7a, 0x13, 0x7b, 0x6b, 0x12, 0x71, 0x6a, 0x10, 0xe1, 0x31, 0xda, 0x36, 0x98, 0x9c, 0xcd,
+	0x0d, 0xce, 0x32, 0xab, 0x49, 0x3c, 0x86, 0x9b, 0x2d, 0x09, 0x38, 0xb3, 0xd7, 0x47, 0x86, 0xeb,
+	0x30, 0x6c, 0x30, 0xdd, 0x72, 0xea, 0x81, 0x99, 0xa7, 0xf7, 0xa2, 0x88, 0xd3, 0x6e, 0x7a, 0x07,
+	0x4c, 0x53, 0xea, 0x52, 0x39, 0xfd, 0xc8, 0xde, 0x69, 0xcb, 0x24, 0x0e, 0xb3, 0xea, 0x16, 0xa1,
+	0x81, 0x8c, 0xe9, 0xbd, 0xa0, 0x26, 0x61, 0xd8, 0xc4, 0x0c, 0x07, 0xbe, 0xda, 0x8b, 0xa0, 0x56,
+	0x63, 0x95, 0x49, 0x09, 0xf9, 0x35, 0x98, 0xbe, 0xe4, 0x6f, 0x6f, 0x85, 0x62, 0xc7, 0x44, 0x93,
+	0x30, 0x6e, 0x99, 0x0a, 0x98, 0x06, 0x33, 0x63, 0x95, 0x91, 0xee, 0x56, 0x2e, 0x5e, 0x5b, 0xd4,
+	0xe2, 0x96, 0x89, 0x10, 0x1c, 0x72, 0x70, 0x93, 0x28, 0x71, 0x3e, 0xa3, 0x89, 0x6f, 0x74, 0x02,
+	0x26, 0xda, 0xd4, 0x56, 0x12, 0x02, 0x3c, 0xda, 0xdd, 0xca, 0x25, 0x96, 0xb5, 0xcb, 0x1a, 0xa7,
+	0xa1, 0xe3, 0x70, 0xd8, 0x76, 0x1b, 0xae, 0xa7, 0x0c, 0x4d, 0x27, 0x66, 0xc6, 0x34, 0x7f, 0x90,
+	0xff, 0x00, 0x84, 0xda, 0xae, 0xb8, 0x26, 0xb1, 0xd1, 0x15, 0x98, 0x5c, 0xe1, 0x6a, 0xf5, 0x50,
+	0x67, 0x69, 0xb7, 0x72, 0x86, 0xe6, 0x95, 0x33, 0x25, 0xf5, 0xe6, 0x75, 0x5c, 0x78, 0xf9, 0xff,
+	0x0a, 0xdf, 0x7a, 0x69, 0x66, 0x61, 0xfe, 0x7a, 0xe1, 0xa5, 0x85, 0x60, 0x38, 0xfb, 0x4a, 0xe9,
+	0xdc, 0xab, 0x67, 0xba, 0x5b, 0xb9, 0x51, 0x61, 0x71, 0x6d, 0x51, 0x1b, 0x15, 0x32, 0x6a, 0x26,
+	0x3a, 0x2f, 0x8c, 0x17, 0x26, 0x56, 0x0a, 0x87, 0x17, 0x34, 0xb8, 0xc6, 0x44, 0x6f, 0x8d, 0xf9,
+	0x9f, 0xc7, 0xe1, 0x09, 0x69, 0xf2, 0xf7, 0x09, 0xf5, 0x2c, 0xd7, 0xa9, 0xf5, 0x76, 0xe1, 0xab,
+	0xb6, 0xff, 0x0a, 0x4c, 0x36, 0xb9, 0x5f, 0xf4, 0x70, 0x15, 0x47, 0x11, 0x27, 0x5c, 0xca, 0xc5,
+	0x09, 0x19, 0x35, 0x13, 0xcd, 0xc2, 0xec, 0x2a, 0xa6, 0xe6, 0x06, 0xa6, 0x44, 0x5f, 0xf7, 0x8d,
+	0x97, 0x6b, 0x9b, 0x08, 0xe8, 0x72, 0x4d, 0x1c, 0x5a, 0xb7, 0x68, 0xb3, 0x0f, 0x3a, 0xe4, 0x43,
+	0x03, 0xba, 0x84, 0xe6, 0xff, 0x11, 0x0f, 0x37, 0x51, 0xc3, 0xa6, 0xe5, 0xa2, 0x49, 0x38, 0x42,
+	0x1c, 0xbc, 0x62, 0x13, 0xe1, 0x82, 0xa4, 0x26, 0x47, 0xe8, 0x24, 0x1c, 0x33, 0x56, 0xad, 0x96,
+	0xce, 0x3a, 0xad, 0x20, 0x6e, 0x92, 0x9c, 0x70, 0xad, 0xd3, 0x22, 0xe8, 0x14, 0x1c, 0xab, 0x53,
+	0xf2, 0xa3, 0x36, 0x71, 0x8c, 0x8e, 0x30, 0x6a, 0x48, 0xeb, 0x11, 0xd0, 0x1c, 0x4c, 0x51, 0xcf,
+	0xb3, 0x74, 0xb7, 0x5e, 0xf7, 0x08, 0x13, 0x96, 0xc4, 0x2b, 0x99, 0xee, 0x56, 0x0e, 0x6a, 0x4b,
+	0x4b, 0xb5, 0xe7, 0x05, 0x55, 0x83, 0x1c, 0xe2, 0x7f, 0xa3, 0x17, 0x60, 0x96, 0x6d, 0xea, 0x86,
+	0xeb, 0xd4, 0xad, 0x86, 0x4c, 0x06, 0xca, 0xf0, 0x34, 0x98, 0x49, 0x95, 0xce, 0x15, 0xfb, 0xf3,
+	0x55, 0x31, 0x6a, 0x7b, 0xf1, 0xda, 0x66, 0x35, 0xca, 0xa3, 0x4d, 0xb0, 0x7e, 0xc2, 0xd4, 0xeb,
+	0x00, 0x4e, 0x0c, 0x80, 0xd0, 0x23, 0x70, 0xbc, 0x69, 0x39, 0x7a, 0xcf, 0x7e, 0x20, 0xec, 0x4f,
+	0x37, 0x2d, 0xe7, 0x62, 0xb8, 0x04, 0x0e, 0xc2, 0x9b, 0x11, 0x50, 0x5c, 0x82, 0xf0, 0x66, 0x0f,
+	0xf4, 0x38, 0x9c, 0x70, 0x5c, 0x66, 0xac, 0xea, 0x83, 0xbe, 0xc8, 0x08, 0x72, 0x08, 0xcc, 0x7f,
+	0x02, 0x60, 0xa6, 0x3f, 0x0c, 0xd1, 0x15, 0x98, 0xb0, 0x4c, 0x4f, 0xe8, 0x4e, 0x95, 0x66, 0xf7,
+	0x59, 0xe5, 0xde, 0x98, 0xad, 0x64, 0x77, 0x2b, 0xc3, 0x6f, 0x82, 0x78, 0x16, 0x7c, 0xbc, 0x95,
+	0x8b, 0xdd, 0xde, 0xca, 0x01, 0x8d, 0xcb, 0xe1, 0xbb, 0xd8, 0x5a, 0x75, 0x99, 0xeb, 0x29, 0x71,
+	0x71, 0x64, 0xe5, 0x08, 0x3d, 0x05, 0x47, 0x28, 0x77, 0x95, 0xa7, 0x24, 0xa6, 0x13, 0x33, 0xa9,
+	0xd2, 0xa9, 0x83, 0xfc, 0xa9, 0x49, 0x2c, 0x7a, 0x18, 0xa6, 0x0d, 0xdb, 0x35, 0xd6, 0x74, 0xcf,
+	0x6d, 0x53, 0x83, 0x28, 0xa3, 0xd3, 0x60, 0x66, 0x5c, 0x4b, 0x09, 0xda, 0x92, 0x20, 0xcd, 0x0f,
+	0x7d, 0xf8, 0x5e, 0x2e, 0x96, 0x7f, 0x37, 0x0d, 0x47, 0xa5, 0x04, 0x74, 0x31, 0xba, 0xa2, 0xfc,
+	0x3e, 0x7a, 0x0e, 0xb1, 0x94, 0x2a, 0x84, 0x06, 0x25, 0x98, 0x11, 0x53, 0xc7, 0x4c, 0xf8, 0x3d,
+	0x55, 0x9a, 0x2a, 0xfa, 0x49, 0xbd, 0x18, 0x24, 0xf5, 0xe2, 0xb5, 0x20, 0xa9, 0x57, 0x92, 0x9c,
+	0xfd, 0xad, 0xbf, 0xe5, 0x80, 0x36, 0x26, 0xf9, 0xca, 0x8c, 0x0b, 0x69, 0xb7, 0xcc, 0x40, 0x48,
+	0xe2, 0x28, 0x42, 0x24, 0x5f, 0x99, 0xa1, 0x93, 0x32, 0xa3, 0x0c, 0xf9, 0x29, 0x72, 0xb7, 0x32,
+	0x44, 0xe3, 0x4a, 0x49, 0xa6, 0xcf, 0xb3, 0x30, 0x65, 0x12, 0xcf, 0xa0, 0x56, 0x2b, 0x0c, 0xd7,
+	0xb1, 0x4a, 0x72, 0xb7, 0x32, 0x4c, 0x13, 0xca, 0xed, 0x09, 0x2d, 0x3a, 0x89, 0xda, 0x10, 0x62,
+	0xc6, 0xa8, 0xb5, 0xd2, 0x66, 0xc4, 0x53, 0x46, 0xc4, 0x4e, 0x3c, 0xbe, 0x8f, 0x87, 0x8a, 0xe5,
+	0x10, 0x79, 0xc1, 0x61, 0xb4, 0x53, 0x39, 0xb7, 0x5b, 0x99, 0xfd, 0x05, 0x78, 0x2c, 0x7f, 0xa8,
+	0x4c, 0xa2, 0x45, 0x14, 0xa1, 0x67, 0x60, 0x3a, 0x7a, 0xb1, 0x29, 0xa3, 0x42, 0xf1, 0xc9, 0x41,
+	0xc5, 0x55, 0x1f, 0x53, 0x73, 0xea, 0xae, 0x96, 0x32, 0x7a, 0x03, 0x74, 0x03, 0xa6, 0x64, 0x36,
+	0xd1, 0xf9, 0xce, 0x26, 0xef, 0x3d, 0x56, 0xe1, 0x7a, 0x80, 0xf2, 0xd0, 0x1f, 0x01, 0x9c, 0x94,
+	0xb5, 0x89, 0xee, 0x11, 0xba, 0x4e, 0xa8, 0x8e, 0x4d, 0x93, 0x12, 0xcf, 0x53, 0xc6, 0x84, 0x33,
+	0x7f, 0x06, 0x76, 0x2b, 0x6f, 0x02, 0xfa, 0x13, 0x50, 0x7a, 0x1d, 0xdc, 0x9c, 0x59, 0x98, 0xe7,
+	0x0b, 0xc6, 0x85, 0x97, 0xcb, 0x85, 0x17, 0xf9, 0x7a, 0x6f, 0x45, 0xbe, 0x7b, 0x9f, 0x37, 0x0a,
+	0x2f, 0x9d, 0x8d, 0x4c, 0xcc, 0xde, 0x28, 0xce, 0x9e, 0xe5, 0x7c, 0xe5, 0xc2, 0x8b, 0xd2, 0x4f,
+	0xb7, 0x22, 0xdf, 0xbd, 0x4f, 0xc1, 0xd7, 0x9b, 0x98, 0x9d, 0x59, 0x98, 0x9f, 0xbf, 0xce, 0xbf,
+	0x5e, 0xf9, 0xff, 0x73, 0x4f, 0xbf, 0x3a, 0xbb, 0x70, 0xe6, 0xd6, 0xcd, 0x33, 0xda, 0x71, 0x69,
+	0xee, 0x92, 0xb0, 0xb6, 0xec, 0x1b, 0x8b, 0x72, 0x30, 0x85, 0xdb, 0xcc, 0xd5, 0xfd, 0xb8, 0x51,
+	0xa0, 0xc8, 0xa2, 0x90, 0x93, 0x96, 0x05, 0x05, 0x3d, 0x0a, 0x33, 0xfe, 0x9c, 0x6e, 0xac, 0x62,
+	0xc7, 0x21, 0xb6, 0x92, 0x12, 0xe9, 0x74, 0xdc, 0xa7, 0x56, 0x7d, 0x22, 0xba, 0x08, 0x8f, 0x85,
+	0x79, 0x44, 0x6f, 0xd9, 0x98, 0x3b, 0x5d, 0x49, 0x0b, 0x4f, 0x4c, 0xf9, 0xa1, 0xf7, 0x6c, 0x77,
+	0x2b, 0x37, 0x11, 0x66, 0x95, 0xab, 0x36, 0x76, 0x6a, 0x8b, 0xda, 0x44, 0xbd, 0x8f, 0x60, 0xa2,
+	0xab, 0x10, 0xed, 0x91, 0xe3, 0x29, 0xc7, 0x79, 0x5a, 0xa8, 0xe4, 0x77, 0x2b, 0xa9, 0xb7, 0x41,
+	0x32, 0x9b, 0xcc, 0x07, 0xf2, 0xb2, 0x03, 0xf2, 0x3c, 0x2d, 0x3b, 0x20, 0xd0, 0x43, 0xcf, 0xc2,
+	0x24, 0x76, 0x18, 0x71, 0x1c, 0xec, 0x29, 0xe3, 0x22, 0x86, 0xd4, 0x7d, 0x82, 0xa0, 0xec, 0xc3,
+	0x2a, 0x43, 0x7c, 0xc7, 0xb5, 0x90, 0x8b, 0xa7, 0x53, 0x8f, 0x61, 0xd6, 0xf6, 0xf4, 0x56, 0x7b,
+	0xc5, 0xb6, 0x0c, 0x25, 0x23, 0xbc, 0x94, 0xf6, 0x89, 0x57, 0x05, 0x8d, 0xa7, 0x53, 0xdb, 0x35,
+	0x44, 0x92, 0x0e, 0x60, 0x13, 0x02, 0x96, 0x09, 0xc8, 0x12, 0xf8, 0x14, 0x9c, 0xf4, 0x8c, 0x55,
+	0x62, 0xb6, 0x6d, 0xa2, 0x9b, 0xee, 0x86, 0x63, 0x5b, 0xce, 0x9a, 0x6e, 0x73, 0xe7, 0x67, 0x05,
+	0xfe, 0x78, 0x30, 0xbb, 0x28, 0x27, 0x2f, 0xf3, 0x6d, 0x38, 0x07, 0x11, 0x71, 0xea, 0x2e, 0x35,
+	0x88, 0x6e, 0xb6, 0x59, 0x47, 0x37, 0x3a, 0x86, 0x4d, 0x94, 0x63, 0x82, 0x23, 0x2b, 0x67, 0x16,
+	0xdb, 0xac, 0x53, 0xe5, 0x74, 0xf4, 0x43, 0xa8, 0x84, 0xa2, 0x5b, 0x98, 0xad, 0xf2, 0xdb, 0xc9,
+	0x63, 0x14, 0x5b, 0x0e, 0x53, 0xd0, 0x34, 0x98, 0xc9, 0x94, 0x1e, 0x1b, 0xf4, 0x41, 0xa0, 0xed,
+	0x2a, 0x66, 0xab, 0xd5, 0x10, 0x2d, 0x72, 0xc2, 0x8f, 0xf9, 0x29, 0xd0, 0x26, 0xcd, 0x3b, 0x22,
+	0xd0, 0x0f, 0x22, 0xeb, 0xc1, 0x4e, 0x87, 0xd7, 0xab, 0xba, 0x49, 0x6c, 0xdc, 0x51, 0x1e, 0x10,
+	0x47, 0xee, 0xc4, 0x9e, 0xc4, 0xb5, 0x28, 0x2f, 0x33, 0x91, 0xb7, 0xc0, 0x3b, 0x3c, 0x6f, 0x85,
+	0x8b, 0x2e, 0xfb, 0x12, 0x16, 0xb9, 0x00, 0x74, 0x1e, 0x9e, 0x94, 0xb1, 0x17, 0xba, 0xb6, 0x4e,
+	0xdd, 0xa6, 0xee, 0x3b, 0x5e, 0x79, 0x50, 0xac, 0x5e, 0xf1, 0x21, 0x97, 0x25, 0xe2, 0x22, 0x75,
+	0x9b, 0x4b, 0x62, 0x7e, 0xea, 0x3c, 0x9c, 0x18, 0x48, 0x47, 0x28, 0x0b, 0x13, 0x6b, 0xc4, 0xbf,
+	0x34, 0xc7, 0x34, 0xfe, 0xc9, 0xab, 0xc5, 0x75, 0x6c, 0xb7, 0x83, 0x2a, 0xc1, 0x1f, 0xcc, 0xc7,
+	0xbf, 0x09, 0xf2, 0x0b, 0x30, 0x29, 0x03, 0xc3, 0x43, 0x4f, 0xc2, 0xa4, 0x3c, 0x3e, 0xfc, 0x8e,
+	0xe0, 0x41, 0xf4, 0xd0, 0x7e, 0x77, 0x51, 0x08, 0xcc, 0xff, 0x16, 0xc0, 0x63, 0x97, 0x08, 0x0b,
+	0x26, 0x78, 0x5c, 0x7a, 0x0c, 0x2d, 0xc3, 0x54, 0x90, 0x38, 0xee, 0xf5, 0xc6, 0x81, 0x8d, 0x00,
+	0xe5, 0xa1, 0x05, 0x08, 0x7b, 0x4f, 0x8d, 0x7d, 0x2f, 0x9e, 0x8b, 0x1c, 0x72, 0x05, 0x7b, 0x6b,
+	0x32, 0xc8, 0xc7, 0xea, 0x01, 0x21, 0xdf, 0x81, 0xf9, 0x9e, 0xb1, 0x11, 0xbd, 0x17, 0x5d, 0x7a,
+	0x61, 0xb9, 0x16, 0x58, 0xbf, 0x04, 0x13, 0xa4, 0x6d, 0x09, 0xab, 0xd3, 0x95, 0x32, 0x97, 0xf1,
+	0x97, 0xad, 0x5c, 0xa9, 0xe1, 0x16, 0xd9, 0x2a, 0x61, 0xab, 0x96, 0xd3, 0xf0, 0x8a, 0x0e, 0x61,
+	0x1b, 0x2e, 0x5d, 0x9b, 0xeb, 0xaf, 0xfe, 0x5b, 0x6b, 0x8d, 0x39, 0x5e, 0x8d, 0x79, 0xc5, 0x0b,
+	0xcb, 0xb5, 0x6f, 0x3c, 0xc5, 0x2b, 0x76, 0x2e, 0x96, 0x4b, 0xcb, 0x7f, 0x12, 0x87, 0x0f, 0x5c,
+	0xb6, 0xbc, 0x40, 0xb9, 0x17, 0x28, 0xfb, 0x1e, 0xbf, 0x02, 0x6c, 0x1b, 0xaf, 0xb8, 0x14, 0x33,
+	0x97, 0x4a, 0x5f, 0x15, 0x06, 0x7d, 0xf5, 0x3c, 0x6d, 0x60, 0xc7, 0x7a, 0x59, 0x6c, 0xff, 0xf3,
+	0x74, 0xd9, 0x23, 0x34, 0x62, 0xbe, 0xd6, 0x27, 0xe2, 0x9e, 0xdd, 0x84, 0x36, 0xe0, 0xb0, 0x4b,
+	0x4d, 0x42, 0xe5, 0xd3, 0x03, 0xef, 0x56, 0x6e, 0xd2, 0x1b, 0x5a, 0x2c, 0xdc, 0x0b, 0xdd, 0x32,
+	0xb5, 0x54, 0x21, 0x3a, 0x08, 0xbe, 0x49, 0xdb, 0xd2, 0xd2, 0x85, 0xe8, 0x48, 0xdc, 0xc5, 0xda,
+	0x70, 0x41, 0xfc, 0x44, 0xea, 0x06, 0x2d, 0x55, 0x88, 0x0c, 0x7c, 0x7d, 0x48, 0x85, 0xc3, 0xb6,
+	0xd5, 0xb4, 0xfc, 0x8a, 0x74, 0x5c, 0x1c, 0xcc, 0xb3, 0x09, 0xe5, 0xf3, 0x51, 0xcd, 0x27, 0xf3,
+	0x17, 0x44, 0x0b, 0x37, 0x88, 0xb8, 0xcb, 0xc7, 0x35, 0xf1, 0x9d, 0xff, 0x03, 0x80, 0xc7, 0xab,
+	0x42, 0xd2, 0x40, 0x10, 0x56, 0xe1, 0xa8, 0x34, 0x44, 0x3a, 0x75, 0xbf, 0x70, 0xbe, 0x43, 0xd4,
+	0x05, 0x9c, 0x48, 0x1f, 0xd8, 0x9e, 0xf8, 0x5d, 0x6c, 0x4f, 0x25, 0x1d, 0x95, 0xdf, 0xbf, 0x59,
+	0xf9, 0x77, 0x01, 0x3c, 0xee, 0x5f, 0x43, 0xf7, 0xc3, 0xfc, 0x7b, 0x3e, 0x31, 0xbf, 0x06, 0xf0,
+	0x44, 0x24, 0x6c, 0xcb, 0x57, 0x6b, 0xcf, 0x91, 0x8e, 0x77, 0x9f, 0xcf, 0x79, 0x18, 0x06, 0xf1,
+	0x83, 0xc3, 0x20, 0x11, 0x09, 0x83, 0xb7, 0x01, 0x7c, 0xe8, 0x12, 0xe9, 0xb7, 0xf3, 0x3e, 0x9b,
+	0x39, 0x0d, 0x47, 0xd6, 0x48, 0xa7, 0xf7, 0x98, 0x1c, 0xeb, 0x6e, 0xe5, 0x86, 0x9f, 0x23, 0x9d,
+	0xda, 0xa2, 0x36, 0xbc, 0x46, 0x3a, 0x35, 0x33, 0xff, 0xe7, 0x38, 0x9c, 0xea, 0x8b, 0xcd, 0xaf,
+	0xc5, 0xae, 0x93, 0xd1, 0x5e, 0xc2, 0x60, 0x55, 0xfc, 0x1d, 0x38, 0xe2, 0x37, 0x28, 0xc4, 0x7b,
+	0x23, 0x53, 0x7a, 0x70, 0x50, 0x9d, 0xc6, 0x67, 0x2b, 0xe3, 0xbb, 0x15, 0xf8, 0x36, 0x18, 0xcd,
+	0xcb, 0x8b, 0x51, 0xf2, 0xf0, 0x78, 0x22, 0x9b, 0x2d, 0x8b, 0x12, 0x4f, 0xc7, 0xfe, 0x29, 0x3d,
+	0xb8, 0x6a, 0x1f, 0xf2, 0x2b, 0x76, 0xc9, 0x23, 0xca, 0xfe, 0x71, 0x6c, 0xdb, 0xee, 0x06, 0x31,
+	0x75, 0xc3, 0x32, 0xa9, 0xa7, 0x0c, 0x8b, 0xb2, 0x47, 0x95, 0x65, 0x4f, 0x56, 0x94, 0x3d, 0x4f,
+	0x74, 0xb7, 0x72, 0xe9, 0xb2, 0x0f, 0xab, 0xd6, 0x16, 0x35, 0x4f, 0x4b, 0x4b, 0xa6, 0x2a, 0xe7,
+	0xc9, 0xff, 0x0b, 0xc0, 0xa9, 0xbe, 0x33, 0xf3, 0xb5, 0xb8, 0xb5, 0x0c, 0x47, 0x71, 0xcb, 0xd2,
+	0xf9, 0xdd, 0xea, 0x1f, 0xa4, 0xc9, 0x41, 0x91, 0xbe, 0x19, 0x77, 0x10, 0x33, 0x82, 0x5b, 0xd6,
+	0x73, 0x64, 0xf0, 0x38, 0x26, 0x8e, 0x7e, 0x1c, 0x7f, 0x07, 0x60, 0x2e, 0x72, 0x1c, 0xab, 0x91,
+	0x4c, 0xf2, 0xbf, 0x78, 0x28, 0xff, 0x0a, 0xe0, 0xe9, 0x4b, 0xe4, 0x4e, 0xd6, 0xde, 0x67, 0x63,
+	0x8d, 0xaf, 0x22, 0x6d, 0xef, 0x55, 0xd1, 0x9f, 0xba, 0xff, 0x04, 0xe0, 0xe9, 0xa5, 0xff, 0xc6,
+	0xea, 0xbe, 0x7b, 0xc7, 0xd5, 0x9d, 0xda, 0xfb, 0x6c, 0xec, 0x61, 0x0e, 0xbc, 0x83, 0x7e, 0x15,
+	0x87, 0x99, 0xfe, 0xf7, 0x01, 0xdf, 0xcd, 0x06, 0xb6, 0x1c, 0x61, 0x72, 0x5c, 0x13, 0xdf, 0xa8,
+	0x02, 0x93, 0x41, 0x8d, 0x2a, 0x55, 0x2a, 0x83, 0x2a, 0x83, 0x0a, 0x75, 0x40, 0x5d, 0xc8, 0x87,
+	0x6e, 0xf5, 0x3d, 0xb4, 0xfd, 0x96, 0x47, 0xf1, 0xe0, 0xb7, 0xca, 0x57, 0xf7, 0xde, 0xbe, 0xd7,
+	0x6a, 0xf9, 0xa7, 0xc3, 0x70, 0x5c, 0xda, 0xe6, 0x97, 0xdf, 0xe8, 0x59, 0x38, 0xc4, 0x4b, 0x79,
+	0x05, 0xec, 0x73, 0x94, 0x7b, 0x99, 0x90, 0xef, 0xe8, 0xef, 0x41, 0x3c, 0x09, 0xc2, 0x3e, 0x86,
+	0xe0, 0x44, 0x65, 0x38, 0xb6, 0xe2, 0xba, 0x4c, 0x17, 0x62, 0x8e, 0xd2, 0x4b, 0x49, 0x72, 0x36,
+	0x3e, 0x81, 0xda, 0x30, 0x29, 0x5f, 0xed, 0x81, 0x47, 0x9f, 0xd8, 0xc7, 0xa3, 0xbe, 0xd5, 0x45,
+	0xd9, 0x09, 0xb8, 0x2b, 0x77, 0x86, 0xaa, 0xd0, 0x05, 0x78, 0x4c, 0x3e, 0x1f, 0xc3, 0xa7, 0x8b,
+	0xdf, 0x8f, 0x3e, 0x20, 0x2e, 0xb4, 0xac, 0x64, 0x09, 0x08, 0x9e, 0xe8, 0x88, 0xb7, 0xe4, 0x35,
+	0xe0, 0x77, 0xc4, 0xaf, 0x6a, 0x71, 0xab, 0x85, 0x28, 0x1c, 0x6d, 0x12, 0x46, 0x2d, 0x23, 0xe8,
+	0xc7, 0x9c, 0x3d, 0x78, 0x51, 0x57, 0x7c, 0xf0, 0xdd, 0xac, 0x29, 0x50, 0xc4, 0x9f, 0x40, 0xd8,
+	0x5c, 0xc7, 0x8e, 0x41, 0x4c, 0xc5, 0x90, 0x45, 0xd7, 0xe0, 0x5e, 0x2c, 0x89, 0xbf, 0x32, 0xb4,
+	0x10, 0x38, 0xf5, 0x6d, 0x38, 0xde, 0xe7, 0xd0, 0xa3, 0x84, 0xd4, 0xd4, 0x3c, 0x4c, 0x47, 0x0d,
+	0xff, 0x4f, 0xbc, 0xf1, 0x68, 0x38, 0xfe, 0x73, 0x14, 0x4e, 0x86, 0xc9, 0xc7, 0x71, 0x88, 0xc1,
+	0x1d, 0xca, 0xbd, 0xc1, 0x5b, 0x74, 0x69, 0xc3, 0x27, 0xf9, 0xfd, 0x35, 0x70, 0xc8, 0x9b, 0x3a,
+	0x15, 0x72, 0x95, 0x19, 0x9a, 0x82, 0x49, 0x01, 0x34, 0x5c, 0x3b, 0xe8, 0x2f, 0x07, 0x63, 0xf4,
+	0x02, 0x7c, 0xc8, 0xc6, 0x1e, 0x93, 0xcf, 0x54, 0x9d, 0x12, 0x83, 0x58, 0xeb, 0x87, 0xed, 0xe5,
+	0xf9, 0xba, 0x8e, 0x73, 0x01, 0xfe, 0xe6, 0x69, 0x92, 0xbd, 0xcc, 0xd0, 0x33, 0x30, 0x15, 0x11,
+	0x2c, 0x4b, 0x8c, 0xd3, 0x07, 0x6e, 0xbd, 0x06, 0x7b, 0x92, 0x42, 0xc3, 0xda, 0x2d, 0xd1, 0x19,
+	0x88, 0x1a, 0x36, 0x7c, 0x14, 0xc3, 0x96, 0x05, 0x7f, 0xc4, 0xb0, 0x87, 0x61, 0x5a, 0xca, 0x34,
+	0xdc, 0xb6, 0xc3, 0x94, 0x11, 0xd1, 0x48, 0x4e, 0xf9, 0xb4, 0x2a, 0x27, 0xa1, 0xeb, 0xf0, 0x84,
+	0xd0, 0x1d, 0xf6, 0x25, 0xa2, 0xda, 0x47, 0x0f, 0xa9, 0x7d, 0x92, 0x8b, 0x08, 0x3a, 0x15, 0x11,
+	0xfd, 0x8f, 0xc2, 0x4c, 0x28, 0xd7, 0xb7, 0x20, 0x29, 0x2c, 0x18, 0x0f, 0xa8, 0xbe, 0x0d, 0x3a,
+	0xcc, 0x52, 0xb7, 0xed, 0x98, 0x3a, 0xa3, 0xfc, 0xbf, 0x01, 0x2e, 0x5c, 0x74, 0xeb, 0x52, 0xa5,
+	0xa7, 0xf7, 0x71, 0xe2, 0x40, 0xec, 0x14, 0x35, 0xce, 0x7e, 0x8d, 0x5a, 0x2d, 0x61, 0x99, 0x96,
+	0xa1, 0x7d, 0x63, 0x54, 0x83, 0x13, 0xa6, 0xe5, 0xf5, 0x45, 0x17, 0x3c, 0xe4, 0xd2, 0x32, 0x51,
+	0xc6, 0x32, 0x43, 0x35, 0x78, 0xac, 0x47, 0xd1, 0x29, 0xc1, 0x9e, 0xeb, 0x28, 0xa9, 0x3b, 0x5f,
+	0x66, 0x17, 0xf8, 0x7f, 0x77, 0x8b, 0x84, 0x61, 0xcb, 0xf6, 0xb4, 0x6c, 0x8f, 0x4d, 0x13, 0x5c,
+	0x53, 0x7f, 0x07, 0x30, 0xd3, 0x6f, 0x38, 0x3a, 0x0f, 0x13, 0x4d, 0x79, 0x83, 0x1d, 0xd8, 0xa1,
+	0xe1, 0x99, 0xf9, 0x37, 0x41, 0x66, 0x16, 0x9d, 0x1a, 0xce, 0x27, 0xd8, 0xf1, 0xa6, 0x12, 0xbf,
+	0x1b, 0x76, 0xbc, 0x89, 0xaa, 0x70, 0xa4, 0x49, 0x4c, 0x0b, 0x3b, 0x4a, 0xe2, 0xe8, 0x12, 0x24,
+	0x2b, 0x3f, 0xfb, 0xfe, 0x56, 0x8b, 0xf7, 0xb0, 0xe6, 0x0f, 0x2a, 0xbf, 0x04, 0x1f, 0x6f, 0xab,
+	0xe0, 0xf6, 0xb6, 0x0a, 0x3e, 0xdd, 0x56, 0x63, 0x9f, 0x6d, 0xab, 0xb1, 0xcf, 0xb7, 0xd5, 0xd8,
+	0x17, 0xdb, 0x6a, 0xec, 0xcb, 0x6d, 0x15, 0xbc, 0xd6, 0x55, 0xc1, 0x1b, 0x5d, 0x35, 0xf6, 0x7e,
+	0x57, 0x05, 0x1f, 0x74, 0xd5, 0xd8, 0x87, 0x5d, 0x35, 0xf6, 0x51, 0x57, 0x8d, 0x7d, 0xdc, 0x55,
+	0xc1, 0xed, 0xae, 0x0a, 0x3e, 0xed, 0xaa, 0xb1, 0xcf, 0xba, 0x2a, 0xf8, 0xbc, 0xab, 0xc6, 0xbe,
+	0xe8, 0xaa, 0xe0, 0xcb, 0xae, 0x1a, 0x7b, 0x6d, 0x47, 0x8d, 0xbd, 0xb1, 0xa3, 0x82, 0xb7, 0x76,
+	0xd4, 0xd8, 0x3b, 0x3b, 0x2a, 0x78, 0x6f, 0x47, 0x8d, 0xbd, 0xbf, 0xa3, 0xc6, 0x3e, 0xd8, 0x51,
+	0xc1, 0x87, 0x3b, 0x2a, 0xf8, 0x68, 0x47, 0x05, 0x2f, 0x9e, 0x3b, 0x6c, 0x07, 0x84, 0x39, 0xad,
+	0x95, 0x95, 0x11, 0xb1, 0xce, 0x27, 0xff, 0x3d, 0x00, 0x32, 0xc7, 0x25, 0x8c, 0xf0, 0x1e, 0x00,
+	0x00,
 }
 
 func (this *GatewayBrand) Equal(that interface{}) bool {
@@ -2183,6 +2246,9 @@ func (this *Gateway) Equal(that interface{}) bool {
 	} else if that1.ScheduleAnytimeDelay != nil {
 		return false
 	}
+	if this.UpdateLocationFromStatus != that1.UpdateLocationFromStatus {
+		return false
+	}
 	return true
 }
 func (this *Gateways) Equal(that interface{}) bool {
@@ -2445,6 +2511,21 @@ func (this *CreateGatewayAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateGatewayAPIKeyRequest) Equal(that interface{}) bool {
@@ -2472,6 +2553,9 @@ func (this *UpdateGatewayAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *ListGatewayCollaboratorsRequest) Equal(that interface{}) bool {
@@ -2717,6 +2801,16 @@ func (this *GatewayConnectionStats) Equal(that interface{}) bool {
 	if !this.RoundTripTimes.Equal(that1.RoundTripTimes) {
 		return false
 	}
+	if that1.DisconnectedAt == nil {
+		if this.DisconnectedAt != nil {
+			return false
+		}
+	} else if !this.DisconnectedAt.Equal(*that1.DisconnectedAt) {
+		return false
+	}
+	if !this.DisconnectReason.Equal(that1.DisconnectReason) {
+		return false
+	}
 	return true
 }
 func (this *GatewayConnectionStats_RoundTripTimes) Equal(that interface{}) bool {
@@ -3082,6 +3176,18 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpdateLocationFromStatus {
+		i--
+		if m.UpdateLocationFromStatus {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.FrequencyPlanIDs) > 0 {
 		for iNdEx := len(m.FrequencyPlanIDs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.FrequencyPlanIDs[iNdEx])
@@ -3651,21 +3757,40 @@ func (m *CreateGatewayAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedCIDRs) > 0 {
+		for iNdEx := len(m.AllowedCIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCIDRs[iNdEx])
+			copy(dAtA[i:], m.AllowedCIDRs[iNdEx])
+			i = encodeVarintGateway(dAtA, i, uint64(len(m.AllowedCIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpiresAt != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintGateway(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Rights) > 0 {
-		dAtA19 := make([]byte, len(m.Rights)*10)
-		var j18 int
+		dAtA20 := make([]byte, len(m.Rights)*10)
+		var j19 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j18++
+				j19++
 			}
-			dAtA19[j18] = uint8(num)
-			j18++
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
-		i -= j18
-		copy(dAtA[i:], dAtA19[:j18])
-		i = encodeVarintGateway(dAtA, i, uint64(j18))
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintGateway(dAtA, i, uint64(j19))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3709,6 +3834,16 @@ func (m *UpdateGatewayAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGateway(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -4013,20 +4148,20 @@ func (m *GatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime):])
-	if err30 != nil {
-		return 0, err30
+	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime):])
+	if err32 != nil {
+		return 0, err32
 	}
-	i -= n30
-	i = encodeVarintGateway(dAtA, i, uint64(n30))
+	i -= n32
+	i = encodeVarintGateway(dAtA, i, uint64(n32))
 	i--
 	dAtA[i] = 0x12
-	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err31 != nil {
-		return 0, err31
+	n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err33 != nil {
+		return 0, err33
 	}
-	i -= n31
-	i = encodeVarintGateway(dAtA, i, uint64(n31))
+	i -= n33
+	i = encodeVarintGateway(dAtA, i, uint64(n33))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4052,6 +4187,28 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.DisconnectReason != nil {
+		{
+			size, err := m.DisconnectReason.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGateway(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.DisconnectedAt != nil {
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DisconnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DisconnectedAt):])
+		if err35 != nil {
+			return 0, err35
+		}
+		i -= n35
+		i = encodeVarintGateway(dAtA, i, uint64(n35))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.RoundTripTimes != nil {
 		{
 			size, err := m.RoundTripTimes.MarshalToSizedBuffer(dAtA[:i])
@@ -4070,12 +4227,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x40
 	}
 	if m.LastDownlinkReceivedAt != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt):])
-		if err33 != nil {
-			return 0, err33
+		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n33
-		i = encodeVarintGateway(dAtA, i, uint64(n33))
+		i -= n37
+		i = encodeVarintGateway(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4085,12 +4242,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x30
 	}
 	if m.LastUplinkReceivedAt != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt):])
-		if err34 != nil {
-			return 0, err34
+		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n34
-		i = encodeVarintGateway(dAtA, i, uint64(n34))
+		i -= n38
+		i = encodeVarintGateway(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -4107,12 +4264,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x22
 	}
 	if m.LastStatusReceivedAt != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
-		if err36 != nil {
-			return 0, err36
+		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
+		if err40 != nil {
+			return 0, err40
 		}
-		i -= n36
-		i = encodeVarintGateway(dAtA, i, uint64(n36))
+		i -= n40
+		i = encodeVarintGateway(dAtA, i, uint64(n40))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4124,12 +4281,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x12
 	}
 	if m.ConnectedAt != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt):])
-		if err37 != nil {
-			return 0, err37
+		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n37
-		i = encodeVarintGateway(dAtA, i, uint64(n37))
+		i -= n41
+		i = encodeVarintGateway(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4161,28 +4318,28 @@ func (m *GatewayConnectionStats_RoundTripTimes) MarshalToSizedBuffer(dAtA []byte
 		i--
 		dAtA[i] = 0x20
 	}
-	n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
-	if err38 != nil {
-		return 0, err38
+	n42, err42 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
+	if err42 != nil {
+		return 0, err42
 	}
-	i -= n38
-	i = encodeVarintGateway(dAtA, i, uint64(n38))
+	i -= n42
+	i = encodeVarintGateway(dAtA, i, uint64(n42))
 	i--
 	dAtA[i] = 0x1a
-	n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
-	if err39 != nil {
-		return 0, err39
+	n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
+	if err43 != nil {
+		return 0, err43
 	}
-	i -= n39
-	i = encodeVarintGateway(dAtA, i, uint64(n39))
+	i -= n43
+	i = encodeVarintGateway(dAtA, i, uint64(n43))
 	i--
 	dAtA[i] = 0x12
-	n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
-	if err40 != nil {
-		return 0, err40
+	n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
+	if err44 != nil {
+		return 0, err44
 	}
-	i -= n40
-	i = encodeVarintGateway(dAtA, i, uint64(n40))
+	i -= n44
+	i = encodeVarintGateway(dAtA, i, uint64(n44))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4313,6 +4470,7 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 	for i := 0; i < v10; i++ {
 		this.FrequencyPlanIDs[i] = randStringGateway(r)
 	}
+	this.UpdateLocationFromStatus = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4420,6 +4578,14 @@ func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGa
 	for i := 0; i < v23; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v24 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v24)
+	for i := 0; i < v24; i++ {
+		this.AllowedCIDRs[i] = randStringGateway(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4427,10 +4593,12 @@ func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGa
 
 func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGatewayAPIKeyRequest {
 	this := &UpdateGatewayAPIKeyRequest{}
-	v24 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v24
-	v25 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v25
+	v25 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v25
+	v26 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v26
+	v27 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v27
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4438,8 +4606,8 @@ func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGa
 
 func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *ListGatewayCollaboratorsRequest {
 	this := &ListGatewayCollaboratorsRequest{}
-	v26 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v26
+	v28 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v28
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -4449,10 +4617,10 @@ func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *Lis
 
 func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGatewayCollaboratorRequest {
 	this := &GetGatewayCollaboratorRequest{}
-	v27 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v27
-	v28 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v28
+	v29 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v29
+	v30 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v30
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4460,10 +4628,10 @@ func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGa
 
 func NewPopulatedSetGatewayCollaboratorRequest(r randyGateway, easy bool) *SetGatewayCollaboratorRequest {
 	this := &SetGatewayCollaboratorRequest{}
-	v29 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v29
-	v30 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v30
+	v31 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v31
+	v32 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v32
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4475,12 +4643,12 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 	if r.Intn(2) == 0 {
 		this.Gain *= -1
 	}
-	v31 := NewPopulatedLocation(r, easy)
-	this.Location = *v31
+	v33 := NewPopulatedLocation(r, easy)
+	this.Location = *v33
 	if r.Intn(5) != 0 {
-		v32 := r.Intn(10)
+		v34 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v32; i++ {
+		for i := 0; i < v34; i++ {
 			this.Attributes[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
@@ -4491,37 +4659,37 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 
 func NewPopulatedGatewayStatus(r randyGateway, easy bool) *GatewayStatus {
 	this := &GatewayStatus{}
-	v33 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v33
-	v34 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.BootTime = *v34
+	v35 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v35
+	v36 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.BootTime = *v36
 	if r.Intn(5) != 0 {
-		v35 := r.Intn(10)
+		v37 := r.Intn(10)
 		this.Versions = make(map[string]string)
-		for i := 0; i < v35; i++ {
+		for i := 0; i < v37; i++ {
 			this.Versions[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
 	if r.Intn(5) != 0 {
-		v36 := r.Intn(5)
-		this.AntennaLocations = make([]*Location, v36)
-		for i := 0; i < v36; i++ {
+		v38 := r.Intn(5)
+		this.AntennaLocations = make([]*Location, v38)
+		for i := 0; i < v38; i++ {
 			this.AntennaLocations[i] = NewPopulatedLocation(r, easy)
 		}
 	}
-	v37 := r.Intn(10)
-	this.IP = make([]string, v37)
-	for i := 0; i < v37; i++ {
+	v39 := r.Intn(10)
+	this.IP = make([]string, v39)
+	for i := 0; i < v39; i++ {
 		this.IP[i] = randStringGateway(r)
 	}
 	if r.Intn(5) != 0 {
-		v38 := r.Intn(10)
+		v40 := r.Intn(10)
 		this.Metrics = make(map[string]float32)
-		for i := 0; i < v38; i++ {
-			v39 := randStringGateway(r)
-			this.Metrics[v39] = float32(r.Float32())
+		for i := 0; i < v40; i++ {
+			v41 := randStringGateway(r)
+			this.Metrics[v41] = float32(r.Float32())
 			if r.Intn(2) == 0 {
-				this.Metrics[v39] *= -1
+				this.Metrics[v41] *= -1
 			}
 		}
 	}
@@ -4556,6 +4724,12 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 	if r.Intn(5) != 0 {
 		this.RoundTripTimes = NewPopulatedGatewayConnectionStats_RoundTripTimes(r, easy)
 	}
+	if r.Intn(5) != 0 {
+		this.DisconnectedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.DisconnectReason = NewPopulatedErrorDetails(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4563,12 +4737,12 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 
 func NewPopulatedGatewayConnectionStats_RoundTripTimes(r randyGateway, easy bool) *GatewayConnectionStats_RoundTripTimes {
 	this := &GatewayConnectionStats_RoundTripTimes{}
-	v40 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Min = *v40
-	v41 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Max = *v41
 	v42 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Median = *v42
+	this.Min = *v42
+	v43 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Max = *v43
+	v44 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Median = *v44
 	this.Count = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -4594,9 +4768,9 @@ func randUTF8RuneGateway(r randyGateway) rune {
 	return rune(ru + 61)
 }
 func randStringGateway(r randyGateway) string {
-	v43 := r.Intn(100)
-	tmps := make([]rune, v43)
-	for i := 0; i < v43; i++ {
+	v45 := r.Intn(100)
+	tmps := make([]rune, v45)
+	for i := 0; i < v45; i++ {
 		tmps[i] = randUTF8RuneGateway(r)
 	}
 	return string(tmps)
@@ -4618,11 +4792,11 @@ func randFieldGateway(dAtA []byte, r randyGateway, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
-		v44 := r.Int63()
+		v46 := r.Int63()
 		if r.Intn(2) == 0 {
-			v44 *= -1
+			v46 *= -1
 		}
-		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v44))
+		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v46))
 	case 1:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -4872,6 +5046,9 @@ func (m *Gateway) Size() (n int) {
 			n += 2 + l + sovGateway(uint64(l))
 		}
 	}
+	if m.UpdateLocationFromStatus {
+		n += 3
+	}
 	return n
 }
 
@@ -5016,6 +5193,16 @@ func (m *CreateGatewayAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovGateway(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5029,6 +5216,8 @@ func (m *UpdateGatewayAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovGateway(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovGateway(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovGateway(uint64(l))
 	return n
 }
 
@@ -5182,6 +5371,14 @@ func (m *GatewayConnectionStats) Size() (n int) {
 		l = m.RoundTripTimes.Size()
 		n += 1 + l + sovGateway(uint64(l))
 	}
+	if m.DisconnectedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DisconnectedAt)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if m.DisconnectReason != nil {
+		l = m.DisconnectReason.Size()
+		n += 1 + l + sovGateway(uint64(l))
+	}
 	return n
 }
 
@@ -5336,6 +5533,7 @@ func (this *Gateway) String() string {
 		`DownlinkPathConstraint:` + fmt.Sprintf("%v", this.DownlinkPathConstraint) + `,`,
 		`ScheduleAnytimeDelay:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleAnytimeDelay), "Duration", "types.Duration", 1) + `,`,
 		`FrequencyPlanIDs:` + fmt.Sprintf("%v", this.FrequencyPlanIDs) + `,`,
+		`UpdateLocationFromStatus:` + fmt.Sprintf("%v", this.UpdateLocationFromStatus) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5443,6 +5641,8 @@ func (this *CreateGatewayAPIKeyRequest) String() string {
 		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedCIDRs:` + fmt.Sprintf("%v", this.AllowedCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5454,6 +5654,7 @@ func (this *UpdateGatewayAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateGatewayAPIKeyRequest{`,
 		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5569,6 +5770,8 @@ func (this *GatewayConnectionStats) String() string {
 		`LastDownlinkReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
 		`RoundTripTimes:` + strings.Replace(fmt.Sprintf("%v", this.RoundTripTimes), "GatewayConnectionStats_RoundTripTimes", "GatewayConnectionStats_RoundTripTimes", 1) + `,`,
+		`DisconnectedAt:` + strings.Replace(fmt.Sprintf("%v", this.DisconnectedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`DisconnectReason:` + strings.Replace(fmt.Sprintf("%v", this.DisconnectReason), "ErrorDetails", "ErrorDetails", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7260,6 +7463,26 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 			}
 			m.FrequencyPlanIDs = append(m.FrequencyPlanIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateLocationFromStatus", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpdateLocationFromStatus = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -8411,6 +8634,74 @@ func (m *CreateGatewayAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -8530,6 +8821,39 @@ func (m *UpdateGatewayAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -9909,6 +10233,78 @@ func (m *GatewayConnectionStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisconnectedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisconnectedAt == nil {
+				m.DisconnectedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DisconnectedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisconnectReason", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisconnectReason == nil {
+				m.DisconnectReason = &ErrorDetails{}
+			}
+			if err := m.DisconnectReason.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -95,6 +95,7 @@ var GatewayFieldPathsNested = []string{
 	"schedule_downlink_late",
 	"status_public",
 	"update_channel",
+	"update_location_from_status",
 	"updated_at",
 	"version_ids",
 	"version_ids.brand_id",
@@ -122,6 +123,7 @@ var GatewayFieldPathsTopLevel = []string{
 	"schedule_downlink_late",
 	"status_public",
 	"update_channel",
+	"update_location_from_status",
 	"updated_at",
 	"version_ids",
 }
@@ -200,6 +202,7 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.schedule_downlink_late",
 	"gateway.status_public",
 	"gateway.update_channel",
+	"gateway.update_location_from_status",
 	"gateway.updated_at",
 	"gateway.version_ids",
 	"gateway.version_ids.brand_id",
@@ -235,6 +238,7 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.schedule_downlink_late",
 	"gateway.status_public",
 	"gateway.update_channel",
+	"gateway.update_location_from_status",
 	"gateway.updated_at",
 	"gateway.version_ids",
 	"gateway.version_ids.brand_id",
@@ -272,6 +276,8 @@ var GetGatewayAPIKeyRequestFieldPathsTopLevel = []string{
 	"key_id",
 }
 var CreateGatewayAPIKeyRequestFieldPathsNested = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -280,16 +286,21 @@ var CreateGatewayAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateGatewayAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"gateway_ids",
 	"name",
 	"rights",
 }
 var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_cidrs",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
 	"api_key.rights",
+	"field_mask",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -297,6 +308,7 @@ var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 
 var UpdateGatewayAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
+	"field_mask",
 	"gateway_ids",
 }
 var ListGatewayCollaboratorsRequestFieldPathsNested = []string{
@@ -385,6 +397,21 @@ var GatewayStatusFieldPathsTopLevel = []string{
 }
 var GatewayConnectionStatsFieldPathsNested = []string{
 	"connected_at",
+	"disconnect_reason",
+	"disconnect_reason.attributes",
+	"disconnect_reason.cause",
+	"disconnect_reason.cause.attributes",
+	"disconnect_reason.cause.correlation_id",
+	"disconnect_reason.cause.message_format",
+	"disconnect_reason.cause.name",
+	"disconnect_reason.cause.namespace",
+	"disconnect_reason.code",
+	"disconnect_reason.correlation_id",
+	"disconnect_reason.details",
+	"disconnect_reason.message_format",
+	"disconnect_reason.name",
+	"disconnect_reason.namespace",
+	"disconnected_at",
 	"downlink_count",
 	"last_downlink_received_at",
 	"last_status",
@@ -408,6 +435,8 @@ var GatewayConnectionStatsFieldPathsNested = []string{
 
 var GatewayConnectionStatsFieldPathsTopLevel = []string{
 	"connected_at",
+	"disconnect_reason",
+	"disconnected_at",
 	"downlink_count",
 	"last_downlink_received_at",
 	"last_status",
@@ -499,6 +499,16 @@ func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 			} else {
 				dst.ScheduleAnytimeDelay = nil
 			}
+		case "update_location_from_status":
+			if len(subs) > 0 {
+				return fmt.Errorf("'update_location_from_status' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdateLocationFromStatus = src.UpdateLocationFromStatus
+			} else {
+				var zero bool
+				dst.UpdateLocationFromStatus = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -877,6 +887,24 @@ func (dst *CreateGatewayAPIKeyRequest) SetFields(src *CreateGatewayAPIKeyRequest
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_cidrs":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_cidrs' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedCIDRs = src.AllowedCIDRs
+			} else {
+				dst.AllowedCIDRs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -924,6 +952,16 @@ func (dst *UpdateGatewayAPIKeyRequest) SetFields(src *UpdateGatewayAPIKeyRequest
 					dst.APIKey = zero
 				}
 			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1318,6 +1356,40 @@ func (dst *GatewayConnectionStats) SetFields(src *GatewayConnectionStats, paths
 					dst.RoundTripTimes = nil
 				}
 			}
+		case "disconnected_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'disconnected_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DisconnectedAt = src.DisconnectedAt
+			} else {
+				dst.DisconnectedAt = nil
+			}
+		case "disconnect_reason":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.DisconnectReason == nil) && dst.DisconnectReason == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DisconnectReason
+				}
+				if dst.DisconnectReason != nil {
+					newDst = dst.DisconnectReason
+				} else {
+					newDst = &ErrorDetails{}
+					dst.DisconnectReason = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DisconnectReason = src.DisconnectReason
+				} else {
+					dst.DisconnectReason = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -774,6 +774,8 @@ func (m *Gateway) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "update_location_from_status":
+			// no validation rules for UpdateLocationFromStatus
 		default:
 			return GatewayValidationError{
 				field:  name,
@@ -1724,6 +1726,32 @@ func (m *CreateGatewayAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateGatewayAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_cidrs":
+
+			for idx, item := range m.GetAllowedCIDRs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 43 {
+					return CreateGatewayAPIKeyRequestValidationError{
+						field:  fmt.Sprintf("allowed_cidrs[%v]", idx),
+						reason: "value length must be at most 43 runes",
+					}
+				}
+
+			}
+
 		default:
 			return CreateGatewayAPIKeyRequestValidationError{
 				field:  name,
@@ -1830,6 +1858,18 @@ func (m *UpdateGatewayAPIKeyRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateGatewayAPIKeyRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return UpdateGatewayAPIKeyRequestValidationError{
 				field:  name,
@@ -2596,6 +2636,30 @@ func (m *GatewayConnectionStats) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "disconnected_at":
+
+			if v, ok := interface{}(m.GetDisconnectedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsValidationError{
+						field:  "disconnected_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "disconnect_reason":
+
+			if v, ok := interface{}(m.GetDisconnectReason()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsValidationError{
+						field:  "disconnect_reason",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return GatewayConnectionStatsValidationError{
 				field:  name,
@@ -5,6 +5,7 @@ package ttnpb
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -238,6 +239,349 @@ func (m *ScheduleDownlinkErrorDetails) GetPathErrors() []*ErrorDetails {
 	return nil
 }
 
+// GatewayLoad contains the downlink load of a connected gateway.
+type GatewayLoad struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	SubBands           []*GatewayLoad_SubBand `protobuf:"bytes,2,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+	// Number of scheduled emissions that have not been transmitted yet.
+	QueuedEmissions      uint32   `protobuf:"varint,3,opt,name=queued_emissions,json=queuedEmissions,proto3" json:"queued_emissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayLoad) Reset()      { *m = GatewayLoad{} }
+func (*GatewayLoad) ProtoMessage() {}
+func (*GatewayLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{4}
+}
+func (m *GatewayLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayLoad.Merge(m, src)
+}
+func (m *GatewayLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayLoad proto.InternalMessageInfo
+
+func (m *GatewayLoad) GetSubBands() []*GatewayLoad_SubBand {
+	if m != nil {
+		return m.SubBands
+	}
+	return nil
+}
+
+func (m *GatewayLoad) GetQueuedEmissions() uint32 {
+	if m != nil {
+		return m.QueuedEmissions
+	}
+	return 0
+}
+
+type GatewayLoad_SubBand struct {
+	MinFrequency uint64 `protobuf:"varint,1,opt,name=min_frequency,json=minFrequency,proto3" json:"min_frequency,omitempty"`
+	MaxFrequency uint64 `protobuf:"varint,2,opt,name=max_frequency,json=maxFrequency,proto3" json:"max_frequency,omitempty"`
+	// Utilization of the sub-band as a fraction of the available duty-cycle.
+	DutyCycleUtilization float32  `protobuf:"fixed32,3,opt,name=duty_cycle_utilization,json=dutyCycleUtilization,proto3" json:"duty_cycle_utilization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayLoad_SubBand) Reset()      { *m = GatewayLoad_SubBand{} }
+func (*GatewayLoad_SubBand) ProtoMessage() {}
+func (*GatewayLoad_SubBand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{4, 0}
+}
+func (m *GatewayLoad_SubBand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayLoad_SubBand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayLoad_SubBand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayLoad_SubBand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayLoad_SubBand.Merge(m, src)
+}
+func (m *GatewayLoad_SubBand) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayLoad_SubBand) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayLoad_SubBand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayLoad_SubBand proto.InternalMessageInfo
+
+func (m *GatewayLoad_SubBand) GetMinFrequency() uint64 {
+	if m != nil {
+		return m.MinFrequency
+	}
+	return 0
+}
+
+func (m *GatewayLoad_SubBand) GetMaxFrequency() uint64 {
+	if m != nil {
+		return m.MaxFrequency
+	}
+	return 0
+}
+
+func (m *GatewayLoad_SubBand) GetDutyCycleUtilization() float32 {
+	if m != nil {
+		return m.DutyCycleUtilization
+	}
+	return 0
+}
+
+type GetGatewayLoadsRequest struct {
+	GatewayIDs           []GatewayIdentifiers `protobuf:"bytes,1,rep,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetGatewayLoadsRequest) Reset()      { *m = GetGatewayLoadsRequest{} }
+func (*GetGatewayLoadsRequest) ProtoMessage() {}
+func (*GetGatewayLoadsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{5}
+}
+func (m *GetGatewayLoadsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGatewayLoadsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGatewayLoadsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetGatewayLoadsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewayLoadsRequest.Merge(m, src)
+}
+func (m *GetGatewayLoadsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGatewayLoadsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewayLoadsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGatewayLoadsRequest proto.InternalMessageInfo
+
+func (m *GetGatewayLoadsRequest) GetGatewayIDs() []GatewayIdentifiers {
+	if m != nil {
+		return m.GatewayIDs
+	}
+	return nil
+}
+
+type GatewayLoads struct {
+	// Loads of the requested gateways that are connected.
+	Loads                []*GatewayLoad `protobuf:"bytes,1,rep,name=loads,proto3" json:"loads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GatewayLoads) Reset()      { *m = GatewayLoads{} }
+func (*GatewayLoads) ProtoMessage() {}
+func (*GatewayLoads) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{6}
+}
+func (m *GatewayLoads) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayLoads) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayLoads.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayLoads) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayLoads.Merge(m, src)
+}
+func (m *GatewayLoads) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayLoads) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayLoads.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayLoads proto.InternalMessageInfo
+
+func (m *GatewayLoads) GetLoads() []*GatewayLoad {
+	if m != nil {
+		return m.Loads
+	}
+	return nil
+}
+
+// GatewayTraffic is a message that is exchanged with a gateway, or a message from the gateway that is dropped by the
+// Gateway Server.
+type GatewayTraffic struct {
+	// Time when the Gateway Server handled the message.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// Types that are valid to be assigned to Message:
+	//	*GatewayTraffic_UplinkMessage
+	//	*GatewayTraffic_GatewayStatus
+	//	*GatewayTraffic_DownlinkMessage
+	//	*GatewayTraffic_TxAcknowledgment
+	Message isGatewayTraffic_Message `protobuf_oneof:"message"`
+	// Reason why the message is dropped by the Gateway Server.
+	// This field is only set for dropped messages.
+	DropReason           *ErrorDetails `protobuf:"bytes,6,opt,name=drop_reason,json=dropReason,proto3" json:"drop_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GatewayTraffic) Reset()      { *m = GatewayTraffic{} }
+func (*GatewayTraffic) ProtoMessage() {}
+func (*GatewayTraffic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{7}
+}
+func (m *GatewayTraffic) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayTraffic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTraffic.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayTraffic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTraffic.Merge(m, src)
+}
+func (m *GatewayTraffic) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayTraffic) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTraffic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayTraffic proto.InternalMessageInfo
+
+type isGatewayTraffic_Message interface {
+	isGatewayTraffic_Message()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type GatewayTraffic_UplinkMessage struct {
+	UplinkMessage *UplinkMessage `protobuf:"bytes,2,opt,name=uplink_message,json=uplinkMessage,proto3,oneof" json:"uplink_message,omitempty"`
+}
+type GatewayTraffic_GatewayStatus struct {
+	GatewayStatus *GatewayStatus `protobuf:"bytes,3,opt,name=gateway_status,json=gatewayStatus,proto3,oneof" json:"gateway_status,omitempty"`
+}
+type GatewayTraffic_DownlinkMessage struct {
+	DownlinkMessage *DownlinkMessage `protobuf:"bytes,4,opt,name=downlink_message,json=downlinkMessage,proto3,oneof" json:"downlink_message,omitempty"`
+}
+type GatewayTraffic_TxAcknowledgment struct {
+	TxAcknowledgment *TxAcknowledgment `protobuf:"bytes,5,opt,name=tx_acknowledgment,json=txAcknowledgment,proto3,oneof" json:"tx_acknowledgment,omitempty"`
+}
+
+func (*GatewayTraffic_UplinkMessage) isGatewayTraffic_Message()    {}
+func (*GatewayTraffic_GatewayStatus) isGatewayTraffic_Message()    {}
+func (*GatewayTraffic_DownlinkMessage) isGatewayTraffic_Message()  {}
+func (*GatewayTraffic_TxAcknowledgment) isGatewayTraffic_Message() {}
+
+func (m *GatewayTraffic) GetMessage() isGatewayTraffic_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *GatewayTraffic) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *GatewayTraffic) GetUplinkMessage() *UplinkMessage {
+	if x, ok := m.GetMessage().(*GatewayTraffic_UplinkMessage); ok {
+		return x.UplinkMessage
+	}
+	return nil
+}
+
+func (m *GatewayTraffic) GetGatewayStatus() *GatewayStatus {
+	if x, ok := m.GetMessage().(*GatewayTraffic_GatewayStatus); ok {
+		return x.GatewayStatus
+	}
+	return nil
+}
+
+func (m *GatewayTraffic) GetDownlinkMessage() *DownlinkMessage {
+	if x, ok := m.GetMessage().(*GatewayTraffic_DownlinkMessage); ok {
+		return x.DownlinkMessage
+	}
+	return nil
+}
+
+func (m *GatewayTraffic) GetTxAcknowledgment() *TxAcknowledgment {
+	if x, ok := m.GetMessage().(*GatewayTraffic_TxAcknowledgment); ok {
+		return x.TxAcknowledgment
+	}
+	return nil
+}
+
+func (m *GatewayTraffic) GetDropReason() *ErrorDetails {
+	if m != nil {
+		return m.DropReason
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*GatewayTraffic) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*GatewayTraffic_UplinkMessage)(nil),
+		(*GatewayTraffic_GatewayStatus)(nil),
+		(*GatewayTraffic_DownlinkMessage)(nil),
+		(*GatewayTraffic_TxAcknowledgment)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
 	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
@@ -247,6 +591,16 @@ func init() {
 	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
 	proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
 	golang_proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+	proto.RegisterType((*GatewayLoad)(nil), "ttn.lorawan.v3.GatewayLoad")
+	golang_proto.RegisterType((*GatewayLoad)(nil), "ttn.lorawan.v3.GatewayLoad")
+	proto.RegisterType((*GatewayLoad_SubBand)(nil), "ttn.lorawan.v3.GatewayLoad.SubBand")
+	golang_proto.RegisterType((*GatewayLoad_SubBand)(nil), "ttn.lorawan.v3.GatewayLoad.SubBand")
+	proto.RegisterType((*GetGatewayLoadsRequest)(nil), "ttn.lorawan.v3.GetGatewayLoadsRequest")
+	golang_proto.RegisterType((*GetGatewayLoadsRequest)(nil), "ttn.lorawan.v3.GetGatewayLoadsRequest")
+	proto.RegisterType((*GatewayLoads)(nil), "ttn.lorawan.v3.GatewayLoads")
+	golang_proto.RegisterType((*GatewayLoads)(nil), "ttn.lorawan.v3.GatewayLoads")
+	proto.RegisterType((*GatewayTraffic)(nil), "ttn.lorawan.v3.GatewayTraffic")
+	golang_proto.RegisterType((*GatewayTraffic)(nil), "ttn.lorawan.v3.GatewayTraffic")
 }
 
 func init() {
@@ -257,61 +611,86 @@ func init() {
 }
 
 var fileDescriptor_62b07a36420f2d6d = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x31, 0x6c, 0x1c, 0x45,
-	0x14, 0x9d, 0xb1, 0x31, 0x82, 0xb1, 0x70, 0xcc, 0x48, 0xc0, 0xf9, 0xe2, 0x8c, 0xad, 0x43, 0x20,
-	0x0b, 0xe5, 0x76, 0xad, 0x8b, 0x84, 0x68, 0x28, 0x6c, 0x5f, 0x38, 0x19, 0x61, 0x24, 0xce, 0x36,
-	0x12, 0x48, 0x96, 0x35, 0xbe, 0x1d, 0xcf, 0xad, 0x6e, 0x3d, 0xb3, 0xec, 0xcc, 0xdd, 0xf9, 0x84,
-	0x90, 0x22, 0xaa, 0x94, 0x48, 0x14, 0x44, 0xa2, 0x41, 0xa9, 0x22, 0xaa, 0x94, 0x29, 0x53, 0xba,
-	0x8c, 0x44, 0x93, 0x8a, 0xe4, 0x76, 0x29, 0x5c, 0xa6, 0x8c, 0xa8, 0xd0, 0xce, 0xcd, 0xda, 0x77,
-	0x7b, 0x5e, 0xa0, 0x49, 0xb7, 0xb3, 0xff, 0xfd, 0xf7, 0xdf, 0x7f, 0xf3, 0xff, 0xa0, 0x0f, 0x02,
-	0x19, 0xd1, 0x3e, 0x15, 0x55, 0xa5, 0x69, 0xab, 0xe3, 0xd2, 0xd0, 0x77, 0x39, 0xd5, 0xac, 0x4f,
-	0x07, 0x8a, 0x45, 0x3d, 0x16, 0x39, 0x61, 0x24, 0xb5, 0xc4, 0x0b, 0x5a, 0x0b, 0xc7, 0x42, 0x9d,
-	0xde, 0xad, 0xf2, 0x06, 0xf7, 0x75, 0xbb, 0x7b, 0xe4, 0xb4, 0xe4, 0x89, 0xcb, 0x44, 0x4f, 0x0e,
-	0xc2, 0x48, 0x9e, 0x0e, 0x5c, 0x03, 0x6e, 0x55, 0x39, 0x13, 0xd5, 0x1e, 0x0d, 0x7c, 0x8f, 0x6a,
-	0xe6, 0x4e, 0x7d, 0x8c, 0x28, 0xcb, 0xd5, 0x31, 0x0a, 0x2e, 0xb9, 0x1c, 0x25, 0x1f, 0x75, 0x8f,
-	0xcd, 0xc9, 0x1c, 0xcc, 0x97, 0x85, 0x2f, 0x73, 0x29, 0x79, 0xc0, 0x8c, 0x42, 0x2a, 0x84, 0xd4,
-	0x54, 0xfb, 0x52, 0x28, 0x1b, 0x25, 0x36, 0x7a, 0xc1, 0xe1, 0x75, 0x23, 0x03, 0xb0, 0xf1, 0xeb,
-	0xf9, 0x38, 0x3b, 0x09, 0xf5, 0xc0, 0x06, 0x6f, 0x4c, 0x7b, 0xc0, 0xa2, 0x48, 0xda, 0xde, 0xcb,
-	0x2b, 0x85, 0x16, 0x59, 0xc0, 0xfb, 0xd3, 0x00, 0xdf, 0x63, 0x42, 0xfb, 0xc7, 0x3e, 0x8b, 0x32,
-	0x85, 0xab, 0xd3, 0xa0, 0x13, 0xa6, 0x14, 0xe5, 0x2c, 0x43, 0x2c, 0x5f, 0x81, 0xf8, 0x4e, 0xeb,
-	0xe2, 0xfc, 0x88, 0x71, 0x5f, 0x0a, 0x1a, 0x8c, 0x10, 0x95, 0x73, 0x88, 0xde, 0x6c, 0x8c, 0x84,
-	0xed, 0x87, 0xf8, 0x33, 0x74, 0xad, 0x1b, 0x06, 0xbe, 0xe8, 0x1c, 0x66, 0x65, 0x4a, 0x70, 0x75,
-	0x76, 0x6d, 0xbe, 0x76, 0xc3, 0x99, 0xbc, 0x4b, 0x67, 0xdf, 0xc0, 0x76, 0x46, 0xa8, 0xe6, 0x42,
-	0x77, 0xfc, 0xa8, 0x70, 0x1d, 0x2d, 0xd8, 0x6e, 0x0f, 0x95, 0xa6, 0xba, 0xab, 0x4a, 0x33, 0xab,
-	0xf0, 0x2a, 0x1a, 0x5b, 0x7a, 0xd7, 0x80, 0x9a, 0x6f, 0xf1, 0xf1, 0x23, 0xde, 0x41, 0x6f, 0xeb,
-	0xd3, 0x43, 0xda, 0xea, 0x08, 0xd9, 0x0f, 0x98, 0xc7, 0x4f, 0x98, 0xd0, 0xa5, 0x59, 0x43, 0xb4,
-	0x9a, 0x27, 0xda, 0x3b, 0xdd, 0x98, 0xc0, 0x35, 0x17, 0x75, 0xee, 0x4f, 0xe5, 0x1b, 0x34, 0x6f,
-	0xcb, 0xd5, 0x65, 0x5f, 0xe0, 0xcf, 0xd1, 0xa2, 0x27, 0xfb, 0x62, 0xbc, 0xdb, 0x12, 0x34, 0xe4,
-	0x2b, 0x79, 0xf2, 0xba, 0xc5, 0x65, 0xed, 0x5e, 0xf3, 0x26, 0x7f, 0x54, 0x0e, 0x50, 0x69, 0xb7,
-	0xd5, 0x66, 0x5e, 0x37, 0x60, 0x19, 0xb6, 0xc9, 0x54, 0x28, 0x85, 0x62, 0x78, 0x03, 0xcd, 0x79,
-	0x2c, 0xa0, 0x03, 0x4b, 0xbe, 0xe4, 0x8c, 0xa6, 0xca, 0xc9, 0xa6, 0xca, 0xa9, 0xdb, 0xa9, 0xdb,
-	0x5c, 0xfc, 0x7b, 0x73, 0xee, 0x77, 0x38, 0xf3, 0x06, 0x3c, 0xfb, 0x73, 0x05, 0xdc, 0x7b, 0xb6,
-	0x02, 0x9b, 0xa3, 0xcc, 0xca, 0x01, 0x5a, 0xce, 0xd3, 0xdf, 0x4e, 0x67, 0xad, 0xce, 0x34, 0xf5,
-	0x03, 0x85, 0x3f, 0x45, 0xf3, 0x21, 0xd5, 0xed, 0x43, 0x33, 0x80, 0xd9, 0x95, 0x2d, 0xe7, 0xbb,
-	0x18, 0x4f, 0x69, 0xa2, 0x34, 0xc1, 0xfc, 0x51, 0xb5, 0x67, 0xb3, 0x68, 0xae, 0xa1, 0xfb, 0x0d,
-	0x85, 0xb7, 0xd1, 0xfc, 0x17, 0xbe, 0xe8, 0x58, 0x9b, 0xf0, 0x52, 0xc1, 0x75, 0xed, 0x87, 0xe5,
-	0xeb, 0x05, 0xa1, 0x54, 0xdf, 0x1a, 0x5c, 0x87, 0x78, 0x17, 0xbd, 0xd3, 0x60, 0x7a, 0x4b, 0x8a,
-	0x16, 0x13, 0x3a, 0xa2, 0x5a, 0x46, 0x5b, 0x52, 0x1c, 0xfb, 0x1c, 0xbf, 0x3b, 0x65, 0xc0, 0xed,
-	0x74, 0xad, 0xca, 0x95, 0x3c, 0xe3, 0x15, 0xb9, 0xbf, 0x40, 0xc3, 0xba, 0xf3, 0xd5, 0xde, 0xde,
-	0x96, 0x14, 0x82, 0xb5, 0x52, 0xdf, 0xb6, 0xc5, 0xb1, 0xc4, 0x95, 0x02, 0x3d, 0xdb, 0x97, 0x3b,
-	0x35, 0x5d, 0x61, 0x9a, 0xa7, 0xf2, 0xf1, 0x8f, 0x7f, 0xfc, 0xf5, 0xf3, 0xcc, 0x3a, 0x76, 0x5c,
-	0xae, 0x2e, 0x1e, 0x35, 0xf7, 0xfb, 0x6c, 0x9a, 0x7d, 0xef, 0x07, 0xb3, 0x5f, 0xd5, 0xd6, 0x45,
-	0x5a, 0xd5, 0x4f, 0xeb, 0xff, 0x0a, 0xd1, 0x7b, 0x56, 0xd9, 0xd7, 0xb5, 0x57, 0xa4, 0xed, 0x13,
-	0xa3, 0xad, 0x86, 0xd7, 0xff, 0x5d, 0x5b, 0xaf, 0x96, 0x57, 0x57, 0x63, 0xe8, 0xb5, 0x2f, 0x55,
-	0x43, 0xe1, 0x03, 0xb4, 0x98, 0x1f, 0x24, 0xfc, 0x5f, 0xd3, 0x5e, 0x5e, 0xcb, 0x03, 0x8a, 0x46,
-	0xbd, 0x76, 0x1f, 0xa2, 0x99, 0x86, 0x4a, 0xbd, 0x58, 0x6a, 0x30, 0x6d, 0xbb, 0xbc, 0x6c, 0x22,
-	0x5d, 0x6b, 0xf5, 0xbf, 0xdc, 0xf8, 0xb0, 0x00, 0x93, 0xe3, 0xaa, 0xd4, 0x8c, 0x23, 0x37, 0xf1,
-	0x47, 0xc5, 0x8e, 0x5c, 0x5a, 0xe1, 0xa6, 0x4f, 0x91, 0xda, 0xbc, 0x0f, 0xcf, 0x86, 0x04, 0x3e,
-	0x19, 0x12, 0xf8, 0x74, 0x48, 0xc0, 0xf3, 0x21, 0x01, 0xe7, 0x43, 0x02, 0x5e, 0x0c, 0x09, 0x78,
-	0x39, 0x24, 0xf0, 0x4e, 0x4c, 0xe0, 0xdd, 0x98, 0x80, 0x07, 0x31, 0x81, 0x0f, 0x63, 0x02, 0x1e,
-	0xc5, 0x04, 0x3c, 0x8e, 0x09, 0x38, 0x8b, 0x09, 0x7c, 0x12, 0x13, 0xf8, 0x34, 0x26, 0xe0, 0x79,
-	0x4c, 0xe0, 0x79, 0x4c, 0xc0, 0x8b, 0x98, 0xc0, 0x97, 0x31, 0x01, 0x77, 0x12, 0x02, 0xee, 0x26,
-	0x04, 0xfe, 0x94, 0x10, 0x70, 0x2f, 0x21, 0xf0, 0xb7, 0x84, 0x80, 0x07, 0x09, 0x01, 0x0f, 0x13,
-	0x02, 0x1f, 0x25, 0x04, 0x3e, 0x4e, 0x08, 0xfc, 0xf6, 0x26, 0x97, 0x8e, 0x6e, 0x33, 0xdd, 0xf6,
-	0x05, 0x57, 0x8e, 0x60, 0xba, 0x2f, 0xa3, 0x8e, 0x3b, 0xf9, 0x3e, 0x87, 0x1d, 0xee, 0x6a, 0x2d,
-	0xc2, 0xa3, 0xa3, 0xd7, 0xcd, 0x72, 0xdc, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x29, 0x78, 0x18,
-	0x24, 0x6a, 0x07, 0x00, 0x00,
+	// 1249 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6c, 0xd4, 0xc6,
+	0x17, 0xf7, 0x6c, 0x12, 0x3e, 0x26, 0xe4, 0xe3, 0x3f, 0xfa, 0x97, 0x86, 0x25, 0x4c, 0xd2, 0x8d,
+	0x8a, 0xa0, 0x22, 0xde, 0x74, 0xa9, 0x2a, 0x2e, 0x95, 0x9a, 0x0f, 0x58, 0xa8, 0xa0, 0x55, 0x9d,
+	0xa4, 0xa8, 0x95, 0xd0, 0x6a, 0x76, 0x3d, 0xeb, 0x58, 0xf1, 0xce, 0x38, 0x9e, 0x71, 0x36, 0xdb,
+	0xaa, 0x15, 0xea, 0x89, 0xf6, 0x84, 0xd4, 0x43, 0x91, 0x7a, 0xa9, 0x38, 0xa1, 0x9e, 0x38, 0x72,
+	0xaa, 0x90, 0x7a, 0xe1, 0x88, 0xd4, 0x0b, 0x97, 0x02, 0xeb, 0xed, 0x81, 0x23, 0x47, 0xd4, 0x53,
+	0xe5, 0x59, 0x3b, 0xbb, 0x6b, 0xc7, 0xb0, 0x97, 0xde, 0xec, 0x79, 0xbf, 0xf7, 0x7b, 0xef, 0xfd,
+	0xe6, 0xbd, 0x67, 0xc3, 0x77, 0x1d, 0xee, 0x91, 0x26, 0x61, 0x8b, 0x42, 0x92, 0xda, 0x76, 0x91,
+	0xb8, 0x76, 0xd1, 0x22, 0x92, 0x36, 0x49, 0x4b, 0x50, 0x6f, 0x97, 0x7a, 0xba, 0xeb, 0x71, 0xc9,
+	0xd1, 0xa4, 0x94, 0x4c, 0x8f, 0xa0, 0xfa, 0xee, 0xf9, 0xfc, 0xb2, 0x65, 0xcb, 0x2d, 0xbf, 0xaa,
+	0xd7, 0x78, 0xa3, 0x48, 0xd9, 0x2e, 0x6f, 0xb9, 0x1e, 0xdf, 0x6b, 0x15, 0x15, 0xb8, 0xb6, 0x68,
+	0x51, 0xb6, 0xb8, 0x4b, 0x1c, 0xdb, 0x24, 0x92, 0x16, 0x53, 0x0f, 0x5d, 0xca, 0xfc, 0x62, 0x1f,
+	0x85, 0xc5, 0x2d, 0xde, 0x75, 0xae, 0xfa, 0x75, 0xf5, 0xa6, 0x5e, 0xd4, 0x53, 0x04, 0x9f, 0xb5,
+	0x38, 0xb7, 0x1c, 0xaa, 0x32, 0x24, 0x8c, 0x71, 0x49, 0xa4, 0xcd, 0x99, 0x88, 0xac, 0x38, 0xb2,
+	0xee, 0x73, 0x98, 0xbe, 0xa7, 0x00, 0x91, 0xfd, 0x64, 0xd2, 0x4e, 0x1b, 0xae, 0x6c, 0x45, 0xc6,
+	0xb9, 0xa4, 0x51, 0xda, 0x0d, 0x2a, 0x24, 0x69, 0xb8, 0x11, 0xe0, 0x54, 0x5a, 0x24, 0xea, 0x79,
+	0xdc, 0x8b, 0xfd, 0x33, 0x35, 0x8c, 0x00, 0x0b, 0x69, 0x80, 0x6d, 0x52, 0x26, 0xed, 0xba, 0x4d,
+	0xbd, 0xb8, 0x84, 0xf9, 0x34, 0xa8, 0x41, 0x85, 0x20, 0x16, 0x8d, 0x11, 0xb3, 0x07, 0x20, 0x76,
+	0xa4, 0xcc, 0xf6, 0xf7, 0xa8, 0x65, 0x73, 0x46, 0x9c, 0x2e, 0xa2, 0xf0, 0x02, 0xc0, 0xa3, 0xe5,
+	0x6e, 0x62, 0x9b, 0x2e, 0xba, 0x04, 0xa7, 0x7c, 0xd7, 0xb1, 0xd9, 0x76, 0x25, 0x0e, 0x33, 0x03,
+	0xe6, 0x47, 0xce, 0x8c, 0x97, 0x4e, 0xe9, 0x83, 0x97, 0xad, 0x6f, 0x2a, 0xd8, 0xb5, 0x2e, 0xca,
+	0x98, 0xf4, 0xfb, 0x5f, 0x05, 0x5a, 0x83, 0x93, 0x51, 0xb5, 0x15, 0x21, 0x89, 0xf4, 0xc5, 0x4c,
+	0x6e, 0x1e, 0x1c, 0x44, 0x13, 0x85, 0x5e, 0x57, 0x20, 0x63, 0xc2, 0xea, 0x7f, 0x45, 0xd7, 0xe0,
+	0xff, 0xe4, 0x5e, 0x85, 0xd4, 0xb6, 0x19, 0x6f, 0x3a, 0xd4, 0xb4, 0x1a, 0x94, 0xc9, 0x99, 0x11,
+	0x45, 0x34, 0x9f, 0x24, 0xda, 0xd8, 0x5b, 0x1e, 0xc0, 0x19, 0xd3, 0x32, 0x71, 0x52, 0xf8, 0x12,
+	0x8e, 0x47, 0xe1, 0xd6, 0x78, 0x93, 0xa1, 0x4f, 0xe0, 0xb4, 0xc9, 0x9b, 0xac, 0xbf, 0xda, 0x19,
+	0xa0, 0xc8, 0xe7, 0x92, 0xe4, 0x6b, 0x11, 0x2e, 0x2e, 0x77, 0xca, 0x1c, 0x3c, 0x28, 0xdc, 0x80,
+	0x33, 0xeb, 0xb5, 0x2d, 0x6a, 0xfa, 0x0e, 0x8d, 0xb1, 0x06, 0x15, 0x2e, 0x67, 0x82, 0xa2, 0x65,
+	0x38, 0x66, 0x52, 0x87, 0xb4, 0x22, 0xf2, 0x13, 0x7a, 0xb7, 0xb3, 0xf4, 0xb8, 0xb3, 0xf4, 0xb5,
+	0xa8, 0x2d, 0x57, 0xa6, 0xff, 0x59, 0x19, 0xfb, 0x0d, 0xe4, 0x8e, 0x80, 0x47, 0x4f, 0xe7, 0xb4,
+	0x3b, 0xcf, 0xe6, 0x80, 0xd1, 0xf5, 0x2c, 0xdc, 0x80, 0xb3, 0x49, 0xfa, 0x8b, 0x61, 0xaf, 0xad,
+	0x51, 0x49, 0x6c, 0x47, 0xa0, 0x8f, 0xe0, 0xb8, 0x4b, 0xe4, 0x56, 0x45, 0x35, 0x60, 0x7c, 0x65,
+	0xb3, 0xc9, 0x2a, 0xfa, 0x5d, 0x0c, 0x18, 0x3a, 0xa8, 0x13, 0x51, 0xf8, 0x2b, 0xb7, 0xaf, 0xcc,
+	0x55, 0x4e, 0x4c, 0xb4, 0x09, 0xc7, 0xe3, 0xdb, 0xb3, 0x4d, 0x11, 0xe5, 0x5d, 0xc8, 0xb8, 0xba,
+	0x2b, 0xbd, 0xa6, 0x55, 0x05, 0xfc, 0x08, 0x72, 0xd3, 0xaa, 0x80, 0xc7, 0x4f, 0xe7, 0x80, 0x01,
+	0xad, 0x18, 0x25, 0xd0, 0xc7, 0xf0, 0xa8, 0xf0, 0xab, 0x95, 0x2a, 0x61, 0x66, 0xd8, 0x0f, 0x61,
+	0x8e, 0x0b, 0x19, 0xa4, 0x61, 0x1a, 0xfa, 0xba, 0x5f, 0x5d, 0x21, 0xcc, 0x34, 0x8e, 0x88, 0xee,
+	0x83, 0x40, 0x67, 0xe1, 0xf4, 0x8e, 0x4f, 0x7d, 0x6a, 0x56, 0x68, 0xc3, 0x16, 0x22, 0x9c, 0x75,
+	0xd5, 0x0f, 0x13, 0xc6, 0x54, 0xf7, 0xfc, 0x62, 0x7c, 0x9c, 0xff, 0x01, 0xc0, 0xc3, 0x11, 0x01,
+	0x5a, 0x80, 0x13, 0x0d, 0x9b, 0x55, 0xea, 0x1e, 0xdd, 0xf1, 0x29, 0xab, 0x75, 0x6f, 0x62, 0xd4,
+	0x38, 0xd6, 0xb0, 0xd9, 0xa5, 0xf8, 0x4c, 0x81, 0xc8, 0x5e, 0x1f, 0x28, 0x17, 0x81, 0xc8, 0x5e,
+	0x0f, 0xf4, 0x01, 0x3c, 0x6e, 0xfa, 0xb2, 0x55, 0xa9, 0xb5, 0x6a, 0x0e, 0xad, 0xf8, 0xd2, 0x76,
+	0xec, 0xaf, 0xd5, 0xdd, 0xa9, 0x34, 0x72, 0xc6, 0xff, 0x43, 0xeb, 0x6a, 0x68, 0xdc, 0xec, 0xd9,
+	0x0a, 0x3b, 0xf0, 0x78, 0x99, 0xca, 0xbe, 0xd2, 0x84, 0x11, 0x12, 0x0a, 0x89, 0xae, 0x27, 0x95,
+	0x1e, 0x19, 0x52, 0x69, 0x14, 0x2a, 0x1c, 0x3c, 0x9d, 0x83, 0xb1, 0x6d, 0x4d, 0xf4, 0x6b, 0x5d,
+	0x58, 0x86, 0xc7, 0xfa, 0xe3, 0xa1, 0xf7, 0xe1, 0x98, 0xc3, 0xc9, 0x7e, 0x88, 0x93, 0xaf, 0xd1,
+	0xdd, 0xe8, 0x22, 0x0b, 0x7f, 0x8c, 0xc0, 0xc9, 0xe8, 0x78, 0xc3, 0x23, 0xf5, 0xba, 0x5d, 0x43,
+	0x17, 0xe0, 0x68, 0xb8, 0x06, 0xa3, 0x8e, 0xc8, 0xa7, 0x3a, 0x79, 0x23, 0xde, 0x91, 0x2b, 0x47,
+	0xc2, 0xfc, 0x6e, 0x87, 0x2d, 0xac, 0x3c, 0xd0, 0x25, 0x38, 0x39, 0xb8, 0x58, 0xb2, 0x16, 0xc2,
+	0xc0, 0x5e, 0xb9, 0xac, 0x19, 0x13, 0x03, 0x9b, 0x25, 0xe4, 0x49, 0x2c, 0x96, 0x91, 0x21, 0x16,
+	0x4b, 0xc8, 0x33, 0xb8, 0x5a, 0xae, 0x1e, 0x30, 0xfc, 0xa3, 0x43, 0x0d, 0xff, 0x65, 0x2d, 0x35,
+	0xfe, 0xe8, 0xb3, 0x83, 0x16, 0xd5, 0xd8, 0x70, 0x8b, 0xea, 0xb2, 0x96, 0x5e, 0x55, 0xe1, 0x40,
+	0x9b, 0x1e, 0x77, 0x2b, 0x1e, 0x25, 0x82, 0xb3, 0x99, 0x43, 0xf3, 0xe0, 0xcd, 0x03, 0x1d, 0x3a,
+	0x18, 0x0a, 0xbf, 0x72, 0x14, 0x1e, 0x8e, 0x8a, 0x2a, 0x3d, 0x1b, 0x81, 0x63, 0x65, 0xd9, 0x2c,
+	0x0b, 0x74, 0x05, 0x8e, 0x5f, 0xb5, 0xd9, 0x76, 0x24, 0x0c, 0x3a, 0x91, 0xa1, 0xd8, 0xa6, 0x9b,
+	0xcf, 0xea, 0x8e, 0x50, 0x89, 0x33, 0x60, 0x09, 0xa0, 0x75, 0xf8, 0x56, 0x99, 0xca, 0x55, 0xce,
+	0x6a, 0x94, 0x49, 0x8f, 0x48, 0xee, 0xad, 0x72, 0x56, 0xb7, 0x2d, 0x74, 0x3c, 0xd5, 0x12, 0x17,
+	0xc3, 0x6f, 0x6a, 0x3e, 0xd5, 0xd2, 0x07, 0xf8, 0xfe, 0x0c, 0x14, 0xeb, 0xb5, 0xcf, 0x37, 0x36,
+	0x56, 0x39, 0x63, 0xb4, 0x16, 0xce, 0xce, 0x15, 0x56, 0xe7, 0x68, 0x88, 0x81, 0x48, 0x47, 0x48,
+	0xf3, 0x14, 0x3e, 0xfc, 0xfe, 0xcf, 0xbf, 0x7f, 0xca, 0x2d, 0x21, 0xbd, 0x68, 0x89, 0xfd, 0x3f,
+	0x9a, 0xe2, 0x37, 0xbd, 0x09, 0xfc, 0x56, 0x7d, 0x3b, 0x17, 0x6b, 0xfb, 0x6e, 0x8b, 0x76, 0x18,
+	0xff, 0x17, 0x00, 0xdf, 0x8e, 0x32, 0xfb, 0xa2, 0xf4, 0x1f, 0xe5, 0x76, 0x41, 0xe5, 0x56, 0x42,
+	0x4b, 0xaf, 0xcf, 0x6d, 0xb7, 0x94, 0xcc, 0xae, 0xf4, 0x3b, 0x80, 0xa3, 0x9f, 0x8a, 0xb2, 0x40,
+	0x37, 0xe0, 0x74, 0xf2, 0x2b, 0x81, 0xde, 0xd4, 0xcd, 0xf9, 0x33, 0x49, 0x40, 0xe6, 0x77, 0xec,
+	0x3a, 0x9c, 0x4a, 0x6c, 0x31, 0x74, 0x3a, 0x55, 0xfc, 0x81, 0x6b, 0x2e, 0x3f, 0xfb, 0x9a, 0x75,
+	0x23, 0x4a, 0x77, 0x73, 0x30, 0x57, 0x16, 0xa1, 0xca, 0x27, 0x7a, 0xfe, 0x3d, 0x79, 0xc2, 0x89,
+	0x15, 0x43, 0xe9, 0x7c, 0x3a, 0x03, 0x93, 0xe0, 0x2a, 0x94, 0x94, 0xd6, 0xe7, 0xd0, 0x7b, 0xd9,
+	0x5a, 0xf7, 0x44, 0x2e, 0x0a, 0x15, 0xff, 0x3b, 0x38, 0xbe, 0x41, 0x6c, 0x27, 0x9e, 0x9e, 0x61,
+	0xd2, 0xc1, 0x19, 0x98, 0x68, 0x9b, 0x16, 0xce, 0xaa, 0x34, 0x16, 0xd0, 0x3b, 0xd9, 0x69, 0xc8,
+	0x2e, 0x74, 0x09, 0xac, 0xdc, 0x05, 0x8f, 0xda, 0x18, 0x3c, 0x6e, 0x63, 0xf0, 0xa4, 0x8d, 0xb5,
+	0xe7, 0x6d, 0xac, 0xbd, 0x68, 0x63, 0xed, 0x65, 0x1b, 0x6b, 0xaf, 0xda, 0x18, 0xdc, 0x0c, 0x30,
+	0xb8, 0x15, 0x60, 0xed, 0x5e, 0x80, 0xc1, 0xfd, 0x00, 0x6b, 0x0f, 0x02, 0xac, 0x3d, 0x0c, 0xb0,
+	0xf6, 0x28, 0xc0, 0xe0, 0x71, 0x80, 0xc1, 0x93, 0x00, 0x6b, 0xcf, 0x03, 0x0c, 0x5e, 0x04, 0x58,
+	0x7b, 0x19, 0x60, 0xf0, 0x2a, 0xc0, 0xda, 0xcd, 0x0e, 0xd6, 0x6e, 0x75, 0x30, 0xb8, 0xdd, 0xc1,
+	0xda, 0x9d, 0x0e, 0x06, 0xbf, 0x76, 0xb0, 0x76, 0xaf, 0x83, 0xb5, 0xfb, 0x1d, 0x0c, 0x1e, 0x74,
+	0x30, 0x78, 0xd8, 0xc1, 0xe0, 0xab, 0x73, 0x16, 0xd7, 0xe5, 0x16, 0x95, 0x5b, 0x36, 0xb3, 0x84,
+	0xce, 0xa8, 0x6c, 0x72, 0x6f, 0xbb, 0x38, 0xf8, 0x57, 0xe9, 0x6e, 0x5b, 0x45, 0x29, 0x99, 0x5b,
+	0xad, 0x1e, 0x52, 0x63, 0x7f, 0xfe, 0xdf, 0x01, 0x00, 0x1b, 0xff, 0x69, 0x33, 0x41, 0x0c, 0x00,
+	0x00,
 }
 
 func (this *GatewayUp) Equal(that interface{}) bool {
@@ -426,34 +805,289 @@ func (this *ScheduleDownlinkErrorDetails) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GatewayLoad) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// GtwGsClient is the client API for GtwGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GtwGsClient interface {
-	// Link the gateway to the Gateway Server.
-	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
-	// GetConcentratorConfig associated to the gateway.
-	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
-	// Get the MQTT server address and the username for the gateway.
-	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
-	// Get the MQTTV2 server address and the username for the gateway.
-	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+	that1, ok := that.(*GatewayLoad)
+	if !ok {
+		that2, ok := that.(GatewayLoad)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if len(this.SubBands) != len(that1.SubBands) {
+		return false
+	}
+	for i := range this.SubBands {
+		if !this.SubBands[i].Equal(that1.SubBands[i]) {
+			return false
+		}
+	}
+	if this.QueuedEmissions != that1.QueuedEmissions {
+		return false
+	}
+	return true
 }
+func (this *GatewayLoad_SubBand) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-type gtwGsClient struct {
-	cc *grpc.ClientConn
+	that1, ok := that.(*GatewayLoad_SubBand)
+	if !ok {
+		that2, ok := that.(GatewayLoad_SubBand)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinFrequency != that1.MinFrequency {
+		return false
+	}
+	if this.MaxFrequency != that1.MaxFrequency {
+		return false
+	}
+	if this.DutyCycleUtilization != that1.DutyCycleUtilization {
+		return false
+	}
+	return true
 }
+func (this *GetGatewayLoadsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
+	that1, ok := that.(*GetGatewayLoadsRequest)
+	if !ok {
+		that2, ok := that.(GetGatewayLoadsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.GatewayIDs) != len(that1.GatewayIDs) {
+		return false
+	}
+	for i := range this.GatewayIDs {
+		if !this.GatewayIDs[i].Equal(&that1.GatewayIDs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayLoads) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayLoads)
+	if !ok {
+		that2, ok := that.(GatewayLoads)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Loads) != len(that1.Loads) {
+		return false
+	}
+	for i := range this.Loads {
+		if !this.Loads[i].Equal(that1.Loads[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayTraffic) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTraffic)
+	if !ok {
+		that2, ok := that.(GatewayTraffic)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if that1.Message == nil {
+		if this.Message != nil {
+			return false
+		}
+	} else if this.Message == nil {
+		return false
+	} else if !this.Message.Equal(that1.Message) {
+		return false
+	}
+	if !this.DropReason.Equal(that1.DropReason) {
+		return false
+	}
+	return true
+}
+func (this *GatewayTraffic_UplinkMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTraffic_UplinkMessage)
+	if !ok {
+		that2, ok := that.(GatewayTraffic_UplinkMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UplinkMessage.Equal(that1.UplinkMessage) {
+		return false
+	}
+	return true
+}
+func (this *GatewayTraffic_GatewayStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTraffic_GatewayStatus)
+	if !ok {
+		that2, ok := that.(GatewayTraffic_GatewayStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayStatus.Equal(that1.GatewayStatus) {
+		return false
+	}
+	return true
+}
+func (this *GatewayTraffic_DownlinkMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTraffic_DownlinkMessage)
+	if !ok {
+		that2, ok := that.(GatewayTraffic_DownlinkMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
+		return false
+	}
+	return true
+}
+func (this *GatewayTraffic_TxAcknowledgment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTraffic_TxAcknowledgment)
+	if !ok {
+		that2, ok := that.(GatewayTraffic_TxAcknowledgment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TxAcknowledgment.Equal(that1.TxAcknowledgment) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GtwGsClient is the client API for GtwGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GtwGsClient interface {
+	// Link the gateway to the Gateway Server.
+	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
+	// GetConcentratorConfig associated to the gateway.
+	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
+	// Get the MQTT server address and the username for the gateway.
+	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+	// Get the MQTTV2 server address and the username for the gateway.
+	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+}
+
+type gtwGsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
 	return &gtwGsClient{cc}
 }
 
@@ -664,6 +1298,9 @@ type NsGsClient interface {
 	// The Gateway Server may refuse if there are any conflicts in the schedule or
 	// if a duty cycle prevents the gateway from transmitting.
 	ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+	// GetGatewayLoads returns the downlink load of the given gateways that are connected to the Gateway Server.
+	// The Network Server uses the load to select the downlink path.
+	GetGatewayLoads(ctx context.Context, in *GetGatewayLoadsRequest, opts ...grpc.CallOption) (*GatewayLoads, error)
 }
 
 type nsGsClient struct {
@@ -683,12 +1320,24 @@ func (c *nsGsClient) ScheduleDownlink(ctx context.Context, in *DownlinkMessage,
 	return out, nil
 }
 
+func (c *nsGsClient) GetGatewayLoads(ctx context.Context, in *GetGatewayLoadsRequest, opts ...grpc.CallOption) (*GatewayLoads, error) {
+	out := new(GatewayLoads)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsGs/GetGatewayLoads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsGsServer is the server API for NsGs service.
 type NsGsServer interface {
 	// ScheduleDownlink instructs the Gateway Server to schedule a downlink message.
 	// The Gateway Server may refuse if there are any conflicts in the schedule or
 	// if a duty cycle prevents the gateway from transmitting.
 	ScheduleDownlink(context.Context, *DownlinkMessage) (*ScheduleDownlinkResponse, error)
+	// GetGatewayLoads returns the downlink load of the given gateways that are connected to the Gateway Server.
+	// The Network Server uses the load to select the downlink path.
+	GetGatewayLoads(context.Context, *GetGatewayLoadsRequest) (*GatewayLoads, error)
 }
 
 // UnimplementedNsGsServer can be embedded to have forward compatible implementations.
@@ -698,6 +1347,9 @@ type UnimplementedNsGsServer struct {
 func (*UnimplementedNsGsServer) ScheduleDownlink(ctx context.Context, req *DownlinkMessage) (*ScheduleDownlinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDownlink not implemented")
 }
+func (*UnimplementedNsGsServer) GetGatewayLoads(ctx context.Context, req *GetGatewayLoadsRequest) (*GatewayLoads, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayLoads not implemented")
+}
 
 func RegisterNsGsServer(s *grpc.Server, srv NsGsServer) {
 	s.RegisterService(&_NsGs_serviceDesc, srv)
@@ -721,6 +1373,24 @@ func _NsGs_ScheduleDownlink_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NsGs_GetGatewayLoads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayLoadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsGsServer).GetGatewayLoads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsGs/GetGatewayLoads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsGsServer).GetGatewayLoads(ctx, req.(*GetGatewayLoadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NsGs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.NsGs",
 	HandlerType: (*NsGsServer)(nil),
@@ -729,6 +1399,10 @@ var _NsGs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ScheduleDownlink",
 			Handler:    _NsGs_ScheduleDownlink_Handler,
 		},
+		{
+			MethodName: "GetGatewayLoads",
+			Handler:    _NsGs_GetGatewayLoads_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/gatewayserver.proto",
@@ -741,6 +1415,9 @@ type GsClient interface {
 	// Get statistics about the current gateway connection to the Gateway Server.
 	// This is not persisted between reconnects.
 	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
+	// Stream the raw traffic of the gateway, including the messages that are dropped by the Gateway Server.
+	// The gateway must be connected to this Gateway Server. The stream ends when the gateway disconnects.
+	TailGateway(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (Gs_TailGatewayClient, error)
 }
 
 type gsClient struct {
@@ -760,11 +1437,46 @@ func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIde
 	return out, nil
 }
 
+func (c *gsClient) TailGateway(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (Gs_TailGatewayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Gs_serviceDesc.Streams[0], "/ttn.lorawan.v3.Gs/TailGateway", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsTailGatewayClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gs_TailGatewayClient interface {
+	Recv() (*GatewayTraffic, error)
+	grpc.ClientStream
+}
+
+type gsTailGatewayClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsTailGatewayClient) Recv() (*GatewayTraffic, error) {
+	m := new(GatewayTraffic)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GsServer is the server API for Gs service.
 type GsServer interface {
 	// Get statistics about the current gateway connection to the Gateway Server.
 	// This is not persisted between reconnects.
 	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+	// Stream the raw traffic of the gateway, including the messages that are dropped by the Gateway Server.
+	// The gateway must be connected to this Gateway Server. The stream ends when the gateway disconnects.
+	TailGateway(*GatewayIdentifiers, Gs_TailGatewayServer) error
 }
 
 // UnimplementedGsServer can be embedded to have forward compatible implementations.
@@ -774,6 +1486,9 @@ type UnimplementedGsServer struct {
 func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req *GatewayIdentifiers) (*GatewayConnectionStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStats not implemented")
 }
+func (*UnimplementedGsServer) TailGateway(req *GatewayIdentifiers, srv Gs_TailGatewayServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailGateway not implemented")
+}
 
 func RegisterGsServer(s *grpc.Server, srv GsServer) {
 	s.RegisterService(&_Gs_serviceDesc, srv)
@@ -797,6 +1512,27 @@ func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gs_TailGateway_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GatewayIdentifiers)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GsServer).TailGateway(m, &gsTailGatewayServer{stream})
+}
+
+type Gs_TailGatewayServer interface {
+	Send(*GatewayTraffic) error
+	grpc.ServerStream
+}
+
+type gsTailGatewayServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsTailGatewayServer) Send(m *GatewayTraffic) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Gs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.Gs",
 	HandlerType: (*GsServer)(nil),
@@ -806,7 +1542,13 @@ var _Gs_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Gs_GetGatewayConnectionStats_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailGateway",
+			Handler:       _Gs_TailGateway_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "lorawan-stack/api/gatewayserver.proto",
 }
 
@@ -974,15 +1716,316 @@ func (m *ScheduleDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGatewayserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GatewayLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *GatewayLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedEmissions != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.QueuedEmissions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SubBands) > 0 {
+		for iNdEx := len(m.SubBands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubBands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayLoad_SubBand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayLoad_SubBand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayLoad_SubBand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DutyCycleUtilization != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DutyCycleUtilization))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.MaxFrequency != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.MaxFrequency))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinFrequency != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.MinFrequency))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetGatewayLoadsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGatewayLoadsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGatewayLoadsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GatewayIDs) > 0 {
+		for iNdEx := len(m.GatewayIDs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GatewayIDs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayLoads) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayLoads) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayLoads) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Loads) > 0 {
+		for iNdEx := len(m.Loads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayTraffic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTraffic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTraffic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DropReason != nil {
+		{
+			size, err := m.DropReason.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Message != nil {
+		{
+			size := m.Message.Size()
+			i -= size
+			if _, err := m.Message.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayTraffic_UplinkMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTraffic_UplinkMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UplinkMessage != nil {
+		{
+			size, err := m.UplinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *GatewayTraffic_GatewayStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTraffic_GatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GatewayStatus != nil {
+		{
+			size, err := m.GatewayStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *GatewayTraffic_DownlinkMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTraffic_DownlinkMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DownlinkMessage != nil {
+		{
+			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *GatewayTraffic_TxAcknowledgment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTraffic_TxAcknowledgment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TxAcknowledgment != nil {
+		{
+			size, err := m.TxAcknowledgment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGatewayserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
@@ -1038,28 +2081,131 @@ func NewPopulatedScheduleDownlinkErrorDetails(r randyGatewayserver, easy bool) *
 	return this
 }
 
-type randyGatewayserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func NewPopulatedGatewayLoad(r randyGatewayserver, easy bool) *GatewayLoad {
+	this := &GatewayLoad{}
+	v4 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v4
+	if r.Intn(5) != 0 {
+		v5 := r.Intn(5)
+		this.SubBands = make([]*GatewayLoad_SubBand, v5)
+		for i := 0; i < v5; i++ {
+			this.SubBands[i] = NewPopulatedGatewayLoad_SubBand(r, easy)
+		}
+	}
+	this.QueuedEmissions = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
+func NewPopulatedGatewayLoad_SubBand(r randyGatewayserver, easy bool) *GatewayLoad_SubBand {
+	this := &GatewayLoad_SubBand{}
+	this.MinFrequency = uint64(uint64(r.Uint32()))
+	this.MaxFrequency = uint64(uint64(r.Uint32()))
+	this.DutyCycleUtilization = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.DutyCycleUtilization *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetGatewayLoadsRequest(r randyGatewayserver, easy bool) *GetGatewayLoadsRequest {
+	this := &GetGatewayLoadsRequest{}
+	if r.Intn(5) != 0 {
+		v6 := r.Intn(5)
+		this.GatewayIDs = make([]GatewayIdentifiers, v6)
+		for i := 0; i < v6; i++ {
+			v7 := NewPopulatedGatewayIdentifiers(r, easy)
+			this.GatewayIDs[i] = *v7
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayLoads(r randyGatewayserver, easy bool) *GatewayLoads {
+	this := &GatewayLoads{}
+	if r.Intn(5) != 0 {
+		v8 := r.Intn(5)
+		this.Loads = make([]*GatewayLoad, v8)
+		for i := 0; i < v8; i++ {
+			this.Loads[i] = NewPopulatedGatewayLoad(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTraffic(r randyGatewayserver, easy bool) *GatewayTraffic {
+	this := &GatewayTraffic{}
+	v9 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v9
+	oneofNumber_Message := []int32{2, 3, 4, 5}[r.Intn(4)]
+	switch oneofNumber_Message {
+	case 2:
+		this.Message = NewPopulatedGatewayTraffic_UplinkMessage(r, easy)
+	case 3:
+		this.Message = NewPopulatedGatewayTraffic_GatewayStatus(r, easy)
+	case 4:
+		this.Message = NewPopulatedGatewayTraffic_DownlinkMessage(r, easy)
+	case 5:
+		this.Message = NewPopulatedGatewayTraffic_TxAcknowledgment(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.DropReason = NewPopulatedErrorDetails(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTraffic_UplinkMessage(r randyGatewayserver, easy bool) *GatewayTraffic_UplinkMessage {
+	this := &GatewayTraffic_UplinkMessage{}
+	this.UplinkMessage = NewPopulatedUplinkMessage(r, easy)
+	return this
+}
+func NewPopulatedGatewayTraffic_GatewayStatus(r randyGatewayserver, easy bool) *GatewayTraffic_GatewayStatus {
+	this := &GatewayTraffic_GatewayStatus{}
+	this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	return this
+}
+func NewPopulatedGatewayTraffic_DownlinkMessage(r randyGatewayserver, easy bool) *GatewayTraffic_DownlinkMessage {
+	this := &GatewayTraffic_DownlinkMessage{}
+	this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	return this
+}
+func NewPopulatedGatewayTraffic_TxAcknowledgment(r randyGatewayserver, easy bool) *GatewayTraffic_TxAcknowledgment {
+	this := &GatewayTraffic_TxAcknowledgment{}
+	this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	return this
+}
+
+type randyGatewayserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
 		return rune(ru + 55)
 	}
 	return rune(ru + 61)
 }
 func randStringGatewayserver(r randyGatewayserver) string {
-	v4 := r.Intn(100)
-	tmps := make([]rune, v4)
-	for i := 0; i < v4; i++ {
+	v10 := r.Intn(100)
+	tmps := make([]rune, v10)
+	for i := 0; i < v10; i++ {
 		tmps[i] = randUTF8RuneGatewayserver(r)
 	}
 	return string(tmps)
@@ -1081,11 +2227,11 @@ func randFieldGatewayserver(dAtA []byte, r randyGatewayserver, fieldNumber int,
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		v5 := r.Int63()
+		v11 := r.Int63()
 		if r.Intn(2) == 0 {
-			v5 *= -1
+			v11 *= -1
 		}
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v5))
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v11))
 	case 1:
 		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1172,6 +2318,141 @@ func (m *ScheduleDownlinkErrorDetails) Size() (n int) {
 	return n
 }
 
+func (m *GatewayLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if len(m.SubBands) > 0 {
+		for _, e := range m.SubBands {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if m.QueuedEmissions != 0 {
+		n += 1 + sovGatewayserver(uint64(m.QueuedEmissions))
+	}
+	return n
+}
+
+func (m *GatewayLoad_SubBand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinFrequency != 0 {
+		n += 1 + sovGatewayserver(uint64(m.MinFrequency))
+	}
+	if m.MaxFrequency != 0 {
+		n += 1 + sovGatewayserver(uint64(m.MaxFrequency))
+	}
+	if m.DutyCycleUtilization != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *GetGatewayLoadsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GatewayIDs) > 0 {
+		for _, e := range m.GatewayIDs {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayLoads) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Loads) > 0 {
+		for _, e := range m.Loads {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayTraffic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Message != nil {
+		n += m.Message.Size()
+	}
+	if m.DropReason != nil {
+		l = m.DropReason.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayTraffic_UplinkMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UplinkMessage != nil {
+		l = m.UplinkMessage.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+func (m *GatewayTraffic_GatewayStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GatewayStatus != nil {
+		l = m.GatewayStatus.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+func (m *GatewayTraffic_DownlinkMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DownlinkMessage != nil {
+		l = m.DownlinkMessage.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+func (m *GatewayTraffic_TxAcknowledgment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxAcknowledgment != nil {
+		l = m.TxAcknowledgment.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
 func sovGatewayserver(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1230,15 +2511,686 @@ func (this *ScheduleDownlinkErrorDetails) String() string {
 	}, "")
 	return s
 }
+func (this *GatewayLoad) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubBands := "[]*GatewayLoad_SubBand{"
+	for _, f := range this.SubBands {
+		repeatedStringForSubBands += strings.Replace(fmt.Sprintf("%v", f), "GatewayLoad_SubBand", "GatewayLoad_SubBand", 1) + ","
+	}
+	repeatedStringForSubBands += "}"
+	s := strings.Join([]string{`&GatewayLoad{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`SubBands:` + repeatedStringForSubBands + `,`,
+		`QueuedEmissions:` + fmt.Sprintf("%v", this.QueuedEmissions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayLoad_SubBand) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayLoad_SubBand{`,
+		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
+		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
+		`DutyCycleUtilization:` + fmt.Sprintf("%v", this.DutyCycleUtilization) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetGatewayLoadsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForGatewayIDs := "[]GatewayIdentifiers{"
+	for _, f := range this.GatewayIDs {
+		repeatedStringForGatewayIDs += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForGatewayIDs += "}"
+	s := strings.Join([]string{`&GetGatewayLoadsRequest{`,
+		`GatewayIDs:` + repeatedStringForGatewayIDs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayLoads) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLoads := "[]*GatewayLoad{"
+	for _, f := range this.Loads {
+		repeatedStringForLoads += strings.Replace(f.String(), "GatewayLoad", "GatewayLoad", 1) + ","
+	}
+	repeatedStringForLoads += "}"
+	s := strings.Join([]string{`&GatewayLoads{`,
+		`Loads:` + repeatedStringForLoads + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTraffic) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTraffic{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`DropReason:` + strings.Replace(fmt.Sprintf("%v", this.DropReason), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTraffic_UplinkMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTraffic_UplinkMessage{`,
+		`UplinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.UplinkMessage), "UplinkMessage", "UplinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTraffic_GatewayStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTraffic_GatewayStatus{`,
+		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTraffic_DownlinkMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTraffic_DownlinkMessage{`,
+		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTraffic_TxAcknowledgment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTraffic_TxAcknowledgment{`,
+		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGatewayserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
+			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayStatus == nil {
+				m.GatewayStatus = &GatewayStatus{}
+			}
+			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxAcknowledgment == nil {
+				m.TxAcknowledgment = &TxAcknowledgment{}
+			}
+			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
+			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayLoad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayLoad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayLoad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubBands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubBands = append(m.SubBands, &GatewayLoad_SubBand{})
+			if err := m.SubBands[len(m.SubBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedEmissions", wireType)
+			}
+			m.QueuedEmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedEmissions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+func (m *GatewayLoad_SubBand) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,17 +3213,17 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubBand: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubBand: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
 			}
-			var msglen int
+			m.MinFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGatewayserver
@@ -1281,31 +3233,16 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGatewayserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGatewayserver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
-			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
 			}
-			var msglen int
+			m.MaxFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGatewayserver
@@ -1315,64 +3252,22 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGatewayserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGatewayserver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GatewayStatus == nil {
-				m.GatewayStatus = &GatewayStatus{}
-			}
-			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGatewayserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGatewayserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGatewayserver
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutyCycleUtilization", wireType)
 			}
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxAcknowledgment == nil {
-				m.TxAcknowledgment = &TxAcknowledgment{}
-			}
-			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.DutyCycleUtilization = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGatewayserver(dAtA[iNdEx:])
@@ -1397,7 +3292,7 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+func (m *GetGatewayLoadsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1420,15 +3315,15 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGatewayLoadsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGatewayLoadsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1455,10 +3350,8 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkMessage == nil {
-				m.DownlinkMessage = &DownlinkMessage{}
-			}
-			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GatewayIDs = append(m.GatewayIDs, GatewayIdentifiers{})
+			if err := m.GatewayIDs[len(m.GatewayIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1486,7 +3379,7 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+func (m *GatewayLoads) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1509,15 +3402,15 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayLoads: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayLoads: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Loads", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1544,7 +3437,8 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+			m.Loads = append(m.Loads, &GatewayLoad{})
+			if err := m.Loads[len(m.Loads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1572,7 +3466,7 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
+func (m *GatewayTraffic) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1595,15 +3489,15 @@ func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayTraffic: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayTraffic: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1630,8 +3524,183 @@ func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
-			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UplinkMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &GatewayTraffic_UplinkMessage{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GatewayStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &GatewayTraffic_GatewayStatus{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DownlinkMessage{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &GatewayTraffic_DownlinkMessage{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TxAcknowledgment{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &GatewayTraffic_TxAcknowledgment{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropReason", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DropReason == nil {
+				m.DropReason = &ErrorDetails{}
+			}
+			if err := m.DropReason.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
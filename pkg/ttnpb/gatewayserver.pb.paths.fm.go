@@ -13,6 +13,91 @@ var GatewayUpFieldPathsNested = []string{
 	"gateway_status.versions",
 	"tx_acknowledgment",
 	"tx_acknowledgment.correlation_ids",
+	"tx_acknowledgment.downlink_message",
+	"tx_acknowledgment.downlink_message.correlation_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids.application_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids.application_ids.application_id",
+	"tx_acknowledgment.downlink_message.end_device_ids.dev_addr",
+	"tx_acknowledgment.downlink_message.end_device_ids.dev_eui",
+	"tx_acknowledgment.downlink_message.end_device_ids.device_id",
+	"tx_acknowledgment.downlink_message.end_device_ids.join_eui",
+	"tx_acknowledgment.downlink_message.payload",
+	"tx_acknowledgment.downlink_message.payload.Payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_port",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"tx_acknowledgment.downlink_message.payload.m_hdr",
+	"tx_acknowledgment.downlink_message.payload.m_hdr.m_type",
+	"tx_acknowledgment.downlink_message.payload.m_hdr.major",
+	"tx_acknowledgment.downlink_message.payload.mic",
+	"tx_acknowledgment.downlink_message.raw_payload",
+	"tx_acknowledgment.downlink_message.settings",
+	"tx_acknowledgment.downlink_message.settings.request",
+	"tx_acknowledgment.downlink_message.settings.request.absolute_time",
+	"tx_acknowledgment.downlink_message.settings.request.advanced",
+	"tx_acknowledgment.downlink_message.settings.request.class",
+	"tx_acknowledgment.downlink_message.settings.request.downlink_paths",
+	"tx_acknowledgment.downlink_message.settings.request.frequency_plan_id",
+	"tx_acknowledgment.downlink_message.settings.request.priority",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_delay",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_frequency",
+	"tx_acknowledgment.downlink_message.settings.request.rx2_data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.request.rx2_frequency",
+	"tx_acknowledgment.downlink_message.settings.scheduled",
+	"tx_acknowledgment.downlink_message.settings.scheduled.coding_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.antenna_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.tx_power",
+	"tx_acknowledgment.downlink_message.settings.scheduled.enable_crc",
+	"tx_acknowledgment.downlink_message.settings.scheduled.frequency",
+	"tx_acknowledgment.downlink_message.settings.scheduled.time",
+	"tx_acknowledgment.downlink_message.settings.scheduled.timestamp",
 	"tx_acknowledgment.result",
 	"uplink_messages",
 }
@@ -127,3 +212,314 @@ var ScheduleDownlinkErrorDetailsFieldPathsNested = []string{
 var ScheduleDownlinkErrorDetailsFieldPathsTopLevel = []string{
 	"path_errors",
 }
+var GatewayLoadFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"queued_emissions",
+	"sub_bands",
+}
+
+var GatewayLoadFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"queued_emissions",
+	"sub_bands",
+}
+var GetGatewayLoadsRequestFieldPathsNested = []string{
+	"gateway_ids",
+}
+
+var GetGatewayLoadsRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+}
+var GatewayLoadsFieldPathsNested = []string{
+	"loads",
+}
+
+var GatewayLoadsFieldPathsTopLevel = []string{
+	"loads",
+}
+var GatewayTrafficFieldPathsNested = []string{
+	"drop_reason",
+	"drop_reason.attributes",
+	"drop_reason.cause",
+	"drop_reason.cause.attributes",
+	"drop_reason.cause.correlation_id",
+	"drop_reason.cause.message_format",
+	"drop_reason.cause.name",
+	"drop_reason.cause.namespace",
+	"drop_reason.code",
+	"drop_reason.correlation_id",
+	"drop_reason.details",
+	"drop_reason.message_format",
+	"drop_reason.name",
+	"drop_reason.namespace",
+	"message",
+	"message.downlink_message",
+	"message.downlink_message.correlation_ids",
+	"message.downlink_message.end_device_ids",
+	"message.downlink_message.end_device_ids.application_ids",
+	"message.downlink_message.end_device_ids.application_ids.application_id",
+	"message.downlink_message.end_device_ids.dev_addr",
+	"message.downlink_message.end_device_ids.dev_eui",
+	"message.downlink_message.end_device_ids.device_id",
+	"message.downlink_message.end_device_ids.join_eui",
+	"message.downlink_message.payload",
+	"message.downlink_message.payload.Payload",
+	"message.downlink_message.payload.Payload.join_accept_payload",
+	"message.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"message.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"message.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"message.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"message.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"message.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"message.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"message.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"message.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"message.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"message.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"message.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"message.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"message.downlink_message.payload.Payload.join_request_payload",
+	"message.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"message.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"message.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"message.downlink_message.payload.Payload.mac_payload",
+	"message.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"message.downlink_message.payload.Payload.mac_payload.f_port",
+	"message.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"message.downlink_message.payload.Payload.rejoin_request_payload",
+	"message.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"message.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"message.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"message.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"message.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"message.downlink_message.payload.m_hdr",
+	"message.downlink_message.payload.m_hdr.m_type",
+	"message.downlink_message.payload.m_hdr.major",
+	"message.downlink_message.payload.mic",
+	"message.downlink_message.raw_payload",
+	"message.downlink_message.settings",
+	"message.downlink_message.settings.request",
+	"message.downlink_message.settings.request.absolute_time",
+	"message.downlink_message.settings.request.advanced",
+	"message.downlink_message.settings.request.class",
+	"message.downlink_message.settings.request.downlink_paths",
+	"message.downlink_message.settings.request.frequency_plan_id",
+	"message.downlink_message.settings.request.priority",
+	"message.downlink_message.settings.request.rx1_data_rate_index",
+	"message.downlink_message.settings.request.rx1_delay",
+	"message.downlink_message.settings.request.rx1_frequency",
+	"message.downlink_message.settings.request.rx2_data_rate_index",
+	"message.downlink_message.settings.request.rx2_frequency",
+	"message.downlink_message.settings.scheduled",
+	"message.downlink_message.settings.scheduled.coding_rate",
+	"message.downlink_message.settings.scheduled.data_rate",
+	"message.downlink_message.settings.scheduled.data_rate.modulation",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"message.downlink_message.settings.scheduled.data_rate_index",
+	"message.downlink_message.settings.scheduled.downlink",
+	"message.downlink_message.settings.scheduled.downlink.antenna_index",
+	"message.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"message.downlink_message.settings.scheduled.downlink.tx_power",
+	"message.downlink_message.settings.scheduled.enable_crc",
+	"message.downlink_message.settings.scheduled.frequency",
+	"message.downlink_message.settings.scheduled.time",
+	"message.downlink_message.settings.scheduled.timestamp",
+	"message.gateway_status",
+	"message.gateway_status.advanced",
+	"message.gateway_status.antenna_locations",
+	"message.gateway_status.boot_time",
+	"message.gateway_status.ip",
+	"message.gateway_status.metrics",
+	"message.gateway_status.time",
+	"message.gateway_status.versions",
+	"message.tx_acknowledgment",
+	"message.tx_acknowledgment.correlation_ids",
+	"message.tx_acknowledgment.downlink_message",
+	"message.tx_acknowledgment.downlink_message.correlation_ids",
+	"message.tx_acknowledgment.downlink_message.end_device_ids",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.application_ids",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.application_ids.application_id",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.dev_addr",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.dev_eui",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.device_id",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.join_eui",
+	"message.tx_acknowledgment.downlink_message.payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_port",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"message.tx_acknowledgment.downlink_message.payload.m_hdr",
+	"message.tx_acknowledgment.downlink_message.payload.m_hdr.m_type",
+	"message.tx_acknowledgment.downlink_message.payload.m_hdr.major",
+	"message.tx_acknowledgment.downlink_message.payload.mic",
+	"message.tx_acknowledgment.downlink_message.raw_payload",
+	"message.tx_acknowledgment.downlink_message.settings",
+	"message.tx_acknowledgment.downlink_message.settings.request",
+	"message.tx_acknowledgment.downlink_message.settings.request.absolute_time",
+	"message.tx_acknowledgment.downlink_message.settings.request.advanced",
+	"message.tx_acknowledgment.downlink_message.settings.request.class",
+	"message.tx_acknowledgment.downlink_message.settings.request.downlink_paths",
+	"message.tx_acknowledgment.downlink_message.settings.request.frequency_plan_id",
+	"message.tx_acknowledgment.downlink_message.settings.request.priority",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate_index",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_delay",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_frequency",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate_index",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_frequency",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.coding_rate",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate_index",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.downlink",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.downlink.antenna_index",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.downlink.tx_power",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.enable_crc",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.frequency",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.time",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.timestamp",
+	"message.tx_acknowledgment.result",
+	"message.uplink_message",
+	"message.uplink_message.correlation_ids",
+	"message.uplink_message.device_channel_index",
+	"message.uplink_message.payload",
+	"message.uplink_message.payload.Payload",
+	"message.uplink_message.payload.Payload.join_accept_payload",
+	"message.uplink_message.payload.Payload.join_accept_payload.cf_list",
+	"message.uplink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"message.uplink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"message.uplink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"message.uplink_message.payload.Payload.join_accept_payload.dev_addr",
+	"message.uplink_message.payload.Payload.join_accept_payload.dl_settings",
+	"message.uplink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"message.uplink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"message.uplink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"message.uplink_message.payload.Payload.join_accept_payload.encrypted",
+	"message.uplink_message.payload.Payload.join_accept_payload.join_nonce",
+	"message.uplink_message.payload.Payload.join_accept_payload.net_id",
+	"message.uplink_message.payload.Payload.join_accept_payload.rx_delay",
+	"message.uplink_message.payload.Payload.join_request_payload",
+	"message.uplink_message.payload.Payload.join_request_payload.dev_eui",
+	"message.uplink_message.payload.Payload.join_request_payload.dev_nonce",
+	"message.uplink_message.payload.Payload.join_request_payload.join_eui",
+	"message.uplink_message.payload.Payload.mac_payload",
+	"message.uplink_message.payload.Payload.mac_payload.decoded_payload",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"message.uplink_message.payload.Payload.mac_payload.f_port",
+	"message.uplink_message.payload.Payload.mac_payload.frm_payload",
+	"message.uplink_message.payload.Payload.rejoin_request_payload",
+	"message.uplink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"message.uplink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"message.uplink_message.payload.Payload.rejoin_request_payload.net_id",
+	"message.uplink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"message.uplink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"message.uplink_message.payload.m_hdr",
+	"message.uplink_message.payload.m_hdr.m_type",
+	"message.uplink_message.payload.m_hdr.major",
+	"message.uplink_message.payload.mic",
+	"message.uplink_message.raw_payload",
+	"message.uplink_message.received_at",
+	"message.uplink_message.rx_metadata",
+	"message.uplink_message.settings",
+	"message.uplink_message.settings.coding_rate",
+	"message.uplink_message.settings.data_rate",
+	"message.uplink_message.settings.data_rate.modulation",
+	"message.uplink_message.settings.data_rate.modulation.fsk",
+	"message.uplink_message.settings.data_rate.modulation.fsk.bit_rate",
+	"message.uplink_message.settings.data_rate.modulation.lora",
+	"message.uplink_message.settings.data_rate.modulation.lora.bandwidth",
+	"message.uplink_message.settings.data_rate.modulation.lora.spreading_factor",
+	"message.uplink_message.settings.data_rate_index",
+	"message.uplink_message.settings.downlink",
+	"message.uplink_message.settings.downlink.antenna_index",
+	"message.uplink_message.settings.downlink.invert_polarization",
+	"message.uplink_message.settings.downlink.tx_power",
+	"message.uplink_message.settings.enable_crc",
+	"message.uplink_message.settings.frequency",
+	"message.uplink_message.settings.time",
+	"message.uplink_message.settings.timestamp",
+	"time",
+}
+
+var GatewayTrafficFieldPathsTopLevel = []string{
+	"drop_reason",
+	"message",
+	"time",
+}
+var GatewayLoad_SubBandFieldPathsNested = []string{
+	"duty_cycle_utilization",
+	"max_frequency",
+	"min_frequency",
+}
+
+var GatewayLoad_SubBandFieldPathsTopLevel = []string{
+	"duty_cycle_utilization",
+	"max_frequency",
+	"min_frequency",
+}
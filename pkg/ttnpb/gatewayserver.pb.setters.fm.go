@@ -153,3 +153,331 @@ func (dst *ScheduleDownlinkErrorDetails) SetFields(src *ScheduleDownlinkErrorDet
 	}
 	return nil
 }
+
+func (dst *GatewayLoad) SetFields(src *GatewayLoad, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "sub_bands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sub_bands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubBands = src.SubBands
+			} else {
+				dst.SubBands = nil
+			}
+		case "queued_emissions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'queued_emissions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.QueuedEmissions = src.QueuedEmissions
+			} else {
+				var zero uint32
+				dst.QueuedEmissions = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewayLoadsRequest) SetFields(src *GetGatewayLoadsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayIDs = src.GatewayIDs
+			} else {
+				dst.GatewayIDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayLoads) SetFields(src *GatewayLoads, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "loads":
+			if len(subs) > 0 {
+				return fmt.Errorf("'loads' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Loads = src.Loads
+			} else {
+				dst.Loads = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTraffic) SetFields(src *GatewayTraffic, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				var zero time.Time
+				dst.Time = zero
+			}
+		case "drop_reason":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.DropReason == nil) && dst.DropReason == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DropReason
+				}
+				if dst.DropReason != nil {
+					newDst = dst.DropReason
+				} else {
+					newDst = &ErrorDetails{}
+					dst.DropReason = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DropReason = src.DropReason
+				} else {
+					dst.DropReason = nil
+				}
+			}
+
+		case "message":
+			if len(subs) == 0 && src == nil {
+				dst.Message = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Message = src.Message
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "uplink_message":
+					_, srcOk := src.Message.(*GatewayTraffic_UplinkMessage)
+					if !srcOk && src.Message != nil {
+						return fmt.Errorf("attempt to set oneof 'uplink_message', while different oneof is set in source")
+					}
+					_, dstOk := dst.Message.(*GatewayTraffic_UplinkMessage)
+					if !dstOk && dst.Message != nil {
+						return fmt.Errorf("attempt to set oneof 'uplink_message', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *UplinkMessage
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Message.(*GatewayTraffic_UplinkMessage).UplinkMessage
+						}
+						if dstOk {
+							newDst = dst.Message.(*GatewayTraffic_UplinkMessage).UplinkMessage
+						} else {
+							newDst = &UplinkMessage{}
+							dst.Message = &GatewayTraffic_UplinkMessage{UplinkMessage: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+				case "gateway_status":
+					_, srcOk := src.Message.(*GatewayTraffic_GatewayStatus)
+					if !srcOk && src.Message != nil {
+						return fmt.Errorf("attempt to set oneof 'gateway_status', while different oneof is set in source")
+					}
+					_, dstOk := dst.Message.(*GatewayTraffic_GatewayStatus)
+					if !dstOk && dst.Message != nil {
+						return fmt.Errorf("attempt to set oneof 'gateway_status', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GatewayStatus
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Message.(*GatewayTraffic_GatewayStatus).GatewayStatus
+						}
+						if dstOk {
+							newDst = dst.Message.(*GatewayTraffic_GatewayStatus).GatewayStatus
+						} else {
+							newDst = &GatewayStatus{}
+							dst.Message = &GatewayTraffic_GatewayStatus{GatewayStatus: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+				case "downlink_message":
+					_, srcOk := src.Message.(*GatewayTraffic_DownlinkMessage)
+					if !srcOk && src.Message != nil {
+						return fmt.Errorf("attempt to set oneof 'downlink_message', while different oneof is set in source")
+					}
+					_, dstOk := dst.Message.(*GatewayTraffic_DownlinkMessage)
+					if !dstOk && dst.Message != nil {
+						return fmt.Errorf("attempt to set oneof 'downlink_message', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *DownlinkMessage
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Message.(*GatewayTraffic_DownlinkMessage).DownlinkMessage
+						}
+						if dstOk {
+							newDst = dst.Message.(*GatewayTraffic_DownlinkMessage).DownlinkMessage
+						} else {
+							newDst = &DownlinkMessage{}
+							dst.Message = &GatewayTraffic_DownlinkMessage{DownlinkMessage: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+				case "tx_acknowledgment":
+					_, srcOk := src.Message.(*GatewayTraffic_TxAcknowledgment)
+					if !srcOk && src.Message != nil {
+						return fmt.Errorf("attempt to set oneof 'tx_acknowledgment', while different oneof is set in source")
+					}
+					_, dstOk := dst.Message.(*GatewayTraffic_TxAcknowledgment)
+					if !dstOk && dst.Message != nil {
+						return fmt.Errorf("attempt to set oneof 'tx_acknowledgment', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *TxAcknowledgment
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Message.(*GatewayTraffic_TxAcknowledgment).TxAcknowledgment
+						}
+						if dstOk {
+							newDst = dst.Message.(*GatewayTraffic_TxAcknowledgment).TxAcknowledgment
+						} else {
+							newDst = &TxAcknowledgment{}
+							dst.Message = &GatewayTraffic_TxAcknowledgment{TxAcknowledgment: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayLoad_SubBand) SetFields(src *GatewayLoad_SubBand, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinFrequency = src.MinFrequency
+			} else {
+				var zero uint64
+				dst.MinFrequency = zero
+			}
+		case "max_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxFrequency = src.MaxFrequency
+			} else {
+				var zero uint64
+				dst.MaxFrequency = zero
+			}
+		case "duty_cycle_utilization":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duty_cycle_utilization' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DutyCycleUtilization = src.DutyCycleUtilization
+			} else {
+				var zero float32
+				dst.DutyCycleUtilization = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
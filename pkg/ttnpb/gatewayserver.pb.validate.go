@@ -428,3 +428,572 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ScheduleDownlinkErrorDetailsValidationError{}
+
+// ValidateFields checks the field values on GatewayLoad with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GatewayLoad) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayLoadFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayLoadValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "sub_bands":
+
+			for idx, item := range m.GetSubBands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayLoadValidationError{
+							field:  fmt.Sprintf("sub_bands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "queued_emissions":
+			// no validation rules for QueuedEmissions
+		default:
+			return GatewayLoadValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayLoadValidationError is the validation error returned by
+// GatewayLoad.ValidateFields if the designated constraints aren't met.
+type GatewayLoadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayLoadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayLoadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayLoadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayLoadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayLoadValidationError) ErrorName() string { return "GatewayLoadValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GatewayLoadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayLoad.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayLoadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayLoadValidationError{}
+
+// ValidateFields checks the field values on GetGatewayLoadsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetGatewayLoadsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayLoadsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			for idx, item := range m.GatewayIDs {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GetGatewayLoadsRequestValidationError{
+							field:  fmt.Sprintf("gateway_ids[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GetGatewayLoadsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayLoadsRequestValidationError is the validation error returned by
+// GetGatewayLoadsRequest.ValidateFields if the designated constraints aren't met.
+type GetGatewayLoadsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayLoadsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewayLoadsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewayLoadsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewayLoadsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayLoadsRequestValidationError) ErrorName() string {
+	return "GetGatewayLoadsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayLoadsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayLoadsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayLoadsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayLoadsRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayLoads with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayLoads) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayLoadsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "loads":
+
+			for idx, item := range m.GetLoads() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayLoadsValidationError{
+							field:  fmt.Sprintf("loads[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayLoadsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayLoadsValidationError is the validation error returned by
+// GatewayLoads.ValidateFields if the designated constraints aren't met.
+type GatewayLoadsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayLoadsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayLoadsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayLoadsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayLoadsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayLoadsValidationError) ErrorName() string { return "GatewayLoadsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GatewayLoadsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayLoads.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayLoadsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayLoadsValidationError{}
+
+// ValidateFields checks the field values on GatewayTraffic with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayTraffic) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if v, ok := interface{}(&m.Time).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficValidationError{
+						field:  "time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "drop_reason":
+
+			if v, ok := interface{}(m.GetDropReason()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficValidationError{
+						field:  "drop_reason",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "message":
+			if len(subs) == 0 {
+				subs = []string{
+					"uplink_message", "gateway_status", "downlink_message", "tx_acknowledgment",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "uplink_message":
+					w, ok := m.Message.(*GatewayTraffic_UplinkMessage)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetUplinkMessage()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayTrafficValidationError{
+								field:  "uplink_message",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "gateway_status":
+					w, ok := m.Message.(*GatewayTraffic_GatewayStatus)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetGatewayStatus()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayTrafficValidationError{
+								field:  "gateway_status",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "downlink_message":
+					w, ok := m.Message.(*GatewayTraffic_DownlinkMessage)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetDownlinkMessage()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayTrafficValidationError{
+								field:  "downlink_message",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "tx_acknowledgment":
+					w, ok := m.Message.(*GatewayTraffic_TxAcknowledgment)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetTxAcknowledgment()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayTrafficValidationError{
+								field:  "tx_acknowledgment",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		default:
+			return GatewayTrafficValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficValidationError is the validation error returned by
+// GatewayTraffic.ValidateFields if the designated constraints aren't met.
+type GatewayTrafficValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficValidationError) ErrorName() string { return "GatewayTrafficValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTraffic.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficValidationError{}
+
+// ValidateFields checks the field values on GatewayLoad_SubBand with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayLoad_SubBand) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayLoad_SubBandFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min_frequency":
+			// no validation rules for MinFrequency
+		case "max_frequency":
+			// no validation rules for MaxFrequency
+		case "duty_cycle_utilization":
+			// no validation rules for DutyCycleUtilization
+		default:
+			return GatewayLoad_SubBandValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayLoad_SubBandValidationError is the validation error returned by
+// GatewayLoad_SubBand.ValidateFields if the designated constraints aren't met.
+type GatewayLoad_SubBandValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayLoad_SubBandValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayLoad_SubBandValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayLoad_SubBandValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayLoad_SubBandValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayLoad_SubBandValidationError) ErrorName() string {
+	return "GatewayLoad_SubBandValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayLoad_SubBandValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayLoad_SubBand.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayLoad_SubBandValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayLoad_SubBandValidationError{}
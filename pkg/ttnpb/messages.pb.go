@@ -83,6 +83,7 @@ const (
 	TxAcknowledgment_TX_FREQ          TxAcknowledgment_Result = 6
 	TxAcknowledgment_TX_POWER         TxAcknowledgment_Result = 7
 	TxAcknowledgment_GPS_UNLOCKED     TxAcknowledgment_Result = 8
+	TxAcknowledgment_CHANNEL_BUSY     TxAcknowledgment_Result = 9
 )
 
 var TxAcknowledgment_Result_name = map[int32]string{
@@ -95,6 +96,7 @@ var TxAcknowledgment_Result_name = map[int32]string{
 	6: "TX_FREQ",
 	7: "TX_POWER",
 	8: "GPS_UNLOCKED",
+	9: "CHANNEL_BUSY",
 }
 
 var TxAcknowledgment_Result_value = map[string]int32{
@@ -107,6 +109,7 @@ var TxAcknowledgment_Result_value = map[string]int32{
 	"TX_FREQ":          6,
 	"TX_POWER":         7,
 	"GPS_UNLOCKED":     8,
+	"CHANNEL_BUSY":     9,
 }
 
 func (TxAcknowledgment_Result) EnumDescriptor() ([]byte, []int) {
@@ -332,10 +335,13 @@ func (*DownlinkMessage) XXX_OneofWrappers() []interface{} {
 }
 
 type TxAcknowledgment struct {
-	CorrelationIDs       []string                `protobuf:"bytes,1,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	Result               TxAcknowledgment_Result `protobuf:"varint,2,opt,name=result,proto3,enum=ttn.lorawan.v3.TxAcknowledgment_Result" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	CorrelationIDs []string                `protobuf:"bytes,1,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	Result         TxAcknowledgment_Result `protobuf:"varint,2,opt,name=result,proto3,enum=ttn.lorawan.v3.TxAcknowledgment_Result" json:"result,omitempty"`
+	// Downlink message that is acknowledged, as requested on the downlink path of the gateway.
+	// This is set by the Gateway Server.
+	DownlinkMessage      *DownlinkMessage `protobuf:"bytes,3,opt,name=downlink_message,json=downlinkMessage,proto3" json:"downlink_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *TxAcknowledgment) Reset()      { *m = TxAcknowledgment{} }
@@ -384,6 +390,59 @@ func (m *TxAcknowledgment) GetResult() TxAcknowledgment_Result {
 	return TxAcknowledgment_SUCCESS
 }
 
+func (m *TxAcknowledgment) GetDownlinkMessage() *DownlinkMessage {
+	if m != nil {
+		return m.DownlinkMessage
+	}
+	return nil
+}
+
+type GatewayTxAcknowledgment struct {
+	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	TxAck                *TxAcknowledgment `protobuf:"bytes,2,opt,name=tx_ack,json=txAck,proto3" json:"tx_ack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GatewayTxAcknowledgment) Reset()      { *m = GatewayTxAcknowledgment{} }
+func (*GatewayTxAcknowledgment) ProtoMessage() {}
+func (*GatewayTxAcknowledgment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{3}
+}
+func (m *GatewayTxAcknowledgment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayTxAcknowledgment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTxAcknowledgment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayTxAcknowledgment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTxAcknowledgment.Merge(m, src)
+}
+func (m *GatewayTxAcknowledgment) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayTxAcknowledgment) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTxAcknowledgment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayTxAcknowledgment proto.InternalMessageInfo
+
+func (m *GatewayTxAcknowledgment) GetTxAck() *TxAcknowledgment {
+	if m != nil {
+		return m.TxAck
+	}
+	return nil
+}
+
 type GatewayUplinkMessage struct {
 	*UplinkMessage `protobuf:"bytes,1,opt,name=message,proto3,embedded=message" json:"message,omitempty"`
 	// LoRaWAN band ID of the gateway.
@@ -395,7 +454,7 @@ type GatewayUplinkMessage struct {
 func (m *GatewayUplinkMessage) Reset()      { *m = GatewayUplinkMessage{} }
 func (*GatewayUplinkMessage) ProtoMessage() {}
 func (*GatewayUplinkMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{3}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{4}
 }
 func (m *GatewayUplinkMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +523,7 @@ type ApplicationUplink struct {
 func (m *ApplicationUplink) Reset()      { *m = ApplicationUplink{} }
 func (*ApplicationUplink) ProtoMessage() {}
 func (*ApplicationUplink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{4}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{5}
 }
 func (m *ApplicationUplink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +633,7 @@ type ApplicationLocation struct {
 func (m *ApplicationLocation) Reset()      { *m = ApplicationLocation{} }
 func (*ApplicationLocation) ProtoMessage() {}
 func (*ApplicationLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{5}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{6}
 }
 func (m *ApplicationLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +695,7 @@ type ApplicationJoinAccept struct {
 func (m *ApplicationJoinAccept) Reset()      { *m = ApplicationJoinAccept{} }
 func (*ApplicationJoinAccept) ProtoMessage() {}
 func (*ApplicationJoinAccept) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{6}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{7}
 }
 func (m *ApplicationJoinAccept) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,7 +784,7 @@ type ApplicationDownlink struct {
 func (m *ApplicationDownlink) Reset()      { *m = ApplicationDownlink{} }
 func (*ApplicationDownlink) ProtoMessage() {}
 func (*ApplicationDownlink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{7}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{8}
 }
 func (m *ApplicationDownlink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +894,7 @@ type ApplicationDownlink_ClassBC struct {
 func (m *ApplicationDownlink_ClassBC) Reset()      { *m = ApplicationDownlink_ClassBC{} }
 func (*ApplicationDownlink_ClassBC) ProtoMessage() {}
 func (*ApplicationDownlink_ClassBC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{7, 0}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{8, 0}
 }
 func (m *ApplicationDownlink_ClassBC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -887,7 +946,7 @@ type ApplicationDownlinks struct {
 func (m *ApplicationDownlinks) Reset()      { *m = ApplicationDownlinks{} }
 func (*ApplicationDownlinks) ProtoMessage() {}
 func (*ApplicationDownlinks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{8}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{9}
 }
 func (m *ApplicationDownlinks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +992,7 @@ type ApplicationDownlinkFailed struct {
 func (m *ApplicationDownlinkFailed) Reset()      { *m = ApplicationDownlinkFailed{} }
 func (*ApplicationDownlinkFailed) ProtoMessage() {}
 func (*ApplicationDownlinkFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{9}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{10}
 }
 func (m *ApplicationDownlinkFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1038,7 @@ type ApplicationInvalidatedDownlinks struct {
 func (m *ApplicationInvalidatedDownlinks) Reset()      { *m = ApplicationInvalidatedDownlinks{} }
 func (*ApplicationInvalidatedDownlinks) ProtoMessage() {}
 func (*ApplicationInvalidatedDownlinks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{10}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{11}
 }
 func (m *ApplicationInvalidatedDownlinks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1045,7 +1104,7 @@ type ApplicationUp struct {
 func (m *ApplicationUp) Reset()      { *m = ApplicationUp{} }
 func (*ApplicationUp) ProtoMessage() {}
 func (*ApplicationUp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{11}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{12}
 }
 func (m *ApplicationUp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1234,7 +1293,7 @@ type MessagePayloadFormatters struct {
 func (m *MessagePayloadFormatters) Reset()      { *m = MessagePayloadFormatters{} }
 func (*MessagePayloadFormatters) ProtoMessage() {}
 func (*MessagePayloadFormatters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{12}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{13}
 }
 func (m *MessagePayloadFormatters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1301,7 +1360,7 @@ type DownlinkQueueRequest struct {
 func (m *DownlinkQueueRequest) Reset()      { *m = DownlinkQueueRequest{} }
 func (*DownlinkQueueRequest) ProtoMessage() {}
 func (*DownlinkQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{13}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{14}
 }
 func (m *DownlinkQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1348,6 +1407,8 @@ func init() {
 	golang_proto.RegisterType((*DownlinkMessage)(nil), "ttn.lorawan.v3.DownlinkMessage")
 	proto.RegisterType((*TxAcknowledgment)(nil), "ttn.lorawan.v3.TxAcknowledgment")
 	golang_proto.RegisterType((*TxAcknowledgment)(nil), "ttn.lorawan.v3.TxAcknowledgment")
+	proto.RegisterType((*GatewayTxAcknowledgment)(nil), "ttn.lorawan.v3.GatewayTxAcknowledgment")
+	golang_proto.RegisterType((*GatewayTxAcknowledgment)(nil), "ttn.lorawan.v3.GatewayTxAcknowledgment")
 	proto.RegisterType((*GatewayUplinkMessage)(nil), "ttn.lorawan.v3.GatewayUplinkMessage")
 	golang_proto.RegisterType((*GatewayUplinkMessage)(nil), "ttn.lorawan.v3.GatewayUplinkMessage")
 	proto.RegisterType((*ApplicationUplink)(nil), "ttn.lorawan.v3.ApplicationUplink")
@@ -1382,142 +1443,146 @@ func init() {
 }
 
 var fileDescriptor_bbc6bff5780bdc9d = []byte{
-	// 2152 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4b, 0x6c, 0x1b, 0xc7,
-	0xf9, 0xdf, 0xe1, 0x9b, 0xc3, 0x87, 0x36, 0x13, 0x25, 0x7f, 0x46, 0x7f, 0x67, 0xa9, 0xd2, 0x4e,
-	0x23, 0xbb, 0x16, 0xd5, 0xca, 0x2d, 0xea, 0xba, 0x68, 0x13, 0x2e, 0xb5, 0xb2, 0x68, 0xc9, 0x24,
-	0x3d, 0xa4, 0x13, 0xbb, 0x69, 0xba, 0x58, 0x71, 0x47, 0xf4, 0x46, 0xd4, 0xee, 0x66, 0x77, 0xa8,
-	0x47, 0x8a, 0x02, 0x6e, 0x4e, 0x41, 0x4f, 0x46, 0x80, 0x16, 0x45, 0x81, 0x16, 0x41, 0x7b, 0xc9,
-	0xa1, 0x40, 0x7d, 0x34, 0x7a, 0x28, 0x72, 0xab, 0x8f, 0x3e, 0x06, 0x3d, 0xa8, 0x16, 0x75, 0xc9,
-	0x31, 0x47, 0x43, 0x97, 0x14, 0x3b, 0xbb, 0x4b, 0xee, 0x52, 0xac, 0x2d, 0x2b, 0xed, 0xa9, 0x27,
-	0x71, 0xe7, 0xfb, 0x7d, 0xbf, 0xf9, 0x66, 0xbe, 0xe7, 0x08, 0xce, 0xf6, 0x0c, 0x4b, 0xd9, 0x51,
-	0xf4, 0x79, 0x9b, 0x2a, 0x9d, 0xcd, 0x05, 0xc5, 0xd4, 0x16, 0xb6, 0x88, 0x6d, 0x2b, 0x5d, 0x62,
-	0x97, 0x4d, 0xcb, 0xa0, 0x06, 0xca, 0x53, 0xaa, 0x97, 0x3d, 0x54, 0x79, 0xfb, 0xd2, 0x4c, 0xa5,
-	0xab, 0xd1, 0x3b, 0xfd, 0xf5, 0x72, 0xc7, 0xd8, 0x5a, 0x20, 0xfa, 0xb6, 0xb1, 0x67, 0x5a, 0xc6,
-	0xee, 0xde, 0x02, 0x03, 0x77, 0xe6, 0xbb, 0x44, 0x9f, 0xdf, 0x56, 0x7a, 0x9a, 0xaa, 0x50, 0xb2,
-	0x70, 0xec, 0x87, 0x4b, 0x39, 0x33, 0x1f, 0xa0, 0xe8, 0x1a, 0x5d, 0xc3, 0x55, 0x5e, 0xef, 0x6f,
-	0xb0, 0x2f, 0xf6, 0xc1, 0x7e, 0x79, 0xf0, 0x33, 0x5d, 0xc3, 0xe8, 0xf6, 0xc8, 0x08, 0x65, 0x53,
-	0xab, 0xdf, 0xa1, 0x9e, 0xb4, 0x38, 0x2e, 0xa5, 0xda, 0x16, 0xb1, 0xa9, 0xb2, 0x65, 0x7a, 0x80,
-	0x57, 0x8f, 0x1f, 0x91, 0x58, 0x96, 0x61, 0x79, 0xe2, 0xb3, 0xc7, 0xc5, 0x9a, 0x4a, 0x74, 0xaa,
-	0x6d, 0x68, 0xc4, 0xb2, 0x7d, 0x13, 0x8e, 0x83, 0x36, 0xc9, 0x9e, 0x2f, 0x2d, 0x1e, 0x97, 0xfa,
-	0x17, 0xe6, 0x02, 0x26, 0xde, 0x32, 0x55, 0x54, 0x85, 0x2a, 0x2e, 0xa2, 0xf4, 0xb7, 0x28, 0xcc,
-	0xdd, 0x34, 0x7b, 0x9a, 0xbe, 0x79, 0xdd, 0xbd, 0x7e, 0x54, 0x84, 0x19, 0x4b, 0xd9, 0x91, 0x4d,
-	0x65, 0xaf, 0x67, 0x28, 0x6a, 0x01, 0xcc, 0x82, 0xb9, 0x2c, 0x86, 0x96, 0xb2, 0xd3, 0x74, 0x57,
-	0xd0, 0x77, 0x60, 0xd2, 0x17, 0x46, 0x66, 0xc1, 0x5c, 0x66, 0xf1, 0xff, 0xca, 0x61, 0x57, 0x95,
-	0x3d, 0x2a, 0xec, 0xe3, 0xd0, 0x12, 0x4c, 0xd9, 0x84, 0x52, 0x4d, 0xef, 0xda, 0x85, 0x18, 0xd3,
-	0x99, 0x19, 0xd7, 0x69, 0xef, 0xb6, 0x3c, 0x84, 0x98, 0x3d, 0x12, 0xe3, 0xbf, 0x02, 0x11, 0x1e,
-	0x3c, 0xdc, 0x2f, 0x72, 0x78, 0xa8, 0x89, 0x7e, 0x08, 0x33, 0xd6, 0xae, 0xec, 0x1f, 0xa0, 0x10,
-	0x9f, 0x8d, 0x4e, 0x22, 0xc2, 0xbb, 0xd7, 0x3d, 0x04, 0x86, 0xd6, 0xf0, 0x37, 0x92, 0x60, 0xc6,
-	0x22, 0x1d, 0xa2, 0x6d, 0x13, 0x55, 0x56, 0x68, 0x21, 0xe1, 0x59, 0xe1, 0x3a, 0xb1, 0xec, 0x3b,
-	0xb1, 0xdc, 0xf6, 0x9d, 0x28, 0xa6, 0x9c, 0xdd, 0xef, 0xfd, 0xb3, 0x08, 0x30, 0xf4, 0x15, 0x2b,
-	0x14, 0x5d, 0x85, 0x53, 0x1d, 0xc3, 0xb2, 0x48, 0x4f, 0xa1, 0x9a, 0xa1, 0xcb, 0x9a, 0x6a, 0x17,
-	0x92, 0xb3, 0xd1, 0xb9, 0xb4, 0x28, 0x1c, 0x89, 0xe9, 0x8f, 0x41, 0xa2, 0x14, 0xb3, 0x22, 0x05,
-	0x75, 0xb0, 0x5f, 0xcc, 0x57, 0x47, 0xb0, 0xda, 0x92, 0x8d, 0xf3, 0x01, 0xb5, 0x9a, 0x6a, 0xa3,
-	0x2b, 0x70, 0x5a, 0x25, 0xdb, 0x5a, 0x87, 0xc8, 0x9d, 0x3b, 0x8a, 0xae, 0x93, 0x9e, 0xac, 0xe9,
-	0x2a, 0xd9, 0x2d, 0xa4, 0x67, 0xc1, 0x5c, 0x4e, 0x4c, 0x1d, 0x89, 0xf1, 0x0b, 0xd1, 0xc2, 0x57,
-	0x00, 0x23, 0x17, 0x55, 0x75, 0x41, 0x35, 0x07, 0x73, 0x25, 0xf6, 0xe0, 0x93, 0x22, 0x77, 0x2d,
-	0x96, 0x4a, 0xf1, 0xe9, 0xd2, 0x6f, 0xa2, 0x70, 0x6a, 0xc9, 0xd8, 0xd1, 0xff, 0xdb, 0x2e, 0xfc,
-	0x29, 0xcc, 0x13, 0x5d, 0x95, 0x3d, 0x9b, 0x9d, 0x73, 0x47, 0x99, 0xe6, 0xb9, 0x71, 0x4d, 0x49,
-	0x57, 0x97, 0x18, 0xa8, 0x36, 0x8a, 0x66, 0x91, 0x1f, 0xec, 0x17, 0xb3, 0x23, 0xc9, 0x92, 0x8d,
-	0xb3, 0x64, 0x84, 0xb3, 0xd1, 0xf7, 0x60, 0xd2, 0x22, 0xef, 0xf7, 0x89, 0x4d, 0xbd, 0xf8, 0x78,
-	0xe5, 0x78, 0x7c, 0x60, 0x17, 0xb0, 0xc2, 0x61, 0x1f, 0x8b, 0xae, 0xc0, 0xb4, 0xdd, 0xb9, 0x43,
-	0xd4, 0x7e, 0x8f, 0xa8, 0x85, 0xf8, 0xb3, 0x02, 0x6b, 0x85, 0xc3, 0x23, 0xf8, 0x24, 0x4f, 0x26,
-	0x4e, 0xe3, 0x49, 0xd7, 0x1b, 0xe2, 0xd4, 0x28, 0xc4, 0x51, 0xf4, 0x89, 0x08, 0x4a, 0x7f, 0x8f,
-	0x40, 0xbe, 0xbd, 0x5b, 0xe9, 0x6c, 0xea, 0xc6, 0x4e, 0x8f, 0xa8, 0xdd, 0x2d, 0xa2, 0x4f, 0x0c,
-	0x1f, 0x70, 0xaa, 0xf0, 0xa9, 0xc1, 0x84, 0x45, 0xec, 0x7e, 0x8f, 0x32, 0x07, 0xe6, 0x17, 0x5f,
-	0x3f, 0x7e, 0xec, 0xf0, 0xd6, 0x65, 0xcc, 0xe0, 0x2c, 0xb2, 0x3e, 0x74, 0x92, 0x0b, 0x7b, 0x04,
-	0xa5, 0x3f, 0x00, 0x98, 0x70, 0x85, 0x28, 0x03, 0x93, 0xad, 0x9b, 0xd5, 0xaa, 0xd4, 0x6a, 0xf1,
-	0x1c, 0x7a, 0x01, 0xe6, 0x6e, 0xd6, 0x57, 0xeb, 0x8d, 0xb7, 0xeb, 0xb2, 0x84, 0x71, 0x03, 0xf3,
-	0x00, 0x65, 0x61, 0xaa, 0xdd, 0x68, 0xc8, 0x6b, 0x95, 0xb6, 0xc4, 0x47, 0x50, 0x0e, 0xa6, 0x9d,
-	0x2f, 0xa9, 0x82, 0xd7, 0x6e, 0xf3, 0x51, 0x34, 0x0d, 0xf9, 0x6a, 0x63, 0x6d, 0xad, 0xd6, 0xaa,
-	0x35, 0xea, 0x72, 0xb3, 0x52, 0x5d, 0x95, 0xda, 0x7c, 0x2c, 0xbc, 0x2a, 0x4a, 0x95, 0x6a, 0xa3,
-	0xce, 0xc7, 0x9d, 0x8d, 0xda, 0xb7, 0xe4, 0x65, 0x2c, 0xdd, 0xe0, 0x13, 0x8c, 0xf5, 0x96, 0xdc,
-	0x6c, 0xbc, 0x2d, 0x61, 0x3e, 0x89, 0x78, 0x98, 0xbd, 0xda, 0x6c, 0xc9, 0x37, 0xeb, 0x6b, 0x8d,
-	0xea, 0xaa, 0xb4, 0xc4, 0xa7, 0x4a, 0x1f, 0x02, 0x38, 0x7d, 0x55, 0xa1, 0x64, 0x47, 0xd9, 0x0b,
-	0x97, 0x2a, 0x09, 0x26, 0xbd, 0xa6, 0xc1, 0x62, 0x3c, 0xb3, 0xf8, 0xea, 0xf8, 0x2d, 0x84, 0xf0,
-	0xa3, 0xc2, 0xf2, 0x68, 0xbf, 0x08, 0xb0, 0xaf, 0x8b, 0xce, 0xc2, 0xe4, 0xba, 0xa2, 0xab, 0xb2,
-	0xe6, 0x66, 0x43, 0x5a, 0x84, 0x83, 0xfd, 0x62, 0x42, 0x54, 0x74, 0xb5, 0xb6, 0x84, 0x13, 0x8e,
-	0xa8, 0xa6, 0x96, 0x1e, 0xc4, 0xe0, 0x0b, 0x15, 0xd3, 0xec, 0x69, 0x1d, 0xe6, 0x03, 0x97, 0x18,
-	0xfd, 0x18, 0xe6, 0x6d, 0x62, 0xdb, 0x8e, 0x2f, 0x37, 0xc9, 0x9e, 0xc3, 0xc0, 0x92, 0x4d, 0x2c,
-	0x1c, 0x89, 0xf1, 0x0f, 0xa2, 0x85, 0xbb, 0x2c, 0xee, 0x5b, 0x2e, 0x62, 0x95, 0xec, 0xd5, 0x96,
-	0x70, 0xd6, 0x1e, 0x7d, 0xa9, 0xe8, 0x1c, 0x4c, 0x6c, 0xc8, 0xa6, 0x61, 0xb9, 0x6e, 0xcc, 0x89,
-	0xb9, 0x23, 0x11, 0x5e, 0x48, 0x15, 0xbe, 0x02, 0x73, 0xe0, 0xf2, 0x63, 0x80, 0xe3, 0x1b, 0x4d,
-	0xc3, 0xa2, 0xe8, 0x45, 0x18, 0xdf, 0x90, 0x3b, 0x3a, 0x65, 0x29, 0x97, 0xc3, 0xb1, 0x8d, 0xaa,
-	0x4e, 0xd1, 0x02, 0xcc, 0x6c, 0x58, 0x5b, 0xc3, 0x24, 0x8f, 0xb1, 0x7d, 0xf3, 0x83, 0xfd, 0x22,
-	0x5c, 0xc6, 0xd7, 0xbd, 0x44, 0xc7, 0x70, 0xc3, 0xda, 0xf2, 0x93, 0xfe, 0x4d, 0x38, 0xa5, 0x92,
-	0x8e, 0xa1, 0x12, 0x75, 0xa8, 0x14, 0xf7, 0x92, 0x7f, 0xbc, 0x0a, 0xb6, 0x58, 0xa3, 0xc3, 0x79,
-	0x0f, 0xef, 0x33, 0x48, 0xe1, 0x02, 0x9c, 0x78, 0x56, 0x01, 0x66, 0xc1, 0xf6, 0x31, 0x88, 0xa4,
-	0x40, 0xa8, 0x14, 0x07, 0xbb, 0x41, 0xf2, 0xd4, 0xdd, 0x60, 0xac, 0xa0, 0xa7, 0x4e, 0x59, 0xd0,
-	0xbf, 0x0f, 0xd3, 0x8a, 0x69, 0xca, 0xb6, 0xe3, 0x3f, 0x56, 0x7c, 0x33, 0x8b, 0xff, 0x3f, 0x6e,
-	0xcd, 0x2a, 0xd9, 0x93, 0xf4, 0x6d, 0xd2, 0x33, 0x4c, 0x82, 0x93, 0x8a, 0x69, 0xb6, 0x56, 0xc9,
-	0x1e, 0x9a, 0x83, 0x2f, 0xf4, 0x14, 0x9b, 0xca, 0x8a, 0xcc, 0x7c, 0x23, 0xab, 0xc6, 0x8e, 0x5e,
-	0x80, 0xcc, 0x41, 0x39, 0x47, 0x50, 0x59, 0xae, 0xea, 0xd4, 0xa9, 0xcc, 0xa5, 0xbf, 0x46, 0xe0,
-	0x8b, 0x81, 0xd0, 0x59, 0x33, 0xdc, 0xbf, 0xa8, 0x00, 0x93, 0x36, 0xb1, 0x9c, 0x12, 0xc8, 0xa2,
-	0x26, 0x8d, 0xfd, 0x4f, 0xb4, 0x0c, 0x53, 0x3d, 0x0f, 0xe5, 0x15, 0xe8, 0xc2, 0xb8, 0x4d, 0x3e,
-	0x8b, 0xc8, 0x07, 0xef, 0x87, 0x05, 0xf6, 0x50, 0x17, 0xfd, 0x12, 0x40, 0xa8, 0x50, 0x6a, 0x69,
-	0xeb, 0x7d, 0x4a, 0x9c, 0x8a, 0xed, 0x38, 0xec, 0xd2, 0x38, 0xd5, 0x04, 0xdb, 0xca, 0x95, 0xa1,
-	0x96, 0xa4, 0x53, 0x6b, 0x4f, 0xbc, 0x78, 0x24, 0x9e, 0xff, 0x1d, 0xf8, 0x66, 0xe9, 0x9c, 0x55,
-	0x2a, 0x9c, 0x5b, 0x14, 0x7e, 0xf6, 0x8e, 0x32, 0xff, 0xc1, 0xb7, 0xe7, 0x7f, 0xf0, 0xee, 0xdc,
-	0x1b, 0x57, 0xde, 0x99, 0x7f, 0xf7, 0x0d, 0xff, 0xf3, 0xfc, 0xcf, 0x17, 0x2f, 0xfe, 0xe2, 0x1c,
-	0x0e, 0x6c, 0x3a, 0xf3, 0x23, 0x38, 0x35, 0x46, 0x86, 0x78, 0x18, 0x75, 0x6e, 0xdb, 0x3d, 0xb4,
-	0xf3, 0x13, 0x4d, 0xc3, 0xf8, 0xb6, 0xd2, 0xeb, 0x13, 0x37, 0x01, 0xb1, 0xfb, 0x71, 0x25, 0x72,
-	0x19, 0x94, 0xfe, 0x11, 0x81, 0x2f, 0x05, 0x0c, 0xbc, 0x66, 0x68, 0x7a, 0xa5, 0xd3, 0x21, 0x26,
-	0xfd, 0xda, 0xb9, 0x17, 0xf2, 0x7c, 0xe4, 0x39, 0x3c, 0x7f, 0x0b, 0xbe, 0xa4, 0xe9, 0xfe, 0x68,
-	0xa9, 0x32, 0xc7, 0x3b, 0xc5, 0xc0, 0xbf, 0xdf, 0xb3, 0x4f, 0xb9, 0x5f, 0xbf, 0x53, 0xe3, 0xe9,
-	0x00, 0x83, 0xbf, 0x68, 0xa3, 0xd7, 0xe1, 0x94, 0x49, 0x74, 0x55, 0xd3, 0xbb, 0xb2, 0x67, 0x2a,
-	0xcb, 0xeb, 0x14, 0xce, 0x7b, 0xcb, 0xde, 0x71, 0xfe, 0x43, 0xc1, 0x5f, 0xfa, 0x53, 0x3c, 0x14,
-	0x99, 0xbe, 0x21, 0xff, 0x63, 0x65, 0xed, 0x0c, 0x4c, 0x77, 0x0c, 0x7d, 0x43, 0xb3, 0xb6, 0x88,
-	0xca, 0x06, 0xc3, 0x14, 0x1e, 0x2d, 0xa0, 0xab, 0x30, 0xdd, 0xe9, 0x29, 0xb6, 0x2d, 0xaf, 0xcb,
-	0x1d, 0xaf, 0x5c, 0x7d, 0xeb, 0x04, 0x1e, 0x2e, 0x57, 0x1d, 0x25, 0xb1, 0x8a, 0x93, 0x1d, 0xf7,
-	0x07, 0x5a, 0x81, 0x29, 0xd3, 0xd2, 0x0c, 0x4b, 0xa3, 0x7b, 0xcc, 0x61, 0xf9, 0xc5, 0xd2, 0x84,
-	0xb2, 0xe7, 0xcd, 0x27, 0x4d, 0x0f, 0x19, 0xe8, 0xd7, 0x43, 0xed, 0x49, 0x53, 0x44, 0xfa, 0x34,
-	0x53, 0xc4, 0xcc, 0xef, 0x01, 0x4c, 0x7a, 0x76, 0xa2, 0x55, 0x98, 0xea, 0xba, 0x4d, 0xd6, 0x1d,
-	0x69, 0x33, 0x8b, 0xe7, 0xc7, 0xcd, 0xf3, 0x9a, 0x70, 0x45, 0xa7, 0x44, 0xd7, 0x95, 0xe0, 0x7c,
-	0x17, 0x73, 0x8b, 0xb3, 0x4f, 0x80, 0x24, 0x98, 0x53, 0xd6, 0x6d, 0xa3, 0xd7, 0xa7, 0x44, 0x76,
-	0xde, 0x45, 0x27, 0x88, 0xd0, 0x18, 0x8b, 0xce, 0xac, 0xaf, 0xe6, 0x08, 0xdc, 0xd1, 0xaa, 0x74,
-	0x1b, 0x4e, 0x4f, 0xb8, 0x60, 0x1b, 0x55, 0x60, 0x7a, 0x94, 0x7b, 0xe0, 0xe4, 0xb9, 0x37, 0xd2,
-	0x2a, 0xdd, 0x07, 0xf0, 0x95, 0x09, 0x90, 0x65, 0x45, 0x73, 0x46, 0xc4, 0x1b, 0x30, 0xe5, 0x43,
-	0xbd, 0x01, 0xe3, 0x24, 0xfc, 0x93, 0x2a, 0xb2, 0x4f, 0x83, 0xde, 0x84, 0x71, 0xf6, 0x08, 0xf4,
-	0x0a, 0xce, 0x99, 0x63, 0xd3, 0xb3, 0x23, 0x5c, 0x22, 0x54, 0xd1, 0x7a, 0xe3, 0xad, 0xcf, 0x55,
-	0x2c, 0xfd, 0x1a, 0xc0, 0x62, 0x60, 0xd7, 0xda, 0xa4, 0x3a, 0xb2, 0x7a, 0xba, 0x9b, 0x09, 0xf4,
-	0xeb, 0x91, 0x3e, 0x7a, 0x0d, 0x4e, 0xb1, 0x46, 0x17, 0x68, 0x73, 0x2c, 0xab, 0x71, 0xd6, 0x59,
-	0x1e, 0x76, 0xb9, 0xc3, 0x24, 0xcc, 0x85, 0x06, 0xa4, 0x09, 0x4f, 0x06, 0xf0, 0x3c, 0x4f, 0x86,
-	0x63, 0xb7, 0x18, 0x7e, 0x32, 0x4c, 0x48, 0x82, 0xc8, 0xa9, 0x46, 0xe9, 0x4a, 0xb8, 0x96, 0x66,
-	0x4f, 0x18, 0xa9, 0xc1, 0x21, 0xe2, 0x1a, 0xcc, 0xf7, 0xd9, 0x40, 0x28, 0xfb, 0xf3, 0xa8, 0xfb,
-	0x38, 0xfa, 0xc6, 0x53, 0x2e, 0xdd, 0x9d, 0x20, 0x57, 0x38, 0x9c, 0xeb, 0x87, 0x86, 0xda, 0x15,
-	0x98, 0x79, 0xcf, 0xd0, 0x74, 0x59, 0x61, 0x5d, 0xce, 0x7b, 0x0e, 0xbd, 0xf6, 0x14, 0xa2, 0x51,
-	0x4b, 0x5c, 0xe1, 0x30, 0x7c, 0x6f, 0xd4, 0x20, 0x57, 0x60, 0xd6, 0xf7, 0xa2, 0xac, 0x74, 0x36,
-	0xbd, 0xb2, 0x78, 0x92, 0x40, 0x58, 0xe1, 0x70, 0xc6, 0x57, 0xad, 0x74, 0x36, 0xd1, 0x35, 0x98,
-	0x1b, 0x32, 0xe9, 0x0e, 0x55, 0xe2, 0x79, 0xa8, 0x86, 0x56, 0xd4, 0x95, 0x31, 0x2e, 0x9b, 0xe8,
-	0xd4, 0xab, 0xa9, 0xcf, 0xcb, 0xd5, 0x72, 0x9e, 0x53, 0x6d, 0x38, 0x35, 0xe4, 0xda, 0x60, 0x39,
-	0xeb, 0x15, 0x9a, 0xf3, 0x27, 0x60, 0x73, 0x93, 0x7c, 0x85, 0xc3, 0x79, 0x35, 0x9c, 0xf6, 0xf5,
-	0x00, 0xeb, 0xfb, 0x7d, 0xd2, 0x27, 0xaa, 0x37, 0x18, 0x9e, 0xd0, 0xc6, 0x21, 0xdf, 0x0d, 0xa6,
-	0x8c, 0x0c, 0x38, 0x13, 0xe6, 0x93, 0x03, 0xcd, 0x9f, 0x8d, 0x8c, 0x99, 0xc5, 0x85, 0xa7, 0x50,
-	0x4f, 0x4a, 0xf1, 0x15, 0x0e, 0x17, 0x42, 0xdb, 0x04, 0x40, 0xce, 0x01, 0xfc, 0x11, 0x50, 0xb6,
-	0x8d, 0xde, 0x36, 0x51, 0x0b, 0x99, 0x67, 0x1e, 0xc0, 0x1f, 0xfd, 0x9c, 0x03, 0xf8, 0xda, 0x2d,
-	0xa6, 0x2c, 0xa6, 0x61, 0xa4, 0x6f, 0xba, 0xaf, 0xda, 0x3f, 0x47, 0x60, 0xc1, 0x8b, 0x54, 0xaf,
-	0x7d, 0x2e, 0x1b, 0xd6, 0x96, 0x42, 0x29, 0xb1, 0x6c, 0x74, 0x1d, 0x66, 0xfb, 0xa6, 0xbc, 0xe1,
-	0x2f, 0xb0, 0x74, 0xcf, 0x2f, 0xce, 0x8e, 0x6f, 0x3a, 0xae, 0x18, 0xe8, 0x71, 0x99, 0xbe, 0x39,
-	0x5c, 0x46, 0xdf, 0x85, 0x2f, 0x07, 0xe9, 0x64, 0x53, 0xb1, 0x94, 0x2d, 0xe2, 0x10, 0xbb, 0x53,
-	0xe2, 0x74, 0x00, 0xdc, 0xf4, 0x65, 0xe8, 0x06, 0x64, 0xf7, 0x1f, 0x30, 0x23, 0xfa, 0xdc, 0x66,
-	0xb0, 0x08, 0x1d, 0x19, 0x72, 0x19, 0x16, 0xc2, 0x94, 0x01, 0x53, 0x62, 0xcc, 0x94, 0x97, 0x43,
-	0x0a, 0x43, 0x63, 0x4a, 0x7f, 0x01, 0x70, 0x7a, 0x29, 0xe8, 0x26, 0xef, 0x9f, 0x18, 0xa8, 0xfd,
-	0xb5, 0x6a, 0x63, 0xea, 0xdf, 0xd4, 0xc4, 0x50, 0x47, 0x8c, 0x9c, 0xa6, 0x23, 0x5e, 0xb8, 0x07,
-	0x20, 0x3f, 0x7e, 0x33, 0x08, 0xc1, 0xfc, 0x72, 0x03, 0x5f, 0xaf, 0xb4, 0xdb, 0x12, 0x96, 0xeb,
-	0x8d, 0xba, 0xc4, 0x73, 0xa8, 0x00, 0xa7, 0x47, 0x6b, 0x58, 0x6a, 0x36, 0x5a, 0xb5, 0x76, 0x03,
-	0xdf, 0xe6, 0x01, 0x9a, 0x81, 0x2f, 0x8f, 0x24, 0x57, 0x71, 0xb3, 0x2a, 0xb7, 0x24, 0xfc, 0x56,
-	0xad, 0x2a, 0xf1, 0x91, 0xb0, 0xd6, 0xb5, 0xca, 0x5b, 0x95, 0x56, 0x15, 0xd7, 0x9a, 0x6d, 0x3e,
-	0x1a, 0x96, 0x54, 0x2b, 0xb7, 0xa5, 0x7a, 0x5d, 0x5a, 0x6b, 0x36, 0xf9, 0x98, 0xf8, 0x47, 0xf0,
-	0xf0, 0x40, 0x00, 0x8f, 0x0e, 0x04, 0xf0, 0xf9, 0x81, 0xc0, 0x3d, 0x3e, 0x10, 0xb8, 0x2f, 0x0e,
-	0x04, 0xee, 0xcb, 0x03, 0x81, 0x7b, 0x72, 0x20, 0x80, 0xbb, 0x03, 0x01, 0x7c, 0x34, 0x10, 0xb8,
-	0x4f, 0x07, 0x02, 0xb8, 0x3f, 0x10, 0xb8, 0x07, 0x03, 0x81, 0xfb, 0x6c, 0x20, 0x70, 0x0f, 0x07,
-	0x02, 0x78, 0x34, 0x10, 0xc0, 0xe7, 0x03, 0x81, 0x7b, 0x3c, 0x10, 0xc0, 0x17, 0x03, 0x81, 0xfb,
-	0x72, 0x20, 0x80, 0x27, 0x03, 0x81, 0xbb, 0x7b, 0x28, 0x70, 0x1f, 0x1d, 0x0a, 0xe0, 0xde, 0xa1,
-	0xc0, 0xfd, 0xf6, 0x50, 0x00, 0x9f, 0x1c, 0x0a, 0xdc, 0xa7, 0x87, 0x02, 0x77, 0xff, 0x50, 0x00,
-	0x0f, 0x0e, 0x05, 0xf0, 0xd9, 0xa1, 0x00, 0x7e, 0x72, 0xb1, 0x6b, 0x94, 0xe9, 0x1d, 0x42, 0xef,
-	0x38, 0xcf, 0xcd, 0xb2, 0x4e, 0xe8, 0x8e, 0x61, 0x6d, 0x2e, 0x84, 0xff, 0xa3, 0x6a, 0x6e, 0x76,
-	0x17, 0x28, 0xd5, 0xcd, 0xf5, 0xf5, 0x04, 0x6b, 0x14, 0x97, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0x0d, 0xbd, 0x72, 0x40, 0xd9, 0x16, 0x00, 0x00,
+	// 2222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xde, 0xe1, 0x3f, 0x87, 0x3f, 0x5a, 0x4f, 0x14, 0x67, 0xa3, 0x3a, 0x4b, 0x95, 0x76, 0x1a,
+	0xd9, 0xb5, 0xa8, 0x56, 0x6e, 0x51, 0xd7, 0x45, 0x9b, 0x70, 0x29, 0xca, 0xa2, 0x24, 0x93, 0xf4,
+	0x90, 0x4a, 0xec, 0xa6, 0xe9, 0x62, 0xc5, 0x1d, 0xd2, 0x1b, 0x51, 0xbb, 0x9b, 0xdd, 0xa1, 0x7e,
+	0x52, 0x14, 0x70, 0x73, 0x0a, 0x7a, 0x32, 0x02, 0xb4, 0x28, 0x0a, 0x14, 0x08, 0x5a, 0x14, 0xc8,
+	0xa1, 0x40, 0x0d, 0xf4, 0x62, 0xf4, 0x50, 0xe4, 0xe8, 0xa3, 0x8f, 0x41, 0x0f, 0xaa, 0x45, 0x5d,
+	0x72, 0xcc, 0xd1, 0xd0, 0x25, 0xc5, 0xfe, 0x91, 0xbb, 0x24, 0x63, 0xcb, 0x4a, 0x7b, 0xca, 0x89,
+	0x3b, 0xf3, 0xbe, 0xf7, 0xcd, 0x9b, 0x79, 0x6f, 0xde, 0x7b, 0x43, 0x38, 0xdb, 0xd5, 0x0c, 0x69,
+	0x57, 0x52, 0xe7, 0x4d, 0x2a, 0xb5, 0xb6, 0x16, 0x24, 0x5d, 0x59, 0xd8, 0x26, 0xa6, 0x29, 0x75,
+	0x88, 0x59, 0xd0, 0x0d, 0x8d, 0x6a, 0x28, 0x4b, 0xa9, 0x5a, 0x70, 0x51, 0x85, 0x9d, 0x2b, 0x33,
+	0xc5, 0x8e, 0x42, 0xef, 0xf4, 0x36, 0x0b, 0x2d, 0x6d, 0x7b, 0x81, 0xa8, 0x3b, 0xda, 0xbe, 0x6e,
+	0x68, 0x7b, 0xfb, 0x0b, 0x36, 0xb8, 0x35, 0xdf, 0x21, 0xea, 0xfc, 0x8e, 0xd4, 0x55, 0x64, 0x89,
+	0x92, 0x85, 0xb1, 0x0f, 0x87, 0x72, 0x66, 0xde, 0x47, 0xd1, 0xd1, 0x3a, 0x9a, 0xa3, 0xbc, 0xd9,
+	0x6b, 0xdb, 0x23, 0x7b, 0x60, 0x7f, 0xb9, 0xf0, 0x73, 0x1d, 0x4d, 0xeb, 0x74, 0xc9, 0x10, 0x65,
+	0x52, 0xa3, 0xd7, 0xa2, 0xae, 0x34, 0x37, 0x2a, 0xa5, 0xca, 0x36, 0x31, 0xa9, 0xb4, 0xad, 0xbb,
+	0x80, 0x57, 0xc6, 0xb7, 0x48, 0x0c, 0x43, 0x33, 0x5c, 0xf1, 0xf9, 0x71, 0xb1, 0x22, 0x13, 0x95,
+	0x2a, 0x6d, 0x85, 0x18, 0xa6, 0x67, 0xc2, 0x38, 0x68, 0x8b, 0xec, 0x7b, 0xd2, 0xdc, 0xb8, 0xd4,
+	0x3b, 0x30, 0x07, 0x30, 0xf1, 0x94, 0xa9, 0x24, 0x4b, 0x54, 0x72, 0x10, 0xf9, 0x7f, 0x85, 0x61,
+	0x66, 0x43, 0xef, 0x2a, 0xea, 0xd6, 0x0d, 0xe7, 0xf8, 0x51, 0x0e, 0xa6, 0x0c, 0x69, 0x57, 0xd4,
+	0xa5, 0xfd, 0xae, 0x26, 0xc9, 0x1c, 0x98, 0x05, 0x73, 0x69, 0x0c, 0x0d, 0x69, 0xb7, 0xee, 0xcc,
+	0xa0, 0xef, 0xc3, 0xb8, 0x27, 0x0c, 0xcd, 0x82, 0xb9, 0xd4, 0xe2, 0x4b, 0x85, 0xa0, 0xab, 0x0a,
+	0x2e, 0x15, 0xf6, 0x70, 0x68, 0x09, 0x26, 0x4c, 0x42, 0xa9, 0xa2, 0x76, 0x4c, 0x2e, 0x62, 0xeb,
+	0xcc, 0x8c, 0xea, 0x34, 0xf7, 0x1a, 0x2e, 0x42, 0x48, 0x1f, 0x0b, 0xd1, 0xdf, 0x82, 0x10, 0x0b,
+	0x1e, 0x1e, 0xe4, 0x18, 0x3c, 0xd0, 0x44, 0x3f, 0x81, 0x29, 0x63, 0x4f, 0xf4, 0x36, 0xc0, 0x45,
+	0x67, 0xc3, 0x93, 0x88, 0xf0, 0xde, 0x0d, 0x17, 0x81, 0xa1, 0x31, 0xf8, 0x46, 0x65, 0x98, 0x32,
+	0x48, 0x8b, 0x28, 0x3b, 0x44, 0x16, 0x25, 0xca, 0xc5, 0x5c, 0x2b, 0x1c, 0x27, 0x16, 0x3c, 0x27,
+	0x16, 0x9a, 0x9e, 0x13, 0x85, 0x84, 0xb5, 0xfa, 0xbd, 0xff, 0xe4, 0x00, 0x86, 0x9e, 0x62, 0x91,
+	0xa2, 0xeb, 0x70, 0xaa, 0xa5, 0x19, 0x06, 0xe9, 0x4a, 0x54, 0xd1, 0x54, 0x51, 0x91, 0x4d, 0x2e,
+	0x3e, 0x1b, 0x9e, 0x4b, 0x0a, 0xfc, 0xb1, 0x90, 0xfc, 0x08, 0xc4, 0xf2, 0x11, 0x23, 0xc4, 0xc9,
+	0xfd, 0x83, 0x5c, 0xb6, 0x34, 0x84, 0x55, 0x96, 0x4c, 0x9c, 0xf5, 0xa9, 0x55, 0x64, 0x13, 0x5d,
+	0x83, 0xd3, 0x32, 0xd9, 0x51, 0x5a, 0x44, 0x6c, 0xdd, 0x91, 0x54, 0x95, 0x74, 0x45, 0x45, 0x95,
+	0xc9, 0x1e, 0x97, 0x9c, 0x05, 0x73, 0x19, 0x21, 0x71, 0x2c, 0x44, 0x2f, 0x85, 0xb9, 0x2f, 0x01,
+	0x46, 0x0e, 0xaa, 0xe4, 0x80, 0x2a, 0x16, 0xe6, 0x5a, 0xe4, 0xc1, 0xc7, 0x39, 0x66, 0x35, 0x92,
+	0x48, 0xb0, 0xc9, 0xfc, 0xef, 0xc3, 0x70, 0x6a, 0x49, 0xdb, 0x55, 0xff, 0xdf, 0x2e, 0xfc, 0x05,
+	0xcc, 0x12, 0x55, 0x16, 0x5d, 0x9b, 0xad, 0x7d, 0x87, 0x6d, 0xcd, 0x0b, 0xa3, 0x9a, 0x65, 0x55,
+	0x5e, 0xb2, 0x41, 0x95, 0x61, 0x34, 0x0b, 0x6c, 0xff, 0x20, 0x97, 0x1e, 0x4a, 0x96, 0x4c, 0x9c,
+	0x26, 0x43, 0x9c, 0x89, 0x7e, 0x08, 0xe3, 0x06, 0x79, 0xaf, 0x47, 0x4c, 0xea, 0xc6, 0xc7, 0xcb,
+	0xe3, 0xf1, 0x81, 0x1d, 0xc0, 0x0a, 0x83, 0x3d, 0x2c, 0xba, 0x06, 0x93, 0x66, 0xeb, 0x0e, 0x91,
+	0x7b, 0x5d, 0x22, 0x73, 0xd1, 0x67, 0x05, 0xd6, 0x0a, 0x83, 0x87, 0xf0, 0x49, 0x9e, 0x8c, 0x9d,
+	0xc6, 0x93, 0x8e, 0x37, 0x84, 0xa9, 0x61, 0x88, 0xa3, 0xf0, 0x13, 0x01, 0xe4, 0xff, 0x1a, 0x86,
+	0x6c, 0x73, 0xaf, 0xd8, 0xda, 0x52, 0xb5, 0xdd, 0x2e, 0x91, 0x3b, 0xdb, 0x44, 0x9d, 0x18, 0x3e,
+	0xe0, 0x54, 0xe1, 0x53, 0x81, 0x31, 0x83, 0x98, 0xbd, 0x2e, 0xb5, 0x1d, 0x98, 0x5d, 0x7c, 0x6d,
+	0x7c, 0xdb, 0xc1, 0xa5, 0x0b, 0xd8, 0x86, 0xdb, 0x91, 0xf5, 0x81, 0x75, 0xb9, 0xb0, 0x4b, 0x80,
+	0x56, 0x21, 0x2b, 0xbb, 0x01, 0x24, 0xba, 0x39, 0xd8, 0xf5, 0x6d, 0x6e, 0x94, 0x74, 0x24, 0xd0,
+	0xf0, 0x94, 0x1c, 0x9c, 0xc8, 0xdf, 0x07, 0x30, 0xe6, 0x2c, 0x84, 0x52, 0x30, 0xde, 0xd8, 0x28,
+	0x95, 0xca, 0x8d, 0x06, 0xcb, 0xa0, 0x33, 0x30, 0xb3, 0x51, 0x5d, 0xab, 0xd6, 0xde, 0xaa, 0x8a,
+	0x65, 0x8c, 0x6b, 0x98, 0x05, 0x28, 0x0d, 0x13, 0xcd, 0x5a, 0x4d, 0x5c, 0x2f, 0x36, 0xcb, 0x6c,
+	0x08, 0x65, 0x60, 0xd2, 0x1a, 0x95, 0x8b, 0x78, 0xfd, 0x36, 0x1b, 0x46, 0xd3, 0x90, 0x2d, 0xd5,
+	0xd6, 0xd7, 0x2b, 0x8d, 0x4a, 0xad, 0x2a, 0xd6, 0x8b, 0xa5, 0xb5, 0x72, 0x93, 0x8d, 0x04, 0x67,
+	0x85, 0x72, 0xb1, 0x54, 0xab, 0xb2, 0x51, 0x6b, 0xa1, 0xe6, 0x2d, 0x71, 0x19, 0x97, 0x6f, 0xb2,
+	0x31, 0x9b, 0xf5, 0x96, 0x58, 0xaf, 0xbd, 0x55, 0xc6, 0x6c, 0x1c, 0xb1, 0x30, 0x7d, 0xbd, 0xde,
+	0x10, 0x37, 0xaa, 0xeb, 0xb5, 0xd2, 0x5a, 0x79, 0x89, 0x4d, 0x58, 0x33, 0xa5, 0x95, 0x62, 0xb5,
+	0x5a, 0x5e, 0x17, 0x85, 0x8d, 0xc6, 0x6d, 0x36, 0x99, 0xff, 0x07, 0x80, 0x2f, 0x5d, 0x97, 0x28,
+	0xd9, 0x95, 0xf6, 0xc7, 0xdc, 0xb5, 0x01, 0x53, 0x1d, 0x47, 0xe4, 0xba, 0xca, 0x3a, 0x95, 0xfc,
+	0xe8, 0xa9, 0xb8, 0xda, 0x81, 0x78, 0xf7, 0xa7, 0xb0, 0x47, 0x07, 0x56, 0x12, 0xe9, 0x78, 0x28,
+	0x13, 0x15, 0x61, 0x8c, 0xee, 0x89, 0x52, 0x6b, 0xcb, 0xbd, 0x7d, 0xb3, 0xcf, 0x72, 0x9e, 0x90,
+	0xf0, 0xf8, 0x70, 0x94, 0x5a, 0xb2, 0xfc, 0x07, 0x00, 0x4e, 0xbb, 0xeb, 0x06, 0xd3, 0x77, 0x19,
+	0xc6, 0x3d, 0x27, 0x3a, 0xe6, 0xbe, 0x32, 0x4a, 0x1e, 0xc0, 0x0f, 0x93, 0xad, 0x6d, 0xa5, 0xa7,
+	0x8b, 0xce, 0xc3, 0xf8, 0xa6, 0xa4, 0xca, 0xa2, 0xe2, 0x64, 0x88, 0xa4, 0x00, 0xfb, 0x07, 0xb9,
+	0x98, 0x20, 0xa9, 0x72, 0x65, 0x09, 0xc7, 0x2c, 0x51, 0x45, 0xce, 0x3f, 0x88, 0xc0, 0x33, 0x45,
+	0x5d, 0xef, 0x2a, 0x2d, 0x3b, 0x2e, 0x1d, 0x62, 0xf4, 0x33, 0x98, 0x35, 0x89, 0x69, 0x5a, 0xf1,
+	0xbd, 0x45, 0xac, 0x83, 0x73, 0x12, 0x90, 0xc0, 0x1d, 0x0b, 0xd1, 0xf7, 0xc3, 0xdc, 0x5d, 0x3b,
+	0x17, 0x34, 0x1c, 0xc4, 0x1a, 0xd9, 0xaf, 0x2c, 0xe1, 0xb4, 0x39, 0x1c, 0xc9, 0xe8, 0x02, 0x8c,
+	0xb5, 0x45, 0x5d, 0x33, 0x9c, 0xd0, 0xce, 0x08, 0x99, 0x63, 0x01, 0x5e, 0x4a, 0x70, 0x5f, 0x82,
+	0x39, 0x70, 0xf5, 0x31, 0xc0, 0xd1, 0x76, 0x5d, 0x33, 0x28, 0x7a, 0x01, 0x46, 0xdb, 0x62, 0x4b,
+	0xa5, 0x76, 0xa8, 0x66, 0x70, 0xa4, 0x5d, 0x52, 0x29, 0x5a, 0x80, 0xa9, 0xb6, 0xb1, 0x3d, 0x48,
+	0x7c, 0x11, 0x7b, 0xdd, 0x6c, 0xff, 0x20, 0x07, 0x97, 0xf1, 0x0d, 0x37, 0xf9, 0x61, 0xd8, 0x36,
+	0xb6, 0xdd, 0x6f, 0xf4, 0x06, 0x9c, 0x92, 0x49, 0x4b, 0x93, 0x89, 0x3c, 0x50, 0x8a, 0xba, 0x09,
+	0x71, 0xb4, 0x32, 0x34, 0xec, 0xe2, 0x8f, 0xb3, 0x2e, 0xde, 0x63, 0x28, 0x07, 0x8b, 0x52, 0xec,
+	0x59, 0x45, 0xc9, 0x76, 0xe5, 0x47, 0x20, 0x94, 0x00, 0x81, 0xf2, 0xe4, 0xaf, 0x90, 0xf1, 0x53,
+	0x57, 0xc8, 0x91, 0x22, 0x97, 0x38, 0x65, 0x91, 0xfb, 0x11, 0x4c, 0x4a, 0xba, 0x2e, 0x9a, 0x96,
+	0xff, 0xec, 0x82, 0x94, 0x5a, 0xfc, 0xd6, 0xa8, 0x35, 0x6b, 0x64, 0xbf, 0xac, 0xee, 0x90, 0xae,
+	0xa6, 0x13, 0x1c, 0x97, 0x74, 0xbd, 0xb1, 0x46, 0xf6, 0xd1, 0x1c, 0x3c, 0xd3, 0x95, 0x4c, 0x2a,
+	0x4a, 0xa2, 0xed, 0x1b, 0xd1, 0x4a, 0x0f, 0x1c, 0xb4, 0x1d, 0x94, 0xb1, 0x04, 0xc5, 0xe5, 0x92,
+	0x4a, 0xad, 0x24, 0x92, 0xff, 0x67, 0x08, 0xbe, 0xe0, 0x0b, 0x9d, 0x75, 0xcd, 0xf9, 0x45, 0x1c,
+	0x8c, 0x9b, 0xc4, 0xb0, 0xca, 0x82, 0x1d, 0x35, 0x49, 0xec, 0x0d, 0xd1, 0x32, 0x4c, 0x74, 0x5d,
+	0x94, 0x7b, 0x6d, 0xb8, 0x51, 0x9b, 0x3c, 0x96, 0x09, 0xd7, 0x6f, 0xa0, 0x8b, 0x7e, 0x03, 0x20,
+	0x94, 0x28, 0x35, 0x94, 0xcd, 0x1e, 0x25, 0x56, 0x15, 0xb3, 0x1c, 0x76, 0x65, 0x94, 0x6a, 0x82,
+	0x6d, 0x85, 0xe2, 0x40, 0xab, 0xac, 0x52, 0x63, 0x5f, 0xb8, 0x7c, 0x2c, 0x5c, 0xfc, 0x23, 0xf8,
+	0x4e, 0xfe, 0x82, 0x91, 0xe7, 0x2e, 0x2c, 0xf2, 0xbf, 0x7c, 0x5b, 0x9a, 0x7f, 0xff, 0x7b, 0xf3,
+	0x3f, 0x7e, 0x67, 0xee, 0xf5, 0x6b, 0x6f, 0xcf, 0xbf, 0xf3, 0xba, 0x37, 0xbc, 0xf8, 0xab, 0xc5,
+	0xcb, 0xbf, 0xbe, 0x80, 0x7d, 0x8b, 0xce, 0xfc, 0x14, 0x4e, 0x8d, 0x90, 0x21, 0x16, 0x86, 0xad,
+	0xd3, 0x76, 0x36, 0x6d, 0x7d, 0xa2, 0x69, 0x18, 0xdd, 0x91, 0xba, 0x3d, 0xe2, 0x5c, 0x40, 0xec,
+	0x0c, 0xae, 0x85, 0xae, 0x82, 0xfc, 0xbf, 0x43, 0xf0, 0x45, 0x9f, 0x81, 0xab, 0x9a, 0xa2, 0x16,
+	0x5b, 0x2d, 0xa2, 0xd3, 0xaf, 0x7d, 0xf7, 0x02, 0x9e, 0x0f, 0x3d, 0x87, 0xe7, 0x6f, 0xc1, 0x17,
+	0x15, 0xd5, 0x6b, 0xb7, 0x65, 0xd1, 0xab, 0x0b, 0xde, 0xf9, 0x9e, 0x7f, 0xca, 0xf9, 0x7a, 0x45,
+	0x05, 0x4f, 0xfb, 0x18, 0xbc, 0x49, 0x13, 0xbd, 0x06, 0xa7, 0x74, 0xa2, 0xca, 0x8a, 0xda, 0x11,
+	0x5d, 0x53, 0xed, 0x7b, 0x9d, 0xc0, 0x59, 0x77, 0xda, 0xdd, 0xce, 0xff, 0x28, 0xf8, 0xf3, 0x7f,
+	0x89, 0x06, 0x22, 0xd3, 0x33, 0xe4, 0x1b, 0x96, 0xd6, 0xce, 0xc1, 0x64, 0x4b, 0x53, 0xdb, 0x8a,
+	0xb1, 0x4d, 0x64, 0xbb, 0x59, 0x4e, 0xe0, 0xe1, 0x04, 0xba, 0x0e, 0x93, 0xad, 0xae, 0x64, 0x9a,
+	0xe2, 0xa6, 0xd8, 0x72, 0xd3, 0xd5, 0x77, 0x4f, 0xe0, 0xe1, 0x42, 0xc9, 0x52, 0x12, 0x4a, 0x38,
+	0xde, 0x72, 0x3e, 0xd0, 0x0a, 0x4c, 0xe8, 0x86, 0xa2, 0x19, 0x0a, 0xdd, 0xb7, 0x1d, 0x96, 0x1d,
+	0xaf, 0xae, 0xcd, 0xbd, 0x86, 0xdb, 0xb3, 0xd5, 0x5d, 0xa4, 0xaf, 0x87, 0x19, 0x68, 0x4f, 0xea,
+	0xac, 0x92, 0xa7, 0xe9, 0xac, 0x66, 0xfe, 0x04, 0x60, 0xdc, 0xb5, 0x13, 0xad, 0xc1, 0x84, 0x5b,
+	0xb6, 0x9d, 0x36, 0x3f, 0xb5, 0x78, 0xf1, 0x2b, 0x8a, 0x7f, 0x51, 0xa5, 0x44, 0x55, 0x25, 0x7f,
+	0x0f, 0x10, 0x71, 0x92, 0xb3, 0x47, 0x80, 0xca, 0x30, 0x23, 0x6d, 0x9a, 0x5a, 0xb7, 0x47, 0x89,
+	0x68, 0xbd, 0x15, 0x4f, 0x10, 0xa1, 0x11, 0x3b, 0x3a, 0xd3, 0x9e, 0x9a, 0x25, 0x70, 0xda, 0xcd,
+	0xfc, 0x6d, 0x38, 0x3d, 0xe1, 0x80, 0xad, 0xd6, 0x22, 0x39, 0xbc, 0x7b, 0xe0, 0xe4, 0x77, 0x6f,
+	0xa8, 0x65, 0xf5, 0x70, 0x2f, 0x4f, 0x80, 0x2c, 0x4b, 0x8a, 0xd5, 0x36, 0xdf, 0x84, 0x09, 0x0f,
+	0xea, 0x36, 0x18, 0x27, 0xe1, 0x9f, 0x94, 0x91, 0x3d, 0x1a, 0xf4, 0x06, 0x8c, 0xda, 0x0f, 0x63,
+	0x37, 0xe1, 0x9c, 0x1b, 0x7b, 0x51, 0x58, 0xc2, 0x25, 0x42, 0x25, 0xa5, 0x3b, 0x5a, 0xfa, 0x1c,
+	0xc5, 0xfc, 0xef, 0x00, 0xcc, 0xf9, 0x56, 0xad, 0x4c, 0xca, 0x23, 0x6b, 0xa7, 0x3b, 0x19, 0x5f,
+	0xbd, 0x1e, 0xea, 0xa3, 0x57, 0xe1, 0x94, 0x5d, 0xe8, 0x7c, 0x65, 0xce, 0xbe, 0xd5, 0x38, 0x6d,
+	0x4d, 0x0f, 0xaa, 0xdc, 0x51, 0x1c, 0x66, 0x02, 0x0d, 0xd2, 0x84, 0x67, 0x14, 0x78, 0x9e, 0x67,
+	0xd4, 0xd8, 0x29, 0x06, 0x9f, 0x51, 0x13, 0x2e, 0x41, 0xe8, 0x54, 0xcf, 0x8b, 0x62, 0x30, 0x97,
+	0xa6, 0x4f, 0x18, 0xa9, 0xfe, 0x26, 0x62, 0x15, 0x66, 0x7b, 0xfa, 0x84, 0x47, 0xc5, 0xb7, 0x9f,
+	0x72, 0xe8, 0x4e, 0x07, 0xb9, 0xc2, 0xe0, 0x4c, 0x2f, 0xd0, 0xd4, 0xae, 0xc0, 0xd4, 0xbb, 0x9a,
+	0xa2, 0x8a, 0x92, 0x5d, 0xe5, 0xdc, 0x27, 0xe2, 0xab, 0x4f, 0x21, 0x1a, 0x96, 0xc4, 0x15, 0x06,
+	0xc3, 0x77, 0x07, 0x23, 0xb4, 0x02, 0xd3, 0x83, 0xc7, 0x8e, 0xd5, 0x80, 0x47, 0x4f, 0x1c, 0xc2,
+	0x2b, 0x0c, 0x4e, 0x79, 0xaa, 0xc5, 0xd6, 0x16, 0x5a, 0x85, 0x99, 0x01, 0x93, 0x6a, 0x51, 0xc5,
+	0x9e, 0x87, 0x6a, 0x60, 0x45, 0x55, 0x1a, 0xe1, 0x32, 0x89, 0x4a, 0xb9, 0xf8, 0xa9, 0xb8, 0x1a,
+	0xd6, 0x9b, 0xa5, 0x09, 0x07, 0xaf, 0x32, 0xb1, 0x6d, 0xdf, 0x59, 0x37, 0xd1, 0x5c, 0x3c, 0x01,
+	0x9b, 0x73, 0xc9, 0x57, 0x18, 0x9c, 0x95, 0x83, 0xd7, 0xbe, 0xea, 0x63, 0x7d, 0xaf, 0x47, 0x7a,
+	0x44, 0xe6, 0x92, 0xcf, 0x63, 0xe3, 0x80, 0xef, 0xa6, 0xad, 0x8c, 0x34, 0x38, 0x13, 0xe4, 0x13,
+	0x7d, 0xc5, 0xdf, 0x6e, 0x19, 0x53, 0x8b, 0x0b, 0x4f, 0xa1, 0x9e, 0x74, 0xc5, 0x57, 0x18, 0xcc,
+	0x05, 0x96, 0xf1, 0x81, 0xac, 0x0d, 0x78, 0x2d, 0xa0, 0x68, 0x6a, 0xdd, 0x1d, 0x22, 0x73, 0xa9,
+	0x67, 0x6e, 0xc0, 0x6b, 0xfd, 0xac, 0x0d, 0x78, 0xda, 0x0d, 0x5b, 0x59, 0x48, 0xc2, 0x50, 0x4f,
+	0x77, 0x5e, 0xfa, 0x7f, 0x0b, 0x41, 0xce, 0x8d, 0x54, 0xb7, 0x7c, 0x2e, 0x6b, 0xc6, 0xb6, 0x44,
+	0x29, 0x31, 0x4c, 0x74, 0x03, 0xa6, 0x7b, 0xba, 0xd8, 0xf6, 0x26, 0xec, 0xeb, 0x9e, 0x1d, 0x7f,
+	0xf1, 0x8d, 0x2a, 0xfa, 0x6a, 0x5c, 0xaa, 0xa7, 0x0f, 0xa6, 0xd1, 0x0f, 0xe0, 0x59, 0x3f, 0x9d,
+	0xa8, 0x4b, 0x86, 0xb4, 0x4d, 0x2c, 0x62, 0xa7, 0x4b, 0x9c, 0xf6, 0x81, 0xeb, 0x9e, 0x0c, 0xdd,
+	0x84, 0xf6, 0xf9, 0xfb, 0xcc, 0x08, 0x3f, 0xb7, 0x19, 0x76, 0x84, 0x0e, 0x0d, 0xb9, 0x0a, 0xb9,
+	0x20, 0xa5, 0xcf, 0x94, 0x88, 0x6d, 0xca, 0xd9, 0x80, 0xc2, 0xc0, 0x98, 0xfc, 0xdf, 0x01, 0x9c,
+	0x5e, 0xf2, 0xbb, 0xc9, 0xfd, 0x63, 0x07, 0x35, 0xbf, 0x56, 0x6e, 0x4c, 0x7c, 0x45, 0x4e, 0x0c,
+	0x54, 0xc4, 0xd0, 0x69, 0x2a, 0xe2, 0xa5, 0x7b, 0x00, 0xb2, 0xa3, 0x27, 0x83, 0x10, 0xcc, 0x2e,
+	0xd7, 0xf0, 0x8d, 0x62, 0xb3, 0x59, 0xc6, 0x62, 0xb5, 0x56, 0x2d, 0xb3, 0x0c, 0xe2, 0xe0, 0xf4,
+	0x70, 0x0e, 0x97, 0xeb, 0xb5, 0x46, 0xa5, 0x59, 0xc3, 0xb7, 0x59, 0x80, 0x66, 0xe0, 0xd9, 0xa1,
+	0xe4, 0x3a, 0xae, 0x97, 0xc4, 0x46, 0x19, 0xbf, 0x59, 0x29, 0x59, 0xff, 0x7d, 0x04, 0xb4, 0x56,
+	0x8b, 0x6f, 0x16, 0x1b, 0x25, 0x5c, 0xa9, 0x37, 0xd9, 0x70, 0x50, 0x52, 0x2a, 0xde, 0x2e, 0x5b,
+	0x7f, 0x5c, 0xd4, 0xeb, 0x6c, 0x44, 0xf8, 0x33, 0x78, 0x78, 0xc8, 0x83, 0x47, 0x87, 0x3c, 0xf8,
+	0xec, 0x90, 0x67, 0x1e, 0x1f, 0xf2, 0xcc, 0xe7, 0x87, 0x3c, 0xf3, 0xc5, 0x21, 0xcf, 0x3c, 0x39,
+	0xe4, 0xc1, 0xdd, 0x3e, 0x0f, 0x3e, 0xec, 0xf3, 0xcc, 0x27, 0x7d, 0x1e, 0xdc, 0xef, 0xf3, 0xcc,
+	0x83, 0x3e, 0xcf, 0x7c, 0xda, 0xe7, 0x99, 0x87, 0x7d, 0x1e, 0x3c, 0xea, 0xf3, 0xe0, 0xb3, 0x3e,
+	0xcf, 0x3c, 0xee, 0xf3, 0xe0, 0xf3, 0x3e, 0xcf, 0x7c, 0xd1, 0xe7, 0xc1, 0x93, 0x3e, 0xcf, 0xdc,
+	0x3d, 0xe2, 0x99, 0x0f, 0x8f, 0x78, 0x70, 0xef, 0x88, 0x67, 0xfe, 0x70, 0xc4, 0x83, 0x8f, 0x8f,
+	0x78, 0xe6, 0x93, 0x23, 0x9e, 0xb9, 0x7f, 0xc4, 0x83, 0x07, 0x47, 0x3c, 0xf8, 0xf4, 0x88, 0x07,
+	0x3f, 0xbf, 0xdc, 0xd1, 0x0a, 0xf4, 0x0e, 0xa1, 0x77, 0xac, 0xe7, 0x66, 0x41, 0x25, 0x74, 0x57,
+	0x33, 0xb6, 0x16, 0x82, 0xff, 0x32, 0xeb, 0x5b, 0x9d, 0x05, 0x4a, 0x55, 0x7d, 0x73, 0x33, 0x66,
+	0x17, 0x8a, 0x2b, 0xff, 0x1d, 0x00, 0x54, 0xc2, 0x63, 0x9f, 0xed, 0x17, 0x00, 0x00,
 }
 
 func (x PayloadFormatter) String() string {
@@ -1711,6 +1776,36 @@ func (this *TxAcknowledgment) Equal(that interface{}) bool {
 	if this.Result != that1.Result {
 		return false
 	}
+	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
+		return false
+	}
+	return true
+}
+func (this *GatewayTxAcknowledgment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTxAcknowledgment)
+	if !ok {
+		that2, ok := that.(GatewayTxAcknowledgment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if !this.TxAck.Equal(that1.TxAck) {
+		return false
+	}
 	return true
 }
 func (this *GatewayUplinkMessage) Equal(that interface{}) bool {
@@ -2600,6 +2695,18 @@ func (m *TxAcknowledgment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DownlinkMessage != nil {
+		{
+			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Result != 0 {
 		i = encodeVarintMessages(dAtA, i, uint64(m.Result))
 		i--
@@ -2617,6 +2724,51 @@ func (m *TxAcknowledgment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GatewayTxAcknowledgment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTxAcknowledgment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTxAcknowledgment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxAck != nil {
+		{
+			size, err := m.TxAck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GatewayUplinkMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2696,12 +2848,12 @@ func (m *ApplicationUplink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x4a
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintMessages(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintMessages(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x42
 	{
@@ -2846,12 +2998,12 @@ func (m *ApplicationJoinAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
-	if err14 != nil {
-		return 0, err14
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n14
-	i = encodeVarintMessages(dAtA, i, uint64(n14))
+	i -= n17
+	i = encodeVarintMessages(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x42
 	if m.PendingSession {
@@ -3016,12 +3168,12 @@ func (m *ApplicationDownlink_ClassBC) MarshalToSizedBuffer(dAtA []byte) (int, er
 	var l int
 	_ = l
 	if m.AbsoluteTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
-		if err18 != nil {
-			return 0, err18
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n18
-		i = encodeVarintMessages(dAtA, i, uint64(n18))
+		i -= n21
+		i = encodeVarintMessages(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -3185,12 +3337,12 @@ func (m *ApplicationUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.ReceivedAt != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt):])
-		if err21 != nil {
-			return 0, err21
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n21
-		i = encodeVarintMessages(dAtA, i, uint64(n21))
+		i -= n24
+		i = encodeVarintMessages(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -3526,7 +3678,22 @@ func NewPopulatedTxAcknowledgment(r randyMessages, easy bool) *TxAcknowledgment
 	for i := 0; i < v1; i++ {
 		this.CorrelationIDs[i] = randStringMessages(r)
 	}
-	this.Result = TxAcknowledgment_Result([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8}[r.Intn(9)])
+	this.Result = TxAcknowledgment_Result([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}[r.Intn(10)])
+	if r.Intn(5) != 0 {
+		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTxAcknowledgment(r randyMessages, easy bool) *GatewayTxAcknowledgment {
+	this := &GatewayTxAcknowledgment{}
+	v2 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v2
+	if r.Intn(5) != 0 {
+		this.TxAck = NewPopulatedTxAcknowledgment(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3545,32 +3712,32 @@ func NewPopulatedGatewayUplinkMessage(r randyMessages, easy bool) *GatewayUplink
 
 func NewPopulatedApplicationUplink(r randyMessages, easy bool) *ApplicationUplink {
 	this := &ApplicationUplink{}
-	v2 := r.Intn(100)
-	this.SessionKeyID = make([]byte, v2)
-	for i := 0; i < v2; i++ {
+	v3 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v3)
+	for i := 0; i < v3; i++ {
 		this.SessionKeyID[i] = byte(r.Intn(256))
 	}
 	this.FPort = r.Uint32()
 	this.FCnt = r.Uint32()
-	v3 := r.Intn(100)
-	this.FRMPayload = make([]byte, v3)
-	for i := 0; i < v3; i++ {
+	v4 := r.Intn(100)
+	this.FRMPayload = make([]byte, v4)
+	for i := 0; i < v4; i++ {
 		this.FRMPayload[i] = byte(r.Intn(256))
 	}
 	if r.Intn(5) != 0 {
 		this.DecodedPayload = types.NewPopulatedStruct(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v4 := r.Intn(5)
-		this.RxMetadata = make([]*RxMetadata, v4)
-		for i := 0; i < v4; i++ {
+		v5 := r.Intn(5)
+		this.RxMetadata = make([]*RxMetadata, v5)
+		for i := 0; i < v5; i++ {
 			this.RxMetadata[i] = NewPopulatedRxMetadata(r, easy)
 		}
 	}
-	v5 := NewPopulatedTxSettings(r, easy)
-	this.Settings = *v5
-	v6 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ReceivedAt = *v6
+	v6 := NewPopulatedTxSettings(r, easy)
+	this.Settings = *v6
+	v7 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ReceivedAt = *v7
 	if r.Intn(5) != 0 {
 		this.AppSKey = NewPopulatedKeyEnvelope(r, easy)
 	}
@@ -3583,12 +3750,12 @@ func NewPopulatedApplicationUplink(r randyMessages, easy bool) *ApplicationUplin
 func NewPopulatedApplicationLocation(r randyMessages, easy bool) *ApplicationLocation {
 	this := &ApplicationLocation{}
 	this.Service = randStringMessages(r)
-	v7 := NewPopulatedLocation(r, easy)
-	this.Location = *v7
+	v8 := NewPopulatedLocation(r, easy)
+	this.Location = *v8
 	if r.Intn(5) != 0 {
-		v8 := r.Intn(10)
+		v9 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v8; i++ {
+		for i := 0; i < v9; i++ {
 			this.Attributes[randStringMessages(r)] = randStringMessages(r)
 		}
 	}
@@ -3599,24 +3766,24 @@ func NewPopulatedApplicationLocation(r randyMessages, easy bool) *ApplicationLoc
 
 func NewPopulatedApplicationJoinAccept(r randyMessages, easy bool) *ApplicationJoinAccept {
 	this := &ApplicationJoinAccept{}
-	v9 := r.Intn(100)
-	this.SessionKeyID = make([]byte, v9)
-	for i := 0; i < v9; i++ {
+	v10 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v10)
+	for i := 0; i < v10; i++ {
 		this.SessionKeyID[i] = byte(r.Intn(256))
 	}
 	if r.Intn(5) != 0 {
 		this.AppSKey = NewPopulatedKeyEnvelope(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(5)
-		this.InvalidatedDownlinks = make([]*ApplicationDownlink, v10)
-		for i := 0; i < v10; i++ {
+		v11 := r.Intn(5)
+		this.InvalidatedDownlinks = make([]*ApplicationDownlink, v11)
+		for i := 0; i < v11; i++ {
 			this.InvalidatedDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
 	this.PendingSession = bool(r.Intn(2) == 0)
-	v11 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ReceivedAt = *v11
+	v12 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ReceivedAt = *v12
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3625,11 +3792,11 @@ func NewPopulatedApplicationJoinAccept(r randyMessages, easy bool) *ApplicationJ
 func NewPopulatedApplicationDownlink_ClassBC(r randyMessages, easy bool) *ApplicationDownlink_ClassBC {
 	this := &ApplicationDownlink_ClassBC{}
 	if r.Intn(5) != 0 {
-		v12 := r.Intn(5)
-		this.Gateways = make([]GatewayAntennaIdentifiers, v12)
-		for i := 0; i < v12; i++ {
-			v13 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
-			this.Gateways[i] = *v13
+		v13 := r.Intn(5)
+		this.Gateways = make([]GatewayAntennaIdentifiers, v13)
+		for i := 0; i < v13; i++ {
+			v14 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
+			this.Gateways[i] = *v14
 		}
 	}
 	if r.Intn(5) != 0 {
@@ -3643,9 +3810,9 @@ func NewPopulatedApplicationDownlink_ClassBC(r randyMessages, easy bool) *Applic
 func NewPopulatedApplicationDownlinks(r randyMessages, easy bool) *ApplicationDownlinks {
 	this := &ApplicationDownlinks{}
 	if r.Intn(5) != 0 {
-		v14 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v14)
-		for i := 0; i < v14; i++ {
+		v15 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v15)
+		for i := 0; i < v15; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3656,10 +3823,10 @@ func NewPopulatedApplicationDownlinks(r randyMessages, easy bool) *ApplicationDo
 
 func NewPopulatedApplicationDownlinkFailed(r randyMessages, easy bool) *ApplicationDownlinkFailed {
 	this := &ApplicationDownlinkFailed{}
-	v15 := NewPopulatedApplicationDownlink(r, easy)
-	this.ApplicationDownlink = *v15
-	v16 := NewPopulatedErrorDetails(r, easy)
-	this.Error = *v16
+	v16 := NewPopulatedApplicationDownlink(r, easy)
+	this.ApplicationDownlink = *v16
+	v17 := NewPopulatedErrorDetails(r, easy)
+	this.Error = *v17
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3668,9 +3835,9 @@ func NewPopulatedApplicationDownlinkFailed(r randyMessages, easy bool) *Applicat
 func NewPopulatedApplicationInvalidatedDownlinks(r randyMessages, easy bool) *ApplicationInvalidatedDownlinks {
 	this := &ApplicationInvalidatedDownlinks{}
 	if r.Intn(5) != 0 {
-		v17 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v17)
-		for i := 0; i < v17; i++ {
+		v18 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v18)
+		for i := 0; i < v18; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3682,11 +3849,11 @@ func NewPopulatedApplicationInvalidatedDownlinks(r randyMessages, easy bool) *Ap
 
 func NewPopulatedApplicationUp(r randyMessages, easy bool) *ApplicationUp {
 	this := &ApplicationUp{}
-	v18 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v18
-	v19 := r.Intn(10)
-	this.CorrelationIDs = make([]string, v19)
-	for i := 0; i < v19; i++ {
+	v19 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v19
+	v20 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v20)
+	for i := 0; i < v20; i++ {
 		this.CorrelationIDs[i] = randStringMessages(r)
 	}
 	oneofNumber_Up := []int32{3, 4, 5, 6, 7, 8, 9, 10, 11}[r.Intn(9)]
@@ -3776,12 +3943,12 @@ func NewPopulatedMessagePayloadFormatters(r randyMessages, easy bool) *MessagePa
 
 func NewPopulatedDownlinkQueueRequest(r randyMessages, easy bool) *DownlinkQueueRequest {
 	this := &DownlinkQueueRequest{}
-	v20 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v20
+	v21 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v21
 	if r.Intn(5) != 0 {
-		v21 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v21)
-		for i := 0; i < v21; i++ {
+		v22 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v22)
+		for i := 0; i < v22; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3809,9 +3976,9 @@ func randUTF8RuneMessages(r randyMessages) rune {
 	return rune(ru + 61)
 }
 func randStringMessages(r randyMessages) string {
-	v22 := r.Intn(100)
-	tmps := make([]rune, v22)
-	for i := 0; i < v22; i++ {
+	v23 := r.Intn(100)
+	tmps := make([]rune, v23)
+	for i := 0; i < v23; i++ {
 		tmps[i] = randUTF8RuneMessages(r)
 	}
 	return string(tmps)
@@ -3833,11 +4000,11 @@ func randFieldMessages(dAtA []byte, r randyMessages, fieldNumber int, wire int)
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMessages(dAtA, uint64(key))
-		v23 := r.Int63()
+		v24 := r.Int63()
 		if r.Intn(2) == 0 {
-			v23 *= -1
+			v24 *= -1
 		}
-		dAtA = encodeVarintPopulateMessages(dAtA, uint64(v23))
+		dAtA = encodeVarintPopulateMessages(dAtA, uint64(v24))
 	case 1:
 		dAtA = encodeVarintPopulateMessages(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3967,6 +4134,25 @@ func (m *TxAcknowledgment) Size() (n int) {
 	if m.Result != 0 {
 		n += 1 + sovMessages(uint64(m.Result))
 	}
+	if m.DownlinkMessage != nil {
+		l = m.DownlinkMessage.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayTxAcknowledgment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	if m.TxAck != nil {
+		l = m.TxAck.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -4430,6 +4616,18 @@ func (this *TxAcknowledgment) String() string {
 	s := strings.Join([]string{`&TxAcknowledgment{`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`DownlinkMessage:` + strings.Replace(this.DownlinkMessage.String(), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTxAcknowledgment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTxAcknowledgment{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`TxAck:` + strings.Replace(this.TxAck.String(), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5341,6 +5539,164 @@ func (m *TxAcknowledgment) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayTxAcknowledgment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayTxAcknowledgment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayTxAcknowledgment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxAck == nil {
+				m.TxAck = &TxAcknowledgment{}
+			}
+			if err := m.TxAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
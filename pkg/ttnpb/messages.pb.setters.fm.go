@@ -296,6 +296,85 @@ func (dst *TxAcknowledgment) SetFields(src *TxAcknowledgment, paths ...string) e
 				var zero TxAcknowledgment_Result
 				dst.Result = zero
 			}
+		case "downlink_message":
+			if len(subs) > 0 {
+				var newDst, newSrc *DownlinkMessage
+				if (src == nil || src.DownlinkMessage == nil) && dst.DownlinkMessage == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DownlinkMessage
+				}
+				if dst.DownlinkMessage != nil {
+					newDst = dst.DownlinkMessage
+				} else {
+					newDst = &DownlinkMessage{}
+					dst.DownlinkMessage = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkMessage = src.DownlinkMessage
+				} else {
+					dst.DownlinkMessage = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTxAcknowledgment) SetFields(src *GatewayTxAcknowledgment, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "tx_ack":
+			if len(subs) > 0 {
+				var newDst, newSrc *TxAcknowledgment
+				if (src == nil || src.TxAck == nil) && dst.TxAck == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.TxAck
+				}
+				if dst.TxAck != nil {
+					newDst = dst.TxAck
+				} else {
+					newDst = &TxAcknowledgment{}
+					dst.TxAck = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.TxAck = src.TxAck
+				} else {
+					dst.TxAck = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -5,6 +5,7 @@ package ttnpb
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -73,9 +74,141 @@ func (m *GenerateDevAddrResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenerateDevAddrResponse proto.InternalMessageInfo
 
+// DownlinkPathSelection describes the order in which the Network Server tries the downlink paths.
+type DownlinkPathSelection struct {
+	// Candidates in the order in which they are tried.
+	Candidates           []*DownlinkPathSelection_Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *DownlinkPathSelection) Reset()      { *m = DownlinkPathSelection{} }
+func (*DownlinkPathSelection) ProtoMessage() {}
+func (*DownlinkPathSelection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{1}
+}
+func (m *DownlinkPathSelection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DownlinkPathSelection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DownlinkPathSelection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DownlinkPathSelection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownlinkPathSelection.Merge(m, src)
+}
+func (m *DownlinkPathSelection) XXX_Size() int {
+	return m.Size()
+}
+func (m *DownlinkPathSelection) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownlinkPathSelection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownlinkPathSelection proto.InternalMessageInfo
+
+func (m *DownlinkPathSelection) GetCandidates() []*DownlinkPathSelection_Candidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type DownlinkPathSelection_Candidate struct {
+	GatewayIDs GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids"`
+	// Signal-to-noise ratio (dB) of the uplink message received by the gateway.
+	SNR float32 `protobuf:"fixed32,2,opt,name=snr,proto3" json:"snr,omitempty"`
+	// Utilization of the sub-band of the downlink frequency as a fraction of the available duty-cycle.
+	DutyCycleUtilization float32 `protobuf:"fixed32,3,opt,name=duty_cycle_utilization,json=dutyCycleUtilization,proto3" json:"duty_cycle_utilization,omitempty"`
+	// Number of scheduled emissions of the gateway that have not been transmitted yet.
+	QueuedEmissions uint32 `protobuf:"varint,4,opt,name=queued_emissions,json=queuedEmissions,proto3" json:"queued_emissions,omitempty"`
+	// Score that balances the link margin against the gateway load. Paths with higher scores are tried first.
+	Score                float32  `protobuf:"fixed32,5,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownlinkPathSelection_Candidate) Reset()      { *m = DownlinkPathSelection_Candidate{} }
+func (*DownlinkPathSelection_Candidate) ProtoMessage() {}
+func (*DownlinkPathSelection_Candidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{1, 0}
+}
+func (m *DownlinkPathSelection_Candidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DownlinkPathSelection_Candidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DownlinkPathSelection_Candidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DownlinkPathSelection_Candidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownlinkPathSelection_Candidate.Merge(m, src)
+}
+func (m *DownlinkPathSelection_Candidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DownlinkPathSelection_Candidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownlinkPathSelection_Candidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownlinkPathSelection_Candidate proto.InternalMessageInfo
+
+func (m *DownlinkPathSelection_Candidate) GetGatewayIDs() GatewayIdentifiers {
+	if m != nil {
+		return m.GatewayIDs
+	}
+	return GatewayIdentifiers{}
+}
+
+func (m *DownlinkPathSelection_Candidate) GetSNR() float32 {
+	if m != nil {
+		return m.SNR
+	}
+	return 0
+}
+
+func (m *DownlinkPathSelection_Candidate) GetDutyCycleUtilization() float32 {
+	if m != nil {
+		return m.DutyCycleUtilization
+	}
+	return 0
+}
+
+func (m *DownlinkPathSelection_Candidate) GetQueuedEmissions() uint32 {
+	if m != nil {
+		return m.QueuedEmissions
+	}
+	return 0
+}
+
+func (m *DownlinkPathSelection_Candidate) GetScore() float32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
 	golang_proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
+	proto.RegisterType((*DownlinkPathSelection)(nil), "ttn.lorawan.v3.DownlinkPathSelection")
+	golang_proto.RegisterType((*DownlinkPathSelection)(nil), "ttn.lorawan.v3.DownlinkPathSelection")
+	proto.RegisterType((*DownlinkPathSelection_Candidate)(nil), "ttn.lorawan.v3.DownlinkPathSelection.Candidate")
+	golang_proto.RegisterType((*DownlinkPathSelection_Candidate)(nil), "ttn.lorawan.v3.DownlinkPathSelection.Candidate")
 }
 
 func init() {
@@ -86,52 +219,66 @@ func init() {
 }
 
 var fileDescriptor_c77e7504ad1081b8 = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x48, 0x1b, 0x4f,
-	0x14, 0x9e, 0x89, 0xe2, 0xef, 0xc7, 0x20, 0x4a, 0xa7, 0xd2, 0x3f, 0x69, 0x3b, 0x95, 0x68, 0xa9,
-	0x48, 0x9d, 0x2d, 0xb1, 0x87, 0xe2, 0x4d, 0x49, 0x88, 0x05, 0x23, 0x55, 0xeb, 0xc5, 0x4b, 0xd8,
-	0x64, 0x9f, 0x9b, 0x25, 0x71, 0x76, 0xbb, 0x33, 0x89, 0x48, 0x11, 0xa4, 0x87, 0xe2, 0xb1, 0x50,
-	0x0a, 0x3d, 0x96, 0x9e, 0x3c, 0x4a, 0x2f, 0xf5, 0x54, 0x3c, 0x7a, 0x14, 0x7a, 0x91, 0x1e, 0xc4,
-	0xec, 0xf6, 0x20, 0xf4, 0xe2, 0xd1, 0x63, 0xc9, 0x26, 0x31, 0x26, 0x6b, 0xc4, 0xfe, 0xb9, 0xcd,
-	0xe4, 0x7d, 0xef, 0x7d, 0xdf, 0xfb, 0xe6, 0xcb, 0x92, 0x07, 0x45, 0xdb, 0xd5, 0x57, 0x75, 0x31,
-	0x26, 0x95, 0x9e, 0x2b, 0x68, 0xba, 0x63, 0x69, 0x02, 0xd4, 0xaa, 0xed, 0x16, 0x24, 0xb8, 0x65,
-	0x70, 0xb9, 0xe3, 0xda, 0xca, 0xa6, 0x7d, 0x4a, 0x09, 0x5e, 0x87, 0xf2, 0xf2, 0x78, 0x74, 0xcc,
-	0xb4, 0x54, 0xbe, 0x94, 0xe5, 0x39, 0x7b, 0x45, 0x33, 0x6d, 0xd3, 0xd6, 0x02, 0x58, 0xb6, 0xb4,
-	0x1c, 0xdc, 0x82, 0x4b, 0x70, 0xaa, 0xb5, 0x47, 0xef, 0x9a, 0xb6, 0x6d, 0x16, 0x21, 0x18, 0xaf,
-	0x0b, 0x61, 0x2b, 0x5d, 0x59, 0xb6, 0x90, 0xf5, 0xea, 0x9d, 0x7a, 0xf5, 0x6c, 0x06, 0xac, 0x38,
-	0x6a, 0xad, 0x5e, 0x8c, 0x85, 0x05, 0x82, 0x30, 0x32, 0x06, 0x94, 0xad, 0x1c, 0xd4, 0x31, 0x43,
-	0x61, 0x8c, 0x65, 0x80, 0x50, 0xd6, 0xb2, 0x05, 0x6e, 0x83, 0x65, 0x30, 0x0c, 0x5a, 0x01, 0x29,
-	0x75, 0x13, 0xea, 0x88, 0x98, 0x20, 0x37, 0x53, 0x20, 0xc0, 0xd5, 0x15, 0x24, 0xa0, 0x3c, 0x69,
-	0x18, 0xee, 0x3c, 0x48, 0xc7, 0x16, 0x12, 0xe8, 0x02, 0xf9, 0xdf, 0x80, 0x72, 0x46, 0x37, 0x0c,
-	0xf7, 0x16, 0x1e, 0xc4, 0x23, 0xbd, 0x53, 0x4f, 0xbf, 0x1f, 0xde, 0x7f, 0x62, 0xda, 0x5c, 0xe5,
-	0x41, 0xe5, 0x2d, 0x61, 0x4a, 0x5e, 0xf7, 0x4d, 0x6b, 0xe5, 0x71, 0x0a, 0xa6, 0xa6, 0xd6, 0x1c,
-	0x90, 0xbc, 0x31, 0xf3, 0x3f, 0xa3, 0x76, 0x88, 0x0b, 0x12, 0x99, 0x95, 0x34, 0x4f, 0xfa, 0xdb,
-	0x58, 0xe9, 0x0d, 0x5e, 0x73, 0x84, 0x37, 0x1c, 0xe1, 0xc9, 0xaa, 0x23, 0xd1, 0x87, 0xbc, 0xf5,
-	0x19, 0x78, 0x07, 0xb9, 0xb1, 0x81, 0xd7, 0xdf, 0x7e, 0xbc, 0x8b, 0xf4, 0xd1, 0x5e, 0x4d, 0x48,
-	0xad, 0x21, 0x3c, 0x7e, 0x18, 0x21, 0xdd, 0x93, 0x72, 0x56, 0xd2, 0x19, 0xd2, 0x3f, 0x63, 0x89,
-	0xc2, 0xa4, 0xe3, 0x14, 0xad, 0x5c, 0xf0, 0x14, 0x1d, 0x29, 0xef, 0xb5, 0x53, 0x9e, 0x6b, 0x5a,
-	0x74, 0x46, 0xf0, 0x63, 0x4c, 0x5f, 0x90, 0x81, 0x84, 0xbd, 0x2a, 0x8a, 0x96, 0x28, 0xcc, 0x95,
-	0xa0, 0x04, 0xf3, 0xe0, 0x14, 0xf5, 0x1c, 0xd0, 0xe1, 0xf6, 0xd6, 0x36, 0xd4, 0xcb, 0x12, 0x48,
-	0x15, 0xed, 0x40, 0x4c, 0xe7, 0xc8, 0xb5, 0x16, 0xfc, 0xf3, 0x92, 0xcc, 0xff, 0xe5, 0xc8, 0x4c,
-	0xdb, 0xc8, 0x19, 0x4b, 0xaa, 0xf0, 0xc8, 0xa4, 0x30, 0x12, 0x41, 0xb8, 0x9e, 0x35, 0x23, 0x14,
-	0x1d, 0xbe, 0xc4, 0x86, 0xc6, 0x4c, 0x19, 0x4f, 0x93, 0xee, 0x54, 0xd5, 0xdf, 0x24, 0xe9, 0x9d,
-	0xd6, 0x85, 0x51, 0x84, 0x45, 0xa7, 0x5a, 0xa0, 0x21, 0x13, 0x6b, 0xbf, 0xa7, 0x6b, 0xf1, 0xeb,
-	0xa4, 0x37, 0xfe, 0xb3, 0x9b, 0x5c, 0x9f, 0x95, 0x67, 0x7a, 0xe6, 0xc1, 0xb4, 0xa4, 0x72, 0xd7,
-	0xe8, 0x67, 0x4c, 0xba, 0x52, 0xa0, 0xe8, 0x50, 0x38, 0x0e, 0xea, 0x1c, 0xba, 0x66, 0xc6, 0xed,
-	0x8e, 0xfb, 0xc5, 0x0a, 0x41, 0x4a, 0x80, 0xe6, 0xaa, 0x29, 0xd1, 0x9b, 0x0b, 0x49, 0xed, 0x55,
-	0xf3, 0x2f, 0x96, 0xb1, 0x0c, 0xc9, 0xcf, 0x15, 0x2f, 0xb8, 0xaf, 0x6b, 0x35, 0x68, 0xb8, 0xef,
-	0xec, 0xb8, 0x4e, 0xdf, 0x44, 0x48, 0xd7, 0xc2, 0x45, 0xa2, 0x17, 0x7e, 0x4f, 0xf4, 0x57, 0x1c,
-	0xa8, 0xfe, 0x82, 0xa3, 0x97, 0xca, 0xe6, 0x7f, 0x28, 0x9b, 0xb7, 0xca, 0x9e, 0xc0, 0xa3, 0x4b,
-	0xe9, 0xd8, 0xf4, 0xbf, 0x62, 0x9a, 0xc0, 0xa3, 0xf4, 0x3d, 0x26, 0x3d, 0x09, 0x28, 0x82, 0x82,
-	0x2b, 0x66, 0xaf, 0x43, 0x3c, 0x62, 0xe9, 0xc0, 0x88, 0xd4, 0x68, 0x32, 0xac, 0xee, 0xca, 0x8b,
-	0x37, 0x37, 0x9d, 0xfa, 0x84, 0xf7, 0x2a, 0x0c, 0xef, 0x57, 0x18, 0x3e, 0xa8, 0x30, 0x74, 0x54,
-	0x61, 0xe8, 0xb8, 0xc2, 0xd0, 0x49, 0x85, 0xa1, 0xd3, 0x0a, 0xc3, 0x1b, 0x1e, 0xc3, 0x9b, 0x1e,
-	0x43, 0x5b, 0x1e, 0xc3, 0xdb, 0x1e, 0x43, 0x3b, 0x1e, 0x43, 0xbb, 0x1e, 0x43, 0x7b, 0x1e, 0xc3,
-	0xfb, 0x1e, 0xc3, 0x07, 0x1e, 0x43, 0x47, 0x1e, 0xc3, 0xc7, 0x1e, 0x43, 0x27, 0x1e, 0xc3, 0xa7,
-	0x1e, 0x43, 0x1b, 0x3e, 0x43, 0x9b, 0x3e, 0xc3, 0x6f, 0x7d, 0x86, 0x3e, 0xf8, 0x0c, 0x7f, 0xf4,
-	0x19, 0xda, 0xf2, 0x19, 0xda, 0xf6, 0x19, 0xde, 0xf1, 0x19, 0xde, 0xf5, 0x19, 0x5e, 0x7a, 0x74,
-	0xd5, 0x6f, 0xa8, 0x12, 0x4e, 0x36, 0xdb, 0x13, 0x78, 0x30, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0x32, 0x2f, 0xe5, 0xb7, 0x06, 0x00, 0x00,
+	// 933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6c, 0x1b, 0x45,
+	0x18, 0xdd, 0xb1, 0xd3, 0x16, 0xa6, 0xa1, 0x81, 0x21, 0x04, 0xd7, 0xc0, 0xc4, 0x72, 0x8b, 0x6a,
+	0x22, 0xba, 0x8b, 0xdc, 0x1e, 0x50, 0x6f, 0x49, 0x6d, 0xb9, 0x48, 0x49, 0x68, 0xd7, 0x8d, 0x10,
+	0xbd, 0x58, 0x9b, 0xdd, 0xaf, 0xeb, 0x91, 0xd7, 0xb3, 0xdb, 0x9d, 0xb1, 0x8d, 0x41, 0x95, 0x2a,
+	0x0e, 0xa8, 0x47, 0x24, 0x84, 0xc4, 0x11, 0x71, 0xca, 0xb1, 0xe2, 0x42, 0x4f, 0xa8, 0xc7, 0x1c,
+	0x2b, 0x71, 0xa9, 0x38, 0x58, 0xf5, 0x9a, 0x43, 0x25, 0x2e, 0x3d, 0xa1, 0x1e, 0xd1, 0xee, 0x7a,
+	0x63, 0xc7, 0x1b, 0x57, 0xe1, 0xe7, 0xb6, 0x33, 0xef, 0xcd, 0xfb, 0xbe, 0xef, 0xcd, 0x1b, 0x1b,
+	0xbf, 0xef, 0xb8, 0xbe, 0xd1, 0x33, 0xf8, 0x45, 0x21, 0x0d, 0xb3, 0xa5, 0x19, 0x1e, 0xd3, 0x38,
+	0xc8, 0x9e, 0xeb, 0xb7, 0x04, 0xf8, 0x5d, 0xf0, 0x55, 0xcf, 0x77, 0xa5, 0x4b, 0xce, 0x48, 0xc9,
+	0xd5, 0x31, 0x55, 0xed, 0x5e, 0xca, 0x5f, 0xb4, 0x99, 0x6c, 0x76, 0x76, 0x55, 0xd3, 0x6d, 0x6b,
+	0xb6, 0x6b, 0xbb, 0x5a, 0x44, 0xdb, 0xed, 0xdc, 0x8e, 0x56, 0xd1, 0x22, 0xfa, 0x8a, 0x8f, 0xe7,
+	0xdf, 0xb5, 0x5d, 0xd7, 0x76, 0x20, 0x92, 0x37, 0x38, 0x77, 0xa5, 0x21, 0x99, 0xcb, 0xc5, 0x18,
+	0x7d, 0x67, 0x8c, 0x1e, 0x68, 0x40, 0xdb, 0x93, 0xfd, 0x31, 0x58, 0x4c, 0x37, 0x08, 0xdc, 0x6a,
+	0x58, 0xd0, 0x65, 0x26, 0x8c, 0x39, 0xe7, 0xd2, 0x1c, 0x66, 0x01, 0x97, 0xec, 0x36, 0x03, 0x3f,
+	0xa9, 0x52, 0x48, 0x93, 0xda, 0x20, 0x84, 0x61, 0xc3, 0x98, 0x51, 0xe4, 0xf8, 0xed, 0x1a, 0x70,
+	0xf0, 0x0d, 0x09, 0x15, 0xe8, 0xae, 0x5b, 0x96, 0xaf, 0x83, 0xf0, 0x5c, 0x2e, 0x80, 0xd4, 0xf1,
+	0x2b, 0x16, 0x74, 0x1b, 0x86, 0x65, 0xf9, 0x39, 0x54, 0x40, 0xa5, 0xc5, 0x8d, 0x8f, 0x7f, 0x1f,
+	0xac, 0x5e, 0xb6, 0x5d, 0x55, 0x36, 0x41, 0x36, 0x19, 0xb7, 0x85, 0x3a, 0xf6, 0x4d, 0x3b, 0x5c,
+	0xc7, 0x6b, 0xd9, 0x9a, 0xec, 0x7b, 0x20, 0xd4, 0x44, 0xf3, 0x94, 0x15, 0x7f, 0x14, 0x87, 0x19,
+	0xfc, 0x56, 0xc5, 0xed, 0x71, 0x87, 0xf1, 0xd6, 0x75, 0x43, 0x36, 0xeb, 0xe0, 0x80, 0x19, 0x1a,
+	0x43, 0x3e, 0xc5, 0xd8, 0x34, 0xb8, 0xc5, 0x2c, 0x43, 0x82, 0xc8, 0xa1, 0x42, 0xb6, 0x74, 0xba,
+	0xac, 0xa9, 0x87, 0xef, 0x40, 0x3d, 0xf2, 0xa8, 0x7a, 0x35, 0x39, 0xa7, 0x4f, 0x49, 0xe4, 0xff,
+	0x42, 0xf8, 0xd5, 0x03, 0x84, 0x7c, 0x86, 0x4f, 0xdb, 0x86, 0x84, 0x9e, 0xd1, 0x6f, 0x30, 0x4b,
+	0x44, 0x03, 0x9d, 0x2e, 0x17, 0x67, 0xf5, 0x6b, 0x31, 0xe5, 0x93, 0x89, 0x93, 0x1b, 0x64, 0x7f,
+	0xb0, 0xaa, 0x04, 0x83, 0x55, 0x9c, 0x60, 0x15, 0xa1, 0x63, 0x3b, 0xe1, 0x09, 0x72, 0x16, 0x67,
+	0x05, 0xf7, 0x73, 0x99, 0x02, 0x2a, 0x65, 0x36, 0x4e, 0x05, 0x83, 0xd5, 0x6c, 0x7d, 0x5b, 0xd7,
+	0xc3, 0x3d, 0x72, 0x19, 0xaf, 0x58, 0x1d, 0xd9, 0x6f, 0x98, 0x7d, 0xd3, 0x81, 0x46, 0x47, 0x32,
+	0x87, 0x7d, 0x19, 0xa5, 0x20, 0x97, 0x0d, 0xd9, 0xfa, 0x72, 0x88, 0x5e, 0x0d, 0xc1, 0x9d, 0x09,
+	0x46, 0x3e, 0xc0, 0xaf, 0xdf, 0xe9, 0x40, 0x07, 0xac, 0x06, 0xb4, 0x99, 0x10, 0x61, 0x68, 0x72,
+	0x0b, 0x05, 0x54, 0x7a, 0x4d, 0x5f, 0x8a, 0xf7, 0xab, 0xc9, 0x36, 0x59, 0xc6, 0x27, 0x84, 0xe9,
+	0xfa, 0x90, 0x3b, 0x11, 0xe9, 0xc5, 0x8b, 0x32, 0xc7, 0x99, 0x6d, 0x41, 0x9a, 0x78, 0x69, 0xe6,
+	0x66, 0xc9, 0x8a, 0x1a, 0xa7, 0x4e, 0x4d, 0x52, 0xa7, 0x56, 0xc3, 0xd4, 0xe5, 0x2f, 0xa4, 0x6c,
+	0x38, 0x3a, 0x12, 0xc5, 0xe5, 0xaf, 0x7f, 0xfb, 0xe3, 0xbb, 0xcc, 0x19, 0xb2, 0xa8, 0x71, 0xa1,
+	0x25, 0xe1, 0x28, 0x0f, 0x32, 0x78, 0x61, 0x5d, 0x6c, 0x0b, 0xb2, 0x89, 0x97, 0x36, 0x19, 0x6f,
+	0xad, 0x7b, 0x9e, 0xc3, 0xcc, 0x78, 0x98, 0x79, 0x25, 0xdf, 0x9b, 0x2d, 0x39, 0x75, 0x68, 0xc7,
+	0x2b, 0xa1, 0x8f, 0x10, 0xb9, 0x89, 0x97, 0x93, 0xeb, 0xbe, 0x11, 0xce, 0xad, 0x83, 0xe7, 0x18,
+	0x26, 0x90, 0xf3, 0xf3, 0x42, 0x31, 0x66, 0xdd, 0xe9, 0x80, 0x90, 0xf9, 0x39, 0x85, 0xc9, 0x0d,
+	0xfc, 0xc6, 0x21, 0xfe, 0xf5, 0x8e, 0x68, 0xfe, 0x47, 0xc9, 0xc6, 0x8c, 0xe4, 0x26, 0x13, 0x32,
+	0x2d, 0x59, 0xe5, 0x56, 0x25, 0x7a, 0xc0, 0x53, 0xe1, 0xca, 0x9f, 0x7f, 0x89, 0x0d, 0x89, 0xa6,
+	0x28, 0xef, 0x21, 0xbc, 0x50, 0x0b, 0x0d, 0xae, 0xe2, 0xc5, 0x6b, 0x06, 0xb7, 0x1c, 0xd8, 0xf1,
+	0x42, 0x84, 0xa4, 0x5c, 0x8c, 0xf7, 0xb7, 0xe2, 0x37, 0x3e, 0xb7, 0xe1, 0xcf, 0xf1, 0x8a, 0x0e,
+	0x9e, 0xeb, 0xcb, 0x9b, 0x5f, 0xac, 0x9b, 0x2d, 0xee, 0xf6, 0x1c, 0xb0, 0xec, 0x36, 0x70, 0x49,
+	0x2e, 0xcc, 0x79, 0x10, 0xb3, 0xc4, 0x79, 0xd2, 0xe5, 0x3f, 0x17, 0xf0, 0x9b, 0xdb, 0xe2, 0x60,
+	0x56, 0x1d, 0x6c, 0x26, 0xa4, 0xdf, 0x27, 0x3f, 0x23, 0x9c, 0xad, 0x81, 0x24, 0xe7, 0xd2, 0x51,
+	0x93, 0x53, 0xec, 0xd8, 0xe8, 0xb3, 0x73, 0xbd, 0x2b, 0xb6, 0xa2, 0x04, 0x02, 0x31, 0xc3, 0x04,
+	0x1a, 0x13, 0xb3, 0x84, 0xf6, 0xd5, 0xe4, 0x27, 0x32, 0x7c, 0xe1, 0xea, 0x14, 0x78, 0xc4, 0xfa,
+	0xae, 0x16, 0x53, 0xd3, 0xe7, 0x0e, 0x3e, 0xef, 0x92, 0x6f, 0x32, 0x38, 0x5b, 0x3f, 0xaa, 0xe9,
+	0xfa, 0x3f, 0x6b, 0xfa, 0x57, 0x14, 0x75, 0xfd, 0x0b, 0xca, 0xbf, 0xb4, 0x6d, 0xf5, 0x5f, 0xb6,
+	0xad, 0x1e, 0x6e, 0xfb, 0x0a, 0x5a, 0xbb, 0xb5, 0x55, 0xbc, 0xf6, 0x7f, 0x55, 0xba, 0x82, 0xd6,
+	0xc8, 0xf7, 0x08, 0x9f, 0xac, 0x80, 0x03, 0x12, 0x8e, 0x99, 0xeb, 0x39, 0xf1, 0x28, 0x6e, 0x45,
+	0x46, 0xd4, 0xd6, 0xaa, 0xe9, 0xee, 0x8e, 0x3d, 0xf8, 0x64, 0xd2, 0x8d, 0x9f, 0xd0, 0xfe, 0x90,
+	0xa2, 0xc7, 0x43, 0x8a, 0x9e, 0x0c, 0xa9, 0xf2, 0x74, 0x48, 0x95, 0x67, 0x43, 0xaa, 0x3c, 0x1f,
+	0x52, 0xe5, 0xc5, 0x90, 0xa2, 0x7b, 0x01, 0x45, 0xf7, 0x03, 0xaa, 0xec, 0x05, 0x14, 0x3d, 0x08,
+	0xa8, 0xf2, 0x30, 0xa0, 0xca, 0xa3, 0x80, 0x2a, 0xfb, 0x01, 0x45, 0x8f, 0x03, 0x8a, 0x9e, 0x04,
+	0x54, 0x79, 0x1a, 0x50, 0xf4, 0x2c, 0xa0, 0xca, 0xf3, 0x80, 0xa2, 0x17, 0x01, 0x55, 0xee, 0x8d,
+	0xa8, 0x72, 0x7f, 0x44, 0xd1, 0xb7, 0x23, 0xaa, 0xfc, 0x30, 0xa2, 0xe8, 0xc7, 0x11, 0x55, 0xf6,
+	0x46, 0x54, 0x79, 0x30, 0xa2, 0xe8, 0xe1, 0x88, 0xa2, 0x47, 0x23, 0x8a, 0x6e, 0x7d, 0x78, 0xdc,
+	0xff, 0x40, 0xc9, 0xbd, 0xdd, 0xdd, 0x93, 0x91, 0x07, 0x97, 0xfe, 0x1e, 0x00, 0xe6, 0x4a, 0x85,
+	0xc3, 0x77, 0x08, 0x00, 0x00,
 }
 
 func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
@@ -162,6 +309,71 @@ func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DownlinkPathSelection) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownlinkPathSelection)
+	if !ok {
+		that2, ok := that.(DownlinkPathSelection)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Candidates) != len(that1.Candidates) {
+		return false
+	}
+	for i := range this.Candidates {
+		if !this.Candidates[i].Equal(that1.Candidates[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DownlinkPathSelection_Candidate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownlinkPathSelection_Candidate)
+	if !ok {
+		that2, ok := that.(DownlinkPathSelection_Candidate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIDs.Equal(&that1.GatewayIDs) {
+		return false
+	}
+	if this.SNR != that1.SNR {
+		return false
+	}
+	if this.DutyCycleUtilization != that1.DutyCycleUtilization {
+		return false
+	}
+	if this.QueuedEmissions != that1.QueuedEmissions {
+		return false
+	}
+	if this.Score != that1.Score {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -463,6 +675,8 @@ var _AsNs_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type GsNsClient interface {
 	HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+	// ReportTxAcknowledgment is called by the Gateway Server when a gateway acknowledges the transmission of a downlink message.
+	ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type gsNsClient struct {
@@ -482,9 +696,20 @@ func (c *gsNsClient) HandleUplink(ctx context.Context, in *UplinkMessage, opts .
 	return out, nil
 }
 
+func (c *gsNsClient) ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GsNsServer is the server API for GsNs service.
 type GsNsServer interface {
 	HandleUplink(context.Context, *UplinkMessage) (*types.Empty, error)
+	// ReportTxAcknowledgment is called by the Gateway Server when a gateway acknowledges the transmission of a downlink message.
+	ReportTxAcknowledgment(context.Context, *GatewayTxAcknowledgment) (*types.Empty, error)
 }
 
 // UnimplementedGsNsServer can be embedded to have forward compatible implementations.
@@ -494,6 +719,9 @@ type UnimplementedGsNsServer struct {
 func (*UnimplementedGsNsServer) HandleUplink(ctx context.Context, req *UplinkMessage) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleUplink not implemented")
 }
+func (*UnimplementedGsNsServer) ReportTxAcknowledgment(ctx context.Context, req *GatewayTxAcknowledgment) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportTxAcknowledgment not implemented")
+}
 
 func RegisterGsNsServer(s *grpc.Server, srv GsNsServer) {
 	s.RegisterService(&_GsNs_serviceDesc, srv)
@@ -517,6 +745,24 @@ func _GsNs_HandleUplink_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GsNs_ReportTxAcknowledgment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayTxAcknowledgment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsNsServer).ReportTxAcknowledgment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsNsServer).ReportTxAcknowledgment(ctx, req.(*GatewayTxAcknowledgment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GsNs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.GsNs",
 	HandlerType: (*GsNsServer)(nil),
@@ -525,6 +771,10 @@ var _GsNs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HandleUplink",
 			Handler:    _GsNs_HandleUplink_Handler,
 		},
+		{
+			MethodName: "ReportTxAcknowledgment",
+			Handler:    _GsNs_ReportTxAcknowledgment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/networkserver.proto",
@@ -719,6 +969,99 @@ func (m *GenerateDevAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *DownlinkPathSelection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkPathSelection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownlinkPathSelection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DownlinkPathSelection_Candidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkPathSelection_Candidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownlinkPathSelection_Candidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Score != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Score))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.QueuedEmissions != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.QueuedEmissions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DutyCycleUtilization != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DutyCycleUtilization))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.SNR != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.SNR))))
+		i--
+		dAtA[i] = 0x15
+	}
+	{
+		size, err := m.GatewayIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNetworkserver(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNetworkserver(v)
 	base := offset
@@ -738,6 +1081,42 @@ func NewPopulatedGenerateDevAddrResponse(r randyNetworkserver, easy bool) *Gener
 	return this
 }
 
+func NewPopulatedDownlinkPathSelection(r randyNetworkserver, easy bool) *DownlinkPathSelection {
+	this := &DownlinkPathSelection{}
+	if r.Intn(5) != 0 {
+		v1 := r.Intn(5)
+		this.Candidates = make([]*DownlinkPathSelection_Candidate, v1)
+		for i := 0; i < v1; i++ {
+			this.Candidates[i] = NewPopulatedDownlinkPathSelection_Candidate(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDownlinkPathSelection_Candidate(r randyNetworkserver, easy bool) *DownlinkPathSelection_Candidate {
+	this := &DownlinkPathSelection_Candidate{}
+	v2 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIDs = *v2
+	this.SNR = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.SNR *= -1
+	}
+	this.DutyCycleUtilization = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.DutyCycleUtilization *= -1
+	}
+	this.QueuedEmissions = uint32(r.Uint32())
+	this.Score = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Score *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyNetworkserver interface {
 	Float32() float32
 	Float64() float64
@@ -757,9 +1136,9 @@ func randUTF8RuneNetworkserver(r randyNetworkserver) rune {
 	return rune(ru + 61)
 }
 func randStringNetworkserver(r randyNetworkserver) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
+	v3 := r.Intn(100)
+	tmps := make([]rune, v3)
+	for i := 0; i < v3; i++ {
 		tmps[i] = randUTF8RuneNetworkserver(r)
 	}
 	return string(tmps)
@@ -781,11 +1160,11 @@ func randFieldNetworkserver(dAtA []byte, r randyNetworkserver, fieldNumber int,
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		v2 := r.Int63()
+		v4 := r.Int63()
 		if r.Intn(2) == 0 {
-			v2 *= -1
+			v4 *= -1
 		}
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(v2))
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(v4))
 	case 1:
 		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -823,6 +1202,44 @@ func (m *GenerateDevAddrResponse) Size() (n int) {
 	return n
 }
 
+func (m *DownlinkPathSelection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DownlinkPathSelection_Candidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIDs.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.SNR != 0 {
+		n += 5
+	}
+	if m.DutyCycleUtilization != 0 {
+		n += 5
+	}
+	if m.QueuedEmissions != 0 {
+		n += 1 + sovNetworkserver(uint64(m.QueuedEmissions))
+	}
+	if m.Score != 0 {
+		n += 5
+	}
+	return n
+}
+
 func sovNetworkserver(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -839,6 +1256,35 @@ func (this *GenerateDevAddrResponse) String() string {
 	}, "")
 	return s
 }
+func (this *DownlinkPathSelection) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCandidates := "[]*DownlinkPathSelection_Candidate{"
+	for _, f := range this.Candidates {
+		repeatedStringForCandidates += strings.Replace(fmt.Sprintf("%v", f), "DownlinkPathSelection_Candidate", "DownlinkPathSelection_Candidate", 1) + ","
+	}
+	repeatedStringForCandidates += "}"
+	s := strings.Join([]string{`&DownlinkPathSelection{`,
+		`Candidates:` + repeatedStringForCandidates + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkPathSelection_Candidate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DownlinkPathSelection_Candidate{`,
+		`GatewayIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIDs), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`SNR:` + fmt.Sprintf("%v", this.SNR) + `,`,
+		`DutyCycleUtilization:` + fmt.Sprintf("%v", this.DutyCycleUtilization) + `,`,
+		`QueuedEmissions:` + fmt.Sprintf("%v", this.QueuedEmissions) + `,`,
+		`Score:` + fmt.Sprintf("%v", this.Score) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringNetworkserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -935,6 +1381,231 @@ func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DownlinkPathSelection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DownlinkPathSelection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DownlinkPathSelection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, &DownlinkPathSelection_Candidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DownlinkPathSelection_Candidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNR", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.SNR = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutyCycleUtilization", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.DutyCycleUtilization = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedEmissions", wireType)
+			}
+			m.QueuedEmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedEmissions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Score = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNetworkserver(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
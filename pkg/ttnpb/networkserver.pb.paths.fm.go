@@ -9,3 +9,27 @@ var GenerateDevAddrResponseFieldPathsNested = []string{
 var GenerateDevAddrResponseFieldPathsTopLevel = []string{
 	"dev_addr",
 }
+var DownlinkPathSelectionFieldPathsNested = []string{
+	"candidates",
+}
+
+var DownlinkPathSelectionFieldPathsTopLevel = []string{
+	"candidates",
+}
+var DownlinkPathSelection_CandidateFieldPathsNested = []string{
+	"duty_cycle_utilization",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"queued_emissions",
+	"score",
+	"snr",
+}
+
+var DownlinkPathSelection_CandidateFieldPathsTopLevel = []string{
+	"duty_cycle_utilization",
+	"gateway_ids",
+	"queued_emissions",
+	"score",
+	"snr",
+}
@@ -23,3 +23,92 @@ func (dst *GenerateDevAddrResponse) SetFields(src *GenerateDevAddrResponse, path
 	}
 	return nil
 }
+
+func (dst *DownlinkPathSelection) SetFields(src *DownlinkPathSelection, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "candidates":
+			if len(subs) > 0 {
+				return fmt.Errorf("'candidates' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Candidates = src.Candidates
+			} else {
+				dst.Candidates = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DownlinkPathSelection_Candidate) SetFields(src *DownlinkPathSelection_Candidate, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIDs
+				}
+				newDst = &dst.GatewayIDs
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIDs = src.GatewayIDs
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIDs = zero
+				}
+			}
+		case "snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SNR = src.SNR
+			} else {
+				var zero float32
+				dst.SNR = zero
+			}
+		case "duty_cycle_utilization":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duty_cycle_utilization' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DutyCycleUtilization = src.DutyCycleUtilization
+			} else {
+				var zero float32
+				dst.DutyCycleUtilization = zero
+			}
+		case "queued_emissions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'queued_emissions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.QueuedEmissions = src.QueuedEmissions
+			} else {
+				var zero uint32
+				dst.QueuedEmissions = zero
+			}
+		case "score":
+			if len(subs) > 0 {
+				return fmt.Errorf("'score' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Score = src.Score
+			} else {
+				var zero float32
+				dst.Score = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -117,3 +117,203 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GenerateDevAddrResponseValidationError{}
+
+// ValidateFields checks the field values on DownlinkPathSelection with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DownlinkPathSelection) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownlinkPathSelectionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "candidates":
+
+			for idx, item := range m.GetCandidates() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return DownlinkPathSelectionValidationError{
+							field:  fmt.Sprintf("candidates[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return DownlinkPathSelectionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownlinkPathSelectionValidationError is the validation error returned by
+// DownlinkPathSelection.ValidateFields if the designated constraints aren't met.
+type DownlinkPathSelectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownlinkPathSelectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownlinkPathSelectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownlinkPathSelectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownlinkPathSelectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownlinkPathSelectionValidationError) ErrorName() string {
+	return "DownlinkPathSelectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownlinkPathSelectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownlinkPathSelection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownlinkPathSelectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownlinkPathSelectionValidationError{}
+
+// ValidateFields checks the field values on DownlinkPathSelection_Candidate
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *DownlinkPathSelection_Candidate) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownlinkPathSelection_CandidateFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkPathSelection_CandidateValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "snr":
+			// no validation rules for SNR
+		case "duty_cycle_utilization":
+			// no validation rules for DutyCycleUtilization
+		case "queued_emissions":
+			// no validation rules for QueuedEmissions
+		case "score":
+			// no validation rules for Score
+		default:
+			return DownlinkPathSelection_CandidateValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownlinkPathSelection_CandidateValidationError is the validation error
+// returned by DownlinkPathSelection_Candidate.ValidateFields if the
+// designated constraints aren't met.
+type DownlinkPathSelection_CandidateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownlinkPathSelection_CandidateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownlinkPathSelection_CandidateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownlinkPathSelection_CandidateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownlinkPathSelection_CandidateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownlinkPathSelection_CandidateValidationError) ErrorName() string {
+	return "DownlinkPathSelection_CandidateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownlinkPathSelection_CandidateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownlinkPathSelection_Candidate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownlinkPathSelection_CandidateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownlinkPathSelection_CandidateValidationError{}
@@ -42,8 +42,12 @@ type Organization struct {
 	Description             string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Attributes              map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	ContactInfo             []*ContactInfo    `protobuf:"bytes,7,rep,name=contact_info,json=contactInfo,proto3" json:"contact_info,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}          `json:"-"`
-	XXX_sizecache           int32             `json:"-"`
+	// Require members of the organization to enable two-factor authentication.
+	// Members that did not enable two-factor authentication only have rights to set it up.
+	// This field can only be modified by admins.
+	RequireMFA           bool     `protobuf:"varint,8,opt,name=require_mfa,json=requireMfa,proto3" json:"require_mfa,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Organization) Reset()      { *m = Organization{} }
@@ -120,6 +124,13 @@ func (m *Organization) GetContactInfo() []*ContactInfo {
 	return nil
 }
 
+func (m *Organization) GetRequireMFA() bool {
+	if m != nil {
+		return m.RequireMFA
+	}
+	return false
+}
+
 type Organizations struct {
 	Organizations        []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -494,10 +505,12 @@ func (m *GetOrganizationAPIKeyRequest) GetKeyID() string {
 
 type CreateOrganizationAPIKeyRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
-	Name                    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights                  []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	Name                    string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights                  []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt               *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedCIDRs            []string   `protobuf:"bytes,5,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}   `json:"-"`
+	XXX_sizecache           int32      `json:"-"`
 }
 
 func (m *CreateOrganizationAPIKeyRequest) Reset()      { *m = CreateOrganizationAPIKeyRequest{} }
@@ -546,11 +559,28 @@ func (m *CreateOrganizationAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateOrganizationAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateOrganizationAPIKeyRequest) GetAllowedCIDRs() []string {
+	if m != nil {
+		return m.AllowedCIDRs
+	}
+	return nil
+}
+
 type UpdateOrganizationAPIKeyRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
 	APIKey                  `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	// The names of the API key fields that should be updated.
+	// If empty, only the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateOrganizationAPIKeyRequest) Reset()      { *m = UpdateOrganizationAPIKeyRequest{} }
@@ -585,6 +615,13 @@ func (m *UpdateOrganizationAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateOrganizationAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateOrganizationAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type ListOrganizationCollaboratorsRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
 	// Limit the number of results per page.
@@ -767,76 +804,83 @@ func init() {
 }
 
 var fileDescriptor_312da2e2e650bd3b = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4b, 0x6c, 0x1b, 0x45,
-	0x18, 0xde, 0xf1, 0x23, 0xa9, 0x27, 0x49, 0x13, 0xad, 0xa0, 0xda, 0xa6, 0xd1, 0xd8, 0x5a, 0x22,
-	0x48, 0xab, 0x78, 0x8d, 0xdc, 0x0b, 0x54, 0x40, 0xe4, 0x0d, 0x0f, 0x45, 0xa1, 0xb4, 0x6c, 0xe9,
-	0x85, 0xaa, 0x58, 0x63, 0xef, 0x78, 0x33, 0xb2, 0xbd, 0xbb, 0xcc, 0x8e, 0x53, 0x1c, 0x84, 0x54,
-	0x71, 0xaa, 0x38, 0x55, 0x9c, 0x10, 0x27, 0xc4, 0x01, 0x95, 0x5b, 0x8e, 0x15, 0x17, 0x72, 0x8c,
-	0x7a, 0xca, 0xb1, 0xe2, 0x10, 0xea, 0xf5, 0x25, 0x37, 0x7a, 0xac, 0x7c, 0x42, 0xfb, 0x70, 0xb3,
-	0xbb, 0x36, 0x96, 0xa0, 0x55, 0x4a, 0x4f, 0x9e, 0xc7, 0x37, 0xff, 0xff, 0x7f, 0x33, 0xdf, 0x37,
-	0xb3, 0x86, 0xcb, 0x2d, 0x8b, 0xe1, 0x5b, 0xd8, 0x2c, 0x3a, 0x1c, 0xd7, 0x9b, 0x25, 0x6c, 0xd3,
-	0x92, 0xc5, 0x0c, 0x6c, 0xd2, 0x1d, 0xcc, 0xa9, 0x65, 0x2a, 0x36, 0xb3, 0xb8, 0x25, 0x9e, 0xe6,
-	0xdc, 0x54, 0x42, 0xa4, 0xb2, 0x7d, 0x71, 0xb1, 0x62, 0x50, 0xbe, 0xd5, 0xa9, 0x29, 0x75, 0xab,
-	0x5d, 0x22, 0xe6, 0xb6, 0xd5, 0xb5, 0x99, 0xf5, 0x55, 0xb7, 0xe4, 0x83, 0xeb, 0x45, 0x83, 0x98,
-	0xc5, 0x6d, 0xdc, 0xa2, 0x3a, 0xe6, 0xa4, 0x34, 0xd2, 0x08, 0x42, 0x2e, 0x16, 0x23, 0x21, 0x0c,
-	0xcb, 0xb0, 0x82, 0xc5, 0xb5, 0x4e, 0xc3, 0xef, 0xf9, 0x1d, 0xbf, 0x15, 0xc2, 0x0b, 0x86, 0x65,
-	0x19, 0x2d, 0x72, 0x8c, 0x6a, 0x50, 0xd2, 0xd2, 0xab, 0x6d, 0xec, 0x34, 0x43, 0x44, 0x3e, 0x89,
-	0xe0, 0xb4, 0x4d, 0x1c, 0x8e, 0xdb, 0x76, 0x08, 0x18, 0x43, 0xb5, 0x6e, 0x99, 0x1c, 0xd7, 0x79,
-	0x95, 0x9a, 0x8d, 0x61, 0xa2, 0xd7, 0x46, 0x51, 0x54, 0x27, 0x26, 0xa7, 0x0d, 0x4a, 0x98, 0x13,
-	0x82, 0xd0, 0x28, 0x88, 0x51, 0x63, 0x8b, 0x87, 0xf3, 0xf2, 0xaf, 0x19, 0x38, 0x7b, 0x25, 0xb2,
-	0x8d, 0xe2, 0x26, 0x4c, 0x53, 0xdd, 0x91, 0x40, 0x01, 0xac, 0xcc, 0x94, 0xdf, 0x50, 0xe2, 0xdb,
-	0xa9, 0x44, 0xa1, 0x1b, 0xc7, 0xc9, 0xd4, 0x85, 0x81, 0x9a, 0xfd, 0x0e, 0xa4, 0x16, 0xc0, 0xfe,
-	0x61, 0x5e, 0x38, 0x38, 0xcc, 0x03, 0xcd, 0x8b, 0x22, 0xae, 0x43, 0x58, 0x67, 0x04, 0x73, 0xa2,
-	0x57, 0x31, 0x97, 0x52, 0x7e, 0xcc, 0x45, 0x25, 0xa0, 0xaf, 0x0c, 0xe9, 0x2b, 0x9f, 0x0d, 0xe9,
-	0xab, 0xa7, 0xbc, 0xe5, 0x77, 0xff, 0xcc, 0x03, 0x2d, 0x17, 0xae, 0xab, 0x70, 0x2f, 0x48, 0xc7,
-	0xd6, 0x87, 0x41, 0xd2, 0xff, 0x26, 0x48, 0xb8, 0xae, 0xc2, 0xc5, 0x73, 0x30, 0x63, 0xe2, 0x36,
-	0x91, 0x32, 0x05, 0xb0, 0x92, 0x53, 0xa7, 0x07, 0x6a, 0x86, 0xa5, 0xa4, 0xb2, 0xe6, 0x0f, 0x8a,
-	0x17, 0xe0, 0x8c, 0x4e, 0x9c, 0x3a, 0xa3, 0xb6, 0xc7, 0x4b, 0xca, 0xfa, 0x98, 0x53, 0x03, 0x35,
-	0xcb, 0xd2, 0xd2, 0xc1, 0xbc, 0x16, 0x9d, 0x14, 0x77, 0x20, 0xc4, 0x9c, 0x33, 0x5a, 0xeb, 0x70,
-	0xe2, 0x48, 0x53, 0x85, 0xf4, 0xca, 0x4c, 0x79, 0x75, 0xd2, 0x36, 0x29, 0x95, 0xa7, 0xf0, 0x0f,
-	0x4c, 0xce, 0xba, 0xea, 0xea, 0x40, 0x3d, 0xff, 0x23, 0x78, 0x5d, 0x5e, 0x66, 0xb2, 0xb4, 0x5c,
-	0x46, 0x5f, 0xdc, 0xc0, 0xc5, 0x9d, 0x37, 0x8b, 0x6f, 0xdf, 0x5c, 0x59, 0xbb, 0x74, 0xa3, 0x78,
-	0x73, 0x6d, 0xd8, 0x3d, 0xff, 0x75, 0x79, 0xf5, 0x9b, 0x65, 0x2d, 0x92, 0x4d, 0x7c, 0x0f, 0xce,
-	0x46, 0x75, 0x20, 0x4d, 0xfb, 0xd9, 0xcf, 0x25, 0xb3, 0xaf, 0x07, 0x98, 0x0d, 0xb3, 0x61, 0x69,
-	0x33, 0xf5, 0xe3, 0xce, 0xe2, 0xbb, 0x70, 0x3e, 0x51, 0x8c, 0xb8, 0x00, 0xd3, 0x4d, 0xd2, 0xf5,
-	0x8f, 0x3b, 0xa7, 0x79, 0x4d, 0xf1, 0x15, 0x98, 0xdd, 0xc6, 0xad, 0x0e, 0xf1, 0x8f, 0x2b, 0xa7,
-	0x05, 0x9d, 0x4b, 0xa9, 0xb7, 0x80, 0x7c, 0x0d, 0xce, 0x45, 0x89, 0x39, 0xa2, 0x0a, 0xe7, 0xa2,
-	0x16, 0xf4, 0x54, 0xe3, 0x15, 0xb4, 0x34, 0x69, 0x3b, 0xb4, 0xf8, 0x12, 0xf9, 0x77, 0x00, 0xcf,
-	0x7c, 0x44, 0x78, 0x0c, 0x42, 0xbe, 0xec, 0x10, 0x87, 0x8b, 0x3a, 0x5c, 0x88, 0x62, 0xab, 0xcf,
-	0x45, 0x97, 0xf3, 0x56, 0x0c, 0xea, 0x88, 0x6b, 0x10, 0x1e, 0x3b, 0xf4, 0x1f, 0x35, 0xfa, 0xa1,
-	0x07, 0xb9, 0x8c, 0x9d, 0xa6, 0x9a, 0xf1, 0x42, 0x69, 0xb9, 0xc6, 0x70, 0x40, 0x7e, 0x90, 0x82,
-	0xd2, 0xc7, 0xd4, 0x89, 0x51, 0x70, 0x86, 0x1c, 0x3e, 0xf5, 0x8e, 0xac, 0xd5, 0xc2, 0x35, 0x8b,
-	0x61, 0x6e, 0xb1, 0xb0, 0xfe, 0xe2, 0xa4, 0xfa, 0xaf, 0xb0, 0xeb, 0x0e, 0x61, 0x11, 0x16, 0x5a,
-	0x2c, 0xc4, 0x33, 0x17, 0x2c, 0x36, 0x60, 0xd6, 0x62, 0x3a, 0x61, 0xbe, 0x97, 0x72, 0xea, 0xd5,
-	0x81, 0x7a, 0x99, 0x6d, 0x6a, 0x42, 0x7c, 0x6b, 0xaa, 0x54, 0xd7, 0x16, 0x8a, 0xc9, 0x11, 0xdf,
-	0x2f, 0x5a, 0xb6, 0xe8, 0xff, 0x44, 0xbc, 0xad, 0xcd, 0x14, 0x23, 0x9d, 0x20, 0xbc, 0x88, 0x60,
-	0xb6, 0x45, 0xdb, 0x94, 0xfb, 0xa6, 0x9b, 0xf3, 0x0d, 0x75, 0x21, 0x2d, 0x1d, 0x4d, 0x6b, 0xc1,
-	0xb0, 0x28, 0xc2, 0x8c, 0x8d, 0x0d, 0xe2, 0xfb, 0x6d, 0x4e, 0xf3, 0xdb, 0xf2, 0x01, 0x80, 0x67,
-	0xd7, 0xfd, 0x48, 0xe3, 0x14, 0xa1, 0xc1, 0xd9, 0x68, 0x45, 0xe1, 0x6e, 0x4e, 0xd4, 0xdb, 0x18,
-	0x09, 0xc4, 0x62, 0x88, 0xd5, 0xc4, 0x09, 0xa5, 0xfe, 0xc3, 0x09, 0xa9, 0xb3, 0xd1, 0x24, 0xf1,
-	0xf3, 0x92, 0x77, 0x01, 0x3c, 0x7b, 0xdd, 0xbf, 0x88, 0x4e, 0x8a, 0xd2, 0x33, 0x4b, 0xfa, 0x37,
-	0x00, 0x51, 0x52, 0xd2, 0x95, 0xab, 0x1b, 0x9b, 0xa4, 0xeb, 0x9c, 0xac, 0x39, 0x9f, 0x4a, 0x28,
-	0x35, 0x59, 0x42, 0xe9, 0x88, 0x84, 0x7e, 0x01, 0x70, 0x29, 0x71, 0xa3, 0x04, 0xb5, 0x9f, 0x6c,
-	0xe9, 0x05, 0x38, 0xd5, 0x24, 0xdd, 0x2a, 0xd5, 0x83, 0x8b, 0x54, 0xcd, 0xb9, 0x87, 0xf9, 0xec,
-	0x26, 0xe9, 0x6e, 0xbc, 0xaf, 0x65, 0x9b, 0xa4, 0xbb, 0xa1, 0xcb, 0x2e, 0x80, 0xf9, 0x51, 0xad,
-	0xbf, 0x88, 0x5a, 0x87, 0xaf, 0x63, 0x6a, 0xdc, 0xeb, 0xf8, 0x0e, 0x9c, 0x0a, 0x3e, 0x19, 0xa4,
-	0x74, 0x21, 0xbd, 0x72, 0xba, 0xfc, 0x6a, 0x32, 0xb1, 0xe6, 0xcd, 0xaa, 0x73, 0x03, 0x15, 0x7e,
-	0x0f, 0xa6, 0xe5, 0xec, 0xb7, 0x5e, 0x2e, 0x2d, 0x5c, 0x23, 0x3f, 0x00, 0x30, 0x3f, 0xaa, 0xfe,
-	0x17, 0x41, 0xb2, 0x02, 0xa7, 0xb1, 0x4d, 0xab, 0xde, 0x73, 0x17, 0x58, 0xe2, 0x4c, 0x32, 0x78,
-	0x50, 0xd5, 0x98, 0x58, 0x53, 0xd8, 0xa6, 0x9b, 0xa4, 0x2b, 0xef, 0x01, 0xb8, 0x9c, 0xf4, 0xc5,
-	0x7a, 0xc4, 0xeb, 0x2f, 0x81, 0x3b, 0xfe, 0x02, 0x50, 0x4e, 0xb8, 0x23, 0xca, 0xe0, 0x64, 0x09,
-	0xd4, 0x9f, 0xc7, 0xdd, 0x3b, 0xe6, 0x36, 0x8c, 0xdd, 0xbf, 0x7f, 0x00, 0x28, 0x5f, 0xfb, 0xbf,
-	0x30, 0xfe, 0x64, 0x2c, 0xe3, 0xa5, 0xd1, 0x4f, 0xb8, 0x63, 0xcc, 0xa4, 0xc7, 0x45, 0xfd, 0x19,
-	0xec, 0xf7, 0x10, 0x38, 0xe8, 0x21, 0xf0, 0xb0, 0x87, 0x84, 0x47, 0x3d, 0x24, 0x1c, 0xf5, 0x90,
-	0xf0, 0xb8, 0x87, 0x84, 0x27, 0x3d, 0x04, 0x6e, 0xbb, 0x08, 0xdc, 0x71, 0x91, 0x70, 0xcf, 0x45,
-	0x60, 0xd7, 0x45, 0xc2, 0x7d, 0x17, 0x09, 0x7b, 0x2e, 0x12, 0xf6, 0x5d, 0x04, 0x0e, 0x5c, 0x04,
-	0x1e, 0xba, 0x48, 0x78, 0xe4, 0x22, 0x70, 0xe4, 0x22, 0xe1, 0xb1, 0x8b, 0xc0, 0x13, 0x17, 0x09,
-	0xb7, 0xfb, 0x48, 0xb8, 0xd3, 0x47, 0xe0, 0x6e, 0x1f, 0x09, 0x3f, 0xf4, 0x11, 0xf8, 0xa9, 0x8f,
-	0x84, 0x7b, 0x7d, 0x24, 0xec, 0xf6, 0x11, 0xb8, 0xdf, 0x47, 0x60, 0xaf, 0x8f, 0xc0, 0xe7, 0xab,
-	0x86, 0xa5, 0xf0, 0x2d, 0xc2, 0xb7, 0xa8, 0x69, 0x38, 0x8a, 0x49, 0xf8, 0x2d, 0x8b, 0x35, 0x4b,
-	0xf1, 0xff, 0x1a, 0x76, 0xd3, 0x28, 0x71, 0x6e, 0xda, 0xb5, 0xda, 0x94, 0xff, 0xe6, 0x5c, 0xfc,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x46, 0xfc, 0x6f, 0xc3, 0x0d, 0x00, 0x00,
+	// 1204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4b, 0x6c, 0x13, 0x47,
+	0x18, 0xde, 0xf1, 0x23, 0x89, 0xc7, 0x09, 0x58, 0xab, 0x16, 0x2d, 0x01, 0x8d, 0xad, 0x6d, 0xd4,
+	0x1a, 0x1a, 0xaf, 0xab, 0x70, 0x69, 0x51, 0x5b, 0xe4, 0x0d, 0x05, 0x45, 0x69, 0x0a, 0x1d, 0xca,
+	0xa5, 0x88, 0x5a, 0x13, 0xef, 0x78, 0x33, 0xb2, 0xbd, 0xbb, 0xcc, 0x8e, 0x03, 0xa6, 0xaa, 0x84,
+	0x7a, 0x42, 0xed, 0x05, 0x71, 0xaa, 0x7a, 0xaa, 0x7a, 0xa8, 0x38, 0x72, 0x44, 0xbd, 0x94, 0x63,
+	0xd4, 0x53, 0x8e, 0x88, 0x43, 0x8a, 0xd7, 0x97, 0xdc, 0xca, 0x11, 0xf9, 0x54, 0xed, 0xc3, 0x64,
+	0xed, 0x18, 0x4b, 0x14, 0x14, 0xda, 0x93, 0x77, 0x66, 0xbf, 0xff, 0x39, 0xdf, 0x37, 0xff, 0x1a,
+	0x2e, 0x34, 0x6d, 0x4e, 0xae, 0x13, 0xab, 0xe4, 0x0a, 0x52, 0x6b, 0x94, 0x89, 0xc3, 0xca, 0x36,
+	0x37, 0x89, 0xc5, 0x6e, 0x12, 0xc1, 0x6c, 0x4b, 0x73, 0xb8, 0x2d, 0x6c, 0xf9, 0x90, 0x10, 0x96,
+	0x16, 0x21, 0xb5, 0xcd, 0x53, 0xf3, 0x15, 0x93, 0x89, 0x8d, 0xf6, 0xba, 0x56, 0xb3, 0x5b, 0x65,
+	0x6a, 0x6d, 0xda, 0x1d, 0x87, 0xdb, 0x37, 0x3a, 0xe5, 0x00, 0x5c, 0x2b, 0x99, 0xd4, 0x2a, 0x6d,
+	0x92, 0x26, 0x33, 0x88, 0xa0, 0xe5, 0x7d, 0x0f, 0xa1, 0xcb, 0xf9, 0x52, 0xcc, 0x85, 0x69, 0x9b,
+	0x76, 0x68, 0xbc, 0xde, 0xae, 0x07, 0xab, 0x60, 0x11, 0x3c, 0x45, 0xf0, 0x82, 0x69, 0xdb, 0x66,
+	0x93, 0xee, 0xa1, 0xea, 0x8c, 0x36, 0x8d, 0x6a, 0x8b, 0xb8, 0x8d, 0x08, 0x91, 0x1f, 0x45, 0x08,
+	0xd6, 0xa2, 0xae, 0x20, 0x2d, 0x27, 0x02, 0x8c, 0x29, 0xb5, 0x66, 0x5b, 0x82, 0xd4, 0x44, 0x95,
+	0x59, 0xf5, 0x41, 0xa0, 0x77, 0xf6, 0xa3, 0x98, 0x41, 0x2d, 0xc1, 0xea, 0x8c, 0x72, 0x37, 0x02,
+	0xa1, 0xfd, 0x20, 0xce, 0xcc, 0x0d, 0x11, 0xbd, 0x57, 0x1f, 0xa7, 0xe0, 0xec, 0x85, 0x58, 0x1b,
+	0xe5, 0x55, 0x98, 0x64, 0x86, 0xab, 0x80, 0x02, 0x28, 0x66, 0x97, 0xde, 0xd3, 0x86, 0xdb, 0xa9,
+	0xc5, 0xa1, 0x2b, 0x7b, 0xc1, 0xf4, 0x5c, 0x5f, 0x4f, 0xff, 0x00, 0x12, 0x39, 0xb0, 0xb5, 0x93,
+	0x97, 0xb6, 0x77, 0xf2, 0x00, 0xfb, 0x5e, 0xe4, 0x65, 0x08, 0x6b, 0x9c, 0x12, 0x41, 0x8d, 0x2a,
+	0x11, 0x4a, 0x22, 0xf0, 0x39, 0xaf, 0x85, 0xe5, 0x6b, 0x83, 0xf2, 0xb5, 0xaf, 0x06, 0xe5, 0xeb,
+	0x33, 0xbe, 0xf9, 0x9d, 0xbf, 0xf2, 0x00, 0x67, 0x22, 0xbb, 0x8a, 0xf0, 0x9d, 0xb4, 0x1d, 0x63,
+	0xe0, 0x24, 0xf9, 0x32, 0x4e, 0x22, 0xbb, 0x8a, 0x90, 0x8f, 0xc1, 0x94, 0x45, 0x5a, 0x54, 0x49,
+	0x15, 0x40, 0x31, 0xa3, 0x4f, 0xf7, 0xf5, 0x14, 0x4f, 0x28, 0x4b, 0x38, 0xd8, 0x94, 0x4f, 0xc2,
+	0xac, 0x41, 0xdd, 0x1a, 0x67, 0x8e, 0x5f, 0x97, 0x92, 0x0e, 0x30, 0x33, 0x7d, 0x3d, 0xcd, 0x93,
+	0xca, 0xf6, 0x61, 0x1c, 0x7f, 0x29, 0xdf, 0x84, 0x90, 0x08, 0xc1, 0xd9, 0x7a, 0x5b, 0x50, 0x57,
+	0x99, 0x2a, 0x24, 0x8b, 0xd9, 0xa5, 0xc5, 0x49, 0x6d, 0xd2, 0x2a, 0xcf, 0xe1, 0x9f, 0x59, 0x82,
+	0x77, 0xf4, 0xc5, 0xbe, 0x7e, 0xe2, 0x67, 0xf0, 0xae, 0xba, 0xc0, 0x55, 0x65, 0x61, 0x09, 0x7d,
+	0x73, 0x85, 0x94, 0x6e, 0x7e, 0x50, 0xfa, 0xe8, 0x6a, 0xf1, 0xcc, 0xe9, 0x2b, 0xa5, 0xab, 0x67,
+	0x06, 0xcb, 0x13, 0xdf, 0x2e, 0x2d, 0x7e, 0xb7, 0x80, 0x63, 0xd1, 0xe4, 0x4f, 0xe1, 0x6c, 0x9c,
+	0x07, 0xca, 0x74, 0x10, 0xfd, 0xd8, 0x68, 0xf4, 0xe5, 0x10, 0xb3, 0x62, 0xd5, 0x6d, 0x9c, 0xad,
+	0xed, 0x2d, 0xe4, 0x32, 0xcc, 0x72, 0x7a, 0xad, 0xcd, 0x38, 0xad, 0xb6, 0xea, 0x44, 0x99, 0x29,
+	0x80, 0xe2, 0x8c, 0x7e, 0xc8, 0xdb, 0xc9, 0x43, 0x1c, 0x6e, 0xaf, 0x9d, 0xab, 0x60, 0x18, 0x41,
+	0xd6, 0xea, 0x64, 0xfe, 0x13, 0x78, 0x78, 0x24, 0x7b, 0x39, 0x07, 0x93, 0x0d, 0xda, 0x09, 0xf8,
+	0x91, 0xc1, 0xfe, 0xa3, 0xfc, 0x16, 0x4c, 0x6f, 0x92, 0x66, 0x9b, 0x06, 0xe7, 0x9b, 0xc1, 0xe1,
+	0xe2, 0x74, 0xe2, 0x43, 0xa0, 0x5e, 0x82, 0x73, 0xf1, 0x4e, 0xb8, 0xb2, 0x0e, 0xe7, 0xe2, 0x9a,
+	0xf5, 0x69, 0xe6, 0x57, 0x70, 0x7c, 0x52, 0xff, 0xf0, 0xb0, 0x89, 0xfa, 0x07, 0x80, 0x47, 0xce,
+	0x53, 0x31, 0x04, 0xa1, 0xd7, 0xda, 0xd4, 0x15, 0xb2, 0x01, 0x73, 0x71, 0x6c, 0xf5, 0xb5, 0x10,
+	0xf9, 0xb0, 0x3d, 0x04, 0x75, 0xe5, 0x33, 0x10, 0xee, 0x49, 0xfa, 0x85, 0xa4, 0x3e, 0xe7, 0x43,
+	0xd6, 0x88, 0xdb, 0xd0, 0x53, 0xbe, 0x2b, 0x9c, 0xa9, 0x0f, 0x36, 0xd4, 0x3f, 0x13, 0x50, 0xf9,
+	0x9c, 0xb9, 0x43, 0x25, 0xb8, 0x83, 0x1a, 0xbe, 0xf4, 0xcf, 0xb8, 0xd9, 0x24, 0xeb, 0x36, 0x27,
+	0xc2, 0xe6, 0x51, 0xfe, 0xa5, 0x49, 0xf9, 0x5f, 0xe0, 0x97, 0x5d, 0xca, 0x63, 0x55, 0xe0, 0x21,
+	0x17, 0xaf, 0x9c, 0xb0, 0x5c, 0x87, 0x69, 0x9b, 0x1b, 0x94, 0x07, 0xe2, 0xcb, 0xe8, 0x17, 0xfb,
+	0xfa, 0x1a, 0x5f, 0xc5, 0xd2, 0x70, 0x6b, 0xaa, 0xcc, 0xc0, 0xb9, 0xd2, 0xe8, 0x4e, 0x20, 0x30,
+	0x9c, 0x2e, 0x05, 0x3f, 0xb1, 0xcb, 0x00, 0x67, 0x4b, 0xb1, 0x45, 0xe8, 0x5e, 0x46, 0x30, 0xdd,
+	0x64, 0x2d, 0x26, 0x02, 0x95, 0xce, 0x05, 0x0a, 0x3c, 0x99, 0x54, 0x76, 0xa7, 0x71, 0xb8, 0x2d,
+	0xcb, 0x30, 0xe5, 0x10, 0x93, 0x06, 0x02, 0x9d, 0xc3, 0xc1, 0xb3, 0xba, 0x0d, 0xe0, 0xd1, 0xe5,
+	0xc0, 0xd3, 0x38, 0x46, 0x60, 0x38, 0x1b, 0xcf, 0x28, 0xea, 0xe6, 0x44, 0xbe, 0x8d, 0xa1, 0xc0,
+	0x90, 0x0f, 0xb9, 0x3a, 0x72, 0x42, 0x89, 0x7f, 0x71, 0x42, 0xfa, 0x6c, 0x3c, 0xc8, 0xf0, 0x79,
+	0xa9, 0xf7, 0x01, 0x3c, 0x7a, 0x39, 0xb8, 0xb9, 0x0e, 0xaa, 0xa4, 0x57, 0xa6, 0xf4, 0xef, 0x00,
+	0xa2, 0x51, 0x4a, 0x57, 0x2e, 0xae, 0xac, 0xd2, 0x8e, 0x7b, 0xb0, 0xe2, 0x7c, 0x4e, 0xa1, 0xc4,
+	0x64, 0x0a, 0x25, 0x63, 0x14, 0xfa, 0x0d, 0xc0, 0xe3, 0xe7, 0xe9, 0x98, 0xdc, 0x0f, 0x36, 0xf5,
+	0x02, 0x9c, 0x6a, 0xd0, 0x4e, 0x95, 0x19, 0xe1, 0x45, 0xaa, 0x67, 0xbc, 0x9d, 0x7c, 0x7a, 0x95,
+	0x76, 0x56, 0xce, 0xe2, 0x74, 0x83, 0x76, 0x56, 0x0c, 0x75, 0x37, 0x01, 0xf3, 0xfb, 0xb9, 0xfe,
+	0x26, 0x72, 0x1d, 0x8c, 0xd3, 0xc4, 0xb8, 0x71, 0xfa, 0x31, 0x9c, 0x0a, 0xbf, 0x31, 0x94, 0x64,
+	0x21, 0x59, 0x3c, 0xb4, 0xf4, 0xf6, 0x68, 0x60, 0xec, 0xbf, 0xd5, 0xe7, 0xfa, 0x3a, 0xbc, 0x0b,
+	0xa6, 0xd5, 0xf4, 0xf7, 0x7e, 0x2c, 0x1c, 0xd9, 0xf8, 0x5c, 0xa4, 0x37, 0x1c, 0xc6, 0xa9, 0x5b,
+	0x25, 0xe1, 0x4d, 0x30, 0x79, 0xdc, 0xa7, 0xc2, 0x51, 0x1f, 0xd9, 0x04, 0xdf, 0x0b, 0x73, 0xa4,
+	0xd9, 0xb4, 0xaf, 0x53, 0xa3, 0x5a, 0x63, 0x06, 0x77, 0x95, 0x74, 0x21, 0x59, 0xcc, 0xe8, 0xa8,
+	0xaf, 0x67, 0xef, 0x82, 0x99, 0x5c, 0x4e, 0xf5, 0x73, 0x7d, 0xdf, 0xdb, 0xc9, 0xcf, 0x56, 0x42,
+	0xd8, 0xf2, 0xca, 0x59, 0xec, 0xe2, 0xd9, 0xc8, 0x68, 0xd9, 0xb7, 0x51, 0x7f, 0x4c, 0xc0, 0xfc,
+	0x7e, 0x0d, 0xbe, 0x89, 0x56, 0x57, 0xe0, 0x34, 0x71, 0x58, 0xd5, 0x1f, 0xba, 0xa1, 0x30, 0x8f,
+	0x8c, 0x3a, 0x0f, 0xb3, 0x1a, 0xe3, 0x6b, 0x8a, 0x38, 0x6c, 0x95, 0x76, 0x46, 0xe4, 0x9d, 0x7c,
+	0x79, 0x79, 0x3f, 0x04, 0x70, 0x61, 0x54, 0xde, 0xcb, 0xb1, 0x2b, 0xeb, 0x7f, 0x20, 0xf2, 0xbf,
+	0x01, 0x54, 0xcf, 0xd3, 0x17, 0x56, 0x70, 0xb0, 0x05, 0xd4, 0x5e, 0xc7, 0x08, 0x19, 0x73, 0xa9,
+	0x0f, 0x8d, 0x91, 0xc7, 0x00, 0xaa, 0x97, 0xfe, 0x2b, 0x15, 0x7f, 0x31, 0xb6, 0xe2, 0xe3, 0xfb,
+	0x3f, 0x5d, 0xf7, 0x30, 0x93, 0x66, 0xa4, 0xfe, 0x2b, 0xd8, 0xea, 0x22, 0xb0, 0xdd, 0x45, 0xe0,
+	0x51, 0x17, 0x49, 0x4f, 0xba, 0x48, 0xda, 0xed, 0x22, 0xe9, 0x69, 0x17, 0x49, 0xcf, 0xba, 0x08,
+	0xdc, 0xf2, 0x10, 0xb8, 0xed, 0x21, 0xe9, 0x9e, 0x87, 0xc0, 0x7d, 0x0f, 0x49, 0x0f, 0x3c, 0x24,
+	0x3d, 0xf4, 0x90, 0xb4, 0xe5, 0x21, 0xb0, 0xed, 0x21, 0xf0, 0xc8, 0x43, 0xd2, 0x13, 0x0f, 0x81,
+	0x5d, 0x0f, 0x49, 0x4f, 0x3d, 0x04, 0x9e, 0x79, 0x48, 0xba, 0xd5, 0x43, 0xd2, 0xed, 0x1e, 0x02,
+	0x77, 0x7a, 0x48, 0xfa, 0xa9, 0x87, 0xc0, 0x2f, 0x3d, 0x24, 0xdd, 0xeb, 0x21, 0xe9, 0x7e, 0x0f,
+	0x81, 0x07, 0x3d, 0x04, 0x1e, 0xf6, 0x10, 0xf8, 0x7a, 0xd1, 0xb4, 0x35, 0xb1, 0x41, 0xc5, 0x06,
+	0xb3, 0x4c, 0x57, 0xb3, 0xa8, 0xb8, 0x6e, 0xf3, 0x46, 0x79, 0xf8, 0x3f, 0x96, 0xd3, 0x30, 0xcb,
+	0x42, 0x58, 0xce, 0xfa, 0xfa, 0x54, 0xa0, 0xad, 0x53, 0xff, 0x0c, 0x00, 0x10, 0xf8, 0x47, 0xc5,
+	0xbb, 0x0e, 0x00, 0x00,
 }
 
 func (this *Organization) Equal(that interface{}) bool {
@@ -889,6 +933,9 @@ func (this *Organization) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.RequireMFA != that1.RequireMFA {
+		return false
+	}
 	return true
 }
 func (this *Organizations) Equal(that interface{}) bool {
@@ -1127,6 +1174,21 @@ func (this *CreateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
@@ -1154,6 +1216,9 @@ func (this *UpdateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *ListOrganizationCollaboratorsRequest) Equal(that interface{}) bool {
@@ -1260,6 +1325,16 @@ func (m *Organization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RequireMFA {
+		i--
+		if m.RequireMFA {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ContactInfo) > 0 {
 		for iNdEx := len(m.ContactInfo) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1667,21 +1742,40 @@ func (m *CreateOrganizationAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedCIDRs) > 0 {
+		for iNdEx := len(m.AllowedCIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCIDRs[iNdEx])
+			copy(dAtA[i:], m.AllowedCIDRs[iNdEx])
+			i = encodeVarintOrganization(dAtA, i, uint64(len(m.AllowedCIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpiresAt != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintOrganization(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Rights) > 0 {
-		dAtA15 := make([]byte, len(m.Rights)*10)
-		var j14 int
+		dAtA16 := make([]byte, len(m.Rights)*10)
+		var j15 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j15++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintOrganization(dAtA, i, uint64(j14))
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintOrganization(dAtA, i, uint64(j15))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1725,6 +1819,16 @@ func (m *UpdateOrganizationAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrganization(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1912,6 +2016,7 @@ func NewPopulatedOrganization(r randyOrganization, easy bool) *Organization {
 			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
 		}
 	}
+	this.RequireMFA = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2010,6 +2115,14 @@ func NewPopulatedCreateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 	for i := 0; i < v17; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v18 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v18)
+	for i := 0; i < v18; i++ {
+		this.AllowedCIDRs[i] = randStringOrganization(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2017,10 +2130,12 @@ func NewPopulatedCreateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 
 func NewPopulatedUpdateOrganizationAPIKeyRequest(r randyOrganization, easy bool) *UpdateOrganizationAPIKeyRequest {
 	this := &UpdateOrganizationAPIKeyRequest{}
-	v18 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v18
-	v19 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v19
+	v19 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v19
+	v20 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2028,8 +2143,8 @@ func NewPopulatedUpdateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 
 func NewPopulatedListOrganizationCollaboratorsRequest(r randyOrganization, easy bool) *ListOrganizationCollaboratorsRequest {
 	this := &ListOrganizationCollaboratorsRequest{}
-	v20 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v20
+	v22 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v22
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -2039,10 +2154,10 @@ func NewPopulatedListOrganizationCollaboratorsRequest(r randyOrganization, easy
 
 func NewPopulatedGetOrganizationCollaboratorRequest(r randyOrganization, easy bool) *GetOrganizationCollaboratorRequest {
 	this := &GetOrganizationCollaboratorRequest{}
-	v21 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v21
-	v22 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v22
+	v23 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v23
+	v24 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2050,10 +2165,10 @@ func NewPopulatedGetOrganizationCollaboratorRequest(r randyOrganization, easy bo
 
 func NewPopulatedSetOrganizationCollaboratorRequest(r randyOrganization, easy bool) *SetOrganizationCollaboratorRequest {
 	this := &SetOrganizationCollaboratorRequest{}
-	v23 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v23
-	v24 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v24
+	v25 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v25
+	v26 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2078,9 +2193,9 @@ func randUTF8RuneOrganization(r randyOrganization) rune {
 	return rune(ru + 61)
 }
 func randStringOrganization(r randyOrganization) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v27 := r.Intn(100)
+	tmps := make([]rune, v27)
+	for i := 0; i < v27; i++ {
 		tmps[i] = randUTF8RuneOrganization(r)
 	}
 	return string(tmps)
@@ -2102,11 +2217,11 @@ func randFieldOrganization(dAtA []byte, r randyOrganization, fieldNumber int, wi
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(key))
-		v26 := r.Int63()
+		v28 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v28 *= -1
 		}
-		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(v28))
 	case 1:
 		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2165,6 +2280,9 @@ func (m *Organization) Size() (n int) {
 			n += 1 + l + sovOrganization(uint64(l))
 		}
 	}
+	if m.RequireMFA {
+		n += 2
+	}
 	return n
 }
 
@@ -2298,6 +2416,16 @@ func (m *CreateOrganizationAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovOrganization(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovOrganization(uint64(l))
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovOrganization(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2311,6 +2439,8 @@ func (m *UpdateOrganizationAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovOrganization(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovOrganization(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovOrganization(uint64(l))
 	return n
 }
 
@@ -2390,6 +2520,7 @@ func (this *Organization) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Attributes:` + mapStringForAttributes + `,`,
 		`ContactInfo:` + repeatedStringForContactInfo + `,`,
+		`RequireMFA:` + fmt.Sprintf("%v", this.RequireMFA) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2487,6 +2618,8 @@ func (this *CreateOrganizationAPIKeyRequest) String() string {
 		`OrganizationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OrganizationIdentifiers), "OrganizationIdentifiers", "OrganizationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedCIDRs:` + fmt.Sprintf("%v", this.AllowedCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2498,6 +2631,7 @@ func (this *UpdateOrganizationAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateOrganizationAPIKeyRequest{`,
 		`OrganizationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.OrganizationIdentifiers), "OrganizationIdentifiers", "OrganizationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2897,6 +3031,26 @@ func (m *Organization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireMFA", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireMFA = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -3962,6 +4116,74 @@ func (m *CreateOrganizationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -4081,6 +4303,39 @@ func (m *UpdateOrganizationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ var OrganizationFieldPathsNested = []string{
 	"ids",
 	"ids.organization_id",
 	"name",
+	"require_mfa",
 	"updated_at",
 }
 
@@ -20,6 +21,7 @@ var OrganizationFieldPathsTopLevel = []string{
 	"description",
 	"ids",
 	"name",
+	"require_mfa",
 	"updated_at",
 }
 var OrganizationsFieldPathsNested = []string{
@@ -76,6 +78,7 @@ var CreateOrganizationRequestFieldPathsNested = []string{
 	"organization.ids",
 	"organization.ids.organization_id",
 	"organization.name",
+	"organization.require_mfa",
 	"organization.updated_at",
 }
 
@@ -93,6 +96,7 @@ var UpdateOrganizationRequestFieldPathsNested = []string{
 	"organization.ids",
 	"organization.ids.organization_id",
 	"organization.name",
+	"organization.require_mfa",
 	"organization.updated_at",
 }
 
@@ -123,6 +127,8 @@ var GetOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
 	"organization_ids",
 }
 var CreateOrganizationAPIKeyRequestFieldPathsNested = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"name",
 	"organization_ids",
 	"organization_ids.organization_id",
@@ -130,22 +136,28 @@ var CreateOrganizationAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"name",
 	"organization_ids",
 	"rights",
 }
 var UpdateOrganizationAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_cidrs",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
 	"api_key.rights",
+	"field_mask",
 	"organization_ids",
 	"organization_ids.organization_id",
 }
 
 var UpdateOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
+	"field_mask",
 	"organization_ids",
 }
 var ListOrganizationCollaboratorsRequestFieldPathsNested = []string{
@@ -88,6 +88,16 @@ func (dst *Organization) SetFields(src *Organization, paths ...string) error {
 			} else {
 				dst.ContactInfo = nil
 			}
+		case "require_mfa":
+			if len(subs) > 0 {
+				return fmt.Errorf("'require_mfa' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RequireMFA = src.RequireMFA
+			} else {
+				var zero bool
+				dst.RequireMFA = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -445,6 +455,24 @@ func (dst *CreateOrganizationAPIKeyRequest) SetFields(src *CreateOrganizationAPI
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_cidrs":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_cidrs' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedCIDRs = src.AllowedCIDRs
+			} else {
+				dst.AllowedCIDRs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -492,6 +520,16 @@ func (dst *UpdateOrganizationAPIKeyRequest) SetFields(src *UpdateOrganizationAPI
 					dst.APIKey = zero
 				}
 			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
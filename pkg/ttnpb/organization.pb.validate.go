@@ -143,6 +143,8 @@ func (m *Organization) ValidateFields(paths ...string) error {
 
 			}
 
+		case "require_mfa":
+			// no validation rules for RequireMFA
 		default:
 			return OrganizationValidationError{
 				field:  name,
@@ -1009,6 +1011,32 @@ func (m *CreateOrganizationAPIKeyRequest) ValidateFields(paths ...string) error
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateOrganizationAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_cidrs":
+
+			for idx, item := range m.GetAllowedCIDRs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 43 {
+					return CreateOrganizationAPIKeyRequestValidationError{
+						field:  fmt.Sprintf("allowed_cidrs[%v]", idx),
+						reason: "value length must be at most 43 runes",
+					}
+				}
+
+			}
+
 		default:
 			return CreateOrganizationAPIKeyRequestValidationError{
 				field:  name,
@@ -1115,6 +1143,18 @@ func (m *UpdateOrganizationAPIKeyRequest) ValidateFields(paths ...string) error
 				}
 			}
 
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateOrganizationAPIKeyRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return UpdateOrganizationAPIKeyRequestValidationError{
 				field:  name,
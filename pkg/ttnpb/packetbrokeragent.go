@@ -0,0 +1,26 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ttnpb
+
+import "bytes"
+
+// PacketBrokerUplinkTokenPrefix is the prefix of uplink tokens of uplink messages received through Packet Broker.
+const PacketBrokerUplinkTokenPrefix = "ttn-lw-packetbroker:"
+
+// IsPacketBrokerUplinkToken returns whether b is an uplink token of an uplink message received through Packet Broker.
+// Downlink messages on paths with such uplink tokens are published through the NsPba service.
+func IsPacketBrokerUplinkToken(b []byte) bool {
+	return bytes.HasPrefix(b, []byte(PacketBrokerUplinkTokenPrefix))
+}
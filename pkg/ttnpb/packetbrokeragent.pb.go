@@ -6,11 +6,18 @@ package ttnpb
 import (
 	context "context"
 	fmt "fmt"
+	io "io"
 	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
 
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
+	go_thethings_network_lorawan_stack_pkg_types "go.thethings.network/lorawan-stack/pkg/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -28,6 +35,222 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PacketBrokerUplinkMessage is an uplink message that is routed from a Forwarder to a Home Network.
+type PacketBrokerUplinkMessage struct {
+	// NetID of the Forwarder that received the uplink message.
+	ForwarderNetID go_thethings_network_lorawan_stack_pkg_types.NetID `protobuf:"bytes,1,opt,name=forwarder_net_id,json=forwarderNetId,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.NetID" json:"forwarder_net_id"`
+	// ID of the Forwarder within its NetID.
+	ForwarderID          string         `protobuf:"bytes,2,opt,name=forwarder_id,json=forwarderId,proto3" json:"forwarder_id,omitempty"`
+	Message              *UplinkMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PacketBrokerUplinkMessage) Reset()      { *m = PacketBrokerUplinkMessage{} }
+func (*PacketBrokerUplinkMessage) ProtoMessage() {}
+func (*PacketBrokerUplinkMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{0}
+}
+func (m *PacketBrokerUplinkMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerUplinkMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerUplinkMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerUplinkMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerUplinkMessage.Merge(m, src)
+}
+func (m *PacketBrokerUplinkMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerUplinkMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerUplinkMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerUplinkMessage proto.InternalMessageInfo
+
+func (m *PacketBrokerUplinkMessage) GetForwarderID() string {
+	if m != nil {
+		return m.ForwarderID
+	}
+	return ""
+}
+
+func (m *PacketBrokerUplinkMessage) GetMessage() *UplinkMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+// PacketBrokerDownlinkMessage is a downlink message that is routed from a Home Network to a Forwarder.
+type PacketBrokerDownlinkMessage struct {
+	// NetID of the Forwarder that transmits the downlink message.
+	ForwarderNetID go_thethings_network_lorawan_stack_pkg_types.NetID `protobuf:"bytes,1,opt,name=forwarder_net_id,json=forwarderNetId,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.NetID" json:"forwarder_net_id"`
+	// ID of the Forwarder within its NetID.
+	ForwarderID string `protobuf:"bytes,2,opt,name=forwarder_id,json=forwarderId,proto3" json:"forwarder_id,omitempty"`
+	// NetID of the Home Network that sent the downlink message.
+	HomeNetworkNetID     go_thethings_network_lorawan_stack_pkg_types.NetID `protobuf:"bytes,3,opt,name=home_network_net_id,json=homeNetworkNetId,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.NetID" json:"home_network_net_id"`
+	Message              *DownlinkMessage                                   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *PacketBrokerDownlinkMessage) Reset()      { *m = PacketBrokerDownlinkMessage{} }
+func (*PacketBrokerDownlinkMessage) ProtoMessage() {}
+func (*PacketBrokerDownlinkMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{1}
+}
+func (m *PacketBrokerDownlinkMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerDownlinkMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerDownlinkMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerDownlinkMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerDownlinkMessage.Merge(m, src)
+}
+func (m *PacketBrokerDownlinkMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerDownlinkMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerDownlinkMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerDownlinkMessage proto.InternalMessageInfo
+
+func (m *PacketBrokerDownlinkMessage) GetForwarderID() string {
+	if m != nil {
+		return m.ForwarderID
+	}
+	return ""
+}
+
+func (m *PacketBrokerDownlinkMessage) GetMessage() *DownlinkMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type PacketBrokerSubscribeUplinkRequest struct {
+	// NetID of the Home Network.
+	HomeNetworkNetID go_thethings_network_lorawan_stack_pkg_types.NetID `protobuf:"bytes,1,opt,name=home_network_net_id,json=homeNetworkNetId,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.NetID" json:"home_network_net_id"`
+	// DevAddr prefixes of the end devices of the Home Network.
+	DevAddrPrefixes      []go_thethings_network_lorawan_stack_pkg_types.DevAddrPrefix `protobuf:"bytes,2,rep,name=dev_addr_prefixes,json=devAddrPrefixes,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.DevAddrPrefix" json:"dev_addr_prefixes"`
+	XXX_NoUnkeyedLiteral struct{}                                                     `json:"-"`
+	XXX_sizecache        int32                                                        `json:"-"`
+}
+
+func (m *PacketBrokerSubscribeUplinkRequest) Reset()      { *m = PacketBrokerSubscribeUplinkRequest{} }
+func (*PacketBrokerSubscribeUplinkRequest) ProtoMessage() {}
+func (*PacketBrokerSubscribeUplinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{2}
+}
+func (m *PacketBrokerSubscribeUplinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerSubscribeUplinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerSubscribeUplinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerSubscribeUplinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerSubscribeUplinkRequest.Merge(m, src)
+}
+func (m *PacketBrokerSubscribeUplinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerSubscribeUplinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerSubscribeUplinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerSubscribeUplinkRequest proto.InternalMessageInfo
+
+type PacketBrokerSubscribeDownlinkRequest struct {
+	// NetID of the Forwarder.
+	ForwarderNetID go_thethings_network_lorawan_stack_pkg_types.NetID `protobuf:"bytes,1,opt,name=forwarder_net_id,json=forwarderNetId,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.NetID" json:"forwarder_net_id"`
+	// ID of the Forwarder within its NetID.
+	ForwarderID          string   `protobuf:"bytes,2,opt,name=forwarder_id,json=forwarderId,proto3" json:"forwarder_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PacketBrokerSubscribeDownlinkRequest) Reset()      { *m = PacketBrokerSubscribeDownlinkRequest{} }
+func (*PacketBrokerSubscribeDownlinkRequest) ProtoMessage() {}
+func (*PacketBrokerSubscribeDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{3}
+}
+func (m *PacketBrokerSubscribeDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerSubscribeDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerSubscribeDownlinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerSubscribeDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerSubscribeDownlinkRequest.Merge(m, src)
+}
+func (m *PacketBrokerSubscribeDownlinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerSubscribeDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerSubscribeDownlinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerSubscribeDownlinkRequest proto.InternalMessageInfo
+
+func (m *PacketBrokerSubscribeDownlinkRequest) GetForwarderID() string {
+	if m != nil {
+		return m.ForwarderID
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PacketBrokerUplinkMessage)(nil), "ttn.lorawan.v3.PacketBrokerUplinkMessage")
+	golang_proto.RegisterType((*PacketBrokerUplinkMessage)(nil), "ttn.lorawan.v3.PacketBrokerUplinkMessage")
+	proto.RegisterType((*PacketBrokerDownlinkMessage)(nil), "ttn.lorawan.v3.PacketBrokerDownlinkMessage")
+	golang_proto.RegisterType((*PacketBrokerDownlinkMessage)(nil), "ttn.lorawan.v3.PacketBrokerDownlinkMessage")
+	proto.RegisterType((*PacketBrokerSubscribeUplinkRequest)(nil), "ttn.lorawan.v3.PacketBrokerSubscribeUplinkRequest")
+	golang_proto.RegisterType((*PacketBrokerSubscribeUplinkRequest)(nil), "ttn.lorawan.v3.PacketBrokerSubscribeUplinkRequest")
+	proto.RegisterType((*PacketBrokerSubscribeDownlinkRequest)(nil), "ttn.lorawan.v3.PacketBrokerSubscribeDownlinkRequest")
+	golang_proto.RegisterType((*PacketBrokerSubscribeDownlinkRequest)(nil), "ttn.lorawan.v3.PacketBrokerSubscribeDownlinkRequest")
+}
+
 func init() {
 	proto.RegisterFile("lorawan-stack/api/packetbrokeragent.proto", fileDescriptor_1a44242dc5cd678e)
 }
@@ -36,27 +259,181 @@ func init() {
 }
 
 var fileDescriptor_1a44242dc5cd678e = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xa1, 0x4f, 0xc3, 0x40,
-	0x14, 0x87, 0xdf, 0x13, 0x20, 0x96, 0x80, 0x98, 0x40, 0x8c, 0xe4, 0x17, 0x04, 0x86, 0x04, 0xae,
-	0x09, 0xfb, 0x0f, 0x48, 0xc8, 0xd4, 0x92, 0x19, 0x10, 0xb8, 0xeb, 0x72, 0x74, 0x4d, 0xbb, 0x5e,
-	0xd3, 0xde, 0x58, 0xe6, 0x26, 0x27, 0x91, 0x48, 0x82, 0x9a, 0x9c, 0x9c, 0x9c, 0x9c, 0x9c, 0x9c,
-	0xdc, 0xdd, 0x99, 0xc9, 0xc9, 0x49, 0xc2, 0x0a, 0x62, 0x41, 0xff, 0xbe, 0x7c, 0xdf, 0xcb, 0xab,
-	0xdd, 0xa4, 0xba, 0x90, 0x43, 0x99, 0xdd, 0x95, 0x46, 0x76, 0x93, 0x40, 0xe6, 0x71, 0x90, 0xcb,
-	0x6e, 0xa2, 0x4c, 0x58, 0xe8, 0x44, 0x15, 0x32, 0x52, 0x99, 0x11, 0x79, 0xa1, 0x8d, 0xae, 0x9f,
-	0x1b, 0x93, 0x89, 0x5f, 0x5c, 0xbc, 0x35, 0x1b, 0x97, 0x91, 0xd6, 0x51, 0xaa, 0x82, 0xc3, 0x1a,
-	0x0e, 0x5e, 0x03, 0xd5, 0xcf, 0xcd, 0xa8, 0x82, 0x1b, 0x57, 0xff, 0xbd, 0x7d, 0x55, 0x96, 0x32,
-	0x52, 0x65, 0x45, 0xdc, 0x3f, 0xd7, 0x4e, 0x5a, 0x65, 0x27, 0x94, 0xf5, 0x76, 0xed, 0xac, 0x33,
-	0x08, 0xd3, 0xb8, 0xec, 0x3d, 0xe5, 0x69, 0x9c, 0x25, 0xf5, 0x6b, 0x71, 0x5c, 0x12, 0x2d, 0x69,
-	0xd4, 0x50, 0x8e, 0xaa, 0xb9, 0x5d, 0x69, 0x1a, 0x17, 0xa2, 0xea, 0x8b, 0xbf, 0xbe, 0x78, 0xfc,
-	0xe9, 0x3f, 0x7c, 0xf1, 0xd2, 0x82, 0x57, 0x16, 0xbc, 0xb6, 0xa0, 0x8d, 0x05, 0x6d, 0x2d, 0x68,
-	0x67, 0x41, 0x7b, 0x0b, 0x1e, 0x3b, 0xf0, 0xc4, 0x81, 0xa6, 0x0e, 0x3c, 0x73, 0xa0, 0xb9, 0x03,
-	0x2d, 0x1c, 0x68, 0xe9, 0xc0, 0x2b, 0x07, 0x5e, 0x3b, 0xd0, 0xc6, 0x81, 0xb7, 0x0e, 0xb4, 0x73,
-	0xe0, 0xbd, 0x03, 0x8d, 0x3d, 0x68, 0xe2, 0xc1, 0xef, 0x1e, 0xf4, 0xe1, 0xc1, 0x9f, 0x1e, 0x34,
-	0xf5, 0xa0, 0x99, 0x07, 0xcf, 0x3d, 0x78, 0xe1, 0xc1, 0x2f, 0xb7, 0x91, 0x16, 0xa6, 0xa7, 0x4c,
-	0x2f, 0xce, 0xa2, 0x52, 0x64, 0xca, 0x0c, 0x75, 0x91, 0x04, 0xc7, 0x5f, 0xc8, 0x93, 0x28, 0x30,
-	0x26, 0xcb, 0xc3, 0xf0, 0xf4, 0x70, 0x74, 0xf3, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x8f, 0x3a,
-	0xc2, 0x7f, 0x01, 0x00, 0x00,
+	// 801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0x4f, 0x4c, 0x33, 0x45,
+	0x14, 0x9f, 0x69, 0xad, 0x7e, 0x0e, 0x08, 0xfd, 0xd6, 0xc4, 0xd4, 0x7e, 0x71, 0xda, 0x34, 0x3d,
+	0x94, 0xf8, 0x75, 0x4b, 0xfa, 0x19, 0x13, 0xb9, 0x10, 0xd6, 0x2a, 0x42, 0xa4, 0x69, 0x4a, 0x24,
+	0x51, 0x82, 0xcd, 0x6e, 0x77, 0xba, 0xdd, 0xb4, 0xdd, 0x59, 0x67, 0xa7, 0x2d, 0xd5, 0x90, 0x70,
+	0x24, 0x9e, 0x8c, 0x27, 0x13, 0x2f, 0xc6, 0x13, 0x27, 0x83, 0x89, 0x07, 0x8e, 0x1c, 0x39, 0x72,
+	0x24, 0x1e, 0x1a, 0xba, 0x7b, 0xe1, 0xc8, 0x11, 0x39, 0x19, 0x77, 0xbb, 0xd0, 0x3f, 0x14, 0x4b,
+	0x4c, 0x3c, 0x70, 0xdb, 0x37, 0xfb, 0xe6, 0xf7, 0xde, 0xef, 0xf7, 0x7b, 0xf3, 0xd0, 0x42, 0x9d,
+	0x32, 0xb9, 0x2d, 0x1b, 0x69, 0x8b, 0xcb, 0xe5, 0x5a, 0x46, 0x36, 0xf5, 0x8c, 0x29, 0x97, 0x6b,
+	0x84, 0x2b, 0x8c, 0xd6, 0x08, 0x93, 0x35, 0x62, 0x70, 0xd1, 0x64, 0x94, 0x53, 0x61, 0x8e, 0x73,
+	0x43, 0xec, 0xa7, 0x8b, 0xad, 0x57, 0xd1, 0x15, 0x4d, 0xe7, 0xd5, 0xa6, 0x22, 0x96, 0x69, 0x23,
+	0x43, 0x8c, 0x16, 0xed, 0x98, 0x8c, 0xee, 0x76, 0x32, 0x6e, 0x72, 0x39, 0xad, 0x11, 0x23, 0xdd,
+	0x92, 0xeb, 0xba, 0x2a, 0x73, 0x92, 0x19, 0xfb, 0xf0, 0x20, 0xa3, 0xe9, 0x01, 0x08, 0x8d, 0x6a,
+	0xd4, 0xbb, 0xac, 0x34, 0x2b, 0x6e, 0xe4, 0x06, 0xee, 0x57, 0x3f, 0xfd, 0x85, 0x46, 0xa9, 0x56,
+	0x27, 0x77, 0x59, 0xa4, 0x61, 0xf2, 0x4e, 0xff, 0x67, 0x7c, 0x9c, 0x49, 0x83, 0x58, 0x96, 0xac,
+	0x11, 0xcb, 0xcb, 0x48, 0xfc, 0x1e, 0x40, 0xef, 0x16, 0x5c, 0x72, 0x92, 0x4b, 0xee, 0x0b, 0xb3,
+	0xae, 0x1b, 0xb5, 0x0d, 0x2f, 0x49, 0x68, 0xa1, 0x70, 0x85, 0xb2, 0xb6, 0xcc, 0x54, 0xc2, 0x4a,
+	0x06, 0xe1, 0x25, 0x5d, 0x8d, 0xc0, 0x38, 0x4c, 0xcd, 0x4a, 0x9f, 0x9f, 0x76, 0x63, 0xe0, 0xcf,
+	0x6e, 0x2c, 0xab, 0x51, 0x91, 0x57, 0x09, 0xaf, 0xea, 0x86, 0x66, 0x89, 0x06, 0xe1, 0x6d, 0xca,
+	0x6a, 0x99, 0xe1, 0xb2, 0x66, 0x4d, 0xcb, 0xf0, 0x8e, 0x49, 0x2c, 0x31, 0x4f, 0xf8, 0x5a, 0xce,
+	0xee, 0xc6, 0xe6, 0x3e, 0xf5, 0x51, 0xdd, 0x93, 0xe2, 0x5c, 0x65, 0x30, 0x56, 0x85, 0x2f, 0xd1,
+	0xec, 0x5d, 0x5d, 0x5d, 0x8d, 0x04, 0xe2, 0x30, 0xf5, 0xa6, 0xf4, 0xe1, 0x8d, 0x94, 0x64, 0x89,
+	0x48, 0x32, 0x8b, 0xbf, 0xde, 0x96, 0xd3, 0xdf, 0x2e, 0xa6, 0x3f, 0xda, 0x49, 0x2d, 0x2f, 0x6d,
+	0xa7, 0x77, 0x96, 0xfd, 0x70, 0xe1, 0xbb, 0xec, 0xcb, 0xbd, 0xa4, 0xdd, 0x8d, 0xcd, 0xdc, 0x16,
+	0x58, 0xcb, 0x15, 0x67, 0x6e, 0xb1, 0xd6, 0x54, 0x61, 0x05, 0xbd, 0xd1, 0x97, 0x20, 0x12, 0x8c,
+	0xc3, 0xd4, 0x4c, 0xf6, 0x3d, 0x71, 0xd8, 0x43, 0x71, 0x48, 0x02, 0xe9, 0xd9, 0x8d, 0x14, 0xfa,
+	0x1e, 0x06, 0xc2, 0xb0, 0xe8, 0xdf, 0x4b, 0xfc, 0x11, 0x44, 0x2f, 0x06, 0x35, 0xcb, 0xd1, 0xb6,
+	0xf1, 0xc4, 0x55, 0xdb, 0x43, 0x6f, 0x57, 0x69, 0x83, 0x94, 0xfa, 0xad, 0xfa, 0xac, 0x82, 0x2e,
+	0xab, 0xfc, 0x7f, 0x62, 0x15, 0xfe, 0x8c, 0x36, 0x48, 0xde, 0x4b, 0xf6, 0x78, 0x85, 0xab, 0xc3,
+	0x27, 0xaa, 0xf0, 0xf1, 0x9d, 0x69, 0xaf, 0xb9, 0xa6, 0xc5, 0x46, 0x4d, 0x1b, 0xf1, 0xe0, 0x3e,
+	0xdb, 0x7e, 0x0e, 0xa0, 0xc4, 0xa0, 0x6d, 0x9b, 0x4d, 0xc5, 0x2a, 0x33, 0x5d, 0x21, 0x9e, 0xe1,
+	0x45, 0xf2, 0x4d, 0x93, 0x58, 0x7c, 0x12, 0x55, 0xf8, 0x3f, 0x51, 0x6d, 0xa1, 0xe7, 0x2a, 0x69,
+	0x95, 0x64, 0x55, 0x65, 0x25, 0x93, 0x91, 0x8a, 0xbe, 0x4b, 0xac, 0x48, 0x20, 0x1e, 0x4c, 0xcd,
+	0x4a, 0xeb, 0x37, 0x52, 0xe8, 0x47, 0x18, 0x78, 0x06, 0xfb, 0x4d, 0x2c, 0x3d, 0xaa, 0x89, 0x1c,
+	0x69, 0xad, 0xa8, 0x2a, 0x2b, 0xb8, 0x98, 0xc5, 0x79, 0x75, 0x30, 0x24, 0x56, 0xe2, 0x2f, 0x88,
+	0x92, 0xf7, 0xaa, 0xe3, 0x2b, 0xeb, 0xeb, 0xf3, 0xf4, 0xa6, 0x3b, 0xbb, 0x85, 0x42, 0xab, 0x56,
+	0x41, 0x91, 0x85, 0x0d, 0xf4, 0x56, 0xa1, 0xa9, 0xd4, 0x75, 0xab, 0xea, 0xcd, 0x84, 0x90, 0x1c,
+	0x9d, 0xb3, 0x55, 0x99, 0x93, 0xb6, 0xdc, 0x19, 0xda, 0x11, 0xd1, 0x77, 0x44, 0x6f, 0x09, 0x8b,
+	0xfe, 0x12, 0x16, 0x3f, 0xf9, 0x67, 0x09, 0x67, 0x37, 0x51, 0x28, 0xef, 0xe2, 0xae, 0xa3, 0xf9,
+	0x3e, 0xae, 0xaf, 0xa6, 0xf0, 0x6f, 0x13, 0x3c, 0x11, 0xf4, 0xb7, 0x20, 0x12, 0x06, 0x8d, 0x2a,
+	0xd2, 0x26, 0x27, 0x4c, 0x28, 0x8e, 0xb6, 0xbe, 0x30, 0x5a, 0x60, 0xe2, 0x9a, 0x9f, 0x54, 0x4a,
+	0x60, 0x68, 0x7e, 0xe4, 0x91, 0x08, 0xd9, 0x87, 0x50, 0xef, 0x7f, 0x51, 0xd1, 0xe9, 0x3b, 0x59,
+	0x84, 0xc2, 0xd6, 0xb8, 0x54, 0xef, 0x3f, 0x74, 0x7f, 0x4a, 0xd9, 0x84, 0x5d, 0xf4, 0x7c, 0x6c,
+	0xa4, 0x85, 0x0f, 0xa6, 0x62, 0x33, 0xf2, 0x02, 0xa2, 0x8f, 0xe9, 0x67, 0x11, 0x4a, 0xbf, 0xc2,
+	0xd3, 0x1e, 0x86, 0x67, 0x3d, 0x0c, 0xcf, 0x7b, 0x18, 0x5c, 0xf4, 0x30, 0xb8, 0xec, 0x61, 0x70,
+	0xd5, 0xc3, 0xe0, 0xba, 0x87, 0xe1, 0xbe, 0x8d, 0xe1, 0x81, 0x8d, 0xc1, 0xa1, 0x8d, 0xe1, 0x91,
+	0x8d, 0xc1, 0xb1, 0x8d, 0xc1, 0x89, 0x8d, 0xc1, 0xa9, 0x8d, 0xe1, 0x99, 0x8d, 0xe1, 0xb9, 0x8d,
+	0xc1, 0x85, 0x8d, 0xe1, 0xa5, 0x8d, 0xc1, 0x95, 0x8d, 0xe1, 0xb5, 0x8d, 0xc1, 0xbe, 0x83, 0xc1,
+	0x81, 0x83, 0xe1, 0x0f, 0x0e, 0x06, 0x3f, 0x39, 0x18, 0xfe, 0xe2, 0x60, 0x70, 0xe8, 0x60, 0x70,
+	0xe4, 0x60, 0x78, 0xec, 0x60, 0x78, 0xe2, 0x60, 0xf8, 0xd5, 0xcb, 0x69, 0x5f, 0x21, 0x37, 0x4c,
+	0x45, 0x79, 0xdd, 0x95, 0xeb, 0xd5, 0xdf, 0x03, 0x00, 0x4c, 0x47, 0x96, 0x92, 0xfc, 0x08, 0x00,
+	0x00,
+}
+
+func (this *PacketBrokerUplinkMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerUplinkMessage)
+	if !ok {
+		that2, ok := that.(PacketBrokerUplinkMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ForwarderNetID.Equal(that1.ForwarderNetID) {
+		return false
+	}
+	if this.ForwarderID != that1.ForwarderID {
+		return false
+	}
+	if !this.Message.Equal(that1.Message) {
+		return false
+	}
+	return true
+}
+func (this *PacketBrokerDownlinkMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerDownlinkMessage)
+	if !ok {
+		that2, ok := that.(PacketBrokerDownlinkMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ForwarderNetID.Equal(that1.ForwarderNetID) {
+		return false
+	}
+	if this.ForwarderID != that1.ForwarderID {
+		return false
+	}
+	if !this.HomeNetworkNetID.Equal(that1.HomeNetworkNetID) {
+		return false
+	}
+	if !this.Message.Equal(that1.Message) {
+		return false
+	}
+	return true
+}
+func (this *PacketBrokerSubscribeUplinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerSubscribeUplinkRequest)
+	if !ok {
+		that2, ok := that.(PacketBrokerSubscribeUplinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HomeNetworkNetID.Equal(that1.HomeNetworkNetID) {
+		return false
+	}
+	if len(this.DevAddrPrefixes) != len(that1.DevAddrPrefixes) {
+		return false
+	}
+	for i := range this.DevAddrPrefixes {
+		if !this.DevAddrPrefixes[i].Equal(that1.DevAddrPrefixes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PacketBrokerSubscribeDownlinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerSubscribeDownlinkRequest)
+	if !ok {
+		that2, ok := that.(PacketBrokerSubscribeDownlinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ForwarderNetID.Equal(that1.ForwarderNetID) {
+		return false
+	}
+	if this.ForwarderID != that1.ForwarderID {
+		return false
+	}
+	return true
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -138,3 +515,1447 @@ var _GsPba_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/packetbrokeragent.proto",
 }
+
+// NsPbaClient is the client API for NsPba service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsPbaClient interface {
+	// PublishDownlink publishes a downlink message to the Forwarder of the gateways in the downlink paths.
+	PublishDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type nsPbaClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsPbaClient(cc *grpc.ClientConn) NsPbaClient {
+	return &nsPbaClient{cc}
+}
+
+func (c *nsPbaClient) PublishDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsPba/PublishDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsPbaServer is the server API for NsPba service.
+type NsPbaServer interface {
+	// PublishDownlink publishes a downlink message to the Forwarder of the gateways in the downlink paths.
+	PublishDownlink(context.Context, *DownlinkMessage) (*types.Empty, error)
+}
+
+// UnimplementedNsPbaServer can be embedded to have forward compatible implementations.
+type UnimplementedNsPbaServer struct {
+}
+
+func (*UnimplementedNsPbaServer) PublishDownlink(ctx context.Context, req *DownlinkMessage) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDownlink not implemented")
+}
+
+func RegisterNsPbaServer(s *grpc.Server, srv NsPbaServer) {
+	s.RegisterService(&_NsPba_serviceDesc, srv)
+}
+
+func _NsPba_PublishDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsPbaServer).PublishDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsPba/PublishDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsPbaServer).PublishDownlink(ctx, req.(*DownlinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsPba_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsPba",
+	HandlerType: (*NsPbaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublishDownlink",
+			Handler:    _NsPba_PublishDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/packetbrokeragent.proto",
+}
+
+// PacketBrokerRouterClient is the client API for PacketBrokerRouter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PacketBrokerRouterClient interface {
+	PublishUplink(ctx context.Context, in *PacketBrokerUplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+	SubscribeUplink(ctx context.Context, in *PacketBrokerSubscribeUplinkRequest, opts ...grpc.CallOption) (PacketBrokerRouter_SubscribeUplinkClient, error)
+	PublishDownlink(ctx context.Context, in *PacketBrokerDownlinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+	SubscribeDownlink(ctx context.Context, in *PacketBrokerSubscribeDownlinkRequest, opts ...grpc.CallOption) (PacketBrokerRouter_SubscribeDownlinkClient, error)
+}
+
+type packetBrokerRouterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPacketBrokerRouterClient(cc *grpc.ClientConn) PacketBrokerRouterClient {
+	return &packetBrokerRouterClient{cc}
+}
+
+func (c *packetBrokerRouterClient) PublishUplink(ctx context.Context, in *PacketBrokerUplinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.PacketBrokerRouter/PublishUplink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packetBrokerRouterClient) SubscribeUplink(ctx context.Context, in *PacketBrokerSubscribeUplinkRequest, opts ...grpc.CallOption) (PacketBrokerRouter_SubscribeUplinkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PacketBrokerRouter_serviceDesc.Streams[0], "/ttn.lorawan.v3.PacketBrokerRouter/SubscribeUplink", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &packetBrokerRouterSubscribeUplinkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PacketBrokerRouter_SubscribeUplinkClient interface {
+	Recv() (*PacketBrokerUplinkMessage, error)
+	grpc.ClientStream
+}
+
+type packetBrokerRouterSubscribeUplinkClient struct {
+	grpc.ClientStream
+}
+
+func (x *packetBrokerRouterSubscribeUplinkClient) Recv() (*PacketBrokerUplinkMessage, error) {
+	m := new(PacketBrokerUplinkMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *packetBrokerRouterClient) PublishDownlink(ctx context.Context, in *PacketBrokerDownlinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.PacketBrokerRouter/PublishDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packetBrokerRouterClient) SubscribeDownlink(ctx context.Context, in *PacketBrokerSubscribeDownlinkRequest, opts ...grpc.CallOption) (PacketBrokerRouter_SubscribeDownlinkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PacketBrokerRouter_serviceDesc.Streams[1], "/ttn.lorawan.v3.PacketBrokerRouter/SubscribeDownlink", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &packetBrokerRouterSubscribeDownlinkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PacketBrokerRouter_SubscribeDownlinkClient interface {
+	Recv() (*PacketBrokerDownlinkMessage, error)
+	grpc.ClientStream
+}
+
+type packetBrokerRouterSubscribeDownlinkClient struct {
+	grpc.ClientStream
+}
+
+func (x *packetBrokerRouterSubscribeDownlinkClient) Recv() (*PacketBrokerDownlinkMessage, error) {
+	m := new(PacketBrokerDownlinkMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PacketBrokerRouterServer is the server API for PacketBrokerRouter service.
+type PacketBrokerRouterServer interface {
+	PublishUplink(context.Context, *PacketBrokerUplinkMessage) (*types.Empty, error)
+	SubscribeUplink(*PacketBrokerSubscribeUplinkRequest, PacketBrokerRouter_SubscribeUplinkServer) error
+	PublishDownlink(context.Context, *PacketBrokerDownlinkMessage) (*types.Empty, error)
+	SubscribeDownlink(*PacketBrokerSubscribeDownlinkRequest, PacketBrokerRouter_SubscribeDownlinkServer) error
+}
+
+// UnimplementedPacketBrokerRouterServer can be embedded to have forward compatible implementations.
+type UnimplementedPacketBrokerRouterServer struct {
+}
+
+func (*UnimplementedPacketBrokerRouterServer) PublishUplink(ctx context.Context, req *PacketBrokerUplinkMessage) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishUplink not implemented")
+}
+func (*UnimplementedPacketBrokerRouterServer) SubscribeUplink(req *PacketBrokerSubscribeUplinkRequest, srv PacketBrokerRouter_SubscribeUplinkServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeUplink not implemented")
+}
+func (*UnimplementedPacketBrokerRouterServer) PublishDownlink(ctx context.Context, req *PacketBrokerDownlinkMessage) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDownlink not implemented")
+}
+func (*UnimplementedPacketBrokerRouterServer) SubscribeDownlink(req *PacketBrokerSubscribeDownlinkRequest, srv PacketBrokerRouter_SubscribeDownlinkServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeDownlink not implemented")
+}
+
+func RegisterPacketBrokerRouterServer(s *grpc.Server, srv PacketBrokerRouterServer) {
+	s.RegisterService(&_PacketBrokerRouter_serviceDesc, srv)
+}
+
+func _PacketBrokerRouter_PublishUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PacketBrokerUplinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PacketBrokerRouterServer).PublishUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.PacketBrokerRouter/PublishUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PacketBrokerRouterServer).PublishUplink(ctx, req.(*PacketBrokerUplinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PacketBrokerRouter_SubscribeUplink_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PacketBrokerSubscribeUplinkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PacketBrokerRouterServer).SubscribeUplink(m, &packetBrokerRouterSubscribeUplinkServer{stream})
+}
+
+type PacketBrokerRouter_SubscribeUplinkServer interface {
+	Send(*PacketBrokerUplinkMessage) error
+	grpc.ServerStream
+}
+
+type packetBrokerRouterSubscribeUplinkServer struct {
+	grpc.ServerStream
+}
+
+func (x *packetBrokerRouterSubscribeUplinkServer) Send(m *PacketBrokerUplinkMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PacketBrokerRouter_PublishDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PacketBrokerDownlinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PacketBrokerRouterServer).PublishDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.PacketBrokerRouter/PublishDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PacketBrokerRouterServer).PublishDownlink(ctx, req.(*PacketBrokerDownlinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PacketBrokerRouter_SubscribeDownlink_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PacketBrokerSubscribeDownlinkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PacketBrokerRouterServer).SubscribeDownlink(m, &packetBrokerRouterSubscribeDownlinkServer{stream})
+}
+
+type PacketBrokerRouter_SubscribeDownlinkServer interface {
+	Send(*PacketBrokerDownlinkMessage) error
+	grpc.ServerStream
+}
+
+type packetBrokerRouterSubscribeDownlinkServer struct {
+	grpc.ServerStream
+}
+
+func (x *packetBrokerRouterSubscribeDownlinkServer) Send(m *PacketBrokerDownlinkMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _PacketBrokerRouter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.PacketBrokerRouter",
+	HandlerType: (*PacketBrokerRouterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublishUplink",
+			Handler:    _PacketBrokerRouter_PublishUplink_Handler,
+		},
+		{
+			MethodName: "PublishDownlink",
+			Handler:    _PacketBrokerRouter_PublishDownlink_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeUplink",
+			Handler:       _PacketBrokerRouter_SubscribeUplink_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeDownlink",
+			Handler:       _PacketBrokerRouter_SubscribeDownlink_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/packetbrokeragent.proto",
+}
+
+func (m *PacketBrokerUplinkMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerUplinkMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerUplinkMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ForwarderID) > 0 {
+		i -= len(m.ForwarderID)
+		copy(dAtA[i:], m.ForwarderID)
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(len(m.ForwarderID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ForwarderNetID.Size()
+		i -= size
+		if _, err := m.ForwarderNetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketBrokerDownlinkMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerDownlinkMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerDownlinkMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.HomeNetworkNetID.Size()
+		i -= size
+		if _, err := m.HomeNetworkNetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ForwarderID) > 0 {
+		i -= len(m.ForwarderID)
+		copy(dAtA[i:], m.ForwarderID)
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(len(m.ForwarderID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ForwarderNetID.Size()
+		i -= size
+		if _, err := m.ForwarderNetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketBrokerSubscribeUplinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerSubscribeUplinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerSubscribeUplinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DevAddrPrefixes) > 0 {
+		for iNdEx := len(m.DevAddrPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DevAddrPrefixes[iNdEx].Size()
+				i -= size
+				if _, err := m.DevAddrPrefixes[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.HomeNetworkNetID.Size()
+		i -= size
+		if _, err := m.HomeNetworkNetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketBrokerSubscribeDownlinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerSubscribeDownlinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerSubscribeDownlinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForwarderID) > 0 {
+		i -= len(m.ForwarderID)
+		copy(dAtA[i:], m.ForwarderID)
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(len(m.ForwarderID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ForwarderNetID.Size()
+		i -= size
+		if _, err := m.ForwarderNetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacketbrokeragent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacketbrokeragent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedPacketBrokerUplinkMessage(r randyPacketbrokeragent, easy bool) *PacketBrokerUplinkMessage {
+	this := &PacketBrokerUplinkMessage{}
+	v1 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedNetID(r)
+	this.ForwarderNetID = *v1
+	this.ForwarderID = randStringPacketbrokeragent(r)
+	if r.Intn(5) != 0 {
+		this.Message = NewPopulatedUplinkMessage(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPacketBrokerDownlinkMessage(r randyPacketbrokeragent, easy bool) *PacketBrokerDownlinkMessage {
+	this := &PacketBrokerDownlinkMessage{}
+	v2 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedNetID(r)
+	this.ForwarderNetID = *v2
+	this.ForwarderID = randStringPacketbrokeragent(r)
+	v3 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedNetID(r)
+	this.HomeNetworkNetID = *v3
+	if r.Intn(5) != 0 {
+		this.Message = NewPopulatedDownlinkMessage(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPacketBrokerSubscribeUplinkRequest(r randyPacketbrokeragent, easy bool) *PacketBrokerSubscribeUplinkRequest {
+	this := &PacketBrokerSubscribeUplinkRequest{}
+	v4 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedNetID(r)
+	this.HomeNetworkNetID = *v4
+	v5 := r.Intn(10)
+	this.DevAddrPrefixes = make([]go_thethings_network_lorawan_stack_pkg_types.DevAddrPrefix, v5)
+	for i := 0; i < v5; i++ {
+		v6 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevAddrPrefix(r)
+		this.DevAddrPrefixes[i] = *v6
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPacketBrokerSubscribeDownlinkRequest(r randyPacketbrokeragent, easy bool) *PacketBrokerSubscribeDownlinkRequest {
+	this := &PacketBrokerSubscribeDownlinkRequest{}
+	v7 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedNetID(r)
+	this.ForwarderNetID = *v7
+	this.ForwarderID = randStringPacketbrokeragent(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyPacketbrokeragent interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RunePacketbrokeragent(r randyPacketbrokeragent) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringPacketbrokeragent(r randyPacketbrokeragent) string {
+	v8 := r.Intn(100)
+	tmps := make([]rune, v8)
+	for i := 0; i < v8; i++ {
+		tmps[i] = randUTF8RunePacketbrokeragent(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedPacketbrokeragent(r randyPacketbrokeragent, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldPacketbrokeragent(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldPacketbrokeragent(dAtA []byte, r randyPacketbrokeragent, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(key))
+		v9 := r.Int63()
+		if r.Intn(2) == 0 {
+			v9 *= -1
+		}
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(v9))
+	case 1:
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulatePacketbrokeragent(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *PacketBrokerUplinkMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ForwarderNetID.Size()
+	n += 1 + l + sovPacketbrokeragent(uint64(l))
+	l = len(m.ForwarderID)
+	if l > 0 {
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketBrokerDownlinkMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ForwarderNetID.Size()
+	n += 1 + l + sovPacketbrokeragent(uint64(l))
+	l = len(m.ForwarderID)
+	if l > 0 {
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	l = m.HomeNetworkNetID.Size()
+	n += 1 + l + sovPacketbrokeragent(uint64(l))
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketBrokerSubscribeUplinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HomeNetworkNetID.Size()
+	n += 1 + l + sovPacketbrokeragent(uint64(l))
+	if len(m.DevAddrPrefixes) > 0 {
+		for _, e := range m.DevAddrPrefixes {
+			l = e.Size()
+			n += 1 + l + sovPacketbrokeragent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketBrokerSubscribeDownlinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ForwarderNetID.Size()
+	n += 1 + l + sovPacketbrokeragent(uint64(l))
+	l = len(m.ForwarderID)
+	if l > 0 {
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	return n
+}
+
+func sovPacketbrokeragent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacketbrokeragent(x uint64) (n int) {
+	return sovPacketbrokeragent((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *PacketBrokerUplinkMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PacketBrokerUplinkMessage{`,
+		`ForwarderNetID:` + fmt.Sprintf("%v", this.ForwarderNetID) + `,`,
+		`ForwarderID:` + fmt.Sprintf("%v", this.ForwarderID) + `,`,
+		`Message:` + strings.Replace(fmt.Sprintf("%v", this.Message), "UplinkMessage", "UplinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PacketBrokerDownlinkMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PacketBrokerDownlinkMessage{`,
+		`ForwarderNetID:` + fmt.Sprintf("%v", this.ForwarderNetID) + `,`,
+		`ForwarderID:` + fmt.Sprintf("%v", this.ForwarderID) + `,`,
+		`HomeNetworkNetID:` + fmt.Sprintf("%v", this.HomeNetworkNetID) + `,`,
+		`Message:` + strings.Replace(fmt.Sprintf("%v", this.Message), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PacketBrokerSubscribeUplinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PacketBrokerSubscribeUplinkRequest{`,
+		`HomeNetworkNetID:` + fmt.Sprintf("%v", this.HomeNetworkNetID) + `,`,
+		`DevAddrPrefixes:` + fmt.Sprintf("%v", this.DevAddrPrefixes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PacketBrokerSubscribeDownlinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PacketBrokerSubscribeDownlinkRequest{`,
+		`ForwarderNetID:` + fmt.Sprintf("%v", this.ForwarderNetID) + `,`,
+		`ForwarderID:` + fmt.Sprintf("%v", this.ForwarderID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringPacketbrokeragent(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PacketBrokerUplinkMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerUplinkMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerUplinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderNetID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForwarderNetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwarderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &UplinkMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketBrokerDownlinkMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerDownlinkMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerDownlinkMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderNetID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForwarderNetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwarderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeNetworkNetID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HomeNetworkNetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &DownlinkMessage{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketBrokerSubscribeUplinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerSubscribeUplinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerSubscribeUplinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeNetworkNetID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HomeNetworkNetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddrPrefixes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v go_thethings_network_lorawan_stack_pkg_types.DevAddrPrefix
+			m.DevAddrPrefixes = append(m.DevAddrPrefixes, v)
+			if err := m.DevAddrPrefixes[len(m.DevAddrPrefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketBrokerSubscribeDownlinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerSubscribeDownlinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerSubscribeDownlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderNetID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForwarderNetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwarderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacketbrokeragent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacketbrokeragent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacketbrokeragent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacketbrokeragent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacketbrokeragent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacketbrokeragent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacketbrokeragent = fmt.Errorf("proto: unexpected end of group")
+)
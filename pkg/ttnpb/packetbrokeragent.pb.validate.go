@@ -34,3 +34,442 @@ var (
 
 // define the regex for a UUID once up-front
 var _packetbrokeragent_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// ValidateFields checks the field values on PacketBrokerUplinkMessage with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PacketBrokerUplinkMessage) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PacketBrokerUplinkMessageFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "forwarder_net_id":
+			// no validation rules for ForwarderNetID
+		case "forwarder_id":
+
+			if utf8.RuneCountInString(m.GetForwarderID()) > 36 {
+				return PacketBrokerUplinkMessageValidationError{
+					field:  "forwarder_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_PacketBrokerUplinkMessage_ForwarderID_Pattern.MatchString(m.GetForwarderID()) {
+				return PacketBrokerUplinkMessageValidationError{
+					field:  "forwarder_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "message":
+
+			if m.Message == nil {
+				return PacketBrokerUplinkMessageValidationError{
+					field:  "message",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetMessage()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PacketBrokerUplinkMessageValidationError{
+						field:  "message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return PacketBrokerUplinkMessageValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PacketBrokerUplinkMessageValidationError is the validation error returned by
+// PacketBrokerUplinkMessage.ValidateFields if the designated constraints
+// aren't met.
+type PacketBrokerUplinkMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PacketBrokerUplinkMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PacketBrokerUplinkMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PacketBrokerUplinkMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PacketBrokerUplinkMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PacketBrokerUplinkMessageValidationError) ErrorName() string {
+	return "PacketBrokerUplinkMessageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PacketBrokerUplinkMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPacketBrokerUplinkMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PacketBrokerUplinkMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PacketBrokerUplinkMessageValidationError{}
+
+var _PacketBrokerUplinkMessage_ForwarderID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on PacketBrokerDownlinkMessage with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *PacketBrokerDownlinkMessage) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PacketBrokerDownlinkMessageFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "forwarder_net_id":
+			// no validation rules for ForwarderNetID
+		case "forwarder_id":
+
+			if utf8.RuneCountInString(m.GetForwarderID()) > 36 {
+				return PacketBrokerDownlinkMessageValidationError{
+					field:  "forwarder_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_PacketBrokerDownlinkMessage_ForwarderID_Pattern.MatchString(m.GetForwarderID()) {
+				return PacketBrokerDownlinkMessageValidationError{
+					field:  "forwarder_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "home_network_net_id":
+			// no validation rules for HomeNetworkNetID
+		case "message":
+
+			if m.Message == nil {
+				return PacketBrokerDownlinkMessageValidationError{
+					field:  "message",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetMessage()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PacketBrokerDownlinkMessageValidationError{
+						field:  "message",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return PacketBrokerDownlinkMessageValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PacketBrokerDownlinkMessageValidationError is the validation error returned
+// by PacketBrokerDownlinkMessage.ValidateFields if the designated constraints
+// aren't met.
+type PacketBrokerDownlinkMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PacketBrokerDownlinkMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PacketBrokerDownlinkMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PacketBrokerDownlinkMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PacketBrokerDownlinkMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PacketBrokerDownlinkMessageValidationError) ErrorName() string {
+	return "PacketBrokerDownlinkMessageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PacketBrokerDownlinkMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPacketBrokerDownlinkMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PacketBrokerDownlinkMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PacketBrokerDownlinkMessageValidationError{}
+
+var _PacketBrokerDownlinkMessage_ForwarderID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on PacketBrokerSubscribeUplinkRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *PacketBrokerSubscribeUplinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PacketBrokerSubscribeUplinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "home_network_net_id":
+			// no validation rules for HomeNetworkNetID
+		case "dev_addr_prefixes":
+
+			if len(m.DevAddrPrefixes) < 1 {
+				return PacketBrokerSubscribeUplinkRequestValidationError{
+					field:  "dev_addr_prefixes",
+					reason: "value must contain at least 1 item(s)",
+				}
+			}
+
+		default:
+			return PacketBrokerSubscribeUplinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PacketBrokerSubscribeUplinkRequestValidationError is the validation error
+// returned by PacketBrokerSubscribeUplinkRequest.ValidateFields if the
+// designated constraints aren't met.
+type PacketBrokerSubscribeUplinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PacketBrokerSubscribeUplinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PacketBrokerSubscribeUplinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PacketBrokerSubscribeUplinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PacketBrokerSubscribeUplinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PacketBrokerSubscribeUplinkRequestValidationError) ErrorName() string {
+	return "PacketBrokerSubscribeUplinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PacketBrokerSubscribeUplinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPacketBrokerSubscribeUplinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PacketBrokerSubscribeUplinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PacketBrokerSubscribeUplinkRequestValidationError{}
+
+// ValidateFields checks the field values on
+// PacketBrokerSubscribeDownlinkRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *PacketBrokerSubscribeDownlinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PacketBrokerSubscribeDownlinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "forwarder_net_id":
+			// no validation rules for ForwarderNetID
+		case "forwarder_id":
+
+			if utf8.RuneCountInString(m.GetForwarderID()) > 36 {
+				return PacketBrokerSubscribeDownlinkRequestValidationError{
+					field:  "forwarder_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_PacketBrokerSubscribeDownlinkRequest_ForwarderID_Pattern.MatchString(m.GetForwarderID()) {
+				return PacketBrokerSubscribeDownlinkRequestValidationError{
+					field:  "forwarder_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return PacketBrokerSubscribeDownlinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PacketBrokerSubscribeDownlinkRequestValidationError is the validation error
+// returned by PacketBrokerSubscribeDownlinkRequest.ValidateFields if the
+// designated constraints aren't met.
+type PacketBrokerSubscribeDownlinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PacketBrokerSubscribeDownlinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PacketBrokerSubscribeDownlinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PacketBrokerSubscribeDownlinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PacketBrokerSubscribeDownlinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PacketBrokerSubscribeDownlinkRequestValidationError) ErrorName() string {
+	return "PacketBrokerSubscribeDownlinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PacketBrokerSubscribeDownlinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPacketBrokerSubscribeDownlinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PacketBrokerSubscribeDownlinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PacketBrokerSubscribeDownlinkRequestValidationError{}
+
+var _PacketBrokerSubscribeDownlinkRequest_ForwarderID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
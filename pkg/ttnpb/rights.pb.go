@@ -11,10 +11,13 @@ import (
 	reflect "reflect"
 	strconv "strconv"
 	strings "strings"
+	time "time"
 
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 )
 
@@ -23,6 +26,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -336,7 +340,13 @@ type APIKey struct {
 	// User-defined (friendly) name for the API key.
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// Rights that are granted to this API key.
-	Rights               []Right  `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	Rights []Right `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key can no longer be used.
+	// If not set, the API key does not expire.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// Source addresses (in CIDR notation) from which the API key can be used.
+	// If empty, the API key can be used from any address.
+	AllowedCIDRs         []string `protobuf:"bytes,6,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -401,6 +411,20 @@ func (m *APIKey) GetRights() []Right {
 	return nil
 }
 
+func (m *APIKey) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetAllowedCIDRs() []string {
+	if m != nil {
+		return m.AllowedCIDRs
+	}
+	return nil
+}
+
 type APIKeys struct {
 	APIKeys              []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -606,83 +630,90 @@ func init() {
 }
 
 var fileDescriptor_9bb69af2cf8904c5 = []byte{
-	// 1210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x3b, 0x6c, 0xdb, 0x46,
-	0x18, 0xc7, 0x79, 0x7a, 0xe7, 0x1c, 0x39, 0x97, 0x4b, 0xec, 0x28, 0xb2, 0x73, 0x72, 0xe4, 0x3c,
-	0xdc, 0x34, 0x92, 0x5a, 0xa7, 0xaf, 0xa1, 0x28, 0x40, 0x4a, 0xb4, 0x42, 0x9b, 0x91, 0x54, 0x92,
-	0x4e, 0x90, 0x2c, 0x02, 0x6d, 0x33, 0x32, 0x61, 0x87, 0x14, 0x28, 0xc6, 0xa9, 0x3b, 0x05, 0x9d,
-	0x8c, 0x02, 0x05, 0x8a, 0x4e, 0x1d, 0x8b, 0x76, 0x09, 0xd0, 0x25, 0x5b, 0x33, 0x66, 0xf4, 0xe8,
-	0x31, 0x93, 0x11, 0x51, 0x4b, 0xc6, 0x8c, 0x86, 0xa7, 0x42, 0x24, 0x65, 0x3e, 0x24, 0xc5, 0x7d,
-	0x6c, 0xa7, 0xfb, 0x7e, 0xf7, 0xf1, 0xff, 0xff, 0xee, 0xfb, 0x0e, 0x82, 0x64, 0x5b, 0x37, 0xe4,
-	0x67, 0xb2, 0x56, 0xe8, 0x98, 0xf2, 0xfa, 0x56, 0x49, 0x6e, 0xab, 0x25, 0x43, 0x6d, 0x6d, 0x9a,
-	0x9d, 0x62, 0xdb, 0xd0, 0x4d, 0x1d, 0x4f, 0x9a, 0xa6, 0x56, 0x74, 0x99, 0xe2, 0xce, 0x9d, 0x2c,
-	0xdd, 0x52, 0xcd, 0xcd, 0xa7, 0x6b, 0xc5, 0x75, 0xfd, 0x49, 0x49, 0xd1, 0x76, 0xf4, 0xdd, 0xb6,
-	0xa1, 0x7f, 0xb7, 0x5b, 0xb2, 0xe1, 0xf5, 0x42, 0x4b, 0xd1, 0x0a, 0x3b, 0xf2, 0xb6, 0xba, 0x21,
-	0x9b, 0x4a, 0x69, 0x68, 0xe1, 0xa4, 0xcc, 0x16, 0x7c, 0x29, 0x5a, 0x7a, 0x4b, 0x77, 0x0e, 0xaf,
-	0x3d, 0x7d, 0x6c, 0xff, 0xb2, 0x7f, 0xd8, 0x2b, 0x17, 0x9f, 0x1f, 0x56, 0xa8, 0x6e, 0x28, 0x9a,
-	0xa9, 0x3e, 0x56, 0x15, 0xc3, 0x95, 0x99, 0x5f, 0x82, 0x09, 0xc1, 0x96, 0x8d, 0xbf, 0x86, 0x09,
-	0xc7, 0x40, 0x06, 0xcc, 0x45, 0x17, 0x26, 0x17, 0xa7, 0x8a, 0x41, 0x07, 0x45, 0x9b, 0x63, 0xd2,
-	0xc7, 0x0c, 0xfc, 0x05, 0x24, 0xf3, 0xf1, 0x1f, 0x40, 0x04, 0x01, 0xc1, 0x3d, 0x93, 0xff, 0x09,
-	0xc0, 0x04, 0xdd, 0xe0, 0x56, 0x94, 0x5d, 0x3c, 0x0d, 0x23, 0xea, 0x46, 0x06, 0xcc, 0x81, 0x85,
-	0x33, 0x4c, 0xc2, 0x3a, 0xcc, 0x45, 0xb8, 0x8a, 0x10, 0x51, 0x37, 0x30, 0x82, 0xd1, 0x2d, 0x65,
-	0x37, 0x13, 0xe9, 0x07, 0x84, 0xfe, 0x12, 0xcf, 0xc0, 0x98, 0x26, 0x3f, 0x51, 0x32, 0x51, 0x9b,
-	0x4d, 0x1e, 0x33, 0x31, 0x23, 0x92, 0x59, 0x14, 0xec, 0x4d, 0x9f, 0x9e, 0xd8, 0x7f, 0xd0, 0xc3,
-	0xc1, 0xa4, 0x23, 0xa7, 0x83, 0xbf, 0x81, 0x29, 0xb9, 0xad, 0x36, 0xb7, 0x94, 0x5d, 0xc7, 0xda,
-	0xc4, 0xe2, 0x74, 0x38, 0x95, 0x83, 0x32, 0x13, 0xd6, 0x61, 0x6e, 0x70, 0x4c, 0x48, 0xca, 0x6d,
-	0xb5, 0xbf, 0xc8, 0xff, 0x05, 0xe0, 0xd9, 0xb2, 0xbe, 0xbd, 0x2d, 0xaf, 0xe9, 0x86, 0x6c, 0xea,
-	0x06, 0xfe, 0x16, 0x46, 0xd5, 0x8d, 0x8e, 0xed, 0x70, 0x62, 0xb1, 0x10, 0xce, 0x55, 0x37, 0x5a,
-	0xb2, 0xa6, 0x7e, 0x2f, 0x9b, 0xaa, 0xae, 0xd5, 0x8d, 0xd5, 0x8e, 0x62, 0x70, 0x5e, 0xd5, 0x19,
-	0x74, 0xcc, 0xc4, 0x7f, 0xec, 0x2b, 0xdd, 0x3f, 0xcc, 0x51, 0x07, 0x87, 0x39, 0x20, 0xf4, 0x73,
-	0xf9, 0xcc, 0x46, 0xfe, 0xbd, 0xd9, 0xe5, 0x58, 0x2a, 0x8a, 0x62, 0xcb, 0xb1, 0x54, 0x0c, 0xc5,
-	0x97, 0x63, 0xa9, 0x38, 0x4a, 0x2c, 0xc7, 0x52, 0x09, 0x94, 0xcc, 0xff, 0x09, 0xe0, 0xa5, 0xaa,
-	0x62, 0xfa, 0xc5, 0x0b, 0x4a, 0xa7, 0xad, 0x6b, 0x1d, 0x05, 0x73, 0xff, 0xc3, 0x44, 0x2a, 0x28,
-	0xbe, 0xf0, 0x8f, 0xc4, 0x9f, 0xaa, 0x56, 0x84, 0x69, 0xbf, 0xd2, 0x0e, 0x66, 0x60, 0x7a, 0xdd,
-	0xbf, 0xe1, 0xde, 0xde, 0x6c, 0x38, 0x7d, 0xc0, 0x5f, 0xf0, 0xc8, 0xad, 0xa3, 0x49, 0x18, 0xb7,
-	0x3f, 0x8f, 0xcf, 0xc3, 0xb4, 0x2d, 0xa0, 0xa9, 0x6a, 0xf6, 0x5c, 0x21, 0x0a, 0x5f, 0x80, 0xe7,
-	0x04, 0xae, 0x7a, 0x57, 0x6a, 0xae, 0x8a, 0xac, 0xd0, 0xe4, 0x6a, 0x4b, 0x75, 0x04, 0xf0, 0x15,
-	0x78, 0xd9, 0xb7, 0x29, 0xb2, 0x92, 0xc4, 0xd5, 0xaa, 0x62, 0x93, 0xa1, 0x45, 0xae, 0x8c, 0x22,
-	0x78, 0x0e, 0xce, 0x8e, 0x0a, 0xd3, 0x0d, 0xae, 0xb9, 0xc2, 0x3e, 0x14, 0x51, 0x14, 0x4f, 0xc1,
-	0xf3, 0x3e, 0xa2, 0xc2, 0xf2, 0xac, 0xc4, 0xa2, 0x18, 0xbe, 0x0a, 0xaf, 0xf8, 0xb6, 0xe9, 0x55,
-	0xe9, 0x6e, 0x5d, 0xe0, 0x1e, 0xb1, 0x95, 0x66, 0x99, 0xe7, 0xd8, 0x9a, 0x24, 0xa2, 0x78, 0x28,
-	0x37, 0xdd, 0x68, 0xf0, 0x5c, 0x99, 0x96, 0xb8, 0x7a, 0x4d, 0x6c, 0xf2, 0x9c, 0x28, 0xa1, 0x04,
-	0xce, 0x43, 0x32, 0x8e, 0x28, 0x0b, 0x2c, 0x2d, 0xb1, 0x28, 0x89, 0x67, 0x61, 0xc6, 0xc7, 0x54,
-	0x69, 0x89, 0x7d, 0x40, 0x3f, 0x74, 0x33, 0xa4, 0x30, 0x81, 0xd9, 0x51, 0x51, 0xf7, 0xf4, 0x19,
-	0x3c, 0x03, 0x2f, 0xf9, 0xe2, 0xae, 0x36, 0xe7, 0x30, 0x0c, 0xd5, 0x66, 0x10, 0x74, 0xcf, 0x4e,
-	0x84, 0x2c, 0xd6, 0x85, 0x2a, 0x5d, 0xe3, 0x1e, 0xf9, 0x0d, 0x9c, 0xc5, 0xf3, 0x30, 0x37, 0x16,
-	0x71, 0xf3, 0xa4, 0x31, 0x86, 0x93, 0x7e, 0x97, 0x3c, 0x8f, 0x26, 0x71, 0x16, 0x4e, 0x3b, 0x7b,
-	0x3e, 0xd3, 0xce, 0x95, 0x9d, 0xc3, 0xd7, 0xe0, 0xdc, 0x70, 0x2c, 0x74, 0x73, 0x08, 0xdf, 0x84,
-	0xf3, 0x1f, 0xa0, 0x4e, 0x2e, 0xf0, 0x3c, 0xbe, 0x0d, 0x17, 0x3e, 0x00, 0x96, 0xeb, 0x3c, 0x4f,
-	0x33, 0x75, 0x81, 0x96, 0xea, 0x82, 0x88, 0xf0, 0x29, 0x69, 0x1b, 0x74, 0x79, 0x85, 0xae, 0xb2,
-	0x22, 0xfa, 0xca, 0xbb, 0x17, 0x3f, 0xe8, 0xb6, 0xc7, 0x05, 0xef, 0x66, 0x83, 0xd1, 0xfb, 0x5c,
-	0x99, 0x15, 0x9b, 0x02, 0x4b, 0x57, 0xd0, 0x45, 0xaf, 0x78, 0xa3, 0x98, 0x07, 0x02, 0x27, 0xb1,
-	0x68, 0x6a, 0xb4, 0x1e, 0x7f, 0x22, 0xc7, 0xe6, 0x34, 0x5e, 0x80, 0xd7, 0x4e, 0xc9, 0xe6, 0x90,
-	0x97, 0x46, 0x6b, 0x93, 0x04, 0x7a, 0x69, 0x89, 0x2b, 0x3b, 0xda, 0x32, 0xf8, 0x06, 0xcc, 0x8f,
-	0x67, 0x56, 0x1b, 0xae, 0xbc, 0xcb, 0xa3, 0xbf, 0x3a, 0xe0, 0x2a, 0xf5, 0x07, 0x35, 0x97, 0xcc,
-	0x8e, 0xbe, 0x71, 0x9e, 0xab, 0xad, 0xa0, 0x19, 0x7c, 0x19, 0x4e, 0x0d, 0xc7, 0xfa, 0x8d, 0x32,
-	0x8b, 0x2f, 0x42, 0xe4, 0x84, 0x9c, 0xf6, 0xb4, 0x77, 0xaf, 0xe0, 0x69, 0x88, 0x9d, 0x5d, 0xb7,
-	0xe3, 0x9d, 0xd6, 0x21, 0xde, 0xc8, 0x0d, 0xf6, 0x43, 0x6d, 0x93, 0xf3, 0x8a, 0x3e, 0x44, 0x9c,
-	0xb4, 0xcc, 0x9c, 0xe7, 0x6a, 0x08, 0x0a, 0xb6, 0xcb, 0x55, 0x9c, 0x81, 0x17, 0x83, 0xa4, 0xdb,
-	0x01, 0x79, 0x6f, 0x32, 0x07, 0x91, 0x40, 0x85, 0xe7, 0xbd, 0x2e, 0x0f, 0xc7, 0x7d, 0x55, 0xbb,
-	0x36, 0x6c, 0xd4, 0xae, 0xd8, 0x75, 0x6f, 0x74, 0x4f, 0x14, 0x4a, 0xb4, 0xb4, 0xea, 0xb6, 0xd6,
-	0x0d, 0x9c, 0x83, 0x33, 0xa1, 0x63, 0x75, 0xb7, 0xaa, 0x36, 0x70, 0xd3, 0x7b, 0xd5, 0x06, 0x40,
-	0xbf, 0xae, 0x0b, 0xde, 0x73, 0xe1, 0x1f, 0x65, 0xa7, 0xb8, 0x1f, 0xe1, 0xeb, 0xf0, 0xea, 0x88,
-	0x60, 0xa8, 0xc2, 0xb7, 0xbc, 0xe2, 0x8d, 0xc6, 0x4e, 0xca, 0xfc, 0xb1, 0xd7, 0xdb, 0xa3, 0xc9,
-	0x7b, 0xec, 0x3d, 0x86, 0x15, 0x44, 0x74, 0xdb, 0x73, 0x1b, 0x00, 0xdd, 0x52, 0x17, 0xc6, 0x7c,
-	0x71, 0xf8, 0xc1, 0x2d, 0xe2, 0x5b, 0xf0, 0xc6, 0x69, 0xa4, 0xfb, 0x6c, 0x95, 0xbc, 0x0b, 0x0a,
-	0xb0, 0xc1, 0x07, 0xf8, 0x13, 0x6f, 0x50, 0x46, 0x53, 0x6e, 0xb6, 0x4f, 0xbd, 0xbe, 0x0b, 0x70,
-	0x81, 0x07, 0x79, 0x71, 0x4c, 0x85, 0x43, 0x0f, 0xf3, 0x9d, 0x71, 0x2e, 0x2a, 0x95, 0x26, 0x1d,
-	0xec, 0x50, 0xf4, 0x99, 0x37, 0x76, 0x41, 0x96, 0xe7, 0xd1, 0xe7, 0x5e, 0x73, 0x89, 0x6c, 0xad,
-	0xd2, 0xe4, 0x6a, 0xf7, 0x39, 0x89, 0x15, 0xd1, 0x17, 0x38, 0x0d, 0xcf, 0xb8, 0xe3, 0xc8, 0xf3,
-	0xe8, 0xcb, 0x6c, 0x6c, 0xef, 0x0f, 0x42, 0x31, 0xbf, 0x83, 0xfd, 0x2e, 0x01, 0x07, 0x5d, 0x02,
-	0xde, 0x74, 0x09, 0xf5, 0xb6, 0x4b, 0xa8, 0x77, 0x5d, 0x42, 0xbd, 0xef, 0x12, 0xea, 0xa8, 0x4b,
-	0xc0, 0x73, 0x8b, 0x80, 0x3d, 0x8b, 0x50, 0x2f, 0x2c, 0x02, 0x5e, 0x5a, 0x84, 0x7a, 0x65, 0x11,
-	0xea, 0xb5, 0x45, 0xa8, 0x7d, 0x8b, 0x80, 0x03, 0x8b, 0x80, 0x37, 0x16, 0xa1, 0xde, 0x5a, 0x04,
-	0xbc, 0xb3, 0x08, 0xf5, 0xde, 0x22, 0xe0, 0xc8, 0x22, 0xd4, 0xf3, 0x1e, 0xa1, 0xf6, 0x7a, 0x04,
-	0xfc, 0xdc, 0x23, 0xd4, 0xaf, 0x3d, 0x02, 0x7e, 0xeb, 0x11, 0xea, 0x45, 0x8f, 0x50, 0x2f, 0x7b,
-	0x04, 0xbc, 0xea, 0x11, 0xf0, 0xba, 0x47, 0xc0, 0xa3, 0xdb, 0x2d, 0xbd, 0x68, 0x6e, 0x2a, 0xe6,
-	0xa6, 0xaa, 0xb5, 0x3a, 0x45, 0x4d, 0x31, 0x9f, 0xe9, 0xc6, 0x56, 0x29, 0xf8, 0x5f, 0xb8, 0xbd,
-	0xd5, 0x2a, 0x99, 0xa6, 0xd6, 0x5e, 0x5b, 0x4b, 0xd8, 0x7f, 0x83, 0xef, 0xfc, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0xe6, 0x94, 0x91, 0x73, 0xcf, 0x0b, 0x00, 0x00,
+	// 1314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x3b, 0x70, 0xd3, 0x58,
+	0x17, 0xc7, 0x75, 0xfd, 0x8a, 0x73, 0x13, 0x87, 0xcb, 0x85, 0x04, 0xe3, 0x84, 0x6b, 0xe3, 0xf0,
+	0xf0, 0x07, 0xd8, 0xfe, 0xbe, 0xf0, 0xed, 0xa3, 0xd8, 0xd9, 0x1d, 0xc9, 0x56, 0x82, 0x12, 0x63,
+	0x67, 0x25, 0x05, 0x06, 0x1a, 0x8d, 0x12, 0x0b, 0x47, 0x13, 0x47, 0xf2, 0x48, 0xe2, 0x91, 0xad,
+	0x98, 0xad, 0x98, 0xad, 0x18, 0xb6, 0xd9, 0x72, 0x67, 0xb7, 0x61, 0x66, 0x1b, 0xba, 0xa5, 0xa4,
+	0xa4, 0xa4, 0xa4, 0xca, 0x62, 0xb9, 0xa1, 0xa4, 0x64, 0x52, 0xed, 0x58, 0x92, 0x23, 0xc9, 0x76,
+	0xc8, 0x32, 0xdb, 0x5d, 0x9f, 0xf3, 0x3b, 0x47, 0xe7, 0xfc, 0xcf, 0xb9, 0x77, 0x0c, 0x49, 0x5b,
+	0x37, 0xe4, 0x87, 0xb2, 0x56, 0x34, 0x2d, 0x79, 0x6b, 0xa7, 0x2c, 0x77, 0xd4, 0xb2, 0xa1, 0xb6,
+	0xb6, 0x2d, 0xb3, 0xd4, 0x31, 0x74, 0x4b, 0xc7, 0x33, 0x96, 0xa5, 0x95, 0x3c, 0xa6, 0xf4, 0xe0,
+	0x7a, 0x86, 0x6e, 0xa9, 0xd6, 0xf6, 0xfd, 0xcd, 0xd2, 0x96, 0xbe, 0x5b, 0x56, 0xb4, 0x07, 0xfa,
+	0x5e, 0xc7, 0xd0, 0x1f, 0xed, 0x95, 0x1d, 0x78, 0xab, 0xd8, 0x52, 0xb4, 0xe2, 0x03, 0xb9, 0xad,
+	0x36, 0x65, 0x4b, 0x29, 0x8f, 0x1c, 0xdc, 0x94, 0x99, 0x62, 0x20, 0x45, 0x4b, 0x6f, 0xe9, 0x6e,
+	0xf0, 0xe6, 0xfd, 0x7b, 0xce, 0x2f, 0xe7, 0x87, 0x73, 0xf2, 0xf0, 0x6c, 0x4b, 0xd7, 0x5b, 0x6d,
+	0xc5, 0xa7, 0x2c, 0x75, 0x57, 0x31, 0x2d, 0x79, 0xb7, 0xe3, 0x01, 0x8b, 0xa3, 0x2d, 0xa8, 0x4d,
+	0x45, 0xb3, 0xd4, 0x7b, 0xaa, 0x62, 0x78, 0x7d, 0xe4, 0x97, 0x61, 0x82, 0x77, 0xfa, 0xc2, 0xdf,
+	0xc0, 0x84, 0xdb, 0x61, 0x1a, 0xe4, 0xa2, 0x85, 0x99, 0xa5, 0xd9, 0x52, 0xb8, 0xc5, 0x92, 0xc3,
+	0x31, 0xa9, 0x03, 0x06, 0x3e, 0x03, 0x13, 0xf9, 0xf8, 0x8f, 0x20, 0x82, 0x00, 0xef, 0xc5, 0xe4,
+	0x7f, 0x8e, 0xc0, 0x04, 0xbd, 0xce, 0xad, 0x29, 0x7b, 0x78, 0x0e, 0x46, 0xd4, 0x66, 0x1a, 0xe4,
+	0x40, 0x61, 0x92, 0x49, 0xd8, 0xfb, 0xd9, 0x08, 0x57, 0xe5, 0x23, 0x6a, 0x13, 0x23, 0x18, 0xdd,
+	0x51, 0xf6, 0xd2, 0x91, 0xbe, 0x83, 0xef, 0x1f, 0xf1, 0x3c, 0x8c, 0x69, 0xf2, 0xae, 0x92, 0x8e,
+	0x3a, 0xec, 0xc4, 0x01, 0x13, 0x33, 0x22, 0xe9, 0x25, 0xde, 0x31, 0x06, 0xea, 0x89, 0x7d, 0x7e,
+	0x3d, 0xf8, 0x3b, 0x08, 0x95, 0x47, 0x1d, 0xd5, 0x50, 0x4c, 0x49, 0xb6, 0xd2, 0xf1, 0x1c, 0x28,
+	0x4c, 0x2d, 0x65, 0x4a, 0xae, 0x64, 0xa5, 0x81, 0x64, 0x25, 0x71, 0x20, 0x19, 0x13, 0x7b, 0xfa,
+	0x57, 0x16, 0xf0, 0x93, 0x5e, 0x0c, 0x6d, 0xe1, 0x0a, 0x4c, 0xc9, 0xed, 0xb6, 0xfe, 0x50, 0x69,
+	0x4a, 0x5b, 0x6a, 0xd3, 0x30, 0xd3, 0x89, 0x5c, 0xb4, 0x30, 0xc9, 0x90, 0x03, 0x66, 0xea, 0x19,
+	0x48, 0x22, 0x94, 0xef, 0xd7, 0x7a, 0xd5, 0xde, 0xcf, 0x4e, 0xd3, 0x2e, 0x56, 0xe1, 0xaa, 0xbc,
+	0xc9, 0x4f, 0x7b, 0x41, 0x95, 0x7e, 0x4c, 0x9e, 0x83, 0x13, 0xae, 0x28, 0x26, 0xfe, 0x16, 0x26,
+	0xe5, 0x8e, 0x2a, 0xed, 0x28, 0x7b, 0xae, 0xc0, 0x53, 0x4b, 0x73, 0xc3, 0x0d, 0xb9, 0x28, 0x33,
+	0x65, 0xef, 0x67, 0x07, 0x61, 0xfc, 0x84, 0xdc, 0x51, 0xfb, 0x87, 0xfc, 0x9f, 0x00, 0x4e, 0x57,
+	0xf4, 0x76, 0x5b, 0xde, 0xd4, 0x0d, 0xd9, 0xd2, 0x0d, 0xfc, 0x3d, 0x8c, 0xaa, 0x4d, 0xd3, 0xd1,
+	0x79, 0x6a, 0xa9, 0x38, 0x9c, 0xab, 0x61, 0xb4, 0x64, 0x4d, 0xfd, 0x41, 0xb6, 0x54, 0x5d, 0x6b,
+	0x18, 0x1b, 0xa6, 0x62, 0x70, 0xfe, 0xec, 0x19, 0x74, 0xc0, 0xc4, 0x7f, 0xea, 0xeb, 0xf5, 0x7a,
+	0x3f, 0x4b, 0xbd, 0xd9, 0xcf, 0x02, 0xbe, 0x9f, 0x2b, 0x20, 0x79, 0xe4, 0xf3, 0x25, 0x5f, 0x8d,
+	0x25, 0xa3, 0x28, 0xb6, 0x1a, 0x4b, 0xc6, 0x50, 0x7c, 0x35, 0x96, 0x8c, 0xa3, 0xc4, 0x6a, 0x2c,
+	0x99, 0x40, 0x13, 0xf9, 0x3f, 0x00, 0x3c, 0xb3, 0xa2, 0x58, 0xc1, 0xe2, 0x79, 0xc5, 0xec, 0xe8,
+	0x9a, 0xa9, 0x60, 0xee, 0x5f, 0x34, 0x91, 0x0c, 0x17, 0x5f, 0xfc, 0x47, 0xc5, 0x1f, 0x5b, 0xad,
+	0x00, 0x53, 0xc1, 0x4a, 0x4d, 0xcc, 0xc0, 0xd4, 0x56, 0xd0, 0xe0, 0x4d, 0x6f, 0x61, 0x38, 0x7d,
+	0xa8, 0xbf, 0x70, 0xc8, 0x95, 0x8f, 0x33, 0x30, 0xee, 0x7c, 0x1e, 0x9f, 0x84, 0x29, 0xa7, 0x00,
+	0x49, 0xd5, 0x9c, 0xeb, 0x8f, 0x28, 0x7c, 0x0a, 0x9e, 0xe0, 0xb9, 0x95, 0x1b, 0xa2, 0xb4, 0x21,
+	0xb0, 0xbc, 0xc4, 0xd5, 0x97, 0x1b, 0x08, 0xe0, 0x73, 0xf0, 0x6c, 0xc0, 0x28, 0xb0, 0xa2, 0xc8,
+	0xd5, 0x57, 0x04, 0x89, 0xa1, 0x05, 0xae, 0x82, 0x22, 0x38, 0x07, 0x17, 0xc6, 0xb9, 0xe9, 0x75,
+	0x4e, 0x5a, 0x63, 0xef, 0x08, 0x28, 0x8a, 0x67, 0xe1, 0xc9, 0x00, 0x51, 0x65, 0x6b, 0xac, 0xc8,
+	0xa2, 0x18, 0x3e, 0x0f, 0xcf, 0x05, 0xcc, 0xf4, 0x86, 0x78, 0xa3, 0xc1, 0x73, 0x77, 0xd9, 0xaa,
+	0x54, 0xa9, 0x71, 0x6c, 0x5d, 0x14, 0x50, 0x7c, 0x28, 0x37, 0xbd, 0xbe, 0x5e, 0xe3, 0x2a, 0xb4,
+	0xc8, 0x35, 0xea, 0x82, 0x54, 0xe3, 0x04, 0x11, 0x25, 0x70, 0x1e, 0x92, 0xa3, 0x88, 0x0a, 0xcf,
+	0xd2, 0x22, 0x8b, 0x26, 0xf0, 0x02, 0x4c, 0x07, 0x98, 0x15, 0x5a, 0x64, 0x6f, 0xd3, 0x77, 0xbc,
+	0x0c, 0x49, 0x4c, 0x60, 0x66, 0x9c, 0xd7, 0x8b, 0x9e, 0xc4, 0xf3, 0xf0, 0x4c, 0xc0, 0xef, 0xd5,
+	0xe6, 0x06, 0xc3, 0x21, 0x6d, 0x06, 0x4e, 0x2f, 0x76, 0x6a, 0xa8, 0xc5, 0x06, 0xbf, 0x42, 0xd7,
+	0xb9, 0xbb, 0xc1, 0x06, 0xa6, 0xf1, 0x22, 0xcc, 0x1e, 0x89, 0x78, 0x79, 0x52, 0x18, 0xc3, 0x99,
+	0x60, 0x97, 0xb5, 0x1a, 0x9a, 0xc1, 0x19, 0x38, 0xe7, 0xda, 0x02, 0x4d, 0xbb, 0x23, 0x3b, 0x81,
+	0x2f, 0xc0, 0xdc, 0xa8, 0x6f, 0x68, 0x72, 0x08, 0x5f, 0x86, 0x8b, 0x9f, 0xa0, 0x0e, 0x07, 0x78,
+	0x12, 0x5f, 0x83, 0x85, 0x4f, 0x80, 0x95, 0x46, 0xad, 0x46, 0x33, 0x0d, 0x9e, 0x16, 0x1b, 0xbc,
+	0x80, 0xf0, 0x31, 0x69, 0xd7, 0xe9, 0xca, 0x1a, 0xbd, 0xc2, 0x0a, 0xe8, 0x6b, 0x7f, 0x2e, 0x41,
+	0xd0, 0x5b, 0x8f, 0x53, 0xfe, 0x64, 0xc3, 0xde, 0x5b, 0x5c, 0x85, 0x15, 0x24, 0x9e, 0xa5, 0xab,
+	0xe8, 0xb4, 0x2f, 0xde, 0x38, 0xe6, 0x36, 0xcf, 0x89, 0x2c, 0x9a, 0x1d, 0x5f, 0x4f, 0x30, 0x91,
+	0xdb, 0xe6, 0x1c, 0x2e, 0xc0, 0x0b, 0xc7, 0x64, 0x73, 0xc9, 0x33, 0xe3, 0x6b, 0x13, 0x79, 0x7a,
+	0x79, 0x99, 0xab, 0xb8, 0xb5, 0xa5, 0xf1, 0x25, 0x98, 0x3f, 0x9a, 0xd9, 0x58, 0xf7, 0xca, 0x3b,
+	0x3b, 0xfe, 0xab, 0x03, 0xae, 0xda, 0xb8, 0x5d, 0xf7, 0xc8, 0xcc, 0xf8, 0x89, 0xd7, 0xb8, 0xfa,
+	0x1a, 0x9a, 0xc7, 0x67, 0xe1, 0xec, 0xa8, 0xaf, 0xbf, 0x28, 0x0b, 0xf8, 0x34, 0x44, 0xae, 0xcb,
+	0x5d, 0x4f, 0xc7, 0x7a, 0x0e, 0xcf, 0x41, 0xec, 0x5a, 0xbd, 0x8d, 0x77, 0x57, 0x87, 0xf8, 0x57,
+	0x6e, 0x60, 0x1f, 0x5a, 0x9b, 0xac, 0x2f, 0xfa, 0x08, 0x71, 0xb8, 0x32, 0x39, 0xbf, 0xab, 0x11,
+	0x28, 0xbc, 0x2e, 0xe7, 0x71, 0x1a, 0x9e, 0x0e, 0x93, 0xde, 0x06, 0xe4, 0xfd, 0x9b, 0x39, 0xf0,
+	0x84, 0x14, 0x5e, 0xf4, 0xb7, 0x7c, 0xd8, 0x1f, 0x50, 0xed, 0xc2, 0x68, 0xa3, 0x8e, 0x62, 0x17,
+	0xfd, 0xab, 0x7b, 0x58, 0xa1, 0x48, 0x8b, 0x1b, 0xde, 0x6a, 0x5d, 0xc2, 0x59, 0x38, 0x3f, 0x14,
+	0xd6, 0xf0, 0x54, 0x75, 0x80, 0xcb, 0xfe, 0xab, 0x36, 0x00, 0xfa, 0xba, 0x16, 0xfc, 0xe7, 0x22,
+	0x78, 0x95, 0x5d, 0x71, 0xff, 0x83, 0x2f, 0xc2, 0xf3, 0x63, 0x9c, 0x43, 0x0a, 0x5f, 0xf1, 0xc5,
+	0x1b, 0x8f, 0x1d, 0xca, 0x7c, 0xd5, 0xdf, 0xed, 0xf1, 0xe4, 0x4d, 0xf6, 0x26, 0xc3, 0xf2, 0x02,
+	0xba, 0xe6, 0x77, 0x1b, 0x02, 0x3d, 0xa9, 0x8b, 0x47, 0x7c, 0x71, 0xf4, 0xc1, 0x2d, 0xe1, 0x2b,
+	0xf0, 0xd2, 0x71, 0xa4, 0xf7, 0x6c, 0x95, 0xfd, 0x01, 0x85, 0xd8, 0xf0, 0x03, 0xfc, 0x5f, 0xff,
+	0xa2, 0x8c, 0xa7, 0xbc, 0x6c, 0xff, 0xf3, 0xf7, 0x2e, 0xc4, 0x85, 0x1e, 0xe4, 0xa5, 0x23, 0x14,
+	0x1e, 0x7a, 0x98, 0xaf, 0x1f, 0xd5, 0x45, 0xb5, 0x2a, 0xd1, 0xe1, 0x0d, 0x45, 0xff, 0xf7, 0xaf,
+	0x5d, 0x98, 0xad, 0xd5, 0xd0, 0x17, 0xfe, 0x72, 0x09, 0x6c, 0xbd, 0x2a, 0x71, 0xf5, 0x5b, 0x9c,
+	0xc8, 0x0a, 0xe8, 0x4b, 0x9c, 0x82, 0x93, 0xae, 0xbd, 0x8f, 0x7d, 0x95, 0x89, 0x3d, 0xf9, 0x9d,
+	0x50, 0xcc, 0x6f, 0xe0, 0x75, 0x97, 0x80, 0x37, 0x5d, 0x02, 0xde, 0x76, 0x09, 0xf5, 0xae, 0x4b,
+	0xa8, 0xf7, 0x5d, 0x42, 0x7d, 0xe8, 0x12, 0xea, 0x63, 0x97, 0x80, 0xc7, 0x36, 0x01, 0x4f, 0x6c,
+	0x42, 0x3d, 0xb7, 0x09, 0x78, 0x61, 0x13, 0xea, 0xa5, 0x4d, 0xa8, 0x57, 0x36, 0xa1, 0x5e, 0xdb,
+	0x04, 0xbc, 0xb1, 0x09, 0x78, 0x6b, 0x13, 0xea, 0x9d, 0x4d, 0xc0, 0x7b, 0x9b, 0x50, 0x1f, 0x6c,
+	0x02, 0x3e, 0xda, 0x84, 0x7a, 0xdc, 0x23, 0xd4, 0x93, 0x1e, 0x01, 0x4f, 0x7b, 0x84, 0xfa, 0xa5,
+	0x47, 0xc0, 0xaf, 0x3d, 0x42, 0x3d, 0xef, 0x11, 0xea, 0x45, 0x8f, 0x80, 0x97, 0x3d, 0x02, 0x5e,
+	0xf5, 0x08, 0xb8, 0x7b, 0xad, 0xa5, 0x97, 0xac, 0x6d, 0xc5, 0xda, 0x56, 0xb5, 0x96, 0x59, 0xd2,
+	0x14, 0xeb, 0xa1, 0x6e, 0xec, 0x94, 0xc3, 0xff, 0xc8, 0x3b, 0x3b, 0xad, 0xb2, 0x65, 0x69, 0x9d,
+	0xcd, 0xcd, 0x84, 0xf3, 0x8f, 0xf4, 0xfa, 0xdf, 0x03, 0x00, 0xe7, 0x5d, 0xbb, 0xe3, 0x76, 0x0c,
+	0x00, 0x00,
 }
 
 func (x Right) String() string {
@@ -757,6 +788,21 @@ func (this *APIKey) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *APIKeys) Equal(that interface{}) bool {
@@ -942,21 +988,40 @@ func (m *APIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedCIDRs) > 0 {
+		for iNdEx := len(m.AllowedCIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCIDRs[iNdEx])
+			copy(dAtA[i:], m.AllowedCIDRs[iNdEx])
+			i = encodeVarintRights(dAtA, i, uint64(len(m.AllowedCIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExpiresAt != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintRights(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Rights) > 0 {
-		dAtA4 := make([]byte, len(m.Rights)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.Rights)*10)
+		var j4 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintRights(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintRights(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1042,20 +1107,20 @@ func (m *Collaborator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Rights) > 0 {
-		dAtA6 := make([]byte, len(m.Rights)*10)
-		var j5 int
+		dAtA7 := make([]byte, len(m.Rights)*10)
+		var j6 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j5++
+				j6++
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintRights(dAtA, i, uint64(j5))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintRights(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1093,20 +1158,20 @@ func (m *GetCollaboratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if len(m.Rights) > 0 {
-		dAtA9 := make([]byte, len(m.Rights)*10)
-		var j8 int
+		dAtA10 := make([]byte, len(m.Rights)*10)
+		var j9 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j9++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintRights(dAtA, i, uint64(j8))
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintRights(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1193,6 +1258,14 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 	for i := 0; i < v2; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v3 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.AllowedCIDRs[i] = randStringRights(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1201,9 +1274,9 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 func NewPopulatedAPIKeys(r randyRights, easy bool) *APIKeys {
 	this := &APIKeys{}
 	if r.Intn(5) != 0 {
-		v3 := r.Intn(5)
-		this.APIKeys = make([]*APIKey, v3)
-		for i := 0; i < v3; i++ {
+		v4 := r.Intn(5)
+		this.APIKeys = make([]*APIKey, v4)
+		for i := 0; i < v4; i++ {
 			this.APIKeys[i] = NewPopulatedAPIKey(r, easy)
 		}
 	}
@@ -1214,11 +1287,11 @@ func NewPopulatedAPIKeys(r randyRights, easy bool) *APIKeys {
 
 func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 	this := &Collaborator{}
-	v4 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v4
-	v5 := r.Intn(10)
-	this.Rights = make([]Right, v5)
-	for i := 0; i < v5; i++ {
+	v5 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v5
+	v6 := r.Intn(10)
+	this.Rights = make([]Right, v6)
+	for i := 0; i < v6; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -1228,11 +1301,11 @@ func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 
 func NewPopulatedGetCollaboratorResponse(r randyRights, easy bool) *GetCollaboratorResponse {
 	this := &GetCollaboratorResponse{}
-	v6 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v6
-	v7 := r.Intn(10)
-	this.Rights = make([]Right, v7)
-	for i := 0; i < v7; i++ {
+	v7 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v7
+	v8 := r.Intn(10)
+	this.Rights = make([]Right, v8)
+	for i := 0; i < v8; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -1243,9 +1316,9 @@ func NewPopulatedGetCollaboratorResponse(r randyRights, easy bool) *GetCollabora
 func NewPopulatedCollaborators(r randyRights, easy bool) *Collaborators {
 	this := &Collaborators{}
 	if r.Intn(5) != 0 {
-		v8 := r.Intn(5)
-		this.Collaborators = make([]*Collaborator, v8)
-		for i := 0; i < v8; i++ {
+		v9 := r.Intn(5)
+		this.Collaborators = make([]*Collaborator, v9)
+		for i := 0; i < v9; i++ {
 			this.Collaborators[i] = NewPopulatedCollaborator(r, easy)
 		}
 	}
@@ -1273,9 +1346,9 @@ func randUTF8RuneRights(r randyRights) rune {
 	return rune(ru + 61)
 }
 func randStringRights(r randyRights) string {
-	v9 := r.Intn(100)
-	tmps := make([]rune, v9)
-	for i := 0; i < v9; i++ {
+	v10 := r.Intn(100)
+	tmps := make([]rune, v10)
+	for i := 0; i < v10; i++ {
 		tmps[i] = randUTF8RuneRights(r)
 	}
 	return string(tmps)
@@ -1297,11 +1370,11 @@ func randFieldRights(dAtA []byte, r randyRights, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRights(dAtA, uint64(key))
-		v10 := r.Int63()
+		v11 := r.Int63()
 		if r.Intn(2) == 0 {
-			v10 *= -1
+			v11 *= -1
 		}
-		dAtA = encodeVarintPopulateRights(dAtA, uint64(v10))
+		dAtA = encodeVarintPopulateRights(dAtA, uint64(v11))
 	case 1:
 		dAtA = encodeVarintPopulateRights(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1367,6 +1440,16 @@ func (m *APIKey) Size() (n int) {
 		}
 		n += 1 + sovRights(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovRights(uint64(l))
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovRights(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1461,6 +1544,8 @@ func (this *APIKey) String() string {
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedCIDRs:` + fmt.Sprintf("%v", this.AllowedCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1841,6 +1926,74 @@ func (m *APIKey) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRights(dAtA[iNdEx:])
@@ -10,6 +10,8 @@ var RightsFieldPathsTopLevel = []string{
 	"rights",
 }
 var APIKeyFieldPathsNested = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"id",
 	"key",
 	"name",
@@ -17,6 +19,8 @@ var APIKeyFieldPathsNested = []string{
 }
 
 var APIKeyFieldPathsTopLevel = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"id",
 	"key",
 	"name",
@@ -66,6 +66,24 @@ func (dst *APIKey) SetFields(src *APIKey, paths ...string) error {
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_cidrs":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_cidrs' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedCIDRs = src.AllowedCIDRs
+			} else {
+				dst.AllowedCIDRs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -170,6 +170,32 @@ func (m *APIKey) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return APIKeyValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_cidrs":
+
+			for idx, item := range m.GetAllowedCIDRs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 43 {
+					return APIKeyValidationError{
+						field:  fmt.Sprintf("allowed_cidrs[%v]", idx),
+						reason: "value length must be at most 43 runes",
+					}
+				}
+
+			}
+
 		default:
 			return APIKeyValidationError{
 				field:  name,
@@ -4,6 +4,7 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -62,8 +63,28 @@ type User struct {
 	TemporaryPasswordCreatedAt *time.Time `protobuf:"bytes,16,opt,name=temporary_password_created_at,json=temporaryPasswordCreatedAt,proto3,stdtime" json:"temporary_password_created_at,omitempty"`
 	TemporaryPasswordExpiresAt *time.Time `protobuf:"bytes,17,opt,name=temporary_password_expires_at,json=temporaryPasswordExpiresAt,proto3,stdtime" json:"temporary_password_expires_at,omitempty"`
 	ProfilePicture             *Picture   `protobuf:"bytes,18,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}   `json:"-"`
-	XXX_sizecache              int32      `json:"-"`
+	// The TOTP secret for two-factor authentication; never returned on API calls.
+	TOTPSecret string `protobuf:"bytes,19,opt,name=totp_secret,json=totpSecret,proto3" json:"totp_secret,omitempty"`
+	// Time when two-factor authentication with TOTP was enabled.
+	// If not set, two-factor authentication is not enabled.
+	// This field can only be modified with the TOTP methods of the UserRegistry.
+	TOTPEnabledAt *time.Time `protobuf:"bytes,20,opt,name=totp_enabled_at,json=totpEnabledAt,proto3,stdtime" json:"totp_enabled_at,omitempty"`
+	// The hashed recovery codes for two-factor authentication; never returned on API calls.
+	TOTPRecoveryCodes []string `protobuf:"bytes,21,rep,name=totp_recovery_codes,json=totpRecoveryCodes,proto3" json:"totp_recovery_codes,omitempty"`
+	// The time step of the last accepted TOTP code. Codes of this or earlier time steps are rejected,
+	// so that codes can only be used once; never returned on API calls.
+	TOTPLastTimeStep uint64 `protobuf:"varint,22,opt,name=totp_last_time_step,json=totpLastTimeStep,proto3" json:"totp_last_time_step,omitempty"`
+	// The number of consecutive incorrect TOTP codes; never returned on API calls.
+	TOTPFailedAttempts uint32 `protobuf:"varint,23,opt,name=totp_failed_attempts,json=totpFailedAttempts,proto3" json:"totp_failed_attempts,omitempty"`
+	// Time until which TOTP codes are rejected after too many incorrect codes; never returned on API calls.
+	TOTPLockedUntil *time.Time `protobuf:"bytes,24,opt,name=totp_locked_until,json=totpLockedUntil,proto3,stdtime" json:"totp_locked_until,omitempty"`
+	// The TOTP secret, encrypted with the KEK label; never returned on API calls.
+	// If the TOTP secret is encrypted, totp_secret is empty.
+	TOTPEncryptedSecret []byte `protobuf:"bytes,25,opt,name=totp_encrypted_secret,json=totpEncryptedSecret,proto3" json:"totp_encrypted_secret,omitempty"`
+	// The KEK label that the TOTP secret is encrypted with; never returned on API calls.
+	TOTPSecretKEKLabel   string   `protobuf:"bytes,26,opt,name=totp_secret_kek_label,json=totpSecretKekLabel,proto3" json:"totp_secret_kek_label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *User) Reset()      { *m = User{} }
@@ -217,6 +238,62 @@ func (m *User) GetProfilePicture() *Picture {
 	return nil
 }
 
+func (m *User) GetTOTPSecret() string {
+	if m != nil {
+		return m.TOTPSecret
+	}
+	return ""
+}
+
+func (m *User) GetTOTPEnabledAt() *time.Time {
+	if m != nil {
+		return m.TOTPEnabledAt
+	}
+	return nil
+}
+
+func (m *User) GetTOTPRecoveryCodes() []string {
+	if m != nil {
+		return m.TOTPRecoveryCodes
+	}
+	return nil
+}
+
+func (m *User) GetTOTPLastTimeStep() uint64 {
+	if m != nil {
+		return m.TOTPLastTimeStep
+	}
+	return 0
+}
+
+func (m *User) GetTOTPFailedAttempts() uint32 {
+	if m != nil {
+		return m.TOTPFailedAttempts
+	}
+	return 0
+}
+
+func (m *User) GetTOTPLockedUntil() *time.Time {
+	if m != nil {
+		return m.TOTPLockedUntil
+	}
+	return nil
+}
+
+func (m *User) GetTOTPEncryptedSecret() []byte {
+	if m != nil {
+		return m.TOTPEncryptedSecret
+	}
+	return nil
+}
+
+func (m *User) GetTOTPSecretKEKLabel() string {
+	if m != nil {
+		return m.TOTPSecretKEKLabel
+	}
+	return ""
+}
+
 type Users struct {
 	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -574,6 +651,266 @@ func (m *UpdateUserPasswordRequest) GetRevokeAllAccess() bool {
 	return false
 }
 
+type SetupUserTOTPRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	// The current password of the user. Required when the request is not authenticated with an OAuth access token.
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetupUserTOTPRequest) Reset()      { *m = SetupUserTOTPRequest{} }
+func (*SetupUserTOTPRequest) ProtoMessage() {}
+func (*SetupUserTOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{8}
+}
+func (m *SetupUserTOTPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetupUserTOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetupUserTOTPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetupUserTOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetupUserTOTPRequest.Merge(m, src)
+}
+func (m *SetupUserTOTPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetupUserTOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetupUserTOTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetupUserTOTPRequest proto.InternalMessageInfo
+
+func (m *SetupUserTOTPRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type UserTOTPSetup struct {
+	// The TOTP secret, encoded in base32.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The provisioning URI (otpauth://) that authenticator apps use to add the TOTP secret.
+	ProvisioningURI string `protobuf:"bytes,2,opt,name=provisioning_uri,json=provisioningUri,proto3" json:"provisioning_uri,omitempty"`
+	// The QR code of the provisioning URI.
+	QRCode               *Picture `protobuf:"bytes,3,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserTOTPSetup) Reset()      { *m = UserTOTPSetup{} }
+func (*UserTOTPSetup) ProtoMessage() {}
+func (*UserTOTPSetup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{9}
+}
+func (m *UserTOTPSetup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserTOTPSetup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserTOTPSetup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserTOTPSetup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserTOTPSetup.Merge(m, src)
+}
+func (m *UserTOTPSetup) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserTOTPSetup) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserTOTPSetup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserTOTPSetup proto.InternalMessageInfo
+
+func (m *UserTOTPSetup) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *UserTOTPSetup) GetProvisioningURI() string {
+	if m != nil {
+		return m.ProvisioningURI
+	}
+	return ""
+}
+
+func (m *UserTOTPSetup) GetQRCode() *Picture {
+	if m != nil {
+		return m.QRCode
+	}
+	return nil
+}
+
+type EnableUserTOTPRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	// The current TOTP code, to confirm that the TOTP secret of SetupTOTP was added to the authenticator app.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// The current password of the user. Required when the request is not authenticated with an OAuth access token.
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnableUserTOTPRequest) Reset()      { *m = EnableUserTOTPRequest{} }
+func (*EnableUserTOTPRequest) ProtoMessage() {}
+func (*EnableUserTOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{10}
+}
+func (m *EnableUserTOTPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableUserTOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableUserTOTPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnableUserTOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableUserTOTPRequest.Merge(m, src)
+}
+func (m *EnableUserTOTPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableUserTOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableUserTOTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnableUserTOTPRequest proto.InternalMessageInfo
+
+func (m *EnableUserTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *EnableUserTOTPRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type UserTOTPRecoveryCodes struct {
+	// Recovery codes that can be used once instead of a TOTP code.
+	RecoveryCodes        []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserTOTPRecoveryCodes) Reset()      { *m = UserTOTPRecoveryCodes{} }
+func (*UserTOTPRecoveryCodes) ProtoMessage() {}
+func (*UserTOTPRecoveryCodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{11}
+}
+func (m *UserTOTPRecoveryCodes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserTOTPRecoveryCodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserTOTPRecoveryCodes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserTOTPRecoveryCodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserTOTPRecoveryCodes.Merge(m, src)
+}
+func (m *UserTOTPRecoveryCodes) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserTOTPRecoveryCodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserTOTPRecoveryCodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserTOTPRecoveryCodes proto.InternalMessageInfo
+
+func (m *UserTOTPRecoveryCodes) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableUserTOTPRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	// The current TOTP code or a recovery code.
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableUserTOTPRequest) Reset()      { *m = DisableUserTOTPRequest{} }
+func (*DisableUserTOTPRequest) ProtoMessage() {}
+func (*DisableUserTOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{12}
+}
+func (m *DisableUserTOTPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisableUserTOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisableUserTOTPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisableUserTOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableUserTOTPRequest.Merge(m, src)
+}
+func (m *DisableUserTOTPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisableUserTOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableUserTOTPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableUserTOTPRequest proto.InternalMessageInfo
+
+func (m *DisableUserTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
 type ListUserAPIKeysRequest struct {
 	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
 	// Limit the number of results per page.
@@ -587,7 +924,7 @@ type ListUserAPIKeysRequest struct {
 func (m *ListUserAPIKeysRequest) Reset()      { *m = ListUserAPIKeysRequest{} }
 func (*ListUserAPIKeysRequest) ProtoMessage() {}
 func (*ListUserAPIKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{8}
+	return fileDescriptor_5ce30de589ccb9af, []int{13}
 }
 func (m *ListUserAPIKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +978,7 @@ type GetUserAPIKeyRequest struct {
 func (m *GetUserAPIKeyRequest) Reset()      { *m = GetUserAPIKeyRequest{} }
 func (*GetUserAPIKeyRequest) ProtoMessage() {}
 func (*GetUserAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{9}
+	return fileDescriptor_5ce30de589ccb9af, []int{14}
 }
 func (m *GetUserAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -679,16 +1016,18 @@ func (m *GetUserAPIKeyRequest) GetKeyID() string {
 
 type CreateUserAPIKeyRequest struct {
 	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights               []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt            *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedCIDRs         []string   `protobuf:"bytes,5,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CreateUserAPIKeyRequest) Reset()      { *m = CreateUserAPIKeyRequest{} }
 func (*CreateUserAPIKeyRequest) ProtoMessage() {}
 func (*CreateUserAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{10}
+	return fileDescriptor_5ce30de589ccb9af, []int{15}
 }
 func (m *CreateUserAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,17 +1070,34 @@ func (m *CreateUserAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateUserAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateUserAPIKeyRequest) GetAllowedCIDRs() []string {
+	if m != nil {
+		return m.AllowedCIDRs
+	}
+	return nil
+}
+
 type UpdateUserAPIKeyRequest struct {
-	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
-	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	APIKey          `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
+	// The names of the API key fields that should be updated.
+	// If empty, only the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateUserAPIKeyRequest) Reset()      { *m = UpdateUserAPIKeyRequest{} }
 func (*UpdateUserAPIKeyRequest) ProtoMessage() {}
 func (*UpdateUserAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{11}
+	return fileDescriptor_5ce30de589ccb9af, []int{16}
 }
 func (m *UpdateUserAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -770,6 +1126,13 @@ func (m *UpdateUserAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateUserAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateUserAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type Invitation struct {
 	Email                string           `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Token                string           `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
@@ -785,7 +1148,7 @@ type Invitation struct {
 func (m *Invitation) Reset()      { *m = Invitation{} }
 func (*Invitation) ProtoMessage() {}
 func (*Invitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{12}
+	return fileDescriptor_5ce30de589ccb9af, []int{17}
 }
 func (m *Invitation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1238,7 @@ type ListInvitationsRequest struct {
 func (m *ListInvitationsRequest) Reset()      { *m = ListInvitationsRequest{} }
 func (*ListInvitationsRequest) ProtoMessage() {}
 func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{13}
+	return fileDescriptor_5ce30de589ccb9af, []int{18}
 }
 func (m *ListInvitationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -927,7 +1290,7 @@ type Invitations struct {
 func (m *Invitations) Reset()      { *m = Invitations{} }
 func (*Invitations) ProtoMessage() {}
 func (*Invitations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{14}
+	return fileDescriptor_5ce30de589ccb9af, []int{19}
 }
 func (m *Invitations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1335,7 @@ type SendInvitationRequest struct {
 func (m *SendInvitationRequest) Reset()      { *m = SendInvitationRequest{} }
 func (*SendInvitationRequest) ProtoMessage() {}
 func (*SendInvitationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{15}
+	return fileDescriptor_5ce30de589ccb9af, []int{20}
 }
 func (m *SendInvitationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1017,7 +1380,7 @@ type DeleteInvitationRequest struct {
 func (m *DeleteInvitationRequest) Reset()      { *m = DeleteInvitationRequest{} }
 func (*DeleteInvitationRequest) ProtoMessage() {}
 func (*DeleteInvitationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{16}
+	return fileDescriptor_5ce30de589ccb9af, []int{21}
 }
 func (m *DeleteInvitationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1426,7 @@ type UserSessionIdentifiers struct {
 func (m *UserSessionIdentifiers) Reset()      { *m = UserSessionIdentifiers{} }
 func (*UserSessionIdentifiers) ProtoMessage() {}
 func (*UserSessionIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{17}
+	return fileDescriptor_5ce30de589ccb9af, []int{22}
 }
 func (m *UserSessionIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1112,7 +1475,7 @@ type UserSession struct {
 func (m *UserSession) Reset()      { *m = UserSession{} }
 func (*UserSession) ProtoMessage() {}
 func (*UserSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{18}
+	return fileDescriptor_5ce30de589ccb9af, []int{23}
 }
 func (m *UserSession) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1178,7 +1541,7 @@ type UserSessions struct {
 func (m *UserSessions) Reset()      { *m = UserSessions{} }
 func (*UserSessions) ProtoMessage() {}
 func (*UserSessions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{19}
+	return fileDescriptor_5ce30de589ccb9af, []int{24}
 }
 func (m *UserSessions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1230,7 +1593,7 @@ type ListUserSessionsRequest struct {
 func (m *ListUserSessionsRequest) Reset()      { *m = ListUserSessionsRequest{} }
 func (*ListUserSessionsRequest) ProtoMessage() {}
 func (*ListUserSessionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ce30de589ccb9af, []int{20}
+	return fileDescriptor_5ce30de589ccb9af, []int{25}
 }
 func (m *ListUserSessionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,6 +1662,16 @@ func init() {
 	golang_proto.RegisterType((*CreateTemporaryPasswordRequest)(nil), "ttn.lorawan.v3.CreateTemporaryPasswordRequest")
 	proto.RegisterType((*UpdateUserPasswordRequest)(nil), "ttn.lorawan.v3.UpdateUserPasswordRequest")
 	golang_proto.RegisterType((*UpdateUserPasswordRequest)(nil), "ttn.lorawan.v3.UpdateUserPasswordRequest")
+	proto.RegisterType((*SetupUserTOTPRequest)(nil), "ttn.lorawan.v3.SetupUserTOTPRequest")
+	golang_proto.RegisterType((*SetupUserTOTPRequest)(nil), "ttn.lorawan.v3.SetupUserTOTPRequest")
+	proto.RegisterType((*UserTOTPSetup)(nil), "ttn.lorawan.v3.UserTOTPSetup")
+	golang_proto.RegisterType((*UserTOTPSetup)(nil), "ttn.lorawan.v3.UserTOTPSetup")
+	proto.RegisterType((*EnableUserTOTPRequest)(nil), "ttn.lorawan.v3.EnableUserTOTPRequest")
+	golang_proto.RegisterType((*EnableUserTOTPRequest)(nil), "ttn.lorawan.v3.EnableUserTOTPRequest")
+	proto.RegisterType((*UserTOTPRecoveryCodes)(nil), "ttn.lorawan.v3.UserTOTPRecoveryCodes")
+	golang_proto.RegisterType((*UserTOTPRecoveryCodes)(nil), "ttn.lorawan.v3.UserTOTPRecoveryCodes")
+	proto.RegisterType((*DisableUserTOTPRequest)(nil), "ttn.lorawan.v3.DisableUserTOTPRequest")
+	golang_proto.RegisterType((*DisableUserTOTPRequest)(nil), "ttn.lorawan.v3.DisableUserTOTPRequest")
 	proto.RegisterType((*ListUserAPIKeysRequest)(nil), "ttn.lorawan.v3.ListUserAPIKeysRequest")
 	golang_proto.RegisterType((*ListUserAPIKeysRequest)(nil), "ttn.lorawan.v3.ListUserAPIKeysRequest")
 	proto.RegisterType((*GetUserAPIKeyRequest)(nil), "ttn.lorawan.v3.GetUserAPIKeyRequest")
@@ -1333,110 +1706,142 @@ func init() {
 }
 
 var fileDescriptor_5ce30de589ccb9af = []byte{
-	// 1634 bytes of a gzipped FileDescriptorProto
+	// 2149 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xde, 0xe1, 0x9f, 0xc8, 0x47, 0x5b, 0x3f, 0x1b, 0xdb, 0xda, 0xca, 0xd5, 0x90, 0xd8, 0x0a,
-	0x85, 0x2c, 0x98, 0x54, 0x21, 0xa1, 0x6e, 0xea, 0xa6, 0x55, 0xb8, 0xb2, 0x1b, 0x08, 0x72, 0x01,
-	0x61, 0xed, 0xf4, 0xd0, 0x20, 0xdd, 0xae, 0xb8, 0x23, 0x6a, 0x40, 0x72, 0x77, 0xb3, 0x3b, 0x94,
-	0xc2, 0x14, 0x05, 0x82, 0x1e, 0x0a, 0xa3, 0x3d, 0xd4, 0x28, 0x50, 0xa0, 0x48, 0x0f, 0x2d, 0x7a,
-	0x28, 0x82, 0x9e, 0x7c, 0x4c, 0x6f, 0x41, 0x4f, 0x3e, 0x0a, 0xe8, 0x25, 0x40, 0x01, 0x35, 0x22,
-	0x2f, 0x46, 0x4f, 0x39, 0x06, 0x3a, 0x15, 0xb3, 0xb3, 0xcb, 0x5d, 0x91, 0xac, 0x2b, 0xd9, 0x22,
-	0x90, 0x13, 0xe7, 0xe7, 0x7b, 0x3f, 0xf3, 0xe6, 0x7d, 0xef, 0xcd, 0x12, 0xbe, 0xde, 0x72, 0x3c,
-	0xf3, 0xd0, 0xb4, 0x2b, 0x3e, 0x33, 0xeb, 0xcd, 0x55, 0xd3, 0xa5, 0xab, 0x1d, 0x9f, 0x78, 0x55,
-	0xd7, 0x73, 0x98, 0x23, 0x4f, 0x33, 0x66, 0x57, 0x43, 0x44, 0xf5, 0x60, 0x7d, 0xa1, 0xd6, 0xa0,
-	0x6c, 0xbf, 0xb3, 0x5b, 0xad, 0x3b, 0xed, 0x55, 0x62, 0x1f, 0x38, 0x5d, 0xd7, 0x73, 0xde, 0xef,
-	0xae, 0x06, 0xe0, 0x7a, 0xa5, 0x41, 0xec, 0xca, 0x81, 0xd9, 0xa2, 0x96, 0xc9, 0xc8, 0xea, 0xc8,
-	0x40, 0xa8, 0x5c, 0xa8, 0x24, 0x54, 0x34, 0x9c, 0x86, 0x23, 0x84, 0x77, 0x3b, 0x7b, 0xc1, 0x2c,
-	0x98, 0x04, 0xa3, 0x10, 0x5e, 0x6e, 0x38, 0x4e, 0xa3, 0x45, 0x62, 0xd4, 0x1e, 0x25, 0x2d, 0xcb,
-	0x68, 0x9b, 0x7e, 0x33, 0x44, 0x94, 0x86, 0x11, 0x8c, 0xb6, 0x89, 0xcf, 0xcc, 0xb6, 0x1b, 0x02,
-	0x96, 0x46, 0x8f, 0x58, 0x77, 0x6c, 0x66, 0xd6, 0x99, 0x41, 0xed, 0xbd, 0xc8, 0xd0, 0xe2, 0x28,
-	0x8a, 0xd8, 0x9d, 0xb6, 0x1f, 0x6e, 0x7f, 0x63, 0x74, 0x9b, 0x5a, 0xc4, 0x66, 0x74, 0x8f, 0x12,
-	0x2f, 0x02, 0x95, 0x46, 0x41, 0x2e, 0xad, 0xb3, 0x8e, 0x17, 0x1d, 0x1e, 0x8f, 0x02, 0x3c, 0xda,
-	0xd8, 0x67, 0xa1, 0x02, 0xf5, 0x3f, 0x05, 0xc8, 0xbc, 0xed, 0x13, 0x4f, 0xde, 0x84, 0x34, 0xb5,
-	0x7c, 0x05, 0x95, 0xd1, 0x72, 0x71, 0xad, 0x54, 0x3d, 0x7b, 0x0d, 0x55, 0x0e, 0xd9, 0x8a, 0xad,
-	0x6b, 0xb3, 0xa7, 0x5a, 0xf6, 0xd7, 0x28, 0x35, 0x8b, 0x9e, 0x1d, 0x97, 0xa4, 0xa3, 0xe3, 0x12,
-	0xd2, 0xb9, 0xb4, 0xbc, 0x09, 0x50, 0xf7, 0x88, 0xc9, 0x88, 0x65, 0x98, 0x4c, 0x49, 0x05, 0xba,
-	0x16, 0xaa, 0x22, 0x5c, 0xd5, 0x28, 0x5c, 0xd5, 0x47, 0x51, 0xb8, 0xb4, 0x3c, 0x17, 0x7f, 0xf2,
-	0xef, 0x12, 0xd2, 0x0b, 0xa1, 0x5c, 0x8d, 0x71, 0x25, 0x1d, 0xd7, 0x8a, 0x94, 0xa4, 0x2f, 0xa2,
-	0x24, 0x94, 0xab, 0x31, 0xf9, 0x26, 0x64, 0x6c, 0xb3, 0x4d, 0x94, 0x4c, 0x19, 0x2d, 0x17, 0xb4,
-	0xa9, 0x53, 0x2d, 0xe3, 0xa5, 0x94, 0x35, 0x3d, 0x58, 0x94, 0x57, 0xa0, 0x68, 0x11, 0xbf, 0xee,
-	0x51, 0x97, 0x51, 0xc7, 0x56, 0xb2, 0x01, 0x26, 0x7f, 0xaa, 0x65, 0xbd, 0xb4, 0x72, 0x34, 0xa3,
-	0x27, 0x37, 0x65, 0x0f, 0xc0, 0x64, 0xcc, 0xa3, 0xbb, 0x1d, 0x46, 0x7c, 0x25, 0x57, 0x4e, 0x2f,
-	0x17, 0xd7, 0x96, 0xc6, 0x85, 0xa7, 0x5a, 0x1b, 0xc0, 0xee, 0xdb, 0xcc, 0xeb, 0x6a, 0xb7, 0x4f,
-	0xb5, 0x5b, 0x1f, 0xa1, 0x6f, 0xaa, 0x4b, 0x9e, 0xaa, 0x2c, 0xad, 0xe1, 0x9f, 0xbe, 0x63, 0x56,
-	0x3e, 0xf8, 0x56, 0xe5, 0xbb, 0xef, 0x2e, 0x6f, 0xdc, 0x7d, 0xa7, 0xf2, 0xee, 0x46, 0x34, 0xbd,
-	0xf5, 0xf3, 0xb5, 0xdb, 0xbf, 0x58, 0xd2, 0x13, 0x56, 0xe4, 0x1f, 0xc0, 0x95, 0x64, 0xbe, 0x28,
-	0x53, 0x81, 0xd5, 0x9b, 0xc3, 0x56, 0x37, 0x05, 0x66, 0xcb, 0xde, 0x73, 0xf4, 0x62, 0x3d, 0x9e,
-	0xc8, 0xdf, 0x83, 0xeb, 0xae, 0x47, 0xdb, 0xa6, 0xd7, 0x35, 0x48, 0xdb, 0xa4, 0x2d, 0xc3, 0xb4,
-	0x2c, 0x8f, 0xf8, 0xbe, 0x92, 0x4f, 0x44, 0xe3, 0x67, 0x48, 0x7f, 0x2d, 0x44, 0xdd, 0xe7, 0xa0,
-	0x9a, 0xc0, 0xc8, 0x2d, 0x50, 0xc7, 0x0a, 0x1b, 0x11, 0xad, 0x82, 0x6b, 0x29, 0xfc, 0xdf, 0x6b,
-	0xc9, 0x04, 0x57, 0x82, 0xc7, 0x98, 0xf8, 0x71, 0xa4, 0xa8, 0xc6, 0xe4, 0x05, 0xc8, 0xbb, 0xa6,
-	0xef, 0x1f, 0x3a, 0x9e, 0xa5, 0x00, 0xf7, 0x4e, 0x1f, 0xcc, 0xe5, 0x1d, 0x78, 0x2d, 0x1a, 0x1b,
-	0x89, 0x8c, 0x28, 0x9e, 0xd3, 0xf4, 0x5c, 0x24, 0xfc, 0xf6, 0x20, 0x2b, 0xee, 0xc0, 0xbc, 0x47,
-	0xde, 0xeb, 0x50, 0x8f, 0x18, 0x43, 0x9a, 0x95, 0x2b, 0x65, 0xb4, 0x9c, 0xd7, 0xaf, 0x87, 0xdb,
-	0x3b, 0x67, 0x44, 0xe5, 0x6f, 0x43, 0xd6, 0x67, 0x1c, 0x75, 0xb5, 0x8c, 0x96, 0xa7, 0xd7, 0xae,
-	0x0f, 0xdf, 0xc4, 0x43, 0xbe, 0x19, 0x64, 0xd0, 0x2f, 0x39, 0x29, 0x74, 0x81, 0x96, 0xaf, 0x41,
-	0xd6, 0xb4, 0xda, 0xd4, 0x56, 0xa6, 0x03, 0xe5, 0x62, 0x22, 0x57, 0x40, 0x66, 0xa4, 0xed, 0x3a,
-	0x1e, 0x0f, 0xf1, 0xe0, 0xf0, 0x33, 0xc1, 0xe1, 0xe7, 0x06, 0x3b, 0x91, 0x07, 0x72, 0x1d, 0x16,
-	0x47, 0xe1, 0x46, 0x82, 0x66, 0xb3, 0xe7, 0x8c, 0xc7, 0xc2, 0x88, 0xee, 0xcd, 0x01, 0xe7, 0xc6,
-	0x1b, 0x21, 0xef, 0xbb, 0xd4, 0x23, 0x3e, 0x37, 0x32, 0xf7, 0xd2, 0x46, 0xee, 0x0b, 0x25, 0x35,
-	0x26, 0xbf, 0x09, 0x33, 0xae, 0xe7, 0xec, 0xd1, 0x16, 0x31, 0xc2, 0x22, 0xa5, 0xc8, 0x81, 0xda,
-	0xf9, 0xe1, 0x78, 0xee, 0x88, 0x6d, 0x7d, 0x3a, 0xc4, 0x87, 0xf3, 0x85, 0xef, 0xc3, 0xcc, 0x10,
-	0xcb, 0xe4, 0x59, 0x48, 0x37, 0x49, 0x37, 0xa8, 0x5b, 0x05, 0x9d, 0x0f, 0x79, 0xd4, 0x0f, 0xcc,
-	0x56, 0x87, 0x04, 0xf5, 0xa7, 0xa0, 0x8b, 0xc9, 0xdd, 0xd4, 0xeb, 0x48, 0x5d, 0x87, 0x2c, 0x67,
-	0xaa, 0x2f, 0xaf, 0x40, 0x96, 0xf7, 0x1c, 0x5e, 0xee, 0x38, 0xb3, 0xae, 0x8d, 0xe3, 0xb3, 0x2e,
-	0x20, 0xea, 0x9f, 0x10, 0x4c, 0xbf, 0x45, 0x58, 0xb0, 0x44, 0xde, 0xeb, 0x10, 0x9f, 0xc9, 0x0f,
-	0x20, 0xcf, 0xf7, 0x8c, 0x57, 0x2a, 0x98, 0x53, 0x9d, 0x00, 0xe2, 0xcb, 0x1b, 0x00, 0x71, 0x8b,
-	0xf9, 0x9f, 0x45, 0xf3, 0x87, 0x1c, 0xf2, 0x23, 0xd3, 0x6f, 0x6a, 0x19, 0xae, 0x42, 0x2f, 0xec,
-	0x45, 0x0b, 0xea, 0xd3, 0x14, 0xcc, 0x3e, 0xa0, 0x7e, 0xe0, 0xa2, 0x1f, 0xf9, 0x78, 0x56, 0x2b,
-	0xba, 0xb0, 0x56, 0xf9, 0xaf, 0x08, 0xb2, 0x8e, 0x67, 0x11, 0x4f, 0xc4, 0x51, 0xfb, 0x2d, 0x3a,
-	0xd5, 0x7e, 0x83, 0xbc, 0xc7, 0x48, 0x97, 0x84, 0xef, 0x06, 0xb5, 0xf4, 0x7c, 0x25, 0x1a, 0x05,
-	0x95, 0x55, 0xcf, 0x56, 0x82, 0x9f, 0xf1, 0xe5, 0x47, 0xbf, 0x51, 0x19, 0xbf, 0x2e, 0xe8, 0xa2,
-	0xe7, 0x2a, 0xe2, 0x57, 0xf0, 0x44, 0xcf, 0x55, 0xc4, 0x6f, 0xa2, 0xa5, 0xe8, 0xc5, 0x4a, 0x62,
-	0x22, 0xdc, 0x93, 0x31, 0x64, 0x5b, 0xb4, 0x4d, 0x45, 0xab, 0xb8, 0x1a, 0xb0, 0x70, 0x25, 0xad,
-	0x3c, 0x9f, 0xd2, 0xc5, 0xb2, 0x2c, 0x43, 0xc6, 0x35, 0x1b, 0xa2, 0x15, 0x5c, 0xd5, 0x83, 0xb1,
-	0xfa, 0x01, 0xcc, 0x89, 0xe4, 0x4f, 0x5e, 0xeb, 0x5d, 0xc8, 0xf0, 0xd3, 0x84, 0xc1, 0x1a, 0x9b,
-	0x14, 0x63, 0xee, 0x31, 0x90, 0x91, 0x6f, 0xc1, 0x2c, 0xb5, 0x0f, 0x28, 0x33, 0x79, 0xd3, 0x30,
-	0x98, 0xd3, 0x24, 0x76, 0x98, 0x7f, 0x33, 0xf1, 0xfa, 0x23, 0xbe, 0xac, 0x3e, 0x41, 0x30, 0x27,
-	0xea, 0xca, 0x65, 0x19, 0x7f, 0xe5, 0x0c, 0xb2, 0x01, 0x8b, 0x70, 0x3c, 0x1a, 0x66, 0xef, 0x44,
-	0x52, 0x5e, 0xfd, 0x3b, 0x82, 0xaf, 0xc5, 0x21, 0x98, 0xa8, 0x2d, 0x5e, 0x20, 0x6c, 0x72, 0x18,
-	0x5e, 0x06, 0x1f, 0xf2, 0x15, 0xa7, 0x65, 0x05, 0xe9, 0x52, 0xd0, 0xf9, 0x50, 0x5e, 0x81, 0x39,
-	0x8f, 0x1c, 0x38, 0x4d, 0x62, 0x98, 0xad, 0x96, 0x61, 0xd6, 0xeb, 0xbc, 0x59, 0x66, 0x82, 0xa2,
-	0x3d, 0x23, 0x36, 0x6a, 0xad, 0x56, 0x2d, 0x58, 0x56, 0x3f, 0x42, 0x70, 0x23, 0x62, 0x5b, 0x6d,
-	0x67, 0x6b, 0x9b, 0x74, 0xfd, 0xc9, 0x38, 0x3e, 0xc8, 0xeb, 0xd4, 0x8b, 0xf3, 0x3a, 0x9d, 0xc8,
-	0xeb, 0x5f, 0x21, 0xb8, 0x16, 0x16, 0x2b, 0xe1, 0xdb, 0x64, 0x5c, 0x2b, 0x43, 0xae, 0x49, 0xba,
-	0x06, 0xb5, 0xc2, 0xda, 0x50, 0xe8, 0x1d, 0x97, 0xb2, 0xdb, 0xa4, 0xbb, 0x75, 0x4f, 0xcf, 0x36,
-	0x49, 0x77, 0xcb, 0x52, 0xff, 0x81, 0x60, 0x3e, 0x66, 0xd8, 0x24, 0x7d, 0x89, 0x5e, 0x7a, 0xa9,
-	0x71, 0x2f, 0xbd, 0x37, 0x20, 0x27, 0x9e, 0xbb, 0x4a, 0xba, 0x9c, 0x1e, 0xd7, 0xb9, 0x75, 0xbe,
-	0xab, 0x5d, 0x3d, 0xd5, 0xe0, 0x77, 0x68, 0x4a, 0x0d, 0xdb, 0x77, 0x28, 0xa3, 0xfe, 0x0d, 0xc1,
-	0x7c, 0x9c, 0xa6, 0x93, 0x3c, 0x44, 0x0d, 0xa6, 0x4c, 0x97, 0x1a, 0xbc, 0x93, 0x09, 0xfa, 0xde,
-	0x18, 0x56, 0x26, 0xac, 0x8f, 0xd1, 0x91, 0x33, 0x5d, 0xba, 0x4d, 0xba, 0xea, 0xef, 0xd3, 0x00,
-	0x5b, 0x83, 0x52, 0x23, 0x2f, 0x42, 0x36, 0x28, 0xb2, 0xa2, 0x33, 0xc6, 0x6f, 0x3e, 0xb1, 0xca,
-	0x9b, 0x64, 0xb2, 0x48, 0x89, 0x09, 0x7f, 0x7a, 0x27, 0x7a, 0xfe, 0x85, 0x9e, 0xde, 0x64, 0xd0,
-	0xe6, 0xcf, 0x7e, 0x04, 0x64, 0x2e, 0xe3, 0x23, 0x20, 0xfb, 0x72, 0x1f, 0x01, 0x35, 0x28, 0x72,
-	0x2e, 0xbb, 0xa1, 0x96, 0xdc, 0x39, 0xdf, 0x30, 0x10, 0x09, 0x05, 0x6f, 0x96, 0x58, 0xc5, 0x6e,
-	0x57, 0x99, 0x3a, 0xd7, 0x4d, 0xc7, 0x1a, 0xb4, 0xae, 0xfa, 0x40, 0x94, 0x8b, 0xf8, 0x6a, 0x06,
-	0xe5, 0x62, 0x40, 0x70, 0xf4, 0x62, 0x82, 0xa7, 0x12, 0x04, 0xdf, 0x86, 0x62, 0x42, 0x93, 0xfc,
-	0x06, 0x14, 0xe3, 0xf6, 0x12, 0x3d, 0x67, 0x16, 0x86, 0xdd, 0x8b, 0x25, 0xf4, 0x24, 0x5c, 0xbd,
-	0x03, 0xd7, 0x1f, 0x12, 0xdb, 0x4a, 0x6c, 0x87, 0x9e, 0xbd, 0x38, 0x79, 0xd4, 0xd7, 0x61, 0xfe,
-	0x1e, 0x69, 0x11, 0x46, 0x2e, 0x2c, 0xf9, 0x47, 0x04, 0x37, 0x78, 0xb0, 0x1e, 0x12, 0xdf, 0xa7,
-	0x8e, 0x9d, 0x88, 0xd9, 0x25, 0x13, 0x6a, 0x1d, 0xc0, 0x17, 0x36, 0xe2, 0x2a, 0x75, 0x4d, 0xd4,
-	0x86, 0x37, 0x7b, 0xc7, 0xa5, 0x42, 0xe4, 0xc0, 0x3d, 0xbd, 0xe0, 0x47, 0xbe, 0xa8, 0xff, 0x4a,
-	0x41, 0x31, 0xe1, 0xdd, 0x57, 0xc0, 0xa5, 0x21, 0x32, 0xa5, 0x2f, 0x83, 0x4c, 0x99, 0x97, 0x23,
-	0xd3, 0xc6, 0x99, 0xda, 0x90, 0x3d, 0x27, 0x97, 0xe2, 0xba, 0xa0, 0xbe, 0x05, 0x57, 0x12, 0xc1,
-	0xf5, 0xe5, 0xef, 0x40, 0x3e, 0x3c, 0x67, 0x94, 0xb8, 0x37, 0xc7, 0x45, 0x37, 0xc4, 0xeb, 0x03,
-	0xb0, 0xfa, 0x4f, 0x04, 0xf3, 0x51, 0x07, 0x8e, 0xb4, 0x4d, 0xa6, 0x2c, 0xdf, 0x39, 0xfb, 0x04,
-	0x2e, 0x9f, 0x6a, 0x8b, 0xde, 0x4d, 0x5d, 0x9a, 0xc4, 0x93, 0x54, 0xfb, 0x0b, 0x7a, 0x76, 0x82,
-	0xd1, 0xd1, 0x09, 0x46, 0x9f, 0x9d, 0x60, 0xe9, 0xf3, 0x13, 0x2c, 0x3d, 0x3f, 0xc1, 0xd2, 0x17,
-	0x27, 0x58, 0xfa, 0xf2, 0x04, 0xa3, 0x0f, 0x7b, 0x18, 0x3d, 0xee, 0x61, 0xe9, 0xe3, 0x1e, 0x46,
-	0x4f, 0x7b, 0x58, 0xfa, 0xa4, 0x87, 0xa5, 0x4f, 0x7b, 0x58, 0x7a, 0xd6, 0xc3, 0xe8, 0xa8, 0x87,
-	0xd1, 0x67, 0x3d, 0x2c, 0x7d, 0xde, 0xc3, 0xe8, 0x79, 0x0f, 0x4b, 0x5f, 0xf4, 0x30, 0xfa, 0xb2,
-	0x87, 0xa5, 0x0f, 0xfb, 0x58, 0x7a, 0xdc, 0xc7, 0xe8, 0x49, 0x1f, 0x4b, 0x7f, 0xe8, 0x63, 0xf4,
-	0xe7, 0x3e, 0x96, 0x3e, 0xee, 0x63, 0xe9, 0x69, 0x1f, 0xa3, 0x4f, 0xfa, 0x18, 0x7d, 0xda, 0xc7,
-	0xe8, 0x27, 0xb7, 0x1b, 0x4e, 0x95, 0xed, 0x13, 0xb6, 0x4f, 0xed, 0x86, 0x5f, 0xb5, 0x09, 0x3b,
-	0x74, 0xbc, 0xe6, 0xea, 0xd9, 0x7f, 0x8d, 0xdc, 0x66, 0x63, 0x95, 0x31, 0xdb, 0xdd, 0xdd, 0xcd,
-	0x05, 0x17, 0xbd, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x7f, 0x2b, 0x49, 0xc5, 0x13,
-	0x00, 0x00,
+	0x15, 0xe6, 0x88, 0x3f, 0x12, 0x87, 0xfa, 0xa1, 0x46, 0x7f, 0x1b, 0xb9, 0x5e, 0x12, 0x5b, 0xa5,
+	0x50, 0xdc, 0x90, 0x2a, 0x64, 0xd4, 0x4d, 0x53, 0xd7, 0x0e, 0x57, 0x56, 0x5c, 0x41, 0x2e, 0xaa,
+	0x8e, 0xec, 0x02, 0xad, 0x91, 0x6c, 0x56, 0xe4, 0x88, 0x5e, 0x70, 0xb9, 0xbb, 0x9e, 0x1d, 0xca,
+	0x61, 0x82, 0x00, 0x46, 0x0b, 0x14, 0x46, 0x7b, 0xa8, 0x11, 0xa0, 0x40, 0x91, 0x1e, 0x5a, 0x14,
+	0x68, 0xe1, 0xa3, 0x8f, 0xe9, 0x2d, 0x97, 0x02, 0x3e, 0x1a, 0xe8, 0x25, 0x40, 0x01, 0x36, 0x5a,
+	0x5d, 0x0c, 0xf4, 0x92, 0x63, 0xa0, 0x53, 0x31, 0x33, 0xbb, 0xe4, 0x92, 0x62, 0x6d, 0xcb, 0x31,
+	0x81, 0x9e, 0x76, 0xe6, 0xfd, 0x7c, 0xf3, 0xe6, 0xbd, 0x99, 0xf7, 0xde, 0x2c, 0xfc, 0x86, 0xed,
+	0x52, 0xf3, 0x8e, 0xe9, 0x94, 0x7c, 0x66, 0x56, 0x1b, 0x6b, 0xa6, 0x67, 0xad, 0xb5, 0x7c, 0x42,
+	0xcb, 0x1e, 0x75, 0x99, 0x8b, 0xa6, 0x19, 0x73, 0xca, 0xa1, 0x44, 0xf9, 0xe0, 0xfc, 0x72, 0xa5,
+	0x6e, 0xb1, 0x5b, 0xad, 0xbd, 0x72, 0xd5, 0x6d, 0xae, 0x11, 0xe7, 0xc0, 0x6d, 0x7b, 0xd4, 0x7d,
+	0xbf, 0xbd, 0x26, 0x84, 0xab, 0xa5, 0x3a, 0x71, 0x4a, 0x07, 0xa6, 0x6d, 0xd5, 0x4c, 0x46, 0xd6,
+	0x4e, 0x0c, 0x24, 0xe4, 0x72, 0x29, 0x06, 0x51, 0x77, 0xeb, 0xae, 0x54, 0xde, 0x6b, 0xed, 0x8b,
+	0x99, 0x98, 0x88, 0x51, 0x28, 0x5e, 0xac, 0xbb, 0x6e, 0xdd, 0x26, 0x3d, 0xa9, 0x7d, 0x8b, 0xd8,
+	0x35, 0xa3, 0x69, 0xfa, 0x8d, 0x50, 0xa2, 0x30, 0x28, 0xc1, 0xac, 0x26, 0xf1, 0x99, 0xd9, 0xf4,
+	0x42, 0x81, 0x95, 0x93, 0x5b, 0xac, 0xba, 0x0e, 0x33, 0xab, 0xcc, 0xb0, 0x9c, 0xfd, 0x68, 0xa1,
+	0xb3, 0x27, 0xa5, 0x88, 0xd3, 0x6a, 0xfa, 0x21, 0xfb, 0x9b, 0x27, 0xd9, 0x56, 0x8d, 0x38, 0xcc,
+	0xda, 0xb7, 0x08, 0x8d, 0x84, 0x0a, 0x27, 0x85, 0x3c, 0xab, 0xca, 0x5a, 0x34, 0xda, 0xbc, 0x7a,
+	0x52, 0x80, 0x5a, 0xf5, 0x5b, 0x2c, 0x04, 0xd0, 0xfe, 0x31, 0x0d, 0x53, 0x37, 0x7c, 0x42, 0xd1,
+	0x06, 0x4c, 0x5a, 0x35, 0x5f, 0x01, 0x45, 0xb0, 0x9a, 0x5b, 0x2f, 0x94, 0xfb, 0xc3, 0x50, 0xe6,
+	0x22, 0x5b, 0xbd, 0xd5, 0xf5, 0xfc, 0xb1, 0x9e, 0xfe, 0x0d, 0x18, 0xcb, 0x83, 0x47, 0x9d, 0x42,
+	0xe2, 0x71, 0xa7, 0x00, 0x30, 0xd7, 0x46, 0x1b, 0x10, 0x56, 0x29, 0x31, 0x19, 0xa9, 0x19, 0x26,
+	0x53, 0xc6, 0x04, 0xd6, 0x72, 0x59, 0xba, 0xab, 0x1c, 0xb9, 0xab, 0x7c, 0x3d, 0x72, 0x97, 0x3e,
+	0xc1, 0xd5, 0xef, 0xff, 0xbb, 0x00, 0x70, 0x36, 0xd4, 0xab, 0x30, 0x0e, 0xd2, 0xf2, 0x6a, 0x11,
+	0x48, 0xf2, 0x34, 0x20, 0xa1, 0x5e, 0x85, 0xa1, 0x33, 0x30, 0xe5, 0x98, 0x4d, 0xa2, 0xa4, 0x8a,
+	0x60, 0x35, 0xab, 0x8f, 0x1f, 0xeb, 0x29, 0x3a, 0xa6, 0xac, 0x63, 0x41, 0x44, 0xe7, 0x60, 0xae,
+	0x46, 0xfc, 0x2a, 0xb5, 0x3c, 0x66, 0xb9, 0x8e, 0x92, 0x16, 0x32, 0x13, 0xc7, 0x7a, 0x9a, 0x26,
+	0x95, 0xc7, 0x33, 0x38, 0xce, 0x44, 0x14, 0x42, 0x93, 0x31, 0x6a, 0xed, 0xb5, 0x18, 0xf1, 0x95,
+	0x4c, 0x31, 0xb9, 0x9a, 0x5b, 0x5f, 0x19, 0xe6, 0x9e, 0x72, 0xa5, 0x2b, 0xb6, 0xe9, 0x30, 0xda,
+	0xd6, 0x5f, 0x3f, 0xd6, 0x5f, 0xfb, 0x04, 0x7c, 0x4b, 0x5b, 0xa1, 0x9a, 0xb2, 0xb2, 0xae, 0xbe,
+	0x7b, 0xd3, 0x2c, 0x7d, 0xf0, 0x9d, 0xd2, 0xf7, 0xdf, 0x59, 0xbd, 0xfc, 0xe6, 0xcd, 0xd2, 0x3b,
+	0x97, 0xa3, 0xe9, 0x6b, 0x1f, 0xae, 0xbf, 0xfe, 0xd1, 0x0a, 0x8e, 0xad, 0x82, 0x2e, 0xc1, 0xc9,
+	0xf8, 0x79, 0x51, 0xc6, 0xc5, 0xaa, 0x67, 0x06, 0x57, 0xdd, 0x90, 0x32, 0x5b, 0xce, 0xbe, 0x8b,
+	0x73, 0xd5, 0xde, 0x04, 0xfd, 0x00, 0x2e, 0x78, 0xd4, 0x6a, 0x9a, 0xb4, 0x6d, 0x90, 0xa6, 0x69,
+	0xd9, 0x86, 0x59, 0xab, 0x51, 0xe2, 0xfb, 0xca, 0x44, 0xcc, 0x1b, 0xef, 0x01, 0x3c, 0x17, 0x4a,
+	0x6d, 0x72, 0xa1, 0x8a, 0x94, 0x41, 0x36, 0xd4, 0x86, 0x2a, 0x1b, 0xd1, 0xb5, 0x12, 0x61, 0xc9,
+	0x3e, 0x33, 0x2c, 0x29, 0x11, 0x12, 0x75, 0xc8, 0x12, 0x3f, 0x8b, 0x80, 0x2a, 0x0c, 0x2d, 0xc3,
+	0x09, 0xcf, 0xf4, 0xfd, 0x3b, 0x2e, 0xad, 0x29, 0x90, 0x5b, 0x87, 0xbb, 0x73, 0xb4, 0x03, 0xe7,
+	0xa2, 0xb1, 0x11, 0x3b, 0x11, 0xb9, 0xe7, 0x5c, 0x7a, 0x36, 0x52, 0xbe, 0xd1, 0x3d, 0x15, 0x17,
+	0xe0, 0x12, 0x25, 0xb7, 0x5b, 0x16, 0x25, 0xc6, 0x00, 0xb2, 0x32, 0x59, 0x04, 0xab, 0x13, 0x78,
+	0x21, 0x64, 0xef, 0xf4, 0xa9, 0xa2, 0xef, 0xc2, 0xb4, 0xcf, 0xb8, 0xd4, 0x54, 0x11, 0xac, 0x4e,
+	0xaf, 0x2f, 0x0c, 0x46, 0x62, 0x97, 0x33, 0xc5, 0x09, 0xfa, 0x25, 0xbf, 0x14, 0x58, 0x4a, 0xa3,
+	0x79, 0x98, 0x36, 0x6b, 0x4d, 0xcb, 0x51, 0xa6, 0x05, 0xb8, 0x9c, 0xa0, 0x12, 0x44, 0x8c, 0x34,
+	0x3d, 0x97, 0x72, 0x17, 0x77, 0x37, 0x3f, 0x23, 0x36, 0x3f, 0xdb, 0xe5, 0x44, 0x16, 0xa0, 0x2a,
+	0x3c, 0x7b, 0x52, 0xdc, 0x88, 0x5d, 0xb3, 0xfc, 0x73, 0xfa, 0x63, 0xf9, 0x04, 0xf6, 0x46, 0xf7,
+	0xce, 0x0d, 0x5f, 0x84, 0xbc, 0xef, 0x59, 0x94, 0xf8, 0x7c, 0x91, 0xd9, 0x17, 0x5e, 0x64, 0x53,
+	0x82, 0x54, 0x18, 0x7a, 0x0b, 0xce, 0x78, 0xd4, 0xdd, 0xb7, 0x6c, 0x62, 0x84, 0x49, 0x4a, 0x41,
+	0x02, 0x76, 0x69, 0xd0, 0x9f, 0x3b, 0x92, 0x8d, 0xa7, 0x43, 0xf9, 0x70, 0x8e, 0xd6, 0x60, 0x8e,
+	0xb9, 0xcc, 0x33, 0x7c, 0x52, 0xa5, 0x84, 0x29, 0x73, 0xe2, 0x38, 0x4f, 0x07, 0x9d, 0x02, 0xbc,
+	0xfe, 0x93, 0xeb, 0x3b, 0xbb, 0x82, 0x8a, 0x21, 0x17, 0x91, 0x63, 0xf4, 0x73, 0x38, 0x23, 0x14,
+	0x88, 0x63, 0xee, 0xd9, 0xd2, 0x5d, 0xf3, 0xcf, 0xdc, 0xc9, 0x42, 0xd0, 0x29, 0x4c, 0x71, 0xc0,
+	0x4d, 0xa9, 0x55, 0x61, 0x62, 0x6b, 0x53, 0x1c, 0xa9, 0x4b, 0x42, 0x9b, 0x70, 0x4e, 0x40, 0x53,
+	0x52, 0x75, 0x0f, 0x08, 0x6d, 0x1b, 0x55, 0xb7, 0x46, 0x7c, 0x65, 0xa1, 0x98, 0x5c, 0xcd, 0x0a,
+	0x88, 0x59, 0x0e, 0x81, 0x43, 0xee, 0x06, 0x67, 0xe2, 0x59, 0xae, 0xd1, 0x47, 0x42, 0x1b, 0x21,
+	0x8c, 0x6d, 0xfa, 0xcc, 0xe0, 0x85, 0xc4, 0xf0, 0x19, 0xf1, 0x94, 0xc5, 0x22, 0x58, 0x4d, 0xe9,
+	0xf3, 0x41, 0xa7, 0x90, 0xe7, 0x30, 0xd7, 0x4c, 0x9f, 0x71, 0x03, 0x77, 0x19, 0xf1, 0x70, 0x9e,
+	0x2b, 0xc4, 0x29, 0xe8, 0x47, 0x70, 0x5e, 0x80, 0xec, 0x9b, 0x96, 0xdc, 0x25, 0x8f, 0x02, 0xf3,
+	0x95, 0xa5, 0x22, 0x58, 0x9d, 0xd2, 0x17, 0x83, 0x4e, 0x01, 0x71, 0x94, 0xb7, 0x05, 0xbb, 0x12,
+	0x72, 0x31, 0xe2, 0x3a, 0xfd, 0x34, 0x64, 0xc0, 0x59, 0x69, 0x8e, 0x5b, 0x6d, 0x90, 0x9a, 0xd1,
+	0x72, 0x98, 0x65, 0x2b, 0xca, 0x33, 0x5d, 0xb6, 0x14, 0x74, 0x0a, 0x33, 0xc2, 0x50, 0xa1, 0x77,
+	0x83, 0xab, 0x09, 0xa7, 0x09, 0xf7, 0xc7, 0x88, 0x68, 0x1b, 0x2e, 0x84, 0x11, 0xa9, 0xd2, 0xb6,
+	0xc7, 0x8f, 0x70, 0x18, 0xcc, 0x57, 0x8a, 0x60, 0x75, 0x52, 0x00, 0xcd, 0x49, 0xdf, 0x87, 0xfc,
+	0x30, 0xaa, 0x73, 0xd2, 0xfb, 0x7d, 0x44, 0xb4, 0x15, 0x82, 0x49, 0x08, 0xa3, 0x41, 0x1a, 0x86,
+	0x6d, 0xee, 0x11, 0x5b, 0x59, 0x16, 0x27, 0xa3, 0xbb, 0x71, 0x29, 0xbe, 0xbd, 0xb9, 0x7d, 0x8d,
+	0x73, 0xe5, 0xc6, 0x43, 0x1a, 0x69, 0x08, 0xda, 0xf2, 0x0f, 0xe1, 0xcc, 0x40, 0x02, 0x47, 0x79,
+	0x98, 0x6c, 0x90, 0xb6, 0x28, 0x89, 0x59, 0xcc, 0x87, 0xfc, 0x42, 0x1f, 0x98, 0x76, 0x8b, 0x88,
+	0xd2, 0x96, 0xc5, 0x72, 0xf2, 0xe6, 0xd8, 0x1b, 0x40, 0x3b, 0x0f, 0xd3, 0xbc, 0x08, 0xf8, 0xe8,
+	0x1c, 0x4c, 0xf3, 0x76, 0x86, 0x57, 0x52, 0x9e, 0xb4, 0xe7, 0x87, 0x95, 0x0a, 0x2c, 0x45, 0xb4,
+	0x3f, 0x01, 0x38, 0x7d, 0x95, 0x30, 0x41, 0x22, 0xb7, 0x5b, 0xc4, 0x67, 0xe8, 0x1a, 0x9c, 0xe0,
+	0x3c, 0xe3, 0x6b, 0xd5, 0xe2, 0xf1, 0x96, 0x10, 0xf1, 0xd1, 0x65, 0x08, 0x7b, 0xdd, 0xcb, 0xff,
+	0xac, 0xc7, 0x6f, 0x73, 0x91, 0x1f, 0x9b, 0x7e, 0x43, 0x4f, 0x71, 0x08, 0x9c, 0xdd, 0x8f, 0x08,
+	0xda, 0xc3, 0x31, 0x98, 0xbf, 0x66, 0xf9, 0xc2, 0x44, 0x3f, 0xb2, 0xb1, 0x1f, 0x15, 0x9c, 0x1a,
+	0x15, 0xfd, 0x0d, 0xc0, 0xb4, 0x4b, 0x6b, 0x84, 0x4a, 0x3f, 0xea, 0xbf, 0x03, 0xc7, 0xfa, 0x6f,
+	0x01, 0xbd, 0x07, 0x70, 0x42, 0xda, 0x6e, 0x58, 0x35, 0x3c, 0x51, 0x8a, 0x46, 0xa2, 0x68, 0xe3,
+	0x74, 0x49, 0x7c, 0x86, 0x57, 0x36, 0xbc, 0x58, 0x1a, 0x4e, 0x97, 0x99, 0x18, 0x67, 0x4a, 0xf2,
+	0x2b, 0x53, 0x30, 0xce, 0x94, 0xe4, 0x37, 0xd6, 0xad, 0xe0, 0x5c, 0x29, 0x36, 0x91, 0xe6, 0x21,
+	0x15, 0xa6, 0x6d, 0xab, 0x69, 0xc9, 0x2e, 0x64, 0x4a, 0x24, 0xf8, 0x73, 0x49, 0xe5, 0xc9, 0x38,
+	0x96, 0x64, 0x84, 0x60, 0xca, 0x33, 0xeb, 0xb2, 0xcb, 0x98, 0xc2, 0x62, 0xac, 0x7d, 0x00, 0x67,
+	0x65, 0x5e, 0x8d, 0x87, 0xf5, 0x4d, 0x98, 0xe2, 0xbb, 0x09, 0x9d, 0x35, 0xf4, 0x50, 0x0c, 0x89,
+	0xa3, 0xd0, 0x41, 0xaf, 0xc1, 0xbc, 0xe5, 0x1c, 0x58, 0xcc, 0xe4, 0xfd, 0x88, 0xc1, 0xdc, 0x06,
+	0x71, 0xc2, 0xf3, 0x37, 0xd3, 0xa3, 0x5f, 0xe7, 0x64, 0xed, 0x3e, 0x80, 0xb3, 0xb2, 0x64, 0xbd,
+	0xac, 0xc5, 0xbf, 0xf6, 0x09, 0x72, 0xa0, 0x2a, 0xdd, 0x71, 0x7d, 0xb0, 0x30, 0x8c, 0xe4, 0xc8,
+	0x6b, 0x7f, 0x07, 0xf0, 0x95, 0x9e, 0x0b, 0x46, 0xba, 0x16, 0x4f, 0x10, 0x0e, 0xb9, 0x13, 0x06,
+	0x83, 0x0f, 0x39, 0xc5, 0xb5, 0x6b, 0xe2, 0xb8, 0x64, 0x31, 0x1f, 0xa2, 0x73, 0x70, 0x96, 0x92,
+	0x03, 0xb7, 0x41, 0x0c, 0xd3, 0xb6, 0x0d, 0xb3, 0x5a, 0xe5, 0x7d, 0x58, 0x4a, 0xf4, 0x03, 0x33,
+	0x92, 0x51, 0xb1, 0xed, 0x8a, 0x20, 0x6b, 0x77, 0x01, 0x9c, 0xdf, 0x25, 0xac, 0xe5, 0x71, 0x1b,
+	0x64, 0xf5, 0x18, 0x85, 0xd9, 0xf1, 0x9e, 0x6b, 0xac, 0xbf, 0xe7, 0xd2, 0xfe, 0x0a, 0xe0, 0x54,
+	0xb4, 0xba, 0x30, 0x05, 0x2d, 0xc2, 0x4c, 0x98, 0xa1, 0x65, 0x22, 0x0c, 0x67, 0xe8, 0x12, 0xcc,
+	0x7b, 0xd4, 0x3d, 0xb0, 0x7c, 0xcb, 0x75, 0x2c, 0xa7, 0x6e, 0xb4, 0xa8, 0x15, 0x5e, 0xe7, 0x39,
+	0x5e, 0x0c, 0x76, 0x62, 0xbc, 0x1b, 0x78, 0x0b, 0xcf, 0xc4, 0x85, 0x6f, 0x50, 0x0b, 0x5d, 0x84,
+	0xe3, 0xb7, 0xa9, 0x28, 0x9a, 0x4a, 0xf2, 0xa9, 0x5d, 0x80, 0x0e, 0x83, 0x4e, 0x21, 0xf3, 0x53,
+	0xcc, 0xeb, 0x25, 0xce, 0xdc, 0xa6, 0xfc, 0xab, 0x3d, 0x00, 0x70, 0x41, 0xd6, 0xe2, 0xd1, 0xfa,
+	0xea, 0x55, 0x98, 0x12, 0x26, 0xca, 0x9d, 0xcd, 0x1e, 0xeb, 0xd3, 0x74, 0x72, 0x1d, 0xbe, 0x7b,
+	0x93, 0x77, 0xee, 0x1f, 0x5e, 0xf8, 0x68, 0x05, 0x0b, 0x76, 0x9f, 0x4b, 0x93, 0x03, 0x2e, 0xbd,
+	0x04, 0x17, 0x7a, 0x36, 0xc6, 0x4b, 0xff, 0xab, 0x70, 0x7a, 0xa0, 0x79, 0xe0, 0x35, 0x23, 0x8b,
+	0xa7, 0x68, 0x5c, 0x4c, 0xfb, 0x15, 0x80, 0x8b, 0x57, 0x2c, 0x7f, 0xf4, 0x7b, 0x3d, 0xd3, 0xb7,
+	0xd7, 0xf0, 0xcd, 0x54, 0x94, 0x3b, 0xd4, 0x3e, 0x01, 0x70, 0x31, 0xaa, 0x04, 0x95, 0x9d, 0xad,
+	0x6d, 0xd2, 0xf6, 0x47, 0x63, 0x45, 0x37, 0xe7, 0x8e, 0x3d, 0x3d, 0xe7, 0x26, 0x63, 0x39, 0xf7,
+	0xd7, 0x00, 0xce, 0x5f, 0x25, 0x31, 0xdb, 0x46, 0x63, 0x5a, 0x11, 0x66, 0x1a, 0xa4, 0x6d, 0x58,
+	0xe1, 0xb5, 0xd1, 0xb3, 0x41, 0xa7, 0x90, 0xde, 0x26, 0xed, 0xad, 0x2b, 0x38, 0xdd, 0x20, 0xed,
+	0xad, 0x9a, 0xf6, 0x68, 0x0c, 0x2e, 0xf5, 0xb2, 0xff, 0x28, 0x6d, 0x89, 0x1e, 0xb8, 0x63, 0xc3,
+	0x1e, 0xb8, 0x17, 0x61, 0x46, 0xbe, 0xf2, 0x95, 0x64, 0x31, 0x39, 0xec, 0xc1, 0x82, 0x39, 0x57,
+	0x9f, 0x3a, 0xd6, 0xe1, 0xc7, 0x60, 0x5c, 0x0b, 0x5f, 0x2d, 0xa1, 0x0e, 0xcf, 0xf9, 0xb1, 0xce,
+	0x3f, 0xf5, 0x9c, 0x9d, 0x7f, 0x96, 0x74, 0x1b, 0xfd, 0x0d, 0x38, 0x65, 0xda, 0xb6, 0x7b, 0x87,
+	0xd4, 0x8c, 0xaa, 0x55, 0xa3, 0xbe, 0x92, 0x16, 0x4d, 0xb1, 0x7a, 0xac, 0xe7, 0x3e, 0x06, 0x13,
+	0xf9, 0xbc, 0xc6, 0x6d, 0xfd, 0x76, 0xd0, 0x29, 0x4c, 0x56, 0xa4, 0xd8, 0xc6, 0xd6, 0x15, 0xec,
+	0xe3, 0xc9, 0x50, 0x69, 0x83, 0xeb, 0x68, 0xff, 0x01, 0x70, 0xa9, 0x97, 0xc8, 0x47, 0xe9, 0xca,
+	0x0a, 0x1c, 0x37, 0x3d, 0xcb, 0xe0, 0xbd, 0x9e, 0x2c, 0x70, 0x8b, 0x83, 0x60, 0x72, 0xf5, 0x21,
+	0x18, 0x19, 0xd3, 0xb3, 0xb6, 0x49, 0x7b, 0xa0, 0x4c, 0x26, 0x4f, 0x5f, 0x26, 0x7f, 0x9f, 0x84,
+	0x70, 0xab, 0x5b, 0xcd, 0xd1, 0x59, 0x98, 0x16, 0x7d, 0x8c, 0x02, 0x62, 0xe1, 0x7d, 0x0f, 0x60,
+	0x49, 0xe5, 0x7d, 0x68, 0xbc, 0x0f, 0x90, 0x13, 0xfe, 0xe3, 0x24, 0x16, 0xb7, 0x53, 0xfd, 0x38,
+	0x89, 0xc7, 0x2e, 0xfe, 0x0b, 0x27, 0xf5, 0x32, 0x7e, 0xe1, 0xa4, 0x5f, 0xec, 0x17, 0x4e, 0x05,
+	0xe6, 0x78, 0xb9, 0xf4, 0x42, 0x94, 0xcc, 0x73, 0x9e, 0x43, 0x18, 0x29, 0x89, 0x17, 0x67, 0x0f,
+	0x62, 0xaf, 0xad, 0x8c, 0x3f, 0xd7, 0x51, 0xe9, 0x21, 0xe8, 0x6d, 0xed, 0x9a, 0xcc, 0x7a, 0xbd,
+	0xd0, 0x74, 0xb3, 0x5e, 0x37, 0x4f, 0x81, 0xa7, 0xe7, 0xa9, 0xb1, 0x58, 0x9e, 0xda, 0x86, 0xb9,
+	0x18, 0x12, 0xba, 0x08, 0x73, 0xbd, 0x0e, 0x2e, 0x7a, 0x31, 0x2c, 0x0f, 0x9a, 0xd7, 0xd3, 0xc0,
+	0x71, 0x71, 0xed, 0x02, 0x5c, 0xd8, 0x25, 0x4e, 0x2d, 0xc6, 0x0e, 0x2d, 0x7b, 0xfa, 0xe1, 0xd1,
+	0xde, 0x80, 0x4b, 0x57, 0x88, 0x4d, 0x18, 0x39, 0xb5, 0xe6, 0x1f, 0x01, 0x5c, 0xe4, 0xce, 0xda,
+	0x25, 0x3e, 0x2f, 0xe4, 0x31, 0x9f, 0xbd, 0xe4, 0x1b, 0x79, 0x1e, 0x42, 0x5f, 0xae, 0xd1, 0x4b,
+	0xb6, 0xf3, 0x32, 0xc5, 0xbd, 0x15, 0x74, 0x0a, 0xd9, 0xc8, 0x80, 0x2b, 0x38, 0xeb, 0x47, 0xb6,
+	0x68, 0xff, 0x1a, 0x83, 0xb9, 0x98, 0x75, 0xff, 0x07, 0x26, 0x0d, 0x5c, 0xa6, 0xe4, 0xcb, 0xb8,
+	0x4c, 0xa9, 0x17, 0xbb, 0x4c, 0xfd, 0x39, 0x3d, 0x7d, 0xea, 0x9c, 0xae, 0x5d, 0x85, 0x93, 0x31,
+	0xe7, 0xfa, 0xe8, 0x7b, 0x70, 0x22, 0xdc, 0x67, 0x74, 0x70, 0xcf, 0x0c, 0xf3, 0x6e, 0x28, 0x8f,
+	0xbb, 0xc2, 0xda, 0x3f, 0x01, 0x5c, 0x8a, 0x1a, 0x89, 0x08, 0x6d, 0x34, 0x79, 0xfd, 0x42, 0xff,
+	0x2b, 0xb3, 0x78, 0xac, 0x9f, 0xa5, 0x67, 0x70, 0x62, 0x14, 0xaf, 0x3e, 0xfd, 0x2f, 0xe0, 0xd1,
+	0xa1, 0x0a, 0x1e, 0x1f, 0xaa, 0xe0, 0xf3, 0x43, 0x35, 0xf1, 0xc5, 0xa1, 0x9a, 0x78, 0x72, 0xa8,
+	0x26, 0xbe, 0x3c, 0x54, 0x13, 0x5f, 0x1d, 0xaa, 0xe0, 0x6e, 0xa0, 0x82, 0x7b, 0x81, 0x9a, 0x78,
+	0x10, 0xa8, 0xe0, 0x61, 0xa0, 0x26, 0x3e, 0x0d, 0xd4, 0xc4, 0x67, 0x81, 0x9a, 0x78, 0x14, 0xa8,
+	0xe0, 0x71, 0xa0, 0x82, 0xcf, 0x03, 0x35, 0xf1, 0x45, 0xa0, 0x82, 0x27, 0x81, 0x9a, 0xf8, 0x32,
+	0x50, 0xc1, 0x57, 0x81, 0x9a, 0xb8, 0x7b, 0xa4, 0x26, 0xee, 0x1d, 0xa9, 0xe0, 0xfe, 0x91, 0x9a,
+	0xf8, 0xc3, 0x91, 0x0a, 0xfe, 0x7c, 0xa4, 0x26, 0x1e, 0x1c, 0xa9, 0x89, 0x87, 0x47, 0x2a, 0xf8,
+	0xf4, 0x48, 0x05, 0x9f, 0x1d, 0xa9, 0xe0, 0x17, 0xaf, 0xd7, 0xdd, 0x32, 0xbb, 0x45, 0xd8, 0x2d,
+	0xcb, 0xa9, 0xfb, 0x65, 0x87, 0xb0, 0x3b, 0x2e, 0x6d, 0xac, 0xf5, 0xff, 0xf3, 0xf7, 0x1a, 0xf5,
+	0x35, 0xc6, 0x1c, 0x6f, 0x6f, 0x2f, 0x23, 0x02, 0x7d, 0xfe, 0xbf, 0x03, 0x00, 0x55, 0xc0, 0xcf,
+	0x84, 0x83, 0x19, 0x00, 0x00,
 }
 
 func (this *User) Equal(that interface{}) bool {
@@ -1538,7 +1943,44 @@ func (this *User) Equal(that interface{}) bool {
 	if !this.ProfilePicture.Equal(that1.ProfilePicture) {
 		return false
 	}
-	return true
+	if this.TOTPSecret != that1.TOTPSecret {
+		return false
+	}
+	if that1.TOTPEnabledAt == nil {
+		if this.TOTPEnabledAt != nil {
+			return false
+		}
+	} else if !this.TOTPEnabledAt.Equal(*that1.TOTPEnabledAt) {
+		return false
+	}
+	if len(this.TOTPRecoveryCodes) != len(that1.TOTPRecoveryCodes) {
+		return false
+	}
+	for i := range this.TOTPRecoveryCodes {
+		if this.TOTPRecoveryCodes[i] != that1.TOTPRecoveryCodes[i] {
+			return false
+		}
+	}
+	if this.TOTPLastTimeStep != that1.TOTPLastTimeStep {
+		return false
+	}
+	if this.TOTPFailedAttempts != that1.TOTPFailedAttempts {
+		return false
+	}
+	if that1.TOTPLockedUntil == nil {
+		if this.TOTPLockedUntil != nil {
+			return false
+		}
+	} else if !this.TOTPLockedUntil.Equal(*that1.TOTPLockedUntil) {
+		return false
+	}
+	if !bytes.Equal(this.TOTPEncryptedSecret, that1.TOTPEncryptedSecret) {
+		return false
+	}
+	if this.TOTPSecretKEKLabel != that1.TOTPSecretKEKLabel {
+		return false
+	}
+	return true
 }
 func (this *Users) Equal(that interface{}) bool {
 	if that == nil {
@@ -1740,6 +2182,149 @@ func (this *UpdateUserPasswordRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetupUserTOTPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetupUserTOTPRequest)
+	if !ok {
+		that2, ok := that.(SetupUserTOTPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	return true
+}
+func (this *UserTOTPSetup) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserTOTPSetup)
+	if !ok {
+		that2, ok := that.(UserTOTPSetup)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Secret != that1.Secret {
+		return false
+	}
+	if this.ProvisioningURI != that1.ProvisioningURI {
+		return false
+	}
+	if !this.QRCode.Equal(that1.QRCode) {
+		return false
+	}
+	return true
+}
+func (this *EnableUserTOTPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EnableUserTOTPRequest)
+	if !ok {
+		that2, ok := that.(EnableUserTOTPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	return true
+}
+func (this *UserTOTPRecoveryCodes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UserTOTPRecoveryCodes)
+	if !ok {
+		that2, ok := that.(UserTOTPRecoveryCodes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.RecoveryCodes) != len(that1.RecoveryCodes) {
+		return false
+	}
+	for i := range this.RecoveryCodes {
+		if this.RecoveryCodes[i] != that1.RecoveryCodes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DisableUserTOTPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DisableUserTOTPRequest)
+	if !ok {
+		that2, ok := that.(DisableUserTOTPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	return true
+}
 func (this *ListUserAPIKeysRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1830,6 +2415,21 @@ func (this *CreateUserAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
@@ -1857,6 +2457,9 @@ func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *Invitation) Equal(that interface{}) bool {
@@ -2158,6 +2761,82 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TOTPSecretKEKLabel) > 0 {
+		i -= len(m.TOTPSecretKEKLabel)
+		copy(dAtA[i:], m.TOTPSecretKEKLabel)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.TOTPSecretKEKLabel)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.TOTPEncryptedSecret) > 0 {
+		i -= len(m.TOTPEncryptedSecret)
+		copy(dAtA[i:], m.TOTPEncryptedSecret)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.TOTPEncryptedSecret)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.TOTPLockedUntil != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TOTPLockedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TOTPLockedUntil):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintUser(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.TOTPFailedAttempts != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.TOTPFailedAttempts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.TOTPLastTimeStep != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.TOTPLastTimeStep))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.TOTPRecoveryCodes) > 0 {
+		for iNdEx := len(m.TOTPRecoveryCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TOTPRecoveryCodes[iNdEx])
+			copy(dAtA[i:], m.TOTPRecoveryCodes[iNdEx])
+			i = encodeVarintUser(dAtA, i, uint64(len(m.TOTPRecoveryCodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.TOTPEnabledAt != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TOTPEnabledAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TOTPEnabledAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintUser(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.TOTPSecret) > 0 {
+		i -= len(m.TOTPSecret)
+		copy(dAtA[i:], m.TOTPSecret)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.TOTPSecret)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.ProfilePicture != nil {
 		{
 			size, err := m.ProfilePicture.MarshalToSizedBuffer(dAtA[:i])
@@ -2173,24 +2852,24 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x92
 	}
 	if m.TemporaryPasswordExpiresAt != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TemporaryPasswordExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordExpiresAt):])
-		if err2 != nil {
-			return 0, err2
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TemporaryPasswordExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordExpiresAt):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n2
-		i = encodeVarintUser(dAtA, i, uint64(n2))
+		i -= n4
+		i = encodeVarintUser(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x8a
 	}
 	if m.TemporaryPasswordCreatedAt != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TemporaryPasswordCreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordCreatedAt):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TemporaryPasswordCreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TemporaryPasswordCreatedAt):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintUser(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintUser(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -2229,12 +2908,12 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x60
 	}
 	if m.PasswordUpdatedAt != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PasswordUpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PasswordUpdatedAt):])
-		if err4 != nil {
-			return 0, err4
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PasswordUpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PasswordUpdatedAt):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n4
-		i = encodeVarintUser(dAtA, i, uint64(n4))
+		i -= n6
+		i = encodeVarintUser(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -2246,12 +2925,12 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.PrimaryEmailAddressValidatedAt != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PrimaryEmailAddressValidatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PrimaryEmailAddressValidatedAt):])
-		if err5 != nil {
-			return 0, err5
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.PrimaryEmailAddressValidatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.PrimaryEmailAddressValidatedAt):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n5
-		i = encodeVarintUser(dAtA, i, uint64(n5))
+		i -= n7
+		i = encodeVarintUser(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x4a
 	}
@@ -2309,20 +2988,20 @@ func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintUser(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintUser(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x1a
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err7 != nil {
-		return 0, err7
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n7
-	i = encodeVarintUser(dAtA, i, uint64(n7))
+	i -= n9
+	i = encodeVarintUser(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -2641,7 +3320,7 @@ func (m *UpdateUserPasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *ListUserAPIKeysRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetupUserTOTPRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2651,25 +3330,22 @@ func (m *ListUserAPIKeysRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListUserAPIKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetupUserTOTPRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListUserAPIKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetupUserTOTPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Page != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Limit != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	{
 		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
@@ -2684,7 +3360,7 @@ func (m *ListUserAPIKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GetUserAPIKeyRequest) Marshal() (dAtA []byte, err error) {
+func (m *UserTOTPSetup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2694,29 +3370,240 @@ func (m *GetUserAPIKeyRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UserTOTPSetup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UserTOTPSetup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.KeyID) > 0 {
-		i -= len(m.KeyID)
-		copy(dAtA[i:], m.KeyID)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.KeyID)))
+	if m.QRCode != nil {
+		{
+			size, err := m.QRCode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUser(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProvisioningURI) > 0 {
+		i -= len(m.ProvisioningURI)
+		copy(dAtA[i:], m.ProvisioningURI)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ProvisioningURI)))
 		i--
 		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnableUserTOTPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableUserTOTPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableUserTOTPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UserTOTPRecoveryCodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserTOTPRecoveryCodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserTOTPRecoveryCodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveryCodes) > 0 {
+		for iNdEx := len(m.RecoveryCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecoveryCodes[iNdEx])
+			copy(dAtA[i:], m.RecoveryCodes[iNdEx])
+			i = encodeVarintUser(dAtA, i, uint64(len(m.RecoveryCodes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisableUserTOTPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisableUserTOTPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisableUserTOTPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListUserAPIKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListUserAPIKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListUserAPIKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserAPIKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
 		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
 	i--
@@ -2744,21 +3631,40 @@ func (m *CreateUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedCIDRs) > 0 {
+		for iNdEx := len(m.AllowedCIDRs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCIDRs[iNdEx])
+			copy(dAtA[i:], m.AllowedCIDRs[iNdEx])
+			i = encodeVarintUser(dAtA, i, uint64(len(m.AllowedCIDRs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpiresAt != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintUser(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Rights) > 0 {
-		dAtA20 := make([]byte, len(m.Rights)*10)
-		var j19 int
+		dAtA27 := make([]byte, len(m.Rights)*10)
+		var j26 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j26++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA27[j26] = uint8(num)
+			j26++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintUser(dAtA, i, uint64(j19))
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintUser(dAtA, i, uint64(j26))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2802,6 +3708,16 @@ func (m *UpdateUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2858,37 +3774,37 @@ func (m *Invitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 	}
 	if m.AcceptedAt != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AcceptedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcceptedAt):])
-		if err25 != nil {
-			return 0, err25
+		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AcceptedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcceptedAt):])
+		if err33 != nil {
+			return 0, err33
 		}
-		i -= n25
-		i = encodeVarintUser(dAtA, i, uint64(n25))
+		i -= n33
+		i = encodeVarintUser(dAtA, i, uint64(n33))
 		i--
 		dAtA[i] = 0x32
 	}
-	n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err26 != nil {
-		return 0, err26
+	n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err34 != nil {
+		return 0, err34
 	}
-	i -= n26
-	i = encodeVarintUser(dAtA, i, uint64(n26))
+	i -= n34
+	i = encodeVarintUser(dAtA, i, uint64(n34))
 	i--
 	dAtA[i] = 0x2a
-	n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err27 != nil {
-		return 0, err27
+	n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err35 != nil {
+		return 0, err35
 	}
-	i -= n27
-	i = encodeVarintUser(dAtA, i, uint64(n27))
+	i -= n35
+	i = encodeVarintUser(dAtA, i, uint64(n35))
 	i--
 	dAtA[i] = 0x22
-	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
-	if err28 != nil {
-		return 0, err28
+	n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err36 != nil {
+		return 0, err36
 	}
-	i -= n28
-	i = encodeVarintUser(dAtA, i, uint64(n28))
+	i -= n36
+	i = encodeVarintUser(dAtA, i, uint64(n36))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.Token) > 0 {
@@ -3099,29 +4015,29 @@ func (m *UserSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.ExpiresAt != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
-		if err30 != nil {
-			return 0, err30
+		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n30
-		i = encodeVarintUser(dAtA, i, uint64(n30))
+		i -= n38
+		i = encodeVarintUser(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0x2a
 	}
-	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err31 != nil {
-		return 0, err31
+	n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err39 != nil {
+		return 0, err39
 	}
-	i -= n31
-	i = encodeVarintUser(dAtA, i, uint64(n31))
+	i -= n39
+	i = encodeVarintUser(dAtA, i, uint64(n39))
 	i--
 	dAtA[i] = 0x22
-	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err32 != nil {
-		return 0, err32
+	n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err40 != nil {
+		return 0, err40
 	}
-	i -= n32
-	i = encodeVarintUser(dAtA, i, uint64(n32))
+	i -= n40
+	i = encodeVarintUser(dAtA, i, uint64(n40))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.SessionID) > 0 {
@@ -3287,6 +4203,26 @@ func NewPopulatedUser(r randyUser, easy bool) *User {
 	if r.Intn(5) != 0 {
 		this.ProfilePicture = NewPopulatedPicture(r, easy)
 	}
+	this.TOTPSecret = randStringUser(r)
+	if r.Intn(5) != 0 {
+		this.TOTPEnabledAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v6 := r.Intn(10)
+	this.TOTPRecoveryCodes = make([]string, v6)
+	for i := 0; i < v6; i++ {
+		this.TOTPRecoveryCodes[i] = randStringUser(r)
+	}
+	this.TOTPLastTimeStep = uint64(uint64(r.Uint32()))
+	this.TOTPFailedAttempts = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.TOTPLockedUntil = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v7 := r.Intn(100)
+	this.TOTPEncryptedSecret = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.TOTPEncryptedSecret[i] = byte(r.Intn(256))
+	}
+	this.TOTPSecretKEKLabel = randStringUser(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3295,9 +4231,9 @@ func NewPopulatedUser(r randyUser, easy bool) *User {
 func NewPopulatedUsers(r randyUser, easy bool) *Users {
 	this := &Users{}
 	if r.Intn(5) != 0 {
-		v6 := r.Intn(5)
-		this.Users = make([]*User, v6)
-		for i := 0; i < v6; i++ {
+		v8 := r.Intn(5)
+		this.Users = make([]*User, v8)
+		for i := 0; i < v8; i++ {
 			this.Users[i] = NewPopulatedUser(r, easy)
 		}
 	}
@@ -3308,10 +4244,10 @@ func NewPopulatedUsers(r randyUser, easy bool) *Users {
 
 func NewPopulatedGetUserRequest(r randyUser, easy bool) *GetUserRequest {
 	this := &GetUserRequest{}
-	v7 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v7
-	v8 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v8
+	v9 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v9
+	v10 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v10
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3319,8 +4255,8 @@ func NewPopulatedGetUserRequest(r randyUser, easy bool) *GetUserRequest {
 
 func NewPopulatedListUsersRequest(r randyUser, easy bool) *ListUsersRequest {
 	this := &ListUsersRequest{}
-	v9 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v9
+	v11 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v11
 	this.Order = randStringUser(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -3331,8 +4267,8 @@ func NewPopulatedListUsersRequest(r randyUser, easy bool) *ListUsersRequest {
 
 func NewPopulatedCreateUserRequest(r randyUser, easy bool) *CreateUserRequest {
 	this := &CreateUserRequest{}
-	v10 := NewPopulatedUser(r, easy)
-	this.User = *v10
+	v12 := NewPopulatedUser(r, easy)
+	this.User = *v12
 	this.InvitationToken = randStringUser(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -3341,10 +4277,10 @@ func NewPopulatedCreateUserRequest(r randyUser, easy bool) *CreateUserRequest {
 
 func NewPopulatedUpdateUserRequest(r randyUser, easy bool) *UpdateUserRequest {
 	this := &UpdateUserRequest{}
-	v11 := NewPopulatedUser(r, easy)
-	this.User = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
+	v13 := NewPopulatedUser(r, easy)
+	this.User = *v13
+	v14 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v14
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3352,8 +4288,8 @@ func NewPopulatedUpdateUserRequest(r randyUser, easy bool) *UpdateUserRequest {
 
 func NewPopulatedCreateTemporaryPasswordRequest(r randyUser, easy bool) *CreateTemporaryPasswordRequest {
 	this := &CreateTemporaryPasswordRequest{}
-	v13 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v13
+	v15 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v15
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3361,8 +4297,8 @@ func NewPopulatedCreateTemporaryPasswordRequest(r randyUser, easy bool) *CreateT
 
 func NewPopulatedUpdateUserPasswordRequest(r randyUser, easy bool) *UpdateUserPasswordRequest {
 	this := &UpdateUserPasswordRequest{}
-	v14 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v14
+	v16 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v16
 	this.New = randStringUser(r)
 	this.Old = randStringUser(r)
 	this.RevokeAllAccess = bool(r.Intn(2) == 0)
@@ -3371,76 +4307,141 @@ func NewPopulatedUpdateUserPasswordRequest(r randyUser, easy bool) *UpdateUserPa
 	return this
 }
 
-func NewPopulatedListUserAPIKeysRequest(r randyUser, easy bool) *ListUserAPIKeysRequest {
-	this := &ListUserAPIKeysRequest{}
-	v15 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v15
-	this.Limit = r.Uint32()
-	this.Page = r.Uint32()
+func NewPopulatedSetupUserTOTPRequest(r randyUser, easy bool) *SetupUserTOTPRequest {
+	this := &SetupUserTOTPRequest{}
+	v17 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v17
+	this.Password = randStringUser(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedGetUserAPIKeyRequest(r randyUser, easy bool) *GetUserAPIKeyRequest {
-	this := &GetUserAPIKeyRequest{}
-	v16 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v16
-	this.KeyID = randStringUser(r)
+func NewPopulatedUserTOTPSetup(r randyUser, easy bool) *UserTOTPSetup {
+	this := &UserTOTPSetup{}
+	this.Secret = randStringUser(r)
+	this.ProvisioningURI = randStringUser(r)
+	if r.Intn(5) != 0 {
+		this.QRCode = NewPopulatedPicture(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIKeyRequest {
-	this := &CreateUserAPIKeyRequest{}
-	v17 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v17
-	this.Name = randStringUser(r)
-	v18 := r.Intn(10)
-	this.Rights = make([]Right, v18)
-	for i := 0; i < v18; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
-	}
+func NewPopulatedEnableUserTOTPRequest(r randyUser, easy bool) *EnableUserTOTPRequest {
+	this := &EnableUserTOTPRequest{}
+	v18 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v18
+	this.Code = randStringUser(r)
+	this.Password = randStringUser(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedUpdateUserAPIKeyRequest(r randyUser, easy bool) *UpdateUserAPIKeyRequest {
-	this := &UpdateUserAPIKeyRequest{}
-	v19 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v19
-	v20 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v20
+func NewPopulatedUserTOTPRecoveryCodes(r randyUser, easy bool) *UserTOTPRecoveryCodes {
+	this := &UserTOTPRecoveryCodes{}
+	v19 := r.Intn(10)
+	this.RecoveryCodes = make([]string, v19)
+	for i := 0; i < v19; i++ {
+		this.RecoveryCodes[i] = randStringUser(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedInvitation(r randyUser, easy bool) *Invitation {
-	this := &Invitation{}
-	this.Email = randStringUser(r)
-	this.Token = randStringUser(r)
-	v21 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ExpiresAt = *v21
-	v22 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v22
-	v23 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v23
-	if r.Intn(5) != 0 {
-		this.AcceptedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.AcceptedBy = NewPopulatedUserIdentifiers(r, easy)
-	}
+func NewPopulatedDisableUserTOTPRequest(r randyUser, easy bool) *DisableUserTOTPRequest {
+	this := &DisableUserTOTPRequest{}
+	v20 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v20
+	this.Code = randStringUser(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedListInvitationsRequest(r randyUser, easy bool) *ListInvitationsRequest {
-	this := &ListInvitationsRequest{}
+func NewPopulatedListUserAPIKeysRequest(r randyUser, easy bool) *ListUserAPIKeysRequest {
+	this := &ListUserAPIKeysRequest{}
+	v21 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v21
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetUserAPIKeyRequest(r randyUser, easy bool) *GetUserAPIKeyRequest {
+	this := &GetUserAPIKeyRequest{}
+	v22 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v22
+	this.KeyID = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIKeyRequest {
+	this := &CreateUserAPIKeyRequest{}
+	v23 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v23
+	this.Name = randStringUser(r)
+	v24 := r.Intn(10)
+	this.Rights = make([]Right, v24)
+	for i := 0; i < v24; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
+	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v25 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v25)
+	for i := 0; i < v25; i++ {
+		this.AllowedCIDRs[i] = randStringUser(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateUserAPIKeyRequest(r randyUser, easy bool) *UpdateUserAPIKeyRequest {
+	this := &UpdateUserAPIKeyRequest{}
+	v26 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v26
+	v27 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v27
+	v28 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v28
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedInvitation(r randyUser, easy bool) *Invitation {
+	this := &Invitation{}
+	this.Email = randStringUser(r)
+	this.Token = randStringUser(r)
+	v29 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ExpiresAt = *v29
+	v30 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v30
+	v31 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v31
+	if r.Intn(5) != 0 {
+		this.AcceptedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.AcceptedBy = NewPopulatedUserIdentifiers(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListInvitationsRequest(r randyUser, easy bool) *ListInvitationsRequest {
+	this := &ListInvitationsRequest{}
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -3451,9 +4452,9 @@ func NewPopulatedListInvitationsRequest(r randyUser, easy bool) *ListInvitations
 func NewPopulatedInvitations(r randyUser, easy bool) *Invitations {
 	this := &Invitations{}
 	if r.Intn(5) != 0 {
-		v24 := r.Intn(5)
-		this.Invitations = make([]*Invitation, v24)
-		for i := 0; i < v24; i++ {
+		v32 := r.Intn(5)
+		this.Invitations = make([]*Invitation, v32)
+		for i := 0; i < v32; i++ {
 			this.Invitations[i] = NewPopulatedInvitation(r, easy)
 		}
 	}
@@ -3480,8 +4481,8 @@ func NewPopulatedDeleteInvitationRequest(r randyUser, easy bool) *DeleteInvitati
 
 func NewPopulatedUserSessionIdentifiers(r randyUser, easy bool) *UserSessionIdentifiers {
 	this := &UserSessionIdentifiers{}
-	v25 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v25
+	v33 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v33
 	this.SessionID = randStringUser(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -3490,13 +4491,13 @@ func NewPopulatedUserSessionIdentifiers(r randyUser, easy bool) *UserSessionIden
 
 func NewPopulatedUserSession(r randyUser, easy bool) *UserSession {
 	this := &UserSession{}
-	v26 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v26
+	v34 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v34
 	this.SessionID = randStringUser(r)
-	v27 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v27
-	v28 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v28
+	v35 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v35
+	v36 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v36
 	if r.Intn(5) != 0 {
 		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
@@ -3508,9 +4509,9 @@ func NewPopulatedUserSession(r randyUser, easy bool) *UserSession {
 func NewPopulatedUserSessions(r randyUser, easy bool) *UserSessions {
 	this := &UserSessions{}
 	if r.Intn(5) != 0 {
-		v29 := r.Intn(5)
-		this.Sessions = make([]*UserSession, v29)
-		for i := 0; i < v29; i++ {
+		v37 := r.Intn(5)
+		this.Sessions = make([]*UserSession, v37)
+		for i := 0; i < v37; i++ {
 			this.Sessions[i] = NewPopulatedUserSession(r, easy)
 		}
 	}
@@ -3521,8 +4522,8 @@ func NewPopulatedUserSessions(r randyUser, easy bool) *UserSessions {
 
 func NewPopulatedListUserSessionsRequest(r randyUser, easy bool) *ListUserSessionsRequest {
 	this := &ListUserSessionsRequest{}
-	v30 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v30
+	v38 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v38
 	this.Order = randStringUser(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -3550,9 +4551,9 @@ func randUTF8RuneUser(r randyUser) rune {
 	return rune(ru + 61)
 }
 func randStringUser(r randyUser) string {
-	v31 := r.Intn(100)
-	tmps := make([]rune, v31)
-	for i := 0; i < v31; i++ {
+	v39 := r.Intn(100)
+	tmps := make([]rune, v39)
+	for i := 0; i < v39; i++ {
 		tmps[i] = randUTF8RuneUser(r)
 	}
 	return string(tmps)
@@ -3574,11 +4575,11 @@ func randFieldUser(dAtA []byte, r randyUser, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
-		v32 := r.Int63()
+		v40 := r.Int63()
 		if r.Intn(2) == 0 {
-			v32 *= -1
+			v40 *= -1
 		}
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(v32))
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(v40))
 	case 1:
 		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3678,6 +4679,38 @@ func (m *User) Size() (n int) {
 		l = m.ProfilePicture.Size()
 		n += 2 + l + sovUser(uint64(l))
 	}
+	l = len(m.TOTPSecret)
+	if l > 0 {
+		n += 2 + l + sovUser(uint64(l))
+	}
+	if m.TOTPEnabledAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TOTPEnabledAt)
+		n += 2 + l + sovUser(uint64(l))
+	}
+	if len(m.TOTPRecoveryCodes) > 0 {
+		for _, s := range m.TOTPRecoveryCodes {
+			l = len(s)
+			n += 2 + l + sovUser(uint64(l))
+		}
+	}
+	if m.TOTPLastTimeStep != 0 {
+		n += 2 + sovUser(uint64(m.TOTPLastTimeStep))
+	}
+	if m.TOTPFailedAttempts != 0 {
+		n += 2 + sovUser(uint64(m.TOTPFailedAttempts))
+	}
+	if m.TOTPLockedUntil != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TOTPLockedUntil)
+		n += 2 + l + sovUser(uint64(l))
+	}
+	l = len(m.TOTPEncryptedSecret)
+	if l > 0 {
+		n += 2 + l + sovUser(uint64(l))
+	}
+	l = len(m.TOTPSecretKEKLabel)
+	if l > 0 {
+		n += 2 + l + sovUser(uint64(l))
+	}
 	return n
 }
 
@@ -3791,6 +4824,91 @@ func (m *UpdateUserPasswordRequest) Size() (n int) {
 	return n
 }
 
+func (m *SetupUserTOTPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *UserTOTPSetup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.ProvisioningURI)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.QRCode != nil {
+		l = m.QRCode.Size()
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *EnableUserTOTPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *UserTOTPRecoveryCodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecoveryCodes) > 0 {
+		for _, s := range m.RecoveryCodes {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DisableUserTOTPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
 func (m *ListUserAPIKeysRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3842,6 +4960,16 @@ func (m *CreateUserAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovUser(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3855,6 +4983,8 @@ func (m *UpdateUserAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovUser(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovUser(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovUser(uint64(l))
 	return n
 }
 
@@ -4063,6 +5193,14 @@ func (this *User) String() string {
 		`TemporaryPasswordCreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordCreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`TemporaryPasswordExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`ProfilePicture:` + strings.Replace(fmt.Sprintf("%v", this.ProfilePicture), "Picture", "Picture", 1) + `,`,
+		`TOTPSecret:` + fmt.Sprintf("%v", this.TOTPSecret) + `,`,
+		`TOTPEnabledAt:` + strings.Replace(fmt.Sprintf("%v", this.TOTPEnabledAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TOTPRecoveryCodes:` + fmt.Sprintf("%v", this.TOTPRecoveryCodes) + `,`,
+		`TOTPLastTimeStep:` + fmt.Sprintf("%v", this.TOTPLastTimeStep) + `,`,
+		`TOTPFailedAttempts:` + fmt.Sprintf("%v", this.TOTPFailedAttempts) + `,`,
+		`TOTPLockedUntil:` + strings.Replace(fmt.Sprintf("%v", this.TOTPLockedUntil), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TOTPEncryptedSecret:` + fmt.Sprintf("%v", this.TOTPEncryptedSecret) + `,`,
+		`TOTPSecretKEKLabel:` + fmt.Sprintf("%v", this.TOTPSecretKEKLabel) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4151,6 +5289,62 @@ func (this *UpdateUserPasswordRequest) String() string {
 	}, "")
 	return s
 }
+func (this *SetupUserTOTPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetupUserTOTPRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserTOTPSetup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UserTOTPSetup{`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`ProvisioningURI:` + fmt.Sprintf("%v", this.ProvisioningURI) + `,`,
+		`QRCode:` + strings.Replace(fmt.Sprintf("%v", this.QRCode), "Picture", "Picture", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EnableUserTOTPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EnableUserTOTPRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UserTOTPRecoveryCodes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UserTOTPRecoveryCodes{`,
+		`RecoveryCodes:` + fmt.Sprintf("%v", this.RecoveryCodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DisableUserTOTPRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DisableUserTOTPRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ListUserAPIKeysRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -4182,6 +5376,8 @@ func (this *CreateUserAPIKeyRequest) String() string {
 		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedCIDRs:` + fmt.Sprintf("%v", this.AllowedCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4193,6 +5389,7 @@ func (this *UpdateUserAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateUserAPIKeyRequest{`,
 		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5008,64 +6205,11 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUser(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthUser
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthUser
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Users) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Users: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPSecret", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5075,12 +6219,818 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TOTPSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPEnabledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TOTPEnabledAt == nil {
+				m.TOTPEnabledAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TOTPEnabledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPRecoveryCodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TOTPRecoveryCodes = append(m.TOTPRecoveryCodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPLastTimeStep", wireType)
+			}
+			m.TOTPLastTimeStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TOTPLastTimeStep |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPFailedAttempts", wireType)
+			}
+			m.TOTPFailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TOTPFailedAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPLockedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TOTPLockedUntil == nil {
+				m.TOTPLockedUntil = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TOTPLockedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPEncryptedSecret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TOTPEncryptedSecret = append(m.TOTPEncryptedSecret[:0], dAtA[iNdEx:postIndex]...)
+			if m.TOTPEncryptedSecret == nil {
+				m.TOTPEncryptedSecret = []byte{}
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPSecretKEKLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TOTPSecretKEKLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Users) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUsersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvitationToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
 			postIndex := iNdEx + msglen
@@ -5090,8 +7040,7 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Users = append(m.Users, &User{})
-			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5119,7 +7068,7 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5142,10 +7091,10 @@ func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5181,39 +7130,6 @@ func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -5238,7 +7154,7 @@ func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5261,15 +7177,15 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUsersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateUserPasswordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateUserPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5296,13 +7212,13 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5330,13 +7246,13 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Order = string(dAtA[iNdEx:postIndex])
+			m.New = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5346,16 +7262,29 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Old = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeAllAccess", wireType)
 			}
-			m.Page = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5365,11 +7294,12 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.RevokeAllAccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -5394,7 +7324,7 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
+func (m *SetupUserTOTPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5417,17 +7347,167 @@ func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetupUserTOTPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetupUserTOTPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserTOTPSetup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserTOTPSetup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserTOTPSetup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningURI", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5437,30 +7517,29 @@ func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProvisioningURI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvitationToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QRCode", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5470,23 +7549,27 @@ func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvitationToken = string(dAtA[iNdEx:postIndex])
+			if m.QRCode == nil {
+				m.QRCode = &Picture{}
+			}
+			if err := m.QRCode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5512,7 +7595,7 @@ func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
+func (m *EnableUserTOTPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5535,15 +7618,15 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnableUserTOTPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnableUserTOTPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5570,15 +7653,15 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5588,24 +7671,55 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5631,7 +7745,7 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *UserTOTPRecoveryCodes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5654,17 +7768,17 @@ func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserTOTPRecoveryCodes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserTOTPRecoveryCodes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryCodes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5674,24 +7788,23 @@ func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecoveryCodes = append(m.RecoveryCodes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5717,7 +7830,7 @@ func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *DisableUserTOTPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5740,10 +7853,10 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateUserPasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisableUserTOTPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateUserPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisableUserTOTPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5781,39 +7894,7 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.New = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5841,28 +7922,8 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Old = string(dAtA[iNdEx:postIndex])
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevokeAllAccess", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RevokeAllAccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6292,6 +8353,74 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6411,6 +8540,39 @@ func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
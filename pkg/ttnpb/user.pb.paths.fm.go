@@ -26,6 +26,14 @@ var UserFieldPathsNested = []string{
 	"temporary_password",
 	"temporary_password_created_at",
 	"temporary_password_expires_at",
+	"totp_enabled_at",
+	"totp_encrypted_secret",
+	"totp_failed_attempts",
+	"totp_last_time_step",
+	"totp_locked_until",
+	"totp_recovery_codes",
+	"totp_secret",
+	"totp_secret_kek_label",
 	"updated_at",
 }
 
@@ -47,6 +55,14 @@ var UserFieldPathsTopLevel = []string{
 	"temporary_password",
 	"temporary_password_created_at",
 	"temporary_password_expires_at",
+	"totp_enabled_at",
+	"totp_encrypted_secret",
+	"totp_failed_attempts",
+	"totp_last_time_step",
+	"totp_locked_until",
+	"totp_recovery_codes",
+	"totp_secret",
+	"totp_secret_kek_label",
 	"updated_at",
 }
 var UsersFieldPathsNested = []string{
@@ -106,6 +122,14 @@ var CreateUserRequestFieldPathsNested = []string{
 	"user.temporary_password",
 	"user.temporary_password_created_at",
 	"user.temporary_password_expires_at",
+	"user.totp_enabled_at",
+	"user.totp_encrypted_secret",
+	"user.totp_failed_attempts",
+	"user.totp_last_time_step",
+	"user.totp_locked_until",
+	"user.totp_recovery_codes",
+	"user.totp_secret",
+	"user.totp_secret_kek_label",
 	"user.updated_at",
 }
 
@@ -139,6 +163,14 @@ var UpdateUserRequestFieldPathsNested = []string{
 	"user.temporary_password",
 	"user.temporary_password_created_at",
 	"user.temporary_password_expires_at",
+	"user.totp_enabled_at",
+	"user.totp_encrypted_secret",
+	"user.totp_failed_attempts",
+	"user.totp_last_time_step",
+	"user.totp_locked_until",
+	"user.totp_recovery_codes",
+	"user.totp_secret",
+	"user.totp_secret_kek_label",
 	"user.updated_at",
 }
 
@@ -170,6 +202,63 @@ var UpdateUserPasswordRequestFieldPathsTopLevel = []string{
 	"revoke_all_access",
 	"user_ids",
 }
+var SetupUserTOTPRequestFieldPathsNested = []string{
+	"password",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var SetupUserTOTPRequestFieldPathsTopLevel = []string{
+	"password",
+	"user_ids",
+}
+var UserTOTPSetupFieldPathsNested = []string{
+	"provisioning_uri",
+	"qr_code",
+	"qr_code.embedded",
+	"qr_code.embedded.data",
+	"qr_code.embedded.mime_type",
+	"qr_code.sizes",
+	"secret",
+}
+
+var UserTOTPSetupFieldPathsTopLevel = []string{
+	"provisioning_uri",
+	"qr_code",
+	"secret",
+}
+var EnableUserTOTPRequestFieldPathsNested = []string{
+	"code",
+	"password",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var EnableUserTOTPRequestFieldPathsTopLevel = []string{
+	"code",
+	"password",
+	"user_ids",
+}
+var UserTOTPRecoveryCodesFieldPathsNested = []string{
+	"recovery_codes",
+}
+
+var UserTOTPRecoveryCodesFieldPathsTopLevel = []string{
+	"recovery_codes",
+}
+var DisableUserTOTPRequestFieldPathsNested = []string{
+	"code",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var DisableUserTOTPRequestFieldPathsTopLevel = []string{
+	"code",
+	"user_ids",
+}
 var ListUserAPIKeysRequestFieldPathsNested = []string{
 	"limit",
 	"page",
@@ -195,6 +284,8 @@ var GetUserAPIKeyRequestFieldPathsTopLevel = []string{
 	"user_ids",
 }
 var CreateUserAPIKeyRequestFieldPathsNested = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"name",
 	"rights",
 	"user_ids",
@@ -203,16 +294,21 @@ var CreateUserAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateUserAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"name",
 	"rights",
 	"user_ids",
 }
 var UpdateUserAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_cidrs",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
 	"api_key.rights",
+	"field_mask",
 	"user_ids",
 	"user_ids.email",
 	"user_ids.user_id",
@@ -220,6 +316,7 @@ var UpdateUserAPIKeyRequestFieldPathsNested = []string{
 
 var UpdateUserAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
+	"field_mask",
 	"user_ids",
 }
 var InvitationFieldPathsNested = []string{
@@ -209,6 +209,82 @@ func (dst *User) SetFields(src *User, paths ...string) error {
 					dst.ProfilePicture = nil
 				}
 			}
+		case "totp_secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPSecret = src.TOTPSecret
+			} else {
+				var zero string
+				dst.TOTPSecret = zero
+			}
+		case "totp_enabled_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_enabled_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPEnabledAt = src.TOTPEnabledAt
+			} else {
+				dst.TOTPEnabledAt = nil
+			}
+		case "totp_recovery_codes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_recovery_codes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPRecoveryCodes = src.TOTPRecoveryCodes
+			} else {
+				dst.TOTPRecoveryCodes = nil
+			}
+		case "totp_last_time_step":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_last_time_step' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPLastTimeStep = src.TOTPLastTimeStep
+			} else {
+				var zero uint64
+				dst.TOTPLastTimeStep = zero
+			}
+		case "totp_failed_attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_failed_attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPFailedAttempts = src.TOTPFailedAttempts
+			} else {
+				var zero uint32
+				dst.TOTPFailedAttempts = zero
+			}
+		case "totp_locked_until":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_locked_until' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPLockedUntil = src.TOTPLockedUntil
+			} else {
+				dst.TOTPLockedUntil = nil
+			}
+		case "totp_encrypted_secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_encrypted_secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPEncryptedSecret = src.TOTPEncryptedSecret
+			} else {
+				dst.TOTPEncryptedSecret = nil
+			}
+		case "totp_secret_kek_label":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_secret_kek_label' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPSecretKEKLabel = src.TOTPSecretKEKLabel
+			} else {
+				var zero string
+				dst.TOTPSecretKEKLabel = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -493,6 +569,209 @@ func (dst *UpdateUserPasswordRequest) SetFields(src *UpdateUserPasswordRequest,
 	return nil
 }
 
+func (dst *SetupUserTOTPRequest) SetFields(src *SetupUserTOTPRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "password":
+			if len(subs) > 0 {
+				return fmt.Errorf("'password' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Password = src.Password
+			} else {
+				var zero string
+				dst.Password = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *UserTOTPSetup) SetFields(src *UserTOTPSetup, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Secret = src.Secret
+			} else {
+				var zero string
+				dst.Secret = zero
+			}
+		case "provisioning_uri":
+			if len(subs) > 0 {
+				return fmt.Errorf("'provisioning_uri' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ProvisioningURI = src.ProvisioningURI
+			} else {
+				var zero string
+				dst.ProvisioningURI = zero
+			}
+		case "qr_code":
+			if len(subs) > 0 {
+				var newDst, newSrc *Picture
+				if (src == nil || src.QRCode == nil) && dst.QRCode == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.QRCode
+				}
+				if dst.QRCode != nil {
+					newDst = dst.QRCode
+				} else {
+					newDst = &Picture{}
+					dst.QRCode = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.QRCode = src.QRCode
+				} else {
+					dst.QRCode = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EnableUserTOTPRequest) SetFields(src *EnableUserTOTPRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Code = src.Code
+			} else {
+				var zero string
+				dst.Code = zero
+			}
+		case "password":
+			if len(subs) > 0 {
+				return fmt.Errorf("'password' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Password = src.Password
+			} else {
+				var zero string
+				dst.Password = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *UserTOTPRecoveryCodes) SetFields(src *UserTOTPRecoveryCodes, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "recovery_codes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'recovery_codes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RecoveryCodes = src.RecoveryCodes
+			} else {
+				dst.RecoveryCodes = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DisableUserTOTPRequest) SetFields(src *DisableUserTOTPRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Code = src.Code
+			} else {
+				var zero string
+				dst.Code = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ListUserAPIKeysRequest) SetFields(src *ListUserAPIKeysRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -621,6 +900,24 @@ func (dst *CreateUserAPIKeyRequest) SetFields(src *CreateUserAPIKeyRequest, path
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_cidrs":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_cidrs' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedCIDRs = src.AllowedCIDRs
+			} else {
+				dst.AllowedCIDRs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -668,6 +965,16 @@ func (dst *UpdateUserAPIKeyRequest) SetFields(src *UpdateUserAPIKeyRequest, path
 					dst.APIKey = zero
 				}
 			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -229,6 +229,42 @@ func (m *User) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "totp_secret":
+			// no validation rules for TOTPSecret
+		case "totp_enabled_at":
+
+			if v, ok := interface{}(m.GetTOTPEnabledAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UserValidationError{
+						field:  "totp_enabled_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "totp_recovery_codes":
+
+		case "totp_last_time_step":
+			// no validation rules for TOTPLastTimeStep
+		case "totp_failed_attempts":
+			// no validation rules for TOTPFailedAttempts
+		case "totp_locked_until":
+
+			if v, ok := interface{}(m.GetTOTPLockedUntil()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UserValidationError{
+						field:  "totp_locked_until",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "totp_encrypted_secret":
+			// no validation rules for TOTPEncryptedSecret
+		case "totp_secret_kek_label":
+			// no validation rules for TOTPSecretKEKLabel
 		default:
 			return UserValidationError{
 				field:  name,
@@ -1065,6 +1101,487 @@ var _ interface {
 	ErrorName() string
 } = UpdateUserPasswordRequestValidationError{}
 
+// ValidateFields checks the field values on SetupUserTOTPRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetupUserTOTPRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetupUserTOTPRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetupUserTOTPRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "password":
+			// no validation rules for Password
+		default:
+			return SetupUserTOTPRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetupUserTOTPRequestValidationError is the validation error returned by
+// SetupUserTOTPRequest.ValidateFields if the designated constraints aren't met.
+type SetupUserTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetupUserTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetupUserTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetupUserTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetupUserTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetupUserTOTPRequestValidationError) ErrorName() string {
+	return "SetupUserTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetupUserTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetupUserTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetupUserTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetupUserTOTPRequestValidationError{}
+
+// ValidateFields checks the field values on UserTOTPSetup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UserTOTPSetup) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UserTOTPSetupFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "secret":
+			// no validation rules for Secret
+		case "provisioning_uri":
+			// no validation rules for ProvisioningURI
+		case "qr_code":
+
+			if v, ok := interface{}(m.GetQRCode()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UserTOTPSetupValidationError{
+						field:  "qr_code",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return UserTOTPSetupValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UserTOTPSetupValidationError is the validation error returned by
+// UserTOTPSetup.ValidateFields if the designated constraints aren't met.
+type UserTOTPSetupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserTOTPSetupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserTOTPSetupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserTOTPSetupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserTOTPSetupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserTOTPSetupValidationError) ErrorName() string { return "UserTOTPSetupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserTOTPSetupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserTOTPSetup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserTOTPSetupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserTOTPSetupValidationError{}
+
+// ValidateFields checks the field values on EnableUserTOTPRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EnableUserTOTPRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EnableUserTOTPRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EnableUserTOTPRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "code":
+
+			if !_EnableUserTOTPRequest_Code_Pattern.MatchString(m.GetCode()) {
+				return EnableUserTOTPRequestValidationError{
+					field:  "code",
+					reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+				}
+			}
+
+		case "password":
+			// no validation rules for Password
+		default:
+			return EnableUserTOTPRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EnableUserTOTPRequestValidationError is the validation error returned by
+// EnableUserTOTPRequest.ValidateFields if the designated constraints aren't met.
+type EnableUserTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableUserTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableUserTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableUserTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableUserTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableUserTOTPRequestValidationError) ErrorName() string {
+	return "EnableUserTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableUserTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableUserTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableUserTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableUserTOTPRequestValidationError{}
+
+var _EnableUserTOTPRequest_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// ValidateFields checks the field values on UserTOTPRecoveryCodes with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UserTOTPRecoveryCodes) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UserTOTPRecoveryCodesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "recovery_codes":
+
+		default:
+			return UserTOTPRecoveryCodesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UserTOTPRecoveryCodesValidationError is the validation error returned by
+// UserTOTPRecoveryCodes.ValidateFields if the designated constraints aren't met.
+type UserTOTPRecoveryCodesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserTOTPRecoveryCodesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserTOTPRecoveryCodesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserTOTPRecoveryCodesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserTOTPRecoveryCodesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserTOTPRecoveryCodesValidationError) ErrorName() string {
+	return "UserTOTPRecoveryCodesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserTOTPRecoveryCodesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserTOTPRecoveryCodes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserTOTPRecoveryCodesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserTOTPRecoveryCodesValidationError{}
+
+// ValidateFields checks the field values on DisableUserTOTPRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DisableUserTOTPRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DisableUserTOTPRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DisableUserTOTPRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "code":
+
+			if utf8.RuneCountInString(m.GetCode()) > 32 {
+				return DisableUserTOTPRequestValidationError{
+					field:  "code",
+					reason: "value length must be at most 32 runes",
+				}
+			}
+
+		default:
+			return DisableUserTOTPRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DisableUserTOTPRequestValidationError is the validation error returned by
+// DisableUserTOTPRequest.ValidateFields if the designated constraints aren't met.
+type DisableUserTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableUserTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableUserTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableUserTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableUserTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableUserTOTPRequestValidationError) ErrorName() string {
+	return "DisableUserTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableUserTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableUserTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableUserTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableUserTOTPRequestValidationError{}
+
 // ValidateFields checks the field values on ListUserAPIKeysRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1314,6 +1831,32 @@ func (m *CreateUserAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateUserAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_cidrs":
+
+			for idx, item := range m.GetAllowedCIDRs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 43 {
+					return CreateUserAPIKeyRequestValidationError{
+						field:  fmt.Sprintf("allowed_cidrs[%v]", idx),
+						reason: "value length must be at most 43 runes",
+					}
+				}
+
+			}
+
 		default:
 			return CreateUserAPIKeyRequestValidationError{
 				field:  name,
@@ -1419,6 +1962,18 @@ func (m *UpdateUserAPIKeyRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateUserAPIKeyRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return UpdateUserAPIKeyRequestValidationError{
 				field:  name,
@@ -37,62 +37,70 @@ func init() {
 }
 
 var fileDescriptor_82df9ba9356987c4 = []byte{
-	// 869 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x4f, 0x6c, 0xe3, 0x44,
-	0x14, 0xc6, 0x3d, 0xa5, 0x44, 0x30, 0x1b, 0x45, 0xec, 0xb0, 0x6c, 0xd8, 0xb4, 0x7a, 0xcb, 0x9a,
-	0xee, 0x56, 0x84, 0x8d, 0x2d, 0xd2, 0x95, 0x90, 0xf6, 0xb6, 0xfc, 0x51, 0xb5, 0x02, 0x89, 0xd5,
-	0xee, 0xf6, 0x02, 0x12, 0x91, 0x93, 0x4c, 0x5d, 0x2b, 0xad, 0x6d, 0x3c, 0xd3, 0x56, 0xa6, 0x6a,
-	0x55, 0x7a, 0xaa, 0xc4, 0x05, 0x09, 0x24, 0x38, 0x22, 0x4e, 0x3d, 0xf6, 0xd8, 0x63, 0x8f, 0x3d,
-	0x56, 0xe2, 0xd2, 0x63, 0x63, 0x73, 0xe8, 0xb1, 0xc7, 0x8a, 0x03, 0x42, 0x9e, 0xb1, 0x93, 0xc6,
-	0xb1, 0x1b, 0xdf, 0xdc, 0x37, 0x9f, 0xdf, 0xef, 0x7b, 0xcf, 0xef, 0x4d, 0x83, 0x1f, 0xae, 0x3a,
-	0x9e, 0xb1, 0x69, 0xd8, 0x0d, 0xc6, 0x8d, 0x4e, 0x4f, 0x37, 0x5c, 0x4b, 0x5f, 0x67, 0xd4, 0x6b,
-	0x31, 0xea, 0x6d, 0x58, 0x1d, 0xca, 0x34, 0xd7, 0x73, 0xb8, 0x43, 0x2a, 0x9c, 0xdb, 0x5a, 0x2c,
-	0xd5, 0x36, 0x16, 0x6a, 0xb3, 0xa6, 0xe3, 0x98, 0xab, 0x54, 0xe8, 0x0d, 0xdb, 0x76, 0xb8, 0xc1,
-	0x2d, 0xc7, 0x8e, 0xd5, 0xb5, 0x99, 0xf8, 0x54, 0xfc, 0xd5, 0x5e, 0x5f, 0xd6, 0xe9, 0x9a, 0xcb,
-	0xfd, 0xf8, 0xf0, 0xc3, 0x71, 0xa2, 0xd5, 0xa5, 0x36, 0xb7, 0x96, 0x2d, 0xea, 0x25, 0x19, 0x60,
-	0x5c, 0xe4, 0x59, 0xe6, 0x0a, 0x4f, 0xce, 0x67, 0xb3, 0x6d, 0xcb, 0xd3, 0xe6, 0xbf, 0x6f, 0xe2,
-	0xf2, 0x12, 0xa3, 0xde, 0x4b, 0x6a, 0x5a, 0x8c, 0x7b, 0x3e, 0x79, 0x8d, 0x4b, 0x9f, 0x7b, 0xd4,
-	0xe0, 0x94, 0x3c, 0xd0, 0x46, 0x2b, 0xd1, 0x64, 0x5c, 0xaa, 0x7f, 0x58, 0xa7, 0x8c, 0xd7, 0xee,
-	0xa4, 0x25, 0xd1, 0xa1, 0x7a, 0x7b, 0xef, 0xef, 0x7f, 0x7e, 0x9d, 0xba, 0xa5, 0x96, 0x04, 0x88,
-	0x3d, 0x45, 0x75, 0xf2, 0x3d, 0x7e, 0x63, 0x91, 0x72, 0x02, 0x69, 0xfd, 0x22, 0xe5, 0x93, 0xf3,
-	0x3d, 0x10, 0xf9, 0x66, 0xc8, 0x3d, 0x99, 0x4f, 0xdf, 0x12, 0x6d, 0xb7, 0xba, 0x4c, 0x8b, 0x1f,
-	0xb6, 0xc9, 0x37, 0x78, 0xfa, 0x6b, 0x8b, 0x71, 0xf2, 0x41, 0x3a, 0x41, 0x14, 0x8d, 0x92, 0xb0,
-	0x04, 0xf1, 0x5e, 0x16, 0x82, 0xa9, 0x15, 0xc1, 0x78, 0x8b, 0xc4, 0x9e, 0x89, 0x89, 0x4b, 0x4b,
-	0x6e, 0x37, 0xb3, 0x0d, 0x32, 0x3e, 0xd9, 0xf6, 0x9c, 0x48, 0x09, 0xb5, 0x11, 0xdb, 0xda, 0x75,
-	0xdb, 0x51, 0x67, 0x7e, 0x47, 0xb8, 0x2a, 0x1b, 0xfb, 0x9a, 0xae, 0xb9, 0x8e, 0x67, 0x78, 0xfe,
-	0x0b, 0x83, 0xb1, 0x4d, 0xc7, 0xeb, 0x12, 0x2d, 0xfb, 0x0b, 0x8c, 0x09, 0x13, 0x1f, 0x77, 0x35,
-	0x39, 0x4d, 0x5a, 0x32, 0x4d, 0xda, 0x97, 0xd1, 0x34, 0xa9, 0x4f, 0x84, 0x13, 0x4d, 0x7d, 0x9c,
-	0xdb, 0x40, 0x9d, 0x27, 0x39, 0x5b, 0x6e, 0x42, 0xdf, 0x43, 0xb8, 0x22, 0x6b, 0x1d, 0x18, 0xfa,
-	0x28, 0xbf, 0x17, 0x45, 0xbd, 0x34, 0x84, 0x97, 0xf9, 0x9a, 0x9a, 0xef, 0x25, 0x71, 0x10, 0xb5,
-	0xe7, 0x3b, 0x5c, 0xfa, 0x82, 0xae, 0x52, 0x4e, 0xc9, 0xfd, 0xac, 0x26, 0x3f, 0x1f, 0xae, 0x43,
-	0x2e, 0xf1, 0x7d, 0x41, 0x24, 0xf5, 0x77, 0x52, 0xc4, 0xed, 0xe6, 0x7f, 0xd3, 0x18, 0x47, 0x59,
-	0x9e, 0x75, 0x3a, 0x94, 0x31, 0xb2, 0x8c, 0x71, 0x34, 0x2e, 0x2f, 0xc5, 0xf6, 0x14, 0xe1, 0xa5,
-	0x04, 0xf2, 0x45, 0xf5, 0xbe, 0xe0, 0xdd, 0x23, 0xd5, 0x34, 0x2f, 0xde, 0x4b, 0xb2, 0x83, 0xcb,
-	0xf2, 0x43, 0x3e, 0x7b, 0xf1, 0xfc, 0x2b, 0xea, 0x93, 0xf9, 0xfc, 0x45, 0x93, 0x8a, 0x61, 0x4f,
-	0x53, 0x42, 0x79, 0x9c, 0xf4, 0x54, 0xbd, 0xa1, 0xa7, 0x86, 0x6b, 0x35, 0x7a, 0xd4, 0x17, 0xcb,
-	0xf8, 0x23, 0xbe, 0x15, 0xd5, 0x29, 0x5f, 0x66, 0xe4, 0x51, 0xde, 0xce, 0xc4, 0x82, 0x84, 0x5e,
-	0xcd, 0xa6, 0x33, 0xb5, 0x2e, 0xf0, 0x73, 0xa4, 0x00, 0x9e, 0xec, 0xe0, 0xb7, 0x17, 0x69, 0x8c,
-	0x26, 0x73, 0x39, 0xd7, 0x41, 0xb1, 0xaa, 0x17, 0x04, 0xb6, 0x41, 0x3e, 0x9e, 0x8c, 0xd5, 0xb7,
-	0x7a, 0xd4, 0x17, 0x17, 0xc5, 0xcf, 0x08, 0x97, 0xe5, 0xd0, 0xe6, 0x35, 0x7f, 0x38, 0xd2, 0xc5,
-	0x6c, 0x3c, 0x15, 0x36, 0x9e, 0xd4, 0xf4, 0x22, 0x36, 0x0c, 0xd7, 0x6a, 0xf5, 0xa8, 0xaf, 0xc9,
-	0xe5, 0x6f, 0x1e, 0x4f, 0xe1, 0xbb, 0x62, 0xac, 0xec, 0x0d, 0x4b, 0xfe, 0x5f, 0x18, 0xdc, 0xc3,
-	0x6d, 0x3c, 0xfd, 0x8a, 0xda, 0x5d, 0xf2, 0x30, 0x8d, 0x8d, 0xa2, 0xd7, 0xf5, 0xd2, 0x5d, 0x2d,
-	0x2d, 0x1b, 0x4a, 0xd4, 0xaa, 0x70, 0x78, 0x5b, 0x2d, 0xeb, 0xd6, 0x20, 0x28, 0x06, 0xc1, 0x88,
-	0x6f, 0xcd, 0xcc, 0x09, 0x18, 0x26, 0x18, 0x4c, 0xc0, 0x4c, 0x3e, 0x84, 0xa9, 0x77, 0x04, 0xa5,
-	0x42, 0x46, 0x28, 0xa4, 0x35, 0xd8, 0xdf, 0xb1, 0x46, 0xcb, 0xf8, 0x78, 0x29, 0x79, 0x7b, 0x1c,
-	0x03, 0xea, 0x23, 0x80, 0xe6, 0x6f, 0x53, 0xf8, 0xdd, 0xa8, 0x85, 0xaf, 0x28, 0x63, 0xd7, 0xfb,
-	0xe7, 0xc7, 0xb5, 0xcd, 0xe7, 0x4d, 0x77, 0xfc, 0xc2, 0xa0, 0xb8, 0xd9, 0xac, 0x7d, 0x4f, 0x44,
-	0x45, 0x66, 0x9c, 0xc5, 0x5a, 0xf2, 0x13, 0x1a, 0x14, 0xfd, 0xe8, 0x86, 0xa4, 0x45, 0xee, 0xae,
-	0x4f, 0x05, 0xf6, 0x93, 0xba, 0x3e, 0x19, 0xab, 0x6f, 0xc5, 0x4f, 0x51, 0xf4, 0xb3, 0xbf, 0xd0,
-	0x49, 0x1f, 0xd0, 0x69, 0x1f, 0xd0, 0x59, 0x1f, 0x94, 0xf3, 0x3e, 0x28, 0x17, 0x7d, 0x50, 0x2e,
-	0xfb, 0xa0, 0x5c, 0xf5, 0x01, 0xed, 0x06, 0x80, 0xf6, 0x03, 0x50, 0x0e, 0x02, 0x40, 0x87, 0x01,
-	0x28, 0x47, 0x01, 0x28, 0xc7, 0x01, 0x28, 0x27, 0x01, 0xa0, 0xd3, 0x00, 0xd0, 0x59, 0x00, 0xca,
-	0x79, 0x00, 0xe8, 0x22, 0x00, 0xe5, 0x32, 0x00, 0x74, 0x15, 0x80, 0xb2, 0x1b, 0x82, 0xb2, 0x1f,
-	0x02, 0xfa, 0x25, 0x04, 0xe5, 0x8f, 0x10, 0xd0, 0x9f, 0x21, 0x28, 0x07, 0x21, 0x28, 0x87, 0x21,
-	0xa0, 0xa3, 0x10, 0xd0, 0x71, 0x08, 0xe8, 0xdb, 0xc7, 0xa6, 0xa3, 0xf1, 0x15, 0xca, 0x57, 0x2c,
-	0xdb, 0x64, 0x9a, 0x4d, 0xf9, 0xa6, 0xe3, 0xf5, 0xf4, 0xd1, 0x5f, 0x20, 0x6e, 0xcf, 0xd4, 0x39,
-	0xb7, 0xdd, 0x76, 0xbb, 0x24, 0xaa, 0x5d, 0xf8, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x7d, 0x9e,
-	0x1c, 0x5a, 0x09, 0x00, 0x00,
+	// 1004 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x31, 0x6c, 0xdb, 0x46,
+	0x14, 0x86, 0x79, 0xa9, 0x21, 0xd4, 0x67, 0xc3, 0x68, 0xae, 0x49, 0xd4, 0xc8, 0xce, 0xa5, 0x61,
+	0x65, 0x1b, 0x55, 0x6c, 0xb2, 0xb1, 0x03, 0x14, 0xc8, 0x96, 0x26, 0x81, 0x11, 0xb4, 0x40, 0x0c,
+	0xdb, 0x59, 0x5a, 0xa0, 0x02, 0x25, 0x3d, 0xcb, 0x07, 0x39, 0x24, 0xcb, 0x3b, 0xdb, 0x60, 0x8d,
+	0x04, 0x6e, 0xa6, 0x00, 0xed, 0x50, 0xa0, 0x05, 0xda, 0xb1, 0xe8, 0x94, 0x31, 0x63, 0x46, 0x8f,
+	0x19, 0x03, 0x74, 0xc9, 0x18, 0x91, 0x1d, 0x32, 0xa6, 0x5b, 0xa6, 0xa2, 0xb8, 0x3b, 0x52, 0xb2,
+	0x28, 0xd2, 0x12, 0x3a, 0x59, 0xbe, 0xf7, 0xeb, 0x7d, 0xff, 0x7b, 0x7c, 0xf7, 0x28, 0x3c, 0xbf,
+	0xeb, 0x05, 0xce, 0x81, 0xe3, 0x2e, 0x73, 0xe1, 0x34, 0x3b, 0xb6, 0xe3, 0x33, 0x7b, 0x8f, 0x43,
+	0x50, 0xe7, 0x10, 0xec, 0xb3, 0x26, 0x70, 0xcb, 0x0f, 0x3c, 0xe1, 0x91, 0x19, 0x21, 0x5c, 0x2b,
+	0x91, 0x5a, 0xfb, 0xab, 0x95, 0xb9, 0xb6, 0xe7, 0xb5, 0x77, 0x41, 0xe9, 0x1d, 0xd7, 0xf5, 0x84,
+	0x23, 0x98, 0xe7, 0x26, 0xea, 0xca, 0x6c, 0x12, 0x55, 0xff, 0x35, 0xf6, 0xb6, 0x6d, 0x78, 0xe0,
+	0x8b, 0x30, 0x09, 0x7e, 0x32, 0x4c, 0x64, 0x2d, 0x70, 0x05, 0xdb, 0x66, 0x10, 0xa4, 0x19, 0xe8,
+	0xb0, 0x28, 0x60, 0xed, 0x1d, 0x91, 0xc6, 0xe7, 0xf2, 0x6d, 0xeb, 0xe8, 0xca, 0x3f, 0x93, 0x78,
+	0xfa, 0x3e, 0x87, 0x60, 0x03, 0xda, 0x8c, 0x8b, 0x20, 0x24, 0x5b, 0xb8, 0x74, 0x2b, 0x00, 0x47,
+	0x00, 0xb9, 0x62, 0x0d, 0x56, 0x62, 0xe9, 0x73, 0xad, 0xfe, 0x6e, 0x0f, 0xb8, 0xa8, 0x9c, 0xcb,
+	0x4a, 0x64, 0xd0, 0x3c, 0xfb, 0xf8, 0xaf, 0xbf, 0x7f, 0x39, 0x33, 0x65, 0x96, 0x14, 0x88, 0xdf,
+	0x40, 0x35, 0xf2, 0x2d, 0x7e, 0x6f, 0x0d, 0x04, 0xa1, 0x59, 0xfd, 0x1a, 0x88, 0xd1, 0xf9, 0xae,
+	0xa8, 0x7c, 0xb3, 0xe4, 0xa2, 0xce, 0x67, 0x1f, 0xca, 0x3f, 0x75, 0xd6, 0xe2, 0x56, 0xf2, 0xe1,
+	0x21, 0xb9, 0x87, 0x27, 0xbe, 0x62, 0x5c, 0x90, 0x8f, 0xb3, 0x09, 0xe4, 0xa9, 0x4c, 0xc2, 0x53,
+	0xc4, 0xf9, 0x3c, 0x04, 0x37, 0x67, 0x14, 0xe3, 0x7d, 0x92, 0x78, 0x26, 0x6d, 0x5c, 0xba, 0xef,
+	0xb7, 0x72, 0xdb, 0xa0, 0xcf, 0x47, 0xdb, 0xae, 0xaa, 0x94, 0xb4, 0x32, 0x60, 0xdb, 0x3a, 0x69,
+	0x5b, 0x76, 0xe6, 0x37, 0x84, 0xcb, 0xba, 0xb1, 0x5b, 0xf0, 0xc0, 0xf7, 0x02, 0x27, 0x08, 0xd7,
+	0x1d, 0xce, 0x0f, 0xbc, 0xa0, 0x45, 0xac, 0xfc, 0x27, 0x30, 0x24, 0x4c, 0x7d, 0x5c, 0xb0, 0xf4,
+	0x34, 0x59, 0xe9, 0x34, 0x59, 0x77, 0xe4, 0x34, 0x99, 0xd7, 0x95, 0x13, 0xcb, 0x5c, 0x2a, 0x6c,
+	0xa0, 0x2d, 0xd2, 0x9c, 0x75, 0x3f, 0xa5, 0x3f, 0x46, 0x78, 0x46, 0xd7, 0xda, 0x33, 0xf4, 0x69,
+	0x71, 0x2f, 0xc6, 0xf5, 0xb2, 0xac, 0xbc, 0x2c, 0x56, 0xcc, 0x62, 0x2f, 0xa9, 0x03, 0xd9, 0x9e,
+	0x6f, 0x70, 0xe9, 0x36, 0xec, 0x82, 0x00, 0x72, 0x39, 0xaf, 0xc9, 0x77, 0xfb, 0xd7, 0xa1, 0x90,
+	0xf8, 0x91, 0x22, 0x92, 0xda, 0x07, 0x19, 0xe2, 0x43, 0xf2, 0x08, 0x4f, 0x6e, 0x82, 0xd8, 0xf3,
+	0xb7, 0xee, 0x6d, 0xad, 0x93, 0x6a, 0x36, 0xbf, 0x0a, 0x49, 0x88, 0x0c, 0xa7, 0x65, 0x5d, 0xca,
+	0x73, 0x21, 0x05, 0x4a, 0x6d, 0x2e, 0x29, 0xd6, 0x82, 0x59, 0x3d, 0xa5, 0xd3, 0x9e, 0xf0, 0x6d,
+	0x2e, 0xd5, 0xe4, 0x27, 0x84, 0xf1, 0x1d, 0xd7, 0x69, 0xec, 0x82, 0x72, 0x30, 0x9f, 0xcd, 0xad,
+	0x63, 0x59, 0x0b, 0xf3, 0x45, 0x16, 0x36, 0xa0, 0xe9, 0xed, 0x43, 0x10, 0xde, 0xf2, 0x5a, 0xc0,
+	0xcd, 0xcf, 0x94, 0x95, 0x9a, 0x39, 0x3f, 0xc2, 0x0a, 0x28, 0x88, 0xec, 0xf5, 0x11, 0xc2, 0x53,
+	0xb7, 0x19, 0xef, 0xf9, 0x59, 0xc8, 0x82, 0x92, 0x60, 0xd6, 0x50, 0x51, 0xe3, 0xaf, 0x29, 0x07,
+	0x57, 0xcd, 0x85, 0x11, 0x0e, 0x5a, 0x8c, 0xa7, 0x16, 0x9a, 0x78, 0x72, 0x03, 0x38, 0x08, 0xc5,
+	0xff, 0xdf, 0x4f, 0xfc, 0x92, 0x02, 0x97, 0x6b, 0xe7, 0xb3, 0x4f, 0x5c, 0xf1, 0x56, 0xfe, 0x9d,
+	0xc0, 0x58, 0xa6, 0xba, 0xd9, 0x6c, 0x02, 0xe7, 0x64, 0x1b, 0x63, 0xb9, 0x25, 0x36, 0xd4, 0xd2,
+	0x1c, 0x07, 0x9a, 0x11, 0xe8, 0x2f, 0x9a, 0x97, 0x15, 0xf4, 0x22, 0x29, 0x0f, 0x41, 0xf5, 0x3a,
+	0x26, 0x8f, 0xf0, 0xb4, 0xbe, 0xbf, 0x37, 0xd7, 0xef, 0x7e, 0x09, 0x21, 0x59, 0x2c, 0xde, 0xaf,
+	0x5a, 0xd1, 0xef, 0x6f, 0x46, 0xa8, 0xc3, 0xe9, 0x55, 0x32, 0x4f, 0xb9, 0x4a, 0x8e, 0xcf, 0x96,
+	0x3b, 0x10, 0xaa, 0x1d, 0xfc, 0x3d, 0x9e, 0x92, 0x75, 0xea, 0x2f, 0xf3, 0xe1, 0xa7, 0x9b, 0xae,
+	0xca, 0x44, 0x90, 0xd2, 0xcb, 0xf9, 0x74, 0x6e, 0xd6, 0x14, 0xbe, 0x4a, 0xc6, 0xc0, 0xcb, 0x9b,
+	0xb6, 0x06, 0x09, 0x9a, 0x54, 0x0b, 0xde, 0x02, 0xe3, 0x55, 0xbd, 0xaa, 0xb0, 0xcb, 0xe4, 0xea,
+	0x68, 0xac, 0x7d, 0xd8, 0x81, 0x50, 0xdd, 0xf4, 0x1f, 0x11, 0x9e, 0xd6, 0xbb, 0xaa, 0xa8, 0xf9,
+	0xfd, 0x4d, 0x36, 0x9e, 0x8d, 0x1b, 0xca, 0xc6, 0xf5, 0x8a, 0x3d, 0x8e, 0x0d, 0xc7, 0x67, 0xf5,
+	0x0e, 0x84, 0x96, 0xde, 0xf9, 0x2b, 0xc7, 0x67, 0xf0, 0x05, 0x35, 0x56, 0xee, 0x3e, 0xd3, 0x3f,
+	0x07, 0x7a, 0xaf, 0xdf, 0x06, 0x9e, 0xd8, 0x04, 0xb7, 0x35, 0xbc, 0x0b, 0xe4, 0xe9, 0x49, 0xbd,
+	0x76, 0x57, 0xc9, 0xca, 0xfa, 0x12, 0xb3, 0xac, 0x1c, 0x9e, 0x35, 0xa7, 0x6d, 0xd6, 0x3b, 0x54,
+	0x83, 0xe0, 0x24, 0x2f, 0xcb, 0xdc, 0x09, 0xe8, 0x27, 0xe8, 0x4d, 0xc0, 0x6c, 0x31, 0x84, 0x9b,
+	0xe7, 0x14, 0x65, 0x86, 0x0c, 0x50, 0x48, 0xbd, 0xb7, 0xb6, 0x87, 0x1a, 0xad, 0xcf, 0x87, 0x4b,
+	0x29, 0xba, 0xcc, 0x09, 0xa0, 0x36, 0x00, 0x58, 0xf9, 0xf5, 0x0c, 0xfe, 0x50, 0xb6, 0x70, 0x13,
+	0x38, 0x3f, 0xd9, 0xbf, 0x30, 0xa9, 0x6d, 0xb1, 0x68, 0xba, 0x93, 0x2f, 0xf4, 0x8a, 0x9b, 0xcb,
+	0xbb, 0xef, 0xa9, 0x68, 0x9c, 0x19, 0xe7, 0x89, 0x96, 0xfc, 0x80, 0x7a, 0x45, 0x2f, 0x9c, 0x92,
+	0x74, 0x9c, 0x05, 0xf6, 0xb9, 0xc2, 0x5e, 0xab, 0xd9, 0xa3, 0xb1, 0xf6, 0x61, 0xf2, 0x49, 0x9e,
+	0x7e, 0xf1, 0x27, 0x7a, 0xd1, 0xa5, 0xe8, 0x65, 0x97, 0xa2, 0x57, 0x5d, 0x6a, 0xbc, 0xee, 0x52,
+	0xe3, 0x4d, 0x97, 0x1a, 0x6f, 0xbb, 0xd4, 0x78, 0xd7, 0xa5, 0xe8, 0x28, 0xa2, 0xe8, 0x49, 0x44,
+	0x8d, 0xa7, 0x11, 0x45, 0xcf, 0x22, 0x6a, 0x3c, 0x8f, 0xa8, 0x71, 0x1c, 0x51, 0xe3, 0x45, 0x44,
+	0xd1, 0xcb, 0x88, 0xa2, 0x57, 0x11, 0x35, 0x5e, 0x47, 0x14, 0xbd, 0x89, 0xa8, 0xf1, 0x36, 0xa2,
+	0xe8, 0x5d, 0x44, 0x8d, 0xa3, 0x98, 0x1a, 0x4f, 0x62, 0x8a, 0x7e, 0x8e, 0xa9, 0xf1, 0x7b, 0x4c,
+	0xd1, 0x1f, 0x31, 0x35, 0x9e, 0xc6, 0xd4, 0x78, 0x16, 0x53, 0xf4, 0x3c, 0xa6, 0xe8, 0x38, 0xa6,
+	0xe8, 0xeb, 0xa5, 0xb6, 0x67, 0x89, 0x1d, 0x10, 0x3b, 0xcc, 0x6d, 0x73, 0xcb, 0x05, 0x71, 0xe0,
+	0x05, 0x1d, 0x7b, 0xf0, 0x87, 0xa7, 0xdf, 0x69, 0xdb, 0x42, 0xb8, 0x7e, 0xa3, 0x51, 0x52, 0xd5,
+	0xae, 0xfe, 0x37, 0x00, 0xe8, 0x51, 0xbf, 0x95, 0x51, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -120,6 +128,18 @@ type UserRegistryClient interface {
 	CreateTemporaryPassword(ctx context.Context, in *CreateTemporaryPasswordRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	Delete(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Set up two-factor authentication with TOTP for the user.
+	// This generates a new TOTP secret, which needs to be confirmed with EnableTOTP.
+	SetupTOTP(ctx context.Context, in *SetupUserTOTPRequest, opts ...grpc.CallOption) (*UserTOTPSetup, error)
+	// Enable two-factor authentication with the TOTP secret of SetupTOTP.
+	// The returned recovery codes are never shown again.
+	EnableTOTP(ctx context.Context, in *EnableUserTOTPRequest, opts ...grpc.CallOption) (*UserTOTPRecoveryCodes, error)
+	// Disable two-factor authentication with TOTP for the user.
+	// This needs to be confirmed with a TOTP code or a recovery code.
+	DisableTOTP(ctx context.Context, in *DisableUserTOTPRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Reset two-factor authentication of the user, for when the user lost access
+	// to the authenticator app and the recovery codes. This method is restricted to admins.
+	ResetTOTP(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type userRegistryClient struct {
@@ -193,6 +213,42 @@ func (c *userRegistryClient) Delete(ctx context.Context, in *UserIdentifiers, op
 	return out, nil
 }
 
+func (c *userRegistryClient) SetupTOTP(ctx context.Context, in *SetupUserTOTPRequest, opts ...grpc.CallOption) (*UserTOTPSetup, error) {
+	out := new(UserTOTPSetup)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/SetupTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) EnableTOTP(ctx context.Context, in *EnableUserTOTPRequest, opts ...grpc.CallOption) (*UserTOTPRecoveryCodes, error) {
+	out := new(UserTOTPRecoveryCodes)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/EnableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) DisableTOTP(ctx context.Context, in *DisableUserTOTPRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/DisableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) ResetTOTP(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/ResetTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserRegistryServer is the server API for UserRegistry service.
 type UserRegistryServer interface {
 	// Register a new user. This method may be restricted by network settings.
@@ -208,6 +264,18 @@ type UserRegistryServer interface {
 	CreateTemporaryPassword(context.Context, *CreateTemporaryPasswordRequest) (*types.Empty, error)
 	UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*types.Empty, error)
 	Delete(context.Context, *UserIdentifiers) (*types.Empty, error)
+	// Set up two-factor authentication with TOTP for the user.
+	// This generates a new TOTP secret, which needs to be confirmed with EnableTOTP.
+	SetupTOTP(context.Context, *SetupUserTOTPRequest) (*UserTOTPSetup, error)
+	// Enable two-factor authentication with the TOTP secret of SetupTOTP.
+	// The returned recovery codes are never shown again.
+	EnableTOTP(context.Context, *EnableUserTOTPRequest) (*UserTOTPRecoveryCodes, error)
+	// Disable two-factor authentication with TOTP for the user.
+	// This needs to be confirmed with a TOTP code or a recovery code.
+	DisableTOTP(context.Context, *DisableUserTOTPRequest) (*types.Empty, error)
+	// Reset two-factor authentication of the user, for when the user lost access
+	// to the authenticator app and the recovery codes. This method is restricted to admins.
+	ResetTOTP(context.Context, *UserIdentifiers) (*types.Empty, error)
 }
 
 // UnimplementedUserRegistryServer can be embedded to have forward compatible implementations.
@@ -235,6 +303,18 @@ func (*UnimplementedUserRegistryServer) UpdatePassword(ctx context.Context, req
 func (*UnimplementedUserRegistryServer) Delete(ctx context.Context, req *UserIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedUserRegistryServer) SetupTOTP(ctx context.Context, req *SetupUserTOTPRequest) (*UserTOTPSetup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupTOTP not implemented")
+}
+func (*UnimplementedUserRegistryServer) EnableTOTP(ctx context.Context, req *EnableUserTOTPRequest) (*UserTOTPRecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTOTP not implemented")
+}
+func (*UnimplementedUserRegistryServer) DisableTOTP(ctx context.Context, req *DisableUserTOTPRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (*UnimplementedUserRegistryServer) ResetTOTP(ctx context.Context, req *UserIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTOTP not implemented")
+}
 
 func RegisterUserRegistryServer(s *grpc.Server, srv UserRegistryServer) {
 	s.RegisterService(&_UserRegistry_serviceDesc, srv)
@@ -366,6 +446,78 @@ func _UserRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRegistry_SetupTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupUserTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).SetupTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/SetupTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).SetupTOTP(ctx, req.(*SetupUserTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_EnableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableUserTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).EnableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/EnableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).EnableTOTP(ctx, req.(*EnableUserTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableUserTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/DisableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).DisableTOTP(ctx, req.(*DisableUserTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_ResetTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).ResetTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/ResetTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).ResetTOTP(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.UserRegistry",
 	HandlerType: (*UserRegistryServer)(nil),
@@ -398,6 +550,22 @@ var _UserRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UserRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "SetupTOTP",
+			Handler:    _UserRegistry_SetupTOTP_Handler,
+		},
+		{
+			MethodName: "EnableTOTP",
+			Handler:    _UserRegistry_EnableTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _UserRegistry_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "ResetTOTP",
+			Handler:    _UserRegistry_ResetTOTP_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/user_services.proto",
@@ -0,0 +1,61 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ttnbolt "go.thethings.network/lorawan-stack/pkg/bolt"
+	"go.thethings.network/lorawan-stack/pkg/config"
+)
+
+// NewBolt returns a new namespaced *bolt.Client backed by a database in a temporary directory
+// and a close function, which should be called after the client is not needed anymore to remove the database.
+func NewBolt(t testing.TB, namespace ...string) (*ttnbolt.Client, func()) {
+	dir, err := ioutil.TempDir("", "bolttest")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory: `%s`", err)
+		return nil, nil
+	}
+
+	db, err := ttnbolt.Open(config.Bolt{
+		Path:    filepath.Join(dir, "test.db"),
+		Timeout: time.Second,
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("Failed to open bbolt database: `%s`", err)
+		return nil, nil
+	}
+
+	cl, err := ttnbolt.New(&ttnbolt.Config{
+		DB:        db,
+		Namespace: append(namespace, t.Name()),
+	})
+	if err != nil {
+		db.Close()
+		os.RemoveAll(dir)
+		t.Fatalf("Failed to create bbolt client: `%s`", err)
+		return nil, nil
+	}
+	return cl, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
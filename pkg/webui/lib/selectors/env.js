@@ -42,6 +42,8 @@ export const selectAsConfig = () => selectStackConfig().as
 
 export const selectLanguageConfig = () => selectApplicationConfig().language
 
+export const selectOIDCProviders = () => selectApplicationConfig().oidc_providers || []
+
 export const selectSupportLinkConfig = () => selectApplicationConfig().support_link
 
 export const selectPageData = () => configSelector().PAGE_DATA
@@ -23,7 +23,11 @@ import * as Yup from 'yup'
 
 import api from '../../api'
 import sharedMessages from '../../../lib/shared-messages'
-import { selectApplicationRootPath, selectApplicationSiteName } from '../../../lib/selectors/env'
+import {
+  selectApplicationRootPath,
+  selectApplicationSiteName,
+  selectOIDCProviders,
+} from '../../../lib/selectors/env'
 import PropTypes from '../../../lib/prop-types'
 
 import Button from '../../../components/button'
@@ -41,6 +45,7 @@ const m = defineMessages({
   createAccount: 'Create an account',
   forgotPassword: 'Forgot password?',
   loginToContinue: 'Please login to continue',
+  loginWith: 'Login with {providerName}',
 })
 
 const validationSchema = Yup.object().shape({
@@ -56,6 +61,7 @@ const validationSchema = Yup.object().shape({
 @connect(
   () => ({
     siteName: selectApplicationSiteName(),
+    oidcProviders: selectOIDCProviders(),
   }),
   {
     replace,
@@ -65,6 +71,12 @@ const validationSchema = Yup.object().shape({
 export default class OAuth extends React.PureComponent {
   static propTypes = {
     location: PropTypes.location.isRequired,
+    oidcProviders: PropTypes.arrayOf(
+      PropTypes.shape({
+        id: PropTypes.string.isRequired,
+        name: PropTypes.string.isRequired,
+      }),
+    ).isRequired,
     replace: PropTypes.func.isRequired,
     siteName: PropTypes.string.isRequired,
   }
@@ -111,7 +123,7 @@ export default class OAuth extends React.PureComponent {
     }
 
     const { info } = this.props.location.state || ''
-    const { siteName } = this.props
+    const { siteName, oidcProviders, location } = this.props
 
     return (
       <div className={style.fullHeightCenter}>
@@ -150,6 +162,14 @@ export default class OAuth extends React.PureComponent {
               <Form.Submit component={SubmitButton} message={sharedMessages.login} />
               <Button naked message={m.createAccount} onClick={this.navigateToRegister} />
               <Button naked message={m.forgotPassword} onClick={this.navigateToResetPassword} />
+              {oidcProviders.map(provider => (
+                <Button.AnchorLink
+                  key={provider.id}
+                  naked
+                  message={{ ...m.loginWith, values: { providerName: provider.name } }}
+                  href={`${appRoot}/login/${provider.id}?n=${encodeURIComponent(url(location))}`}
+                />
+              ))}
             </Form>
           </div>
         </div>